@@ -1,12 +1,17 @@
 package api
 
 import (
+	"sync"
+	"time"
+
 	"github.com/onkernel/hypeman/cmd/api/config"
+	"github.com/onkernel/hypeman/lib/apikeys"
 	"github.com/onkernel/hypeman/lib/builds"
 	"github.com/onkernel/hypeman/lib/devices"
 	"github.com/onkernel/hypeman/lib/images"
 	"github.com/onkernel/hypeman/lib/ingress"
 	"github.com/onkernel/hypeman/lib/instances"
+	mw "github.com/onkernel/hypeman/lib/middleware"
 	"github.com/onkernel/hypeman/lib/network"
 	"github.com/onkernel/hypeman/lib/oapi"
 	"github.com/onkernel/hypeman/lib/resources"
@@ -24,6 +29,11 @@ type ApiService struct {
 	IngressManager  ingress.Manager
 	BuildManager    builds.Manager
 	ResourceManager *resources.Manager
+	APIKeyManager   apikeys.Manager
+	JWTVerifier     *mw.JWTVerifier
+
+	drainMu       sync.Mutex
+	drainingSince *time.Time // non-nil while draining
 }
 
 var _ oapi.StrictServerInterface = (*ApiService)(nil)
@@ -39,6 +49,8 @@ func New(
 	ingressManager ingress.Manager,
 	buildManager builds.Manager,
 	resourceManager *resources.Manager,
+	apiKeyManager apikeys.Manager,
+	jwtVerifier *mw.JWTVerifier,
 ) *ApiService {
 	return &ApiService{
 		Config:          config,
@@ -50,5 +62,7 @@ func New(
 		IngressManager:  ingressManager,
 		BuildManager:    buildManager,
 		ResourceManager: resourceManager,
+		APIKeyManager:   apiKeyManager,
+		JWTVerifier:     jwtVerifier,
 	}
 }
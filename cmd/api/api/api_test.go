@@ -42,7 +42,7 @@ func newTestService(t *testing.T) *ApiService {
 	limits := instances.ResourceLimits{
 		MaxOverlaySize: 100 * 1024 * 1024 * 1024, // 100GB
 	}
-	instanceMgr := instances.NewManager(p, imageMgr, systemMgr, networkMgr, deviceMgr, volumeMgr, limits, "", nil, nil)
+	instanceMgr := instances.NewManager(p, imageMgr, systemMgr, networkMgr, deviceMgr, volumeMgr, limits, 0, instances.ConsoleConfig{}, "", nil, nil)
 
 	// Register cleanup for orphaned Cloud Hypervisor processes
 	t.Cleanup(func() {
@@ -117,9 +117,6 @@ func ctxWithInstance(svc *ApiService, idOrName string) context.Context {
 // ctxWithVolume creates a context with a resolved volume (simulates ResolveResource middleware)
 func ctxWithVolume(svc *ApiService, idOrName string) context.Context {
 	vol, err := svc.VolumeManager.GetVolume(ctx(), idOrName)
-	if err != nil {
-		vol, err = svc.VolumeManager.GetVolumeByName(ctx(), idOrName)
-	}
 	if err != nil {
 		return ctx()
 	}
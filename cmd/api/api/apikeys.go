@@ -0,0 +1,184 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/onkernel/hypeman/lib/apikeys"
+	"github.com/onkernel/hypeman/lib/logger"
+	mw "github.com/onkernel/hypeman/lib/middleware"
+	"github.com/onkernel/hypeman/lib/oapi"
+)
+
+// ListApiKeys lists all API keys, including revoked ones. A caller
+// authenticated with a scoped API key only sees keys for its own subject.
+func (s *ApiService) ListApiKeys(ctx context.Context, request oapi.ListApiKeysRequestObject) (oapi.ListApiKeysResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	keys, err := s.APIKeyManager.List(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to list api keys", "error", err)
+		return oapi.ListApiKeys500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to list api keys",
+		}, nil
+	}
+
+	oapiKeys := make([]oapi.ApiKey, 0, len(keys))
+	for i := range keys {
+		if apiKeyVisible(ctx, &keys[i]) {
+			oapiKeys = append(oapiKeys, apiKeyToOAPI(&keys[i]))
+		}
+	}
+	return oapi.ListApiKeys200JSONResponse(oapiKeys), nil
+}
+
+// CreateApiKey creates an API key. A caller authenticated with a scoped API
+// key can only create keys for its own subject within its own scopes.
+func (s *ApiService) CreateApiKey(ctx context.Context, request oapi.CreateApiKeyRequestObject) (oapi.CreateApiKeyResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	domainReq := apikeys.CreateAPIKeyRequest{
+		Subject: mw.GetUserIDFromContext(ctx),
+	}
+	if request.Body.Name != nil {
+		domainReq.Name = *request.Body.Name
+	}
+	if request.Body.Scopes != nil {
+		domainReq.Scopes = *request.Body.Scopes
+	}
+
+	caller := mw.GetAPIKeyFromContext(ctx)
+	if request.Body.Subject != nil && *request.Body.Subject != "" {
+		if caller != nil && *request.Body.Subject != caller.Subject {
+			return oapi.CreateApiKey403JSONResponse{
+				Code:    "forbidden",
+				Message: "api keys can only create keys for their own subject",
+			}, nil
+		}
+		domainReq.Subject = *request.Body.Subject
+	}
+	if caller != nil && len(caller.Scopes) > 0 {
+		if len(domainReq.Scopes) == 0 {
+			return oapi.CreateApiKey403JSONResponse{
+				Code:    "forbidden",
+				Message: "a scoped api key cannot create a key with full access",
+			}, nil
+		}
+		for _, scope := range domainReq.Scopes {
+			resource, action, _ := strings.Cut(scope, ":")
+			if !caller.Allows(resource, action == apikeys.ScopeWrite) {
+				return oapi.CreateApiKey403JSONResponse{
+					Code:    "forbidden",
+					Message: "scope " + scope + " exceeds the calling api key's scopes",
+				}, nil
+			}
+		}
+	}
+
+	key, secret, err := s.APIKeyManager.Create(ctx, domainReq)
+	if err != nil {
+		if errors.Is(err, apikeys.ErrInvalidRequest) {
+			return oapi.CreateApiKey400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		}
+		log.ErrorContext(ctx, "failed to create api key", "error", err)
+		return oapi.CreateApiKey500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to create api key",
+		}, nil
+	}
+
+	log.InfoContext(ctx, "api key created", "api_key_id", key.ID, "subject", key.Subject, "scopes", key.Scopes)
+	k := apiKeyToOAPI(key)
+	return oapi.CreateApiKey201JSONResponse{
+		Id:        k.Id,
+		Name:      k.Name,
+		Subject:   k.Subject,
+		Scopes:    k.Scopes,
+		CreatedAt: k.CreatedAt,
+		RevokedAt: k.RevokedAt,
+		Key:       secret,
+	}, nil
+}
+
+// GetApiKey gets API key details
+func (s *ApiService) GetApiKey(ctx context.Context, request oapi.GetApiKeyRequestObject) (oapi.GetApiKeyResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	key, err := s.APIKeyManager.Get(ctx, request.Id)
+	if err == nil && !apiKeyVisible(ctx, key) {
+		err = apikeys.ErrNotFound
+	}
+	if err != nil {
+		if errors.Is(err, apikeys.ErrNotFound) {
+			return oapi.GetApiKey404JSONResponse{
+				Code:    "not_found",
+				Message: "api key not found",
+			}, nil
+		}
+		log.ErrorContext(ctx, "failed to get api key", "error", err, "id", request.Id)
+		return oapi.GetApiKey500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to get api key",
+		}, nil
+	}
+	return oapi.GetApiKey200JSONResponse(apiKeyToOAPI(key)), nil
+}
+
+// RevokeApiKey revokes an API key. It is rejected from the next request on.
+func (s *ApiService) RevokeApiKey(ctx context.Context, request oapi.RevokeApiKeyRequestObject) (oapi.RevokeApiKeyResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	key, err := s.APIKeyManager.Get(ctx, request.Id)
+	if err == nil && !apiKeyVisible(ctx, key) {
+		err = apikeys.ErrNotFound
+	}
+	if err == nil {
+		_, err = s.APIKeyManager.Revoke(ctx, request.Id)
+	}
+	if err != nil {
+		if errors.Is(err, apikeys.ErrNotFound) {
+			return oapi.RevokeApiKey404JSONResponse{
+				Code:    "not_found",
+				Message: "api key not found",
+			}, nil
+		}
+		log.ErrorContext(ctx, "failed to revoke api key", "error", err, "id", request.Id)
+		return oapi.RevokeApiKey500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to revoke api key",
+		}, nil
+	}
+
+	log.InfoContext(ctx, "api key revoked", "api_key_id", request.Id)
+	return oapi.RevokeApiKey204Response{}, nil
+}
+
+// apiKeyVisible reports whether the caller can see and revoke key. A scoped
+// API key is limited to keys for its own subject; others answer 404, so
+// their IDs aren't revealed.
+func apiKeyVisible(ctx context.Context, key *apikeys.APIKey) bool {
+	caller := mw.GetAPIKeyFromContext(ctx)
+	return caller == nil || len(caller.Scopes) == 0 || key.Subject == caller.Subject
+}
+
+func apiKeyToOAPI(key *apikeys.APIKey) oapi.ApiKey {
+	out := oapi.ApiKey{
+		Id:        key.ID,
+		Subject:   key.Subject,
+		Scopes:    key.Scopes,
+		CreatedAt: key.CreatedAt,
+		RevokedAt: key.RevokedAt,
+	}
+	if out.Scopes == nil {
+		out.Scopes = []string{}
+	}
+	if key.Name != "" {
+		out.Name = &key.Name
+	}
+	return out
+}
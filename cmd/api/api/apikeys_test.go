@@ -0,0 +1,53 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/apikeys"
+	mw "github.com/onkernel/hypeman/lib/middleware"
+	"github.com/onkernel/hypeman/lib/oapi"
+	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiKeys_ScopedCallerLimitedToOwnSubject(t *testing.T) {
+	keys := apikeys.NewManager(paths.New(t.TempDir()))
+	svc := &ApiService{APIKeyManager: keys}
+
+	caller, _, err := keys.Create(ctx(), apikeys.CreateAPIKeyRequest{Subject: "alice", Scopes: []string{"api-keys:write"}})
+	require.NoError(t, err)
+	own, _, err := keys.Create(ctx(), apikeys.CreateAPIKeyRequest{Subject: "alice", Scopes: []string{"instances:read"}})
+	require.NoError(t, err)
+	other, _, err := keys.Create(ctx(), apikeys.CreateAPIKeyRequest{Subject: "bob"})
+	require.NoError(t, err)
+	scoped := mw.WithAPIKey(ctx(), caller)
+
+	listResp, err := svc.ListApiKeys(scoped, oapi.ListApiKeysRequestObject{})
+	require.NoError(t, err)
+	var ids []string
+	for _, k := range listResp.(oapi.ListApiKeys200JSONResponse) {
+		ids = append(ids, k.Id)
+	}
+	assert.ElementsMatch(t, []string{caller.ID, own.ID}, ids)
+
+	// Another subject's key looks like it doesn't exist and stays active
+	getResp, err := svc.GetApiKey(scoped, oapi.GetApiKeyRequestObject{Id: other.ID})
+	require.NoError(t, err)
+	assert.IsType(t, oapi.GetApiKey404JSONResponse{}, getResp)
+	revokeResp, err := svc.RevokeApiKey(scoped, oapi.RevokeApiKeyRequestObject{Id: other.ID})
+	require.NoError(t, err)
+	assert.IsType(t, oapi.RevokeApiKey404JSONResponse{}, revokeResp)
+	stored, err := keys.Get(ctx(), other.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.Revoked())
+
+	revokeResp, err = svc.RevokeApiKey(scoped, oapi.RevokeApiKeyRequestObject{Id: own.ID})
+	require.NoError(t, err)
+	assert.IsType(t, oapi.RevokeApiKey204Response{}, revokeResp)
+
+	// Unscoped callers see every key
+	listResp, err = svc.ListApiKeys(ctx(), oapi.ListApiKeysRequestObject{})
+	require.NoError(t, err)
+	assert.Len(t, listResp.(oapi.ListApiKeys200JSONResponse), 3)
+}
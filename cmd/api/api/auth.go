@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/logger"
+	"github.com/onkernel/hypeman/lib/oapi"
+	"github.com/samber/lo"
+)
+
+// VerifyToken reports whether a token would be accepted and decodes its
+// claims, even if it is invalid
+func (s *ApiService) VerifyToken(ctx context.Context, request oapi.VerifyTokenRequestObject) (oapi.VerifyTokenResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	ti, err := s.JWTVerifier.Inspect(request.Body.Token)
+	if err != nil {
+		log.DebugContext(ctx, "failed to decode token", "error", err)
+		return oapi.VerifyToken400JSONResponse{
+			Code:    "invalid_token",
+			Message: err.Error(),
+		}, nil
+	}
+
+	now := time.Now().UTC()
+	resp := oapi.VerifyToken200JSONResponse{
+		Valid:      ti.Valid,
+		Error:      lo.EmptyableToPtr(ti.Error),
+		Type:       oapi.TokenVerificationType(ti.Type),
+		Algorithm:  ti.Algorithm,
+		KeyId:      lo.EmptyableToPtr(ti.KeyID),
+		Subject:    lo.EmptyableToPtr(ti.Subject),
+		Issuer:     lo.EmptyableToPtr(ti.Issuer),
+		IssuedAt:   ti.IssuedAt,
+		NotBefore:  ti.NotBefore,
+		ExpiresAt:  ti.ExpiresAt,
+		ServerTime: now,
+	}
+	if len(ti.Audience) > 0 {
+		resp.Audience = &ti.Audience
+	}
+	if ti.ExpiresAt != nil {
+		resp.ExpiresInSeconds = lo.ToPtr(int64(ti.ExpiresAt.Sub(now).Seconds()))
+	}
+	log.InfoContext(ctx, "token verified", "valid", ti.Valid, "type", ti.Type, "subject", ti.Subject)
+	return resp, nil
+}
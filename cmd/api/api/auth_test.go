@@ -0,0 +1,67 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	mw "github.com/onkernel/hypeman/lib/middleware"
+	"github.com/onkernel/hypeman/lib/oapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyToken(t *testing.T) {
+	svc := newTestService(t)
+	svc.JWTVerifier = mw.HMACVerifier("test-secret")
+
+	sign := func(secret string, exp time.Time) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub": "user-123",
+			"aud": "hypeman",
+			"exp": exp.Unix(),
+		}).SignedString([]byte(secret))
+		require.NoError(t, err)
+		return token
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		resp, err := svc.VerifyToken(ctx(), oapi.VerifyTokenRequestObject{Body: &oapi.VerifyTokenJSONRequestBody{Token: sign("test-secret", time.Now().Add(time.Hour))}})
+		require.NoError(t, err)
+		v, ok := resp.(oapi.VerifyToken200JSONResponse)
+		require.True(t, ok, "expected 200 response")
+		assert.True(t, v.Valid)
+		assert.Nil(t, v.Error)
+		assert.Equal(t, oapi.TokenVerificationType("user"), v.Type)
+		assert.Equal(t, "HS256", v.Algorithm)
+		assert.Equal(t, "user-123", *v.Subject)
+		assert.Equal(t, []string{"hypeman"}, *v.Audience)
+		require.NotNil(t, v.ExpiresInSeconds)
+		assert.InDelta(t, 3600, *v.ExpiresInSeconds, 5)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		resp, err := svc.VerifyToken(ctx(), oapi.VerifyTokenRequestObject{Body: &oapi.VerifyTokenJSONRequestBody{Token: sign("test-secret", time.Now().Add(-time.Minute))}})
+		require.NoError(t, err)
+		v := resp.(oapi.VerifyToken200JSONResponse)
+		assert.False(t, v.Valid)
+		assert.Contains(t, *v.Error, "expired")
+		assert.Less(t, *v.ExpiresInSeconds, int64(0))
+		assert.Equal(t, "user-123", *v.Subject, "claims are decoded even when invalid")
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		resp, err := svc.VerifyToken(ctx(), oapi.VerifyTokenRequestObject{Body: &oapi.VerifyTokenJSONRequestBody{Token: sign("other-secret", time.Now().Add(time.Hour))}})
+		require.NoError(t, err)
+		v := resp.(oapi.VerifyToken200JSONResponse)
+		assert.False(t, v.Valid)
+		assert.Contains(t, *v.Error, "signature")
+	})
+
+	t.Run("not a jwt", func(t *testing.T) {
+		resp, err := svc.VerifyToken(ctx(), oapi.VerifyTokenRequestObject{Body: &oapi.VerifyTokenJSONRequestBody{Token: "garbage"}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.VerifyToken400JSONResponse)
+		assert.True(t, ok, "expected 400 response")
+	})
+}
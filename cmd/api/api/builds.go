@@ -6,20 +6,31 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"strconv"
 
 	"github.com/onkernel/hypeman/lib/builds"
 	"github.com/onkernel/hypeman/lib/logger"
+	mw "github.com/onkernel/hypeman/lib/middleware"
 	"github.com/onkernel/hypeman/lib/oapi"
+	"github.com/samber/lo"
 )
 
-// ListBuilds returns all builds
+// ListBuilds returns one page of builds
 func (s *ApiService) ListBuilds(ctx context.Context, request oapi.ListBuildsRequestObject) (oapi.ListBuildsResponseObject, error) {
 	log := logger.FromContext(ctx)
 
-	domainBuilds, err := s.BuildManager.ListBuilds(ctx)
+	params := request.Params
+	page, err := s.BuildManager.ListBuildsPage(ctx, pageRequest(params.Limit, params.Cursor, params.Sort))
 	if err != nil {
+		if isPageRequestError(err) {
+			return oapi.ListBuilds400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		}
 		log.ErrorContext(ctx, "failed to list builds", "error", err)
 		return oapi.ListBuilds500JSONResponse{
 			Code:    "internal_error",
@@ -27,24 +38,70 @@ func (s *ApiService) ListBuilds(ctx context.Context, request oapi.ListBuildsRequ
 		}, nil
 	}
 
-	oapiBuilds := make([]oapi.Build, len(domainBuilds))
-	for i, b := range domainBuilds {
+	oapiBuilds := make([]oapi.Build, len(page.Items))
+	for i, b := range page.Items {
 		oapiBuilds[i] = buildToOAPI(b)
 	}
 
-	return oapi.ListBuilds200JSONResponse(oapiBuilds), nil
+	return oapi.ListBuilds200JSONResponse{
+		Body:    oapiBuilds,
+		Headers: oapi.ListBuilds200ResponseHeaders{XTotalCount: page.Total, XNextCursor: page.NextCursor},
+	}, nil
+}
+
+// buildSourceContentTypes are the Content-Types accepted on the source part.
+// Clients that don't know the type send application/octet-stream. A plain
+// tar isn't accepted: the source must be gzip-compressed.
+var buildSourceContentTypes = map[string]bool{
+	"application/gzip":             true,
+	"application/x-gzip":           true,
+	"application/x-compressed-tar": true,
+	"application/octet-stream":     true,
 }
 
+// maxBuildFieldBytes caps the form fields other than the source, which are
+// read into memory, taken together
+const maxBuildFieldBytes = 1 << 20
+
+var errBuildFieldsTooLarge = errors.New("build form fields too large")
+
 // CreateBuild creates a new build job
 func (s *ApiService) CreateBuild(ctx context.Context, request oapi.CreateBuildRequestObject) (oapi.CreateBuildResponseObject, error) {
 	log := logger.FromContext(ctx)
 
-	// Parse multipart form fields
-	var sourceData []byte
+	if err := s.Draining(); err != nil {
+		return oapi.CreateBuild503JSONResponse{
+			Code:    "draining",
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Parse multipart form fields. The source is streamed to disk as it
+	// arrives; it is removed unless a build takes it.
+	var source *builds.StagedSource
+	defer func() { source.Remove() }()
 	var baseImageDigest, cacheScope, dockerfile string
-	var timeoutSeconds int
+	var cacheImports []string
+	var timeoutSeconds, memoryMB int
+	var skipDockerfileValidation bool
+	var outputType string
+	var frontend string
+	var frontendOpts map[string]string
 	var secrets []builds.SecretRef
 
+	fieldBudget := int64(maxBuildFieldBytes)
+	readField := func(part *multipart.Part) ([]byte, error) {
+		data, err := io.ReadAll(io.LimitReader(part, fieldBudget+1))
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(data)) > fieldBudget {
+			return nil, errBuildFieldsTooLarge
+		}
+		fieldBudget -= int64(len(data))
+		return data, nil
+	}
+
 	for {
 		part, err := request.Body.NextPart()
 		if err == io.EOF {
@@ -59,15 +116,38 @@ func (s *ApiService) CreateBuild(ctx context.Context, request oapi.CreateBuildRe
 
 		switch part.FormName() {
 		case "source":
-			sourceData, err = io.ReadAll(part)
+			if contentType := part.Header.Get("Content-Type"); contentType != "" {
+				mediaType, _, _ := mime.ParseMediaType(contentType)
+				if !buildSourceContentTypes[mediaType] {
+					return oapi.CreateBuild415JSONResponse{
+						Code:    "unsupported_media_type",
+						Message: fmt.Sprintf("source must be a gzip-compressed tarball, got Content-Type %s", contentType),
+					}, nil
+				}
+			}
+			source.Remove()
+			source, err = s.BuildManager.StageSource(ctx, part)
 			if err != nil {
+				switch {
+				case errors.Is(err, builds.ErrSourceTooLarge):
+					return oapi.CreateBuild413JSONResponse{
+						Code:    "source_too_large",
+						Message: err.Error(),
+					}, nil
+				case errors.Is(err, builds.ErrInvalidSource):
+					return oapi.CreateBuild400JSONResponse{
+						Code:    "invalid_source",
+						Message: err.Error(),
+					}, nil
+				}
+				log.WarnContext(ctx, "failed to receive build source", "error", err)
 				return oapi.CreateBuild400JSONResponse{
 					Code:    "invalid_source",
 					Message: "failed to read source data",
 				}, nil
 			}
 		case "base_image_digest":
-			data, err := io.ReadAll(part)
+			data, err := readField(part)
 			if err != nil {
 				return oapi.CreateBuild400JSONResponse{
 					Code:    "invalid_request",
@@ -76,7 +156,7 @@ func (s *ApiService) CreateBuild(ctx context.Context, request oapi.CreateBuildRe
 			}
 			baseImageDigest = string(data)
 		case "cache_scope":
-			data, err := io.ReadAll(part)
+			data, err := readField(part)
 			if err != nil {
 				return oapi.CreateBuild400JSONResponse{
 					Code:    "invalid_request",
@@ -84,8 +164,17 @@ func (s *ApiService) CreateBuild(ctx context.Context, request oapi.CreateBuildRe
 				}, nil
 			}
 			cacheScope = string(data)
+		case "cache_imports":
+			data, err := readField(part)
+			if err != nil {
+				return oapi.CreateBuild400JSONResponse{
+					Code:    "invalid_request",
+					Message: "failed to read cache_imports field",
+				}, nil
+			}
+			cacheImports = append(cacheImports, string(data))
 		case "dockerfile":
-			data, err := io.ReadAll(part)
+			data, err := readField(part)
 			if err != nil {
 				return oapi.CreateBuild400JSONResponse{
 					Code:    "invalid_request",
@@ -94,7 +183,7 @@ func (s *ApiService) CreateBuild(ctx context.Context, request oapi.CreateBuildRe
 			}
 			dockerfile = string(data)
 		case "timeout_seconds":
-			data, err := io.ReadAll(part)
+			data, err := readField(part)
 			if err != nil {
 				return oapi.CreateBuild400JSONResponse{
 					Code:    "invalid_request",
@@ -104,8 +193,70 @@ func (s *ApiService) CreateBuild(ctx context.Context, request oapi.CreateBuildRe
 			if v, err := strconv.Atoi(string(data)); err == nil {
 				timeoutSeconds = v
 			}
+		case "memory_mb":
+			data, err := readField(part)
+			if err != nil {
+				return oapi.CreateBuild400JSONResponse{
+					Code:    "invalid_request",
+					Message: "failed to read memory_mb field",
+				}, nil
+			}
+			memoryMB, err = strconv.Atoi(string(data))
+			if err != nil || memoryMB <= 0 {
+				return oapi.CreateBuild400JSONResponse{
+					Code:    "invalid_request",
+					Message: "memory_mb must be a positive integer",
+				}, nil
+			}
+		case "output_type":
+			data, err := readField(part)
+			if err != nil {
+				return oapi.CreateBuild400JSONResponse{
+					Code:    "invalid_request",
+					Message: "failed to read output_type field",
+				}, nil
+			}
+			outputType = string(data)
+		case "frontend":
+			data, err := readField(part)
+			if err != nil {
+				return oapi.CreateBuild400JSONResponse{
+					Code:    "invalid_request",
+					Message: "failed to read frontend field",
+				}, nil
+			}
+			frontend = string(data)
+		case "frontend_opts":
+			data, err := readField(part)
+			if err != nil {
+				return oapi.CreateBuild400JSONResponse{
+					Code:    "invalid_request",
+					Message: "failed to read frontend_opts field",
+				}, nil
+			}
+			if err := json.Unmarshal(data, &frontendOpts); err != nil {
+				return oapi.CreateBuild400JSONResponse{
+					Code:    "invalid_request",
+					Message: "frontend_opts must be a JSON object of string values",
+				}, nil
+			}
+		case "skip_dockerfile_validation":
+			data, err := readField(part)
+			if err != nil {
+				return oapi.CreateBuild400JSONResponse{
+					Code:    "invalid_request",
+					Message: "failed to read skip_dockerfile_validation field",
+				}, nil
+			}
+			skipDockerfileValidation, err = strconv.ParseBool(string(data))
+			if err != nil {
+				return oapi.CreateBuild400JSONResponse{
+					Code:    "invalid_request",
+					Message: "skip_dockerfile_validation must be true or false",
+				}, nil
+			}
 		case "secrets":
-			data, err := io.ReadAll(part)
+			data, err := readField(part)
 			if err != nil {
 				return oapi.CreateBuild400JSONResponse{
 					Code:    "invalid_request",
@@ -122,7 +273,7 @@ func (s *ApiService) CreateBuild(ctx context.Context, request oapi.CreateBuildRe
 		part.Close()
 	}
 
-	if len(sourceData) == 0 {
+	if source == nil {
 		return oapi.CreateBuild400JSONResponse{
 			Code:    "invalid_request",
 			Message: "source is required",
@@ -136,18 +287,26 @@ func (s *ApiService) CreateBuild(ctx context.Context, request oapi.CreateBuildRe
 	domainReq := builds.CreateBuildRequest{
 		BaseImageDigest: baseImageDigest,
 		CacheScope:      cacheScope,
+		CacheImports:    cacheImports,
 		Dockerfile:      dockerfile,
 		Secrets:         secrets,
+		OutputType:      outputType,
+		Frontend:        frontend,
+		FrontendOpts:    frontendOpts,
+
+		SkipDockerfileValidation: skipDockerfileValidation,
+		Tenant:                   mw.GetUserIDFromContext(ctx),
 	}
 
-	// Apply timeout if provided
-	if timeoutSeconds > 0 {
+	// Apply timeout and memory if provided
+	if timeoutSeconds > 0 || memoryMB > 0 {
 		domainReq.BuildPolicy = &builds.BuildPolicy{
 			TimeoutSeconds: timeoutSeconds,
+			MemoryMB:       memoryMB,
 		}
 	}
 
-	build, err := s.BuildManager.CreateBuild(ctx, domainReq, sourceData)
+	build, err := s.BuildManager.CreateBuild(ctx, domainReq, source)
 	if err != nil {
 		switch {
 		case errors.Is(err, builds.ErrDockerfileRequired):
@@ -155,11 +314,46 @@ func (s *ApiService) CreateBuild(ctx context.Context, request oapi.CreateBuildRe
 				Code:    "dockerfile_required",
 				Message: err.Error(),
 			}, nil
+		case errors.Is(err, builds.ErrInvalidOutputType):
+			return oapi.CreateBuild400JSONResponse{
+				Code:    "invalid_output_type",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, builds.ErrFrontendNotAllowed):
+			return oapi.CreateBuild400JSONResponse{
+				Code:    "frontend_not_allowed",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, builds.ErrInvalidFrontendOption):
+			return oapi.CreateBuild400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, builds.ErrInvalidDockerfile):
+			return oapi.CreateBuild400JSONResponse{
+				Code:    "invalid_dockerfile",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, builds.ErrInvalidBuildPolicy):
+			return oapi.CreateBuild400JSONResponse{
+				Code:    "invalid_build_policy",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, builds.ErrInvalidCacheScope):
+			return oapi.CreateBuild400JSONResponse{
+				Code:    "invalid_cache_scope",
+				Message: err.Error(),
+			}, nil
 		case errors.Is(err, builds.ErrInvalidSource):
 			return oapi.CreateBuild400JSONResponse{
 				Code:    "invalid_source",
 				Message: err.Error(),
 			}, nil
+		case errors.Is(err, builds.ErrBuilderNotReady):
+			return oapi.CreateBuild503JSONResponse{
+				Code:    "builder_image_unavailable",
+				Message: err.Error(),
+			}, nil
 		default:
 			log.ErrorContext(ctx, "failed to create build", "error", err)
 			return oapi.CreateBuild500JSONResponse{
@@ -173,32 +367,44 @@ func (s *ApiService) CreateBuild(ctx context.Context, request oapi.CreateBuildRe
 }
 
 // GetBuild gets build details
+// The id parameter can be a build ID or a unique ID prefix
+// Note: Resolution is handled by ResolveResource middleware
 func (s *ApiService) GetBuild(ctx context.Context, request oapi.GetBuildRequestObject) (oapi.GetBuildResponseObject, error) {
-	log := logger.FromContext(ctx)
-
-	build, err := s.BuildManager.GetBuild(ctx, request.Id)
-	if err != nil {
-		if errors.Is(err, builds.ErrNotFound) {
-			return oapi.GetBuild404JSONResponse{
-				Code:    "not_found",
-				Message: "build not found",
-			}, nil
-		}
-		log.ErrorContext(ctx, "failed to get build", "error", err, "id", request.Id)
+	build := mw.GetResolvedBuild[builds.Build](ctx)
+	if build == nil {
 		return oapi.GetBuild500JSONResponse{
 			Code:    "internal_error",
-			Message: "failed to get build",
+			Message: "resource not resolved",
 		}, nil
 	}
-
 	return oapi.GetBuild200JSONResponse(buildToOAPI(build)), nil
 }
 
+// GetBuildQueue returns the build queue's counts, overall and per tenant
+func (s *ApiService) GetBuildQueue(ctx context.Context, request oapi.GetBuildQueueRequestObject) (oapi.GetBuildQueueResponseObject, error) {
+	stats := s.BuildManager.QueueStats()
+	return oapi.GetBuildQueue200JSONResponse{
+		MaxConcurrent: stats.MaxConcurrent,
+		Active:        stats.Active,
+		Pending:       stats.Pending,
+		Tenants: lo.Map(stats.Tenants, func(t builds.TenantQueueStats, _ int) oapi.TenantBuildQueueStats {
+			return oapi.TenantBuildQueueStats{Tenant: t.Tenant, Active: t.Active, Pending: t.Pending}
+		}),
+	}, nil
+}
+
 // CancelBuild cancels a build
 func (s *ApiService) CancelBuild(ctx context.Context, request oapi.CancelBuildRequestObject) (oapi.CancelBuildResponseObject, error) {
+	build := mw.GetResolvedBuild[builds.Build](ctx)
+	if build == nil {
+		return oapi.CancelBuild500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
 	log := logger.FromContext(ctx)
 
-	err := s.BuildManager.CancelBuild(ctx, request.Id)
+	err := s.BuildManager.CancelBuild(ctx, build.ID)
 	if err != nil {
 		switch {
 		case errors.Is(err, builds.ErrNotFound):
@@ -212,7 +418,7 @@ func (s *ApiService) CancelBuild(ctx context.Context, request oapi.CancelBuildRe
 				Message: "build already in progress",
 			}, nil
 		default:
-			log.ErrorContext(ctx, "failed to cancel build", "error", err, "id", request.Id)
+			log.ErrorContext(ctx, "failed to cancel build", "error", err)
 			return oapi.CancelBuild500JSONResponse{
 				Code:    "internal_error",
 				Message: "failed to cancel build",
@@ -223,10 +429,98 @@ func (s *ApiService) CancelBuild(ctx context.Context, request oapi.CancelBuildRe
 	return oapi.CancelBuild204Response{}, nil
 }
 
+// RequestBuildCancel cancels a build and returns it
+func (s *ApiService) RequestBuildCancel(ctx context.Context, request oapi.RequestBuildCancelRequestObject) (oapi.RequestBuildCancelResponseObject, error) {
+	build := mw.GetResolvedBuild[builds.Build](ctx)
+	if build == nil {
+		return oapi.RequestBuildCancel500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+	log := logger.FromContext(ctx)
+
+	err := s.BuildManager.CancelBuild(ctx, build.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, builds.ErrNotFound):
+			return oapi.RequestBuildCancel404JSONResponse{
+				Code:    "not_found",
+				Message: "build not found",
+			}, nil
+		case errors.Is(err, builds.ErrBuildInProgress):
+			return oapi.RequestBuildCancel409JSONResponse{
+				Code:    "conflict",
+				Message: "build already in progress",
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to cancel build", "error", err)
+			return oapi.RequestBuildCancel500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to cancel build",
+			}, nil
+		}
+	}
+
+	cancelled, err := s.BuildManager.GetBuild(ctx, build.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get cancelled build", "error", err)
+		return oapi.RequestBuildCancel500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to get build",
+		}, nil
+	}
+	return oapi.RequestBuildCancel200JSONResponse(buildToOAPI(cancelled)), nil
+}
+
+// GetBuildArtifact downloads the files exported by a local or tar build
+func (s *ApiService) GetBuildArtifact(ctx context.Context, request oapi.GetBuildArtifactRequestObject) (oapi.GetBuildArtifactResponseObject, error) {
+	build := mw.GetResolvedBuild[builds.Build](ctx)
+	if build == nil {
+		return oapi.GetBuildArtifact500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+	log := logger.FromContext(ctx)
+
+	artifact, err := s.BuildManager.GetBuildArtifact(ctx, build.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, builds.ErrNotFound):
+			return oapi.GetBuildArtifact404JSONResponse{
+				Code:    "not_found",
+				Message: "build not found",
+			}, nil
+		case errors.Is(err, builds.ErrNoArtifact):
+			return oapi.GetBuildArtifact409JSONResponse{
+				Code:    "no_artifact",
+				Message: err.Error(),
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to get build artifact", "error", err)
+			return oapi.GetBuildArtifact500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to get build artifact",
+			}, nil
+		}
+	}
+
+	// The response closes the artifact once it has been written
+	return oapi.GetBuildArtifact200ApplicationxTarResponse{Body: artifact}, nil
+}
+
 // GetBuildEvents streams build events via SSE
 // With follow=false (default), streams existing logs then closes
 // With follow=true, continues streaming until build completes
 func (s *ApiService) GetBuildEvents(ctx context.Context, request oapi.GetBuildEventsRequestObject) (oapi.GetBuildEventsResponseObject, error) {
+	build := mw.GetResolvedBuild[builds.Build](ctx)
+	if build == nil {
+		return oapi.GetBuildEvents500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
 	log := logger.FromContext(ctx)
 
 	// Parse follow parameter (default false)
@@ -235,7 +529,7 @@ func (s *ApiService) GetBuildEvents(ctx context.Context, request oapi.GetBuildEv
 		follow = *request.Params.Follow
 	}
 
-	eventChan, err := s.BuildManager.StreamBuildEvents(ctx, request.Id, follow)
+	eventChan, err := s.BuildManager.StreamBuildEvents(ctx, build.ID, follow)
 	if err != nil {
 		if errors.Is(err, builds.ErrNotFound) {
 			return oapi.GetBuildEvents404JSONResponse{
@@ -243,7 +537,7 @@ func (s *ApiService) GetBuildEvents(ctx context.Context, request oapi.GetBuildEv
 				Message: "build not found",
 			}, nil
 		}
-		log.ErrorContext(ctx, "failed to stream build events", "error", err, "id", request.Id)
+		log.ErrorContext(ctx, "failed to stream build events", "error", err)
 		return oapi.GetBuildEvents500JSONResponse{
 			Code:    "internal_error",
 			Message: "failed to stream build events",
@@ -296,6 +590,15 @@ func buildToOAPI(b *builds.Build) oapi.Build {
 		DurationMs:    b.DurationMS,
 	}
 
+	if b.OutputType != "" {
+		outputType := oapi.BuildOutputType(b.OutputType)
+		oapiBuild.OutputType = &outputType
+	}
+
+	if b.CacheStats != nil {
+		oapiBuild.CacheStats = cacheStatsToOAPI(b.CacheStats)
+	}
+
 	if b.Provenance != nil {
 		oapiBuild.Provenance = &oapi.BuildProvenance{
 			BaseImageDigest: &b.Provenance.BaseImageDigest,
@@ -306,8 +609,29 @@ func buildToOAPI(b *builds.Build) oapi.Build {
 		if len(b.Provenance.LockfileHashes) > 0 {
 			oapiBuild.Provenance.LockfileHashes = &b.Provenance.LockfileHashes
 		}
+		if scan := b.Provenance.SecretScan; scan != nil {
+			oapiBuild.Provenance.SecretScan = &oapi.SecretScan{
+				Passed:        scan.Passed,
+				LayersScanned: scan.LayersScanned,
+			}
+			if len(scan.Leaks) > 0 {
+				oapiBuild.Provenance.SecretScan.Leaks = &scan.Leaks
+			}
+		}
+		if b.Provenance.CacheStats != nil {
+			oapiBuild.Provenance.CacheStats = cacheStatsToOAPI(b.Provenance.CacheStats)
+		}
 	}
 
 	return oapiBuild
 }
 
+// cacheStatsToOAPI converts domain build cache stats to OAPI
+func cacheStatsToOAPI(stats *builds.BuildCacheStats) *oapi.BuildCacheStats {
+	return &oapi.BuildCacheStats{
+		CacheHits:   stats.CacheHits,
+		CacheMisses: stats.CacheMisses,
+		CachedBytes: stats.CachedBytes,
+	}
+}
+
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/textproto"
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/oapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBuild_UncompressedTarRefused(t *testing.T) {
+	svc := newTestService(t)
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {`form-data; name="source"; filename="source.tar"`},
+		"Content-Type":        {"application/x-tar"},
+	})
+	require.NoError(t, err)
+	_, err = part.Write([]byte("not gzip"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	resp, err := svc.CreateBuild(ctx(), oapi.CreateBuildRequestObject{Body: multipart.NewReader(&body, w.Boundary())})
+	require.NoError(t, err)
+	refused, ok := resp.(oapi.CreateBuild415JSONResponse)
+	require.True(t, ok, "expected 415, got %T", resp)
+	assert.Equal(t, "unsupported_media_type", refused.Code)
+}
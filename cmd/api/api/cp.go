@@ -136,6 +136,15 @@ func (s *ApiService) CpHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// A cp declared read-only with ?direction=from passed the API key scope
+	// check as a read, so it can't turn into a copy to the guest
+	if declared := r.URL.Query().Get("direction"); declared != "" && declared != cpReq.Direction {
+		log.WarnContext(ctx, "cp direction does not match query", "declared", declared, "direction", cpReq.Direction)
+		errMsg, _ := json.Marshal(CpError{Type: "error", Message: fmt.Sprintf("direction %q does not match ?direction=%s", cpReq.Direction, declared)})
+		ws.WriteMessage(websocket.TextMessage, errMsg)
+		return
+	}
+
 	// Get JWT subject for audit logging
 	subject := "unknown"
 	if claims, ok := r.Context().Value("claims").(map[string]interface{}); ok {
@@ -219,6 +228,12 @@ func (s *ApiService) CpHandler(w http.ResponseWriter, r *http.Request) {
 // handleCopyTo handles copying files from client to guest
 // Returns the number of bytes transferred and any error.
 func (s *ApiService) handleCopyTo(ctx context.Context, ws *websocket.Conn, inst *instances.Instance, req CpRequest) (int64, error) {
+	// The guest agent writes files as root, outside the sandbox execSandbox
+	// puts scoped keys' commands in, so a scoped key could overwrite any file
+	if key := mw.GetAPIKeyFromContext(ctx); key != nil && len(key.Scopes) > 0 {
+		return 0, fmt.Errorf("copying to the guest needs a JWT or an unscoped API key")
+	}
+
 	// Create vsock dialer for this hypervisor type
 	dialer, err := hypervisor.NewVsockDialer(inst.HypervisorType, inst.VsockSocket, inst.VsockCID)
 	if err != nil {
@@ -46,9 +46,12 @@ func TestCpToAndFromInstance(t *testing.T) {
 			Name:  "cp-test",
 			Image: "docker.io/library/nginx:alpine",
 			Network: &struct {
-				BandwidthDownload *string `json:"bandwidth_download,omitempty"`
-				BandwidthUpload   *string `json:"bandwidth_upload,omitempty"`
-				Enabled           *bool   `json:"enabled,omitempty"`
+				BandwidthDownload *string   `json:"bandwidth_download,omitempty"`
+				BandwidthUpload   *string   `json:"bandwidth_upload,omitempty"`
+				DnsServers        *[]string `json:"dns_servers,omitempty"`
+				Enabled           *bool     `json:"enabled,omitempty"`
+				Mtu               *int      `json:"mtu,omitempty"`
+				SearchDomains     *[]string `json:"search_domains,omitempty"`
 			}{
 				Enabled: &networkEnabled,
 			},
@@ -184,9 +187,12 @@ func TestCpDirectoryToInstance(t *testing.T) {
 			Name:  "cp-dir-test",
 			Image: "docker.io/library/nginx:alpine",
 			Network: &struct {
-				BandwidthDownload *string `json:"bandwidth_download,omitempty"`
-				BandwidthUpload   *string `json:"bandwidth_upload,omitempty"`
-				Enabled           *bool   `json:"enabled,omitempty"`
+				BandwidthDownload *string   `json:"bandwidth_download,omitempty"`
+				BandwidthUpload   *string   `json:"bandwidth_upload,omitempty"`
+				DnsServers        *[]string `json:"dns_servers,omitempty"`
+				Enabled           *bool     `json:"enabled,omitempty"`
+				Mtu               *int      `json:"mtu,omitempty"`
+				SearchDomains     *[]string `json:"search_domains,omitempty"`
 			}{
 				Enabled: &networkEnabled,
 			},
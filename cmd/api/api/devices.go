@@ -6,24 +6,35 @@ import (
 
 	"github.com/onkernel/hypeman/lib/devices"
 	"github.com/onkernel/hypeman/lib/oapi"
+	"github.com/samber/lo"
 )
 
-// ListDevices returns all registered devices
+// ListDevices returns one page of registered devices
 func (s *ApiService) ListDevices(ctx context.Context, request oapi.ListDevicesRequestObject) (oapi.ListDevicesResponseObject, error) {
-	deviceList, err := s.DeviceManager.ListDevices(ctx)
+	params := request.Params
+	page, err := s.DeviceManager.ListDevicesPage(ctx, pageRequest(params.Limit, params.Cursor, params.Sort))
 	if err != nil {
+		if isPageRequestError(err) {
+			return oapi.ListDevices400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		}
 		return oapi.ListDevices500JSONResponse{
 			Code:    "internal_error",
 			Message: err.Error(),
 		}, nil
 	}
 
-	result := make([]oapi.Device, len(deviceList))
-	for i, d := range deviceList {
+	result := make([]oapi.Device, len(page.Items))
+	for i, d := range page.Items {
 		result[i] = deviceToOAPI(d)
 	}
 
-	return oapi.ListDevices200JSONResponse(result), nil
+	return oapi.ListDevices200JSONResponse{
+		Body:    result,
+		Headers: oapi.ListDevices200ResponseHeaders{XTotalCount: page.Total, XNextCursor: page.NextCursor},
+	}, nil
 }
 
 // ListAvailableDevices discovers passthrough-capable devices on the host
@@ -53,6 +64,7 @@ func (s *ApiService) CreateDevice(ctx context.Context, request oapi.CreateDevice
 	req := devices.CreateDeviceRequest{
 		Name:       name,
 		PCIAddress: request.Body.PciAddress,
+		Pool:       lo.FromPtr(request.Body.Pool),
 	}
 
 	device, err := s.DeviceManager.CreateDevice(ctx, req)
@@ -89,6 +101,27 @@ func (s *ApiService) CreateDevice(ctx context.Context, request oapi.CreateDevice
 	return oapi.CreateDevice201JSONResponse(deviceToOAPI(*device)), nil
 }
 
+// ListDevicePools returns all device pools
+func (s *ApiService) ListDevicePools(ctx context.Context, request oapi.ListDevicePoolsRequestObject) (oapi.ListDevicePoolsResponseObject, error) {
+	pools, err := s.DeviceManager.ListPools(ctx)
+	if err != nil {
+		return oapi.ListDevicePools500JSONResponse{
+			Code:    "internal_error",
+			Message: err.Error(),
+		}, nil
+	}
+
+	result := make([]oapi.DevicePool, len(pools))
+	for i, p := range pools {
+		result[i] = oapi.DevicePool{
+			Name:      p.Name,
+			DeviceIds: p.DeviceIDs,
+		}
+	}
+
+	return oapi.ListDevicePools200JSONResponse(result), nil
+}
+
 // GetDevice returns a device by ID or name
 func (s *ApiService) GetDevice(ctx context.Context, request oapi.GetDeviceRequestObject) (oapi.GetDeviceResponseObject, error) {
 	device, err := s.DeviceManager.GetDevice(ctx, request.Id)
@@ -148,6 +181,9 @@ func deviceToOAPI(d devices.Device) oapi.Device {
 		IommuGroup:  d.IOMMUGroup,
 		BoundToVfio: d.BoundToVFIO,
 		AttachedTo:  d.AttachedTo,
+		ParentPf:    d.ParentPF,
+		Pool:        lo.EmptyableToPtr(d.Pool),
+		NumaNode:    d.NUMANode,
 		CreatedAt:   d.CreatedAt,
 	}
 }
@@ -161,6 +197,8 @@ func availableDeviceToOAPI(d devices.AvailableDevice) oapi.AvailableDevice {
 		DeviceName:    &d.DeviceName,
 		IommuGroup:    d.IOMMUGroup,
 		CurrentDriver: d.CurrentDriver,
+		ParentPf:      d.ParentPF,
+		NumaNode:      d.NUMANode,
 	}
 }
 
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/builds"
+	"github.com/onkernel/hypeman/lib/instances"
+	"github.com/onkernel/hypeman/lib/logger"
+	"github.com/onkernel/hypeman/lib/oapi"
+)
+
+// ErrDraining is returned when the host is draining and not accepting new
+// instances or builds
+var ErrDraining = errors.New("host is draining and not accepting new work")
+
+// SetDraining starts or stops draining. Only the creation of new instances
+// and builds is refused; running instances and accepted builds are untouched.
+func (s *ApiService) SetDraining(ctx context.Context, draining bool) {
+	s.drainMu.Lock()
+	defer s.drainMu.Unlock()
+
+	if draining == (s.drainingSince != nil) {
+		return
+	}
+	if draining {
+		now := time.Now().UTC()
+		s.drainingSince = &now
+	} else {
+		s.drainingSince = nil
+	}
+	logger.FromContext(ctx).InfoContext(ctx, "drain state changed", "draining", draining)
+}
+
+// Draining returns ErrDraining while the host is draining, nil otherwise
+func (s *ApiService) Draining() error {
+	s.drainMu.Lock()
+	defer s.drainMu.Unlock()
+	if s.drainingSince != nil {
+		return ErrDraining
+	}
+	return nil
+}
+
+// GetDrainStatus reports whether the host is draining and how much work remains
+func (s *ApiService) GetDrainStatus(ctx context.Context, request oapi.GetDrainStatusRequestObject) (oapi.GetDrainStatusResponseObject, error) {
+	status, err := s.drainStatus(ctx)
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "failed to get drain status", "error", err)
+		return oapi.GetDrainStatus500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to get drain status",
+		}, nil
+	}
+	return oapi.GetDrainStatus200JSONResponse(status), nil
+}
+
+// SetDrain starts or stops draining the host
+func (s *ApiService) SetDrain(ctx context.Context, request oapi.SetDrainRequestObject) (oapi.SetDrainResponseObject, error) {
+	s.SetDraining(ctx, request.Body.Draining)
+
+	status, err := s.drainStatus(ctx)
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "failed to get drain status", "error", err)
+		return oapi.SetDrain500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to get drain status",
+		}, nil
+	}
+	return oapi.SetDrain200JSONResponse(status), nil
+}
+
+func (s *ApiService) drainStatus(ctx context.Context) (oapi.DrainStatus, error) {
+	s.drainMu.Lock()
+	status := oapi.DrainStatus{
+		Draining: s.drainingSince != nil,
+		Since:    s.drainingSince,
+	}
+	s.drainMu.Unlock()
+
+	insts, err := s.InstanceManager.ListInstances(ctx)
+	if err != nil {
+		return status, err
+	}
+	for _, inst := range insts {
+		if inst.State == instances.StateRunning {
+			status.RunningInstances++
+		}
+	}
+
+	if s.BuildManager != nil {
+		allBuilds, err := s.BuildManager.ListBuilds(ctx)
+		if err != nil {
+			return status, err
+		}
+		for _, b := range allBuilds {
+			switch b.Status {
+			case builds.StatusQueued, builds.StatusBuilding, builds.StatusPushing:
+				status.ActiveBuilds++
+			}
+		}
+	}
+	return status, nil
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/oapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrain(t *testing.T) {
+	svc := newTestService(t)
+
+	resp, err := svc.SetDrain(ctx(), oapi.SetDrainRequestObject{Body: &oapi.SetDrainJSONRequestBody{Draining: true}})
+	require.NoError(t, err)
+	status, ok := resp.(oapi.SetDrain200JSONResponse)
+	require.True(t, ok, "expected 200 response")
+	assert.True(t, status.Draining)
+	assert.NotNil(t, status.Since)
+	assert.Equal(t, 0, status.RunningInstances)
+	assert.ErrorIs(t, svc.Draining(), ErrDraining)
+
+	// New instances are refused before the request is even looked at
+	createResp, err := svc.CreateInstance(ctx(), oapi.CreateInstanceRequestObject{
+		Body: &oapi.CreateInstanceRequest{Name: "test", Image: "docker.io/library/alpine:latest"},
+	})
+	require.NoError(t, err)
+	draining, ok := createResp.(oapi.CreateInstance503JSONResponse)
+	require.True(t, ok, "expected 503 response")
+	assert.Equal(t, "draining", draining.Code)
+
+	resp, err = svc.SetDrain(ctx(), oapi.SetDrainRequestObject{Body: &oapi.SetDrainJSONRequestBody{Draining: false}})
+	require.NoError(t, err)
+	status = resp.(oapi.SetDrain200JSONResponse)
+	assert.False(t, status.Draining)
+	assert.Nil(t, status.Since)
+	assert.NoError(t, svc.Draining())
+}
@@ -4,18 +4,22 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/c2h5oh/datasize"
 	"github.com/gorilla/websocket"
 	"github.com/onkernel/hypeman/lib/guest"
 	"github.com/onkernel/hypeman/lib/hypervisor"
 	"github.com/onkernel/hypeman/lib/instances"
 	"github.com/onkernel/hypeman/lib/logger"
 	mw "github.com/onkernel/hypeman/lib/middleware"
+	"github.com/onkernel/hypeman/lib/oapi"
 )
 
 var upgrader = websocket.Upgrader{
@@ -35,8 +39,57 @@ type ExecRequest struct {
 	Cwd          string            `json:"cwd,omitempty"`
 	Timeout      int32             `json:"timeout,omitempty"`       // seconds
 	WaitForAgent int32             `json:"wait_for_agent,omitempty"` // seconds to wait for guest agent to be ready
+
+	// SeparateStreams prefixes each binary output message with a stream byte
+	// (execStreamStdout or execStreamStderr) instead of merging stderr into stdout
+	SeparateStreams bool `json:"separate_streams,omitempty"`
+
+	// Resource limits for the command (unset = unlimited)
+	CPUQuota    float64 `json:"cpu_quota,omitempty"`    // CPUs, e.g. 0.5
+	MemoryLimit string  `json:"memory_limit,omitempty"` // e.g. "256MB"
+
+	// Sandbox for the command (unset = whatever the guest allows)
+	DropCapabilities []string `json:"drop_capabilities,omitempty"` // e.g. "CAP_SYS_ADMIN", or "ALL"
+	SeccompProfile   string   `json:"seccomp_profile,omitempty"`   // "default"
+}
+
+// restrictedExecCapabilities are dropped from every exec by a scoped API key
+var restrictedExecCapabilities = []string{"CAP_SYS_ADMIN"}
+
+// execSandbox returns the capabilities to drop and seccomp profile for an
+// exec. Scoped API keys are how lower-trust users get access, so their
+// commands always run under the default seccomp profile and without
+// restrictedExecCapabilities; only JWTs and unscoped keys can exec
+// unrestricted.
+func execSandbox(ctx context.Context, dropCapabilities []string, seccompProfile string) ([]string, string, error) {
+	if err := guest.ValidateExecSandbox(dropCapabilities, seccompProfile); err != nil {
+		return nil, "", err
+	}
+	if key := mw.GetAPIKeyFromContext(ctx); key != nil && len(key.Scopes) > 0 {
+		dropCapabilities = append(dropCapabilities, restrictedExecCapabilities...)
+		seccompProfile = guest.SeccompProfileDefault
+	}
+	return dropCapabilities, seccompProfile, nil
+}
+
+// execRecordingBanner is written to the terminal of a recorded exec session
+// before the command starts, so the user knows its output is kept
+const execRecordingBanner = "\r\n*** This session is being recorded (session %s) ***\r\n\r\n"
+
+// execTimeouts returns the exec WebSocket ping interval and idle timeout.
+// The settings are validated at startup.
+func (s *ApiService) execTimeouts() (keepalive, idle time.Duration) {
+	keepalive, _ = time.ParseDuration(s.Config.ExecKeepaliveInterval)
+	idle, _ = time.ParseDuration(s.Config.ExecIdleTimeout)
+	return keepalive, idle
 }
 
+// Stream bytes that prefix output messages when separate_streams is set
+const (
+	execStreamStdout byte = 1
+	execStreamStderr byte = 2
+)
+
 // ExecHandler handles exec requests via WebSocket for bidirectional streaming
 // Note: Resolution is handled by ResolveResource middleware
 func (s *ApiService) ExecHandler(w http.ResponseWriter, r *http.Request) {
@@ -86,32 +139,98 @@ func (s *ApiService) ExecHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var memoryLimit datasize.ByteSize
+	if execReq.MemoryLimit != "" {
+		if err := memoryLimit.UnmarshalText([]byte(execReq.MemoryLimit)); err != nil {
+			ws.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"error":"invalid memory_limit: %v"}`, err)))
+			return
+		}
+	}
+	if execReq.CPUQuota < 0 {
+		ws.WriteMessage(websocket.TextMessage, []byte(`{"error":"cpu_quota must not be negative"}`))
+		return
+	}
+	dropCapabilities, seccompProfile, err := execSandbox(ctx, execReq.DropCapabilities, execReq.SeccompProfile)
+	if err != nil {
+		ws.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"error":%q}`, err.Error())))
+		return
+	}
+
 	// Default command if not specified
 	if len(execReq.Command) == 0 {
 		execReq.Command = []string{"/bin/sh"}
 	}
 
-	// Get JWT subject for audit logging (if available)
+	// Get the authenticated subject for audit logging (if available)
 	subject := "unknown"
-	if claims, ok := r.Context().Value("claims").(map[string]interface{}); ok {
-		if sub, ok := claims["sub"].(string); ok {
-			subject = sub
-		}
+	if userID := mw.GetUserIDFromContext(ctx); userID != "" {
+		subject = userID
 	}
 
+	// Every session goes in the instance's exec session log, and TTY sessions
+	// are recorded when EXEC_RECORDING is set. A session that can't be
+	// audited doesn't run.
+	recorder, err := s.InstanceManager.StartExecSession(ctx, inst.Id, instances.ExecSessionRequest{
+		Subject: subject,
+		Command: execReq.Command,
+		TTY:     execReq.TTY,
+		Record:  s.Config.ExecRecording,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "failed to start exec session log", "error", err)
+		ws.WriteMessage(websocket.BinaryMessage, []byte(fmt.Sprintf("Error: failed to start exec session log: %v\r\n", err)))
+		ws.WriteMessage(websocket.TextMessage, []byte(`{"exitCode":127}`))
+		return
+	}
+	session := recorder.Session()
+	exitCode := 127 // Unless the command runs
+	defer func() {
+		if err := recorder.Finish(exitCode); err != nil {
+			log.ErrorContext(ctx, "failed to finish exec session log", "session_id", session.ID, "error", err)
+		}
+	}()
+
 	// Audit log: exec session started
 	log.InfoContext(ctx, "exec session started",
 		"instance_id", inst.Id,
+		"session_id", session.ID,
+		"recorded", session.Recorded,
 		"subject", subject,
 		"command", execReq.Command,
 		"tty", execReq.TTY,
 		"cwd", execReq.Cwd,
 		"timeout", execReq.Timeout,
 		"wait_for_agent", execReq.WaitForAgent,
+		"separate_streams", execReq.SeparateStreams,
+		"cpu_quota", execReq.CPUQuota,
+		"memory_limit", execReq.MemoryLimit,
+		"drop_capabilities", dropCapabilities,
+		"seccomp_profile", seccompProfile,
 	)
 
+	// An open exec session keeps the instance from being stopped as idle
+	defer s.InstanceManager.TrackExecSession(inst.Id)()
+
+	// Only interactive sessions time out when idle; commands have a timeout
+	keepalive, idleTimeout := s.execTimeouts()
+	if !execReq.TTY {
+		idleTimeout = 0
+	}
+	execCtx, watchdog := newExecWatchdog(ctx, ws, keepalive, idleTimeout)
+	defer watchdog.stop()
+
 	// Create WebSocket read/writer wrapper
 	wsConn := &wsReadWriter{ws: ws, ctx: ctx}
+	var stdout, stderr io.Writer = wsConn, wsConn
+	if execReq.SeparateStreams && !execReq.TTY {
+		stdout = &wsStreamWriter{ws: ws, stream: execStreamStdout}
+		stderr = &wsStreamWriter{ws: ws, stream: execStreamStderr}
+	}
+	if session.Recorded {
+		stdout = io.MultiWriter(stdout, recorder)
+		wsConn.Write([]byte(fmt.Sprintf(execRecordingBanner, session.ID)))
+	}
+	stdout, stderr = watchdog.writer(stdout), watchdog.writer(stderr)
 
 	// Create vsock dialer for this hypervisor type
 	dialer, err := hypervisor.NewVsockDialer(hypervisor.Type(inst.HypervisorType), inst.VsockSocket, inst.VsockCID)
@@ -123,20 +242,50 @@ func (s *ApiService) ExecHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Execute via vsock
-	exit, err := guest.ExecIntoInstance(ctx, dialer, guest.ExecOptions{
+	exit, err := guest.ExecIntoInstance(execCtx, dialer, guest.ExecOptions{
 		Command:      execReq.Command,
-		Stdin:        wsConn,
-		Stdout:       wsConn,
-		Stderr:       wsConn,
+		Stdin:        watchdog.reader(wsConn),
+		Stdout:       stdout,
+		Stderr:       stderr,
 		TTY:          execReq.TTY,
 		Env:          execReq.Env,
 		Cwd:          execReq.Cwd,
 		Timeout:      execReq.Timeout,
 		WaitForAgent: time.Duration(execReq.WaitForAgent) * time.Second,
+
+		SeparateStreams: execReq.SeparateStreams,
+		CPUQuota:        execReq.CPUQuota,
+		MemoryLimit:     int64(memoryLimit),
+
+		DropCapabilities: dropCapabilities,
+		SeccompProfile:   seccompProfile,
 	})
 
 	duration := time.Since(startTime)
 
+	if err != nil && errors.Is(context.Cause(execCtx), errExecIdleTimeout) {
+		exitCode = execIdleTimeoutExitCode
+		log.InfoContext(ctx, "exec session closed after idle timeout",
+			"instance_id", inst.Id,
+			"session_id", session.ID,
+			"subject", subject,
+			"idle_timeout", idleTimeout,
+			"duration_ms", duration.Milliseconds(),
+		)
+		ws.WriteMessage(websocket.BinaryMessage, []byte(fmt.Sprintf("\r\nSession closed after %s without input or output\r\n", idleTimeout)))
+		ws.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"exitCode":%d,"reason":%q}`, exitCode, execReasonIdleTimeout)))
+		ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "idle timeout"), time.Now().Add(time.Second))
+		return
+	}
+	if err != nil && errors.Is(context.Cause(execCtx), errExecConnectionLost) {
+		log.WarnContext(ctx, "exec client connection lost, command killed",
+			"instance_id", inst.Id,
+			"session_id", session.ID,
+			"subject", subject,
+			"duration_ms", duration.Milliseconds(),
+		)
+		return
+	}
 	if err != nil {
 		log.ErrorContext(ctx, "exec failed",
 			"error", err,
@@ -153,16 +302,19 @@ func (s *ApiService) ExecHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	exitCode = exit.Code
+
 	// Audit log: exec session ended
 	log.InfoContext(ctx, "exec session ended",
 		"instance_id", inst.Id,
+		"session_id", session.ID,
 		"subject", subject,
 		"exit_code", exit.Code,
 		"duration_ms", duration.Milliseconds(),
 	)
 
 	// Send close frame with exit code in JSON
-	closeMsg := fmt.Sprintf(`{"exitCode":%d}`, exit.Code)
+	closeMsg := fmt.Sprintf(`{"exitCode":%d,"reason":%q}`, exit.Code, execReasonExited)
 	ws.WriteMessage(websocket.TextMessage, []byte(closeMsg))
 }
 
@@ -210,3 +362,167 @@ func (w *wsReadWriter) Write(p []byte) (n int, err error) {
 	}
 	return len(p), nil
 }
+
+// wsStreamWriter writes binary WebSocket messages tagged with a stream byte,
+// so the client can tell stdout and stderr apart
+type wsStreamWriter struct {
+	ws     *websocket.Conn
+	stream byte
+}
+
+func (w *wsStreamWriter) Write(p []byte) (n int, err error) {
+	msg := make([]byte, 0, len(p)+1)
+	msg = append(msg, w.stream)
+	msg = append(msg, p...)
+	if err := w.ws.WriteMessage(websocket.BinaryMessage, msg); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// execRunMaxOutput caps each of stdout and stderr for RunInstanceCommand
+const execRunMaxOutput = 1024 * 1024
+
+// RunInstanceCommand runs a non-interactive command and returns its output as JSON
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) RunInstanceCommand(ctx context.Context, request oapi.RunInstanceCommandRequestObject) (oapi.RunInstanceCommandResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.RunInstanceCommand500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+
+	if inst.State != instances.StateRunning {
+		return oapi.RunInstanceCommand409JSONResponse{
+			Code:    "invalid_state",
+			Message: fmt.Sprintf("instance must be running (current state: %s)", inst.State),
+		}, nil
+	}
+
+	body := request.Body
+	if len(body.Command) == 0 {
+		return oapi.RunInstanceCommand400JSONResponse{
+			Code:    "invalid_request",
+			Message: "command is required",
+		}, nil
+	}
+
+	opts := guest.ExecOptions{
+		Command:         body.Command,
+		Stdin:           strings.NewReader(""),
+		SeparateStreams: true,
+	}
+	if body.Env != nil {
+		opts.Env = *body.Env
+	}
+	if body.Cwd != nil {
+		opts.Cwd = *body.Cwd
+	}
+	if body.Stdin != nil {
+		opts.Stdin = strings.NewReader(*body.Stdin)
+	}
+	if body.Timeout != nil {
+		opts.Timeout = *body.Timeout
+	}
+	if body.WaitForAgent != nil {
+		opts.WaitForAgent = time.Duration(*body.WaitForAgent) * time.Second
+	}
+	if body.CpuQuota != nil {
+		if *body.CpuQuota < 0 {
+			return oapi.RunInstanceCommand400JSONResponse{
+				Code:    "invalid_request",
+				Message: "cpu_quota must not be negative",
+			}, nil
+		}
+		opts.CPUQuota = *body.CpuQuota
+	}
+	if body.MemoryLimit != nil && *body.MemoryLimit != "" {
+		var memoryLimit datasize.ByteSize
+		if err := memoryLimit.UnmarshalText([]byte(*body.MemoryLimit)); err != nil {
+			return oapi.RunInstanceCommand400JSONResponse{
+				Code:    "invalid_request",
+				Message: fmt.Sprintf("invalid memory_limit: %v", err),
+			}, nil
+		}
+		opts.MemoryLimit = int64(memoryLimit)
+	}
+	var dropCapabilities []string
+	if body.DropCapabilities != nil {
+		dropCapabilities = *body.DropCapabilities
+	}
+	var seccompProfile string
+	if body.SeccompProfile != nil {
+		seccompProfile = string(*body.SeccompProfile)
+	}
+	var err error
+	opts.DropCapabilities, opts.SeccompProfile, err = execSandbox(ctx, dropCapabilities, seccompProfile)
+	if err != nil {
+		return oapi.RunInstanceCommand400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+
+	stdout := &cappedBuffer{max: execRunMaxOutput}
+	stderr := &cappedBuffer{max: execRunMaxOutput}
+	opts.Stdout = stdout
+	opts.Stderr = stderr
+
+	dialer, err := hypervisor.NewVsockDialer(inst.HypervisorType, inst.VsockSocket, inst.VsockCID)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to create vsock dialer", "error", err)
+		return oapi.RunInstanceCommand500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to create vsock dialer",
+		}, nil
+	}
+
+	log.InfoContext(ctx, "exec run started",
+		"instance_id", inst.Id,
+		"command", body.Command,
+		"timeout", opts.Timeout,
+		"drop_capabilities", opts.DropCapabilities,
+		"seccomp_profile", opts.SeccompProfile,
+	)
+
+	defer s.InstanceManager.TrackExecSession(inst.Id)()
+	exit, err := guest.ExecIntoInstance(ctx, dialer, opts)
+	if err != nil {
+		log.ErrorContext(ctx, "exec run failed", "error", err, "instance_id", inst.Id)
+		return oapi.RunInstanceCommand500JSONResponse{
+			Code:    "internal_error",
+			Message: fmt.Sprintf("exec failed: %v", err),
+		}, nil
+	}
+
+	log.InfoContext(ctx, "exec run finished", "instance_id", inst.Id, "exit_code", exit.Code)
+
+	return oapi.RunInstanceCommand200JSONResponse{
+		Stdout:    stdout.buf.String(),
+		Stderr:    stderr.buf.String(),
+		ExitCode:  exit.Code,
+		Truncated: stdout.truncated || stderr.truncated,
+	}, nil
+}
+
+// cappedBuffer keeps the first max bytes written to it and discards the
+// rest, so a chatty command can't exhaust server memory
+type cappedBuffer struct {
+	buf       bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.max - b.buf.Len(); len(p) > room {
+		b.buf.Write(p[:max(room, 0)])
+		b.truncated = true
+	} else {
+		b.buf.Write(p)
+	}
+	return len(p), nil
+}
@@ -2,11 +2,16 @@ package api
 
 import (
 	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/onkernel/hypeman/lib/guest"
 	"github.com/onkernel/hypeman/lib/hypervisor"
 	"github.com/onkernel/hypeman/lib/instances"
@@ -47,9 +52,12 @@ func TestExecInstanceNonTTY(t *testing.T) {
 			Name:  "exec-test",
 			Image: "docker.io/library/nginx:alpine",
 			Network: &struct {
-				BandwidthDownload *string `json:"bandwidth_download,omitempty"`
-				BandwidthUpload   *string `json:"bandwidth_upload,omitempty"`
-				Enabled           *bool   `json:"enabled,omitempty"`
+				BandwidthDownload *string   `json:"bandwidth_download,omitempty"`
+				BandwidthUpload   *string   `json:"bandwidth_upload,omitempty"`
+				DnsServers        *[]string `json:"dns_servers,omitempty"`
+				Enabled           *bool     `json:"enabled,omitempty"`
+				Mtu               *int      `json:"mtu,omitempty"`
+				SearchDomains     *[]string `json:"search_domains,omitempty"`
 			}{
 				Enabled: &networkEnabled,
 			},
@@ -187,9 +195,12 @@ func TestExecWithDebianMinimal(t *testing.T) {
 			Name:  "debian-exec-test",
 			Image: "docker.io/library/debian:12-slim",
 			Network: &struct {
-				BandwidthDownload *string `json:"bandwidth_download,omitempty"`
-				BandwidthUpload   *string `json:"bandwidth_upload,omitempty"`
-				Enabled           *bool   `json:"enabled,omitempty"`
+				BandwidthDownload *string   `json:"bandwidth_download,omitempty"`
+				BandwidthUpload   *string   `json:"bandwidth_upload,omitempty"`
+				DnsServers        *[]string `json:"dns_servers,omitempty"`
+				Enabled           *bool     `json:"enabled,omitempty"`
+				Mtu               *int      `json:"mtu,omitempty"`
+				SearchDomains     *[]string `json:"search_domains,omitempty"`
 			}{
 				Enabled: &networkEnabled,
 			},
@@ -290,6 +301,89 @@ func collectTestLogs(t *testing.T, svc *ApiService, instanceID string, n int) st
 	return strings.Join(lines, "\n")
 }
 
+func TestCappedBuffer(t *testing.T) {
+	b := &cappedBuffer{max: 8}
+
+	n, err := b.Write([]byte("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.False(t, b.truncated)
+
+	// Writes past the cap report success so the exec keeps draining output
+	n, err = b.Write([]byte(" world"))
+	require.NoError(t, err)
+	assert.Equal(t, 6, n)
+	b.Write([]byte("!"))
+	assert.Equal(t, "hello wo", b.buf.String())
+	assert.True(t, b.truncated)
+}
+
+func TestExecWatchdog(t *testing.T) {
+	// watch serves one WebSocket, reading it as the stdin copy would, and
+	// returns the session context of a watchdog on it
+	watch := func(t *testing.T, keepalive, idle time.Duration, answerPings bool) (context.Context, *execWatchdog) {
+		sessions := make(chan context.Context, 1)
+		watchdogs := make(chan *execWatchdog, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ws, err := upgrader.Upgrade(w, r, nil)
+			require.NoError(t, err)
+			defer ws.Close()
+			session, wd := newExecWatchdog(context.Background(), ws, keepalive, idle)
+			defer wd.stop()
+			sessions <- session
+			watchdogs <- wd
+			go io.Copy(io.Discard, wd.reader(&wsReadWriter{ws: ws}))
+			<-session.Done()
+		}))
+		t.Cleanup(srv.Close)
+
+		client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { client.Close() })
+		if answerPings {
+			// The default ping handler answers while the client reads
+			go func() {
+				for {
+					if _, _, err := client.ReadMessage(); err != nil {
+						return
+					}
+				}
+			}()
+		}
+		return <-sessions, <-watchdogs
+	}
+
+	t.Run("idle timeout", func(t *testing.T) {
+		session, _ := watch(t, 20*time.Millisecond, 200*time.Millisecond, true)
+		select {
+		case <-session.Done():
+			assert.ErrorIs(t, context.Cause(session), errExecIdleTimeout)
+		case <-time.After(5 * time.Second):
+			t.Fatal("idle session was not closed")
+		}
+	})
+
+	t.Run("output keeps the session open", func(t *testing.T) {
+		session, wd := watch(t, 20*time.Millisecond, 200*time.Millisecond, true)
+		out := wd.writer(io.Discard)
+		for i := 0; i < 20; i++ {
+			out.Write([]byte("x"))
+			time.Sleep(20 * time.Millisecond)
+		}
+		assert.NoError(t, session.Err())
+	})
+
+	t.Run("client not answering pings", func(t *testing.T) {
+		session, _ := watch(t, 20*time.Millisecond, 0, false)
+		select {
+		case <-session.Done():
+			assert.ErrorIs(t, context.Cause(session), errExecConnectionLost)
+		case <-time.After(5 * time.Second):
+			t.Fatal("session with an unresponsive client was not closed")
+		}
+	})
+}
+
 // outputBuffer is a simple buffer for capturing exec output
 type outputBuffer struct {
 	buf bytes.Buffer
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Reasons an exec session ended, sent with the exit code in the final message
+const (
+	execReasonExited      = "exited"
+	execReasonIdleTimeout = "idle_timeout"
+)
+
+// execIdleTimeoutExitCode is reported for a session closed for being idle,
+// following the GNU timeout convention the guest uses for exec timeouts
+const execIdleTimeoutExitCode = 124
+
+var (
+	errExecIdleTimeout    = errors.New("exec session idle timeout")
+	errExecConnectionLost = errors.New("exec client stopped answering pings")
+)
+
+// execWatchdog pings an exec WebSocket so proxies don't drop a quiet
+// session, and ends the session when the client stops answering or, with an
+// idle timeout, when no input or output has passed for that long. Ending the
+// session cancels its context, which kills the command in the guest.
+type execWatchdog struct {
+	ws        *websocket.Conn
+	keepalive time.Duration // Ping interval (0 = no pings)
+	idle      time.Duration // Idle timeout (0 = none)
+	cancel    context.CancelCauseFunc
+
+	lastIO   atomic.Int64 // Unix nanoseconds of the last input or output
+	lastPong atomic.Int64 // Unix nanoseconds of the last pong
+}
+
+// newExecWatchdog starts watching an exec session. The returned context is
+// the session's; it is cancelled with errExecIdleTimeout or
+// errExecConnectionLost when the watchdog ends the session. Call stop once
+// the session is over.
+func newExecWatchdog(ctx context.Context, ws *websocket.Conn, keepalive, idle time.Duration) (context.Context, *execWatchdog) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	wd := &execWatchdog{ws: ws, keepalive: keepalive, idle: idle, cancel: cancel}
+	now := time.Now().UnixNano()
+	wd.lastIO.Store(now)
+	wd.lastPong.Store(now)
+
+	// Pongs are handled by whoever reads the connection, which is the stdin
+	// copy for the whole session
+	ws.SetPongHandler(func(string) error {
+		wd.lastPong.Store(time.Now().UnixNano())
+		return nil
+	})
+
+	interval := keepalive
+	if idle > 0 && (interval <= 0 || idle/4 < interval) {
+		interval = idle / 4
+	}
+	if interval > 0 {
+		go wd.run(ctx, interval)
+	}
+	return ctx, wd
+}
+
+// stop ends the watchdog
+func (wd *execWatchdog) stop() {
+	wd.cancel(nil)
+}
+
+func (wd *execWatchdog) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var lastPing time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if wd.idle > 0 && now.Sub(time.Unix(0, wd.lastIO.Load())) >= wd.idle {
+				wd.cancel(errExecIdleTimeout)
+				return
+			}
+			if wd.keepalive <= 0 || now.Sub(lastPing) < wd.keepalive {
+				continue
+			}
+			// One missed pong is allowed for a slow link
+			if now.Sub(time.Unix(0, wd.lastPong.Load())) > 2*wd.keepalive {
+				wd.cancel(errExecConnectionLost)
+				return
+			}
+			// WriteControl is safe alongside the session's own writes
+			if err := wd.ws.WriteControl(websocket.PingMessage, nil, now.Add(wd.keepalive)); err != nil {
+				wd.cancel(errExecConnectionLost)
+				return
+			}
+			lastPing = now
+		}
+	}
+}
+
+// touch records input or output
+func (wd *execWatchdog) touch() {
+	wd.lastIO.Store(time.Now().UnixNano())
+}
+
+// reader returns r, recording what is read from it as input
+func (wd *execWatchdog) reader(r io.Reader) io.Reader {
+	return &execActivityReader{r: r, wd: wd}
+}
+
+// writer returns w, recording what is written to it as output
+func (wd *execWatchdog) writer(w io.Writer) io.Writer {
+	return &execActivityWriter{w: w, wd: wd}
+}
+
+type execActivityReader struct {
+	r  io.Reader
+	wd *execWatchdog
+}
+
+func (a *execActivityReader) Read(p []byte) (int, error) {
+	n, err := a.r.Read(p)
+	if n > 0 {
+		a.wd.touch()
+	}
+	return n, err
+}
+
+type execActivityWriter struct {
+	w  io.Writer
+	wd *execWatchdog
+}
+
+func (a *execActivityWriter) Write(p []byte) (int, error) {
+	a.wd.touch()
+	return a.w.Write(p)
+}
@@ -2,10 +2,18 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
 
+	"github.com/onkernel/hypeman/lib/logger"
 	"github.com/onkernel/hypeman/lib/oapi"
 )
 
+// readinessTimeout bounds how long all readiness checks may take together
+const readinessTimeout = 3 * time.Second
+
 // GetHealth implements health check endpoint
 func (s *ApiService) GetHealth(ctx context.Context, request oapi.GetHealthRequestObject) (oapi.GetHealthResponseObject, error) {
 	return oapi.GetHealth200JSONResponse{
@@ -13,3 +21,75 @@ func (s *ApiService) GetHealth(ctx context.Context, request oapi.GetHealthReques
 	}, nil
 }
 
+// ReadinessCheck is a named check of a subsystem the API depends on.
+// Check returns nil when the subsystem is usable.
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// CheckResult is the outcome of one readiness check.
+type CheckResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ReadinessResponse is the body returned by the readiness endpoint.
+type ReadinessResponse struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// LivenessHandler reports that the process is up and serving requests.
+// It deliberately checks nothing else, so a wedged dependency doesn't get
+// the server restarted.
+func LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	writeHealthJSON(w, http.StatusOK, ReadinessResponse{Status: "ok"})
+}
+
+// ReadinessHandler runs the given checks concurrently and reports each
+// result. It responds 503 if any check fails.
+func ReadinessHandler(checks []ReadinessCheck) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+		defer cancel()
+
+		resp := ReadinessResponse{
+			Status: "ok",
+			Checks: make(map[string]CheckResult, len(checks)),
+		}
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for _, c := range checks {
+			wg.Add(1)
+			go func(c ReadinessCheck) {
+				defer wg.Done()
+				result := CheckResult{Status: "ok"}
+				if err := c.Check(ctx); err != nil {
+					result = CheckResult{Status: "failed", Error: err.Error()}
+				}
+				mu.Lock()
+				resp.Checks[c.Name] = result
+				mu.Unlock()
+			}(c)
+		}
+		wg.Wait()
+
+		status := http.StatusOK
+		for name, result := range resp.Checks {
+			if result.Status != "ok" {
+				resp.Status = "unavailable"
+				status = http.StatusServiceUnavailable
+				logger.FromContext(r.Context()).WarnContext(r.Context(), "readiness check failed", "check", name, "error", result.Error)
+			}
+		}
+		writeHealthJSON(w, status, resp)
+	}
+}
+
+func writeHealthJSON(w http.ResponseWriter, status int, body ReadinessResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadinessHandler(t *testing.T) {
+	ok := ReadinessCheck{Name: "kvm", Check: func(ctx context.Context) error { return nil }}
+	failing := ReadinessCheck{Name: "ingress", Check: func(ctx context.Context) error { return errors.New("caddy admin API unreachable") }}
+
+	rr := httptest.NewRecorder()
+	ReadinessHandler([]ReadinessCheck{ok})(rr, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	rr = httptest.NewRecorder()
+	ReadinessHandler([]ReadinessCheck{ok, failing})(rr, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+
+	var resp ReadinessResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "unavailable", resp.Status)
+	assert.Equal(t, CheckResult{Status: "ok"}, resp.Checks["kvm"])
+	assert.Equal(t, CheckResult{Status: "failed", Error: "caddy admin API unreachable"}, resp.Checks["ingress"])
+}
+
+func TestLivenessHandler(t *testing.T) {
+	rr := httptest.NewRecorder()
+	LivenessHandler(rr, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rr.Body.String())
+}
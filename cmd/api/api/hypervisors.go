@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+
+	"github.com/onkernel/hypeman/lib/instances"
+	"github.com/onkernel/hypeman/lib/oapi"
+	"github.com/samber/lo"
+)
+
+// ListHypervisors returns the supported hypervisors with their versions and capabilities
+func (s *ApiService) ListHypervisors(ctx context.Context, _ oapi.ListHypervisorsRequestObject) (oapi.ListHypervisorsResponseObject, error) {
+	infos := s.InstanceManager.ListHypervisors(ctx)
+
+	resp := oapi.HypervisorList{
+		Default:     oapi.HypervisorListDefault(s.InstanceManager.DefaultHypervisor()),
+		Hypervisors: make([]oapi.HypervisorInfo, 0, len(infos)),
+	}
+	for _, info := range infos {
+		resp.Hypervisors = append(resp.Hypervisors, hypervisorInfoToOAPI(info))
+	}
+
+	return oapi.ListHypervisors200JSONResponse(resp), nil
+}
+
+func hypervisorInfoToOAPI(info instances.HypervisorInfo) oapi.HypervisorInfo {
+	caps := info.Capabilities
+	return oapi.HypervisorInfo{
+		Type:      oapi.HypervisorInfoType(info.Type),
+		Version:   lo.EmptyableToPtr(info.Version),
+		Available: info.Available,
+		Error:     lo.EmptyableToPtr(info.Error),
+		Capabilities: oapi.HypervisorCapabilities{
+			Snapshot:       caps.SupportsSnapshot,
+			HotplugMemory:  caps.SupportsHotplugMemory,
+			Pause:          caps.SupportsPause,
+			Vsock:          caps.SupportsVsock,
+			GpuPassthrough: caps.SupportsGPUPassthrough,
+			DiskIoLimit:    caps.SupportsDiskIOLimit,
+			HotplugDevice:  caps.SupportsHotplugDevice,
+			HotplugDisk:    caps.SupportsHotplugDisk,
+			Qcow2:          caps.SupportsQcow2,
+		},
+	}
+}
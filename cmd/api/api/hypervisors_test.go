@@ -0,0 +1,31 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/oapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListHypervisors(t *testing.T) {
+	svc := newTestService(t)
+
+	resp, err := svc.ListHypervisors(ctx(), oapi.ListHypervisorsRequestObject{})
+	require.NoError(t, err)
+
+	list, ok := resp.(oapi.ListHypervisors200JSONResponse)
+	require.True(t, ok, "expected 200 response")
+	assert.Equal(t, oapi.HypervisorListDefaultCloudHypervisor, list.Default)
+
+	types := make([]oapi.HypervisorInfoType, 0, len(list.Hypervisors))
+	for _, hv := range list.Hypervisors {
+		types = append(types, hv.Type)
+		// Every supported hypervisor can talk to the guest agent
+		assert.True(t, hv.Capabilities.Vsock, "%s should support vsock", hv.Type)
+		if !hv.Available {
+			assert.NotNil(t, hv.Error, "%s unavailable without an error", hv.Type)
+		}
+	}
+	assert.Equal(t, []oapi.HypervisorInfoType{oapi.HypervisorInfoTypeCloudHypervisor, oapi.HypervisorInfoTypeFirecracker, oapi.HypervisorInfoTypeQemu}, types)
+}
@@ -2,7 +2,10 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
 
 	"github.com/onkernel/hypeman/lib/images"
 	"github.com/onkernel/hypeman/lib/logger"
@@ -97,6 +100,89 @@ func (s *ApiService) DeleteImage(ctx context.Context, request oapi.DeleteImageRe
 	return oapi.DeleteImage204Response{}, nil
 }
 
+// GetImageEvents streams image pull progress via SSE
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) GetImageEvents(ctx context.Context, request oapi.GetImageEventsRequestObject) (oapi.GetImageEventsResponseObject, error) {
+	img := mw.GetResolvedImage[images.Image](ctx)
+	if img == nil {
+		return oapi.GetImageEvents500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+	log := logger.FromContext(ctx)
+
+	eventChan, err := s.ImageManager.StreamImagePull(ctx, img.Name)
+	if err != nil {
+		if errors.Is(err, images.ErrNotFound) {
+			return oapi.GetImageEvents404JSONResponse{
+				Code:    "not_found",
+				Message: "image not found",
+			}, nil
+		}
+		log.ErrorContext(ctx, "failed to stream image events", "error", err)
+		return oapi.GetImageEvents500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to stream image events",
+		}, nil
+	}
+
+	return imageEventsStreamResponse{eventChan: eventChan}, nil
+}
+
+// imageEventsStreamResponse implements oapi.GetImageEventsResponseObject with proper SSE streaming
+type imageEventsStreamResponse struct {
+	eventChan <-chan images.PullEvent
+}
+
+func (r imageEventsStreamResponse) VisitGetImageEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable nginx buffering
+	w.WriteHeader(200)
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("streaming not supported")
+	}
+
+	for event := range r.eventChan {
+		jsonEvent, err := json.Marshal(event)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(w, "data: %s\n\n", jsonEvent)
+		flusher.Flush()
+	}
+	return nil
+}
+
+func (s *ApiService) CollectImageGarbage(ctx context.Context, request oapi.CollectImageGarbageRequestObject) (oapi.CollectImageGarbageResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	req := images.GCRequest{}
+	if request.Params.DryRun != nil {
+		req.DryRun = *request.Params.DryRun
+	}
+
+	result, err := s.ImageManager.GarbageCollect(ctx, req)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to garbage collect images", "error", err)
+		return oapi.CollectImageGarbage500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to garbage collect images",
+		}, nil
+	}
+
+	return oapi.CollectImageGarbage200JSONResponse{
+		DryRun:        result.DryRun,
+		RemovedImages: result.RemovedImages,
+		RemovedBlobs:  result.RemovedBlobs,
+		FreedBytes:    result.FreedBytes,
+	}, nil
+}
+
 func imageToOAPI(img images.Image) oapi.Image {
 	oapiImg := oapi.Image{
 		Name:          img.Name,
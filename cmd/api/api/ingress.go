@@ -3,7 +3,11 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/onkernel/hypeman/lib/dns"
 	"github.com/onkernel/hypeman/lib/ingress"
 	"github.com/onkernel/hypeman/lib/logger"
 	mw "github.com/onkernel/hypeman/lib/middleware"
@@ -54,17 +58,31 @@ func (s *ApiService) CreateIngress(ctx context.Context, request oapi.CreateIngre
 		if rule.RedirectHttp != nil {
 			redirectHTTP = *rule.RedirectHttp
 		}
+		protocol := ingress.ProtocolHTTP
+		if rule.Match.Protocol != nil {
+			protocol = ingress.IngressProtocol(*rule.Match.Protocol)
+		}
+		var maxRequestBodyBytes int64
+		if rule.MaxRequestBodyBytes != nil {
+			maxRequestBodyBytes = *rule.MaxRequestBodyBytes
+		}
+		target, err := ingressTargetFromOAPI(rule.Target)
+		if err != nil {
+			return oapi.CreateIngress400JSONResponse{
+				Code:    "bad_request",
+				Message: fmt.Sprintf("%v in rule %d", err, i),
+			}, nil
+		}
 		domainReq.Rules[i] = ingress.IngressRule{
 			Match: ingress.IngressMatch{
 				Hostname: rule.Match.Hostname,
 				Port:     matchPort,
+				Protocol: protocol,
 			},
-			Target: ingress.IngressTarget{
-				Instance: rule.Target.Instance,
-				Port:     rule.Target.Port,
-			},
-			TLS:          tlsEnabled,
-			RedirectHTTP: redirectHTTP,
+			Target:              target,
+			TLS:                 tlsEnabled,
+			RedirectHTTP:        redirectHTTP,
+			MaxRequestBodyBytes: maxRequestBodyBytes,
 		}
 	}
 
@@ -145,6 +163,13 @@ func (s *ApiService) DeleteIngress(ctx context.Context, request oapi.DeleteIngre
 	log := logger.FromContext(ctx)
 
 	err := s.IngressManager.Delete(ctx, ing.ID)
+	if errors.Is(err, ingress.ErrConfigValidationFailed) {
+		log.ErrorContext(ctx, "failed to delete ingress", "error", err)
+		return oapi.DeleteIngress400JSONResponse{
+			Code:    "config_validation_failed",
+			Message: err.Error(),
+		}, nil
+	}
 	if err != nil {
 		log.ErrorContext(ctx, "failed to delete ingress", "error", err)
 		return oapi.DeleteIngress500JSONResponse{
@@ -156,25 +181,155 @@ func (s *ApiService) DeleteIngress(ctx context.Context, request oapi.DeleteIngre
 	return oapi.DeleteIngress204Response{}, nil
 }
 
+// LookupIngressDNS resolves an instance through the ingress DNS server, so
+// operators can check upstream resolution without sending traffic through Caddy
+func (s *ApiService) LookupIngressDNS(ctx context.Context, request oapi.LookupIngressDNSRequestObject) (oapi.LookupIngressDNSResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	instance := request.Params.Instance
+	if instance == "" || strings.Contains(instance, ".") {
+		return oapi.LookupIngressDNS400JSONResponse{
+			Code:    "bad_request",
+			Message: "instance must be a non-empty instance name or ID",
+		}, nil
+	}
+
+	lookup, err := s.IngressManager.LookupInstanceDNS(ctx, instance)
+	if err != nil {
+		if errors.Is(err, dns.ErrNotRunning) {
+			return oapi.LookupIngressDNS503JSONResponse{
+				Code:    "dns_not_running",
+				Message: err.Error(),
+			}, nil
+		}
+		log.ErrorContext(ctx, "failed to look up instance through ingress DNS", "instance", instance, "error", err)
+		return oapi.LookupIngressDNS500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to look up instance",
+		}, nil
+	}
+
+	resp := oapi.LookupIngressDNS200JSONResponse{
+		Hostname:       lookup.Hostname,
+		DnsServer:      lookup.Server,
+		Rcode:          lookup.Rcode,
+		KnownInstances: lookup.KnownInstances,
+	}
+	if lookup.IP != "" {
+		resp.Ip = &lookup.IP
+	}
+	return resp, nil
+}
+
+// GetIngressDefaultBackend returns the instance serving unmatched hostnames
+func (s *ApiService) GetIngressDefaultBackend(ctx context.Context, request oapi.GetIngressDefaultBackendRequestObject) (oapi.GetIngressDefaultBackendResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	target, err := s.IngressManager.GetDefaultBackend(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get ingress default backend", "error", err)
+		return oapi.GetIngressDefaultBackend500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to get default backend",
+		}, nil
+	}
+	if target == nil {
+		return oapi.GetIngressDefaultBackend404JSONResponse{
+			Code:    "not_found",
+			Message: "no default backend is set",
+		}, nil
+	}
+
+	return oapi.GetIngressDefaultBackend200JSONResponse(ingressTargetToOAPI(*target)), nil
+}
+
+// SetIngressDefaultBackend routes unmatched hostnames to an instance
+func (s *ApiService) SetIngressDefaultBackend(ctx context.Context, request oapi.SetIngressDefaultBackendRequestObject) (oapi.SetIngressDefaultBackendResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if request.Body.Instances != nil || request.Body.StickySession != nil {
+		return oapi.SetIngressDefaultBackend400JSONResponse{
+			Code:    "bad_request",
+			Message: "the default backend is a single instance; instances and sticky_session are not supported",
+		}, nil
+	}
+	var instance string
+	if request.Body.Instance != nil {
+		instance = *request.Body.Instance
+	}
+	target, err := s.IngressManager.SetDefaultBackend(ctx, instance, request.Body.Port)
+	if err != nil {
+		switch {
+		case errors.Is(err, ingress.ErrInvalidRequest):
+			return oapi.SetIngressDefaultBackend400JSONResponse{
+				Code:    "bad_request",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, ingress.ErrInstanceNotFound):
+			return oapi.SetIngressDefaultBackend400JSONResponse{
+				Code:    "instance_not_found",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, ingress.ErrConfigValidationFailed):
+			log.ErrorContext(ctx, "failed to set ingress default backend", "error", err)
+			return oapi.SetIngressDefaultBackend400JSONResponse{
+				Code:    "config_validation_failed",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, ingress.ErrPortInUse):
+			return oapi.SetIngressDefaultBackend409JSONResponse{
+				Code:    "port_in_use",
+				Message: err.Error(),
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to set ingress default backend", "error", err)
+			return oapi.SetIngressDefaultBackend500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to set default backend",
+			}, nil
+		}
+	}
+
+	return oapi.SetIngressDefaultBackend200JSONResponse(ingressTargetToOAPI(*target)), nil
+}
+
+// ClearIngressDefaultBackend restores the 404 for unmatched hostnames
+func (s *ApiService) ClearIngressDefaultBackend(ctx context.Context, request oapi.ClearIngressDefaultBackendRequestObject) (oapi.ClearIngressDefaultBackendResponseObject, error) {
+	if err := s.IngressManager.ClearDefaultBackend(ctx); err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "failed to clear ingress default backend", "error", err)
+		return oapi.ClearIngressDefaultBackend500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to clear default backend",
+		}, nil
+	}
+	return oapi.ClearIngressDefaultBackend204Response{}, nil
+}
+
 // ingressToOAPI converts a domain Ingress to the OAPI type
 func ingressToOAPI(ing ingress.Ingress) oapi.Ingress {
 	rules := make([]oapi.IngressRule, len(ing.Rules))
 	for i, rule := range ing.Rules {
 		port := rule.Match.GetPort()
+		protocol := oapi.IngressMatchProtocol(ingress.ProtocolHTTP)
+		if rule.Match.IsTCP() {
+			protocol = oapi.IngressMatchProtocol(ingress.ProtocolTCP)
+		}
 		tls := rule.TLS
 		redirectHTTP := rule.RedirectHTTP
 		rules[i] = oapi.IngressRule{
 			Match: oapi.IngressMatch{
 				Hostname: rule.Match.Hostname,
 				Port:     &port,
+				Protocol: &protocol,
 			},
-			Target: oapi.IngressTarget{
-				Instance: rule.Target.Instance,
-				Port:     rule.Target.Port,
-			},
+			Target:       ingressTargetToOAPI(rule.Target),
 			Tls:          &tls,
 			RedirectHttp: &redirectHTTP,
 		}
+		if rule.MaxRequestBodyBytes > 0 {
+			maxRequestBodyBytes := rule.MaxRequestBodyBytes
+			rules[i].MaxRequestBodyBytes = &maxRequestBodyBytes
+		}
 	}
 
 	return oapi.Ingress{
@@ -184,3 +339,47 @@ func ingressToOAPI(ing ingress.Ingress) oapi.Ingress {
 		CreatedAt: ing.CreatedAt,
 	}
 }
+
+// ingressTargetFromOAPI converts an API ingress target, parsing its sticky
+// session TTL
+func ingressTargetFromOAPI(t oapi.IngressTarget) (ingress.IngressTarget, error) {
+	target := ingress.IngressTarget{Port: t.Port}
+	if t.Instance != nil {
+		target.Instance = *t.Instance
+	}
+	if t.Instances != nil {
+		target.Instances = *t.Instances
+	}
+	if t.StickySession != nil {
+		target.StickySession = &ingress.StickySession{Cookie: t.StickySession.Cookie}
+		if t.StickySession.Ttl != nil && *t.StickySession.Ttl != "" {
+			ttl, err := time.ParseDuration(*t.StickySession.Ttl)
+			if err != nil || ttl <= 0 {
+				return ingress.IngressTarget{}, fmt.Errorf("sticky_session.ttl must be a positive duration like \"1h\", got %q", *t.StickySession.Ttl)
+			}
+			target.StickySession.TTL = ttl
+		}
+	}
+	return target, nil
+}
+
+// ingressTargetToOAPI converts an ingress target for the API
+func ingressTargetToOAPI(t ingress.IngressTarget) oapi.IngressTarget {
+	target := oapi.IngressTarget{Port: t.Port}
+	if t.Instance != "" {
+		instance := t.Instance
+		target.Instance = &instance
+	}
+	if len(t.Instances) > 0 {
+		instances := t.Instances
+		target.Instances = &instances
+	}
+	if t.StickySession != nil {
+		target.StickySession = &oapi.StickySession{Cookie: t.StickySession.Cookie}
+		if t.StickySession.TTL > 0 {
+			ttl := t.StickySession.TTL.String()
+			target.StickySession.Ttl = &ttl
+		}
+	}
+	return target
+}
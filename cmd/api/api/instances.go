@@ -2,30 +2,45 @@ package api
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/c2h5oh/datasize"
+	"github.com/onkernel/hypeman/lib/devices"
 	"github.com/onkernel/hypeman/lib/guest"
 	"github.com/onkernel/hypeman/lib/hypervisor"
+	"github.com/onkernel/hypeman/lib/ingress"
 	"github.com/onkernel/hypeman/lib/instances"
 	"github.com/onkernel/hypeman/lib/logger"
 	mw "github.com/onkernel/hypeman/lib/middleware"
 	"github.com/onkernel/hypeman/lib/network"
 	"github.com/onkernel/hypeman/lib/oapi"
 	"github.com/onkernel/hypeman/lib/resources"
+	"github.com/onkernel/hypeman/lib/volumes"
 	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// ListInstances lists all instances
+// ListInstances lists one page of instances
 func (s *ApiService) ListInstances(ctx context.Context, request oapi.ListInstancesRequestObject) (oapi.ListInstancesResponseObject, error) {
 	log := logger.FromContext(ctx)
 
-	domainInsts, err := s.InstanceManager.ListInstances(ctx)
+	params := request.Params
+	page, err := s.InstanceManager.ListInstancesPage(ctx, pageRequest(params.Limit, params.Cursor, params.Sort))
 	if err != nil {
+		if isPageRequestError(err) {
+			return oapi.ListInstances400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		}
 		log.ErrorContext(ctx, "failed to list instances", "error", err)
 		return oapi.ListInstances500JSONResponse{
 			Code:    "internal_error",
@@ -33,112 +48,228 @@ func (s *ApiService) ListInstances(ctx context.Context, request oapi.ListInstanc
 		}, nil
 	}
 
-	oapiInsts := make([]oapi.Instance, len(domainInsts))
-	for i, inst := range domainInsts {
+	oapiInsts := make([]oapi.Instance, len(page.Items))
+	for i, inst := range page.Items {
 		oapiInsts[i] = instanceToOAPI(inst)
 	}
 
-	return oapi.ListInstances200JSONResponse(oapiInsts), nil
+	return oapi.ListInstances200JSONResponse{
+		Body:    oapiInsts,
+		Headers: oapi.ListInstances200ResponseHeaders{XTotalCount: page.Total, XNextCursor: page.NextCursor},
+	}, nil
 }
 
 // CreateInstance creates and starts a new instance
 func (s *ApiService) CreateInstance(ctx context.Context, request oapi.CreateInstanceRequestObject) (oapi.CreateInstanceResponseObject, error) {
 	log := logger.FromContext(ctx)
 
+	if err := s.Draining(); err != nil {
+		return oapi.CreateInstance503JSONResponse{
+			Code:    "draining",
+			Message: err.Error(),
+		}, nil
+	}
+
+	domainReq, apiErr := s.toCreateInstanceRequest(request.Body)
+	if apiErr != nil {
+		return oapi.CreateInstance400JSONResponse(*apiErr), nil
+	}
+
+	inst, err := s.InstanceManager.CreateInstance(ctx, domainReq)
+	if err != nil {
+		if errors.Is(err, instances.ErrNameExists) {
+			return oapi.CreateInstance409JSONResponse{
+				Code:    "name_exists",
+				Message: err.Error(),
+			}, nil
+		}
+		if code := createErrorCode(err); code != "" {
+			return oapi.CreateInstance400JSONResponse{
+				Code:    code,
+				Message: err.Error(),
+			}, nil
+		}
+		log.ErrorContext(ctx, "failed to create instance", "error", err, "image", request.Body.Image)
+		return oapi.CreateInstance500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to create instance",
+		}, nil
+	}
+	return oapi.CreateInstance201JSONResponse(instanceToOAPI(*inst)), nil
+}
+
+// createErrorCode returns the API error code for a create error caused by the
+// request, or "" for an internal error
+func createErrorCode(err error) string {
+	switch {
+	case errors.Is(err, instances.ErrImageNotReady):
+		return "image_not_ready"
+	case errors.Is(err, instances.ErrAlreadyExists):
+		return "already_exists"
+	case errors.Is(err, instances.ErrNameExists):
+		return "name_exists"
+	case errors.Is(err, network.ErrNameExists):
+		return "name_conflict"
+	case errors.Is(err, devices.ErrNoDeviceAvailable):
+		return "no_device_available"
+	case errors.Is(err, instances.ErrLimitExceeded):
+		return "limit_exceeded"
+	case errors.Is(err, instances.ErrInvalidBatch):
+		return "invalid_request"
+	case errors.Is(err, instances.ErrInvalidKernel):
+		return "invalid_kernel"
+	}
+	return ""
+}
+
+// CreateInstances creates and starts a batch of instances from one template
+func (s *ApiService) CreateInstances(ctx context.Context, request oapi.CreateInstancesRequestObject) (oapi.CreateInstancesResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if err := s.Draining(); err != nil {
+		return oapi.CreateInstances503JSONResponse{
+			Code:    "draining",
+			Message: err.Error(),
+		}, nil
+	}
+
+	domainReq, apiErr := s.toCreateInstanceRequest(&request.Body.Template)
+	if apiErr != nil {
+		return oapi.CreateInstances400JSONResponse(*apiErr), nil
+	}
+
+	result, err := s.InstanceManager.CreateInstances(ctx, domainReq, request.Body.Count)
+	if err != nil && result == nil {
+		if code := createErrorCode(err); code != "" {
+			return oapi.CreateInstances400JSONResponse{
+				Code:    code,
+				Message: err.Error(),
+			}, nil
+		}
+		log.ErrorContext(ctx, "failed to create instance batch", "error", err, "image", domainReq.Image)
+		return oapi.CreateInstances500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to create instances",
+		}, nil
+	}
+
+	out := oapi.CreateInstancesResult{
+		Instances: make([]oapi.Instance, len(result.Instances)),
+		Errors:    make([]oapi.BatchMemberError, len(result.Errors)),
+	}
+	for i, inst := range result.Instances {
+		out.Instances[i] = instanceToOAPI(inst)
+	}
+	for i, memberErr := range result.Errors {
+		code := createErrorCode(memberErr.Err)
+		if code == "" {
+			code = "internal_error"
+		}
+		out.Errors[i] = oapi.BatchMemberError{Name: memberErr.Name, Code: code, Message: memberErr.Err.Error()}
+	}
+	if err != nil {
+		return oapi.CreateInstances409JSONResponse(out), nil
+	}
+	return oapi.CreateInstances201JSONResponse(out), nil
+}
+
+// toCreateInstanceRequest converts an API create request to a domain request,
+// applying defaults. It returns an API error for invalid input.
+func (s *ApiService) toCreateInstanceRequest(body *oapi.CreateInstanceRequest) (instances.CreateInstanceRequest, *oapi.Error) {
 	// Parse size (default: 1GB)
 	size := int64(0)
-	if request.Body.Size != nil && *request.Body.Size != "" {
+	if body.Size != nil && *body.Size != "" {
 		var sizeBytes datasize.ByteSize
-		if err := sizeBytes.UnmarshalText([]byte(*request.Body.Size)); err != nil {
-			return oapi.CreateInstance400JSONResponse{
+		if err := sizeBytes.UnmarshalText([]byte(*body.Size)); err != nil {
+			return instances.CreateInstanceRequest{}, &oapi.Error{
 				Code:    "invalid_size",
 				Message: fmt.Sprintf("invalid size format: %v", err),
-			}, nil
+			}
 		}
 		size = int64(sizeBytes)
 	}
 
 	// Parse hotplug_size (default: 3GB)
 	hotplugSize := int64(0)
-	if request.Body.HotplugSize != nil && *request.Body.HotplugSize != "" {
+	if body.HotplugSize != nil && *body.HotplugSize != "" {
 		var hotplugBytes datasize.ByteSize
-		if err := hotplugBytes.UnmarshalText([]byte(*request.Body.HotplugSize)); err != nil {
-			return oapi.CreateInstance400JSONResponse{
+		if err := hotplugBytes.UnmarshalText([]byte(*body.HotplugSize)); err != nil {
+			return instances.CreateInstanceRequest{}, &oapi.Error{
 				Code:    "invalid_hotplug_size",
 				Message: fmt.Sprintf("invalid hotplug_size format: %v", err),
-			}, nil
+			}
 		}
 		hotplugSize = int64(hotplugBytes)
 	}
 
 	// Parse overlay_size (default: 10GB)
 	overlaySize := int64(0)
-	if request.Body.OverlaySize != nil && *request.Body.OverlaySize != "" {
+	if body.OverlaySize != nil && *body.OverlaySize != "" {
 		var overlayBytes datasize.ByteSize
-		if err := overlayBytes.UnmarshalText([]byte(*request.Body.OverlaySize)); err != nil {
-			return oapi.CreateInstance400JSONResponse{
+		if err := overlayBytes.UnmarshalText([]byte(*body.OverlaySize)); err != nil {
+			return instances.CreateInstanceRequest{}, &oapi.Error{
 				Code:    "invalid_overlay_size",
 				Message: fmt.Sprintf("invalid overlay_size format: %v", err),
-			}, nil
+			}
 		}
 		overlaySize = int64(overlayBytes)
 	}
 
 	// Parse disk_io_bps (0 = auto/unlimited)
 	diskIOBps := int64(0)
-	if request.Body.DiskIoBps != nil && *request.Body.DiskIoBps != "" {
+	if body.DiskIoBps != nil && *body.DiskIoBps != "" {
 		var ioBpsBytes datasize.ByteSize
 		// Remove "/s" suffix if present
-		ioStr := *request.Body.DiskIoBps
+		ioStr := *body.DiskIoBps
 		ioStr = strings.TrimSuffix(ioStr, "/s")
 		ioStr = strings.TrimSuffix(ioStr, "ps")
 		if err := ioBpsBytes.UnmarshalText([]byte(ioStr)); err != nil {
-			return oapi.CreateInstance400JSONResponse{
+			return instances.CreateInstanceRequest{}, &oapi.Error{
 				Code:    "invalid_disk_io_bps",
 				Message: fmt.Sprintf("invalid disk_io_bps format: %v", err),
-			}, nil
+			}
 		}
 		diskIOBps = int64(ioBpsBytes)
 	}
 
 	vcpus := 2
-	if request.Body.Vcpus != nil {
-		vcpus = *request.Body.Vcpus
+	if body.Vcpus != nil {
+		vcpus = *body.Vcpus
 	}
 
 	env := make(map[string]string)
-	if request.Body.Env != nil {
-		env = *request.Body.Env
+	if body.Env != nil {
+		env = *body.Env
 	}
 
 	// Parse network enabled (default: true)
 	networkEnabled := true
-	if request.Body.Network != nil && request.Body.Network.Enabled != nil {
-		networkEnabled = *request.Body.Network.Enabled
+	if body.Network != nil && body.Network.Enabled != nil {
+		networkEnabled = *body.Network.Enabled
 	}
 
 	// Parse network bandwidth limits (0 = auto)
 	// Supports both bit-based (e.g., "1Gbps") and byte-based (e.g., "125MB/s") formats
 	var networkBandwidthDownload int64
 	var networkBandwidthUpload int64
-	if request.Body.Network != nil {
-		if request.Body.Network.BandwidthDownload != nil && *request.Body.Network.BandwidthDownload != "" {
-			bw, err := resources.ParseBandwidth(*request.Body.Network.BandwidthDownload)
+	if body.Network != nil {
+		if body.Network.BandwidthDownload != nil && *body.Network.BandwidthDownload != "" {
+			bw, err := resources.ParseBandwidth(*body.Network.BandwidthDownload)
 			if err != nil {
-				return oapi.CreateInstance400JSONResponse{
+				return instances.CreateInstanceRequest{}, &oapi.Error{
 					Code:    "invalid_bandwidth_download",
 					Message: fmt.Sprintf("invalid bandwidth_download format: %v", err),
-				}, nil
+				}
 			}
 			networkBandwidthDownload = bw
 		}
-		if request.Body.Network.BandwidthUpload != nil && *request.Body.Network.BandwidthUpload != "" {
-			bw, err := resources.ParseBandwidth(*request.Body.Network.BandwidthUpload)
+		if body.Network.BandwidthUpload != nil && *body.Network.BandwidthUpload != "" {
+			bw, err := resources.ParseBandwidth(*body.Network.BandwidthUpload)
 			if err != nil {
-				return oapi.CreateInstance400JSONResponse{
+				return instances.CreateInstanceRequest{}, &oapi.Error{
 					Code:    "invalid_bandwidth_upload",
 					Message: fmt.Sprintf("invalid bandwidth_upload format: %v", err),
-				}, nil
+				}
 			}
 			networkBandwidthUpload = bw
 		}
@@ -146,15 +277,15 @@ func (s *ApiService) CreateInstance(ctx context.Context, request oapi.CreateInst
 
 	// Parse devices (GPU passthrough)
 	var deviceRefs []string
-	if request.Body.Devices != nil {
-		deviceRefs = *request.Body.Devices
+	if body.Devices != nil {
+		deviceRefs = *body.Devices
 	}
 
 	// Parse volumes
 	var volumes []instances.VolumeAttachment
-	if request.Body.Volumes != nil {
-		volumes = make([]instances.VolumeAttachment, len(*request.Body.Volumes))
-		for i, vol := range *request.Body.Volumes {
+	if body.Volumes != nil {
+		volumes = make([]instances.VolumeAttachment, len(*body.Volumes))
+		for i, vol := range *body.Volumes {
 			readonly := false
 			if vol.Readonly != nil {
 				readonly = *vol.Readonly
@@ -167,10 +298,10 @@ func (s *ApiService) CreateInstance(ctx context.Context, request oapi.CreateInst
 			if vol.OverlaySize != nil && *vol.OverlaySize != "" {
 				var overlaySizeBytes datasize.ByteSize
 				if err := overlaySizeBytes.UnmarshalText([]byte(*vol.OverlaySize)); err != nil {
-					return oapi.CreateInstance400JSONResponse{
+					return instances.CreateInstanceRequest{}, &oapi.Error{
 						Code:    "invalid_overlay_size",
 						Message: fmt.Sprintf("invalid overlay_size for volume %s: %v", vol.VolumeId, err),
-					}, nil
+					}
 				}
 				overlaySize = int64(overlaySizeBytes)
 			}
@@ -184,10 +315,48 @@ func (s *ApiService) CreateInstance(ctx context.Context, request oapi.CreateInst
 		}
 	}
 
+	// Parse per-instance log retention
+	var logRetention *instances.LogRetention
+	if lr := body.LogRetention; lr != nil {
+		logRetention = &instances.LogRetention{}
+		if lr.MaxAge != nil && *lr.MaxAge != "" {
+			maxAge, err := time.ParseDuration(*lr.MaxAge)
+			if err != nil || maxAge <= 0 {
+				return instances.CreateInstanceRequest{}, &oapi.Error{
+					Code:    "invalid_log_retention",
+					Message: fmt.Sprintf("log_retention.max_age must be a positive duration like \"168h\", got %q", *lr.MaxAge),
+				}
+			}
+			logRetention.MaxAge = maxAge
+		}
+		if lr.MaxFiles != nil {
+			if *lr.MaxFiles < 1 {
+				return instances.CreateInstanceRequest{}, &oapi.Error{
+					Code:    "invalid_log_retention",
+					Message: "log_retention.max_files must be at least 1",
+				}
+			}
+			logRetention.MaxFiles = *lr.MaxFiles
+		}
+	}
+
+	// Parse idle auto-stop timeout
+	var idleTimeout time.Duration
+	if body.IdleTimeout != nil && *body.IdleTimeout != "" {
+		var err error
+		idleTimeout, err = time.ParseDuration(*body.IdleTimeout)
+		if err != nil || idleTimeout <= 0 {
+			return instances.CreateInstanceRequest{}, &oapi.Error{
+				Code:    "invalid_idle_timeout",
+				Message: fmt.Sprintf("idle_timeout must be a positive duration like \"30m\", got %q", *body.IdleTimeout),
+			}
+		}
+	}
+
 	// Convert hypervisor type from API enum to domain type
 	var hvType hypervisor.Type
-	if request.Body.Hypervisor != nil {
-		hvType = hypervisor.Type(*request.Body.Hypervisor)
+	if body.Hypervisor != nil {
+		hvType = hypervisor.Type(*body.Hypervisor)
 	}
 
 	// Calculate default resource limits when not specified (0 = auto)
@@ -205,9 +374,9 @@ func (s *ApiService) CreateInstance(ctx context.Context, request oapi.CreateInst
 		}
 	}
 
-	domainReq := instances.CreateInstanceRequest{
-		Name:                     request.Body.Name,
-		Image:                    request.Body.Image,
+	req := instances.CreateInstanceRequest{
+		Name:                     body.Name,
+		Image:                    body.Image,
 		Size:                     size,
 		HotplugSize:              hotplugSize,
 		OverlaySize:              overlaySize,
@@ -220,35 +389,33 @@ func (s *ApiService) CreateInstance(ctx context.Context, request oapi.CreateInst
 		Devices:                  deviceRefs,
 		Volumes:                  volumes,
 		Hypervisor:               hvType,
+		LogRetention:             logRetention,
+		IdleTimeout:              idleTimeout,
+		KernelVersion:            lo.FromPtr(body.KernelVersion),
+		KernelModules:            lo.FromPtr(body.KernelModules),
 	}
-
-	inst, err := s.InstanceManager.CreateInstance(ctx, domainReq)
-	if err != nil {
-		switch {
-		case errors.Is(err, instances.ErrImageNotReady):
-			return oapi.CreateInstance400JSONResponse{
-				Code:    "image_not_ready",
-				Message: err.Error(),
-			}, nil
-		case errors.Is(err, instances.ErrAlreadyExists):
-			return oapi.CreateInstance400JSONResponse{
-				Code:    "already_exists",
-				Message: "instance already exists",
-			}, nil
-		case errors.Is(err, network.ErrNameExists):
-			return oapi.CreateInstance400JSONResponse{
-				Code:    "name_conflict",
-				Message: err.Error(),
-			}, nil
-		default:
-			log.ErrorContext(ctx, "failed to create instance", "error", err, "image", request.Body.Image)
-			return oapi.CreateInstance500JSONResponse{
-				Code:    "internal_error",
-				Message: "failed to create instance",
-			}, nil
+	if body.IdleAction != nil {
+		req.IdleAction = instances.IdleAction(*body.IdleAction)
+	}
+	if body.Network != nil {
+		req.MTU = lo.FromPtr(body.Network.Mtu)
+		req.DNSServers = lo.FromPtr(body.Network.DnsServers)
+		req.SearchDomains = lo.FromPtr(body.Network.SearchDomains)
+	}
+	if body.OverlayFormat != nil {
+		req.OverlayFormat = instances.OverlayFormat(*body.OverlayFormat)
+	}
+	req.Discard = body.DiskDiscard
+	if body.InitMode != nil {
+		req.InitMode = instances.InitMode(*body.InitMode)
+	}
+	if sd := body.Systemd; sd != nil {
+		req.Systemd = &instances.SystemdOptions{
+			DefaultTarget: lo.FromPtr(sd.DefaultTarget),
+			MaskUnits:     lo.FromPtr(sd.MaskUnits),
 		}
 	}
-	return oapi.CreateInstance201JSONResponse(instanceToOAPI(*inst)), nil
+	return req, nil
 }
 
 // GetInstance gets instance details
@@ -278,15 +445,197 @@ func (s *ApiService) DeleteInstance(ctx context.Context, request oapi.DeleteInst
 	}
 	log := logger.FromContext(ctx)
 
-	err := s.InstanceManager.DeleteInstance(ctx, inst.Id)
+	force := request.Params.Force != nil && *request.Params.Force
+	snapshot := s.Config.SnapshotBeforeDelete
+	if request.Params.Snapshot != nil {
+		snapshot = *request.Params.Snapshot
+	}
+	if force && snapshot {
+		// An explicit force wins over the server default
+		if request.Params.Snapshot != nil {
+			return oapi.DeleteInstance400JSONResponse{
+				Code:    "bad_request",
+				Message: "force and snapshot can't be combined",
+			}, nil
+		}
+		snapshot = false
+	}
+	cascade := request.Params.Cascade != nil && *request.Params.Cascade
+	cascadeVolumes := request.Params.CascadeVolumes != nil && *request.Params.CascadeVolumes
+	if cascadeVolumes && !cascade {
+		return oapi.DeleteInstance400JSONResponse{
+			Code:    "bad_request",
+			Message: "cascade_volumes requires cascade",
+		}, nil
+	}
+
+	// What a cascade removes is decided before the delete detaches the
+	// instance's volumes
+	result := oapi.CascadeDeleteResult{
+		InstanceId:       inst.Id,
+		DeletedIngresses: []oapi.DeletedResource{},
+		DeletedVolumes:   []oapi.DeletedResource{},
+	}
+	var dependents *instanceDependents
+	if cascade {
+		var err error
+		if dependents, err = s.findInstanceDependents(ctx, inst, cascadeVolumes); err != nil {
+			log.ErrorContext(ctx, "failed to find resources to cascade delete", "error", err)
+			return oapi.DeleteInstance500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to find resources to cascade delete",
+			}, nil
+		}
+		// An ingress that also routes elsewhere isn't the cascade's to delete,
+		// and would still break; nothing is deleted then
+		if len(dependents.shared) > 0 && !force {
+			return oapi.DeleteInstance409JSONResponse{
+				Code:    "instance_in_use",
+				Message: fmt.Sprintf("%v: routed to by ingress %s, which also route to other instances; remove the instance from them first, or delete with force", instances.ErrInUse, strings.Join(dependents.shared, ", ")),
+			}, nil
+		}
+
+		// The ingresses go first, so the delete doesn't find them. If the
+		// instance then can't be deleted, the error says which are gone.
+		for _, ing := range dependents.ingresses {
+			if err := s.IngressManager.Delete(ctx, ing.ID); err != nil && !errors.Is(err, ingress.ErrNotFound) {
+				log.ErrorContext(ctx, "failed to cascade delete ingress", "ingress_id", ing.ID, "error", err)
+				return oapi.DeleteInstance500JSONResponse{
+					Code:    "internal_error",
+					Message: fmt.Sprintf("failed to delete ingress %s; the instance was not deleted", ing.Name),
+				}, nil
+			}
+			result.DeletedIngresses = append(result.DeletedIngresses, oapi.DeletedResource{Id: ing.ID, Name: ing.Name})
+		}
+	}
+
+	var err error
+	switch {
+	case force:
+		err = s.InstanceManager.ForceDeleteInstance(ctx, inst.Id)
+	case snapshot:
+		_, err = s.InstanceManager.SnapshotAndDeleteInstance(ctx, inst.Id)
+	default:
+		err = s.InstanceManager.DeleteInstance(ctx, inst.Id)
+	}
+	if errors.Is(err, instances.ErrInvalidState) {
+		return oapi.DeleteInstance409JSONResponse{
+			Code:    "invalid_state",
+			Message: err.Error() + deletedIngressesNote(result.DeletedIngresses),
+		}, nil
+	}
+	if errors.Is(err, instances.ErrInUse) {
+		return oapi.DeleteInstance409JSONResponse{
+			Code:    "instance_in_use",
+			Message: err.Error() + deletedIngressesNote(result.DeletedIngresses),
+		}, nil
+	}
 	if err != nil {
-		log.ErrorContext(ctx, "failed to delete instance", "error", err)
+		log.ErrorContext(ctx, "failed to delete instance", "error", err, "deleted_ingresses", len(result.DeletedIngresses))
 		return oapi.DeleteInstance500JSONResponse{
 			Code:    "internal_error",
-			Message: "failed to delete instance",
+			Message: "failed to delete instance" + deletedIngressesNote(result.DeletedIngresses),
+		}, nil
+	}
+	if !cascade {
+		return oapi.DeleteInstance204Response{}, nil
+	}
+
+	for _, vol := range dependents.volumes {
+		if err := s.VolumeManager.DeleteVolume(ctx, vol.Id); err != nil && !errors.Is(err, volumes.ErrNotFound) {
+			log.ErrorContext(ctx, "failed to cascade delete volume", "volume_id", vol.Id, "error", err)
+			return oapi.DeleteInstance500JSONResponse{
+				Code:    "internal_error",
+				Message: fmt.Sprintf("instance deleted, but failed to delete volume %s", vol.Name),
+			}, nil
+		}
+		result.DeletedVolumes = append(result.DeletedVolumes, oapi.DeletedResource{Id: vol.Id, Name: vol.Name})
+	}
+	log.InfoContext(ctx, "instance deleted with cascade", "instance_id", inst.Id,
+		"ingresses", len(result.DeletedIngresses), "volumes", len(result.DeletedVolumes))
+	return oapi.DeleteInstance200JSONResponse(result), nil
+}
+
+// deletedIngressesNote tells the caller of a cascade delete whose instance
+// delete failed which ingresses were already removed
+func deletedIngressesNote(deleted []oapi.DeletedResource) string {
+	if len(deleted) == 0 {
+		return ""
+	}
+	names := make([]string, len(deleted))
+	for i, d := range deleted {
+		names[i] = d.Name
+	}
+	return fmt.Sprintf("; cascade already deleted ingress %s", strings.Join(names, ", "))
+}
+
+// instanceDependents are the resources a cascade delete of an instance removes
+type instanceDependents struct {
+	ingresses []ingress.Ingress
+	volumes   []volumes.Volume
+	shared    []string // Names of ingresses routing to the instance and others, which are kept
+}
+
+// findInstanceDependents returns the ingresses that only route to an
+// instance and, if withVolumes is set, the volumes attached to it alone
+func (s *ApiService) findInstanceDependents(ctx context.Context, inst *instances.Instance, withVolumes bool) (*instanceDependents, error) {
+	deps := &instanceDependents{}
+	if s.IngressManager != nil {
+		all, err := s.IngressManager.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list ingresses: %w", err)
+		}
+		for _, ing := range all {
+			switch {
+			case ing.TargetsOnly(inst.Name, inst.Id):
+				deps.ingresses = append(deps.ingresses, ing)
+			case ing.Targets(inst.Name, inst.Id):
+				deps.shared = append(deps.shared, ing.Name)
+			}
+		}
+	}
+	if withVolumes {
+		for _, attachment := range inst.Volumes {
+			vol, err := s.VolumeManager.GetVolume(ctx, attachment.VolumeID)
+			if errors.Is(err, volumes.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("get volume %s: %w", attachment.VolumeID, err)
+			}
+			exclusive := lo.EveryBy(vol.Attachments, func(a volumes.Attachment) bool { return a.InstanceID == inst.Id })
+			if exclusive {
+				deps.volumes = append(deps.volumes, *vol)
+			}
+		}
+	}
+	return deps, nil
+}
+
+// ListPreservedSnapshots lists deleted instances whose state was preserved
+func (s *ApiService) ListPreservedSnapshots(ctx context.Context, request oapi.ListPreservedSnapshotsRequestObject) (oapi.ListPreservedSnapshotsResponseObject, error) {
+	preserved, err := s.InstanceManager.ListPreservedSnapshots(ctx)
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "failed to list preserved snapshots", "error", err)
+		return oapi.ListPreservedSnapshots500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to list preserved snapshots",
 		}, nil
 	}
-	return oapi.DeleteInstance204Response{}, nil
+
+	resp := make(oapi.ListPreservedSnapshots200JSONResponse, len(preserved))
+	for i, p := range preserved {
+		resp[i] = oapi.PreservedSnapshot{
+			InstanceId:  p.InstanceID,
+			Name:        p.Name,
+			Image:       p.Image,
+			Hypervisor:  oapi.PreservedSnapshotHypervisor(p.Hypervisor),
+			HasMemory:   p.HasMemory,
+			SizeBytes:   p.SizeBytes,
+			PreservedAt: p.PreservedAt,
+		}
+	}
+	return resp, nil
 }
 
 // StandbyInstance puts an instance in standby (pause, snapshot, delete VMM)
@@ -321,6 +670,60 @@ func (s *ApiService) StandbyInstance(ctx context.Context, request oapi.StandbyIn
 	return oapi.StandbyInstance200JSONResponse(instanceToOAPI(*result)), nil
 }
 
+// CloneInstance creates a new instance from a copy of an existing one
+// The id parameter can be an instance ID, name, or ID prefix
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) CloneInstance(ctx context.Context, request oapi.CloneInstanceRequestObject) (oapi.CloneInstanceResponseObject, error) {
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.CloneInstance500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+	log := logger.FromContext(ctx)
+
+	if err := s.Draining(); err != nil {
+		return oapi.CloneInstance503JSONResponse{
+			Code:    "draining",
+			Message: err.Error(),
+		}, nil
+	}
+
+	result, err := s.InstanceManager.CloneInstance(ctx, inst.Id, instances.CloneInstanceRequest{
+		Name:         request.Body.Name,
+		CloneVolumes: lo.FromPtr(request.Body.CloneVolumes),
+	})
+	if err != nil {
+		if errors.Is(err, instances.ErrNameExists) {
+			return oapi.CloneInstance409JSONResponse{
+				Code:    "name_exists",
+				Message: err.Error(),
+			}, nil
+		}
+		if code := createErrorCode(err); code != "" {
+			return oapi.CloneInstance400JSONResponse{
+				Code:    code,
+				Message: err.Error(),
+			}, nil
+		}
+		switch {
+		case errors.Is(err, instances.ErrInvalidState):
+			return oapi.CloneInstance409JSONResponse{
+				Code:    "invalid_state",
+				Message: err.Error(),
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to clone instance", "error", err)
+			return oapi.CloneInstance500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to clone instance",
+			}, nil
+		}
+	}
+	return oapi.CloneInstance201JSONResponse(instanceToOAPI(*result)), nil
+}
+
 // RestoreInstance restores an instance from standby
 // The id parameter can be an instance ID, name, or ID prefix
 // Note: Resolution is handled by ResolveResource middleware
@@ -385,93 +788,281 @@ func (s *ApiService) StopInstance(ctx context.Context, request oapi.StopInstance
 	return oapi.StopInstance200JSONResponse(instanceToOAPI(*result)), nil
 }
 
-// StartInstance starts a stopped instance
+// ResetInstanceOverlay discards the changes to an instance's overlay disk
 // The id parameter can be an instance ID, name, or ID prefix
 // Note: Resolution is handled by ResolveResource middleware
-func (s *ApiService) StartInstance(ctx context.Context, request oapi.StartInstanceRequestObject) (oapi.StartInstanceResponseObject, error) {
+func (s *ApiService) ResetInstanceOverlay(ctx context.Context, request oapi.ResetInstanceOverlayRequestObject) (oapi.ResetInstanceOverlayResponseObject, error) {
 	inst := mw.GetResolvedInstance[instances.Instance](ctx)
 	if inst == nil {
-		return oapi.StartInstance500JSONResponse{
+		return oapi.ResetInstanceOverlay500JSONResponse{
 			Code:    "internal_error",
 			Message: "resource not resolved",
 		}, nil
 	}
 	log := logger.FromContext(ctx)
 
-	result, err := s.InstanceManager.StartInstance(ctx, inst.Id)
+	result, err := s.InstanceManager.ResetOverlay(ctx, inst.Id)
 	if err != nil {
 		switch {
 		case errors.Is(err, instances.ErrInvalidState):
-			return oapi.StartInstance409JSONResponse{
+			return oapi.ResetInstanceOverlay409JSONResponse{
 				Code:    "invalid_state",
 				Message: err.Error(),
 			}, nil
 		default:
-			log.ErrorContext(ctx, "failed to start instance", "error", err)
-			return oapi.StartInstance500JSONResponse{
+			log.ErrorContext(ctx, "failed to reset instance overlay", "error", err)
+			return oapi.ResetInstanceOverlay500JSONResponse{
 				Code:    "internal_error",
-				Message: "failed to start instance",
+				Message: "failed to reset instance overlay",
 			}, nil
 		}
 	}
-	return oapi.StartInstance200JSONResponse(instanceToOAPI(*result)), nil
-}
-
-// logsStreamResponse implements oapi.GetInstanceLogsResponseObject with proper SSE flushing
-type logsStreamResponse struct {
-	logChan <-chan string
+	return oapi.ResetInstanceOverlay200JSONResponse(instanceToOAPI(*result)), nil
 }
 
-func (r logsStreamResponse) VisitGetInstanceLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/event-stream")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Header().Set("Connection", "keep-alive")
-	w.Header().Set("X-Accel-Buffering", "no") // Disable nginx buffering
-	w.WriteHeader(200)
-
-	flusher, ok := w.(http.Flusher)
-	if !ok {
-		return fmt.Errorf("streaming not supported")
+// CompactInstanceOverlay reclaims unused space in a stopped instance's overlay disk
+// The id parameter can be an instance ID, name, or ID prefix
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) CompactInstanceOverlay(ctx context.Context, request oapi.CompactInstanceOverlayRequestObject) (oapi.CompactInstanceOverlayResponseObject, error) {
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.CompactInstanceOverlay500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
 	}
+	log := logger.FromContext(ctx)
 
-	for line := range r.logChan {
-		jsonLine, _ := json.Marshal(line)
-		fmt.Fprintf(w, "data: %s\n\n", jsonLine)
-		flusher.Flush()
+	result, err := s.InstanceManager.CompactOverlay(ctx, inst.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, instances.ErrInvalidState):
+			return oapi.CompactInstanceOverlay409JSONResponse{
+				Code:    "invalid_state",
+				Message: err.Error(),
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to compact instance overlay", "error", err)
+			return oapi.CompactInstanceOverlay500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to compact instance overlay",
+			}, nil
+		}
 	}
-	return nil
+	return oapi.CompactInstanceOverlay200JSONResponse{
+		OverlayFormat: oapi.OverlayCompactionOverlayFormat(result.Format),
+		BytesBefore:   result.BytesBefore,
+		BytesAfter:    result.BytesAfter,
+	}, nil
 }
 
-// GetInstanceLogs streams instance logs via SSE
-// With follow=false (default), streams last N lines then closes
-// With follow=true, streams last N lines then continues following new output
+// StartInstance starts a stopped instance
 // The id parameter can be an instance ID, name, or ID prefix
 // Note: Resolution is handled by ResolveResource middleware
-func (s *ApiService) GetInstanceLogs(ctx context.Context, request oapi.GetInstanceLogsRequestObject) (oapi.GetInstanceLogsResponseObject, error) {
+func (s *ApiService) StartInstance(ctx context.Context, request oapi.StartInstanceRequestObject) (oapi.StartInstanceResponseObject, error) {
 	inst := mw.GetResolvedInstance[instances.Instance](ctx)
 	if inst == nil {
-		return oapi.GetInstanceLogs500JSONResponse{
+		return oapi.StartInstance500JSONResponse{
 			Code:    "internal_error",
 			Message: "resource not resolved",
 		}, nil
 	}
+	log := logger.FromContext(ctx)
 
-	tail := 100
-	if request.Params.Tail != nil {
-		tail = *request.Params.Tail
-	}
-
-	follow := false
-	if request.Params.Follow != nil {
-		follow = *request.Params.Follow
-	}
-
-	// Map source parameter to LogSource type (default to app)
+	result, err := s.InstanceManager.StartInstance(ctx, inst.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, instances.ErrInvalidState):
+			return oapi.StartInstance409JSONResponse{
+				Code:    "invalid_state",
+				Message: err.Error(),
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to start instance", "error", err)
+			return oapi.StartInstance500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to start instance",
+			}, nil
+		}
+	}
+	return oapi.StartInstance200JSONResponse(instanceToOAPI(*result)), nil
+}
+
+// SyncInstanceTime steps a running instance's guest clock to the host's
+// The id parameter can be an instance ID, name, or ID prefix
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) SyncInstanceTime(ctx context.Context, request oapi.SyncInstanceTimeRequestObject) (oapi.SyncInstanceTimeResponseObject, error) {
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.SyncInstanceTime500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+	log := logger.FromContext(ctx)
+
+	offset, err := s.InstanceManager.SyncTime(ctx, inst.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, instances.ErrInvalidState):
+			return oapi.SyncInstanceTime409JSONResponse{
+				Code:    "invalid_state",
+				Message: err.Error(),
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to sync instance time", "error", err)
+			return oapi.SyncInstanceTime500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to sync guest time",
+			}, nil
+		}
+	}
+	return oapi.SyncInstanceTime200JSONResponse{
+		OffsetMs: offset.Milliseconds(),
+		SyncedAt: time.Now(),
+	}, nil
+}
+
+// logsStreamResponse implements oapi.GetInstanceLogsResponseObject with proper SSE flushing
+type logsStreamResponse struct {
+	logChan <-chan string
+}
+
+func (r logsStreamResponse) VisitGetInstanceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable nginx buffering
+	w.WriteHeader(200)
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("streaming not supported")
+	}
+
+	for line := range r.logChan {
+		jsonLine, _ := json.Marshal(line)
+		fmt.Fprintf(w, "data: %s\n\n", jsonLine)
+		flusher.Flush()
+	}
+	return nil
+}
+
+// instanceEventsHeartbeat is how often an SSE comment is sent on an idle
+// instance event stream
+const instanceEventsHeartbeat = 30 * time.Second
+
+// instanceEventsStreamResponse implements oapi.WatchInstancesResponseObject with SSE streaming
+type instanceEventsStreamResponse struct {
+	events <-chan instances.Event
+}
+
+func (r instanceEventsStreamResponse) VisitWatchInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable nginx buffering
+	w.WriteHeader(200)
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("streaming not supported")
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(instanceEventsHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case event, ok := <-r.events:
+			if !ok {
+				return nil
+			}
+			jsonEvent, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "data: %s\n\n", jsonEvent)
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		}
+		flusher.Flush()
+	}
+}
+
+// WatchInstances streams instance state changes via SSE until the client
+// disconnects. The instance filter can be an instance ID, name, or ID prefix.
+func (s *ApiService) WatchInstances(ctx context.Context, request oapi.WatchInstancesRequestObject) (oapi.WatchInstancesResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	var sel instances.WatchSelector
+	if request.Params.Instance != nil {
+		inst, err := s.InstanceManager.GetInstance(ctx, *request.Params.Instance)
+		if err != nil {
+			switch {
+			case errors.Is(err, instances.ErrNotFound):
+				return oapi.WatchInstances404JSONResponse{
+					Code:    "not_found",
+					Message: "instance not found",
+				}, nil
+			case errors.Is(err, instances.ErrAmbiguousName):
+				return oapi.WatchInstances409JSONResponse{
+					Code:    "ambiguous",
+					Message: "multiple instances match, use full ID",
+				}, nil
+			default:
+				log.ErrorContext(ctx, "failed to resolve instance to watch", "error", err)
+				return oapi.WatchInstances500JSONResponse{
+					Code:    "internal_error",
+					Message: "failed to resolve instance",
+				}, nil
+			}
+		}
+		sel.InstanceID = inst.Id
+	}
+	if request.Params.Type != nil {
+		for _, t := range *request.Params.Type {
+			sel.Types = append(sel.Types, instances.EventType(t))
+		}
+	}
+
+	return instanceEventsStreamResponse{events: s.InstanceManager.WatchInstances(ctx, sel)}, nil
+}
+
+// GetInstanceLogs streams instance logs via SSE
+// With follow=false (default), streams last N lines then closes
+// With follow=true, streams last N lines then continues following new output
+// The id parameter can be an instance ID, name, or ID prefix
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) GetInstanceLogs(ctx context.Context, request oapi.GetInstanceLogsRequestObject) (oapi.GetInstanceLogsResponseObject, error) {
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.GetInstanceLogs500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+
+	tail := 100
+	if request.Params.Tail != nil {
+		tail = *request.Params.Tail
+	}
+
+	follow := false
+	if request.Params.Follow != nil {
+		follow = *request.Params.Follow
+	}
+
+	// Map source parameter to LogSource type (default to app)
 	source := instances.LogSourceApp
 	if request.Params.Source != nil {
 		switch *request.Params.Source {
 		case oapi.App:
 			source = instances.LogSourceApp
+		case oapi.Kernel:
+			source = instances.LogSourceKernel
+		case oapi.System:
+			source = instances.LogSourceSystem
 		case oapi.Vmm:
 			source = instances.LogSourceVMM
 		case oapi.Hypeman:
@@ -503,6 +1094,104 @@ func (s *ApiService) GetInstanceLogs(ctx context.Context, request oapi.GetInstan
 	return logsStreamResponse{logChan: logChan}, nil
 }
 
+// GetInstanceBootStatus reports how far the instance's last boot got
+// The id parameter can be an instance ID, name, or ID prefix
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) GetInstanceBootStatus(ctx context.Context, request oapi.GetInstanceBootStatusRequestObject) (oapi.GetInstanceBootStatusResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.GetInstanceBootStatus500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+
+	status, err := s.InstanceManager.GetBootStatus(ctx, inst.Id)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get boot status", "error", err)
+		return oapi.GetInstanceBootStatus500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to get boot status",
+		}, nil
+	}
+	return oapi.GetInstanceBootStatus200JSONResponse{
+		State:       oapi.BootStatusState(status.State),
+		FailedPhase: lo.EmptyableToPtr(status.FailedPhase),
+		Error:       lo.EmptyableToPtr(status.Error),
+	}, nil
+}
+
+// ListExecSessions returns the exec session audit log of an instance
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) ListExecSessions(ctx context.Context, request oapi.ListExecSessionsRequestObject) (oapi.ListExecSessionsResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.ListExecSessions500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+
+	sessions, err := s.InstanceManager.ListExecSessions(ctx, inst.Id)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to list exec sessions", "error", err)
+		return oapi.ListExecSessions500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to list exec sessions",
+		}, nil
+	}
+	return oapi.ListExecSessions200JSONResponse(lo.Map(sessions, func(session instances.ExecSession, _ int) oapi.ExecSession {
+		return oapi.ExecSession{
+			Id:        session.ID,
+			Subject:   session.Subject,
+			Command:   session.Command,
+			Tty:       session.TTY,
+			StartedAt: session.StartedAt,
+			EndedAt:   session.EndedAt,
+			ExitCode:  session.ExitCode,
+			Recorded:  session.Recorded,
+		}
+	})), nil
+}
+
+// GetExecSessionRecording returns the asciicast recording of an exec session
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) GetExecSessionRecording(ctx context.Context, request oapi.GetExecSessionRecordingRequestObject) (oapi.GetExecSessionRecordingResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.GetExecSessionRecording500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+
+	recording, err := s.InstanceManager.OpenExecRecording(ctx, inst.Id, request.SessionId)
+	if err != nil {
+		switch {
+		case errors.Is(err, instances.ErrRecordingNotFound):
+			return oapi.GetExecSessionRecording404JSONResponse{
+				Code:    "recording_not_found",
+				Message: "exec session not found or not recorded",
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to open exec recording", "error", err)
+			return oapi.GetExecSessionRecording500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to open exec recording",
+			}, nil
+		}
+	}
+
+	// The response closes the recording once it has been written
+	return oapi.GetExecSessionRecording200ApplicationxAsciicastResponse{Body: recording}, nil
+}
+
 // StatInstancePath returns information about a path in the guest filesystem
 // The id parameter can be an instance ID, name, or ID prefix
 // Note: Resolution is handled by ResolveResource middleware
@@ -579,20 +1268,428 @@ func (s *ApiService) StatInstancePath(ctx context.Context, request oapi.StatInst
 	return response, nil
 }
 
-// AttachVolume attaches a volume to an instance (not yet implemented)
+// GetInstanceFile returns metadata and (optionally) content of a file in the guest
+// The id parameter can be an instance ID, name, or ID prefix
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) GetInstanceFile(ctx context.Context, request oapi.GetInstanceFileRequestObject) (oapi.GetInstanceFileResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.GetInstanceFile500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+
+	if inst.State != instances.StateRunning {
+		return oapi.GetInstanceFile409JSONResponse{
+			Code:    "invalid_state",
+			Message: fmt.Sprintf("instance must be running (current state: %s)", inst.State),
+		}, nil
+	}
+
+	dialer, err := hypervisor.NewVsockDialer(inst.HypervisorType, inst.VsockSocket, inst.VsockCID)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to create vsock dialer", "error", err)
+		return oapi.GetInstanceFile500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to create vsock dialer",
+		}, nil
+	}
+
+	grpcConn, err := guest.GetOrCreateConn(ctx, dialer)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get grpc connection", "error", err)
+		return oapi.GetInstanceFile500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to connect to guest agent",
+		}, nil
+	}
+
+	client := guest.NewGuestServiceClient(grpcConn)
+	statOnly := request.Params.StatOnly != nil && *request.Params.StatOnly
+
+	var info *guest.FileInfo
+	var data []byte
+	var truncated bool
+	if statOnly {
+		resp, err := client.StatFile(ctx, &guest.StatFileRequest{Path: request.Params.Path})
+		if err != nil {
+			return guestFileErrorToOAPI(ctx, err, request.Params.Path), nil
+		}
+		info = resp.Info
+	} else {
+		resp, err := client.ReadFile(ctx, &guest.ReadFileRequest{Path: request.Params.Path})
+		if err != nil {
+			return guestFileErrorToOAPI(ctx, err, request.Params.Path), nil
+		}
+		info, data, truncated = resp.Info, resp.Data, resp.Truncated
+	}
+
+	response := oapi.GetInstanceFile200JSONResponse{
+		Path:  info.Path,
+		IsDir: info.IsDir,
+		Mode:  int(info.Mode),
+		Size:  info.Size,
+		Mtime: time.Unix(info.Mtime, 0).UTC(),
+		Uid:   int(info.Uid),
+		Gid:   int(info.Gid),
+	}
+	if !statOnly {
+		content, encoding := string(data), oapi.Utf8
+		if !utf8.Valid(data) {
+			content, encoding = base64.StdEncoding.EncodeToString(data), oapi.Base64
+		}
+		response.Content = &content
+		response.Encoding = &encoding
+		response.Truncated = &truncated
+	}
+	return response, nil
+}
+
+// guestFileErrorToOAPI maps guest agent file errors to API responses
+func guestFileErrorToOAPI(ctx context.Context, err error, path string) oapi.GetInstanceFileResponseObject {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.NotFound:
+		return oapi.GetInstanceFile404JSONResponse{
+			Code:    "not_found",
+			Message: st.Message(),
+		}
+	case codes.InvalidArgument, codes.PermissionDenied, codes.FailedPrecondition:
+		return oapi.GetInstanceFile400JSONResponse{
+			Code:    "invalid_request",
+			Message: st.Message(),
+		}
+	default:
+		logger.FromContext(ctx).ErrorContext(ctx, "read guest file failed", "error", err, "path", path)
+		return oapi.GetInstanceFile500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to read file in guest",
+		}
+	}
+}
+
+// ListInstanceProcesses returns the guest process list and resource usage
+// The id parameter can be an instance ID, name, or ID prefix
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) ListInstanceProcesses(ctx context.Context, request oapi.ListInstanceProcessesRequestObject) (oapi.ListInstanceProcessesResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.ListInstanceProcesses500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+
+	if inst.State != instances.StateRunning {
+		return oapi.ListInstanceProcesses409JSONResponse{
+			Code:    "invalid_state",
+			Message: fmt.Sprintf("instance must be running (current state: %s)", inst.State),
+		}, nil
+	}
+
+	dialer, err := hypervisor.NewVsockDialer(inst.HypervisorType, inst.VsockSocket, inst.VsockCID)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to create vsock dialer", "error", err)
+		return oapi.ListInstanceProcesses500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to create vsock dialer",
+		}, nil
+	}
+
+	grpcConn, err := guest.GetOrCreateConn(ctx, dialer)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get grpc connection", "error", err)
+		return oapi.ListInstanceProcesses500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to connect to guest agent",
+		}, nil
+	}
+
+	client := guest.NewGuestServiceClient(grpcConn)
+	procsResp, err := client.ListProcesses(ctx, &guest.ListProcessesRequest{})
+	if err != nil {
+		log.ErrorContext(ctx, "list processes failed", "error", err)
+		return oapi.ListInstanceProcesses500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to list guest processes",
+		}, nil
+	}
+
+	statsResp, err := client.GetGuestStats(ctx, &guest.GetGuestStatsRequest{})
+	if err != nil {
+		log.ErrorContext(ctx, "get guest stats failed", "error", err)
+		return oapi.ListInstanceProcesses500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to get guest stats",
+		}, nil
+	}
+
+	return oapi.ListInstanceProcesses200JSONResponse(guestProcessesToOAPI(procsResp, statsResp)), nil
+}
+
+// guestProcessesToOAPI converts guest agent process and stats responses to OAPI
+func guestProcessesToOAPI(procs *guest.ListProcessesResponse, stats *guest.GetGuestStatsResponse) oapi.InstanceProcesses {
+	processes := make([]oapi.GuestProcess, 0, len(procs.Processes))
+	for _, p := range procs.Processes {
+		cmdline := p.Cmdline
+		if cmdline == nil {
+			cmdline = []string{}
+		}
+		processes = append(processes, oapi.GuestProcess{
+			Pid:      int(p.Pid),
+			Ppid:     int(p.Ppid),
+			Name:     p.Name,
+			Cmdline:  cmdline,
+			State:    p.State,
+			RssBytes: p.RssBytes,
+		})
+	}
+
+	disks := make([]oapi.GuestDiskUsage, 0, len(stats.Disks))
+	for _, d := range stats.Disks {
+		disks = append(disks, oapi.GuestDiskUsage{
+			MountPoint:     d.MountPoint,
+			Device:         d.Device,
+			FsType:         d.FsType,
+			TotalBytes:     int64(d.TotalBytes),
+			UsedBytes:      int64(d.UsedBytes),
+			AvailableBytes: int64(d.AvailableBytes),
+		})
+	}
+
+	return oapi.InstanceProcesses{
+		Processes: processes,
+		Stats: oapi.GuestStats{
+			Load1:                float32(stats.Load1),
+			Load5:                float32(stats.Load5),
+			Load15:               float32(stats.Load15),
+			MemoryTotalBytes:     int64(stats.MemoryTotalBytes),
+			MemoryAvailableBytes: int64(stats.MemoryAvailableBytes),
+			MemoryFreeBytes:      int64(stats.MemoryFreeBytes),
+			Disks:                disks,
+		},
+	}
+}
+
+// AttachVolume attaches a volume to an instance, hotplugging it if the instance is running
+// The id parameter can be an instance ID, name, or ID prefix; volumeId a volume ID or name
+// Note: Instance resolution is handled by ResolveResource middleware
 func (s *ApiService) AttachVolume(ctx context.Context, request oapi.AttachVolumeRequestObject) (oapi.AttachVolumeResponseObject, error) {
-	return oapi.AttachVolume500JSONResponse{
-		Code:    "not_implemented",
-		Message: "volume attachment not yet implemented",
-	}, nil
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.AttachVolume500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+	log := logger.FromContext(ctx)
+
+	volumeID, _, err := VolumeResolver{Manager: s.VolumeManager}.Resolve(ctx, request.VolumeId)
+	if err != nil {
+		if errors.Is(err, volumes.ErrNotFound) {
+			return oapi.AttachVolume404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		log.ErrorContext(ctx, "failed to resolve volume", "volume", request.VolumeId, "error", err)
+		return oapi.AttachVolume500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to resolve volume",
+		}, nil
+	}
+
+	result, err := s.InstanceManager.AttachVolume(ctx, inst.Id, volumeID, instances.AttachVolumeRequest{
+		MountPath: request.Body.MountPath,
+		Readonly:  lo.FromPtr(request.Body.Readonly),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, instances.ErrInvalidVolume):
+			return oapi.AttachVolume400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, volumes.ErrNotFound):
+			return oapi.AttachVolume404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, instances.ErrInvalidState):
+			return oapi.AttachVolume409JSONResponse{
+				Code:    "invalid_state",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, volumes.ErrInUse):
+			return oapi.AttachVolume409JSONResponse{
+				Code:    "conflict",
+				Message: err.Error(),
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to attach volume", "volume", volumeID, "error", err)
+			return oapi.AttachVolume500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to attach volume",
+			}, nil
+		}
+	}
+	return oapi.AttachVolume200JSONResponse(instanceToOAPI(*result)), nil
 }
 
-// DetachVolume detaches a volume from an instance (not yet implemented)
+// DetachVolume detaches a volume from an instance, hot-unplugging it if the instance is running
+// The id parameter can be an instance ID, name, or ID prefix; volumeId a volume ID or name
+// Note: Instance resolution is handled by ResolveResource middleware
 func (s *ApiService) DetachVolume(ctx context.Context, request oapi.DetachVolumeRequestObject) (oapi.DetachVolumeResponseObject, error) {
-	return oapi.DetachVolume500JSONResponse{
-		Code:    "not_implemented",
-		Message: "volume detachment not yet implemented",
-	}, nil
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.DetachVolume500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+	log := logger.FromContext(ctx)
+
+	volumeID, _, err := VolumeResolver{Manager: s.VolumeManager}.Resolve(ctx, request.VolumeId)
+	if err != nil {
+		if errors.Is(err, volumes.ErrNotFound) {
+			return oapi.DetachVolume404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		log.ErrorContext(ctx, "failed to resolve volume", "volume", request.VolumeId, "error", err)
+		return oapi.DetachVolume500JSONResponse{
+			Code:    "internal_error",
+			Message: "failed to resolve volume",
+		}, nil
+	}
+
+	result, err := s.InstanceManager.DetachVolume(ctx, inst.Id, volumeID)
+	if err != nil {
+		switch {
+		case errors.Is(err, instances.ErrNotSupported):
+			return oapi.DetachVolume400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, volumes.ErrNotFound):
+			return oapi.DetachVolume404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, instances.ErrInvalidState):
+			return oapi.DetachVolume409JSONResponse{
+				Code:    "invalid_state",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, volumes.ErrInUse):
+			return oapi.DetachVolume409JSONResponse{
+				Code:    "volume_in_use",
+				Message: err.Error(),
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to detach volume", "volume", volumeID, "error", err)
+			return oapi.DetachVolume500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to detach volume",
+			}, nil
+		}
+	}
+	return oapi.DetachVolume200JSONResponse(instanceToOAPI(*result)), nil
+}
+
+// AttachInstanceDevice hotplugs a passthrough device into a running instance
+// The id parameter can be an instance ID, name, or ID prefix
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) AttachInstanceDevice(ctx context.Context, request oapi.AttachInstanceDeviceRequestObject) (oapi.AttachInstanceDeviceResponseObject, error) {
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.AttachInstanceDevice500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+	log := logger.FromContext(ctx)
+
+	result, err := s.InstanceManager.AttachDevice(ctx, inst.Id, request.DeviceId)
+	if err != nil {
+		switch {
+		case errors.Is(err, instances.ErrNotSupported):
+			return oapi.AttachInstanceDevice400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, devices.ErrNotFound):
+			return oapi.AttachInstanceDevice404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, instances.ErrInvalidState):
+			return oapi.AttachInstanceDevice409JSONResponse{
+				Code:    "invalid_state",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, devices.ErrInUse), errors.Is(err, devices.ErrNoDeviceAvailable):
+			return oapi.AttachInstanceDevice409JSONResponse{
+				Code:    "conflict",
+				Message: err.Error(),
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to attach device", "device", request.DeviceId, "error", err)
+			return oapi.AttachInstanceDevice500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to attach device",
+			}, nil
+		}
+	}
+	return oapi.AttachInstanceDevice200JSONResponse(instanceToOAPI(*result)), nil
+}
+
+// DetachInstanceDevice hot-unplugs a passthrough device from a running instance
+// The id parameter can be an instance ID, name, or ID prefix
+// Note: Resolution is handled by ResolveResource middleware
+func (s *ApiService) DetachInstanceDevice(ctx context.Context, request oapi.DetachInstanceDeviceRequestObject) (oapi.DetachInstanceDeviceResponseObject, error) {
+	inst := mw.GetResolvedInstance[instances.Instance](ctx)
+	if inst == nil {
+		return oapi.DetachInstanceDevice500JSONResponse{
+			Code:    "internal_error",
+			Message: "resource not resolved",
+		}, nil
+	}
+	log := logger.FromContext(ctx)
+
+	result, err := s.InstanceManager.DetachDevice(ctx, inst.Id, request.DeviceId)
+	if err != nil {
+		switch {
+		case errors.Is(err, instances.ErrNotSupported):
+			return oapi.DetachInstanceDevice400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, devices.ErrNotFound):
+			return oapi.DetachInstanceDevice404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, instances.ErrInvalidState):
+			return oapi.DetachInstanceDevice409JSONResponse{
+				Code:    "invalid_state",
+				Message: err.Error(),
+			}, nil
+		default:
+			log.ErrorContext(ctx, "failed to detach device", "device", request.DeviceId, "error", err)
+			return oapi.DetachInstanceDevice500JSONResponse{
+				Code:    "internal_error",
+				Message: "failed to detach device",
+			}, nil
+		}
+	}
+	return oapi.DetachInstanceDevice200JSONResponse(instanceToOAPI(*result)), nil
 }
 
 // instanceToOAPI converts domain Instance to OAPI Instance
@@ -616,12 +1713,15 @@ func instanceToOAPI(inst instances.Instance) oapi.Instance {
 
 	// Build network object with ip/mac and bandwidth nested inside
 	netObj := &struct {
-		BandwidthDownload *string `json:"bandwidth_download,omitempty"`
-		BandwidthUpload   *string `json:"bandwidth_upload,omitempty"`
-		Enabled           *bool   `json:"enabled,omitempty"`
-		Ip                *string `json:"ip"`
-		Mac               *string `json:"mac"`
-		Name              *string `json:"name,omitempty"`
+		BandwidthDownload *string   `json:"bandwidth_download,omitempty"`
+		BandwidthUpload   *string   `json:"bandwidth_upload,omitempty"`
+		DnsServers        *[]string `json:"dns_servers,omitempty"`
+		Enabled           *bool     `json:"enabled,omitempty"`
+		Ip                *string   `json:"ip"`
+		Mac               *string   `json:"mac"`
+		Mtu               *int      `json:"mtu,omitempty"`
+		Name              *string   `json:"name,omitempty"`
+		SearchDomains     *[]string `json:"search_domains,omitempty"`
 	}{
 		Enabled:           lo.ToPtr(inst.NetworkEnabled),
 		BandwidthDownload: downloadBwStr,
@@ -632,6 +1732,15 @@ func instanceToOAPI(inst instances.Instance) oapi.Instance {
 		netObj.Ip = lo.ToPtr(inst.IP)
 		netObj.Mac = lo.ToPtr(inst.MAC)
 	}
+	if inst.MTU > 0 {
+		netObj.Mtu = lo.ToPtr(inst.MTU)
+	}
+	if len(inst.DNSServers) > 0 {
+		netObj.DnsServers = lo.ToPtr(inst.DNSServers)
+	}
+	if len(inst.SearchDomains) > 0 {
+		netObj.SearchDomains = lo.ToPtr(inst.SearchDomains)
+	}
 
 	// Convert hypervisor type
 	hvType := oapi.InstanceHypervisor(inst.HypervisorType)
@@ -660,12 +1769,66 @@ func instanceToOAPI(inst instances.Instance) oapi.Instance {
 		StoppedAt:   inst.StoppedAt,
 		HasSnapshot: lo.ToPtr(inst.HasSnapshot),
 		Hypervisor:  &hvType,
+		NumaNode:    inst.NUMANode,
+	}
+
+	overlayFormat := instances.OverlayFormatRaw
+	if inst.OverlayFormat != "" {
+		overlayFormat = inst.OverlayFormat
+	}
+	oapiInst.OverlayFormat = lo.ToPtr(oapi.InstanceOverlayFormat(overlayFormat))
+	oapiInst.DiskDiscard = lo.ToPtr(inst.Discard)
+
+	if inst.KernelVersion != "" {
+		oapiInst.KernelVersion = lo.ToPtr(inst.KernelVersion)
+	}
+	if len(inst.KernelModules) > 0 {
+		oapiInst.KernelModules = &inst.KernelModules
+	}
+	if inst.InitMode != "" {
+		oapiInst.InitMode = lo.ToPtr(oapi.InstanceInitMode(inst.InitMode))
+	}
+	if inst.Systemd != nil {
+		oapiInst.Systemd = &oapi.SystemdOptions{
+			DefaultTarget: lo.EmptyableToPtr(inst.Systemd.DefaultTarget),
+		}
+		if len(inst.Systemd.MaskUnits) > 0 {
+			oapiInst.Systemd.MaskUnits = &inst.Systemd.MaskUnits
+		}
+	}
+
+	if inst.StateReason != "" {
+		oapiInst.StateReason = lo.ToPtr(oapi.InstanceStateReason(inst.StateReason))
 	}
 
 	if len(inst.Env) > 0 {
 		oapiInst.Env = &inst.Env
 	}
 
+	if inst.LogRetention != nil {
+		oapiInst.LogRetention = &oapi.LogRetention{}
+		if inst.LogRetention.MaxAge > 0 {
+			oapiInst.LogRetention.MaxAge = lo.ToPtr(inst.LogRetention.MaxAge.String())
+		}
+		if inst.LogRetention.MaxFiles > 0 {
+			oapiInst.LogRetention.MaxFiles = lo.ToPtr(inst.LogRetention.MaxFiles)
+		}
+	}
+
+	if inst.IdleTimeout > 0 {
+		oapiInst.IdleTimeout = lo.ToPtr(inst.IdleTimeout.String())
+		idleAction := oapi.InstanceIdleActionStop
+		if inst.IdleAction != "" {
+			idleAction = oapi.InstanceIdleAction(inst.IdleAction)
+		}
+		oapiInst.IdleAction = &idleAction
+		oapiInst.LastActivityAt = inst.LastActivityAt
+	}
+
+	if inst.ParentID != "" {
+		oapiInst.ParentId = lo.ToPtr(inst.ParentID)
+	}
+
 	// Convert volume attachments
 	if len(inst.Volumes) > 0 {
 		oapiVolumes := make([]oapi.VolumeMount, len(inst.Volumes))
@@ -5,9 +5,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/onkernel/hypeman/lib/instances"
+	mw "github.com/onkernel/hypeman/lib/middleware"
 	"github.com/onkernel/hypeman/lib/oapi"
 	"github.com/onkernel/hypeman/lib/paths"
 	"github.com/onkernel/hypeman/lib/system"
+	"github.com/onkernel/hypeman/lib/volumes"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -20,7 +24,22 @@ func TestListInstances_Empty(t *testing.T) {
 
 	list, ok := resp.(oapi.ListInstances200JSONResponse)
 	require.True(t, ok, "expected 200 response")
-	assert.Empty(t, list)
+	assert.Empty(t, list.Body)
+	assert.Equal(t, 0, list.Headers.XTotalCount)
+	assert.Empty(t, list.Headers.XNextCursor)
+}
+
+func TestWatchInstances_NotFound(t *testing.T) {
+	svc := newTestService(t)
+
+	missing := "missing"
+	resp, err := svc.WatchInstances(ctx(), oapi.WatchInstancesRequestObject{
+		Params: oapi.WatchInstancesParams{Instance: &missing},
+	})
+	require.NoError(t, err)
+
+	_, ok := resp.(oapi.WatchInstances404JSONResponse)
+	assert.True(t, ok, "expected 404 response")
 }
 
 func TestGetInstance_NotFound(t *testing.T) {
@@ -32,6 +51,56 @@ func TestGetInstance_NotFound(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestDeleteInstance_CascadeVolumesRequiresCascade(t *testing.T) {
+	svc := newTestService(t)
+	inst := &instances.Instance{StoredMetadata: instances.StoredMetadata{Id: "inst-1", Name: "web"}}
+
+	resp, err := svc.DeleteInstance(mw.WithResolvedInstance(ctx(), inst.Id, inst), oapi.DeleteInstanceRequestObject{
+		Id:     inst.Id,
+		Params: oapi.DeleteInstanceParams{CascadeVolumes: lo.ToPtr(true)},
+	})
+	require.NoError(t, err)
+	assert.IsType(t, oapi.DeleteInstance400JSONResponse{}, resp)
+}
+
+func TestDeletedIngressesNote(t *testing.T) {
+	assert.Empty(t, deletedIngressesNote(nil))
+	assert.Equal(t, "; cascade already deleted ingress web-public, web-admin", deletedIngressesNote([]oapi.DeletedResource{
+		{Id: "ing-1", Name: "web-public"},
+		{Id: "ing-2", Name: "web-admin"},
+	}))
+}
+
+func TestFindInstanceDependents_ExclusiveVolumes(t *testing.T) {
+	svc := newTestService(t)
+
+	attach := func(name string, readonly bool, instanceIDs ...string) string {
+		vol, err := svc.VolumeManager.CreateVolume(ctx(), volumes.CreateVolumeRequest{Name: name, SizeGb: 1})
+		require.NoError(t, err)
+		for _, id := range instanceIDs {
+			require.NoError(t, svc.VolumeManager.AttachVolume(ctx(), vol.Id, volumes.AttachVolumeRequest{InstanceID: id, MountPath: "/data", Readonly: readonly}))
+		}
+		return vol.Id
+	}
+	own := attach("own", false, "inst-1")
+	shared := attach("shared", true, "inst-1", "inst-2")
+
+	inst := &instances.Instance{StoredMetadata: instances.StoredMetadata{
+		Id:      "inst-1",
+		Name:    "web",
+		Volumes: []instances.VolumeAttachment{{VolumeID: own}, {VolumeID: shared, Readonly: true}},
+	}}
+
+	deps, err := svc.findInstanceDependents(ctx(), inst, true)
+	require.NoError(t, err)
+	require.Len(t, deps.volumes, 1, "a volume another instance has attached is kept")
+	assert.Equal(t, own, deps.volumes[0].Id)
+
+	deps, err = svc.findInstanceDependents(ctx(), inst, false)
+	require.NoError(t, err)
+	assert.Empty(t, deps.volumes)
+}
+
 func TestCreateInstance_ParsesHumanReadableSizes(t *testing.T) {
 	// Require KVM access for VM creation
 	if _, err := os.Stat("/dev/kvm"); os.IsNotExist(err) {
@@ -65,9 +134,12 @@ func TestCreateInstance_ParsesHumanReadableSizes(t *testing.T) {
 			HotplugSize: &hotplugSize,
 			OverlaySize: &overlaySize,
 			Network: &struct {
-				BandwidthDownload *string `json:"bandwidth_download,omitempty"`
-				BandwidthUpload   *string `json:"bandwidth_upload,omitempty"`
-				Enabled           *bool   `json:"enabled,omitempty"`
+				BandwidthDownload *string   `json:"bandwidth_download,omitempty"`
+				BandwidthUpload   *string   `json:"bandwidth_upload,omitempty"`
+				DnsServers        *[]string `json:"dns_servers,omitempty"`
+				Enabled           *bool     `json:"enabled,omitempty"`
+				Mtu               *int      `json:"mtu,omitempty"`
+				SearchDomains     *[]string `json:"search_domains,omitempty"`
 			}{
 				Enabled: &networkEnabled,
 			},
@@ -111,9 +183,12 @@ func TestCreateInstance_InvalidSizeFormat(t *testing.T) {
 			Image: "docker.io/library/alpine:latest",
 			Size:  &invalidSize,
 			Network: &struct {
-				BandwidthDownload *string `json:"bandwidth_download,omitempty"`
-				BandwidthUpload   *string `json:"bandwidth_upload,omitempty"`
-				Enabled           *bool   `json:"enabled,omitempty"`
+				BandwidthDownload *string   `json:"bandwidth_download,omitempty"`
+				BandwidthUpload   *string   `json:"bandwidth_upload,omitempty"`
+				DnsServers        *[]string `json:"dns_servers,omitempty"`
+				Enabled           *bool     `json:"enabled,omitempty"`
+				Mtu               *int      `json:"mtu,omitempty"`
+				SearchDomains     *[]string `json:"search_domains,omitempty"`
 			}{
 				Enabled: &networkEnabled,
 			},
@@ -128,6 +203,33 @@ func TestCreateInstance_InvalidSizeFormat(t *testing.T) {
 	assert.Contains(t, badReq.Message, "invalid size format")
 }
 
+func TestCreateInstances_InvalidRequest(t *testing.T) {
+	svc := newTestService(t)
+
+	invalidSize := "not-a-size"
+	resp, err := svc.CreateInstances(ctx(), oapi.CreateInstancesRequestObject{
+		Body: &oapi.CreateInstancesRequest{
+			Template: oapi.CreateInstanceRequest{Name: "worker", Image: "docker.io/library/alpine:latest", Size: &invalidSize},
+			Count:    2,
+		},
+	})
+	require.NoError(t, err)
+	badReq, ok := resp.(oapi.CreateInstances400JSONResponse)
+	require.True(t, ok, "expected 400 response")
+	assert.Equal(t, "invalid_size", badReq.Code)
+
+	resp, err = svc.CreateInstances(ctx(), oapi.CreateInstancesRequestObject{
+		Body: &oapi.CreateInstancesRequest{
+			Template: oapi.CreateInstanceRequest{Name: "worker", Image: "docker.io/library/alpine:latest"},
+			Count:    0,
+		},
+	})
+	require.NoError(t, err)
+	badReq, ok = resp.(oapi.CreateInstances400JSONResponse)
+	require.True(t, ok, "expected 400 response")
+	assert.Equal(t, "invalid_request", badReq.Code)
+}
+
 func TestInstanceLifecycle_StopStart(t *testing.T) {
 	// Require KVM access for VM creation
 	if _, err := os.Stat("/dev/kvm"); os.IsNotExist(err) {
@@ -154,9 +256,12 @@ func TestInstanceLifecycle_StopStart(t *testing.T) {
 			Name:  "test-lifecycle",
 			Image: "docker.io/library/nginx:alpine",
 			Network: &struct {
-				BandwidthDownload *string `json:"bandwidth_download,omitempty"`
-				BandwidthUpload   *string `json:"bandwidth_upload,omitempty"`
-				Enabled           *bool   `json:"enabled,omitempty"`
+				BandwidthDownload *string   `json:"bandwidth_download,omitempty"`
+				BandwidthUpload   *string   `json:"bandwidth_upload,omitempty"`
+				DnsServers        *[]string `json:"dns_servers,omitempty"`
+				Enabled           *bool     `json:"enabled,omitempty"`
+				Mtu               *int      `json:"mtu,omitempty"`
+				SearchDomains     *[]string `json:"search_domains,omitempty"`
 			}{
 				Enabled: &networkEnabled,
 			},
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"errors"
+
+	"github.com/onkernel/hypeman/lib/pagination"
+)
+
+// pageRequest builds a pagination request from a list endpoint's limit,
+// cursor and sort query parameters
+func pageRequest[S ~string](limit *int, cursor *string, sort *S) pagination.Request {
+	var req pagination.Request
+	if limit != nil {
+		req.Limit = *limit
+	}
+	if cursor != nil {
+		req.Cursor = *cursor
+	}
+	if sort != nil {
+		req.Sort = string(*sort)
+	}
+	return req
+}
+
+// isPageRequestError reports whether err is a bad limit, cursor or sort
+func isPageRequestError(err error) bool {
+	return errors.Is(err, pagination.ErrInvalidLimit) ||
+		errors.Is(err, pagination.ErrInvalidCursor) ||
+		errors.Is(err, pagination.ErrInvalidSort)
+}
@@ -137,9 +137,12 @@ func TestRegistryPushAndCreateInstance(t *testing.T) {
 			Name:  "test-pushed-image",
 			Image: imageName,
 			Network: &struct {
-				BandwidthDownload *string `json:"bandwidth_download,omitempty"`
-				BandwidthUpload   *string `json:"bandwidth_upload,omitempty"`
-				Enabled           *bool   `json:"enabled,omitempty"`
+				BandwidthDownload *string   `json:"bandwidth_download,omitempty"`
+				BandwidthUpload   *string   `json:"bandwidth_upload,omitempty"`
+				DnsServers        *[]string `json:"dns_servers,omitempty"`
+				Enabled           *bool     `json:"enabled,omitempty"`
+				Mtu               *int      `json:"mtu,omitempty"`
+				SearchDomains     *[]string `json:"search_domains,omitempty"`
 			}{
 				Enabled: &networkEnabled,
 			},
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/onkernel/hypeman/lib/builds"
 	"github.com/onkernel/hypeman/lib/images"
 	"github.com/onkernel/hypeman/lib/ingress"
 	"github.com/onkernel/hypeman/lib/instances"
@@ -31,11 +32,7 @@ type VolumeResolver struct {
 }
 
 func (r VolumeResolver) Resolve(ctx context.Context, idOrName string) (string, any, error) {
-	// Try by ID first, then by name
 	vol, err := r.Manager.GetVolume(ctx, idOrName)
-	if errors.Is(err, volumes.ErrNotFound) {
-		vol, err = r.Manager.GetVolumeByName(ctx, idOrName)
-	}
 	if err != nil {
 		return "", nil, err
 	}
@@ -55,6 +52,19 @@ func (r IngressResolver) Resolve(ctx context.Context, idOrName string) (string,
 	return ing.ID, ing, nil
 }
 
+// BuildResolver adapts builds.Manager to middleware.ResourceResolver.
+type BuildResolver struct {
+	Manager builds.Manager
+}
+
+func (r BuildResolver) Resolve(ctx context.Context, idOrPrefix string) (string, any, error) {
+	build, err := r.Manager.GetBuild(ctx, idOrPrefix)
+	if err != nil {
+		return "", nil, err
+	}
+	return build.ID, build, nil
+}
+
 // ImageResolver adapts images.Manager to middleware.ResourceResolver.
 // Note: Images are looked up by name (OCI reference), not ID.
 type ImageResolver struct {
@@ -76,6 +86,7 @@ func (s *ApiService) NewResolvers() middleware.Resolvers {
 		Volume:   VolumeResolver{Manager: s.VolumeManager},
 		Ingress:  IngressResolver{Manager: s.IngressManager},
 		Image:    ImageResolver{Manager: s.ImageManager},
+		Build:    BuildResolver{Manager: s.BuildManager},
 	}
 }
 
@@ -87,13 +98,15 @@ func ResolverErrorResponder(w http.ResponseWriter, err error, lookup string) {
 	case errors.Is(err, instances.ErrNotFound),
 		errors.Is(err, volumes.ErrNotFound),
 		errors.Is(err, ingress.ErrNotFound),
-		errors.Is(err, images.ErrNotFound):
+		errors.Is(err, images.ErrNotFound),
+		errors.Is(err, builds.ErrNotFound):
 		w.WriteHeader(http.StatusNotFound)
 		w.Write([]byte(`{"code":"not_found","message":"resource not found"}`))
 
 	case errors.Is(err, instances.ErrAmbiguousName),
 		errors.Is(err, volumes.ErrAmbiguousName),
-		errors.Is(err, ingress.ErrAmbiguousName):
+		errors.Is(err, ingress.ErrAmbiguousName),
+		errors.Is(err, builds.ErrAmbiguousID):
 		w.WriteHeader(http.StatusConflict)
 		w.Write([]byte(`{"code":"ambiguous","message":"multiple resources match, use full ID"}`))
 
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+
+	"github.com/onkernel/hypeman/lib/oapi"
+	"github.com/samber/lo"
+)
+
+// GetHostTopology returns the host's CPU topology and, for a vCPU count, the
+// guest topology an instance of that size gets
+func (s *ApiService) GetHostTopology(ctx context.Context, request oapi.GetHostTopologyRequestObject) (oapi.GetHostTopologyResponseObject, error) {
+	report, err := s.InstanceManager.GetTopology(lo.FromPtr(request.Params.Vcpus))
+	if err != nil {
+		return oapi.GetHostTopology400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := oapi.HostTopology{NumaNodes: make([]oapi.NUMANode, 0, len(report.NUMANodes))}
+	if host := report.Host; host != nil {
+		resp.Sockets = lo.ToPtr(host.Sockets)
+		resp.CoresPerSocket = lo.ToPtr(host.CoresPerSocket)
+		resp.ThreadsPerCore = lo.ToPtr(host.ThreadsPerCore)
+		resp.LogicalCpus = lo.ToPtr(host.Sockets * host.CoresPerSocket * host.ThreadsPerCore)
+	}
+	for _, node := range report.NUMANodes {
+		resp.NumaNodes = append(resp.NumaNodes, oapi.NUMANode{
+			Id:          node.ID,
+			Cpus:        node.CPUs,
+			MemoryBytes: lo.EmptyableToPtr(node.MemoryBytes),
+		})
+	}
+	if guest := report.Guest; guest != nil {
+		resp.Guest = &oapi.GuestTopology{
+			Vcpus:          guest.Vcpus,
+			ThreadsPerCore: lo.EmptyableToPtr(guest.ThreadsPerCore),
+			CoresPerDie:    lo.EmptyableToPtr(guest.CoresPerDie),
+			DiesPerPackage: lo.EmptyableToPtr(guest.DiesPerPackage),
+			Packages:       lo.EmptyableToPtr(guest.Packages),
+			Warnings:       append([]string{}, guest.Warnings...),
+		}
+	}
+	return oapi.GetHostTopology200JSONResponse(resp), nil
+}
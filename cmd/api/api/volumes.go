@@ -13,12 +13,19 @@ import (
 	"github.com/onkernel/hypeman/lib/volumes"
 )
 
-// ListVolumes lists all volumes
+// ListVolumes lists one page of volumes
 func (s *ApiService) ListVolumes(ctx context.Context, request oapi.ListVolumesRequestObject) (oapi.ListVolumesResponseObject, error) {
 	log := logger.FromContext(ctx)
 
-	domainVols, err := s.VolumeManager.ListVolumes(ctx)
+	params := request.Params
+	page, err := s.VolumeManager.ListVolumesPage(ctx, pageRequest(params.Limit, params.Cursor, params.Sort))
 	if err != nil {
+		if isPageRequestError(err) {
+			return oapi.ListVolumes400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		}
 		log.ErrorContext(ctx, "failed to list volumes", "error", err)
 		return oapi.ListVolumes500JSONResponse{
 			Code:    "internal_error",
@@ -26,12 +33,15 @@ func (s *ApiService) ListVolumes(ctx context.Context, request oapi.ListVolumesRe
 		}, nil
 	}
 
-	oapiVols := make([]oapi.Volume, len(domainVols))
-	for i, vol := range domainVols {
+	oapiVols := make([]oapi.Volume, len(page.Items))
+	for i, vol := range page.Items {
 		oapiVols[i] = volumeToOAPI(vol)
 	}
 
-	return oapi.ListVolumes200JSONResponse(oapiVols), nil
+	return oapi.ListVolumes200JSONResponse{
+		Body:    oapiVols,
+		Headers: oapi.ListVolumes200ResponseHeaders{XTotalCount: page.Total, XNextCursor: page.NextCursor},
+	}, nil
 }
 
 // CreateVolume creates a new volume
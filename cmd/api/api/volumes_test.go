@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/onkernel/hypeman/lib/oapi"
+	"github.com/onkernel/hypeman/lib/volumes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -16,7 +17,49 @@ func TestListVolumes_Empty(t *testing.T) {
 
 	list, ok := resp.(oapi.ListVolumes200JSONResponse)
 	require.True(t, ok, "expected 200 response")
-	assert.Empty(t, list)
+	assert.Empty(t, list.Body)
+}
+
+func TestListVolumes_Paginated(t *testing.T) {
+	svc := newTestService(t)
+
+	for _, name := range []string{"vol-c", "vol-a", "vol-b"} {
+		_, err := svc.VolumeManager.CreateVolume(ctx(), volumes.CreateVolumeRequest{Name: name, SizeGb: 1})
+		require.NoError(t, err)
+	}
+
+	limit := 2
+	sort := oapi.ListVolumesParamsSort("name")
+	resp, err := svc.ListVolumes(ctx(), oapi.ListVolumesRequestObject{
+		Params: oapi.ListVolumesParams{Limit: &limit, Sort: &sort},
+	})
+	require.NoError(t, err)
+	first, ok := resp.(oapi.ListVolumes200JSONResponse)
+	require.True(t, ok, "expected 200 response")
+	require.Len(t, first.Body, 2)
+	assert.Equal(t, "vol-a", first.Body[0].Name)
+	assert.Equal(t, "vol-b", first.Body[1].Name)
+	assert.Equal(t, 3, first.Headers.XTotalCount)
+	require.NotEmpty(t, first.Headers.XNextCursor)
+
+	resp, err = svc.ListVolumes(ctx(), oapi.ListVolumesRequestObject{
+		Params: oapi.ListVolumesParams{Limit: &limit, Sort: &sort, Cursor: &first.Headers.XNextCursor},
+	})
+	require.NoError(t, err)
+	second, ok := resp.(oapi.ListVolumes200JSONResponse)
+	require.True(t, ok, "expected 200 response")
+	require.Len(t, second.Body, 1)
+	assert.Equal(t, "vol-c", second.Body[0].Name)
+	assert.Empty(t, second.Headers.XNextCursor)
+
+	// A cursor from one sort can't continue another
+	other := oapi.ListVolumesParamsSort("-created_at")
+	resp, err = svc.ListVolumes(ctx(), oapi.ListVolumesRequestObject{
+		Params: oapi.ListVolumesParams{Sort: &other, Cursor: &first.Headers.XNextCursor},
+	})
+	require.NoError(t, err)
+	_, ok = resp.(oapi.ListVolumes400JSONResponse)
+	assert.True(t, ok, "expected 400 response")
 }
 
 func TestGetVolume_NotFound(t *testing.T) {
@@ -51,17 +51,31 @@ func getBuildVersion() string {
 
 type Config struct {
 	Port                string
+	ListenTCP           bool   // Serve the API on Port (off to serve only on ListenSocket)
+	ListenSocket        string // Unix socket to serve the API on (empty = none)
+	ListenSocketMode    string // Octal permissions of ListenSocket
+	TLSCertFile         string // Certificate for serving the API over HTTPS on Port (empty = plain HTTP)
+	TLSKeyFile          string // Private key of TLSCertFile
+	TLSRedirectPort     string // Port redirecting plain HTTP to HTTPS when TLS is on (empty = none)
 	DataDir             string
 	BridgeName          string
 	SubnetCIDR          string
 	SubnetGateway       string
 	UplinkInterface     string
-	JwtSecret           string
+	NetworkMTU          int    // MTU of the bridge and instance TAP devices (0 = kernel default)
+	JwtSecret           string // One secret, or a comma-separated keyset (primary first) during a rotation
+	JwtAlgorithm        string // Algorithm user tokens are signed with: HS256, RS256 or ES256
+	JwtPublicKeyFile    string // PEM public key verifying RS256/ES256 user tokens
+	JwtJwksURL          string // JWKS URL of an identity provider, instead of a public key file
+	JwtAudience         string // Required "aud" claim of user tokens; unchecked if empty
+	JwtIssuer           string // Required "iss" claim of user tokens; unchecked if empty
 	DNSServer           string
 	MaxConcurrentBuilds int
 	MaxOverlaySize      string
 	LogMaxSize          string
 	LogMaxFiles         int
+	LogMaxAge           string
+	LogCompress         bool
 	LogRotateInterval   string
 
 	// Resource limits - per instance
@@ -82,15 +96,36 @@ type Config struct {
 	Version               string // Application version for telemetry
 	Env                   string // Deployment environment (e.g., dev, staging, prod)
 
+	// Prometheus scrape endpoint (independent of OTLP export)
+	PrometheusEnabled bool   // Serve metrics at /metrics
+	PrometheusToken   string // Bearer token required to scrape /metrics (empty = unauthenticated)
+
 	// Logging configuration
 	LogLevel string // Default log level (debug, info, warn, error)
 
+	// API rate limiting per JWT subject (0 = unlimited)
+	RateLimitRPS                  float64 // Sustained requests per second
+	RateLimitBurst                int     // Requests allowed at once before the rate applies (0 = RPS rounded up)
+	RateLimitMaxInFlightMutations int     // Concurrent mutating requests (create, delete, standby, ...)
+
+	// API request timeouts (0 = none); log and event streams have none
+	RequestTimeoutRead  string // GET requests
+	RequestTimeoutWrite string // Other requests
+	RequestTimeoutLong  string // Creating, starting or restoring instances, builds, exec and file transfers
+
+	// Audit log configuration
+	AuditLogEnabled      bool   // Record mutating API requests to {DATA_DIR}/logs/audit.log
+	AuditLogIncludeReads bool   // Also record read-only requests (GET/HEAD/OPTIONS)
+	AuditLogMaxSize      string // Rotate the audit log at this size
+	AuditLogMaxFiles     int    // Rotated audit logs to keep
+
 	// Caddy / Ingress configuration
-	CaddyListenAddress  string // Address for Caddy to listen on
-	CaddyAdminAddress   string // Address for Caddy admin API
-	CaddyAdminPort      int    // Port for Caddy admin API
-	InternalDNSPort     int    // Port for internal DNS server (used for dynamic upstreams)
-	CaddyStopOnShutdown bool   // Stop Caddy when hypeman shuts down
+	CaddyListenAddress        string // Address for Caddy to listen on
+	CaddyAdminAddress         string // Address for Caddy admin API
+	CaddyAdminPort            int    // Port for Caddy admin API
+	InternalDNSPort           int    // Port for internal DNS server (used for dynamic upstreams)
+	CaddyStopOnShutdown       bool   // Stop Caddy when hypeman shuts down
+	InternalDNSServiceRecords bool   // Answer SRV/TXT service discovery queries on the internal DNS server
 
 	// ACME / TLS configuration
 	AcmeEmail             string // ACME account email (required for TLS ingresses)
@@ -109,9 +144,37 @@ type Config struct {
 	RegistryURL               string // URL of registry for built images
 	BuildTimeout              int    // Default build timeout in seconds
 	BuildSecretsDir           string // Directory containing build secrets (optional)
+	BuildAllowedFrontends     string // Comma-separated frontend images builds may use besides dockerfile.v0
+	BuilderPoolSize           int    // Booted builder VMs kept waiting for builds (0 = disabled, capped at MaxConcurrentSourceBuilds)
+	BuildMinMemoryMB          int    // Smallest builder VM memory a build may ask for (0 = no bound)
+	BuildMaxMemoryMB          int    // Largest builder VM memory a build may ask for (0 = no bound)
+	BuildMaxSourceSize        string // Largest source tarball a build accepts (e.g. "500MB", 0 = no limit)
+
+	// Registry pull-through cache (optional)
+	RegistryUpstream         string // Upstream registry to mirror on pull misses (e.g. "docker.io"), empty = disabled
+	RegistryUpstreamUsername string // Upstream username (empty = use Docker config credentials)
+	RegistryUpstreamPassword string // Upstream password or token
+	RegistryUpstreamTagTTL   string // How long mirrored tags are served before revalidating (e.g. "5m")
+
+	// Registry storage
+	RegistryRepoQuota string // Max size of each registry repository (e.g. "20GB"), empty = unlimited
 
 	// Hypervisor configuration
-	DefaultHypervisor string // Default hypervisor type: "cloud-hypervisor" or "qemu"
+	DefaultHypervisor string // Default hypervisor type: "cloud-hypervisor", "qemu" or "firecracker"
+
+	// Guest console configuration
+	SerialConsoleBaud   int    // Baud rate of the guest serial console
+	KernelLogBufferSize string // Guest kernel log buffer size (e.g. "1MB"), empty = kernel default
+
+	// Instance lifecycle configuration
+	StopGracePeriod            string // Time to wait for a clean guest shutdown on stop before stopping the VMM (0 = skip)
+	IdleCheckInterval          string // How often instances with an idle_timeout are checked for activity
+	SnapshotBeforeDelete       bool   // Preserve instance state on delete unless the request says otherwise
+	PreservedSnapshotRetention string // How long state preserved on delete is kept
+	GuestTimeSyncInterval      string // How often running guests' clocks are stepped to the host's (0 = never)
+	ExecRecording              bool   // Record the output of interactive (TTY) exec sessions
+	ExecKeepaliveInterval      string // How often exec WebSockets are pinged (0 = never)
+	ExecIdleTimeout            string // Close interactive exec sessions without input or output for this long (0 = never)
 
 	// Oversubscription ratios (1.0 = no oversubscription, 2.0 = 2x oversubscription)
 	OversubCPU     float64 // CPU oversubscription ratio
@@ -139,17 +202,31 @@ func Load() *Config {
 
 	cfg := &Config{
 		Port:                getEnv("PORT", "8080"),
+		ListenTCP:           getEnvBool("LISTEN_TCP", true),
+		ListenSocket:        getEnv("LISTEN_SOCKET", ""),
+		ListenSocketMode:    getEnv("LISTEN_SOCKET_MODE", "0660"),
+		TLSCertFile:         getEnv("TLS_CERT_FILE", ""),
+		TLSKeyFile:          getEnv("TLS_KEY_FILE", ""),
+		TLSRedirectPort:     getEnv("TLS_REDIRECT_PORT", ""),
 		DataDir:             getEnv("DATA_DIR", "/var/lib/hypeman"),
 		BridgeName:          getEnv("BRIDGE_NAME", "vmbr0"),
 		SubnetCIDR:          getEnv("SUBNET_CIDR", "10.100.0.0/16"),
 		SubnetGateway:       getEnv("SUBNET_GATEWAY", ""),   // empty = derived as first IP from subnet
 		UplinkInterface:     getEnv("UPLINK_INTERFACE", ""), // empty = auto-detect from default route
+		NetworkMTU:          getEnvInt("NETWORK_MTU", 0),
 		JwtSecret:           getEnv("JWT_SECRET", ""),
+		JwtAlgorithm:        getEnv("JWT_ALGORITHM", "HS256"),
+		JwtPublicKeyFile:    getEnv("JWT_PUBLIC_KEY_FILE", ""),
+		JwtJwksURL:          getEnv("JWT_JWKS_URL", ""),
+		JwtAudience:         getEnv("JWT_AUDIENCE", ""),
+		JwtIssuer:           getEnv("JWT_ISSUER", ""),
 		DNSServer:           getEnv("DNS_SERVER", "1.1.1.1"),
 		MaxConcurrentBuilds: getEnvInt("MAX_CONCURRENT_BUILDS", 1),
 		MaxOverlaySize:      getEnv("MAX_OVERLAY_SIZE", "100GB"),
 		LogMaxSize:          getEnv("LOG_MAX_SIZE", "50MB"),
 		LogMaxFiles:         getEnvInt("LOG_MAX_FILES", 1),
+		LogMaxAge:           getEnv("LOG_MAX_AGE", ""),
+		LogCompress:         getEnvBool("LOG_COMPRESS", true),
 		LogRotateInterval:   getEnv("LOG_ROTATE_INTERVAL", "5m"),
 
 		// Resource limits - per instance (0 = unlimited)
@@ -170,16 +247,37 @@ func Load() *Config {
 		Version:               getEnv("VERSION", getBuildVersion()),
 		Env:                   getEnv("ENV", "unset"),
 
+		// Prometheus scrape endpoint
+		PrometheusEnabled: getEnvBool("PROMETHEUS_ENABLED", false),
+		PrometheusToken:   getEnv("PROMETHEUS_TOKEN", ""),
+
 		// Logging configuration
 		LogLevel: getEnv("LOG_LEVEL", "info"),
 
+		// API rate limiting per JWT subject
+		RateLimitRPS:                  getEnvFloat("RATE_LIMIT_RPS", 0),
+		RateLimitBurst:                getEnvInt("RATE_LIMIT_BURST", 0),
+		RateLimitMaxInFlightMutations: getEnvInt("RATE_LIMIT_MAX_MUTATIONS", 0),
+
+		// API request timeouts
+		RequestTimeoutRead:  getEnv("REQUEST_TIMEOUT_READ", "30s"),
+		RequestTimeoutWrite: getEnv("REQUEST_TIMEOUT_WRITE", "60s"),
+		RequestTimeoutLong:  getEnv("REQUEST_TIMEOUT_LONG", "10m"),
+
+		// Audit log configuration
+		AuditLogEnabled:      getEnvBool("AUDIT_LOG_ENABLED", false),
+		AuditLogIncludeReads: getEnvBool("AUDIT_LOG_INCLUDE_READS", false),
+		AuditLogMaxSize:      getEnv("AUDIT_LOG_MAX_SIZE", "100MB"),
+		AuditLogMaxFiles:     getEnvInt("AUDIT_LOG_MAX_FILES", 10),
+
 		// Caddy / Ingress configuration
 		CaddyListenAddress: getEnv("CADDY_LISTEN_ADDRESS", "0.0.0.0"),
 		CaddyAdminAddress:  getEnv("CADDY_ADMIN_ADDRESS", "127.0.0.1"),
 		CaddyAdminPort:     getEnvInt("CADDY_ADMIN_PORT", 0),  // 0 = random port to prevent conflicts on shared dev machines
 		InternalDNSPort:    getEnvInt("INTERNAL_DNS_PORT", 0), // 0 = random port; used for dynamic upstream resolution
 		// Set to false if you're likely to frequently update hypeman
-		CaddyStopOnShutdown: getEnvBool("CADDY_STOP_ON_SHUTDOWN", true),
+		CaddyStopOnShutdown:       getEnvBool("CADDY_STOP_ON_SHUTDOWN", true),
+		InternalDNSServiceRecords: getEnvBool("INTERNAL_DNS_SERVICE_RECORDS", false),
 
 		// ACME / TLS configuration
 		AcmeEmail:             getEnv("ACME_EMAIL", ""),
@@ -198,10 +296,38 @@ func Load() *Config {
 		RegistryURL:               getEnv("REGISTRY_URL", "localhost:8080"),
 		BuildTimeout:              getEnvInt("BUILD_TIMEOUT", 600),
 		BuildSecretsDir:           getEnv("BUILD_SECRETS_DIR", ""), // Optional: path to directory with build secrets
+		BuildAllowedFrontends:     getEnv("BUILD_ALLOWED_FRONTENDS", "docker/dockerfile"),
+		BuilderPoolSize:           getEnvInt("BUILDER_POOL_SIZE", 0),
+		BuildMinMemoryMB:          getEnvInt("BUILD_MIN_MEMORY_MB", 512),
+		BuildMaxMemoryMB:          getEnvInt("BUILD_MAX_MEMORY_MB", 16384),
+		BuildMaxSourceSize:        getEnv("BUILD_MAX_SOURCE_SIZE", "500MB"),
+
+		// Registry pull-through cache
+		RegistryUpstream:         getEnv("REGISTRY_UPSTREAM", ""),
+		RegistryUpstreamUsername: getEnv("REGISTRY_UPSTREAM_USERNAME", ""),
+		RegistryUpstreamPassword: getEnv("REGISTRY_UPSTREAM_PASSWORD", ""),
+		RegistryUpstreamTagTTL:   getEnv("REGISTRY_UPSTREAM_TAG_TTL", "5m"),
+
+		// Registry storage
+		RegistryRepoQuota: getEnv("REGISTRY_REPO_QUOTA", ""),
 
 		// Hypervisor configuration
 		DefaultHypervisor: getEnv("DEFAULT_HYPERVISOR", "cloud-hypervisor"),
 
+		// Guest console configuration
+		SerialConsoleBaud:   getEnvInt("SERIAL_CONSOLE_BAUD", 115200),
+		KernelLogBufferSize: getEnv("KERNEL_LOG_BUFFER_SIZE", ""),
+
+		// Instance lifecycle configuration
+		StopGracePeriod:            getEnv("STOP_GRACE_PERIOD", "10s"),
+		IdleCheckInterval:          getEnv("IDLE_CHECK_INTERVAL", "1m"),
+		SnapshotBeforeDelete:       getEnvBool("SNAPSHOT_BEFORE_DELETE", false),
+		PreservedSnapshotRetention: getEnv("PRESERVED_SNAPSHOT_RETENTION", "168h"),
+		GuestTimeSyncInterval:      getEnv("GUEST_TIME_SYNC_INTERVAL", "15m"),
+		ExecRecording:              getEnvBool("EXEC_RECORDING", false),
+		ExecKeepaliveInterval:      getEnv("EXEC_KEEPALIVE_INTERVAL", "30s"),
+		ExecIdleTimeout:            getEnv("EXEC_IDLE_TIMEOUT", "0"),
+
 		// Oversubscription ratios (1.0 = no oversubscription)
 		OversubCPU:     getEnvFloat("OVERSUB_CPU", 4.0),
 		OversubMemory:  getEnvFloat("OVERSUB_MEMORY", 1.0),
@@ -282,5 +408,38 @@ func (c *Config) Validate() error {
 	if c.DownloadBurstMultiplier < 1 {
 		return fmt.Errorf("DOWNLOAD_BURST_MULTIPLIER must be >= 1, got %v", c.DownloadBurstMultiplier)
 	}
+	if c.RateLimitRPS < 0 {
+		return fmt.Errorf("RATE_LIMIT_RPS must be >= 0, got %v", c.RateLimitRPS)
+	}
+	if c.RateLimitBurst < 0 {
+		return fmt.Errorf("RATE_LIMIT_BURST must be >= 0, got %v", c.RateLimitBurst)
+	}
+	if c.RateLimitMaxInFlightMutations < 0 {
+		return fmt.Errorf("RATE_LIMIT_MAX_MUTATIONS must be >= 0, got %v", c.RateLimitMaxInFlightMutations)
+	}
+	if c.NetworkMTU != 0 && (c.NetworkMTU < 576 || c.NetworkMTU > 9000) {
+		return fmt.Errorf("NETWORK_MTU must be 0 or between 576 and 9000, got %v", c.NetworkMTU)
+	}
+	if c.BuildMinMemoryMB < 0 || c.BuildMaxMemoryMB < 0 {
+		return fmt.Errorf("BUILD_MIN_MEMORY_MB and BUILD_MAX_MEMORY_MB must be >= 0, got %v and %v", c.BuildMinMemoryMB, c.BuildMaxMemoryMB)
+	}
+	if !c.ListenTCP && c.ListenSocket == "" {
+		return fmt.Errorf("LISTEN_SOCKET must be set when LISTEN_TCP is off")
+	}
+	if mode, err := strconv.ParseUint(c.ListenSocketMode, 8, 32); err != nil || mode > 0777 {
+		return fmt.Errorf("LISTEN_SOCKET_MODE must be octal permissions such as 0660, got %q", c.ListenSocketMode)
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return fmt.Errorf("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	}
+	if c.TLSRedirectPort != "" && c.TLSCertFile == "" {
+		return fmt.Errorf("TLS_REDIRECT_PORT requires TLS_CERT_FILE and TLS_KEY_FILE")
+	}
+	if c.TLSRedirectPort != "" && c.TLSRedirectPort == c.Port {
+		return fmt.Errorf("TLS_REDIRECT_PORT must differ from PORT, got %s", c.TLSRedirectPort)
+	}
+	if c.BuildMaxMemoryMB > 0 && c.BuildMinMemoryMB > c.BuildMaxMemoryMB {
+		return fmt.Errorf("BUILD_MIN_MEMORY_MB (%v) must not exceed BUILD_MAX_MEMORY_MB (%v)", c.BuildMinMemoryMB, c.BuildMaxMemoryMB)
+	}
 	return nil
 }
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenUnix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run", "hypeman.sock")
+	oldMask := syscall.Umask(0o022)
+	defer syscall.Umask(oldMask)
+
+	l, err := listenUnix(path, 0660)
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0660), info.Mode().Perm())
+	assert.Equal(t, 0o022, syscall.Umask(0o022), "the process umask is restored")
+
+	// A socket a server still answers on is left alone
+	_, err = listenUnix(path, 0660)
+	assert.ErrorContains(t, err, "in use")
+
+	// Closing removes the socket
+	require.NoError(t, l.Close())
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+
+	// A stale socket from a run that didn't clean up is replaced
+	stale, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+	l, err = listenUnix(path, 0600)
+	require.NoError(t, err)
+	l.Close()
+
+	// Anything else at the path is never removed
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0644))
+	_, err = listenUnix(path, 0600)
+	assert.ErrorContains(t, err, "not a socket")
+}
+
+// writeTestCert writes a self-signed certificate for 127.0.0.1 and its key
+func writeTestCert(t *testing.T) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "hypeman-test"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certFile, keyFile
+}
+
+func TestServerTLSConfig_ServesWebSockets(t *testing.T) {
+	certFile, keyFile := writeTestCert(t)
+	tlsConfig, err := serverTLSConfig(certFile, keyFile)
+	require.NoError(t, err)
+
+	_, err = serverTLSConfig(certFile, filepath.Join(t.TempDir(), "missing.pem"))
+	assert.Error(t, err)
+
+	upgrader := websocket.Upgrader{}
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer ws.Close()
+		ws.WriteMessage(websocket.TextMessage, []byte("hello"))
+	})}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(tls.NewListener(l, tlsConfig))
+	defer srv.Close()
+
+	dialer := websocket.Dialer{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	ws, _, err := dialer.Dial("wss://"+l.Addr().String()+"/instances/x/exec", nil)
+	require.NoError(t, err)
+	defer ws.Close()
+	_, msg, err := ws.ReadMessage()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(msg))
+}
+
+func TestHTTPSRedirect(t *testing.T) {
+	tests := []struct {
+		host, port, want string
+	}{
+		{"api.example.com:8080", "8443", "https://api.example.com:8443/instances?limit=5"},
+		{"api.example.com", "443", "https://api.example.com/instances?limit=5"},
+		{"[::1]:8080", "8443", "https://[::1]:8443/instances?limit=5"},
+		{"[::1]:8080", "443", "https://[::1]/instances?limit=5"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/instances?limit=5", nil)
+		req.Host = tt.host
+		rec := httptest.NewRecorder()
+		httpsRedirect(tt.port).ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusPermanentRedirect, rec.Code, tt.host)
+		assert.Equal(t, tt.want, rec.Header().Get("Location"), tt.host)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Host = ""
+	rec := httptest.NewRecorder()
+	httpsRedirect("443").ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
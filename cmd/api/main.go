@@ -2,13 +2,16 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	_ "embed"
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -22,12 +25,16 @@ import (
 	"github.com/onkernel/hypeman"
 	"github.com/onkernel/hypeman/cmd/api/api"
 	"github.com/onkernel/hypeman/cmd/api/config"
+	"github.com/onkernel/hypeman/lib/builds"
 	"github.com/onkernel/hypeman/lib/guest"
+	"github.com/onkernel/hypeman/lib/hypervisor/firecracker"
 	"github.com/onkernel/hypeman/lib/hypervisor/qemu"
 	"github.com/onkernel/hypeman/lib/instances"
 	mw "github.com/onkernel/hypeman/lib/middleware"
 	"github.com/onkernel/hypeman/lib/oapi"
 	"github.com/onkernel/hypeman/lib/otel"
+	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/onkernel/hypeman/lib/refindex"
 	"github.com/onkernel/hypeman/lib/vmm"
 	"github.com/riandyrn/otelchi"
 	"golang.org/x/sync/errgroup"
@@ -59,6 +66,8 @@ func run() error {
 		Insecure:          cfg.OtelInsecure,
 		Version:           cfg.Version,
 		Env:               cfg.Env,
+		PrometheusEnabled: cfg.PrometheusEnabled,
+		PrometheusToken:   cfg.PrometheusToken,
 	}
 
 	otelProvider, otelShutdown, err := otel.Init(context.Background(), otelCfg)
@@ -119,10 +128,20 @@ func run() error {
 	if cfg.OtelEnabled {
 		logger.Info("OpenTelemetry enabled", "endpoint", cfg.OtelEndpoint, "service", cfg.OtelServiceName)
 	}
+	if cfg.PrometheusEnabled {
+		logger.Info("Prometheus metrics enabled", "path", "/metrics", "token_required", cfg.PrometheusToken != "")
+	}
 
 	// Validate JWT secret is configured
-	if app.Config.JwtSecret == "" {
-		logger.Warn("JWT_SECRET not configured - API authentication will fail")
+	jwtVerifier := app.JWTVerifier
+	if secrets := mw.SplitJWTSecrets(app.Config.JwtSecret); len(secrets) == 0 {
+		if jwtVerifier.Algorithm() == mw.JWTAlgorithmHS256 {
+			logger.Warn("JWT_SECRET not configured - API authentication will fail")
+		} else {
+			logger.Warn("JWT_SECRET not configured - builder VMs will fail to authenticate to the registry")
+		}
+	} else if len(secrets) > 1 {
+		logger.Info("JWT secret rotation in progress, tokens signed with previous secrets are still accepted", "previous_secrets", len(secrets)-1)
 	}
 
 	// Verify KVM access (required for VM creation)
@@ -136,6 +155,11 @@ func run() error {
 		logger.Warn("QEMU not available - QEMU hypervisor will not work", "error", err)
 	}
 
+	// Check if Firecracker is available (optional - only warn if not present)
+	if _, err := (&firecracker.Starter{}).GetBinaryPath(nil, ""); err != nil {
+		logger.Warn("Firecracker not available - Firecracker hypervisor will not work", "error", err)
+	}
+
 	// Validate log rotation config
 	var logMaxSize datasize.ByteSize
 	if err := logMaxSize.UnmarshalText([]byte(app.Config.LogMaxSize)); err != nil {
@@ -145,6 +169,45 @@ func run() error {
 	if err != nil {
 		return fmt.Errorf("invalid LOG_ROTATE_INTERVAL %q: %w", app.Config.LogRotateInterval, err)
 	}
+	logRetention := instances.LogRetention{MaxFiles: app.Config.LogMaxFiles}
+	if app.Config.LogMaxAge != "" {
+		if logRetention.MaxAge, err = time.ParseDuration(app.Config.LogMaxAge); err != nil {
+			return fmt.Errorf("invalid LOG_MAX_AGE %q: %w", app.Config.LogMaxAge, err)
+		}
+	}
+
+	idleCheckInterval, err := time.ParseDuration(app.Config.IdleCheckInterval)
+	if err != nil || idleCheckInterval <= 0 {
+		return fmt.Errorf("invalid IDLE_CHECK_INTERVAL %q: must be a positive duration", app.Config.IdleCheckInterval)
+	}
+
+	preservedRetention, err := time.ParseDuration(app.Config.PreservedSnapshotRetention)
+	if err != nil || preservedRetention < 0 {
+		return fmt.Errorf("invalid PRESERVED_SNAPSHOT_RETENTION %q: must be a non-negative duration", app.Config.PreservedSnapshotRetention)
+	}
+
+	timeSyncInterval, err := time.ParseDuration(app.Config.GuestTimeSyncInterval)
+	if err != nil || timeSyncInterval < 0 {
+		return fmt.Errorf("invalid GUEST_TIME_SYNC_INTERVAL %q: must be a non-negative duration", app.Config.GuestTimeSyncInterval)
+	}
+
+	if d, err := time.ParseDuration(app.Config.ExecKeepaliveInterval); err != nil || d < 0 {
+		return fmt.Errorf("invalid EXEC_KEEPALIVE_INTERVAL %q: must be a non-negative duration", app.Config.ExecKeepaliveInterval)
+	}
+	if d, err := time.ParseDuration(app.Config.ExecIdleTimeout); err != nil || d < 0 {
+		return fmt.Errorf("invalid EXEC_IDLE_TIMEOUT %q: must be a non-negative duration", app.Config.ExecIdleTimeout)
+	}
+
+	var requestTimeouts mw.RequestTimeouts
+	if requestTimeouts.Read, err = time.ParseDuration(app.Config.RequestTimeoutRead); err != nil || requestTimeouts.Read < 0 {
+		return fmt.Errorf("invalid REQUEST_TIMEOUT_READ %q: must be a non-negative duration", app.Config.RequestTimeoutRead)
+	}
+	if requestTimeouts.Write, err = time.ParseDuration(app.Config.RequestTimeoutWrite); err != nil || requestTimeouts.Write < 0 {
+		return fmt.Errorf("invalid REQUEST_TIMEOUT_WRITE %q: must be a non-negative duration", app.Config.RequestTimeoutWrite)
+	}
+	if requestTimeouts.Long, err = time.ParseDuration(app.Config.RequestTimeoutLong); err != nil || requestTimeouts.Long < 0 {
+		return fmt.Errorf("invalid REQUEST_TIMEOUT_LONG %q: must be a non-negative duration", app.Config.RequestTimeoutLong)
+	}
 
 	// Ensure system files (kernel, initrd) exist before starting server
 	logger.Info("Ensuring system files...")
@@ -156,6 +219,14 @@ func run() error {
 	logger.Info("System files ready",
 		"kernel", kernelVer)
 
+	// Reconcile instance state (kills VMMs of deleted instances, removes stray
+	// instance directories) before deciding which TAPs to keep
+	logger.Info("Reconciling instance state...")
+	if err := app.InstanceManager.Reconcile(app.Ctx); err != nil {
+		logger.Error("failed to reconcile instance state", "error", err)
+		return fmt.Errorf("reconcile instance state: %w", err)
+	}
+
 	// Initialize network manager (creates default network if needed)
 	// Get instance IDs that might have a running VMM for TAP cleanup safety.
 	// Include Unknown state: we couldn't confirm their state, but they might still
@@ -200,6 +271,14 @@ func run() error {
 		return fmt.Errorf("reconcile device state: %w", err)
 	}
 
+	// Register image users so image garbage collection keeps their images
+	if src := instances.NewImageReferenceSource(app.InstanceManager); src != nil {
+		app.ImageManager.AddReferenceSource(src)
+	}
+	app.ImageManager.AddReferenceSource(builds.NewImageReferenceSource(app.BuildManager))
+	// Pushed images and their blobs are kept until deleted from the registry
+	app.ImageManager.AddReferenceSource(app.Registry)
+
 	// Initialize ingress manager (starts Caddy daemon and DNS server for dynamic upstreams)
 	logger.Info("Initializing ingress manager...")
 	if err := app.IngressManager.Initialize(app.Ctx); err != nil {
@@ -207,6 +286,23 @@ func run() error {
 		return fmt.Errorf("initialize ingress manager: %w", err)
 	}
 	logger.Info("Ingress manager initialized", "listen_addr", cfg.CaddyListenAddress, "admin", app.IngressManager.AdminURL())
+	// Instances an ingress routes to are only deleted with cascade or force
+	app.InstanceManager.SetReferenceChecker(app.IngressManager)
+
+	// Index which resources reference which. The managers keep it up to
+	// date from here on; it is rebuilt from their metadata in case the
+	// persisted copy is stale.
+	refIndex, err := refindex.Open(paths.New(app.Config.DataDir).ReferenceIndex())
+	if err != nil {
+		return fmt.Errorf("open reference index: %w", err)
+	}
+	app.InstanceManager.SetReferenceIndex(refIndex)
+	app.IngressManager.SetReferenceIndex(refIndex)
+	app.BuildManager.SetReferenceIndex(refIndex)
+	if err := refIndex.Rebuild(app.Ctx, app.InstanceManager, app.IngressManager, app.BuildManager); err != nil {
+		logger.Error("failed to rebuild reference index", "error", err)
+		return fmt.Errorf("rebuild reference index: %w", err)
+	}
 
 	// Create router
 	r := chi.NewRouter()
@@ -238,6 +334,35 @@ func run() error {
 	// See: https://github.com/oapi-codegen/nethttp-middleware#usage
 	spec.Servers = nil
 
+	// Audit log for mutating API requests (nil when disabled)
+	var auditLogger *mw.AuditLogger
+	if app.Config.AuditLogEnabled {
+		var auditMaxSize datasize.ByteSize
+		if err := auditMaxSize.UnmarshalText([]byte(app.Config.AuditLogMaxSize)); err != nil {
+			return fmt.Errorf("invalid AUDIT_LOG_MAX_SIZE %q: %w", app.Config.AuditLogMaxSize, err)
+		}
+		auditLogger, err = mw.NewAuditLogger(mw.AuditConfig{
+			Path:         paths.New(app.Config.DataDir).AuditLog(),
+			MaxBytes:     int64(auditMaxSize),
+			MaxFiles:     app.Config.AuditLogMaxFiles,
+			IncludeReads: app.Config.AuditLogIncludeReads,
+			Spec:         spec,
+		})
+		if err != nil {
+			return fmt.Errorf("initialize audit log: %w", err)
+		}
+		defer auditLogger.Close()
+		logger.Info("audit logging enabled", "max_size", auditMaxSize, "max_files", app.Config.AuditLogMaxFiles, "include_reads", app.Config.AuditLogIncludeReads)
+	}
+
+	// Per-subject rate and concurrency limits (nil when unconfigured).
+	// Applied after authentication; /spec.yaml and /spec.json are exempt.
+	rateLimiter := mw.NewRateLimiter(mw.RateLimitConfig{
+		RequestsPerSecond:    app.Config.RateLimitRPS,
+		Burst:                app.Config.RateLimitBurst,
+		MaxInFlightMutations: app.Config.RateLimitMaxInFlightMutations,
+	})
+
 	// Custom exec endpoint (outside OpenAPI spec, uses WebSocket)
 	// Note: No otelchi here as WebSocket doesn't work well with tracing middleware
 	r.With(
@@ -246,7 +371,9 @@ func run() error {
 		middleware.Recoverer,
 		mw.InjectLogger(logger),
 		mw.AccessLogger(accessLogger),
-		mw.JwtAuth(app.Config.JwtSecret),
+		mw.AuditOperation(auditLogger, "execInstance"),
+		mw.JwtAuth(jwtVerifier, app.APIKeyManager),
+		mw.RateLimit(rateLimiter),
 		mw.ResolveResource(app.ApiService.NewResolvers(), api.ResolverErrorResponder),
 	).Get("/instances/{id}/exec", app.ApiService.ExecHandler)
 
@@ -257,7 +384,9 @@ func run() error {
 		middleware.Recoverer,
 		mw.InjectLogger(logger),
 		mw.AccessLogger(accessLogger),
-		mw.JwtAuth(app.Config.JwtSecret),
+		mw.AuditOperation(auditLogger, "cpInstance"),
+		mw.JwtAuth(jwtVerifier, app.APIKeyManager),
+		mw.RateLimit(rateLimiter),
 		mw.ResolveResource(app.ApiService.NewResolvers(), api.ResolverErrorResponder),
 	).Get("/instances/{id}/cp", app.ApiService.CpHandler)
 
@@ -267,7 +396,7 @@ func run() error {
 		r.Use(middleware.RealIP)
 		r.Use(middleware.Logger)
 		r.Use(middleware.Recoverer)
-		r.Use(mw.JwtAuth(app.Config.JwtSecret))
+		r.Use(mw.JwtAuth(jwtVerifier, app.APIKeyManager))
 		r.Mount("/", app.Registry.Handler())
 	})
 
@@ -302,16 +431,41 @@ func run() error {
 			})
 		}
 
-		r.Use(middleware.Timeout(60 * time.Second))
+		// Audit before request validation so rejected requests are recorded;
+		// authentication and resource resolution fill in the user and target
+		r.Use(mw.Audit(auditLogger))
+
+		// Request timeouts by class; needs the route pattern, which chi has
+		// matched by the time group middleware runs
+		r.Use(mw.Timeout(requestTimeouts))
 
 		// OpenAPI request validation with authentication
 		validatorOptions := &nethttpmiddleware.Options{
 			Options: openapi3filter.Options{
-				AuthenticationFunc: mw.OapiAuthenticationFunc(app.Config.JwtSecret),
+				AuthenticationFunc: mw.OapiAuthenticationFunc(jwtVerifier, app.APIKeyManager),
 			},
-			ErrorHandler: mw.OapiErrorHandler,
+			ErrorHandlerWithOpts: mw.OapiErrorHandlerWithOpts,
 		}
-		r.Use(nethttpmiddleware.OapiRequestValidatorWithOptions(spec, validatorOptions))
+		// Build uploads skip body validation, which would read the whole
+		// body into memory; the handler streams the source to disk and
+		// checks the other fields itself
+		validator := nethttpmiddleware.OapiRequestValidatorWithOptions(spec, validatorOptions)
+		uploadOptions := *validatorOptions
+		uploadOptions.Options.ExcludeRequestBody = true
+		uploadValidator := nethttpmiddleware.OapiRequestValidatorWithOptions(spec, &uploadOptions)
+		r.Use(func(next http.Handler) http.Handler {
+			validated, upload := validator(next), uploadValidator(next)
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost && r.URL.Path == "/builds" {
+					upload.ServeHTTP(w, r)
+					return
+				}
+				validated.ServeHTTP(w, r)
+			})
+		})
+
+		// Rate limiting keyed on the JWT subject set during validation
+		r.Use(mw.RateLimit(rateLimiter))
 
 		// Resource resolver middleware - resolves IDs/names/prefixes before handlers
 		// Enriches context with resolved resource and logger with resolved ID
@@ -328,6 +482,12 @@ func run() error {
 	})
 
 	// Unauthenticated endpoints (outside group)
+	// /metrics is mounted here so scrapes skip JWT auth and aren't counted in
+	// the HTTP metrics; PROMETHEUS_TOKEN can protect it instead.
+	if otelProvider != nil && otelProvider.MetricsHandler != nil {
+		r.Method(http.MethodGet, "/metrics", otelProvider.MetricsHandler)
+	}
+
 	r.Get("/spec.yaml", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/vnd.oai.openapi")
 		w.Write(hypeman.OpenAPIYAML)
@@ -346,7 +506,12 @@ func run() error {
 
 	r.Get("/swagger", api.SwaggerUIHandler)
 
-	// Create HTTP server
+	// Liveness and readiness probes for orchestrators
+	r.Get("/healthz", api.LivenessHandler)
+	r.With(mw.InjectLogger(logger)).Get("/readyz", api.ReadinessHandler(readinessChecks(app)))
+
+	// Create HTTP server. It serves the same routes on each listener, so the
+	// registry, exec and SSE endpoints work over the Unix socket too.
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%s", app.Config.Port),
 		Handler: r,
@@ -361,15 +526,74 @@ func run() error {
 		return err
 	}
 
-	// Run the server
-	grp.Go(func() error {
-		logger.Info("starting hypeman API", "port", app.Config.Port)
-		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			logger.Error("http server error", "error", err)
+	// With a certificate configured, the TCP port serves HTTPS. The Unix
+	// socket stays plain, since only local clients reach it.
+	var tlsConfig *tls.Config
+	if app.Config.TLSCertFile != "" {
+		var err error
+		tlsConfig, err = serverTLSConfig(app.Config.TLSCertFile, app.Config.TLSKeyFile)
+		if err != nil {
+			logger.Error("failed to load API TLS certificate", "cert", app.Config.TLSCertFile, "error", err)
 			return err
 		}
-		return nil
-	})
+	}
+
+	// Listen on the TCP port, the Unix socket, or both
+	var listeners []net.Listener
+	if app.Config.ListenTCP {
+		l, err := net.Listen("tcp", srv.Addr)
+		if err != nil {
+			logger.Error("failed to listen", "addr", srv.Addr, "error", err)
+			return err
+		}
+		if tlsConfig != nil {
+			l = tls.NewListener(l, tlsConfig)
+		}
+		logger.Info("starting hypeman API", "port", app.Config.Port, "tls", tlsConfig != nil)
+		listeners = append(listeners, l)
+	}
+	if app.Config.ListenSocket != "" {
+		mode, _ := strconv.ParseUint(app.Config.ListenSocketMode, 8, 32) // Validated at startup
+		l, err := listenUnix(app.Config.ListenSocket, os.FileMode(mode))
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			logger.Error("failed to listen on socket", "socket", app.Config.ListenSocket, "error", err)
+			return err
+		}
+		logger.Info("starting hypeman API", "socket", app.Config.ListenSocket, "mode", app.Config.ListenSocketMode)
+		listeners = append(listeners, l)
+	}
+
+	// Run the server on each listener. Shutdown closes them all, which
+	// removes the Unix socket.
+	for _, l := range listeners {
+		grp.Go(func() error {
+			if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("http server error", "addr", l.Addr().String(), "error", err)
+				return err
+			}
+			return nil
+		})
+	}
+
+	// Plain HTTP on the redirect port only sends clients to HTTPS
+	var redirectSrv *http.Server
+	if app.Config.TLSRedirectPort != "" {
+		redirectSrv = &http.Server{
+			Addr:    fmt.Sprintf(":%s", app.Config.TLSRedirectPort),
+			Handler: httpsRedirect(app.Config.Port),
+		}
+		grp.Go(func() error {
+			logger.Info("redirecting HTTP to HTTPS", "port", app.Config.TLSRedirectPort)
+			if err := redirectSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("http redirect server error", "error", err)
+				return err
+			}
+			return nil
+		})
+	}
 
 	// Shutdown handler
 	grp.Go(func() error {
@@ -386,6 +610,9 @@ func run() error {
 			return err
 		}
 		logger.Info("http server shutdown complete")
+		if redirectSrv != nil {
+			redirectSrv.Shutdown(shutdownCtx)
+		}
 
 		// Shutdown ingress manager (stops Caddy if CADDY_STOP_ON_SHUTDOWN=true)
 		if err := app.IngressManager.Shutdown(shutdownCtx); err != nil {
@@ -403,21 +630,98 @@ func run() error {
 		ticker := time.NewTicker(logRotateInterval)
 		defer ticker.Stop()
 
-		logger.Info("log rotation scheduler started", "interval", app.Config.LogRotateInterval, "max_size", logMaxSize, "max_files", app.Config.LogMaxFiles)
+		logger.Info("log rotation scheduler started", "interval", app.Config.LogRotateInterval, "max_size", logMaxSize, "max_files", logRetention.MaxFiles, "max_age", logRetention.MaxAge, "compress", app.Config.LogCompress)
 		for {
 			select {
 			case <-gctx.Done():
 				return nil
 			case <-ticker.C:
-				if err := app.InstanceManager.RotateLogs(gctx, int64(logMaxSize), app.Config.LogMaxFiles); err != nil {
+				if err := app.InstanceManager.RotateLogs(gctx, int64(logMaxSize), logRetention, app.Config.LogCompress); err != nil {
 					logger.Error("log rotation failed", "error", err)
 				} else {
-					logger.Info("log rotation completed", "max_size", logMaxSize, "max_files", app.Config.LogMaxFiles)
+					logger.Info("log rotation completed", "max_size", logMaxSize, "max_files", logRetention.MaxFiles, "max_age", logRetention.MaxAge)
 				}
 			}
 		}
 	})
 
+	// Idle auto-stop scheduler. The context carries the app logger, so
+	// auto-stops are also written to the instance's hypeman log.
+	grp.Go(func() error {
+		ticker := time.NewTicker(idleCheckInterval)
+		defer ticker.Stop()
+
+		logger.Info("idle auto-stop scheduler started", "interval", app.Config.IdleCheckInterval)
+		for {
+			select {
+			case <-gctx.Done():
+				return nil
+			case <-ticker.C:
+				if err := app.InstanceManager.StopIdleInstances(gctx); err != nil {
+					logger.Error("idle instance check failed", "error", err)
+				}
+			}
+		}
+	})
+
+	// Guest clock sync, for drift in long-running instances. Restores sync
+	// on their own.
+	if timeSyncInterval > 0 {
+		grp.Go(func() error {
+			ticker := time.NewTicker(timeSyncInterval)
+			defer ticker.Stop()
+
+			logger.Info("guest time sync started", "interval", timeSyncInterval)
+			for {
+				select {
+				case <-gctx.Done():
+					return nil
+				case <-ticker.C:
+					if err := app.InstanceManager.SyncGuestClocks(gctx); err != nil {
+						logger.Error("guest time sync failed", "error", err)
+					}
+				}
+			}
+		})
+	}
+
+	// Preserved snapshot GC. Checked hourly, as retention is counted in days
+	// rather than minutes; the first check runs at startup.
+	grp.Go(func() error {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		logger.Info("preserved snapshot GC started", "retention", preservedRetention)
+		for {
+			if err := app.InstanceManager.PrunePreservedSnapshots(gctx, preservedRetention); err != nil {
+				logger.Error("preserved snapshot GC failed", "error", err)
+			}
+			select {
+			case <-gctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+
+	// SIGUSR1 toggles drain mode, for maintenance scripts without API credentials
+	grp.Go(func() error {
+		drainSignals := make(chan os.Signal, 1)
+		signal.Notify(drainSignals, syscall.SIGUSR1)
+		defer signal.Stop(drainSignals)
+
+		for {
+			select {
+			case <-gctx.Done():
+				return nil
+			case <-drainSignals:
+				draining := app.ApiService.Draining() == nil
+				logger.Info("SIGUSR1 received, toggling drain mode", "draining", draining)
+				app.ApiService.SetDraining(gctx, draining)
+			}
+		}
+	})
+
 	err = grp.Wait()
 	slog.Info("all goroutines finished")
 	return err
@@ -438,3 +742,53 @@ func checkKVMAccess() error {
 	f.Close()
 	return nil
 }
+
+// readinessChecks returns the subsystem checks reported by /readyz
+func readinessChecks(app *application) []api.ReadinessCheck {
+	return []api.ReadinessCheck{
+		{Name: "drain", Check: func(ctx context.Context) error {
+			return app.ApiService.Draining()
+		}},
+		{Name: "kvm", Check: func(ctx context.Context) error {
+			return checkKVMAccess()
+		}},
+		{Name: "network", Check: func(ctx context.Context) error {
+			if !app.NetworkManager.Initialized() {
+				return fmt.Errorf("network manager not initialized")
+			}
+			return nil
+		}},
+		{Name: "ingress", Check: func(ctx context.Context) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, app.IngressManager.AdminURL()+"/config/", nil)
+			if err != nil {
+				return err
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return fmt.Errorf("caddy admin API unreachable: %w", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("caddy admin API returned %d", resp.StatusCode)
+			}
+			return nil
+		}},
+		{Name: "system_files", Check: func(ctx context.Context) error {
+			kernelPath, err := app.SystemManager.GetKernelPath(app.SystemManager.GetDefaultKernelVersion())
+			if err != nil {
+				return err
+			}
+			if _, err := os.Stat(kernelPath); err != nil {
+				return fmt.Errorf("kernel: %w", err)
+			}
+			initrdPath, err := app.SystemManager.GetInitrdPath()
+			if err != nil {
+				return fmt.Errorf("initrd: %w", err)
+			}
+			if _, err := os.Stat(initrdPath); err != nil {
+				return fmt.Errorf("initrd: %w", err)
+			}
+			return nil
+		}},
+	}
+}
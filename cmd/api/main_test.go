@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -28,6 +29,10 @@ func generateValidJWT(userID string) (string, error) {
 }
 
 func setupTestRouter(t *testing.T) http.Handler {
+	return setupTestRouterWithVerifier(t, mw.HMACVerifier(testJWTSecret))
+}
+
+func setupTestRouterWithVerifier(t *testing.T, verifier *mw.JWTVerifier) http.Handler {
 	spec, err := oapi.GetSwagger()
 	require.NoError(t, err)
 	spec.Servers = nil
@@ -35,9 +40,9 @@ func setupTestRouter(t *testing.T) http.Handler {
 	r := chi.NewRouter()
 	r.Use(nethttpmiddleware.OapiRequestValidatorWithOptions(spec, &nethttpmiddleware.Options{
 		Options: openapi3filter.Options{
-			AuthenticationFunc: mw.OapiAuthenticationFunc(testJWTSecret),
+			AuthenticationFunc: mw.OapiAuthenticationFunc(verifier, nil),
 		},
-		ErrorHandler: mw.OapiErrorHandler,
+		ErrorHandlerWithOpts: mw.OapiErrorHandlerWithOpts,
 	}))
 
 	// Simple handler for testing
@@ -92,3 +97,44 @@ func TestMiddleware_ValidJWT(t *testing.T) {
 
 	assert.Equal(t, http.StatusCreated, w.Code)
 }
+
+func TestMiddleware_JWTAudienceAndIssuer(t *testing.T) {
+	verifier, err := mw.NewJWTVerifier(mw.JWTConfig{Secret: testJWTSecret, Audience: "hypeman", Issuer: "https://idp.example.com"})
+	require.NoError(t, err)
+	router := setupTestRouterWithVerifier(t, verifier)
+
+	tests := []struct {
+		name     string
+		claims   jwt.MapClaims
+		wantCode int
+		wantErr  string
+	}{
+		{"valid", jwt.MapClaims{"aud": "hypeman", "iss": "https://idp.example.com"}, http.StatusCreated, ""},
+		{"wrong audience", jwt.MapClaims{"aud": "billing", "iss": "https://idp.example.com"}, http.StatusUnauthorized, "invalid_audience"},
+		{"missing issuer", jwt.MapClaims{"aud": "hypeman"}, http.StatusUnauthorized, "invalid_issuer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.claims["sub"] = "user-123"
+			tt.claims["exp"] = time.Now().Add(time.Hour).Unix()
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, tt.claims).SignedString([]byte(testJWTSecret))
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/images", bytes.NewBufferString(`{"name":"docker.io/library/nginx:latest"}`))
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			if tt.wantErr != "" {
+				var body struct {
+					Code string `json:"code"`
+				}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				assert.Equal(t, tt.wantErr, body.Code)
+			}
+		})
+	}
+}
@@ -9,11 +9,13 @@ import (
 	"github.com/google/wire"
 	"github.com/onkernel/hypeman/cmd/api/api"
 	"github.com/onkernel/hypeman/cmd/api/config"
+	"github.com/onkernel/hypeman/lib/apikeys"
 	"github.com/onkernel/hypeman/lib/builds"
 	"github.com/onkernel/hypeman/lib/devices"
 	"github.com/onkernel/hypeman/lib/images"
 	"github.com/onkernel/hypeman/lib/ingress"
 	"github.com/onkernel/hypeman/lib/instances"
+	"github.com/onkernel/hypeman/lib/middleware"
 	"github.com/onkernel/hypeman/lib/network"
 	"github.com/onkernel/hypeman/lib/providers"
 	"github.com/onkernel/hypeman/lib/registry"
@@ -37,6 +39,8 @@ type application struct {
 	BuildManager    builds.Manager
 	ResourceManager *resources.Manager
 	Registry        *registry.Registry
+	APIKeyManager   apikeys.Manager
+	JWTVerifier     *middleware.JWTVerifier
 	ApiService      *api.ApiService
 }
 
@@ -57,6 +61,8 @@ func initializeApp() (*application, func(), error) {
 		providers.ProvideBuildManager,
 		providers.ProvideResourceManager,
 		providers.ProvideRegistry,
+		providers.ProvideAPIKeyManager,
+		providers.ProvideJWTVerifier,
 		api.New,
 		wire.Struct(new(application), "*"),
 	))
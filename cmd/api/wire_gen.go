@@ -10,11 +10,13 @@ import (
 	"context"
 	"github.com/onkernel/hypeman/cmd/api/api"
 	"github.com/onkernel/hypeman/cmd/api/config"
+	"github.com/onkernel/hypeman/lib/apikeys"
 	"github.com/onkernel/hypeman/lib/builds"
 	"github.com/onkernel/hypeman/lib/devices"
 	"github.com/onkernel/hypeman/lib/images"
 	"github.com/onkernel/hypeman/lib/ingress"
 	"github.com/onkernel/hypeman/lib/instances"
+	"github.com/onkernel/hypeman/lib/middleware"
 	"github.com/onkernel/hypeman/lib/network"
 	"github.com/onkernel/hypeman/lib/providers"
 	"github.com/onkernel/hypeman/lib/registry"
@@ -55,7 +57,11 @@ func initializeApp() (*application, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	buildsManager, err := providers.ProvideBuildManager(paths, config, instancesManager, volumesManager, logger)
+	registry, err := providers.ProvideRegistry(paths, config, manager)
+	if err != nil {
+		return nil, nil, err
+	}
+	buildsManager, err := providers.ProvideBuildManager(paths, config, instancesManager, volumesManager, manager, registry, logger)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -63,11 +69,12 @@ func initializeApp() (*application, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	registry, err := providers.ProvideRegistry(paths, manager)
+	apikeysManager := providers.ProvideAPIKeyManager(paths)
+	jwtVerifier, err := providers.ProvideJWTVerifier(config)
 	if err != nil {
 		return nil, nil, err
 	}
-	apiService := api.New(config, manager, instancesManager, volumesManager, networkManager, devicesManager, ingressManager, buildsManager, resourcesManager)
+	apiService := api.New(config, manager, instancesManager, volumesManager, networkManager, devicesManager, ingressManager, buildsManager, resourcesManager, apikeysManager, jwtVerifier)
 	mainApplication := &application{
 		Ctx:             context,
 		Logger:          logger,
@@ -82,6 +89,8 @@ func initializeApp() (*application, func(), error) {
 		BuildManager:    buildsManager,
 		ResourceManager: resourcesManager,
 		Registry:        registry,
+		APIKeyManager:   apikeysManager,
+		JWTVerifier:     jwtVerifier,
 		ApiService:      apiService,
 	}
 	return mainApplication, func() {
@@ -105,5 +114,7 @@ type application struct {
 	BuildManager    builds.Manager
 	ResourceManager *resources.Manager
 	Registry        *registry.Registry
+	APIKeyManager   apikeys.Manager
+	JWTVerifier     *middleware.JWTVerifier
 	ApiService      *api.ApiService
 }
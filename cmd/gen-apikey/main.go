@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/onkernel/hypeman/lib/apikeys"
+	"github.com/onkernel/hypeman/lib/paths"
+)
+
+func main() {
+	dataDir := os.Getenv("DATA_DIR")
+	if dataDir == "" {
+		dataDir = "/var/lib/hypeman"
+	}
+	subject := flag.String("subject", "test-user", "Subject (user ID) the key acts as")
+	name := flag.String("name", "", "Label for the key")
+	scopes := flag.String("scopes", "", "Comma-separated scopes like instances:write,images:read (empty = full access)")
+	flag.Parse()
+
+	req := apikeys.CreateAPIKeyRequest{
+		Name:    *name,
+		Subject: *subject,
+	}
+	if *scopes != "" {
+		req.Scopes = strings.Split(*scopes, ",")
+	}
+
+	// Keys are stored in the data directory and read on every request, so a
+	// running server accepts the new key immediately
+	key, secret, err := apikeys.NewManager(paths.New(dataDir)).Create(context.Background(), req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating API key: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Fprintf(os.Stderr, "Created API key %s for %s\n", key.ID, key.Subject)
+	fmt.Println(secret)
+}
@@ -4,27 +4,70 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
 func main() {
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		fmt.Fprintf(os.Stderr, "Error: JWT_SECRET environment variable is not set\n")
-		os.Exit(1)
-	}
 	userID := flag.String("user-id", "test-user", "User ID to include in the JWT token")
+	alg := flag.String("alg", "HS256", "Signing algorithm: HS256 (signed with JWT_SECRET), RS256 or ES256 (signed with -private-key)")
+	privateKeyFile := flag.String("private-key", "", "PEM private key for RS256 and ES256")
+	keyID := flag.String("kid", "", "Key ID header, selecting the verification key from a JWKS")
+	audience := flag.String("aud", envOr("JWT_AUDIENCE", "hypeman"), "Audience claim (defaults to JWT_AUDIENCE)")
+	issuer := flag.String("iss", envOr("JWT_ISSUER", "hypeman"), "Issuer claim (defaults to JWT_ISSUER)")
 	flag.Parse()
 
+	method := jwt.GetSigningMethod(strings.ToUpper(*alg))
+	var key interface{}
+	switch method {
+	case jwt.SigningMethodHS256:
+		// During a rotation JWT_SECRET is a comma-separated keyset; tokens are
+		// signed with its first (primary) secret
+		jwtSecret, _, _ := strings.Cut(os.Getenv("JWT_SECRET"), ",")
+		jwtSecret = strings.TrimSpace(jwtSecret)
+		if jwtSecret == "" {
+			fmt.Fprintf(os.Stderr, "Error: JWT_SECRET environment variable is not set\n")
+			os.Exit(1)
+		}
+		key = []byte(jwtSecret)
+	case jwt.SigningMethodRS256, jwt.SigningMethodES256:
+		if *privateKeyFile == "" {
+			fmt.Fprintf(os.Stderr, "Error: -private-key is required for %s\n", method.Alg())
+			os.Exit(1)
+		}
+		keyPEM, err := os.ReadFile(*privateKeyFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading private key: %v\n", err)
+			os.Exit(1)
+		}
+		if method == jwt.SigningMethodRS256 {
+			key, err = jwt.ParseRSAPrivateKeyFromPEM(keyPEM)
+		} else {
+			key, err = jwt.ParseECPrivateKeyFromPEM(keyPEM)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error parsing private key: %v\n", err)
+			os.Exit(1)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unsupported algorithm %q (want HS256, RS256 or ES256)\n", *alg)
+		os.Exit(1)
+	}
+
 	claims := jwt.MapClaims{
 		"sub": *userID,
+		"aud": *audience,
+		"iss": *issuer,
 		"iat": time.Now().Unix(),
 		"exp": time.Now().Add(24 * time.Hour).Unix(),
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(jwtSecret))
+	token := jwt.NewWithClaims(method, claims)
+	if *keyID != "" {
+		token.Header["kid"] = *keyID
+	}
+	tokenString, err := token.SignedString(key)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error generating token: %v\n", err)
 		os.Exit(1)
@@ -32,3 +75,11 @@ func main() {
 
 	fmt.Println(tokenString)
 }
+
+// envOr returns the environment variable key, or def if it is unset
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
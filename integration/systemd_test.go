@@ -71,7 +71,7 @@ func TestSystemdMode(t *testing.T) {
 		MaxTotalMemory:       0,
 	}
 
-	instanceManager := instances.NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, "", nil, nil)
+	instanceManager := instances.NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, 0, instances.ConsoleConfig{}, "", nil, nil)
 
 	// Cleanup any orphaned instances
 	t.Cleanup(func() {
@@ -0,0 +1,10 @@
+package apikeys
+
+import "errors"
+
+var (
+	ErrNotFound       = errors.New("api key not found")
+	ErrInvalidKey     = errors.New("invalid api key")
+	ErrRevoked        = errors.New("api key has been revoked")
+	ErrInvalidRequest = errors.New("invalid request")
+)
@@ -0,0 +1,238 @@
+// Package apikeys manages long-lived, revocable API keys.
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nrednav/cuid2"
+	"github.com/onkernel/hypeman/lib/paths"
+)
+
+// keyPrefix starts every API key, so keys are recognizable in config files
+// and secret scanners. The full format is "hm_<id>_<secret>".
+const keyPrefix = "hm_"
+
+// Manager creates, lists, revokes and authenticates API keys.
+type Manager interface {
+	// Create stores a new key and returns it along with the full key string,
+	// which is not stored and can't be recovered later.
+	Create(ctx context.Context, req CreateAPIKeyRequest) (*APIKey, string, error)
+	List(ctx context.Context) ([]APIKey, error)
+	Get(ctx context.Context, id string) (*APIKey, error)
+	Revoke(ctx context.Context, id string) (*APIKey, error)
+	// Authenticate returns the key matching a full key string. Returns
+	// ErrInvalidKey if it doesn't match and ErrRevoked if it was revoked.
+	Authenticate(ctx context.Context, key string) (*APIKey, error)
+}
+
+// Filesystem structure:
+// {dataDir}/apikeys/{id}.json   # Key record with a SHA-256 hash of the secret
+//
+// Keys are read from disk on every Authenticate, so revocations and keys
+// created by the gen-apikey tool take effect immediately.
+
+// storedKey is the API key record persisted to disk
+type storedKey struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Subject    string     `json:"subject"`
+	Scopes     []string   `json:"scopes,omitempty"`
+	SecretHash string     `json:"secret_hash"` // hex SHA-256 of the secret
+	CreatedAt  time.Time  `json:"created_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+type manager struct {
+	paths *paths.Paths
+	mu    sync.Mutex // serializes writes
+}
+
+// NewManager creates an API key manager storing keys under the data directory.
+func NewManager(p *paths.Paths) Manager {
+	return &manager{paths: p}
+}
+
+func (m *manager) Create(ctx context.Context, req CreateAPIKeyRequest) (*APIKey, string, error) {
+	if req.Subject == "" {
+		return nil, "", fmt.Errorf("%w: subject is required", ErrInvalidRequest)
+	}
+	if err := validateScopes(req.Scopes); err != nil {
+		return nil, "", err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("generate secret: %w", err)
+	}
+	secretHex := hex.EncodeToString(secret)
+
+	stored := &storedKey{
+		ID:         cuid2.Generate(),
+		Name:       req.Name,
+		Subject:    req.Subject,
+		Scopes:     req.Scopes,
+		SecretHash: hashSecret(secretHex),
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.save(stored); err != nil {
+		return nil, "", err
+	}
+	return stored.toAPIKey(), keyPrefix + stored.ID + "_" + secretHex, nil
+}
+
+func (m *manager) List(ctx context.Context) ([]APIKey, error) {
+	entries, err := os.ReadDir(m.paths.APIKeysDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []APIKey{}, nil
+		}
+		return nil, fmt.Errorf("read api keys directory: %w", err)
+	}
+
+	keys := []APIKey{}
+	for _, e := range entries {
+		id, ok := strings.CutSuffix(e.Name(), ".json")
+		if !ok || e.IsDir() {
+			continue
+		}
+		stored, err := m.load(id)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, *stored.toAPIKey())
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.Before(keys[j].CreatedAt) })
+	return keys, nil
+}
+
+func (m *manager) Get(ctx context.Context, id string) (*APIKey, error) {
+	stored, err := m.load(id)
+	if err != nil {
+		return nil, err
+	}
+	return stored.toAPIKey(), nil
+}
+
+func (m *manager) Revoke(ctx context.Context, id string) (*APIKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, err := m.load(id)
+	if err != nil {
+		return nil, err
+	}
+	if stored.RevokedAt == nil {
+		now := time.Now().UTC()
+		stored.RevokedAt = &now
+		if err := m.save(stored); err != nil {
+			return nil, err
+		}
+	}
+	return stored.toAPIKey(), nil
+}
+
+func (m *manager) Authenticate(ctx context.Context, key string) (*APIKey, error) {
+	id, secret, ok := parseKey(key)
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+
+	stored, err := m.load(id)
+	if err != nil {
+		if err == ErrNotFound {
+			return nil, ErrInvalidKey
+		}
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(stored.SecretHash)) != 1 {
+		return nil, ErrInvalidKey
+	}
+	if stored.RevokedAt != nil {
+		return nil, ErrRevoked
+	}
+	return stored.toAPIKey(), nil
+}
+
+// parseKey splits "hm_<id>_<secret>" into its parts
+func parseKey(key string) (id, secret string, ok bool) {
+	rest, ok := strings.CutPrefix(key, keyPrefix)
+	if !ok {
+		return "", "", false
+	}
+	id, secret, ok = strings.Cut(rest, "_")
+	if !ok || id == "" || secret == "" || strings.ContainsAny(id, "/.") {
+		return "", "", false
+	}
+	return id, secret, true
+}
+
+// hashSecret hashes a key's secret for storage. Secrets are 256 random bits,
+// so a fast hash is enough; there's nothing to brute force.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func (m *manager) load(id string) (*storedKey, error) {
+	if id == "" || strings.ContainsAny(id, "/.") {
+		return nil, ErrNotFound
+	}
+	data, err := os.ReadFile(m.paths.APIKeyMetadata(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("read api key: %w", err)
+	}
+	var stored storedKey
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("unmarshal api key: %w", err)
+	}
+	return &stored, nil
+}
+
+// save writes a key record atomically, readable only by the server's user
+func (m *manager) save(stored *storedKey) error {
+	if err := os.MkdirAll(m.paths.APIKeysDir(), 0700); err != nil {
+		return fmt.Errorf("create api keys directory: %w", err)
+	}
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal api key: %w", err)
+	}
+	path := m.paths.APIKeyMetadata(stored.ID)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("write api key: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("write api key: %w", err)
+	}
+	return nil
+}
+
+func (s *storedKey) toAPIKey() *APIKey {
+	return &APIKey{
+		ID:        s.ID,
+		Name:      s.Name,
+		Subject:   s.Subject,
+		Scopes:    s.Scopes,
+		CreatedAt: s.CreatedAt,
+		RevokedAt: s.RevokedAt,
+	}
+}
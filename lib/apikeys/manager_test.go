@@ -0,0 +1,93 @@
+package apikeys
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAndAuthenticate(t *testing.T) {
+	p := paths.New(t.TempDir())
+	m := NewManager(p)
+	ctx := context.Background()
+
+	key, secret, err := m.Create(ctx, CreateAPIKeyRequest{Name: "ci", Subject: "ci-bot", Scopes: []string{"instances:write"}})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, "hm_"+key.ID+"_"))
+
+	// Only a hash of the secret is stored
+	data, err := os.ReadFile(p.APIKeyMetadata(key.ID))
+	require.NoError(t, err)
+	_, rawSecret, _ := parseKey(secret)
+	assert.NotContains(t, string(data), rawSecret)
+
+	got, err := m.Authenticate(ctx, secret)
+	require.NoError(t, err)
+	assert.Equal(t, "ci-bot", got.Subject)
+	assert.Equal(t, []string{"instances:write"}, got.Scopes)
+
+	tampered := secret[:len(secret)-1] + "0"
+	if tampered == secret {
+		tampered = secret[:len(secret)-1] + "1"
+	}
+	_, err = m.Authenticate(ctx, tampered)
+	assert.ErrorIs(t, err, ErrInvalidKey)
+	_, err = m.Authenticate(ctx, "hm_missing_abc")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+	_, err = m.Authenticate(ctx, "not-a-key")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func TestRevoke(t *testing.T) {
+	p := paths.New(t.TempDir())
+	ctx := context.Background()
+
+	key, secret, err := NewManager(p).Create(ctx, CreateAPIKeyRequest{Subject: "ci-bot"})
+	require.NoError(t, err)
+
+	// Revoking through a separate manager (e.g. another process) takes effect
+	// immediately, since keys aren't cached
+	revoked, err := NewManager(p).Revoke(ctx, key.ID)
+	require.NoError(t, err)
+	assert.True(t, revoked.Revoked())
+
+	_, err = NewManager(p).Authenticate(ctx, secret)
+	assert.ErrorIs(t, err, ErrRevoked)
+
+	keys, err := NewManager(p).List(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.NotNil(t, keys[0].RevokedAt)
+
+	_, err = NewManager(p).Revoke(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCreateValidation(t *testing.T) {
+	m := NewManager(paths.New(t.TempDir()))
+	ctx := context.Background()
+
+	_, _, err := m.Create(ctx, CreateAPIKeyRequest{})
+	assert.ErrorIs(t, err, ErrInvalidRequest)
+
+	for _, scope := range []string{"instances", "instances:admin", ":read", "Instances:read"} {
+		_, _, err := m.Create(ctx, CreateAPIKeyRequest{Subject: "ci-bot", Scopes: []string{scope}})
+		assert.ErrorIs(t, err, ErrInvalidRequest, scope)
+	}
+}
+
+func TestAllows(t *testing.T) {
+	full := &APIKey{}
+	assert.True(t, full.Allows("instances", true))
+
+	scoped := &APIKey{Scopes: []string{"instances:write", "*:read"}}
+	assert.True(t, scoped.Allows("instances", true))
+	assert.True(t, scoped.Allows("images", false))
+	assert.False(t, scoped.Allows("images", true))
+	assert.False(t, scoped.Allows("api-keys", true))
+}
@@ -0,0 +1,70 @@
+package apikeys
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Scope actions. Write implies read.
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+)
+
+// scopeResourcePattern matches the resource part of a scope: an API path's
+// first segment (e.g. "instances", "api-keys") or "*" for all of them
+var scopeResourcePattern = regexp.MustCompile(`^(\*|[a-z][a-z0-9-]*)$`)
+
+// APIKey is a long-lived credential for the API. The secret is only known
+// when the key is created; the record keeps a hash of it.
+type APIKey struct {
+	ID        string
+	Name      string
+	Subject   string   // Reported as the user, like a JWT's sub claim
+	Scopes    []string // "<resource>:<read|write>"; empty grants full access
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+// CreateAPIKeyRequest is the domain request for creating an API key.
+type CreateAPIKeyRequest struct {
+	Name    string
+	Subject string
+	Scopes  []string
+}
+
+// Revoked reports whether the key has been revoked.
+func (k *APIKey) Revoked() bool {
+	return k.RevokedAt != nil
+}
+
+// Allows reports whether the key's scopes cover an action on a resource.
+// resource is the first segment of the API path (e.g. "instances").
+func (k *APIKey) Allows(resource string, write bool) bool {
+	if len(k.Scopes) == 0 {
+		return true
+	}
+	for _, scope := range k.Scopes {
+		res, action, _ := strings.Cut(scope, ":")
+		if res != "*" && res != resource {
+			continue
+		}
+		if action == ScopeWrite || (action == ScopeRead && !write) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateScopes checks every scope has the form "<resource>:<read|write>"
+func validateScopes(scopes []string) error {
+	for _, scope := range scopes {
+		res, action, ok := strings.Cut(scope, ":")
+		if !ok || !scopeResourcePattern.MatchString(res) || (action != ScopeRead && action != ScopeWrite) {
+			return fmt.Errorf("%w: scope %q must be <resource>:<read|write>, e.g. instances:write or *:read", ErrInvalidRequest, scope)
+		}
+	}
+	return nil
+}
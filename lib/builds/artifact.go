@@ -0,0 +1,182 @@
+package builds
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// artifactMountPath is where the output volume is mounted in the builder VM
+	artifactMountPath = "/output"
+
+	// artifactVolumeSizeGB is the size of the output volume for artifact builds
+	artifactVolumeSizeGB = 10
+
+	// Names the builder agent writes to at the root of the output volume
+	artifactTarName = "artifact.tar"
+	artifactDirName = "artifact"
+)
+
+// outputType returns the request's output type, defaulting to an image
+func (r *CreateBuildRequest) outputType() string {
+	if r == nil || r.OutputType == "" {
+		return OutputImage
+	}
+	return r.OutputType
+}
+
+// isArtifactOutput reports whether an output type exports files instead of an image
+func isArtifactOutput(outputType string) bool {
+	return outputType == OutputLocal || outputType == OutputTar
+}
+
+// validateOutputType checks that a build requests a known output type
+func validateOutputType(outputType string) error {
+	switch outputType {
+	case "", OutputImage, OutputLocal, OutputTar:
+		return nil
+	}
+	return fmt.Errorf("%w: %q (must be %s, %s or %s)", ErrInvalidOutputType, outputType, OutputImage, OutputLocal, OutputTar)
+}
+
+// formatOutputDisk replaces an output volume's disk with one the builder
+// agent can write to: it runs unprivileged, and an empty ext4 root directory
+// belongs to root. There is no journal, so once the guest has synced, the
+// host can read the disk with debugfs without replaying one.
+func formatOutputDisk(diskPath string, sizeGB int) error {
+	if err := os.Truncate(diskPath, int64(sizeGB)*1024*1024*1024); err != nil {
+		return fmt.Errorf("truncate disk: %w", err)
+	}
+	cmd := exec.Command("mkfs.ext4", "-b", "4096", "-O", "^has_journal", "-F", diskPath)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("mkfs.ext4 failed: %w, output: %s", err, output)
+	}
+	cmd = exec.Command("debugfs", "-w", "-R", "set_inode_field / mode 040777", diskPath)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("debugfs: %w, output: %s", err, output)
+	}
+	return nil
+}
+
+// extractArtifact copies a build's artifact out of the output volume's ext4
+// image into dest. It uses debugfs so the image doesn't need to be mounted;
+// the builder VM must have stopped so everything it wrote has been flushed.
+func extractArtifact(volumePath, outputType, dest string) error {
+	if err := os.RemoveAll(dest); err != nil {
+		return fmt.Errorf("remove old artifact: %w", err)
+	}
+
+	var request string
+	switch outputType {
+	case OutputTar:
+		request = fmt.Sprintf("dump /%s %s", artifactTarName, dest)
+	case OutputLocal:
+		// rdump recreates the artifact directory inside the given directory
+		if filepath.Base(dest) != artifactDirName {
+			return fmt.Errorf("local artifact destination must be named %s", artifactDirName)
+		}
+		request = fmt.Sprintf("rdump /%s %s", artifactDirName, filepath.Dir(dest))
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidOutputType, outputType)
+	}
+
+	cmd := exec.Command("debugfs", "-R", request, volumePath)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("debugfs: %w, output: %s", err, output)
+	}
+	// debugfs reports a missing file on stderr but still exits 0
+	if _, err := os.Stat(dest); err != nil {
+		return fmt.Errorf("artifact not found in output volume: %s", strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// GetBuildArtifact returns the files exported by a successful "local" or
+// "tar" build, as a tar stream
+func (m *manager) GetBuildArtifact(ctx context.Context, id string) (io.ReadCloser, error) {
+	meta, err := readMetadata(m.paths, id)
+	if err != nil {
+		return nil, err
+	}
+
+	outputType := meta.Request.outputType()
+	if !isArtifactOutput(outputType) {
+		return nil, fmt.Errorf("%w: build pushes an image", ErrNoArtifact)
+	}
+	if meta.Status != StatusReady {
+		return nil, fmt.Errorf("%w: build is %s", ErrNoArtifact, meta.Status)
+	}
+
+	path := m.paths.BuildArtifact(id)
+	if outputType == OutputLocal {
+		if _, err := os.Stat(path); err != nil {
+			return nil, fmt.Errorf("stat artifact: %w", err)
+		}
+		return tarDirectory(path), nil
+	}
+	return os.Open(path)
+}
+
+// tarDirectory streams the contents of dir as a tar archive, with paths
+// relative to dir
+func tarDirectory(dir string) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		tw := tar.NewWriter(pw)
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil || rel == "." {
+				return err
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			var link string
+			if info.Mode()&os.ModeSymlink != 0 {
+				if link, err = os.Readlink(path); err != nil {
+					return err
+				}
+			}
+			hdr, err := tar.FileInfoHeader(info, link)
+			if err != nil {
+				return err
+			}
+			hdr.Name = filepath.ToSlash(rel)
+			if d.IsDir() {
+				hdr.Name += "/"
+			}
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			_, err = io.Copy(tw, f)
+			return err
+		})
+		if err == nil {
+			err = tw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
@@ -0,0 +1,132 @@
+package builds
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/images"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readTar returns the regular files in a tar stream by name
+func readTar(t *testing.T, r io.Reader) map[string]string {
+	t.Helper()
+	files := map[string]string{}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		if hdr.Typeflag == tar.TypeReg {
+			data, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			files[hdr.Name] = string(data)
+		}
+	}
+}
+
+func TestValidateOutputType(t *testing.T) {
+	for _, outputType := range []string{"", OutputImage, OutputLocal, OutputTar} {
+		assert.NoError(t, validateOutputType(outputType), outputType)
+	}
+	assert.ErrorIs(t, validateOutputType("oci"), ErrInvalidOutputType)
+}
+
+func TestGetBuildArtifact(t *testing.T) {
+	mgr, _, _, tempDir := setupTestManager(t)
+	defer os.RemoveAll(tempDir)
+	ctx := context.Background()
+
+	writeBuild := func(id, status, outputType string) {
+		require.NoError(t, writeMetadata(mgr.paths, &buildMetadata{
+			ID:      id,
+			Status:  status,
+			Request: &CreateBuildRequest{OutputType: outputType},
+		}))
+	}
+
+	// Local output is served as a tar of the exported directory
+	writeBuild("local-build", StatusReady, OutputLocal)
+	dir := mgr.paths.BuildArtifact("local-build")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "bin"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bin", "app"), []byte("binary"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("docs"), 0644))
+
+	artifact, err := mgr.GetBuildArtifact(ctx, "local-build")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"bin/app": "binary", "README": "docs"}, readTar(t, artifact))
+	artifact.Close()
+
+	// Tar output is served as is
+	writeBuild("tar-build", StatusReady, OutputTar)
+	require.NoError(t, os.WriteFile(mgr.paths.BuildArtifact("tar-build"), []byte("tarball"), 0644))
+	artifact, err = mgr.GetBuildArtifact(ctx, "tar-build")
+	require.NoError(t, err)
+	data, err := io.ReadAll(artifact)
+	require.NoError(t, err)
+	assert.Equal(t, "tarball", string(data))
+	artifact.Close()
+
+	writeBuild("image-build", StatusReady, "")
+	_, err = mgr.GetBuildArtifact(ctx, "image-build")
+	assert.ErrorIs(t, err, ErrNoArtifact)
+
+	writeBuild("running-build", StatusBuilding, OutputTar)
+	_, err = mgr.GetBuildArtifact(ctx, "running-build")
+	assert.ErrorIs(t, err, ErrNoArtifact)
+
+	_, err = mgr.GetBuildArtifact(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestExtractArtifact(t *testing.T) {
+	if _, err := exec.LookPath("debugfs"); err != nil {
+		t.Skip("debugfs not available")
+	}
+	if _, err := exec.LookPath("mkfs.ext4"); err != nil {
+		t.Skip("mkfs.ext4 not available")
+	}
+
+	// A fresh output disk is writable by anyone
+	disk := filepath.Join(t.TempDir(), "fresh.ext4")
+	require.NoError(t, os.WriteFile(disk, nil, 0644))
+	require.NoError(t, formatOutputDisk(disk, 1))
+	out, err := exec.Command("debugfs", "-R", "stat /", disk).Output()
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "Mode:  0777")
+
+	// Simulate an output volume the builder agent wrote to
+	volDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(volDir, artifactDirName, "bin"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(volDir, artifactDirName, "bin", "app"), []byte("binary"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(volDir, artifactTarName), []byte("tarball"), 0644))
+	volPath := filepath.Join(t.TempDir(), "output.ext4")
+	_, err = images.ExportRootfs(volDir, volPath, images.FormatExt4)
+	require.NoError(t, err)
+
+	dest := filepath.Join(t.TempDir(), artifactDirName)
+	require.NoError(t, extractArtifact(volPath, OutputLocal, dest))
+	data, err := os.ReadFile(filepath.Join(dest, "bin", "app"))
+	require.NoError(t, err)
+	assert.Equal(t, "binary", string(data))
+
+	dest = filepath.Join(t.TempDir(), "artifact")
+	require.NoError(t, extractArtifact(volPath, OutputTar, dest))
+	data, err = os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, "tarball", string(data))
+
+	// A build that didn't write its artifact fails extraction
+	emptyVol := filepath.Join(t.TempDir(), "empty.ext4")
+	_, err = images.ExportRootfs(t.TempDir(), emptyVol, images.FormatExt4)
+	require.NoError(t, err)
+	assert.Error(t, extractArtifact(emptyVol, OutputTar, filepath.Join(t.TempDir(), "artifact")))
+}
@@ -0,0 +1,195 @@
+package builds
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+// BaseImageCache is a pull-through cache of the upstream registry base images
+// come from. Builder VMs pull through it instead of from the upstream, so a
+// common base like node:20 is downloaded once per host rather than per build.
+type BaseImageCache interface {
+	// Upstream returns the registry host the cache mirrors, e.g. "docker.io"
+	Upstream() string
+
+	// Prefetch makes sure an image's manifest, config and layers are in the
+	// cache. It returns true if nothing had to be downloaded.
+	Prefetch(ctx context.Context, repo, reference string) (bool, error)
+}
+
+// baseImage is a base image from the cache's upstream registry
+type baseImage struct {
+	repo      string // Repository in the upstream, e.g. "library/node"
+	reference string // Tag or digest
+}
+
+// buildArgPattern matches $VAR and ${VAR} in a FROM image
+var buildArgPattern = regexp.MustCompile(`\$\{?([A-Za-z_][A-Za-z0-9_]*)\}?`)
+
+// dockerfileBaseImages returns the images a Dockerfile's stages are built
+// FROM, with build args and ARG defaults substituted. Earlier stages, scratch
+// and images that still contain an unset variable are left out. Like the
+// pre-validation, this is best effort: BuildKit pulls whatever it misses.
+func dockerfileBaseImages(dockerfile string, buildArgs map[string]string) []string {
+	lines, _, err := parseDockerfile(dockerfile)
+	if err != nil {
+		return nil
+	}
+
+	args := map[string]string{}
+	stages := map[string]bool{}
+	var images []string
+	seenFrom := false
+	for _, line := range lines {
+		switch line.instruction {
+		case "ARG":
+			// Only ARGs before the first FROM apply to FROM lines
+			if seenFrom {
+				continue
+			}
+			for _, field := range strings.Fields(line.args) {
+				key, value, _ := strings.Cut(field, "=")
+				args[key] = strings.Trim(value, `"'`)
+			}
+		case "FROM":
+			seenFrom = true
+			fields := strings.Fields(line.args)
+			for len(fields) > 0 && strings.HasPrefix(fields[0], "--") {
+				fields = fields[1:]
+			}
+			if len(fields) == 0 {
+				continue
+			}
+			unset := false
+			image := buildArgPattern.ReplaceAllStringFunc(fields[0], func(v string) string {
+				key := buildArgPattern.FindStringSubmatch(v)[1]
+				if value, ok := buildArgs[key]; ok {
+					return value
+				}
+				if args[key] == "" {
+					unset = true
+				}
+				return args[key]
+			})
+			if !unset && image != "scratch" && !stages[strings.ToLower(image)] {
+				images = append(images, image)
+			}
+			if len(fields) == 3 && strings.EqualFold(fields[1], "AS") {
+				stages[strings.ToLower(fields[2])] = true
+			}
+		}
+	}
+	return images
+}
+
+// mirroredBaseImages picks the images that come from upstream, skipping
+// duplicates and references that don't parse
+func mirroredBaseImages(images []string, upstream string) []baseImage {
+	reg, err := name.NewRegistry(upstream)
+	if err != nil {
+		return nil
+	}
+	seen := map[baseImage]bool{}
+	var result []baseImage
+	for _, image := range images {
+		ref, err := name.ParseReference(image)
+		if err != nil || ref.Context().RegistryStr() != reg.Name() {
+			continue
+		}
+		base := baseImage{repo: ref.Context().RepositoryStr(), reference: ref.Identifier()}
+		if !seen[base] {
+			seen[base] = true
+			result = append(result, base)
+		}
+	}
+	return result
+}
+
+// mirrorHost returns the host BuildKit knows the upstream registry by, which
+// for Docker Hub is docker.io rather than index.docker.io
+func mirrorHost(upstream string) string {
+	reg, err := name.NewRegistry(upstream)
+	if err != nil || reg.Name() == name.DefaultRegistry {
+		return "docker.io"
+	}
+	return reg.Name()
+}
+
+// dockerfileFromSource reads the Dockerfile at the root of a source tarball
+func dockerfileFromSource(r io.Reader) (string, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return "", err
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return "", os.ErrNotExist
+		}
+		if err != nil {
+			return "", err
+		}
+		if hdr.Typeflag == tar.TypeReg && path.Clean(hdr.Name) == "Dockerfile" {
+			data, err := io.ReadAll(io.LimitReader(tr, 1<<20))
+			return string(data), err
+		}
+	}
+}
+
+// baseImagesFor returns the base images of a build that the base image cache
+// can serve. sourcePath is the build's source tarball, read when the
+// Dockerfile isn't inline.
+func (m *manager) baseImagesFor(req *CreateBuildRequest, sourcePath string) []baseImage {
+	if m.baseImages == nil || !isDefaultFrontend(req.Frontend) {
+		return nil
+	}
+	dockerfile := req.Dockerfile
+	if dockerfile == "" {
+		f, err := os.Open(sourcePath)
+		if err != nil {
+			return nil
+		}
+		defer f.Close()
+		if dockerfile, err = dockerfileFromSource(f); err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				m.logger.Warn("read dockerfile from source for base image prefetch", "error", err)
+			}
+			return nil
+		}
+	}
+	return mirroredBaseImages(dockerfileBaseImages(dockerfile, req.BuildArgs), m.baseImages.Upstream())
+}
+
+// prefetchBaseImages pulls a build's base images into the cache before its
+// builder VM boots. Failures are only logged: the VM then pulls through the
+// cache, or from the upstream, as it would without a prefetch.
+func (m *manager) prefetchBaseImages(ctx context.Context, id string, images []baseImage) {
+	ctx, span := m.startSpan(ctx, "PrefetchBaseImages")
+	defer span.End()
+
+	for _, image := range images {
+		start := time.Now()
+		cached, err := m.baseImages.Prefetch(ctx, image.repo, image.reference)
+		if err != nil {
+			m.logger.Warn("base image prefetch failed", "id", id, "repo", image.repo, "reference", image.reference, "error", err)
+			continue
+		}
+		m.logger.Info("base image prefetched", "id", id, "repo", image.repo, "reference", image.reference,
+			"cached", cached, "duration", time.Since(start))
+		if m.metrics != nil {
+			m.metrics.RecordBaseImagePrefetch(ctx, cached, time.Since(start))
+		}
+	}
+}
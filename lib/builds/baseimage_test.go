@@ -0,0 +1,61 @@
+package builds
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDockerfileBaseImages(t *testing.T) {
+	dockerfile := `ARG NODE_VERSION=18
+ARG DISTRO
+FROM --platform=linux/amd64 node:${NODE_VERSION}-alpine AS deps
+RUN npm ci
+FROM golang:1.25 AS build
+FROM deps AS test
+FROM scratch
+FROM alpine:$DISTRO
+FROM ghcr.io/org/runtime@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef
+`
+	images := dockerfileBaseImages(dockerfile, map[string]string{"NODE_VERSION": "20"})
+	assert.Equal(t, []string{
+		"node:20-alpine",
+		"golang:1.25",
+		"ghcr.io/org/runtime@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	}, images)
+
+	assert.Empty(t, dockerfileBaseImages("FROM", nil))
+}
+
+func TestMirroredBaseImages(t *testing.T) {
+	images := []string{"node:20", "docker.io/library/node:20", "ghcr.io/org/app:v1", "myorg/app@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"}
+	assert.Equal(t, []baseImage{
+		{repo: "library/node", reference: "20"},
+		{repo: "myorg/app", reference: "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"},
+	}, mirroredBaseImages(images, "docker.io"))
+	assert.Equal(t, []baseImage{{repo: "org/app", reference: "v1"}}, mirroredBaseImages(images, "ghcr.io"))
+
+	assert.Equal(t, "docker.io", mirrorHost("index.docker.io"))
+	assert.Equal(t, "ghcr.io", mirrorHost("ghcr.io"))
+}
+
+func TestDockerfileFromSource(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range map[string]string{"app/Dockerfile": "FROM other", "./Dockerfile": "FROM node:20"} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	dockerfile, err := dockerfileFromSource(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, "FROM node:20", dockerfile)
+}
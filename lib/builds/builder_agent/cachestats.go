@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CacheStats matches the BuildCacheStats type from lib/builds/types.go
+type CacheStats struct {
+	CacheHits   int   `json:"cache_hits"`
+	CacheMisses int   `json:"cache_misses"`
+	CachedBytes int64 `json:"cached_bytes"`
+}
+
+var (
+	// buildStepPattern matches the first line of a Dockerfile step in BuildKit's
+	// plain progress output, e.g. "#7 [build 3/6] RUN npm ci". FROM steps pull
+	// the base image rather than build a layer, so they are not counted.
+	buildStepPattern = regexp.MustCompile(`^#(\d+) \[(?:[^\]]+ )?\d+/\d+\] (\S+)`)
+
+	// stepStatusPattern matches a step's final status line: "#7 CACHED" or "#7 DONE 12.3s"
+	stepStatusPattern = regexp.MustCompile(`^#(\d+) (CACHED|DONE)\b`)
+
+	// blobFetchPattern matches a finished layer download within a step, e.g.
+	// "#7 sha256:4f4f... 12.58MB / 12.58MB 0.4s done"
+	blobFetchPattern = regexp.MustCompile(`^#(\d+) sha256:[0-9a-f]+ \S+ / (\d+(?:\.\d+)?)([kMG]?B) .*done$`)
+)
+
+// cacheCounter counts the Dockerfile steps BuildKit took from its cache and
+// the ones it executed, from the plain progress output. CachedBytes is the
+// size of the layers fetched from a registry cache for cached steps; layers
+// already in the local cache are not counted.
+type cacheCounter struct {
+	mu      sync.Mutex
+	partial []byte
+	steps   map[string]int64 // Bytes fetched so far for each counted step
+	stats   CacheStats
+}
+
+func (c *cacheCounter) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.steps == nil {
+		c.steps = make(map[string]int64)
+	}
+	c.partial = append(c.partial, b...)
+	for {
+		idx := bytes.IndexByte(c.partial, '\n')
+		if idx < 0 {
+			break
+		}
+		line := strings.TrimSpace(string(c.partial[:idx]))
+		c.partial = c.partial[idx+1:]
+		c.processLine(line)
+	}
+	return len(b), nil
+}
+
+func (c *cacheCounter) processLine(line string) {
+	if m := buildStepPattern.FindStringSubmatch(line); m != nil {
+		if _, seen := c.steps[m[1]]; !seen && m[2] != "FROM" {
+			c.steps[m[1]] = 0
+		}
+		return
+	}
+	if m := blobFetchPattern.FindStringSubmatch(line); m != nil {
+		if _, ok := c.steps[m[1]]; ok {
+			c.steps[m[1]] += parseProgressSize(m[2], m[3])
+		}
+		return
+	}
+	if m := stepStatusPattern.FindStringSubmatch(line); m != nil {
+		fetched, ok := c.steps[m[1]]
+		if !ok {
+			return
+		}
+		delete(c.steps, m[1])
+		if m[2] == "CACHED" {
+			c.stats.CacheHits++
+			c.stats.CachedBytes += fetched
+		} else {
+			c.stats.CacheMisses++
+		}
+	}
+}
+
+func (c *cacheCounter) result() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// parseProgressSize converts a size as BuildKit prints it (decimal units,
+// e.g. "12.58" "MB") to bytes
+func parseProgressSize(value, unit string) int64 {
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	switch unit {
+	case "kB":
+		n *= 1e3
+	case "MB":
+		n *= 1e6
+	case "GB":
+		n *= 1e9
+	}
+	return int64(n)
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheCounter(t *testing.T) {
+	progress := `#1 [internal] load build definition from Dockerfile
+#1 DONE 0.0s
+#5 [1/4] FROM docker.io/library/node:20-alpine@sha256:abc
+#5 DONE 1.2s
+#6 importing cache manifest from 10.102.0.1:8083/cache/tenant
+#6 DONE 0.1s
+#7 [2/4] COPY package.json .
+#7 sha256:4f4fb700ef54461cfa02571ae0db9a0dc1e0cdb5577484a6d75e68dc38e8acc1 2.50MB / 2.50MB 0.1s done
+#7 CACHED
+#8 [build 3/4] RUN npm ci
+#8 sha256:9e3f1f2a0c66b1a6bd3c2b0e1c5d1a7f0e4c6b2d3a1f0e9d8c7b6a5f4e3d2c1b 1.5kB / 1.5kB 0.0s done
+#8 CACHED
+#9 [4/4] COPY . .
+#9 DONE 0.3s
+`
+	c := &cacheCounter{}
+	// Split mid-line, as pipe reads do
+	_, _ = c.Write([]byte(progress[:100]))
+	_, _ = c.Write([]byte(progress[100:]))
+
+	stats := c.result()
+	assert.Equal(t, 2, stats.CacheHits)
+	assert.Equal(t, 1, stats.CacheMisses)
+	assert.Equal(t, int64(2_500_000+1_500), stats.CachedBytes)
+}
@@ -23,8 +23,12 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/mdlayher/vsock"
@@ -33,6 +37,14 @@ import (
 const (
 	configPath = "/config/build.json"
 	vsockPort  = 5001 // Build agent port (different from exec agent)
+
+	// errOutOfMemory is the error reported when the kernel OOM-killed a process
+	// during the build. It matches builds.ErrBuildOutOfMemory, which the host
+	// looks for to suggest a larger memory_mb.
+	errOutOfMemory = "out of memory"
+
+	// errCancelled is the error reported when the host cancelled the build
+	errCancelled = "build cancelled"
 )
 
 // BuildConfig matches the BuildConfig type from lib/builds/types.go
@@ -42,6 +54,12 @@ type BuildConfig struct {
 	RegistryURL     string            `json:"registry_url"`
 	RegistryToken   string            `json:"registry_token,omitempty"`
 	CacheScope      string            `json:"cache_scope,omitempty"`
+	CacheImports    []string          `json:"cache_imports,omitempty"`
+	RegistryMirror  string            `json:"registry_mirror,omitempty"`
+	Frontend        string            `json:"frontend,omitempty"`
+	FrontendOpts    map[string]string `json:"frontend_opts,omitempty"`
+	OutputType      string            `json:"output_type,omitempty"`
+	OutputPath      string            `json:"output_path,omitempty"`
 	SourcePath      string            `json:"source_path"`
 	Dockerfile      string            `json:"dockerfile,omitempty"`
 	BuildArgs       map[string]string `json:"build_args,omitempty"`
@@ -64,6 +82,10 @@ type BuildResult struct {
 	Logs        string          `json:"logs,omitempty"`
 	Provenance  BuildProvenance `json:"provenance"`
 	DurationMS  int64           `json:"duration_ms"`
+
+	PushDurationMS int64 `json:"push_duration_ms,omitempty"`
+
+	CacheStats
 }
 
 // BuildProvenance records build inputs
@@ -73,6 +95,8 @@ type BuildProvenance struct {
 	LockfileHashes  map[string]string `json:"lockfile_hashes,omitempty"`
 	BuildkitVersion string            `json:"buildkit_version,omitempty"`
 	Timestamp       time.Time         `json:"timestamp"`
+	SecretScan      *SecretScan       `json:"secret_scan,omitempty"`
+	CacheStats      *CacheStats       `json:"cache_stats,omitempty"`
 }
 
 // VsockMessage is the envelope for vsock communication
@@ -90,6 +114,9 @@ var (
 	buildResultLock sync.Mutex
 	buildDone       = make(chan struct{})
 
+	// buildCtx is cancelled when the host sends a cancel message
+	buildCtx, cancelBuild = context.WithCancel(context.Background())
+
 	// Secrets coordination
 	buildConfig     *BuildConfig
 	buildConfigLock sync.Mutex
@@ -257,6 +284,18 @@ func handleHostConnection(conn net.Conn) {
 			}
 			encoderLock.Unlock()
 
+		case "cancel":
+			// Host is cancelling the build: stopping buildctl makes the build
+			// fail, and its result is sent as usual
+			log.Printf("Build cancelled by host")
+			cancelBuild()
+			encoderLock.Lock()
+			err := encoder.Encode(VsockMessage{Type: "cancelled"})
+			encoderLock.Unlock()
+			if err != nil {
+				log.Printf("Failed to acknowledge cancel: %v", err)
+			}
+
 		default:
 			log.Printf("Unknown message type: %s", msg.Type)
 		}
@@ -343,10 +382,16 @@ func runBuildProcess() {
 	log.SetOutput(logWriter)
 
 	defer func() {
+		// Secrets must not outlive the build: a pooled builder VM is reused
+		if err := os.RemoveAll("/run/secrets"); err != nil {
+			log.Printf("Warning: failed to remove secrets: %v", err)
+		}
 		close(buildDone)
 	}()
 
-	// Load build config
+	// Load build config. A pooled builder VM boots before its build is
+	// assigned, so the config volume may not be attached yet.
+	waitForConfig()
 	config, err := loadConfig()
 	if err != nil {
 		setResult(BuildResult{
@@ -376,7 +421,7 @@ func runBuildProcess() {
 	}
 
 	// Setup timeout context
-	ctx := context.Background()
+	ctx := buildCtx
 	if config.TimeoutSeconds > 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, time.Duration(config.TimeoutSeconds)*time.Second)
@@ -396,9 +441,13 @@ func runBuildProcess() {
 				close(secretsReady)
 			})
 		case <-ctx.Done():
+			errMsg := "build timeout while waiting for secrets"
+			if buildCtx.Err() != nil {
+				errMsg = errCancelled
+			}
 			setResult(BuildResult{
 				Success:    false,
-				Error:      "build timeout while waiting for secrets",
+				Error:      errMsg,
 				Logs:       logs.String(),
 				DurationMS: time.Since(start).Milliseconds(),
 			})
@@ -406,11 +455,15 @@ func runBuildProcess() {
 		}
 	}
 
-	// Ensure Dockerfile exists (either in source or provided via config)
+	// Ensure Dockerfile exists (either in source or provided via config).
+	// Frontend images (e.g. buildpacks) may not need one, so they are left to
+	// report a missing Dockerfile themselves.
 	dockerfilePath := filepath.Join(config.SourcePath, "Dockerfile")
 	if _, err := os.Stat(dockerfilePath); os.IsNotExist(err) {
 		// Check if Dockerfile was provided in config
-		if config.Dockerfile == "" {
+		if config.Dockerfile == "" && !usesDefaultFrontend(config) {
+			log.Printf("No Dockerfile, leaving it to frontend %s", config.Frontend)
+		} else if config.Dockerfile == "" {
 			setResult(BuildResult{
 				Success:    false,
 				Error:      "Dockerfile required: provide dockerfile parameter or include Dockerfile in source tarball",
@@ -439,32 +492,84 @@ func runBuildProcess() {
 
 	// Run the build
 	log.Println("=== Starting Build ===")
-	digest, buildLogs, err := runBuild(ctx, config, logWriter)
+	pushTimer := &pushTimer{}
+	cacheCounter := &cacheCounter{}
+	oomKillsBefore := readOOMKills()
+	digest, buildLogs, err := runBuild(ctx, config, io.MultiWriter(logWriter, pushTimer, cacheCounter))
 	logs.WriteString(buildLogs)
 
 	duration := time.Since(start).Milliseconds()
+	cacheStats := cacheCounter.result()
+	provenance.CacheStats = &cacheStats
+	log.Printf("Cache: %d steps cached, %d executed", cacheStats.CacheHits, cacheStats.CacheMisses)
 
 	if err != nil {
+		// buildctl only reports the killed step's exit code, so check whether
+		// the kernel's OOM killer ran during the build
+		errMsg := err.Error()
+		if buildCtx.Err() != nil {
+			errMsg = errCancelled
+		} else if readOOMKills() > oomKillsBefore {
+			log.Printf("OOM kill during build: %v", err)
+			errMsg = errOutOfMemory
+		}
 		setResult(BuildResult{
 			Success:    false,
-			Error:      err.Error(),
+			Error:      errMsg,
 			Logs:       logs.String(),
 			Provenance: provenance,
 			DurationMS: duration,
+			CacheStats: cacheStats,
 		})
 		return
 	}
 
+	// Check the pushed image for the build's secrets before reporting it
+	if digest != "" && len(config.Secrets) > 0 {
+		log.Println("=== Scanning image for leaked secrets ===")
+		scan, err := scanImageForSecrets(ctx, config, digest, loadSecretValues(config.Secrets))
+		if err != nil {
+			setResult(BuildResult{
+				Success:    false,
+				Error:      fmt.Sprintf("scan image for leaked secrets: %v", err),
+				Logs:       logs.String(),
+				Provenance: provenance,
+				DurationMS: time.Since(start).Milliseconds(),
+				CacheStats: cacheStats,
+			})
+			return
+		}
+		provenance.SecretScan = scan
+		if !scan.Passed {
+			setResult(BuildResult{
+				Success:    false,
+				Error:      fmt.Sprintf("image leaks build secrets: %s", strings.Join(scan.Leaks, "; ")),
+				Logs:       logs.String(),
+				Provenance: provenance,
+				DurationMS: time.Since(start).Milliseconds(),
+				CacheStats: cacheStats,
+			})
+			return
+		}
+		log.Printf("No secrets found in %d layers", scan.LayersScanned)
+	}
+
 	// Success!
-	log.Printf("=== Build Complete: %s ===", digest)
+	if digest != "" {
+		log.Printf("=== Build Complete: %s ===", digest)
+	} else {
+		log.Printf("=== Build Complete: %s output in %s ===", config.OutputType, config.OutputPath)
+	}
 	provenance.Timestamp = time.Now()
 
 	setResult(BuildResult{
-		Success:     true,
-		ImageDigest: digest,
-		Logs:        logs.String(),
-		Provenance:  provenance,
-		DurationMS:  duration,
+		Success:        true,
+		ImageDigest:    digest,
+		Logs:           logs.String(),
+		Provenance:     provenance,
+		DurationMS:     duration,
+		PushDurationMS: pushTimer.total().Milliseconds(),
+		CacheStats:     cacheStats,
 	})
 }
 
@@ -475,6 +580,20 @@ func setResult(result BuildResult) {
 	buildResult = &result
 }
 
+// waitForConfig blocks until the build config exists
+func waitForConfig() {
+	if _, err := os.Stat(configPath); err == nil {
+		return
+	}
+	log.Printf("Waiting for build config at %s", configPath)
+	for {
+		time.Sleep(200 * time.Millisecond)
+		if _, err := os.Stat(configPath); err == nil {
+			return
+		}
+	}
+}
+
 func loadConfig() (*BuildConfig, error) {
 	data, err := os.ReadFile(configPath)
 	if err != nil {
@@ -533,26 +652,50 @@ func setupRegistryAuth(registryURL, token string) error {
 func runBuild(ctx context.Context, config *BuildConfig, logWriter io.Writer) (string, string, error) {
 	var buildLogs bytes.Buffer
 
-	// Build output reference
-	outputRef := fmt.Sprintf("%s/builds/%s", config.RegistryURL, config.JobID)
-
 	// Build arguments
 	// Use registry.insecure=true for internal HTTP registries
 	args := []string{
 		"build",
-		"--frontend", "dockerfile.v0",
-		"--local", "context=" + config.SourcePath,
-		"--local", "dockerfile=" + config.SourcePath,
-		"--output", fmt.Sprintf("type=image,name=%s,push=true,registry.insecure=true,oci-mediatypes=true", outputRef),
+	}
+	if usesDefaultFrontend(config) {
+		args = append(args, "--frontend", "dockerfile.v0")
+	} else {
+		// Frontend images run through the gateway frontend
+		args = append(args, "--frontend", "gateway.v0", "--opt", "source="+config.Frontend)
+	}
+	args = append(args,
+		"--local", "context="+config.SourcePath,
+		"--local", "dockerfile="+config.SourcePath,
+		"--output", buildOutput(config),
 		"--metadata-file", "/tmp/build-metadata.json",
+		// Plain progress has per-step timings, which pushTimer reads
+		"--progress", "plain",
+	)
+
+	// Frontend options, sorted so the logged command is stable
+	optKeys := make([]string, 0, len(config.FrontendOpts))
+	for k := range config.FrontendOpts {
+		optKeys = append(optKeys, k)
+	}
+	sort.Strings(optKeys)
+	for _, k := range optKeys {
+		args = append(args, "--opt", fmt.Sprintf("%s=%s", k, config.FrontendOpts[k]))
 	}
 
-	// Add cache if scope is set
+	// Add cache if scope is set. Extra import scopes (e.g. a shared base-layer
+	// cache) are only read from; the build exports to its own scope alone.
 	if config.CacheScope != "" {
 		cacheRef := fmt.Sprintf("%s/cache/%s", config.RegistryURL, config.CacheScope)
 		args = append(args, "--import-cache", fmt.Sprintf("type=registry,ref=%s,registry.insecure=true", cacheRef))
 		args = append(args, "--export-cache", fmt.Sprintf("type=registry,ref=%s,mode=max,registry.insecure=true", cacheRef))
 	}
+	for _, scope := range config.CacheImports {
+		if scope == config.CacheScope {
+			continue
+		}
+		importRef := fmt.Sprintf("%s/cache/%s", config.RegistryURL, scope)
+		args = append(args, "--import-cache", fmt.Sprintf("type=registry,ref=%s,registry.insecure=true", importRef))
+	}
 
 	// Add secret mounts
 	for _, secret := range config.Secrets {
@@ -573,11 +716,26 @@ func runBuild(ctx context.Context, config *BuildConfig, logWriter io.Writer) (st
 	cmd.Stderr = io.MultiWriter(logWriter, &buildLogs)
 	// Use BUILDKITD_FLAGS from environment (set in Dockerfile) or empty for default
 	cmd.Env = os.Environ()
+	if config.RegistryMirror != "" {
+		configFile, err := writeBuildkitdConfig(config)
+		if err != nil {
+			return "", "", fmt.Errorf("write buildkitd config: %w", err)
+		}
+		// The last BUILDKITD_FLAGS in Env wins
+		cmd.Env = append(cmd.Env, "BUILDKITD_FLAGS="+strings.TrimSpace(os.Getenv("BUILDKITD_FLAGS")+" --config="+configFile))
+	}
 
 	if err := cmd.Run(); err != nil {
 		return "", buildLogs.String(), fmt.Errorf("buildctl failed: %w", err)
 	}
 
+	// Artifact builds have no image, so no digest. Flush what was written to
+	// the output volume before the host reads it.
+	if isArtifactOutput(config) {
+		syscall.Sync()
+		return "", buildLogs.String(), nil
+	}
+
 	// Extract digest from metadata
 	digest, err := extractDigest("/tmp/build-metadata.json")
 	if err != nil {
@@ -587,6 +745,84 @@ func runBuild(ctx context.Context, config *BuildConfig, logWriter io.Writer) (st
 	return digest, buildLogs.String(), nil
 }
 
+// readOOMKills returns how many processes the kernel has OOM-killed since
+// boot, from the oom_kill counter in /proc/vmstat. Unlike the kernel log it
+// is readable without privileges. Returns 0 if it can't be read.
+func readOOMKills() int64 {
+	f, err := os.Open("/proc/vmstat")
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+	return parseOOMKills(f)
+}
+
+// parseOOMKills finds the oom_kill counter in vmstat-formatted input
+func parseOOMKills(r io.Reader) int64 {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		name, value, ok := strings.Cut(scanner.Text(), " ")
+		if ok && name == "oom_kill" {
+			n, _ := strconv.ParseInt(value, 10, 64)
+			return n
+		}
+	}
+	return 0
+}
+
+// writeBuildkitdConfig writes a buildkitd config that sends pulls from the
+// mirrored upstream registry to the host registry, which serves them from its
+// pull-through cache. BuildKit falls back to the upstream if the mirror fails.
+func writeBuildkitdConfig(config *BuildConfig) (string, error) {
+	path := "/tmp/buildkitd.toml"
+	content := fmt.Sprintf(`[registry.%q]
+  mirrors = [%q]
+
+[registry.%q]
+  http = true
+  insecure = true
+`, config.RegistryMirror, config.RegistryURL, config.RegistryURL)
+	return path, os.WriteFile(path, []byte(content), 0644)
+}
+
+// pushStepPattern matches completed push steps in BuildKit's plain progress
+// output, e.g. "#12 pushing layers 1.3s done"
+var pushStepPattern = regexp.MustCompile(`^#\d+ pushing .* (\d+(?:\.\d+)?)s done$`)
+
+// pushTimer sums the durations of push steps in BuildKit's plain progress output
+type pushTimer struct {
+	mu      sync.Mutex
+	partial []byte
+	pushing time.Duration
+}
+
+func (p *pushTimer) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.partial = append(p.partial, b...)
+	for {
+		idx := bytes.IndexByte(p.partial, '\n')
+		if idx < 0 {
+			break
+		}
+		line := strings.TrimSpace(string(p.partial[:idx]))
+		p.partial = p.partial[idx+1:]
+		if m := pushStepPattern.FindStringSubmatch(line); m != nil {
+			if secs, err := strconv.ParseFloat(m[1], 64); err == nil {
+				p.pushing += time.Duration(secs * float64(time.Second))
+			}
+		}
+	}
+	return len(b), nil
+}
+
+func (p *pushTimer) total() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.pushing
+}
+
 func extractDigest(metadataPath string) (string, error) {
 	data, err := os.ReadFile(metadataPath)
 	if err != nil {
@@ -675,3 +911,30 @@ func getBuildkitVersion() string {
 	out, _ := cmd.Output()
 	return strings.TrimSpace(string(out))
 }
+
+// usesDefaultFrontend reports whether the build uses BuildKit's built-in
+// Dockerfile frontend rather than a frontend image
+func usesDefaultFrontend(config *BuildConfig) bool {
+	return config.Frontend == "" || config.Frontend == "dockerfile.v0"
+}
+
+// buildOutput returns the buildctl --output flag for the build's output type.
+// Images are pushed to the registry; artifacts are written to the output volume,
+// where the host picks them up after the VM stops.
+func buildOutput(config *BuildConfig) string {
+	switch config.OutputType {
+	case "tar":
+		return fmt.Sprintf("type=tar,dest=%s", filepath.Join(config.OutputPath, "artifact.tar"))
+	case "local":
+		return fmt.Sprintf("type=local,dest=%s", filepath.Join(config.OutputPath, "artifact"))
+	default:
+		outputRef := fmt.Sprintf("%s/builds/%s", config.RegistryURL, config.JobID)
+		// Use registry.insecure=true for internal HTTP registries
+		return fmt.Sprintf("type=image,name=%s,push=true,registry.insecure=true,oci-mediatypes=true", outputRef)
+	}
+}
+
+// isArtifactOutput reports whether the build exports files instead of an image
+func isArtifactOutput(config *BuildConfig) bool {
+	return config.OutputType == "local" || config.OutputType == "tar"
+}
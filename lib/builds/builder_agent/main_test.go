@@ -0,0 +1,16 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOOMKills(t *testing.T) {
+	vmstat := "nr_free_pages 12345\noom_kill 3\nnr_zone_active_file 42\n"
+	assert.Equal(t, int64(3), parseOOMKills(strings.NewReader(vmstat)))
+
+	// Kernels without the counter report none
+	assert.Zero(t, parseOOMKills(strings.NewReader("nr_free_pages 12345\n")))
+}
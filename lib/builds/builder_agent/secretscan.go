@@ -0,0 +1,199 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// minScannedSecretLength is the shortest secret value searched for in layer
+// contents; shorter values would match by chance
+const minScannedSecretLength = 8
+
+// SecretScan records the check of a pushed image for leaked build secrets
+type SecretScan struct {
+	Passed        bool     `json:"passed"`
+	LayersScanned int      `json:"layers_scanned"`
+	Leaks         []string `json:"leaks,omitempty"`
+}
+
+// loadSecretValues reads the secrets handleSecretsRequest wrote, keyed by ID
+func loadSecretValues(refs []SecretRef) map[string][]byte {
+	values := make(map[string][]byte)
+	for _, ref := range refs {
+		data, err := os.ReadFile(fmt.Sprintf("/run/secrets/%s", ref.ID))
+		if err != nil || len(data) < minScannedSecretLength {
+			continue
+		}
+		values[ref.ID] = data
+	}
+	return values
+}
+
+// scanImageForSecrets pulls the layers of the pushed image back from the
+// registry and checks that none holds a file under /run/secrets or a secret
+// value verbatim
+func scanImageForSecrets(ctx context.Context, config *BuildConfig, digest string, secrets map[string][]byte) (*SecretScan, error) {
+	ref, err := name.NewDigest(fmt.Sprintf("%s/builds/%s@%s", config.RegistryURL, config.JobID, digest), name.Insecure)
+	if err != nil {
+		return nil, fmt.Errorf("parse image reference: %w", err)
+	}
+	opts := []remote.Option{remote.WithContext(ctx)}
+	if config.RegistryToken != "" {
+		opts = append(opts, remote.WithAuth(&authn.Bearer{Token: config.RegistryToken}))
+	}
+
+	desc, err := remote.Get(ref, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("fetch image manifest: %w", err)
+	}
+	var imgs []v1.Image
+	if desc.MediaType.IsIndex() {
+		idx, err := desc.ImageIndex()
+		if err != nil {
+			return nil, fmt.Errorf("read image index: %w", err)
+		}
+		manifest, err := idx.IndexManifest()
+		if err != nil {
+			return nil, fmt.Errorf("read image index: %w", err)
+		}
+		for _, m := range manifest.Manifests {
+			// Attestations are in-toto documents, not filesystem layers
+			if m.Annotations["vnd.docker.reference.type"] == "attestation-manifest" {
+				continue
+			}
+			img, err := idx.Image(m.Digest)
+			if err != nil {
+				return nil, fmt.Errorf("read image %s: %w", m.Digest, err)
+			}
+			imgs = append(imgs, img)
+		}
+	} else {
+		img, err := desc.Image()
+		if err != nil {
+			return nil, fmt.Errorf("read image: %w", err)
+		}
+		imgs = append(imgs, img)
+	}
+
+	scan := &SecretScan{}
+	seen := make(map[v1.Hash]bool)
+	for _, img := range imgs {
+		layers, err := img.Layers()
+		if err != nil {
+			return nil, fmt.Errorf("list layers: %w", err)
+		}
+		for _, layer := range layers {
+			layerDigest, err := layer.Digest()
+			if err != nil {
+				return nil, fmt.Errorf("layer digest: %w", err)
+			}
+			if seen[layerDigest] {
+				continue
+			}
+			seen[layerDigest] = true
+
+			rc, err := layer.Uncompressed()
+			if err != nil {
+				return nil, fmt.Errorf("fetch layer %s: %w", layerDigest, err)
+			}
+			leaks, err := scanLayer(rc, secrets)
+			rc.Close()
+			if err != nil {
+				return nil, fmt.Errorf("scan layer %s: %w", layerDigest, err)
+			}
+			for _, leak := range leaks {
+				scan.Leaks = append(scan.Leaks, fmt.Sprintf("layer %s: %s", layerDigest, leak))
+			}
+			scan.LayersScanned++
+		}
+	}
+	scan.Passed = len(scan.Leaks) == 0
+	return scan, nil
+}
+
+// scanLayer reads an uncompressed layer tarball and describes each file that
+// is under /run/secrets or contains a secret value. Directories and empty
+// files under /run/secrets, such as mount points BuildKit left behind, hold
+// nothing and are allowed.
+func scanLayer(r io.Reader, secrets map[string][]byte) ([]string, error) {
+	var leaks []string
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return leaks, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		filePath := path.Clean("/" + hdr.Name)
+		if strings.HasPrefix(filePath, "/run/secrets/") && hdr.Size > 0 {
+			leaks = append(leaks, fmt.Sprintf("%s is a file under /run/secrets", filePath))
+		}
+		ids, err := findSecrets(tr, secrets)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			leaks = append(leaks, fmt.Sprintf("%s contains secret %q", filePath, id))
+		}
+	}
+}
+
+// findSecrets returns the IDs of the secrets whose value appears in r, in
+// order. r is read in chunks, keeping enough of the previous one to match a
+// value that straddles them.
+func findSecrets(r io.Reader, secrets map[string][]byte) ([]string, error) {
+	if len(secrets) == 0 {
+		return nil, nil
+	}
+	keep := 0
+	for _, v := range secrets {
+		keep = max(keep, len(v)-1)
+	}
+
+	found := make(map[string]bool)
+	chunk := make([]byte, 64*1024)
+	buf := make([]byte, 0, len(chunk)+keep)
+	for {
+		n, err := r.Read(chunk)
+		buf = append(buf, chunk[:n]...)
+		for id, v := range secrets {
+			if !found[id] && bytes.Contains(buf, v) {
+				found[id] = true
+			}
+		}
+		if len(buf) > keep {
+			buf = buf[:copy(buf, buf[len(buf)-keep:])]
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]string, 0, len(found))
+	for id := range found {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
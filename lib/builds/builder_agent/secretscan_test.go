@@ -0,0 +1,57 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func layerTar(t *testing.T, files map[string]string) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "run/secrets/", Typeflag: tar.TypeDir, Mode: 0755}))
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return &buf
+}
+
+func TestScanLayer(t *testing.T) {
+	secrets := map[string][]byte{"npm_token": []byte("npm_s3cr3t_value")}
+
+	// Mount points left behind empty are fine
+	leaks, err := scanLayer(layerTar(t, map[string]string{
+		"run/secrets/npm_token": "",
+		"app/index.js":          "console.log('hi')",
+	}), secrets)
+	require.NoError(t, err)
+	assert.Empty(t, leaks)
+
+	leaks, err = scanLayer(layerTar(t, map[string]string{
+		"run/secrets/npm_token": "anything",
+		"root/.npmrc":           "//registry.npmjs.org/:_authToken=npm_s3cr3t_value\n",
+	}), secrets)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"/run/secrets/npm_token is a file under /run/secrets",
+		`/root/.npmrc contains secret "npm_token"`,
+	}, leaks)
+}
+
+func TestFindSecretsAcrossChunks(t *testing.T) {
+	secret := []byte("straddling-secret")
+	// Put the value across the boundary of the 64KiB read chunks
+	content := strings.Repeat("x", 64*1024-5) + string(secret) + "tail"
+
+	ids, err := findSecrets(strings.NewReader(content), map[string][]byte{"a": secret, "b": []byte("not-present-value")})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, ids)
+}
@@ -0,0 +1,58 @@
+package builds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onkernel/hypeman/lib/images"
+)
+
+// BuilderImageSource looks up and pulls the image builder VMs boot from.
+// images.Manager implements it.
+type BuilderImageSource interface {
+	GetImage(ctx context.Context, name string) (*images.Image, error)
+	CreateImage(ctx context.Context, req images.CreateImageRequest) (*images.Image, error)
+}
+
+// ensureBuilderImage returns the builder image, starting a pull of it if the
+// server doesn't have it yet. The pull runs in the background, so the image
+// returned may still be pending.
+func (m *manager) ensureBuilderImage(ctx context.Context) (*images.Image, error) {
+	img, err := m.builderImages.GetImage(ctx, m.config.BuilderImage)
+	if err == nil {
+		return img, nil
+	}
+	if !errors.Is(err, images.ErrNotFound) {
+		return nil, err
+	}
+	m.logger.Info("builder image missing, pulling it", "image", m.config.BuilderImage)
+	return m.builderImages.CreateImage(ctx, images.CreateImageRequest{Name: m.config.BuilderImage})
+}
+
+// checkBuilderImage returns ErrBuilderNotReady, saying what to do about it,
+// unless the builder image is ready to boot builder VMs from. A missing image
+// is pulled on the way, so a later build finds it.
+func (m *manager) checkBuilderImage(ctx context.Context) error {
+	name := m.config.BuilderImage
+	img, err := m.ensureBuilderImage(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: builder image %s is not available and could not be pulled (%v); push it to the registry or set BUILDER_IMAGE to an image the server can pull",
+			ErrBuilderNotReady, name, err)
+	}
+
+	switch img.Status {
+	case images.StatusReady:
+		return nil
+	case images.StatusFailed:
+		reason := "unknown error"
+		if img.Error != nil {
+			reason = *img.Error
+		}
+		return fmt.Errorf("%w: pulling builder image %s failed (%s); delete the image and submit the build again to retry the pull, or set BUILDER_IMAGE",
+			ErrBuilderNotReady, name, reason)
+	default:
+		return fmt.Errorf("%w: builder image %s is being pulled (status %s); retry the build once the image is ready",
+			ErrBuilderNotReady, name, img.Status)
+	}
+}
@@ -94,6 +94,20 @@ func ValidateCacheScope(scope string) error {
 	return nil
 }
 
+// cacheRepoPattern matches cache scopes usable as a registry repository path
+var cacheRepoPattern = regexp.MustCompile(`^[a-z0-9]+(?:[._-][a-z0-9]+)*(?:/[a-z0-9]+(?:[._-][a-z0-9]+)*)*$`)
+
+// validateCacheImports checks that each imported cache scope can be used in
+// a registry reference. Imports can name any scope, since they are only read.
+func validateCacheImports(scopes []string) error {
+	for _, scope := range scopes {
+		if !cacheRepoPattern.MatchString(scope) {
+			return fmt.Errorf("%w: %q", ErrInvalidCacheScope, scope)
+		}
+	}
+	return nil
+}
+
 // ImportCacheArg returns the BuildKit --import-cache argument
 func (k *CacheKey) ImportCacheArg() string {
 	return fmt.Sprintf("type=registry,ref=%s", k.Reference)
@@ -132,6 +132,16 @@ func TestValidateCacheScope(t *testing.T) {
 	}
 }
 
+func TestValidateCacheImports(t *testing.T) {
+	assert.NoError(t, validateCacheImports(nil))
+	assert.NoError(t, validateCacheImports([]string{"shared-base", "team/node_20", "v1.2"}))
+
+	for _, scope := range []string{"", "Shared", "../builds/x", "a//b", "-base", "base cache"} {
+		err := validateCacheImports([]string{"shared-base", scope})
+		assert.ErrorIs(t, err, ErrInvalidCacheScope, scope)
+	}
+}
+
 func TestNormalizeCacheScope(t *testing.T) {
 	tests := []struct {
 		input    string
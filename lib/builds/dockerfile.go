@@ -0,0 +1,273 @@
+package builds
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Dockerfile pre-validation.
+//
+// This catches obvious mistakes in an inline Dockerfile (unknown instructions,
+// a missing FROM, bad stage references, build args nothing declares) before
+// a builder VM is booted. It is deliberately shallow: BuildKit in the VM is
+// still the authority, so anything this doesn't understand is let through.
+
+// dockerfileInstructions are the instructions of the standard Dockerfile frontend
+var dockerfileInstructions = map[string]bool{
+	"ADD": true, "ARG": true, "CMD": true, "COPY": true, "ENTRYPOINT": true,
+	"ENV": true, "EXPOSE": true, "FROM": true, "HEALTHCHECK": true, "LABEL": true,
+	"MAINTAINER": true, "ONBUILD": true, "RUN": true, "SHELL": true,
+	"STOPSIGNAL": true, "USER": true, "VOLUME": true, "WORKDIR": true,
+}
+
+// predefinedBuildArgs may be passed without a matching ARG instruction
+var predefinedBuildArgs = map[string]bool{
+	"HTTP_PROXY": true, "http_proxy": true, "HTTPS_PROXY": true, "https_proxy": true,
+	"FTP_PROXY": true, "ftp_proxy": true, "NO_PROXY": true, "no_proxy": true,
+	"ALL_PROXY": true, "all_proxy": true,
+	"BUILDKIT_INLINE_CACHE": true, "BUILDKIT_MULTI_PLATFORM": true,
+	"BUILDKIT_SANDBOX_HOSTNAME": true, "BUILDKIT_CONTEXT_KEEP_GIT_DIR": true,
+	"SOURCE_DATE_EPOCH": true,
+}
+
+var (
+	// stageNamePattern matches the stage names BuildKit accepts
+	stageNamePattern = regexp.MustCompile(`^[a-z][a-z0-9._-]*$`)
+	// heredocPattern matches a heredoc start like <<EOF, <<-EOF or <<"EOF"
+	heredocPattern = regexp.MustCompile(`<<(-?)(["']?)([A-Za-z_][A-Za-z0-9_]*)(["']?)`)
+)
+
+// dockerfileLine is one logical instruction, with continuations joined
+type dockerfileLine struct {
+	number      int // line the instruction starts on
+	instruction string
+	args        string
+}
+
+// validateDockerfile checks an inline Dockerfile's syntax and its references
+// to stages and build args. Dockerfiles selecting another frontend with a
+// "# syntax=" directive are not checked, since that frontend defines the syntax.
+func validateDockerfile(dockerfile string, buildArgs map[string]string) error {
+	lines, directives, err := parseDockerfile(dockerfile)
+	if err != nil {
+		return err
+	}
+	if _, ok := directives["syntax"]; ok {
+		return nil
+	}
+	if len(lines) == 0 {
+		return fmt.Errorf("%w: no instructions", ErrInvalidDockerfile)
+	}
+
+	declaredArgs := map[string]bool{}
+	stages := map[string]int{}
+	stageCount := 0
+
+	for _, line := range lines {
+		fail := func(format string, a ...any) error {
+			return fmt.Errorf("%w: line %d: %s %s", ErrInvalidDockerfile, line.number, line.instruction, fmt.Sprintf(format, a...))
+		}
+
+		if !dockerfileInstructions[line.instruction] {
+			return fmt.Errorf("%w: line %d: unknown instruction %s", ErrInvalidDockerfile, line.number, line.instruction)
+		}
+		if stageCount == 0 && line.instruction != "FROM" && line.instruction != "ARG" {
+			return fail("before the first FROM (only ARG may precede FROM)")
+		}
+		if line.args == "" {
+			return fail("requires at least one argument")
+		}
+
+		switch line.instruction {
+		case "FROM":
+			name, err := parseFrom(line.args)
+			if err != nil {
+				return fail("%v", err)
+			}
+			if name != "" {
+				if !stageNamePattern.MatchString(name) {
+					return fail("has invalid stage name %q (use lowercase letters, digits, '.', '_' and '-')", name)
+				}
+				if _, dup := stages[name]; dup {
+					return fail("redefines stage %q", name)
+				}
+				stages[name] = stageCount
+			}
+			stageCount++
+
+		case "ARG":
+			for _, field := range strings.Fields(line.args) {
+				name, _, _ := strings.Cut(field, "=")
+				declaredArgs[name] = true
+			}
+
+		case "COPY", "ADD", "RUN":
+			for _, ref := range stageRefs(line.instruction, line.args) {
+				if idx, err := strconv.Atoi(ref); err == nil {
+					if idx < 0 || idx >= stageCount-1 {
+						return fail("refers to stage %d, which is not an earlier stage", idx)
+					}
+					continue
+				}
+				// Anything that isn't a known stage name is an image reference
+				if idx, ok := stages[ref]; ok && idx == stageCount-1 {
+					return fail("refers to its own stage %q", ref)
+				}
+			}
+		}
+	}
+
+	for name := range buildArgs {
+		if !declaredArgs[name] && !predefinedBuildArgs[name] {
+			return fmt.Errorf("%w: build arg %s is not declared by any ARG instruction", ErrInvalidDockerfile, name)
+		}
+	}
+	return nil
+}
+
+// parseDockerfile splits a Dockerfile into logical instructions, joining
+// continuation lines and skipping comments and heredoc bodies. It also
+// returns the parser directives from the top of the file.
+func parseDockerfile(dockerfile string) ([]dockerfileLine, map[string]string, error) {
+	rawLines := strings.Split(strings.ReplaceAll(dockerfile, "\r\n", "\n"), "\n")
+	directives := map[string]string{}
+	escape := `\`
+
+	// Parser directives are "# key=value" comments before anything else
+	i := 0
+	for ; i < len(rawLines); i++ {
+		trimmed := strings.TrimSpace(rawLines[i])
+		body, ok := strings.CutPrefix(trimmed, "#")
+		if !ok {
+			break
+		}
+		key, value, ok := strings.Cut(strings.TrimSpace(body), "=")
+		if !ok {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		if key != "syntax" && key != "escape" && key != "check" {
+			break
+		}
+		directives[key] = strings.TrimSpace(value)
+	}
+	if e, ok := directives["escape"]; ok {
+		if e != `\` && e != "`" {
+			return nil, nil, fmt.Errorf("%w: invalid escape directive %q", ErrInvalidDockerfile, e)
+		}
+		escape = e
+	}
+
+	var lines []dockerfileLine
+	var current *dockerfileLine
+	var logical strings.Builder
+	for ; i < len(rawLines); i++ {
+		raw := rawLines[i]
+		trimmed := strings.TrimSpace(raw)
+
+		if current == nil {
+			if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+				continue
+			}
+			current = &dockerfileLine{number: i + 1}
+		} else if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			// Blank lines and comments inside a continued instruction are dropped
+			continue
+		}
+
+		if cont, ok := strings.CutSuffix(strings.TrimRightFunc(raw, isSpace), escape); ok {
+			logical.WriteString(cont)
+			logical.WriteString(" ")
+			continue
+		}
+		logical.WriteString(raw)
+
+		args := current.finish(logical.String())
+		lines = append(lines, *current)
+		current = nil
+		logical.Reset()
+
+		// Skip heredoc bodies (RUN <<EOF ... EOF)
+		for _, m := range heredocPattern.FindAllStringSubmatch(args, -1) {
+			stripTabs, delimiter := m[1] == "-", m[3]
+			for i++; i < len(rawLines); i++ {
+				body := rawLines[i]
+				if stripTabs {
+					body = strings.TrimLeft(body, "\t")
+				}
+				if body == delimiter {
+					break
+				}
+			}
+			if i >= len(rawLines) {
+				return nil, nil, fmt.Errorf("%w: line %d: unterminated heredoc %s", ErrInvalidDockerfile, lines[len(lines)-1].number, delimiter)
+			}
+		}
+	}
+	// Like BuildKit, a continuation at the end of the file just ends the instruction
+	if current != nil {
+		current.finish(logical.String())
+		lines = append(lines, *current)
+	}
+
+	return lines, directives, nil
+}
+
+// finish splits a joined logical line into the instruction and its
+// arguments, returning the arguments
+func (l *dockerfileLine) finish(logical string) string {
+	logical = strings.TrimSpace(logical)
+	instruction, args := logical, ""
+	if idx := strings.IndexFunc(logical, isSpace); idx >= 0 {
+		instruction, args = logical[:idx], logical[idx+1:]
+	}
+	l.instruction = strings.ToUpper(instruction)
+	l.args = strings.TrimSpace(args)
+	return l.args
+}
+
+// parseFrom checks "FROM [--flag=value...] image [AS name]" and returns the
+// stage name, if any
+func parseFrom(args string) (string, error) {
+	fields := strings.Fields(args)
+	for len(fields) > 0 && strings.HasPrefix(fields[0], "--") {
+		fields = fields[1:]
+	}
+	switch {
+	case len(fields) == 1:
+		return "", nil
+	case len(fields) == 3 && strings.EqualFold(fields[1], "AS"):
+		return strings.ToLower(fields[2]), nil
+	case len(fields) == 0:
+		return "", fmt.Errorf("requires an image")
+	default:
+		return "", fmt.Errorf("expects \"FROM image [AS name]\"")
+	}
+}
+
+// stageRefs returns the stages or images an instruction copies or mounts from
+func stageRefs(instruction, args string) []string {
+	var refs []string
+	for _, field := range strings.Fields(args) {
+		if !strings.HasPrefix(field, "--") {
+			break // flags come first
+		}
+		if from, ok := strings.CutPrefix(field, "--from="); ok && instruction != "RUN" {
+			refs = append(refs, strings.ToLower(from))
+		}
+		if mount, ok := strings.CutPrefix(field, "--mount="); ok {
+			for _, opt := range strings.Split(mount, ",") {
+				if from, ok := strings.CutPrefix(opt, "from="); ok {
+					refs = append(refs, strings.ToLower(from))
+				}
+			}
+		}
+	}
+	return refs
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t'
+}
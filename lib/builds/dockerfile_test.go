@@ -0,0 +1,68 @@
+package builds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDockerfile_Valid(t *testing.T) {
+	dockerfile := `# escape=\
+ARG NODE_VERSION=20
+FROM node:${NODE_VERSION}-alpine AS deps
+WORKDIR /app
+COPY package.json \
+     package-lock.json ./
+RUN npm ci
+
+# Heredoc bodies aren't parsed as instructions
+RUN <<EOF
+set -e
+echo not an instruction
+EOF
+
+FROM --platform=linux/amd64 node:20-alpine
+ARG APP_ENV
+COPY --from=deps /app/node_modules ./node_modules
+COPY --from=0 /app/package.json ./
+RUN --mount=type=cache,target=/root/.npm --mount=type=bind,from=deps,source=/app,target=/deps ls /deps
+CMD ["node", "index.js"]
+`
+	err := validateDockerfile(dockerfile, map[string]string{"APP_ENV": "prod", "HTTP_PROXY": "http://proxy"})
+	assert.NoError(t, err)
+}
+
+func TestValidateDockerfile_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		dockerfile string
+		buildArgs  map[string]string
+		wantErr    string
+	}{
+		{"empty", "# just a comment\n", nil, "no instructions"},
+		{"typo", "FROM alpine\nRUNN echo hi\n", nil, "line 2: unknown instruction RUNN"},
+		{"no from", "RUN echo hi\n", nil, "line 1: RUN before the first FROM"},
+		{"missing args", "FROM alpine\nWORKDIR\n", nil, "line 2: WORKDIR requires at least one argument"},
+		{"bad from", "FROM alpine as\n", nil, `expects "FROM image [AS name]"`},
+		{"bad stage name", "FROM alpine AS 1st\n", nil, `invalid stage name "1st"`},
+		{"duplicate stage", "FROM alpine AS base\nFROM alpine AS base\n", nil, `redefines stage "base"`},
+		{"stage index", "FROM alpine\nCOPY --from=1 /a /b\n", nil, "refers to stage 1"},
+		{"self reference", "FROM alpine AS app\nCOPY --from=app /a /b\n", nil, `refers to its own stage "app"`},
+		{"undeclared arg", "FROM alpine\nARG VERSION\n", map[string]string{"VERSOIN": "1"}, "build arg VERSOIN is not declared"},
+		{"unterminated heredoc", "FROM alpine\nRUN <<EOF\necho hi\n", nil, "unterminated heredoc EOF"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDockerfile(tt.dockerfile, tt.buildArgs)
+			assert.ErrorIs(t, err, ErrInvalidDockerfile)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateDockerfile_SyntaxDirective(t *testing.T) {
+	// Another frontend may accept instructions the standard one doesn't
+	dockerfile := "# syntax=example.com/experimental-frontend\nFROM alpine\nFROBNICATE all\n"
+	assert.NoError(t, validateDockerfile(dockerfile, nil))
+}
@@ -6,6 +6,9 @@ var (
 	// ErrNotFound is returned when a build is not found
 	ErrNotFound = errors.New("build not found")
 
+	// ErrAmbiguousID is returned when an ID prefix matches multiple builds
+	ErrAmbiguousID = errors.New("ambiguous build ID prefix matches multiple builds")
+
 	// ErrAlreadyExists is returned when a build with the same ID already exists
 	ErrAlreadyExists = errors.New("build already exists")
 
@@ -18,18 +21,48 @@ var (
 	// ErrBuildTimeout is returned when a build exceeds its timeout
 	ErrBuildTimeout = errors.New("build timeout")
 
+	// ErrBuildOutOfMemory is the error a build fails with when a build step
+	// is killed for running out of memory in the builder VM
+	ErrBuildOutOfMemory = errors.New("out of memory")
+
 	// ErrBuildCancelled is returned when a build is cancelled
 	ErrBuildCancelled = errors.New("build cancelled")
 
 	// ErrInvalidSource is returned when the source tarball is invalid
 	ErrInvalidSource = errors.New("invalid source")
 
+	// ErrSourceTooLarge is returned when a source tarball exceeds the maximum size
+	ErrSourceTooLarge = errors.New("source too large")
+
 	// ErrSourceHashMismatch is returned when the source hash doesn't match
 	ErrSourceHashMismatch = errors.New("source hash mismatch")
 
 	// ErrBuilderNotReady is returned when the builder image is not available
 	ErrBuilderNotReady = errors.New("builder image not ready")
 
+	// ErrInvalidDockerfile is returned when an inline Dockerfile fails pre-validation
+	ErrInvalidDockerfile = errors.New("invalid dockerfile")
+
+	// ErrFrontendNotAllowed is returned when a build selects a frontend that isn't allowlisted
+	ErrFrontendNotAllowed = errors.New("frontend not allowed")
+
+	// ErrInvalidFrontendOption is returned when a frontend option would override one the builder sets
+	ErrInvalidFrontendOption = errors.New("invalid frontend option")
+
+	// ErrInvalidOutputType is returned when a build requests an unknown output type
+	ErrInvalidOutputType = errors.New("invalid output type")
+
+	// ErrNoArtifact is returned when a build has no artifact to download, either
+	// because it pushes an image or because it hasn't finished successfully
+	ErrNoArtifact = errors.New("build has no artifact")
+
+	// ErrInvalidBuildPolicy is returned when a build policy asks for resources
+	// outside the server's configured bounds
+	ErrInvalidBuildPolicy = errors.New("invalid build policy")
+
+	// ErrInvalidCacheScope is returned when a cache scope isn't a valid registry path
+	ErrInvalidCacheScope = errors.New("invalid cache scope")
+
 	// ErrBuildInProgress is returned when trying to cancel a build that's already complete
 	ErrBuildInProgress = errors.New("build in progress")
 )
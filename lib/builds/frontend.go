@@ -0,0 +1,57 @@
+package builds
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DefaultFrontend is BuildKit's built-in Dockerfile frontend
+const DefaultFrontend = "dockerfile.v0"
+
+// reservedFrontendOpts are set by the builder agent and can't be overridden:
+// "source" selects the frontend image itself
+var reservedFrontendOpts = []string{"source"}
+
+// isDefaultFrontend reports whether a build uses the built-in Dockerfile frontend
+func isDefaultFrontend(frontend string) bool {
+	return frontend == "" || frontend == DefaultFrontend
+}
+
+// validateFrontend checks that a frontend image is allowlisted and that the
+// frontend options don't override what the builder agent sets itself.
+// allowed lists image repositories; any tag or digest of them may be used.
+func validateFrontend(frontend string, opts map[string]string, allowed []string) error {
+	for key := range opts {
+		if key == "" || slices.Contains(reservedFrontendOpts, key) {
+			return fmt.Errorf("%w: %q is set by the builder", ErrInvalidFrontendOption, key)
+		}
+	}
+	if isDefaultFrontend(frontend) {
+		return nil
+	}
+
+	repo := frontendImageRepo(frontend)
+	for _, a := range allowed {
+		if repo == frontendImageRepo(a) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s (allowed: %s)", ErrFrontendNotAllowed, frontend, strings.Join(append([]string{DefaultFrontend}, allowed...), ", "))
+}
+
+// frontendImageRepo strips the tag or digest from an image reference and
+// normalizes Docker Hub names, so "docker/dockerfile:1.7" and
+// "docker.io/docker/dockerfile@sha256:..." both give "docker/dockerfile"
+func frontendImageRepo(ref string) string {
+	ref, _, _ = strings.Cut(ref, "@")
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		ref = ref[:i]
+	}
+	ref = strings.TrimPrefix(ref, "docker.io/")
+	ref = strings.TrimPrefix(ref, "index.docker.io/")
+	if !strings.Contains(ref, "/") {
+		ref = "library/" + ref
+	}
+	return strings.ToLower(ref)
+}
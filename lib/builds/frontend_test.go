@@ -0,0 +1,46 @@
+package builds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFrontend(t *testing.T) {
+	allowed := []string{"docker/dockerfile", "ghcr.io/example/buildpacks-frontend"}
+
+	for _, frontend := range []string{
+		"",
+		DefaultFrontend,
+		"docker/dockerfile:1.7",
+		"docker.io/docker/dockerfile@sha256:4c68376a702446fc3c79af22de146a148bc3367e73c25a5803d453b6b3f722fb",
+		"ghcr.io/example/buildpacks-frontend:v2",
+	} {
+		assert.NoError(t, validateFrontend(frontend, nil, allowed), frontend)
+	}
+
+	err := validateFrontend("example.com/evil/frontend:latest", nil, allowed)
+	assert.ErrorIs(t, err, ErrFrontendNotAllowed)
+	assert.ErrorContains(t, err, "allowed: dockerfile.v0, docker/dockerfile")
+
+	// A tag of the allowlisted image doesn't allow other images under that name
+	assert.ErrorIs(t, validateFrontend("docker/dockerfile-evil:1", nil, allowed), ErrFrontendNotAllowed)
+
+	err = validateFrontend("docker/dockerfile:1.7", map[string]string{"source": "example.com/evil"}, allowed)
+	assert.ErrorIs(t, err, ErrInvalidFrontendOption)
+	assert.NoError(t, validateFrontend("", map[string]string{"target": "production"}, allowed))
+}
+
+func TestFrontendImageRepo(t *testing.T) {
+	tests := map[string]string{
+		"docker/dockerfile":                    "docker/dockerfile",
+		"docker/dockerfile:1.7":                "docker/dockerfile",
+		"index.docker.io/docker/dockerfile:1":  "docker/dockerfile",
+		"alpine":                               "library/alpine",
+		"localhost:5000/frontend:dev":          "localhost:5000/frontend",
+		"GHCR.io/Example/Frontend@sha256:abcd": "ghcr.io/example/frontend",
+	}
+	for ref, want := range tests {
+		assert.Equal(t, want, frontendImageRepo(ref), ref)
+	}
+}
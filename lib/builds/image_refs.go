@@ -0,0 +1,34 @@
+package builds
+
+import (
+	"context"
+
+	"github.com/onkernel/hypeman/lib/images"
+)
+
+// imageReferenceAdapter adapts builds.Manager to images.ReferenceSource
+type imageReferenceAdapter struct {
+	manager Manager
+}
+
+// NewImageReferenceSource creates an images.ReferenceSource reporting build
+// output images, so image garbage collection keeps them.
+func NewImageReferenceSource(m Manager) images.ReferenceSource {
+	return &imageReferenceAdapter{manager: m}
+}
+
+// ImageReferences returns the output image digest of every completed build
+func (a *imageReferenceAdapter) ImageReferences(ctx context.Context) ([]string, error) {
+	builds, err := a.manager.ListBuilds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var digests []string
+	for _, b := range builds {
+		if b.ImageDigest != nil && *b.ImageDigest != "" {
+			digests = append(digests, *b.ImageDigest)
+		}
+	}
+	return digests, nil
+}
@@ -4,22 +4,31 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/nrednav/cuid2"
+	"github.com/onkernel/hypeman/lib/hypervisor"
 	"github.com/onkernel/hypeman/lib/images"
 	"github.com/onkernel/hypeman/lib/instances"
+	"github.com/onkernel/hypeman/lib/pagination"
 	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/onkernel/hypeman/lib/refindex"
 	"github.com/onkernel/hypeman/lib/volumes"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Manager interface for the build system
@@ -28,15 +37,22 @@ type Manager interface {
 	// This should be called once when the API server starts.
 	Start(ctx context.Context) error
 
-	// CreateBuild starts a new build job
-	CreateBuild(ctx context.Context, req CreateBuildRequest, sourceData []byte) (*Build, error)
+	// StageSource streams a build's source tarball to disk ahead of CreateBuild
+	StageSource(ctx context.Context, r io.Reader) (*StagedSource, error)
 
-	// GetBuild returns a build by ID
+	// CreateBuild starts a new build job from a staged source
+	CreateBuild(ctx context.Context, req CreateBuildRequest, source *StagedSource) (*Build, error)
+
+	// GetBuild returns a build by ID or unique ID prefix
 	GetBuild(ctx context.Context, id string) (*Build, error)
 
 	// ListBuilds returns all builds
 	ListBuilds(ctx context.Context) ([]*Build, error)
 
+	// ListBuildsPage returns one page of builds, sorted by created_at
+	// (default newest first) or status
+	ListBuildsPage(ctx context.Context, req pagination.Request) (*pagination.Page[*Build], error)
+
 	// CancelBuild cancels a pending or running build
 	CancelBuild(ctx context.Context, id string) error
 
@@ -48,8 +64,24 @@ type Manager interface {
 	// With follow=true, continues streaming until build completes or context cancels
 	StreamBuildEvents(ctx context.Context, id string, follow bool) (<-chan BuildEvent, error)
 
+	// GetBuildArtifact returns the files exported by a "local" or "tar" build
+	// as a tar stream. Returns ErrNoArtifact for image builds and builds that
+	// haven't succeeded.
+	GetBuildArtifact(ctx context.Context, id string) (io.ReadCloser, error)
+
+	// QueueStats returns the build queue's running and queued builds, overall
+	// and per tenant
+	QueueStats() QueueStats
+
 	// RecoverPendingBuilds recovers builds that were interrupted on restart
 	RecoverPendingBuilds()
+
+	// SetReferenceIndex makes the manager record in idx the builder VM of
+	// each running build
+	SetReferenceIndex(idx *refindex.Index)
+
+	// IndexedReferences returns the builder VM of each running build
+	IndexedReferences(ctx context.Context) (map[refindex.Ref][]refindex.Ref, error)
 }
 
 // Config holds configuration for the build manager
@@ -70,6 +102,24 @@ type Config struct {
 	// RegistrySecret is the secret used to sign registry access tokens
 	// This should be the same secret used by the registry middleware
 	RegistrySecret string
+
+	// AllowedFrontends are the frontend images (repository, without tag or
+	// digest) builds may select besides the built-in dockerfile.v0
+	AllowedFrontends []string
+
+	// BuilderPoolSize is how many booted builder VMs are kept waiting for
+	// builds that use the default build policy (0 = disabled). It is capped
+	// at MaxConcurrentBuilds.
+	BuilderPoolSize int
+
+	// MinMemoryMB and MaxMemoryMB bound the memory a build policy may give
+	// the builder VM (0 = no bound)
+	MinMemoryMB int
+	MaxMemoryMB int
+
+	// MaxSourceSize is the largest source tarball a build accepts, in
+	// bytes (0 = no limit)
+	MaxSourceSize int64
 }
 
 // DefaultConfig returns the default build manager configuration
@@ -88,15 +138,24 @@ type manager struct {
 	queue           *BuildQueue
 	instanceManager instances.Manager
 	volumeManager   volumes.Manager
+	builderImages   BuilderImageSource
 	secretProvider  SecretProvider
+	baseImages      BaseImageCache // nil when builder VMs pull base images directly
 	tokenGenerator  *RegistryTokenGenerator
 	logger          *slog.Logger
 	metrics         *Metrics
+	pool            *builderPool // nil when the builder pool is disabled
+	refIndex        atomic.Pointer[refindex.Index]
 	createMu        sync.Mutex
+	queueSeq        uint64 // Last enqueue sequence number handed out (guarded by createMu)
 
 	// Status subscription system for SSE streaming
 	statusSubscribers map[string][]chan BuildEvent
 	subscriberMu      sync.RWMutex
+
+	// Cancel funcs of the builds running in this process, for CancelBuild
+	running   map[string]context.CancelFunc
+	runningMu sync.Mutex
 }
 
 // NewManager creates a new build manager
@@ -105,9 +164,12 @@ func NewManager(
 	config Config,
 	instanceMgr instances.Manager,
 	volumeMgr volumes.Manager,
+	builderImages BuilderImageSource,
 	secretProvider SecretProvider,
+	baseImages BaseImageCache,
 	logger *slog.Logger,
 	meter metric.Meter,
+	tracer trace.Tracer,
 ) (Manager, error) {
 	if logger == nil {
 		logger = slog.Default()
@@ -119,21 +181,35 @@ func NewManager(
 		queue:             NewBuildQueue(config.MaxConcurrentBuilds),
 		instanceManager:   instanceMgr,
 		volumeManager:     volumeMgr,
+		builderImages:     builderImages,
 		secretProvider:    secretProvider,
+		baseImages:        baseImages,
 		tokenGenerator:    NewRegistryTokenGenerator(config.RegistrySecret),
 		logger:            logger,
 		statusSubscribers: make(map[string][]chan BuildEvent),
+		running:           make(map[string]context.CancelFunc),
 	}
 
 	// Initialize metrics if meter is provided
 	if meter != nil {
-		metrics, err := NewMetrics(meter)
+		metrics, err := NewMetrics(meter, tracer)
 		if err != nil {
 			return nil, fmt.Errorf("create metrics: %w", err)
 		}
 		m.metrics = metrics
 	}
 
+	// Pooled builders get their volumes hotplugged, so the pool needs a
+	// hypervisor that supports it
+	if size := min(config.BuilderPoolSize, config.MaxConcurrentBuilds); size > 0 {
+		caps, _ := hypervisor.CapabilitiesForType(instanceMgr.DefaultHypervisor())
+		if caps.SupportsHotplugDisk {
+			m.pool = newBuilderPool(instanceMgr, config.BuilderImage, size, logger)
+		} else {
+			logger.Warn("builder pool disabled, the default hypervisor cannot hotplug disks", "hypervisor", instanceMgr.DefaultHypervisor())
+		}
+	}
+
 	// Recover any pending builds from disk
 	m.RecoverPendingBuilds()
 
@@ -145,14 +221,28 @@ func (m *manager) Start(ctx context.Context) error {
 	// Note: We no longer use a global vsock listener.
 	// Instead, we connect TO each builder VM's vsock socket directly.
 	// This follows the Cloud Hypervisor vsock pattern where host initiates connections.
+
+	m.clearStagedSources()
+
+	// Start pulling the builder image on a fresh install, so it's there by
+	// the first build. Builds are refused until it is.
+	if err := m.checkBuilderImage(ctx); err != nil {
+		m.logger.Warn("builder image not ready", "error", err)
+	}
+	if m.pool != nil {
+		m.pool.start(ctx)
+	}
 	m.logger.Info("build manager started")
 	return nil
 }
 
 // CreateBuild starts a new build job
-func (m *manager) CreateBuild(ctx context.Context, req CreateBuildRequest, sourceData []byte) (*Build, error) {
+func (m *manager) CreateBuild(ctx context.Context, req CreateBuildRequest, source *StagedSource) (*Build, error) {
 	m.logger.Info("creating build")
 
+	ctx, span := m.startSpan(ctx, "CreateBuild")
+	defer span.End()
+
 	// Apply defaults to build policy
 	policy := req.BuildPolicy
 	if policy == nil {
@@ -162,18 +252,47 @@ func (m *manager) CreateBuild(ctx context.Context, req CreateBuildRequest, sourc
 		policy.ApplyDefaults()
 	}
 
+	if err := validateBuildPolicy(policy, m.config); err != nil {
+		return nil, err
+	}
+	if err := m.checkBuilderImage(ctx); err != nil {
+		return nil, err
+	}
+	if err := validateCacheImports(req.CacheImports); err != nil {
+		return nil, err
+	}
+	if err := validateOutputType(req.OutputType); err != nil {
+		return nil, err
+	}
+	if err := validateFrontend(req.Frontend, req.FrontendOpts, m.config.AllowedFrontends); err != nil {
+		return nil, err
+	}
+
+	// Fail fast on Dockerfile mistakes rather than after booting a builder VM.
+	// Dockerfiles inside the source tarball are only checked by BuildKit, as
+	// are Dockerfiles for frontend images, which may extend the syntax.
+	if req.Dockerfile != "" && !req.SkipDockerfileValidation && isDefaultFrontend(req.Frontend) {
+		if err := validateDockerfile(req.Dockerfile, req.BuildArgs); err != nil {
+			return nil, err
+		}
+	}
+
 	m.createMu.Lock()
 	defer m.createMu.Unlock()
 
 	// Generate build ID
 	id := cuid2.Generate()
+	attrs := buildSpanAttributes(id, req)
+	span.SetAttributes(attrs...)
 
 	// Create build metadata
+	m.queueSeq++
 	meta := &buildMetadata{
 		ID:        id,
 		Status:    StatusQueued,
 		Request:   &req,
 		CreatedAt: time.Now(),
+		QueueSeq:  m.queueSeq,
 	}
 
 	// Write initial metadata
@@ -181,23 +300,15 @@ func (m *manager) CreateBuild(ctx context.Context, req CreateBuildRequest, sourc
 		return nil, fmt.Errorf("write metadata: %w", err)
 	}
 
-	// Store source data
-	if err := m.storeSource(id, sourceData); err != nil {
+	// Move the staged source into the build
+	if err := m.storeSource(id, source); err != nil {
 		deleteBuild(m.paths, id)
 		return nil, fmt.Errorf("store source: %w", err)
 	}
 
 	// Generate scoped registry token for this build
-	// Token grants push access to the build output repo and cache repo
-	allowedRepos := []string{fmt.Sprintf("builds/%s", id)}
-	if req.CacheScope != "" {
-		allowedRepos = append(allowedRepos, fmt.Sprintf("cache/%s", req.CacheScope))
-	}
-	tokenTTL := time.Duration(policy.TimeoutSeconds) * time.Second
-	if tokenTTL < 30*time.Minute {
-		tokenTTL = 30 * time.Minute // Minimum 30 minutes
-	}
-	registryToken, err := m.tokenGenerator.GeneratePushToken(id, allowedRepos, tokenTTL)
+	baseImages := m.baseImagesFor(&req, m.paths.BuildSourceDir(id)+"/source.tar.gz")
+	registryToken, err := m.generateBuildToken(id, &req, policy, baseImages)
 	if err != nil {
 		deleteBuild(m.paths, id)
 		return nil, fmt.Errorf("generate registry token: %w", err)
@@ -210,6 +321,10 @@ func (m *manager) CreateBuild(ctx context.Context, req CreateBuildRequest, sourc
 		RegistryURL:     m.config.RegistryURL,
 		RegistryToken:   registryToken,
 		CacheScope:      req.CacheScope,
+		CacheImports:    req.CacheImports,
+		Frontend:        req.Frontend,
+		FrontendOpts:    req.FrontendOpts,
+		OutputType:      req.outputType(),
 		SourcePath:      "/src",
 		Dockerfile:      req.Dockerfile,
 		BuildArgs:       req.BuildArgs,
@@ -217,14 +332,27 @@ func (m *manager) CreateBuild(ctx context.Context, req CreateBuildRequest, sourc
 		TimeoutSeconds:  policy.TimeoutSeconds,
 		NetworkMode:     policy.NetworkMode,
 	}
+	if isArtifactOutput(buildConfig.OutputType) {
+		buildConfig.OutputPath = artifactMountPath
+	}
+	if len(baseImages) > 0 {
+		buildConfig.RegistryMirror = mirrorHost(m.baseImages.Upstream())
+	}
 	if err := writeBuildConfig(m.paths, id, buildConfig); err != nil {
 		deleteBuild(m.paths, id)
 		return nil, fmt.Errorf("write build config: %w", err)
 	}
 
+	// The build outlives the request, so detach from its cancellation but keep
+	// its span context: the build then shows up in the same trace as the API call
+	buildCtx := trace.ContextWithSpanContext(context.Background(), span.SpanContext())
+	enqueuedAt := time.Now()
+
 	// Enqueue the build
 	queuePos := m.queue.Enqueue(id, req, func() {
-		m.runBuild(context.Background(), id, req, policy)
+		_, waitSpan := m.startSpan(buildCtx, "BuildQueueWait", trace.WithTimestamp(enqueuedAt), trace.WithAttributes(attrs...))
+		waitSpan.End()
+		m.runBuild(buildCtx, id, req, policy)
 	})
 
 	build := meta.toBuild()
@@ -232,22 +360,10 @@ func (m *manager) CreateBuild(ctx context.Context, req CreateBuildRequest, sourc
 		build.QueuePosition = &queuePos
 	}
 
-	m.logger.Info("build created", "id", id, "queue_position", queuePos)
+	m.logger.Info("build created", "id", id, "tenant", req.Tenant, "queue_position", queuePos)
 	return build, nil
 }
 
-// storeSource stores the source tarball for a build
-func (m *manager) storeSource(buildID string, data []byte) error {
-	sourceDir := m.paths.BuildSourceDir(buildID)
-	if err := ensureDir(sourceDir); err != nil {
-		return err
-	}
-
-	// Write source tarball
-	sourcePath := sourceDir + "/source.tar.gz"
-	return writeFile(sourcePath, data)
-}
-
 // runBuild executes a build in a builder VM
 func (m *manager) runBuild(ctx context.Context, id string, req CreateBuildRequest, policy *BuildPolicy) {
 	start := time.Now()
@@ -259,6 +375,14 @@ func (m *manager) runBuild(ctx context.Context, id string, req CreateBuildReques
 	// Create timeout context
 	buildCtx, cancel := context.WithTimeout(ctx, time.Duration(policy.TimeoutSeconds)*time.Second)
 	defer cancel()
+	m.runningMu.Lock()
+	m.running[id] = cancel
+	m.runningMu.Unlock()
+	defer func() {
+		m.runningMu.Lock()
+		delete(m.running, id)
+		m.runningMu.Unlock()
+	}()
 
 	// Run the build in a builder VM
 	result, err := m.executeBuild(buildCtx, id, req, policy)
@@ -284,6 +408,10 @@ func (m *manager) runBuild(ctx context.Context, id string, req CreateBuildReques
 	}
 
 	if !result.Success {
+		if result.Error == ErrBuildOutOfMemory.Error() {
+			result.Error = fmt.Sprintf("%s: a build step used up the builder VM's %d MB, retry with a larger memory_mb in the build policy",
+				result.Error, policy.MemoryMB)
+		}
 		m.logger.Error("build failed", "id", id, "error", result.Error, "duration", duration)
 		m.updateBuildComplete(id, StatusFailed, nil, &result.Error, &result.Provenance, &durationMS)
 		if m.metrics != nil {
@@ -292,7 +420,17 @@ func (m *manager) runBuild(ctx context.Context, id string, req CreateBuildReques
 		return
 	}
 
-	m.logger.Info("build succeeded", "id", id, "digest", result.ImageDigest, "duration", duration)
+	if isArtifactOutput(req.outputType()) {
+		m.logger.Info("build succeeded", "id", id, "output_type", req.outputType(), "duration", duration)
+		m.updateBuildComplete(id, StatusReady, nil, nil, &result.Provenance, &durationMS)
+		if m.metrics != nil {
+			m.metrics.RecordBuild(ctx, "success", duration)
+		}
+		return
+	}
+
+	m.logger.Info("build succeeded", "id", id, "digest", result.ImageDigest, "duration", duration,
+		"cache_hits", result.CacheHits, "cache_misses", result.CacheMisses)
 	imageRef := fmt.Sprintf("%s/builds/%s", m.config.RegistryURL, id)
 	m.updateBuildComplete(id, StatusReady, &result.ImageDigest, nil, &result.Provenance, &durationMS)
 
@@ -309,6 +447,9 @@ func (m *manager) runBuild(ctx context.Context, id string, req CreateBuildReques
 
 // executeBuild runs the build in a builder VM
 func (m *manager) executeBuild(ctx context.Context, id string, req CreateBuildRequest, policy *BuildPolicy) (*BuildResult, error) {
+	ctx, span := m.startSpan(ctx, "ExecuteBuild", trace.WithAttributes(buildSpanAttributes(id, req)...))
+	defer span.End()
+
 	// Create a volume with the source data
 	sourceVolID := fmt.Sprintf("build-source-%s", id)
 	sourcePath := m.paths.BuildSourceDir(id) + "/source.tar.gz"
@@ -362,58 +503,124 @@ func (m *manager) executeBuild(ctx context.Context, id string, req CreateBuildRe
 	}
 	defer m.volumeManager.DeleteVolume(context.Background(), configVolID)
 
-	// Create builder instance
-	builderName := fmt.Sprintf("builder-%s", id)
-	networkEnabled := policy.NetworkMode == "egress"
-
-	inst, err := m.instanceManager.CreateInstance(ctx, instances.CreateInstanceRequest{
-		Name:           builderName,
-		Image:          m.config.BuilderImage,
-		Size:           int64(policy.MemoryMB) * 1024 * 1024,
-		Vcpus:          policy.CPUs,
-		NetworkEnabled: networkEnabled,
-		Volumes: []instances.VolumeAttachment{
-			{
-				VolumeID:  sourceVolID,
-				MountPath: "/src",
-				Readonly:  false, // Builder needs to write generated Dockerfile
-			},
-			{
-				VolumeID:  configVolID,
-				MountPath: "/config",
-				Readonly:  true,
-			},
+	attachments := []instances.VolumeAttachment{
+		{
+			VolumeID:  sourceVolID,
+			MountPath: "/src",
+			Readonly:  false, // Builder needs to write generated Dockerfile
+		},
+		{
+			VolumeID:  configVolID,
+			MountPath: "/config",
+			Readonly:  true,
 		},
-	})
-	if err != nil {
-		return nil, fmt.Errorf("create builder instance: %w", err)
 	}
 
+	// Artifact builds write their files to an empty output volume
+	outputType := req.outputType()
+	outputVolID := fmt.Sprintf("build-output-%s", id)
+	if isArtifactOutput(outputType) {
+		_, err = m.volumeManager.CreateVolume(ctx, volumes.CreateVolumeRequest{
+			Id:     &outputVolID,
+			Name:   outputVolID,
+			SizeGb: artifactVolumeSizeGB,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create output volume: %w", err)
+		}
+		defer m.volumeManager.DeleteVolume(context.Background(), outputVolID)
+		if err := formatOutputDisk(m.volumeManager.GetVolumePath(outputVolID), artifactVolumeSizeGB); err != nil {
+			return nil, fmt.Errorf("format output volume: %w", err)
+		}
+		attachments = append(attachments, instances.VolumeAttachment{
+			VolumeID:  outputVolID,
+			MountPath: artifactMountPath,
+		})
+	}
+
+	if baseImages := m.baseImagesFor(&req, sourcePath); len(baseImages) > 0 {
+		m.prefetchBaseImages(ctx, id, baseImages)
+	}
+
+	// Take a booted builder from the pool if one fits, else create one
+	inst, warm := m.pool.acquire(ctx, policy)
+	if warm {
+		if err := m.attachBuilderVolumes(ctx, inst.Id, attachments); err != nil {
+			m.pool.discard(inst.Id)
+			return nil, fmt.Errorf("attach volumes to pooled builder: %w", err)
+		}
+	} else {
+		builderName := fmt.Sprintf("builder-%s", id)
+		networkEnabled := policy.NetworkMode == "egress"
+
+		inst, err = m.instanceManager.CreateInstance(ctx, instances.CreateInstanceRequest{
+			Name:           builderName,
+			Image:          m.config.BuilderImage,
+			Size:           int64(policy.MemoryMB) * 1024 * 1024,
+			Vcpus:          policy.CPUs,
+			NetworkEnabled: networkEnabled,
+			Volumes:        attachments,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create builder instance: %w", err)
+		}
+	}
+	span.SetAttributes(attribute.Bool("warm_builder", warm))
+
 	// Update metadata with builder instance
 	if meta, err := readMetadata(m.paths, id); err == nil {
 		meta.BuilderInstance = &inst.Id
 		writeMetadata(m.paths, meta)
 	}
+	m.refIndex.Load().Set(buildRef(id), builderRefs(inst.Id))
 
-	// Ensure cleanup
-	defer func() {
-		m.instanceManager.DeleteInstance(context.Background(), inst.Id)
-	}()
+	// Ensure cleanup. A pooled builder goes back to the pool.
+	releaseBuilder := sync.OnceValue(func() error {
+		m.refIndex.Load().Remove(buildRef(id))
+		if warm {
+			m.pool.release(context.Background(), inst.Id)
+			return nil
+		}
+		return m.instanceManager.DeleteOwnedInstance(context.Background(), inst.Id)
+	})
+	defer releaseBuilder()
 
 	// Wait for build result via vsock
 	// The builder agent will send the result when complete
-	result, err := m.waitForResult(ctx, inst)
+	result, err := m.waitForResult(ctx, inst, warm)
 	if err != nil {
 		return nil, fmt.Errorf("wait for result: %w", err)
 	}
+	span.SetAttributes(attribute.Bool("success", result.Success))
+
+	if result.Success && isArtifactOutput(outputType) {
+		// Stop the builder before reading the output volume, so the guest has
+		// released it and everything it wrote is on disk
+		if err := releaseBuilder(); err != nil {
+			return nil, fmt.Errorf("delete builder instance: %w", err)
+		}
+		if err := extractArtifact(m.volumeManager.GetVolumePath(outputVolID), outputType, m.paths.BuildArtifact(id)); err != nil {
+			return nil, fmt.Errorf("extract artifact: %w", err)
+		}
+	}
 
 	return result, nil
 }
 
-// waitForResult waits for the build result from the builder agent via vsock
-func (m *manager) waitForResult(ctx context.Context, inst *instances.Instance) (*BuildResult, error) {
+// waitForResult waits for the build result from the builder agent via vsock.
+// warm is set for a pooled builder, whose agent is already listening.
+func (m *manager) waitForResult(ctx context.Context, inst *instances.Instance, warm bool) (*BuildResult, error) {
+	ctx, span := m.startSpan(ctx, "WaitForBuildResult", trace.WithAttributes(attribute.String("instance_id", inst.Id)))
+	defer span.End()
+
+	// Connecting covers the builder VM booting and its agent starting
+	_, connectSpan := m.startSpan(ctx, "ConnectBuilderAgent")
+	defer connectSpan.End()
+
 	// Wait a bit for the VM to start and the builder agent to listen on vsock
-	time.Sleep(3 * time.Second)
+	if !warm {
+		time.Sleep(3 * time.Second)
+	}
 
 	// Try to connect to the builder agent with retries
 	var conn net.Conn
@@ -451,6 +658,7 @@ func (m *manager) waitForResult(ctx context.Context, inst *instances.Instance) (
 		return nil, fmt.Errorf("failed to connect to builder agent after retries: %w", err)
 	}
 	defer conn.Close()
+	connectSpan.End()
 
 	m.logger.Info("connected to builder agent", "instance", inst.Id)
 
@@ -517,6 +725,7 @@ func (m *manager) waitForResult(ctx context.Context, inst *instances.Instance) (
 			if dr.response.Result == nil {
 				return nil, fmt.Errorf("received build_result with nil result")
 			}
+			m.recordPushSpan(ctx, dr.response.Result)
 			return dr.response.Result, nil
 
 		default:
@@ -525,6 +734,21 @@ func (m *manager) waitForResult(ctx context.Context, inst *instances.Instance) (
 	}
 }
 
+// recordPushSpan records the image push the agent timed inside the builder VM.
+// The push ends just before the result is sent, so the span is placed ending now.
+func (m *manager) recordPushSpan(ctx context.Context, result *BuildResult) {
+	if result.PushDurationMS <= 0 {
+		return
+	}
+	end := time.Now()
+	start := end.Add(-time.Duration(result.PushDurationMS) * time.Millisecond)
+	_, span := m.startSpan(ctx, "PushImage", trace.WithTimestamp(start))
+	if result.ImageDigest != "" {
+		span.SetAttributes(attribute.String("image_digest", result.ImageDigest))
+	}
+	span.End(trace.WithTimestamp(end))
+}
+
 // dialBuilderVsock connects to a builder VM's vsock socket using Cloud Hypervisor's handshake
 func (m *manager) dialBuilderVsock(vsockSocketPath string) (net.Conn, error) {
 	// Connect to the Cloud Hypervisor vsock Unix socket
@@ -589,6 +813,9 @@ func (m *manager) updateStatus(id string, status string, err error) {
 		return
 	}
 
+	if status == StatusBuilding && meta.Status != StatusBuilding {
+		meta.Attempts++
+	}
 	meta.Status = status
 	if status == StatusBuilding && meta.StartedAt == nil {
 		now := time.Now()
@@ -627,6 +854,9 @@ func (m *manager) updateBuildComplete(id string, status string, digest *string,
 	meta.ImageDigest = digest
 	meta.Error = errMsg
 	meta.Provenance = provenance
+	if provenance != nil {
+		meta.CacheStats = provenance.CacheStats
+	}
 	meta.DurationMS = durationMS
 
 	now := time.Now()
@@ -686,12 +916,15 @@ func (m *manager) notifyStatusChange(buildID string, status string) {
 	}
 }
 
-// GetBuild returns a build by ID
+// GetBuild returns a build by ID or ID prefix. Builds have no names, so
+// an exact ID is tried first and then a unique ID prefix.
+// Returns ErrAmbiguousID if the prefix matches multiple builds.
 func (m *manager) GetBuild(ctx context.Context, id string) (*Build, error) {
-	meta, err := readMetadata(m.paths, id)
+	meta, err := m.resolveBuild(id)
 	if err != nil {
 		return nil, err
 	}
+	id = meta.ID
 
 	build := meta.toBuild()
 
@@ -703,6 +936,36 @@ func (m *manager) GetBuild(ctx context.Context, id string) (*Build, error) {
 	return build, nil
 }
 
+// resolveBuild finds a build's metadata by exact ID, then by ID prefix
+func (m *manager) resolveBuild(id string) (*buildMetadata, error) {
+	meta, err := readMetadata(m.paths, id)
+	if !errors.Is(err, ErrNotFound) {
+		return meta, err
+	}
+	if id == "" {
+		return nil, ErrNotFound
+	}
+
+	metas, err := listAllBuilds(m.paths)
+	if err != nil {
+		return nil, err
+	}
+	var matches []*buildMetadata
+	for _, meta := range metas {
+		if strings.HasPrefix(meta.ID, id) {
+			matches = append(matches, meta)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, ErrNotFound
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, ErrAmbiguousID
+	}
+}
+
 // ListBuilds returns all builds
 func (m *manager) ListBuilds(ctx context.Context) ([]*Build, error) {
 	metas, err := listAllBuilds(m.paths)
@@ -722,6 +985,32 @@ func (m *manager) ListBuilds(ctx context.Context) ([]*Build, error) {
 	return builds, nil
 }
 
+// buildSortKeys are the fields build lists can be sorted by
+var buildSortKeys = pagination.SortKeys[*buildMetadata]{
+	"created_at": func(meta *buildMetadata) string { return pagination.TimeKey(meta.CreatedAt) },
+	"status":     func(meta *buildMetadata) string { return meta.Status },
+}
+
+// ListBuildsPage returns one page of builds, newest first by default
+func (m *manager) ListBuildsPage(ctx context.Context, req pagination.Request) (*pagination.Page[*Build], error) {
+	metas, err := listAllBuilds(m.paths)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := pagination.Paginate(metas, req, buildSortKeys, "-created_at", func(meta *buildMetadata) string { return meta.ID })
+	if err != nil {
+		return nil, err
+	}
+	return pagination.Map(page, func(meta *buildMetadata) *Build {
+		build := meta.toBuild()
+		if meta.Status == StatusQueued {
+			build.QueuePosition = m.queue.GetPosition(meta.ID)
+		}
+		return build
+	}), nil
+}
+
 // CancelBuild cancels a pending build
 func (m *manager) CancelBuild(ctx context.Context, id string) error {
 	meta, err := readMetadata(m.paths, id)
@@ -739,12 +1028,34 @@ func (m *manager) CancelBuild(ctx context.Context, id string) error {
 		return ErrBuildInProgress // Was already picked up
 
 	case StatusBuilding, StatusPushing:
-		// Can't cancel a running build easily
-		// Would need to terminate the builder instance
+		// Marked first, so the failure the build ends with isn't recorded
+		m.updateStatus(id, StatusCancelled, nil)
+
+		m.runningMu.Lock()
+		stop, running := m.running[id]
+		m.runningMu.Unlock()
+		if !running {
+			// Not running in this process, so nothing else will clean up
+			if meta.BuilderInstance != nil {
+				m.instanceManager.DeleteOwnedInstance(ctx, *meta.BuilderInstance)
+			}
+			m.refIndex.Load().Remove(buildRef(id))
+			return nil
+		}
+
+		// The agent stops BuildKit and reports the build as failed, after
+		// which the builder VM is released as for any other build. The
+		// build's context is cancelled in case it doesn't, which deletes the
+		// VM wherever the build is.
 		if meta.BuilderInstance != nil {
-			m.instanceManager.DeleteInstance(ctx, *meta.BuilderInstance)
+			if err := m.cancelInBuilder(ctx, *meta.BuilderInstance); err != nil {
+				m.logger.Warn("failed to cancel build in builder VM", "id", id, "error", err)
+			} else {
+				time.AfterFunc(cancelGracePeriod, stop)
+				return nil
+			}
 		}
-		m.updateStatus(id, StatusCancelled, nil)
+		stop()
 		return nil
 
 	case StatusReady, StatusFailed, StatusCancelled:
@@ -755,6 +1066,43 @@ func (m *manager) CancelBuild(ctx context.Context, id string) error {
 	}
 }
 
+// cancelGracePeriod is how long a build the builder agent has been asked to
+// cancel gets to report back before its builder VM is deleted
+const cancelGracePeriod = 15 * time.Second
+
+// cancelInBuilder asks a builder VM's agent to stop the build it is running
+func (m *manager) cancelInBuilder(ctx context.Context, instanceID string) error {
+	inst, err := m.instanceManager.GetInstance(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("get builder instance: %w", err)
+	}
+	conn, err := m.dialBuilderVsock(inst.VsockSocket)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		return fmt.Errorf("set deadline: %w", err)
+	}
+	if err := json.NewEncoder(conn).Encode(VsockMessage{Type: "cancel"}); err != nil {
+		return fmt.Errorf("send cancel: %w", err)
+	}
+	var response VsockMessage
+	if err := json.NewDecoder(conn).Decode(&response); err != nil {
+		return fmt.Errorf("read cancel response: %w", err)
+	}
+	if response.Type != "cancelled" {
+		return fmt.Errorf("unexpected response to cancel: %s", response.Type)
+	}
+	return nil
+}
+
+// QueueStats returns the build queue's running and queued builds
+func (m *manager) QueueStats() QueueStats {
+	return m.queue.Stats()
+}
+
 // GetBuildLogs returns the logs for a build
 func (m *manager) GetBuildLogs(ctx context.Context, id string) ([]byte, error) {
 	_, err := readMetadata(m.paths, id)
@@ -924,7 +1272,15 @@ func (m *manager) StreamBuildEvents(ctx context.Context, id string, follow bool)
 	return out, nil
 }
 
-// RecoverPendingBuilds recovers builds that were interrupted on restart
+// maxBuildAttempts is how many times a build may start running. A build
+// interrupted mid-run by a restart is re-run until it reaches this limit and
+// then marked failed, so a build that crashes the server can't loop forever.
+const maxBuildAttempts = 2
+
+// RecoverPendingBuilds recovers builds that were interrupted on restart.
+// Builds are re-enqueued in their original order. Queued builds simply wait
+// their turn again; builds that were already running have their builder VM
+// and volumes cleaned up and are re-run, or failed after maxBuildAttempts.
 func (m *manager) RecoverPendingBuilds() {
 	pending, err := listPendingBuilds(m.paths)
 	if err != nil {
@@ -932,9 +1288,29 @@ func (m *manager) RecoverPendingBuilds() {
 		return
 	}
 
+	// New builds must sort after everything being recovered
+	m.createMu.Lock()
+	for _, meta := range pending {
+		if meta.QueueSeq > m.queueSeq {
+			m.queueSeq = meta.QueueSeq
+		}
+	}
+	m.createMu.Unlock()
+
 	for _, meta := range pending {
 		meta := meta // Shadow loop variable for closure capture
-		m.logger.Info("recovering build", "id", meta.ID, "status", meta.Status)
+		m.logger.Info("recovering build", "id", meta.ID, "status", meta.Status, "queue_seq", meta.QueueSeq, "attempts", meta.Attempts)
+
+		if meta.Status != StatusQueued {
+			m.cleanupInterruptedBuild(meta)
+			if meta.Attempts >= maxBuildAttempts {
+				m.logger.Warn("not re-running interrupted build", "id", meta.ID, "attempts", meta.Attempts)
+				errMsg := fmt.Sprintf("build interrupted by server restart after %d attempts", meta.Attempts)
+				m.updateBuildComplete(meta.ID, StatusFailed, nil, &errMsg, nil, nil)
+				continue
+			}
+			m.updateStatus(meta.ID, StatusQueued, nil)
+		}
 
 		// Re-enqueue the build
 		if meta.Request != nil {
@@ -964,6 +1340,23 @@ func (m *manager) RecoverPendingBuilds() {
 	}
 }
 
+// cleanupInterruptedBuild removes the builder VM and volumes left behind by a
+// build that was running when the server stopped, so it can be re-run
+func (m *manager) cleanupInterruptedBuild(meta *buildMetadata) {
+	ctx := context.Background()
+	m.refIndex.Load().Remove(buildRef(meta.ID))
+	if meta.BuilderInstance != nil {
+		if err := m.instanceManager.DeleteOwnedInstance(ctx, *meta.BuilderInstance); err != nil && !errors.Is(err, instances.ErrNotFound) {
+			m.logger.Warn("failed to delete builder instance of interrupted build", "id", meta.ID, "instance", *meta.BuilderInstance, "error", err)
+		}
+	}
+	for _, volID := range []string{fmt.Sprintf("build-source-%s", meta.ID), fmt.Sprintf("build-config-%s", meta.ID), fmt.Sprintf("build-output-%s", meta.ID)} {
+		if err := m.volumeManager.DeleteVolume(ctx, volID); err != nil && !errors.Is(err, volumes.ErrNotFound) {
+			m.logger.Warn("failed to delete volume of interrupted build", "id", meta.ID, "volume", volID, "error", err)
+		}
+	}
+}
+
 // refreshBuildToken regenerates the registry token for a build and updates the config file
 func (m *manager) refreshBuildToken(buildID string, req *CreateBuildRequest) error {
 	// Read existing build config
@@ -978,25 +1371,20 @@ func (m *manager) refreshBuildToken(buildID string, req *CreateBuildRequest) err
 		policy = *req.BuildPolicy
 		policy.ApplyDefaults()
 	}
-	tokenTTL := time.Duration(policy.TimeoutSeconds) * time.Second
-	if tokenTTL < 30*time.Minute {
-		tokenTTL = 30 * time.Minute // Minimum 30 minutes
-	}
-
-	// Generate allowed repos list
-	allowedRepos := []string{fmt.Sprintf("builds/%s", buildID)}
-	if req.CacheScope != "" {
-		allowedRepos = append(allowedRepos, fmt.Sprintf("cache/%s", req.CacheScope))
-	}
 
-	// Generate fresh registry token
-	registryToken, err := m.tokenGenerator.GeneratePushToken(buildID, allowedRepos, tokenTTL)
+	// Generate fresh registry token, for the base image cache as it is now
+	baseImages := m.baseImagesFor(req, m.paths.BuildSourceDir(buildID)+"/source.tar.gz")
+	registryToken, err := m.generateBuildToken(buildID, req, &policy, baseImages)
 	if err != nil {
 		return fmt.Errorf("generate registry token: %w", err)
 	}
 
 	// Update config with new token
 	config.RegistryToken = registryToken
+	config.RegistryMirror = ""
+	if len(baseImages) > 0 {
+		config.RegistryMirror = mirrorHost(m.baseImages.Upstream())
+	}
 
 	// Write updated config back to disk
 	if err := writeBuildConfig(m.paths, buildID, config); err != nil {
@@ -1007,16 +1395,39 @@ func (m *manager) refreshBuildToken(buildID string, req *CreateBuildRequest) err
 	return nil
 }
 
+// generateBuildToken creates the registry token for a build. It grants push
+// access to the build output repo and the build's own cache, and pull access
+// to any caches it imports from and to the base images it pulls through the
+// base image cache.
+func (m *manager) generateBuildToken(buildID string, req *CreateBuildRequest, policy *BuildPolicy, baseImages []baseImage) (string, error) {
+	pushRepos := []string{fmt.Sprintf("builds/%s", buildID)}
+	if req.CacheScope != "" {
+		pushRepos = append(pushRepos, fmt.Sprintf("cache/%s", req.CacheScope))
+	}
+	var pullRepos []string
+	for _, scope := range req.CacheImports {
+		pullRepos = append(pullRepos, fmt.Sprintf("cache/%s", scope))
+	}
+	// Base images are pulled through the registry's upstream mirror
+	for _, image := range baseImages {
+		if !slices.Contains(pullRepos, image.repo) {
+			pullRepos = append(pullRepos, image.repo)
+		}
+	}
+
+	tokenTTL := time.Duration(policy.TimeoutSeconds) * time.Second
+	if tokenTTL < 30*time.Minute {
+		tokenTTL = 30 * time.Minute // Minimum 30 minutes
+	}
+	return m.tokenGenerator.GenerateBuildToken(buildID, pushRepos, pullRepos, tokenTTL)
+}
+
 // Helper functions
 
 func ensureDir(path string) error {
 	return os.MkdirAll(path, 0755)
 }
 
-func writeFile(path string, data []byte) error {
-	return os.WriteFile(path, data, 0644)
-}
-
 func readFile(path string) ([]byte, error) {
 	return os.ReadFile(path)
 }
@@ -1031,31 +1442,19 @@ func (m *manager) createBuildConfigVolume(buildID, volID string) (string, error)
 		return "", fmt.Errorf("read build config: %w", err)
 	}
 
-	// Create temp directory with config file
-	tmpDir, err := os.MkdirTemp("", "hypeman-build-config-*")
-	if err != nil {
-		return "", fmt.Errorf("create temp dir: %w", err)
-	}
-	defer os.RemoveAll(tmpDir)
-
-	// Write build.json to temp directory
-	buildJSONPath := filepath.Join(tmpDir, "build.json")
-	if err := os.WriteFile(buildJSONPath, configData, 0644); err != nil {
-		return "", fmt.Errorf("write build.json: %w", err)
-	}
-
 	// Also write a metadata file for debugging
 	metadata := map[string]interface{}{
 		"build_id":   buildID,
 		"created_at": time.Now().Format(time.RFC3339),
 	}
 	metadataData, _ := json.MarshalIndent(metadata, "", "  ")
-	metadataPath := filepath.Join(tmpDir, "metadata.json")
-	os.WriteFile(metadataPath, metadataData, 0644)
 
-	// Create ext4 disk from the directory
+	// Create ext4 disk with build.json and the metadata
 	diskPath := filepath.Join(os.TempDir(), fmt.Sprintf("build-config-%s.ext4", buildID))
-	_, err = images.ExportRootfs(tmpDir, diskPath, images.FormatExt4)
+	_, err = images.WriteConfigDisk(diskPath, map[string][]byte{
+		"build.json":    configData,
+		"metadata.json": metadataData,
+	})
 	if err != nil {
 		return "", fmt.Errorf("create config disk: %w", err)
 	}
@@ -1063,6 +1462,25 @@ func (m *manager) createBuildConfigVolume(buildID, volID string) (string, error)
 	return diskPath, nil
 }
 
+// attachBuilderVolumes hotplugs a build's volumes into a pooled builder. The
+// config volume goes last: the builder agent starts the build once
+// build.json appears, by which time the other volumes are mounted.
+func (m *manager) attachBuilderVolumes(ctx context.Context, instanceID string, attachments []instances.VolumeAttachment) error {
+	ordered := slices.Clone(attachments)
+	if i := slices.IndexFunc(ordered, func(v instances.VolumeAttachment) bool { return v.MountPath == "/config" }); i >= 0 {
+		ordered = append(slices.Delete(ordered, i, i+1), attachments[i])
+	}
+	for _, vol := range ordered {
+		if _, err := m.instanceManager.AttachVolume(ctx, instanceID, vol.VolumeID, instances.AttachVolumeRequest{
+			MountPath: vol.MountPath,
+			Readonly:  vol.Readonly,
+		}); err != nil {
+			return fmt.Errorf("attach volume %s: %w", vol.VolumeID, err)
+		}
+	}
+	return nil
+}
+
 // copyFile copies a file from src to dst
 func copyFile(src, dst string) error {
 	// Ensure parent directory exists
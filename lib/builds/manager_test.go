@@ -10,12 +10,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/onkernel/hypeman/lib/hypervisor"
+	"github.com/onkernel/hypeman/lib/images"
 	"github.com/onkernel/hypeman/lib/instances"
+	"github.com/onkernel/hypeman/lib/pagination"
 	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/onkernel/hypeman/lib/refindex"
 	"github.com/onkernel/hypeman/lib/resources"
 	"github.com/onkernel/hypeman/lib/volumes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 // mockInstanceManager implements instances.Manager for testing
@@ -43,6 +51,20 @@ func (m *mockInstanceManager) ListInstances(ctx context.Context) ([]instances.In
 	return result, nil
 }
 
+func (m *mockInstanceManager) ListInstancesPage(ctx context.Context, req pagination.Request) (*pagination.Page[instances.Instance], error) {
+	result, _ := m.ListInstances(ctx)
+	return &pagination.Page[instances.Instance]{Items: result, Total: len(result)}, nil
+}
+
+func (m *mockInstanceManager) WatchInstances(ctx context.Context, sel instances.WatchSelector) <-chan instances.Event {
+	ch := make(chan instances.Event)
+	go func() {
+		<-ctx.Done()
+		close(ch)
+	}()
+	return ch
+}
+
 func (m *mockInstanceManager) CreateInstance(ctx context.Context, req instances.CreateInstanceRequest) (*instances.Instance, error) {
 	m.createCallCount++
 	if m.createFunc != nil {
@@ -78,6 +100,30 @@ func (m *mockInstanceManager) DeleteInstance(ctx context.Context, id string) err
 	return nil
 }
 
+func (m *mockInstanceManager) DeleteOwnedInstance(ctx context.Context, id string) error {
+	return m.DeleteInstance(ctx, id)
+}
+
+func (m *mockInstanceManager) ForceDeleteInstance(ctx context.Context, id string) error {
+	return m.DeleteInstance(ctx, id)
+}
+
+func (m *mockInstanceManager) SnapshotAndDeleteInstance(ctx context.Context, id string) (*instances.PreservedSnapshot, error) {
+	return nil, m.DeleteInstance(ctx, id)
+}
+
+func (m *mockInstanceManager) ListPreservedSnapshots(ctx context.Context) ([]instances.PreservedSnapshot, error) {
+	return nil, nil
+}
+
+func (m *mockInstanceManager) PrunePreservedSnapshots(ctx context.Context, maxAge time.Duration) error {
+	return nil
+}
+
+func (m *mockInstanceManager) Reconcile(ctx context.Context) error {
+	return nil
+}
+
 func (m *mockInstanceManager) StandbyInstance(ctx context.Context, id string) (*instances.Instance, error) {
 	return nil, nil
 }
@@ -101,14 +147,74 @@ func (m *mockInstanceManager) StartInstance(ctx context.Context, id string) (*in
 	return nil, nil
 }
 
+func (m *mockInstanceManager) ResetOverlay(ctx context.Context, id string) (*instances.Instance, error) {
+	return nil, nil
+}
+
 func (m *mockInstanceManager) StreamInstanceLogs(ctx context.Context, id string, tail int, follow bool, source instances.LogSource) (<-chan string, error) {
 	return nil, nil
 }
 
-func (m *mockInstanceManager) RotateLogs(ctx context.Context, maxBytes int64, maxFiles int) error {
+func (m *mockInstanceManager) GetBootStatus(ctx context.Context, id string) (*instances.BootStatus, error) {
+	return nil, nil
+}
+
+func (m *mockInstanceManager) SyncTime(ctx context.Context, id string) (time.Duration, error) {
+	return 0, nil
+}
+
+func (m *mockInstanceManager) SyncGuestClocks(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockInstanceManager) GetTopology(vcpus int) (*instances.TopologyReport, error) {
+	return nil, nil
+}
+
+func (m *mockInstanceManager) CompactOverlay(ctx context.Context, id string) (*instances.CompactResult, error) {
+	return nil, nil
+}
+
+func (m *mockInstanceManager) RotateLogs(ctx context.Context, maxBytes int64, retention instances.LogRetention, compress bool) error {
 	return nil
 }
 
+func (m *mockInstanceManager) SetReferenceChecker(checker instances.ReferenceChecker) {}
+
+func (m *mockInstanceManager) SetReferenceIndex(idx *refindex.Index) {}
+
+func (m *mockInstanceManager) IndexedReferences(ctx context.Context) (map[refindex.Ref][]refindex.Ref, error) {
+	return nil, nil
+}
+
+func (m *mockInstanceManager) TrackExecSession(id string) func() {
+	return func() {}
+}
+
+func (m *mockInstanceManager) StartExecSession(ctx context.Context, id string, req instances.ExecSessionRequest) (*instances.ExecSessionRecorder, error) {
+	return nil, nil
+}
+
+func (m *mockInstanceManager) ListExecSessions(ctx context.Context, id string) ([]instances.ExecSession, error) {
+	return nil, nil
+}
+
+func (m *mockInstanceManager) OpenExecRecording(ctx context.Context, id, sessionID string) (io.ReadCloser, error) {
+	return nil, instances.ErrRecordingNotFound
+}
+
+func (m *mockInstanceManager) StopIdleInstances(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockInstanceManager) CloneInstance(ctx context.Context, id string, req instances.CloneInstanceRequest) (*instances.Instance, error) {
+	return nil, nil
+}
+
+func (m *mockInstanceManager) CreateInstances(ctx context.Context, req instances.CreateInstanceRequest, count int) (*instances.BatchCreateResult, error) {
+	return nil, nil
+}
+
 func (m *mockInstanceManager) AttachVolume(ctx context.Context, id string, volumeId string, req instances.AttachVolumeRequest) (*instances.Instance, error) {
 	return nil, nil
 }
@@ -117,10 +223,26 @@ func (m *mockInstanceManager) DetachVolume(ctx context.Context, id string, volum
 	return nil, nil
 }
 
+func (m *mockInstanceManager) AttachDevice(ctx context.Context, id string, deviceRef string) (*instances.Instance, error) {
+	return nil, nil
+}
+
+func (m *mockInstanceManager) DetachDevice(ctx context.Context, id string, deviceRef string) (*instances.Instance, error) {
+	return nil, nil
+}
+
 func (m *mockInstanceManager) ListInstanceAllocations(ctx context.Context) ([]resources.InstanceAllocation, error) {
 	return nil, nil
 }
 
+func (m *mockInstanceManager) ListHypervisors(ctx context.Context) []instances.HypervisorInfo {
+	return nil
+}
+
+func (m *mockInstanceManager) DefaultHypervisor() hypervisor.Type {
+	return hypervisor.TypeCloudHypervisor
+}
+
 // mockVolumeManager implements volumes.Manager for testing
 type mockVolumeManager struct {
 	volumes               map[string]*volumes.Volume
@@ -145,6 +267,11 @@ func (m *mockVolumeManager) ListVolumes(ctx context.Context) ([]volumes.Volume,
 	return result, nil
 }
 
+func (m *mockVolumeManager) ListVolumesPage(ctx context.Context, req pagination.Request) (*pagination.Page[volumes.Volume], error) {
+	result, _ := m.ListVolumes(ctx)
+	return &pagination.Page[volumes.Volume]{Items: result, Total: len(result)}, nil
+}
+
 func (m *mockVolumeManager) CreateVolume(ctx context.Context, req volumes.CreateVolumeRequest) (*volumes.Volume, error) {
 	m.createCallCount++
 	if m.createFunc != nil {
@@ -196,6 +323,10 @@ func (m *mockVolumeManager) DeleteVolume(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m *mockVolumeManager) CloneVolume(ctx context.Context, id string, name string) (*volumes.Volume, error) {
+	return nil, nil
+}
+
 func (m *mockVolumeManager) AttachVolume(ctx context.Context, id string, req volumes.AttachVolumeRequest) error {
 	return nil
 }
@@ -219,6 +350,26 @@ func (m *mockSecretProvider) GetSecrets(ctx context.Context, secretIDs []string)
 	return make(map[string]string), nil
 }
 
+// mockBuilderImages implements BuilderImageSource for testing
+type mockBuilderImages struct {
+	images map[string]*images.Image
+	pulls  []string
+}
+
+func (m *mockBuilderImages) GetImage(ctx context.Context, name string) (*images.Image, error) {
+	if img, ok := m.images[name]; ok {
+		return img, nil
+	}
+	return nil, images.ErrNotFound
+}
+
+func (m *mockBuilderImages) CreateImage(ctx context.Context, req images.CreateImageRequest) (*images.Image, error) {
+	m.pulls = append(m.pulls, req.Name)
+	img := &images.Image{Name: req.Name, Status: images.StatusPending}
+	m.images[req.Name] = img
+	return img, nil
+}
+
 // Test helper to create a manager with test paths and mocks
 func setupTestManager(t *testing.T) (*manager, *mockInstanceManager, *mockVolumeManager, string) {
 	t.Helper()
@@ -237,6 +388,9 @@ func setupTestManager(t *testing.T) (*manager, *mockInstanceManager, *mockVolume
 	instanceMgr := newMockInstanceManager()
 	volumeMgr := newMockVolumeManager()
 	secretProvider := &mockSecretProvider{}
+	builderImages := &mockBuilderImages{images: map[string]*images.Image{
+		"test/builder:latest": {Name: "test/builder:latest", Status: images.StatusReady},
+	}}
 
 	// Create config
 	config := Config{
@@ -257,10 +411,12 @@ func setupTestManager(t *testing.T) (*manager, *mockInstanceManager, *mockVolume
 		queue:             NewBuildQueue(config.MaxConcurrentBuilds),
 		instanceManager:   instanceMgr,
 		volumeManager:     volumeMgr,
+		builderImages:     builderImages,
 		secretProvider:    secretProvider,
 		tokenGenerator:    NewRegistryTokenGenerator(config.RegistrySecret),
 		logger:            logger,
 		statusSubscribers: make(map[string][]chan BuildEvent),
+		running:           make(map[string]context.CancelFunc),
 	}
 
 	return mgr, instanceMgr, volumeMgr, tempDir
@@ -277,7 +433,7 @@ func TestCreateBuild_Success(t *testing.T) {
 	}
 	sourceData := []byte("fake-tarball-data")
 
-	build, err := mgr.CreateBuild(ctx, req, sourceData)
+	build, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, sourceData))
 
 	require.NoError(t, err)
 	assert.NotEmpty(t, build.ID)
@@ -301,6 +457,65 @@ func TestCreateBuild_Success(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateBuild_Tracing(t *testing.T) {
+	mgr, _, _, tempDir := setupTestManager(t)
+	defer os.RemoveAll(tempDir)
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	metrics, err := NewMetrics(noop.NewMeterProvider().Meter("test"), tp.Tracer("test"))
+	require.NoError(t, err)
+	mgr.metrics = metrics
+
+	// The API request's span
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "POST /builds")
+	build, err := mgr.CreateBuild(ctx, CreateBuildRequest{
+		CacheScope:      "test-scope",
+		BaseImageDigest: "sha256:abc",
+		Dockerfile:      "FROM alpine",
+	}, stageTestSource(t, mgr, []byte("fake-tarball-data")))
+	require.NoError(t, err)
+	parent.End()
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	require.Eventually(t, func() bool {
+		for _, s := range recorder.Ended() {
+			spans[s.Name()] = s
+		}
+		return spans["CreateBuild"] != nil && spans["BuildQueueWait"] != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	create := spans["CreateBuild"]
+	assert.Equal(t, parent.SpanContext().SpanID(), create.Parent().SpanID())
+	assert.Contains(t, create.Attributes(), attribute.String("build_id", build.ID))
+	assert.Contains(t, create.Attributes(), attribute.String("cache_scope", "test-scope"))
+	assert.Contains(t, create.Attributes(), attribute.String("base_image_digest", "sha256:abc"))
+
+	// Queued work stays in the API request's trace
+	wait := spans["BuildQueueWait"]
+	assert.Equal(t, parent.SpanContext().TraceID(), wait.SpanContext().TraceID())
+	assert.Equal(t, create.SpanContext().SpanID(), wait.Parent().SpanID())
+}
+
+func TestRecordPushSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	mgr := &manager{metrics: &Metrics{tracer: tp.Tracer("test")}}
+
+	mgr.recordPushSpan(context.Background(), &BuildResult{Success: true})
+	assert.Empty(t, recorder.Ended(), "no span without a push duration")
+
+	mgr.recordPushSpan(context.Background(), &BuildResult{Success: true, ImageDigest: "sha256:abc", PushDurationMS: 1500})
+	require.Len(t, recorder.Ended(), 1)
+	span := recorder.Ended()[0]
+	assert.Equal(t, "PushImage", span.Name())
+	assert.Equal(t, 1500*time.Millisecond, span.EndTime().Sub(span.StartTime()))
+	assert.Contains(t, span.Attributes(), attribute.String("image_digest", "sha256:abc"))
+
+	// Without a tracer nothing is recorded
+	(&manager{}).recordPushSpan(context.Background(), &BuildResult{PushDurationMS: 1500})
+}
+
 func TestCreateBuild_WithBuildPolicy(t *testing.T) {
 	mgr, _, _, tempDir := setupTestManager(t)
 	defer os.RemoveAll(tempDir)
@@ -315,7 +530,7 @@ func TestCreateBuild_WithBuildPolicy(t *testing.T) {
 		},
 	}
 
-	build, err := mgr.CreateBuild(ctx, req, []byte("source"))
+	build, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, []byte("source")))
 
 	require.NoError(t, err)
 	assert.NotEmpty(t, build.ID)
@@ -331,7 +546,7 @@ func TestGetBuild_Found(t *testing.T) {
 	req := CreateBuildRequest{
 		Dockerfile: "FROM alpine",
 	}
-	created, err := mgr.CreateBuild(ctx, req, []byte("source"))
+	created, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, []byte("source")))
 	require.NoError(t, err)
 
 	// Get the build
@@ -354,6 +569,43 @@ func TestGetBuild_NotFound(t *testing.T) {
 	assert.ErrorIs(t, err, ErrNotFound)
 }
 
+func TestGetBuild_Resolution(t *testing.T) {
+	mgr, _, _, tempDir := setupTestManager(t)
+	defer os.RemoveAll(tempDir)
+
+	ctx := context.Background()
+
+	for _, id := range []string{"abc123def456", "abc789xyz123", "xyz999aaa111"} {
+		require.NoError(t, writeMetadata(mgr.paths, &buildMetadata{ID: id, Status: StatusReady, CreatedAt: time.Now()}))
+	}
+
+	t.Run("exact ID match", func(t *testing.T) {
+		build, err := mgr.GetBuild(ctx, "abc123def456")
+		require.NoError(t, err)
+		assert.Equal(t, "abc123def456", build.ID)
+	})
+
+	t.Run("unique ID prefix match", func(t *testing.T) {
+		build, err := mgr.GetBuild(ctx, "xyz")
+		require.NoError(t, err)
+		assert.Equal(t, "xyz999aaa111", build.ID)
+
+		build, err = mgr.GetBuild(ctx, "abc789")
+		require.NoError(t, err)
+		assert.Equal(t, "abc789xyz123", build.ID)
+	})
+
+	t.Run("ambiguous ID prefix", func(t *testing.T) {
+		_, err := mgr.GetBuild(ctx, "abc")
+		assert.ErrorIs(t, err, ErrAmbiguousID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := mgr.GetBuild(ctx, "nonexistent")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
 func TestListBuilds_Empty(t *testing.T) {
 	mgr, _, _, tempDir := setupTestManager(t)
 	defer os.RemoveAll(tempDir)
@@ -377,7 +629,7 @@ func TestListBuilds_WithBuilds(t *testing.T) {
 		req := CreateBuildRequest{
 			Dockerfile: "FROM alpine",
 		}
-		_, err := mgr.CreateBuild(ctx, req, []byte("source"))
+		_, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, []byte("source")))
 		require.NoError(t, err)
 	}
 
@@ -416,6 +668,32 @@ func TestCancelBuild_QueuedBuild(t *testing.T) {
 	assert.Equal(t, 0, queue.PendingCount())
 }
 
+func TestCreateBuild_BuilderImageMissing(t *testing.T) {
+	mgr, _, _, tempDir := setupTestManager(t)
+	defer os.RemoveAll(tempDir)
+
+	source := &mockBuilderImages{images: map[string]*images.Image{}}
+	mgr.builderImages = source
+	req := CreateBuildRequest{Dockerfile: "FROM alpine:latest\nRUN echo hello"}
+
+	// The first build starts a pull of the builder image and is refused
+	_, err := mgr.CreateBuild(context.Background(), req, stageTestSource(t, mgr, []byte("source")))
+	require.ErrorIs(t, err, ErrBuilderNotReady)
+	assert.Contains(t, err.Error(), "being pulled")
+	assert.Equal(t, []string{"test/builder:latest"}, source.pulls)
+
+	failed := "manifest unknown"
+	source.images["test/builder:latest"] = &images.Image{Status: images.StatusFailed, Error: &failed}
+	_, err = mgr.CreateBuild(context.Background(), req, stageTestSource(t, mgr, []byte("source")))
+	require.ErrorIs(t, err, ErrBuilderNotReady)
+	assert.Contains(t, err.Error(), "manifest unknown")
+	assert.Len(t, source.pulls, 1)
+
+	source.images["test/builder:latest"].Status = images.StatusReady
+	_, err = mgr.CreateBuild(context.Background(), req, stageTestSource(t, mgr, []byte("source")))
+	require.NoError(t, err)
+}
+
 func TestCancelBuild_NotFound(t *testing.T) {
 	mgr, _, _, tempDir := setupTestManager(t)
 	defer os.RemoveAll(tempDir)
@@ -465,6 +743,31 @@ func TestCancelBuild_AlreadyCompleted(t *testing.T) {
 	assert.Contains(t, err.Error(), "already completed")
 }
 
+func TestCancelBuild_RunningBuild(t *testing.T) {
+	mgr, _, _, tempDir := setupTestManager(t)
+	defer os.RemoveAll(tempDir)
+
+	// The builder VM can't be reached, so the build's context is cancelled
+	builder := "missing-builder"
+	require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "builds", "running-build"), 0755))
+	require.NoError(t, writeMetadata(mgr.paths, &buildMetadata{
+		ID:              "running-build",
+		Status:          StatusBuilding,
+		BuilderInstance: &builder,
+		CreatedAt:       time.Now(),
+	}))
+	buildCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mgr.running["running-build"] = cancel
+
+	require.NoError(t, mgr.CancelBuild(context.Background(), "running-build"))
+	assert.ErrorIs(t, buildCtx.Err(), context.Canceled)
+
+	build, err := mgr.GetBuild(context.Background(), "running-build")
+	require.NoError(t, err)
+	assert.Equal(t, StatusCancelled, build.Status)
+}
+
 func TestGetBuildLogs_Empty(t *testing.T) {
 	mgr, _, _, tempDir := setupTestManager(t)
 	defer os.RemoveAll(tempDir)
@@ -475,7 +778,7 @@ func TestGetBuildLogs_Empty(t *testing.T) {
 	req := CreateBuildRequest{
 		Dockerfile: "FROM alpine",
 	}
-	build, err := mgr.CreateBuild(ctx, req, []byte("source"))
+	build, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, []byte("source")))
 	require.NoError(t, err)
 
 	// Get logs (should be empty initially)
@@ -495,7 +798,7 @@ func TestGetBuildLogs_WithLogs(t *testing.T) {
 	req := CreateBuildRequest{
 		Dockerfile: "FROM alpine",
 	}
-	build, err := mgr.CreateBuild(ctx, req, []byte("source"))
+	build, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, []byte("source")))
 	require.NoError(t, err)
 
 	// Append some logs
@@ -623,7 +926,7 @@ func TestRegistryTokenGeneration(t *testing.T) {
 		CacheScope: "my-cache",
 		Dockerfile: "FROM alpine",
 	}
-	build, err := mgr.CreateBuild(ctx, req, []byte("source"))
+	build, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, []byte("source")))
 	require.NoError(t, err)
 
 	// Read the build config and verify token was generated
@@ -666,7 +969,7 @@ func TestCreateBuild_MultipleConcurrent(t *testing.T) {
 			req := CreateBuildRequest{
 				Dockerfile: "FROM alpine",
 			}
-			build, err := mgr.CreateBuild(ctx, req, []byte("source"))
+			build, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, []byte("source")))
 			if err != nil {
 				errs <- err
 			} else {
@@ -715,7 +1018,7 @@ func TestStreamBuildEvents_ExistingLogs(t *testing.T) {
 	// Create a build
 	req := CreateBuildRequest{Dockerfile: "FROM alpine"}
 	sourceData := []byte("fake-tarball-data")
-	build, err := mgr.CreateBuild(ctx, req, sourceData)
+	build, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, sourceData))
 	require.NoError(t, err)
 
 	// Write some logs directly
@@ -753,7 +1056,7 @@ func TestStreamBuildEvents_NoLogs(t *testing.T) {
 	// Create a build
 	req := CreateBuildRequest{Dockerfile: "FROM alpine"}
 	sourceData := []byte("fake-tarball-data")
-	build, err := mgr.CreateBuild(ctx, req, sourceData)
+	build, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, sourceData))
 	require.NoError(t, err)
 
 	// Stream events without follow (no logs exist)
@@ -778,7 +1081,7 @@ func TestStreamBuildEvents_WithStatusUpdate(t *testing.T) {
 	// Create a build
 	req := CreateBuildRequest{Dockerfile: "FROM alpine"}
 	sourceData := []byte("fake-tarball-data")
-	build, err := mgr.CreateBuild(ctx, req, sourceData)
+	build, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, sourceData))
 	require.NoError(t, err)
 
 	// Write some initial logs
@@ -839,7 +1142,7 @@ func TestStreamBuildEvents_ContextCancellation(t *testing.T) {
 	// Create a build
 	req := CreateBuildRequest{Dockerfile: "FROM alpine"}
 	sourceData := []byte("fake-tarball-data")
-	build, err := mgr.CreateBuild(ctx, req, sourceData)
+	build, err := mgr.CreateBuild(ctx, req, stageTestSource(t, mgr, sourceData))
 	require.NoError(t, err)
 
 	// Write some logs
@@ -887,3 +1190,50 @@ eventLoop:
 		}
 	}
 }
+
+func TestRecoverPendingBuilds_Order(t *testing.T) {
+	mgr, instanceMgr, _, tempDir := setupTestManager(t)
+	defer os.RemoveAll(tempDir)
+
+	// Occupy the only build slot so recovered builds stay in the pending queue
+	mgr.queue = NewBuildQueue(1)
+	mgr.queue.active["blocker"] = ""
+
+	// Created in the reverse of their enqueue order, so created_at alone would
+	// recover them backwards
+	base := time.Now()
+	builder := "builder-inst"
+	metas := []*buildMetadata{
+		{ID: "queued-late", Status: StatusQueued, QueueSeq: 3, CreatedAt: base},
+		{ID: "queued-early", Status: StatusQueued, QueueSeq: 2, CreatedAt: base.Add(-time.Second)},
+		{ID: "interrupted", Status: StatusBuilding, QueueSeq: 1, Attempts: 1, BuilderInstance: &builder, CreatedAt: base.Add(-2 * time.Second)},
+		{ID: "interrupted-twice", Status: StatusPushing, QueueSeq: 4, Attempts: maxBuildAttempts, CreatedAt: base.Add(-3 * time.Second)},
+	}
+	for _, meta := range metas {
+		meta.Request = &CreateBuildRequest{}
+		require.NoError(t, writeMetadata(mgr.paths, meta))
+		require.NoError(t, writeBuildConfig(mgr.paths, meta.ID, &BuildConfig{JobID: meta.ID}))
+	}
+
+	mgr.RecoverPendingBuilds()
+
+	var order []string
+	for _, b := range mgr.queue.pending {
+		order = append(order, b.BuildID)
+	}
+	assert.Equal(t, []string{"interrupted", "queued-early", "queued-late"}, order)
+
+	// The interrupted build's builder VM is removed and it waits to re-run
+	assert.Equal(t, 1, instanceMgr.deleteCallCount)
+	meta, err := readMetadata(mgr.paths, "interrupted")
+	require.NoError(t, err)
+	assert.Equal(t, StatusQueued, meta.Status)
+
+	// A build interrupted too many times is failed instead
+	meta, err = readMetadata(mgr.paths, "interrupted-twice")
+	require.NoError(t, err)
+	assert.Equal(t, StatusFailed, meta.Status)
+
+	// New builds are numbered after the recovered ones
+	assert.Equal(t, uint64(4), mgr.queueSeq)
+}
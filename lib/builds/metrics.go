@@ -6,18 +6,21 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// Metrics provides Prometheus metrics for the build system
+// Metrics provides Prometheus metrics and tracing for the build system
 type Metrics struct {
 	buildDuration metric.Float64Histogram
 	buildTotal    metric.Int64Counter
+	prefetch      metric.Float64Histogram
 	queueLength   metric.Int64ObservableGauge
 	activeBuilds  metric.Int64ObservableGauge
+	tracer        trace.Tracer
 }
 
-// NewMetrics creates a new Metrics instance
-func NewMetrics(meter metric.Meter) (*Metrics, error) {
+// NewMetrics creates a new Metrics instance. tracer may be nil to disable tracing.
+func NewMetrics(meter metric.Meter, tracer trace.Tracer) (*Metrics, error) {
 	buildDuration, err := meter.Float64Histogram(
 		"hypeman_build_duration_seconds",
 		metric.WithDescription("Duration of builds in seconds"),
@@ -35,6 +38,15 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 		return nil, err
 	}
 
+	prefetch, err := meter.Float64Histogram(
+		"hypeman_build_base_image_prefetch_duration_seconds",
+		metric.WithDescription("Time to make sure a build's base image is in the base image cache, by whether it already was"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	queueLength, err := meter.Int64ObservableGauge(
 		"hypeman_build_queue_length",
 		metric.WithDescription("Number of builds in queue"),
@@ -54,8 +66,10 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 	return &Metrics{
 		buildDuration: buildDuration,
 		buildTotal:    buildTotal,
+		prefetch:      prefetch,
 		queueLength:   queueLength,
 		activeBuilds:  activeBuilds,
+		tracer:        tracer,
 	}, nil
 }
 
@@ -69,6 +83,12 @@ func (m *Metrics) RecordBuild(ctx context.Context, status string, duration time.
 	m.buildTotal.Add(ctx, 1, metric.WithAttributes(attrs...))
 }
 
+// RecordBaseImagePrefetch records how long a base image prefetch took. A cold
+// prefetch (cached=false) is the download a build saves on later runs.
+func (m *Metrics) RecordBaseImagePrefetch(ctx context.Context, cached bool, duration time.Duration) {
+	m.prefetch.Record(ctx, duration.Seconds(), metric.WithAttributes(attribute.Bool("cached", cached)))
+}
+
 // RegisterQueueCallbacks registers callbacks for queue metrics
 func (m *Metrics) RegisterQueueCallbacks(queue *BuildQueue, meter metric.Meter) error {
 	_, err := meter.RegisterCallback(
@@ -83,3 +103,32 @@ func (m *Metrics) RegisterQueueCallbacks(queue *BuildQueue, meter metric.Meter)
 	return err
 }
 
+// startSpan starts a tracing span if a tracer is configured. Otherwise it
+// returns ctx unchanged and a no-op span, so callers can always End it.
+func (m *manager) startSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if m.metrics == nil || m.metrics.tracer == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return m.metrics.tracer.Start(ctx, name, opts...)
+}
+
+// buildSpanAttributes describes a build on its tracing spans
+func buildSpanAttributes(id string, req CreateBuildRequest) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{attribute.String("build_id", id)}
+	if req.BaseImageDigest != "" {
+		attrs = append(attrs, attribute.String("base_image_digest", req.BaseImageDigest))
+	}
+	if req.CacheScope != "" {
+		attrs = append(attrs, attribute.String("cache_scope", req.CacheScope))
+	}
+	if req.OutputType != "" {
+		attrs = append(attrs, attribute.String("output_type", req.OutputType))
+	}
+	if req.Frontend != "" {
+		attrs = append(attrs, attribute.String("frontend", req.Frontend))
+	}
+	if len(req.CacheImports) > 0 {
+		attrs = append(attrs, attribute.StringSlice("cache_imports", req.CacheImports))
+	}
+	return attrs
+}
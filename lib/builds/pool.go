@@ -0,0 +1,250 @@
+package builds
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/nrednav/cuid2"
+	"github.com/onkernel/hypeman/lib/instances"
+)
+
+// builderPoolPrefix names pooled builder instances, so ones left behind by a
+// previous run can be found and deleted
+const builderPoolPrefix = "builder-pool-"
+
+// builderPool keeps booted builder VMs waiting for builds, so a build skips
+// the VM boot and BuildKit start. Pooled VMs boot without volumes and their
+// builder agent waits for the config volume; a build hotplugs its volumes
+// into one. Afterwards the VM is stopped, its volumes are detached and its
+// overlay is reset before it boots again, so nothing a build wrote (including
+// /run/secrets) is seen by the next one.
+type builderPool struct {
+	instances instances.Manager
+	image     string
+	policy    BuildPolicy // Shape of pooled VMs; builds with another one boot their own
+	size      int
+	logger    *slog.Logger
+
+	mu      sync.Mutex
+	ctx     context.Context
+	closed  bool
+	idle    []string // Booted instances waiting for a build
+	pending int      // Instances being created or reset
+	busy    int      // Instances running a build
+}
+
+func newBuilderPool(instanceMgr instances.Manager, image string, size int, logger *slog.Logger) *builderPool {
+	return &builderPool{
+		instances: instanceMgr,
+		image:     image,
+		policy:    DefaultBuildPolicy(),
+		size:      size,
+		logger:    logger,
+	}
+}
+
+// start deletes pooled instances left behind by a previous run, then fills
+// the pool. Idle instances are deleted once ctx is done.
+func (p *builderPool) start(ctx context.Context) {
+	p.mu.Lock()
+	p.ctx = ctx
+	p.mu.Unlock()
+
+	p.sweep(ctx)
+	p.fill()
+
+	go func() {
+		<-ctx.Done()
+		p.drain()
+	}()
+}
+
+// sweep deletes pooled instances this pool doesn't know about
+func (p *builderPool) sweep(ctx context.Context) {
+	insts, err := p.instances.ListInstances(ctx)
+	if err != nil {
+		p.logger.Warn("failed to list instances for builder pool cleanup", "error", err)
+		return
+	}
+	for _, inst := range insts {
+		if !strings.HasPrefix(inst.Name, builderPoolPrefix) {
+			continue
+		}
+		if err := p.instances.DeleteOwnedInstance(ctx, inst.Id); err != nil && !errors.Is(err, instances.ErrNotFound) {
+			p.logger.Warn("failed to delete stale pooled builder", "instance", inst.Id, "error", err)
+		}
+	}
+}
+
+// drain stops the pool and deletes its idle instances. Instances busy or
+// being reset are deleted when they come back.
+func (p *builderPool) drain() {
+	p.mu.Lock()
+	p.closed = true
+	idle := p.idle
+	p.idle = nil
+	p.mu.Unlock()
+
+	for _, id := range idle {
+		p.delete(id)
+	}
+}
+
+// fits reports whether a build with policy can run on a pooled VM
+func (p *builderPool) fits(policy *BuildPolicy) bool {
+	return policy.CPUs == p.policy.CPUs &&
+		policy.MemoryMB == p.policy.MemoryMB &&
+		policy.NetworkMode == p.policy.NetworkMode
+}
+
+// fill boots instances until the pool is back to its size
+func (p *builderPool) fill() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.ctx == nil || p.closed {
+		return
+	}
+	for len(p.idle)+p.pending+p.busy < p.size {
+		p.pending++
+		go p.warm()
+	}
+}
+
+// warm boots a new pooled instance
+func (p *builderPool) warm() {
+	inst, err := p.instances.CreateInstance(p.ctx, instances.CreateInstanceRequest{
+		Name:           builderPoolPrefix + cuid2.Generate(),
+		Image:          p.image,
+		Size:           int64(p.policy.MemoryMB) * 1024 * 1024,
+		Vcpus:          p.policy.CPUs,
+		NetworkEnabled: p.policy.NetworkMode == "egress",
+	})
+	if err != nil {
+		// Not retried here: the next build that misses the pool fills it again
+		p.logger.Warn("failed to create pooled builder", "error", err)
+		p.mu.Lock()
+		p.pending--
+		p.mu.Unlock()
+		return
+	}
+	p.ready(inst.Id)
+}
+
+// ready adds a booted instance to the idle list
+func (p *builderPool) ready(id string) {
+	p.mu.Lock()
+	p.pending--
+	if p.closed {
+		p.mu.Unlock()
+		p.delete(id)
+		return
+	}
+	p.idle = append(p.idle, id)
+	p.mu.Unlock()
+}
+
+// acquire takes an idle instance for a build with policy. Returns false if
+// the pool is disabled, empty, or its VMs don't fit the policy.
+func (p *builderPool) acquire(ctx context.Context, policy *BuildPolicy) (*instances.Instance, bool) {
+	if p == nil || !p.fits(policy) {
+		return nil, false
+	}
+	for {
+		p.mu.Lock()
+		if len(p.idle) == 0 {
+			p.mu.Unlock()
+			p.fill()
+			return nil, false
+		}
+		id := p.idle[0]
+		p.idle = p.idle[1:]
+		p.busy++
+		p.mu.Unlock()
+
+		inst, err := p.instances.GetInstance(ctx, id)
+		if err == nil && inst.State == instances.StateRunning {
+			return inst, true
+		}
+		p.logger.Warn("discarding unusable pooled builder", "instance", id, "error", err)
+		p.discard(id)
+	}
+}
+
+// release takes back an instance after its build. It is stopped and its
+// volumes detached before release returns, so the build can delete them;
+// the overlay reset and boot happen in the background.
+func (p *builderPool) release(ctx context.Context, id string) {
+	if err := p.clean(ctx, id); err != nil {
+		p.logger.Warn("failed to clean pooled builder, deleting it", "instance", id, "error", err)
+		p.discard(id)
+		return
+	}
+
+	p.mu.Lock()
+	p.busy--
+	p.pending++
+	p.mu.Unlock()
+	go p.reboot(id)
+}
+
+// clean stops an instance and detaches its volumes
+func (p *builderPool) clean(ctx context.Context, id string) error {
+	inst, err := p.instances.GetInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	if inst.State != instances.StateStopped {
+		if inst, err = p.instances.StopInstance(ctx, id); err != nil {
+			return err
+		}
+	}
+	for _, vol := range inst.Volumes {
+		if _, err := p.instances.DetachVolume(ctx, id, vol.VolumeID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reboot resets a cleaned instance's overlay and boots it back into the pool
+func (p *builderPool) reboot(id string) {
+	ctx := context.WithoutCancel(p.ctx)
+	if _, err := p.instances.ResetOverlay(ctx, id); err != nil {
+		p.logger.Warn("failed to reset pooled builder, deleting it", "instance", id, "error", err)
+		p.abandon(id)
+		return
+	}
+	if _, err := p.instances.StartInstance(ctx, id); err != nil {
+		p.logger.Warn("failed to boot pooled builder, deleting it", "instance", id, "error", err)
+		p.abandon(id)
+		return
+	}
+	p.ready(id)
+}
+
+// discard deletes a busy instance and boots a replacement
+func (p *builderPool) discard(id string) {
+	p.mu.Lock()
+	p.busy--
+	p.mu.Unlock()
+	p.delete(id)
+	p.fill()
+}
+
+// abandon deletes an instance that failed to reset and boots a replacement
+func (p *builderPool) abandon(id string) {
+	p.mu.Lock()
+	p.pending--
+	p.mu.Unlock()
+	p.delete(id)
+	p.fill()
+}
+
+func (p *builderPool) delete(id string) {
+	if err := p.instances.DeleteOwnedInstance(context.Background(), id); err != nil && !errors.Is(err, instances.ErrNotFound) {
+		p.logger.Warn("failed to delete pooled builder", "instance", id, "error", err)
+	}
+}
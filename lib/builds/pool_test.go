@@ -0,0 +1,185 @@
+package builds
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/instances"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// poolInstanceManager is a concurrency-safe fake of the instance operations
+// the builder pool uses
+type poolInstanceManager struct {
+	instances.Manager
+
+	mu     sync.Mutex
+	insts  map[string]*instances.Instance
+	resets int
+}
+
+func (m *poolInstanceManager) get(id string) (*instances.Instance, error) {
+	inst, ok := m.insts[id]
+	if !ok {
+		return nil, instances.ErrNotFound
+	}
+	cp := *inst
+	return &cp, nil
+}
+
+func (m *poolInstanceManager) names() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var names []string
+	for _, inst := range m.insts {
+		names = append(names, inst.Name)
+	}
+	return names
+}
+
+func (m *poolInstanceManager) ListInstances(ctx context.Context) ([]instances.Instance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []instances.Instance
+	for _, inst := range m.insts {
+		result = append(result, *inst)
+	}
+	return result, nil
+}
+
+func (m *poolInstanceManager) CreateInstance(ctx context.Context, req instances.CreateInstanceRequest) (*instances.Instance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	inst := &instances.Instance{
+		StoredMetadata: instances.StoredMetadata{Id: "inst-" + req.Name, Name: req.Name},
+		State:          instances.StateRunning,
+	}
+	m.insts[inst.Id] = inst
+	return m.get(inst.Id)
+}
+
+func (m *poolInstanceManager) GetInstance(ctx context.Context, id string) (*instances.Instance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.get(id)
+}
+
+func (m *poolInstanceManager) DeleteInstance(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.insts[id]; !ok {
+		return instances.ErrNotFound
+	}
+	delete(m.insts, id)
+	return nil
+}
+
+func (m *poolInstanceManager) DeleteOwnedInstance(ctx context.Context, id string) error {
+	return m.DeleteInstance(ctx, id)
+}
+
+func (m *poolInstanceManager) setState(id string, state instances.State) (*instances.Instance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if inst, ok := m.insts[id]; ok {
+		inst.State = state
+	}
+	return m.get(id)
+}
+
+func (m *poolInstanceManager) StopInstance(ctx context.Context, id string) (*instances.Instance, error) {
+	return m.setState(id, instances.StateStopped)
+}
+
+func (m *poolInstanceManager) StartInstance(ctx context.Context, id string) (*instances.Instance, error) {
+	return m.setState(id, instances.StateRunning)
+}
+
+func (m *poolInstanceManager) ResetOverlay(ctx context.Context, id string) (*instances.Instance, error) {
+	m.mu.Lock()
+	m.resets++
+	m.mu.Unlock()
+	return m.GetInstance(ctx, id)
+}
+
+func (m *poolInstanceManager) AttachVolume(ctx context.Context, id string, volumeId string, req instances.AttachVolumeRequest) (*instances.Instance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	inst := m.insts[id]
+	inst.Volumes = append(inst.Volumes, instances.VolumeAttachment{VolumeID: volumeId, MountPath: req.MountPath, Readonly: req.Readonly})
+	return m.get(id)
+}
+
+func (m *poolInstanceManager) DetachVolume(ctx context.Context, id string, volumeId string) (*instances.Instance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	inst := m.insts[id]
+	inst.Volumes = slices.DeleteFunc(inst.Volumes, func(v instances.VolumeAttachment) bool { return v.VolumeID == volumeId })
+	return m.get(id)
+}
+
+func (p *builderPool) idleCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.idle)
+}
+
+func TestBuilderPool(t *testing.T) {
+	instMgr := &poolInstanceManager{insts: map[string]*instances.Instance{
+		"stale": {StoredMetadata: instances.StoredMetadata{Id: "stale", Name: builderPoolPrefix + "stale"}, State: instances.StateRunning},
+		"other": {StoredMetadata: instances.StoredMetadata{Id: "other", Name: "my-app"}, State: instances.StateRunning},
+	}}
+	pool := newBuilderPool(instMgr, "hypeman/builder:latest", 2, slog.Default())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pool.start(ctx)
+
+	// Leftovers of a previous run are deleted, then the pool fills
+	require.Eventually(t, func() bool { return pool.idleCount() == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.NotContains(t, instMgr.names(), builderPoolPrefix+"stale")
+	assert.Contains(t, instMgr.names(), "my-app")
+
+	// Builds with another shape boot their own builder
+	bigger := DefaultBuildPolicy()
+	bigger.MemoryMB *= 2
+	_, ok := pool.acquire(ctx, &bigger)
+	assert.False(t, ok)
+
+	policy := DefaultBuildPolicy()
+	inst, ok := pool.acquire(ctx, &policy)
+	require.True(t, ok)
+	assert.True(t, strings.HasPrefix(inst.Name, builderPoolPrefix))
+	assert.Equal(t, 1, pool.idleCount())
+
+	_, err := instMgr.AttachVolume(ctx, inst.Id, "build-source-1", instances.AttachVolumeRequest{MountPath: "/src"})
+	require.NoError(t, err)
+
+	// Release stops the builder and frees its volumes before returning, then
+	// resets it back into the pool
+	pool.release(ctx, inst.Id)
+	released, err := instMgr.GetInstance(ctx, inst.Id)
+	require.NoError(t, err)
+	assert.Empty(t, released.Volumes)
+	require.Eventually(t, func() bool { return pool.idleCount() == 2 }, 5*time.Second, 10*time.Millisecond)
+	instMgr.mu.Lock()
+	assert.Equal(t, 1, instMgr.resets)
+	instMgr.mu.Unlock()
+
+	// A builder deleted during its build, as CancelBuild does, is replaced
+	inst, ok = pool.acquire(ctx, &policy)
+	require.True(t, ok)
+	require.NoError(t, instMgr.DeleteInstance(ctx, inst.Id))
+	pool.release(ctx, inst.Id)
+	require.Eventually(t, func() bool { return pool.idleCount() == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	// Shutting down deletes the idle builders
+	cancel()
+	require.Eventually(t, func() bool { return len(instMgr.names()) == 1 }, 5*time.Second, 10*time.Millisecond)
+}
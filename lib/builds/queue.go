@@ -1,14 +1,33 @@
 package builds
 
-import "sync"
+import (
+	"sort"
+	"sync"
+)
 
 // QueuedBuild represents a build waiting to be executed
 type QueuedBuild struct {
 	BuildID string
+	Tenant  string
 	Request CreateBuildRequest
 	StartFn func()
 }
 
+// QueueStats is a snapshot of the build queue
+type QueueStats struct {
+	MaxConcurrent int
+	Active        int
+	Pending       int
+	Tenants       []TenantQueueStats // Tenants with active or pending builds, by name
+}
+
+// TenantQueueStats counts one tenant's builds in the queue
+type TenantQueueStats struct {
+	Tenant  string
+	Active  int
+	Pending int
+}
+
 // BuildQueue manages concurrent builds with a configurable limit.
 // Following the pattern from lib/images/queue.go.
 //
@@ -16,6 +35,10 @@ type QueuedBuild struct {
 // - Queue state is in-memory (lost on restart)
 // - Build metadata is persisted to disk
 // - On startup, pending builds are recovered via listPendingBuilds()
+// - Slots are shared fairly between tenants: a freed slot goes to the tenant
+//   with the fewest running builds, then the one that least recently started
+//   one, so a tenant submitting many builds can't starve the others. Builds
+//   of one tenant start in the order they were enqueued.
 //
 // Future migration path if needed:
 // - Add BuildQueue interface with Enqueue/Dequeue/Ack/Nack
@@ -23,8 +46,10 @@ type QueuedBuild struct {
 // - Use BUILD_QUEUE_BACKEND env var to select implementation
 type BuildQueue struct {
 	maxConcurrent int
-	active        map[string]bool
-	pending       []QueuedBuild
+	active        map[string]string // Build ID -> tenant
+	pending       []QueuedBuild     // In enqueue order
+	lastStarted   map[string]uint64 // Tenant -> value of started when its latest build started
+	started       uint64
 	mu            sync.Mutex
 }
 
@@ -35,8 +60,9 @@ func NewBuildQueue(maxConcurrent int) *BuildQueue {
 	}
 	return &BuildQueue{
 		maxConcurrent: maxConcurrent,
-		active:        make(map[string]bool),
+		active:        make(map[string]string),
 		pending:       make([]QueuedBuild, 0),
+		lastStarted:   make(map[string]uint64),
 	}
 }
 
@@ -47,15 +73,13 @@ func (q *BuildQueue) Enqueue(buildID string, req CreateBuildRequest, startFn fun
 	defer q.mu.Unlock()
 
 	// Check if already building (position 0, actively running)
-	if q.active[buildID] {
+	if _, ok := q.active[buildID]; ok {
 		return 0
 	}
 
 	// Check if already in pending queue
-	for i, build := range q.pending {
-		if build.BuildID == buildID {
-			return i + 1 // Return existing queue position
-		}
+	if pos := q.position(buildID); pos > 0 {
+		return pos // Return existing queue position
 	}
 
 	// Wrap the function to auto-complete
@@ -66,20 +90,28 @@ func (q *BuildQueue) Enqueue(buildID string, req CreateBuildRequest, startFn fun
 
 	build := QueuedBuild{
 		BuildID: buildID,
+		Tenant:  req.Tenant,
 		Request: req,
 		StartFn: wrappedFn,
 	}
 
 	// Start immediately if under concurrency limit
 	if len(q.active) < q.maxConcurrent {
-		q.active[buildID] = true
-		go wrappedFn()
+		q.start(build)
 		return 0
 	}
 
 	// Otherwise queue it
 	q.pending = append(q.pending, build)
-	return len(q.pending)
+	return q.position(buildID)
+}
+
+// start runs a build. The caller holds q.mu.
+func (q *BuildQueue) start(build QueuedBuild) {
+	q.started++
+	q.active[build.BuildID] = build.Tenant
+	q.lastStarted[build.Tenant] = q.started
+	go build.StartFn()
 }
 
 // MarkComplete marks a build as complete and starts the next pending build if any
@@ -91,11 +123,65 @@ func (q *BuildQueue) MarkComplete(buildID string) {
 
 	// Start next pending build if we have capacity
 	if len(q.pending) > 0 && len(q.active) < q.maxConcurrent {
-		next := q.pending[0]
-		q.pending = q.pending[1:]
-		q.active[next.BuildID] = true
-		go next.StartFn()
+		i := nextPending(q.pending, q.activePerTenant(), q.lastStarted)
+		next := q.pending[i]
+		q.pending = append(q.pending[:i], q.pending[i+1:]...)
+		q.start(next)
+	}
+}
+
+// nextPending returns the index of the pending build to start next: the
+// oldest build of the tenant with the fewest active builds, ties going to the
+// tenant that least recently started one
+func nextPending(pending []QueuedBuild, active map[string]int, lastStarted map[string]uint64) int {
+	best := 0
+	seen := make(map[string]bool)
+	for i, build := range pending {
+		if seen[build.Tenant] {
+			continue // Only a tenant's oldest build is a candidate
+		}
+		seen[build.Tenant] = true
+		current := pending[best]
+		if active[build.Tenant] < active[current.Tenant] ||
+			(active[build.Tenant] == active[current.Tenant] && lastStarted[build.Tenant] < lastStarted[current.Tenant]) {
+			best = i
+		}
+	}
+	return best
+}
+
+// activePerTenant counts the active builds of each tenant. The caller holds q.mu.
+func (q *BuildQueue) activePerTenant() map[string]int {
+	counts := make(map[string]int)
+	for _, tenant := range q.active {
+		counts[tenant]++
+	}
+	return counts
+}
+
+// position returns where a pending build is in the order builds would start
+// if none of the active builds finished in between, or 0 if it isn't
+// pending. The caller holds q.mu.
+func (q *BuildQueue) position(buildID string) int {
+	pending := append([]QueuedBuild(nil), q.pending...)
+	active := q.activePerTenant()
+	lastStarted := make(map[string]uint64, len(q.lastStarted))
+	for tenant, seq := range q.lastStarted {
+		lastStarted[tenant] = seq
+	}
+	started := q.started
+
+	for pos := 1; len(pending) > 0; pos++ {
+		i := nextPending(pending, active, lastStarted)
+		if pending[i].BuildID == buildID {
+			return pos
+		}
+		started++
+		active[pending[i].Tenant]++
+		lastStarted[pending[i].Tenant] = started
+		pending = append(pending[:i], pending[i+1:]...)
 	}
+	return 0
 }
 
 // GetPosition returns the queue position for a build.
@@ -104,15 +190,12 @@ func (q *BuildQueue) GetPosition(buildID string) *int {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	if q.active[buildID] {
+	if _, ok := q.active[buildID]; ok {
 		return nil // Actively running, not queued
 	}
 
-	for i, build := range q.pending {
-		if build.BuildID == buildID {
-			pos := i + 1
-			return &pos
-		}
+	if pos := q.position(buildID); pos > 0 {
+		return &pos
 	}
 
 	return nil // Not in queue
@@ -126,7 +209,7 @@ func (q *BuildQueue) Cancel(buildID string) bool {
 	defer q.mu.Unlock()
 
 	// Can't cancel if actively running
-	if q.active[buildID] {
+	if _, ok := q.active[buildID]; ok {
 		return false
 	}
 
@@ -145,7 +228,8 @@ func (q *BuildQueue) Cancel(buildID string) bool {
 func (q *BuildQueue) IsActive(buildID string) bool {
 	q.mu.Lock()
 	defer q.mu.Unlock()
-	return q.active[buildID]
+	_, ok := q.active[buildID]
+	return ok
 }
 
 // ActiveCount returns the number of actively building builds
@@ -169,3 +253,34 @@ func (q *BuildQueue) QueueLength() int {
 	return len(q.active) + len(q.pending)
 }
 
+// Stats returns the queue's counts, overall and per tenant
+func (q *BuildQueue) Stats() QueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	tenants := make(map[string]*TenantQueueStats)
+	tenant := func(name string) *TenantQueueStats {
+		if tenants[name] == nil {
+			tenants[name] = &TenantQueueStats{Tenant: name}
+		}
+		return tenants[name]
+	}
+	for _, name := range q.active {
+		tenant(name).Active++
+	}
+	for _, build := range q.pending {
+		tenant(build.Tenant).Pending++
+	}
+
+	stats := QueueStats{
+		MaxConcurrent: q.maxConcurrent,
+		Active:        len(q.active),
+		Pending:       len(q.pending),
+		Tenants:       make([]TenantQueueStats, 0, len(tenants)),
+	}
+	for _, t := range tenants {
+		stats.Tenants = append(stats.Tenants, *t)
+	}
+	sort.Slice(stats.Tenants, func(i, j int) bool { return stats.Tenants[i].Tenant < stats.Tenants[j].Tenant })
+	return stats
+}
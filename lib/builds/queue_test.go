@@ -228,3 +228,50 @@ func TestBuildQueue_Counts(t *testing.T) {
 	close(done)
 }
 
+func TestBuildQueue_FairShareBetweenTenants(t *testing.T) {
+	queue := NewBuildQueue(2)
+
+	started := make(chan string, 10)
+	release := map[string]chan struct{}{}
+	enqueue := func(id, tenant string) int {
+		ch := make(chan struct{})
+		release[id] = ch
+		return queue.Enqueue(id, CreateBuildRequest{Tenant: tenant}, func() {
+			started <- id
+			<-ch
+		})
+	}
+
+	// Tenant a fills both slots and queues two more builds
+	assert.Equal(t, 0, enqueue("a1", "a"))
+	assert.Equal(t, 0, enqueue("a2", "a"))
+	assert.Equal(t, 1, enqueue("a3", "a"))
+	assert.Equal(t, 2, enqueue("a4", "a"))
+	<-started
+	<-started
+
+	// Tenant b has nothing running, so its build goes ahead of a's
+	assert.Equal(t, 1, enqueue("b1", "b"))
+	assert.Equal(t, 2, *queue.GetPosition("a3"))
+	assert.Equal(t, 3, *queue.GetPosition("a4"))
+
+	stats := queue.Stats()
+	assert.Equal(t, 2, stats.Active)
+	assert.Equal(t, 3, stats.Pending)
+	assert.Equal(t, []TenantQueueStats{
+		{Tenant: "a", Active: 2, Pending: 2},
+		{Tenant: "b", Active: 0, Pending: 1},
+	}, stats.Tenants)
+
+	close(release["a1"])
+	assert.Equal(t, "b1", <-started)
+
+	// With one build each running, a's builds take the next slots in order
+	close(release["b1"])
+	assert.Equal(t, "a3", <-started)
+	close(release["a2"])
+	assert.Equal(t, "a4", <-started)
+
+	close(release["a3"])
+	close(release["a4"])
+}
@@ -0,0 +1,42 @@
+package builds
+
+import (
+	"context"
+
+	"github.com/onkernel/hypeman/lib/refindex"
+)
+
+// SetReferenceIndex sets the index that records the builder VM of each
+// running build
+func (m *manager) SetReferenceIndex(idx *refindex.Index) {
+	m.refIndex.Store(idx)
+}
+
+// IndexedReferences returns the builder VM of each build still building or
+// pushing, for rebuilding the reference index. Finished builds keep the ID
+// of their builder VM, but no longer use it.
+func (m *manager) IndexedReferences(ctx context.Context) (map[refindex.Ref][]refindex.Ref, error) {
+	metas, err := listAllBuilds(m.paths)
+	if err != nil {
+		return nil, err
+	}
+	refs := make(map[refindex.Ref][]refindex.Ref)
+	for _, meta := range metas {
+		if meta.BuilderInstance == nil {
+			continue
+		}
+		switch meta.Status {
+		case StatusBuilding, StatusPushing:
+			refs[buildRef(meta.ID)] = builderRefs(*meta.BuilderInstance)
+		}
+	}
+	return refs, nil
+}
+
+func buildRef(id string) refindex.Ref {
+	return refindex.Ref{Type: refindex.TypeBuild, ID: id}
+}
+
+func builderRefs(instanceID string) []refindex.Ref {
+	return []refindex.Ref{{Type: refindex.TypeInstance, ID: instanceID}}
+}
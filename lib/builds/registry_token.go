@@ -3,6 +3,8 @@ package builds
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -62,6 +64,48 @@ func (g *RegistryTokenGenerator) GeneratePushToken(buildID string, repos []strin
 	return token.SignedString(g.secret)
 }
 
+// GenerateBuildToken creates a short-lived token granting push access to
+// pushRepos and read-only access to pullRepos, such as shared caches a build
+// imports from. Without pullRepos it is the same as GeneratePushToken.
+func (g *RegistryTokenGenerator) GenerateBuildToken(buildID string, pushRepos, pullRepos []string, ttl time.Duration) (string, error) {
+	if len(pullRepos) == 0 {
+		return g.GeneratePushToken(buildID, pushRepos, ttl)
+	}
+	if buildID == "" {
+		return "", fmt.Errorf("build ID is required")
+	}
+	if len(pushRepos) == 0 {
+		return "", fmt.Errorf("at least one repository is required")
+	}
+
+	// Mixed access can't be expressed with the repos claim, so use
+	// per-repository scope entries instead
+	entries := make([]string, 0, len(pushRepos)+len(pullRepos))
+	for _, repo := range pushRepos {
+		entries = append(entries, fmt.Sprintf("repository:%s:push,pull", repo))
+	}
+	for _, repo := range pullRepos {
+		if !slices.Contains(pushRepos, repo) {
+			entries = append(entries, fmt.Sprintf("repository:%s:pull", repo))
+		}
+	}
+
+	now := time.Now()
+	claims := RegistryTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "builder-" + buildID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			Issuer:    "hypeman",
+		},
+		BuildID: buildID,
+		Scope:   strings.Join(entries, " "),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(g.secret)
+}
+
 // ValidateToken parses and validates a registry token, returning the claims if valid.
 func (g *RegistryTokenGenerator) ValidateToken(tokenString string) (*RegistryTokenClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &RegistryTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
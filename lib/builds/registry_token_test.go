@@ -109,3 +109,30 @@ func TestRegistryTokenClaims_IsPushAllowed(t *testing.T) {
 		assert.True(t, claims.IsPullAllowed())
 	})
 }
+
+func TestRegistryTokenGenerator_GenerateBuildToken(t *testing.T) {
+	generator := NewRegistryTokenGenerator("test-secret-key")
+
+	t.Run("without imports uses repos claim", func(t *testing.T) {
+		token, err := generator.GenerateBuildToken("build-123", []string{"builds/build-123"}, nil, time.Hour)
+		require.NoError(t, err)
+
+		claims, err := generator.ValidateToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"builds/build-123"}, claims.Repositories)
+		assert.Equal(t, "push", claims.Scope)
+	})
+
+	t.Run("imported caches are pull only", func(t *testing.T) {
+		token, err := generator.GenerateBuildToken("build-123",
+			[]string{"builds/build-123", "cache/app"},
+			[]string{"cache/shared-base", "cache/app"}, time.Hour)
+		require.NoError(t, err)
+
+		claims, err := generator.ValidateToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, "build-123", claims.BuildID)
+		assert.Empty(t, claims.Repositories)
+		assert.Equal(t, "repository:builds/build-123:push,pull repository:cache/app:push,pull repository:cache/shared-base:pull", claims.Scope)
+	})
+}
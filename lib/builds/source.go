@@ -0,0 +1,93 @@
+package builds
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// StagedSource is a build's source tarball received onto disk, before the
+// build it belongs to is created. CreateBuild moves it into the build.
+type StagedSource struct {
+	path string
+	Size int64 // Bytes received
+}
+
+// Remove deletes the staged tarball. It is a no-op once CreateBuild has
+// moved it into a build, so callers can always defer it.
+func (s *StagedSource) Remove() {
+	if s != nil {
+		os.Remove(s.path)
+	}
+}
+
+// gzipMagic starts every gzip stream; sources are extracted as tar.gz
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// StageSource streams a source tarball to disk, so an upload is never held
+// in memory. It fails with ErrSourceTooLarge past the configured maximum
+// size, and with ErrInvalidSource if the data isn't gzip-compressed.
+func (m *manager) StageSource(ctx context.Context, r io.Reader) (*StagedSource, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(gzipMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("read source: %w", err)
+	}
+	if len(magic) < len(gzipMagic) || magic[0] != gzipMagic[0] || magic[1] != gzipMagic[1] {
+		return nil, fmt.Errorf("%w: source must be a gzip-compressed tarball", ErrInvalidSource)
+	}
+
+	dir := m.paths.BuildUploadsDir()
+	if err := ensureDir(dir); err != nil {
+		return nil, fmt.Errorf("create uploads directory: %w", err)
+	}
+	f, err := os.CreateTemp(dir, "source-*.tar.gz")
+	if err != nil {
+		return nil, fmt.Errorf("create staged source: %w", err)
+	}
+	staged := &StagedSource{path: f.Name()}
+
+	// Read one byte past the limit to tell a source of exactly the maximum
+	// size from a larger one
+	src := io.Reader(br)
+	if m.config.MaxSourceSize > 0 {
+		src = io.LimitReader(br, m.config.MaxSourceSize+1)
+	}
+	staged.Size, err = io.Copy(f, src)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		staged.Remove()
+		return nil, fmt.Errorf("write staged source: %w", err)
+	}
+	if m.config.MaxSourceSize > 0 && staged.Size > m.config.MaxSourceSize {
+		staged.Remove()
+		return nil, fmt.Errorf("%w: limit is %d bytes", ErrSourceTooLarge, m.config.MaxSourceSize)
+	}
+	return staged, nil
+}
+
+// storeSource moves a staged source tarball into a build
+func (m *manager) storeSource(buildID string, source *StagedSource) error {
+	if source == nil {
+		return fmt.Errorf("%w: no source staged", ErrInvalidSource)
+	}
+	sourceDir := m.paths.BuildSourceDir(buildID)
+	if err := ensureDir(sourceDir); err != nil {
+		return err
+	}
+	return os.Rename(source.path, filepath.Join(sourceDir, "source.tar.gz"))
+}
+
+// clearStagedSources removes sources staged by uploads that never became
+// builds, e.g. because the server stopped mid-upload
+func (m *manager) clearStagedSources() {
+	if err := os.RemoveAll(m.paths.BuildUploadsDir()); err != nil {
+		m.logger.Warn("failed to clear staged build sources", "error", err)
+	}
+}
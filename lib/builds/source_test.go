@@ -0,0 +1,79 @@
+package builds
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stageTestSource stages data as a build source as-is, without the checks
+// StageSource makes, for tests that don't care what the source holds. It
+// reports failures with t.Errorf, so it can be called from goroutines.
+func stageTestSource(t *testing.T, mgr *manager, data []byte) *StagedSource {
+	t.Helper()
+	dir := mgr.paths.BuildUploadsDir()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Errorf("create uploads directory: %v", err)
+		return nil
+	}
+	f, err := os.CreateTemp(dir, "source-*.tar.gz")
+	if err != nil {
+		t.Errorf("create staged source: %v", err)
+		return nil
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		t.Errorf("write staged source: %v", err)
+		return nil
+	}
+	return &StagedSource{path: f.Name(), Size: int64(len(data))}
+}
+
+func gzipData(t *testing.T, size int) []byte {
+	var buf bytes.Buffer
+	zw, _ := gzip.NewWriterLevel(&buf, gzip.NoCompression)
+	_, err := zw.Write(bytes.Repeat([]byte("a"), size))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestStageSource(t *testing.T) {
+	mgr, _, _, tempDir := setupTestManager(t)
+	defer os.RemoveAll(tempDir)
+	ctx := context.Background()
+
+	data := gzipData(t, 1000)
+	mgr.config.MaxSourceSize = int64(len(data))
+
+	// A source of exactly the maximum size is accepted and moved into the build
+	staged, err := mgr.StageSource(ctx, bytes.NewReader(data))
+	require.NoError(t, err)
+	defer staged.Remove()
+	assert.Equal(t, int64(len(data)), staged.Size)
+
+	build, err := mgr.CreateBuild(ctx, CreateBuildRequest{Dockerfile: "FROM alpine"}, staged)
+	require.NoError(t, err)
+	stored, err := os.ReadFile(filepath.Join(mgr.paths.BuildSourceDir(build.ID), "source.tar.gz"))
+	require.NoError(t, err)
+	assert.Equal(t, data, stored)
+
+	// One byte more is refused, and nothing is left staged
+	_, err = mgr.StageSource(ctx, bytes.NewReader(append(data, 0)))
+	assert.ErrorIs(t, err, ErrSourceTooLarge)
+
+	_, err = mgr.StageSource(ctx, bytes.NewReader([]byte("plain text, not gzip")))
+	assert.ErrorIs(t, err, ErrInvalidSource)
+	_, err = mgr.StageSource(ctx, bytes.NewReader(nil))
+	assert.ErrorIs(t, err, ErrInvalidSource)
+
+	entries, err := os.ReadDir(mgr.paths.BuildUploadsDir())
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
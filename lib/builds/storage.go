@@ -19,11 +19,14 @@ type buildMetadata struct {
 	ImageRef        *string             `json:"image_ref,omitempty"`
 	Error           *string             `json:"error,omitempty"`
 	Provenance      *BuildProvenance    `json:"provenance,omitempty"`
+	CacheStats      *BuildCacheStats    `json:"cache_stats,omitempty"`
 	CreatedAt       time.Time           `json:"created_at"`
 	StartedAt       *time.Time          `json:"started_at,omitempty"`
 	CompletedAt     *time.Time          `json:"completed_at,omitempty"`
 	DurationMS      *int64              `json:"duration_ms,omitempty"`
 	BuilderInstance *string             `json:"builder_instance,omitempty"` // Instance ID of builder VM
+	QueueSeq        uint64              `json:"queue_seq,omitempty"`        // Enqueue order, preserved across restarts
+	Attempts        int                 `json:"attempts,omitempty"`         // Times the build has started running
 }
 
 // toBuild converts internal metadata to the public Build type
@@ -33,8 +36,10 @@ func (m *buildMetadata) toBuild() *Build {
 		Status:      m.Status,
 		ImageDigest: m.ImageDigest,
 		ImageRef:    m.ImageRef,
+		OutputType:  m.Request.outputType(),
 		Error:       m.Error,
 		Provenance:  m.Provenance,
+		CacheStats:  m.CacheStats,
 		CreatedAt:   m.CreatedAt,
 		StartedAt:   m.StartedAt,
 		CompletedAt: m.CompletedAt,
@@ -122,7 +127,8 @@ func listAllBuilds(p *paths.Paths) ([]*buildMetadata, error) {
 }
 
 // listPendingBuilds returns builds that need to be recovered on startup
-// Returns builds with status queued/building, sorted by created_at (oldest first for FIFO)
+// Returns builds with status queued/building/pushing in the order they were
+// enqueued. Builds written before queue_seq existed sort first, by created_at.
 func listPendingBuilds(p *paths.Paths) ([]*buildMetadata, error) {
 	all, err := listAllBuilds(p)
 	if err != nil {
@@ -137,8 +143,11 @@ func listPendingBuilds(p *paths.Paths) ([]*buildMetadata, error) {
 		}
 	}
 
-	// Sort by created_at ascending (oldest first for FIFO recovery)
-	sort.Slice(pending, func(i, j int) bool {
+	// Sort by enqueue order (oldest first for FIFO recovery)
+	sort.SliceStable(pending, func(i, j int) bool {
+		if pending[i].QueueSeq != pending[j].QueueSeq {
+			return pending[i].QueueSeq < pending[j].QueueSeq
+		}
 		return pending[i].CreatedAt.Before(pending[j].CreatedAt)
 	})
 
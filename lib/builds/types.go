@@ -2,7 +2,10 @@
 // inside ephemeral Cloud Hypervisor microVMs for multi-tenant isolation.
 package builds
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // Build status constants
 const (
@@ -14,6 +17,13 @@ const (
 	StatusCancelled = "cancelled"
 )
 
+// Build output types
+const (
+	OutputImage = "image" // push an image to the registry (default)
+	OutputLocal = "local" // export the final stage's files
+	OutputTar   = "tar"   // export the final stage's files as one tarball
+)
+
 // Build represents a source-to-image build job
 type Build struct {
 	ID            string           `json:"id"`
@@ -21,8 +31,10 @@ type Build struct {
 	QueuePosition *int             `json:"queue_position,omitempty"`
 	ImageDigest   *string          `json:"image_digest,omitempty"`
 	ImageRef      *string          `json:"image_ref,omitempty"`
+	OutputType    string           `json:"output_type,omitempty"`
 	Error         *string          `json:"error,omitempty"`
 	Provenance    *BuildProvenance `json:"provenance,omitempty"`
+	CacheStats    *BuildCacheStats `json:"cache_stats,omitempty"`
 	CreatedAt     time.Time        `json:"created_at"`
 	StartedAt     *time.Time       `json:"started_at,omitempty"`
 	CompletedAt   *time.Time       `json:"completed_at,omitempty"`
@@ -47,11 +59,35 @@ type CreateBuildRequest struct {
 	// CacheScope is the tenant-specific cache key prefix for isolation
 	CacheScope string `json:"cache_scope,omitempty"`
 
+	// CacheImports are additional cache scopes to import from but never
+	// export to, e.g. a base-layer cache shared between projects
+	CacheImports []string `json:"cache_imports,omitempty"`
+
 	// BuildArgs are ARG values to pass to the Dockerfile
 	BuildArgs map[string]string `json:"build_args,omitempty"`
 
 	// Secrets are secret references to inject during build
 	Secrets []SecretRef `json:"secrets,omitempty"`
+
+	// Frontend is the BuildKit frontend: "dockerfile.v0" (the default) or a
+	// frontend image such as "docker/dockerfile:1.7" or a buildpacks frontend.
+	// Images must be in the configured allowlist.
+	Frontend string `json:"frontend,omitempty"`
+
+	// FrontendOpts are extra options passed to the frontend (buildctl --opt)
+	FrontendOpts map[string]string `json:"frontend_opts,omitempty"`
+
+	// OutputType is "image" (the default), or "local" or "tar" to export the
+	// build's files as an artifact instead of pushing an image
+	OutputType string `json:"output_type,omitempty"`
+
+	// SkipDockerfileValidation skips the syntax check of an inline Dockerfile
+	// that normally runs before a builder VM is started
+	SkipDockerfileValidation bool `json:"skip_dockerfile_validation,omitempty"`
+
+	// Tenant is the authenticated subject that submitted the build. The build
+	// queue shares its slots fairly between tenants.
+	Tenant string `json:"tenant,omitempty"`
 }
 
 // BuildPolicy defines resource limits and network policy for a build
@@ -99,6 +135,45 @@ type BuildProvenance struct {
 
 	// Timestamp is when the build completed
 	Timestamp time.Time `json:"timestamp"`
+
+	// SecretScan is the check of the pushed image for leaked build secrets
+	// (nil if the build used no secrets or pushed no image)
+	SecretScan *SecretScan `json:"secret_scan,omitempty"`
+
+	// CacheStats is how much of the build came from the build cache
+	// (nil for builds from before it was recorded)
+	CacheStats *BuildCacheStats `json:"cache_stats,omitempty"`
+}
+
+// BuildCacheStats counts the Dockerfile steps BuildKit took from its cache
+// and the ones it executed, as read from its progress output. A miss on a
+// step that should have hit means an earlier input changed or the cache
+// scope isn't being imported.
+type BuildCacheStats struct {
+	// CacheHits is how many steps were cached
+	CacheHits int `json:"cache_hits"`
+
+	// CacheMisses is how many steps were executed
+	CacheMisses int `json:"cache_misses"`
+
+	// CachedBytes is the size of the cached layers fetched from the registry
+	// cache; layers already on the builder are not counted
+	CachedBytes int64 `json:"cached_bytes"`
+}
+
+// SecretScan records the builder agent's check that no build secret ended up
+// in the pushed image: no file under /run/secrets and no secret value in any
+// layer's file contents
+type SecretScan struct {
+	// Passed is true if no leak was found
+	Passed bool `json:"passed"`
+
+	// LayersScanned is how many distinct layers were checked
+	LayersScanned int `json:"layers_scanned"`
+
+	// Leaks describes each leak found, naming the layer, file and secret ID
+	// but never the value
+	Leaks []string `json:"leaks,omitempty"`
 }
 
 // BuildConfig is the configuration passed to the builder VM via config disk
@@ -123,6 +198,25 @@ type BuildConfig struct {
 	// CacheScope is the tenant-specific cache key prefix
 	CacheScope string `json:"cache_scope,omitempty"`
 
+	// CacheImports are extra cache scopes imported read-only alongside CacheScope
+	CacheImports []string `json:"cache_imports,omitempty"`
+
+	// RegistryMirror is the upstream registry host (e.g. "docker.io") whose
+	// pulls BuildKit sends to RegistryURL, which caches them (empty = none)
+	RegistryMirror string `json:"registry_mirror,omitempty"`
+
+	// Frontend is "dockerfile.v0" or a frontend image (empty = dockerfile.v0)
+	Frontend string `json:"frontend,omitempty"`
+
+	// FrontendOpts are extra frontend options passed as buildctl --opt
+	FrontendOpts map[string]string `json:"frontend_opts,omitempty"`
+
+	// OutputType is "image", "local" or "tar" (empty = image)
+	OutputType string `json:"output_type,omitempty"`
+
+	// OutputPath is where artifact outputs are written in the guest (typically /output)
+	OutputPath string `json:"output_path,omitempty"`
+
 	// SourcePath is the path to source in the guest (typically /src)
 	SourcePath string `json:"source_path"`
 
@@ -180,6 +274,13 @@ type BuildResult struct {
 
 	// DurationMS is the build duration in milliseconds
 	DurationMS int64 `json:"duration_ms"`
+
+	// PushDurationMS is how long pushing the image to the registry took,
+	// as reported by BuildKit (0 if unknown)
+	PushDurationMS int64 `json:"push_duration_ms,omitempty"`
+
+	// BuildCacheStats counts the steps taken from the build cache
+	BuildCacheStats
 }
 
 // DefaultBuildPolicy returns the default build policy
@@ -192,6 +293,18 @@ func DefaultBuildPolicy() BuildPolicy {
 	}
 }
 
+// validateBuildPolicy checks a build policy, after defaults are applied,
+// against the server's resource bounds
+func validateBuildPolicy(p *BuildPolicy, config Config) error {
+	if config.MinMemoryMB > 0 && p.MemoryMB < config.MinMemoryMB {
+		return fmt.Errorf("%w: memory_mb %d is below the minimum of %d", ErrInvalidBuildPolicy, p.MemoryMB, config.MinMemoryMB)
+	}
+	if config.MaxMemoryMB > 0 && p.MemoryMB > config.MaxMemoryMB {
+		return fmt.Errorf("%w: memory_mb %d exceeds the maximum of %d", ErrInvalidBuildPolicy, p.MemoryMB, config.MaxMemoryMB)
+	}
+	return nil
+}
+
 // ApplyDefaults fills in default values for a build policy
 func (p *BuildPolicy) ApplyDefaults() {
 	defaults := DefaultBuildPolicy()
@@ -0,0 +1,21 @@
+package builds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateBuildPolicy(t *testing.T) {
+	config := Config{MinMemoryMB: 512, MaxMemoryMB: 8192}
+	policy := DefaultBuildPolicy()
+	assert.NoError(t, validateBuildPolicy(&policy, config))
+
+	policy.MemoryMB = 256
+	assert.ErrorIs(t, validateBuildPolicy(&policy, config), ErrInvalidBuildPolicy)
+	policy.MemoryMB = 16384
+	assert.ErrorIs(t, validateBuildPolicy(&policy, config), ErrInvalidBuildPolicy)
+
+	// Unset bounds allow any size
+	assert.NoError(t, validateBuildPolicy(&policy, Config{}))
+}
@@ -9,7 +9,8 @@ import (
 	"strings"
 )
 
-const (
+// sysfs roots, variables so tests can point them at a fake tree
+var (
 	sysfsDevicesPath = "/sys/bus/pci/devices"
 	sysfsIOMMUPath   = "/sys/kernel/iommu_groups"
 )
@@ -24,6 +25,8 @@ func ValidatePCIAddress(addr string) bool {
 
 // DiscoverAvailableDevices scans sysfs for PCI devices that can be used for passthrough
 // It filters for devices that are likely candidates (GPUs, network cards, etc.)
+// SR-IOV virtual functions are listed after their parent physical function's
+// candidates, with ParentPF set, regardless of device class.
 func DiscoverAvailableDevices() ([]AvailableDevice, error) {
 	entries, err := os.ReadDir(sysfsDevicesPath)
 	if err != nil {
@@ -43,15 +46,71 @@ func DiscoverAvailableDevices() ([]AvailableDevice, error) {
 			continue
 		}
 
+		// VFs are reported through their parent PF below
+		if device.ParentPF != nil {
+			continue
+		}
+
 		// Filter for passthrough-capable devices (GPUs, 3D controllers, etc.)
 		if isPassthroughCandidate(device) {
 			devices = append(devices, *device)
 		}
+
+		vfs, err := ListVirtualFunctions(addr)
+		if err != nil {
+			continue
+		}
+		for _, vfAddr := range vfs {
+			vf, err := readDeviceInfo(vfAddr)
+			if err != nil {
+				continue
+			}
+			devices = append(devices, *vf)
+		}
 	}
 
 	return devices, nil
 }
 
+// ListVirtualFunctions returns the PCI addresses of the enabled SR-IOV virtual
+// functions of a physical function, in VF index order.
+// Returns nil if the device is not SR-IOV capable or has no VFs enabled.
+func ListVirtualFunctions(pciAddress string) ([]string, error) {
+	devicePath := filepath.Join(sysfsDevicesPath, pciAddress)
+
+	numStr, err := readSysfsFile(filepath.Join(devicePath, "sriov_numvfs"))
+	if err != nil {
+		// Not SR-IOV capable
+		return nil, nil
+	}
+	numVFs, err := strconv.Atoi(numStr)
+	if err != nil {
+		return nil, fmt.Errorf("parse sriov_numvfs: %w", err)
+	}
+
+	vfs := make([]string, 0, numVFs)
+	for i := 0; i < numVFs; i++ {
+		// virtfnN links point at the VF's device directory, e.g. "../0000:3b:02.0"
+		target, err := os.Readlink(filepath.Join(devicePath, fmt.Sprintf("virtfn%d", i)))
+		if err != nil {
+			return nil, fmt.Errorf("read virtfn%d link: %w", i, err)
+		}
+		vfs = append(vfs, filepath.Base(target))
+	}
+	return vfs, nil
+}
+
+// readParentPF returns the physical function of an SR-IOV virtual function,
+// or nil if the device is not a VF
+func readParentPF(pciAddress string) *string {
+	target, err := os.Readlink(filepath.Join(sysfsDevicesPath, pciAddress, "physfn"))
+	if err != nil {
+		return nil
+	}
+	pf := filepath.Base(target)
+	return &pf
+}
+
 // GetDeviceInfo reads information about a specific PCI device
 func GetDeviceInfo(pciAddress string) (*AvailableDevice, error) {
 	if !ValidatePCIAddress(pciAddress) {
@@ -100,9 +159,25 @@ func readDeviceInfo(pciAddress string) (*AvailableDevice, error) {
 		DeviceName:    getDeviceName(vendorID, deviceID, classCode),
 		IOMMUGroup:    iommuGroup,
 		CurrentDriver: driver,
+		ParentPF:      readParentPF(pciAddress),
+		NUMANode:      readNUMANode(pciAddress),
 	}, nil
 }
 
+// readNUMANode reads the NUMA node a device is attached to.
+// Returns nil if unknown, which is how single-node hosts report it (numa_node = -1).
+func readNUMANode(pciAddress string) *int {
+	nodeStr, err := readSysfsFile(filepath.Join(sysfsDevicesPath, pciAddress, "numa_node"))
+	if err != nil {
+		return nil
+	}
+	node, err := strconv.Atoi(nodeStr)
+	if err != nil || node < 0 {
+		return nil
+	}
+	return &node
+}
+
 // readSysfsFile reads and trims a sysfs file
 func readSysfsFile(path string) (string, error) {
 	data, err := os.ReadFile(path)
@@ -252,6 +327,8 @@ func getDeviceName(vendorID, deviceID, classCode string) string {
 			return "3D Controller"
 		case "0403":
 			return "Audio Device"
+		case "0200":
+			return "Ethernet Controller"
 		}
 	}
 
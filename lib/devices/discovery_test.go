@@ -0,0 +1,170 @@
+package devices
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSysfs builds a minimal /sys/bus/pci/devices and /sys/kernel/iommu_groups tree
+type fakeSysfs struct {
+	t       *testing.T
+	devices string
+	groups  string
+}
+
+func newFakeSysfs(t *testing.T) *fakeSysfs {
+	root := t.TempDir()
+	fs := &fakeSysfs{
+		t:       t,
+		devices: filepath.Join(root, "devices"),
+		groups:  filepath.Join(root, "iommu_groups"),
+	}
+	require.NoError(t, os.MkdirAll(fs.devices, 0755))
+	require.NoError(t, os.MkdirAll(fs.groups, 0755))
+
+	oldDevices, oldGroups := sysfsDevicesPath, sysfsIOMMUPath
+	sysfsDevicesPath, sysfsIOMMUPath = fs.devices, fs.groups
+	t.Cleanup(func() {
+		sysfsDevicesPath, sysfsIOMMUPath = oldDevices, oldGroups
+	})
+	return fs
+}
+
+// addDevice creates a device directory with vendor/device/class files in the given IOMMU group
+func (fs *fakeSysfs) addDevice(addr, vendor, device, class string, group string) string {
+	dir := filepath.Join(fs.devices, addr)
+	require.NoError(fs.t, os.MkdirAll(dir, 0755))
+	require.NoError(fs.t, os.WriteFile(filepath.Join(dir, "vendor"), []byte("0x"+vendor+"\n"), 0644))
+	require.NoError(fs.t, os.WriteFile(filepath.Join(dir, "device"), []byte("0x"+device+"\n"), 0644))
+	require.NoError(fs.t, os.WriteFile(filepath.Join(dir, "class"), []byte("0x"+class+"\n"), 0644))
+
+	groupDir := filepath.Join(fs.groups, group, "devices")
+	require.NoError(fs.t, os.MkdirAll(groupDir, 0755))
+	require.NoError(fs.t, os.Symlink(dir, filepath.Join(groupDir, addr)))
+	require.NoError(fs.t, os.Symlink(filepath.Join(fs.groups, group), filepath.Join(dir, "iommu_group")))
+	return dir
+}
+
+// addVFs marks pf as an SR-IOV PF whose VFs are the given addresses
+func (fs *fakeSysfs) addVFs(pf string, vfs ...string) {
+	pfDir := filepath.Join(fs.devices, pf)
+	require.NoError(fs.t, os.WriteFile(filepath.Join(pfDir, "sriov_numvfs"), []byte(strconv.Itoa(len(vfs))+"\n"), 0644))
+	for i, vf := range vfs {
+		require.NoError(fs.t, os.Symlink("../"+vf, filepath.Join(pfDir, "virtfn"+strconv.Itoa(i))))
+		require.NoError(fs.t, os.Symlink("../"+pf, filepath.Join(fs.devices, vf, "physfn")))
+	}
+}
+
+func TestDiscoverAvailableDevices_SRIOV(t *testing.T) {
+	fs := newFakeSysfs(t)
+	fs.addDevice("0000:a2:00.0", "10de", "27b8", "030200", "82") // GPU
+	fs.addDevice("0000:3b:00.0", "8086", "1592", "020000", "10") // NIC PF
+	fs.addDevice("0000:3b:01.0", "8086", "1889", "020000", "11") // VF 0
+	fs.addDevice("0000:3b:01.1", "8086", "1889", "020000", "12") // VF 1
+	fs.addDevice("0000:00:1f.0", "8086", "a082", "060100", "1")  // ISA bridge
+	fs.addVFs("0000:3b:00.0", "0000:3b:01.0", "0000:3b:01.1")
+
+	devices, err := DiscoverAvailableDevices()
+	require.NoError(t, err)
+
+	var addrs []string
+	for _, d := range devices {
+		addrs = append(addrs, d.PCIAddress)
+	}
+	assert.ElementsMatch(t, []string{"0000:a2:00.0", "0000:3b:01.0", "0000:3b:01.1"}, addrs)
+
+	for _, d := range devices {
+		switch d.PCIAddress {
+		case "0000:a2:00.0":
+			assert.Nil(t, d.ParentPF)
+		default:
+			require.NotNil(t, d.ParentPF)
+			assert.Equal(t, "0000:3b:00.0", *d.ParentPF)
+			assert.Equal(t, "Ethernet Controller", d.DeviceName)
+		}
+	}
+}
+
+func TestListVirtualFunctions(t *testing.T) {
+	fs := newFakeSysfs(t)
+	fs.addDevice("0000:3b:00.0", "8086", "1592", "020000", "10")
+	fs.addDevice("0000:3b:01.0", "8086", "1889", "020000", "11")
+	fs.addDevice("0000:a2:00.0", "10de", "27b8", "030200", "82")
+	fs.addVFs("0000:3b:00.0", "0000:3b:01.0")
+
+	vfs, err := ListVirtualFunctions("0000:3b:00.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0000:3b:01.0"}, vfs)
+
+	vfs, err = ListVirtualFunctions("0000:a2:00.0")
+	require.NoError(t, err)
+	assert.Empty(t, vfs)
+}
+
+func TestCheckIOMMUGroupSafe_SRIOV(t *testing.T) {
+	binder := NewVFIOBinder()
+
+	t.Run("isolated VF is safe", func(t *testing.T) {
+		fs := newFakeSysfs(t)
+		fs.addDevice("0000:3b:00.0", "8086", "1592", "020000", "10")
+		fs.addDevice("0000:3b:01.0", "8086", "1889", "020000", "11")
+		fs.addVFs("0000:3b:00.0", "0000:3b:01.0")
+
+		assert.NoError(t, binder.CheckIOMMUGroupSafe("0000:3b:01.0", []string{"0000:3b:01.0"}))
+	})
+
+	t.Run("PF with VFs is rejected", func(t *testing.T) {
+		fs := newFakeSysfs(t)
+		fs.addDevice("0000:3b:00.0", "8086", "1592", "020000", "10")
+		fs.addDevice("0000:3b:01.0", "8086", "1889", "020000", "11")
+		fs.addVFs("0000:3b:00.0", "0000:3b:01.0")
+
+		err := binder.CheckIOMMUGroupSafe("0000:3b:00.0", []string{"0000:3b:00.0"})
+		assert.ErrorIs(t, err, ErrPFHasVFs)
+	})
+
+	t.Run("VF sharing a group with its PF is rejected", func(t *testing.T) {
+		fs := newFakeSysfs(t)
+		fs.addDevice("0000:3b:00.0", "8086", "1592", "020000", "10")
+		fs.addDevice("0000:3b:01.0", "8086", "1889", "020000", "10")
+		fs.addVFs("0000:3b:00.0", "0000:3b:01.0")
+
+		err := binder.CheckIOMMUGroupSafe("0000:3b:01.0", []string{"0000:3b:01.0", "0000:3b:00.0"})
+		assert.ErrorIs(t, err, ErrIOMMUGroupConflict)
+		assert.Contains(t, err.Error(), "physical function")
+	})
+
+	t.Run("sibling VF in same group must be included", func(t *testing.T) {
+		fs := newFakeSysfs(t)
+		fs.addDevice("0000:3b:00.0", "8086", "1592", "020000", "10")
+		fs.addDevice("0000:3b:01.0", "8086", "1889", "020000", "11")
+		fs.addDevice("0000:3b:01.1", "8086", "1889", "020000", "11")
+		fs.addVFs("0000:3b:00.0", "0000:3b:01.0", "0000:3b:01.1")
+
+		err := binder.CheckIOMMUGroupSafe("0000:3b:01.0", []string{"0000:3b:01.0"})
+		assert.ErrorIs(t, err, ErrIOMMUGroupConflict)
+
+		assert.NoError(t, binder.CheckIOMMUGroupSafe("0000:3b:01.0", []string{"0000:3b:01.0", "0000:3b:01.1"}))
+	})
+}
+
+func TestReadNUMANode(t *testing.T) {
+	fs := newFakeSysfs(t)
+	near := fs.addDevice("0000:3b:00.0", "10de", "27b8", "030200", "10")
+	unknown := fs.addDevice("0000:5e:00.0", "10de", "27b8", "030200", "11")
+	fs.addDevice("0000:af:00.0", "10de", "27b8", "030200", "12")
+	require.NoError(t, os.WriteFile(filepath.Join(near, "numa_node"), []byte("1\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(unknown, "numa_node"), []byte("-1\n"), 0644))
+
+	node := readNUMANode("0000:3b:00.0")
+	require.NotNil(t, node)
+	assert.Equal(t, 1, *node)
+
+	assert.Nil(t, readNUMANode("0000:5e:00.0"), "-1 means unknown")
+	assert.Nil(t, readNUMANode("0000:af:00.0"), "missing file means unknown")
+}
@@ -35,6 +35,12 @@ var (
 
 	// ErrIOMMUGroupConflict is returned when not all devices in IOMMU group can be passed through
 	ErrIOMMUGroupConflict = errors.New("IOMMU group contains other devices that must also be passed through")
+
+	// ErrNoDeviceAvailable is returned when every device in a pool is attached
+	ErrNoDeviceAvailable = errors.New("no device available in pool")
+
+	// ErrPFHasVFs is returned when passing through an SR-IOV physical function that has VFs enabled
+	ErrPFHasVFs = errors.New("SR-IOV physical function has virtual functions enabled")
 )
 
 
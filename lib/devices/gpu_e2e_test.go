@@ -73,7 +73,7 @@ func TestGPUPassthrough(t *testing.T) {
 	limits := instances.ResourceLimits{
 		MaxOverlaySize: 100 * 1024 * 1024 * 1024, // 100GB
 	}
-	instanceMgr := instances.NewManager(p, imageMgr, systemMgr, networkMgr, deviceMgr, volumeMgr, limits, "", nil, nil)
+	instanceMgr := instances.NewManager(p, imageMgr, systemMgr, networkMgr, deviceMgr, volumeMgr, limits, 0, instances.ConsoleConfig{}, "", nil, nil)
 
 	// Step 1: Discover available GPUs
 	t.Log("Step 1: Discovering available GPUs...")
@@ -11,6 +11,7 @@ import (
 
 	"github.com/nrednav/cuid2"
 	"github.com/onkernel/hypeman/lib/logger"
+	"github.com/onkernel/hypeman/lib/pagination"
 	"github.com/onkernel/hypeman/lib/paths"
 )
 
@@ -29,7 +30,7 @@ type InstanceLivenessChecker interface {
 	// ListAllInstanceDevices returns a map of instanceID -> []deviceIDs for all instances.
 	ListAllInstanceDevices(ctx context.Context) map[string][]string
 
-	// DetectSuspiciousVMMProcesses finds cloud-hypervisor processes that don't match
+	// DetectSuspiciousVMMProcesses finds hypervisor processes that don't match
 	// known instances and logs warnings. Returns the count of suspicious processes found.
 	DetectSuspiciousVMMProcesses(ctx context.Context) int
 }
@@ -39,6 +40,10 @@ type Manager interface {
 	// ListDevices returns all registered devices
 	ListDevices(ctx context.Context) ([]Device, error)
 
+	// ListDevicesPage returns one page of registered devices, sorted by
+	// created_at (default) or name
+	ListDevicesPage(ctx context.Context, req pagination.Request) (*pagination.Page[Device], error)
+
 	// ListAvailableDevices discovers passthrough-capable devices on the host
 	ListAvailableDevices(ctx context.Context) ([]AvailableDevice, error)
 
@@ -63,6 +68,14 @@ type Manager interface {
 	// MarkDetached marks a device as detached from an instance
 	MarkDetached(ctx context.Context, deviceID string) error
 
+	// ListPools returns all device pools with their member device IDs
+	ListPools(ctx context.Context) ([]DevicePool, error)
+
+	// AcquireFromPool picks a free device from the named pool and marks it attached
+	// to the instance. Returns ErrNotFound if the pool has no members and
+	// ErrNoDeviceAvailable if all members are attached.
+	AcquireFromPool(ctx context.Context, pool, instanceID string) (*Device, error)
+
 	// ReconcileDevices cleans up stale device state on startup.
 	// It detects devices with AttachedTo referencing non-existent instances
 	// and clears the orphaned attachment state.
@@ -99,6 +112,43 @@ func (m *manager) SetLivenessChecker(checker InstanceLivenessChecker) {
 }
 
 func (m *manager) ListDevices(ctx context.Context) ([]Device, error) {
+	devices, err := m.loadDevices()
+	if err != nil {
+		return nil, err
+	}
+	for i := range devices {
+		// Update VFIO binding status from system state
+		devices[i].BoundToVFIO = m.vfioBinder.IsDeviceBoundToVFIO(devices[i].PCIAddress)
+	}
+	return devices, nil
+}
+
+// deviceSortKeys are the fields device lists can be sorted by
+var deviceSortKeys = pagination.SortKeys[Device]{
+	"created_at": func(d Device) string { return pagination.TimeKey(d.CreatedAt) },
+	"name":       func(d Device) string { return d.Name },
+}
+
+// ListDevicesPage returns one page of devices, oldest first by default.
+// Only the devices on the page have their VFIO binding read from sysfs.
+func (m *manager) ListDevicesPage(ctx context.Context, req pagination.Request) (*pagination.Page[Device], error) {
+	devices, err := m.loadDevices()
+	if err != nil {
+		return nil, err
+	}
+	page, err := pagination.Paginate(devices, req, deviceSortKeys, "created_at", func(d Device) string { return d.Id })
+	if err != nil {
+		return nil, err
+	}
+	for i := range page.Items {
+		page.Items[i].BoundToVFIO = m.vfioBinder.IsDeviceBoundToVFIO(page.Items[i].PCIAddress)
+	}
+	return page, nil
+}
+
+// loadDevices loads all registered devices as stored, skipping any that
+// can't be read
+func (m *manager) loadDevices() ([]Device, error) {
 	// RLock protects against concurrent directory modifications (CreateDevice/DeleteDevice)
 	// during iteration. While individual file reads are atomic, directory iteration could
 	// see inconsistent state if a device is being created or deleted concurrently.
@@ -123,10 +173,6 @@ func (m *manager) ListDevices(ctx context.Context) ([]Device, error) {
 		if err != nil {
 			continue
 		}
-
-		// Update VFIO binding status from system state
-		device.BoundToVFIO = m.vfioBinder.IsDeviceBoundToVFIO(device.PCIAddress)
-
 		devices = append(devices, *device)
 	}
 
@@ -165,14 +211,26 @@ func (m *manager) CreateDevice(ctx context.Context, req CreateDeviceRequest) (*D
 	if !ValidateDeviceName(name) {
 		return nil, ErrInvalidName
 	}
+	if req.Pool != "" && !ValidateDeviceName(req.Pool) {
+		return nil, ErrInvalidName
+	}
 
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	// Check if name already exists
+	// Check if name already exists. Device and pool names share a namespace
+	// because instances reference either one by name.
 	if _, err := m.findByName(name); err == nil {
 		return nil, ErrNameExists
 	}
+	if m.poolExists(name) {
+		return nil, ErrNameExists
+	}
+	if req.Pool != "" {
+		if _, err := m.findByName(req.Pool); err == nil {
+			return nil, ErrNameExists
+		}
+	}
 
 	// Check if PCI address already registered
 	if _, err := m.findByPCIAddress(req.PCIAddress); err == nil {
@@ -190,6 +248,9 @@ func (m *manager) CreateDevice(ctx context.Context, req CreateDeviceRequest) (*D
 		IOMMUGroup:  deviceInfo.IOMMUGroup,
 		BoundToVFIO: m.vfioBinder.IsDeviceBoundToVFIO(req.PCIAddress),
 		AttachedTo:  nil,
+		ParentPF:    deviceInfo.ParentPF,
+		Pool:        req.Pool,
+		NUMANode:    deviceInfo.NUMANode,
 		CreatedAt:   time.Now(),
 	}
 
@@ -209,6 +270,8 @@ func (m *manager) CreateDevice(ctx context.Context, req CreateDeviceRequest) (*D
 		"name", name,
 		"pci_address", req.PCIAddress,
 		"type", device.Type,
+		"parent_pf", deviceInfo.ParentPF,
+		"pool", req.Pool,
 	)
 
 	return device, nil
@@ -388,13 +451,84 @@ func (m *manager) MarkDetached(ctx context.Context, deviceID string) error {
 	return m.saveDevice(device)
 }
 
+func (m *manager) ListPools(ctx context.Context) ([]DevicePool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	all, err := m.loadAllDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []DevicePool
+	index := make(map[string]int)
+	for _, device := range all {
+		if device.Pool == "" {
+			continue
+		}
+		i, ok := index[device.Pool]
+		if !ok {
+			i = len(pools)
+			index[device.Pool] = i
+			pools = append(pools, DevicePool{Name: device.Pool})
+		}
+		pools[i].DeviceIDs = append(pools[i].DeviceIDs, device.Id)
+	}
+
+	return pools, nil
+}
+
+func (m *manager) AcquireFromPool(ctx context.Context, pool, instanceID string) (*Device, error) {
+	log := logger.FromContext(ctx)
+
+	// Hold the write lock across selection and marking so concurrent creates
+	// cannot pick the same device.
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	all, err := m.loadAllDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for _, device := range all {
+		if device.Pool != pool {
+			continue
+		}
+		found = true
+		if device.AttachedTo != nil {
+			continue
+		}
+
+		device.AttachedTo = &instanceID
+		if err := m.saveDevice(device); err != nil {
+			return nil, fmt.Errorf("save device: %w", err)
+		}
+		device.BoundToVFIO = m.vfioBinder.IsDeviceBoundToVFIO(device.PCIAddress)
+
+		log.InfoContext(ctx, "acquired device from pool",
+			"pool", pool,
+			"id", device.Id,
+			"name", device.Name,
+			"instance_id", instanceID,
+		)
+		return device, nil
+	}
+
+	if !found {
+		return nil, ErrNotFound
+	}
+	return nil, ErrNoDeviceAvailable
+}
+
 // ReconcileDevices cleans up stale device state on startup.
 // It performs safe-by-default reconciliation:
 // 1. Detects orphaned device attachments (instance missing or not running)
 // 2. Clears orphaned AttachedTo metadata
 // 3. Runs GPU-reset-lite for orphaned devices (unbind VFIO, clear override, probe driver)
 // 4. Logs mismatches between instance→device and device→instance references
-// 5. Detects suspicious cloud-hypervisor processes
+// 5. Detects suspicious hypervisor processes
 func (m *manager) ReconcileDevices(ctx context.Context) error {
 	log := logger.FromContext(ctx)
 	log.InfoContext(ctx, "reconciling device state")
@@ -528,7 +662,7 @@ func (m *manager) ReconcileDevices(ctx context.Context) error {
 		}
 	}
 
-	// Phase 3: Detect suspicious cloud-hypervisor processes (log-only)
+	// Phase 3: Detect suspicious hypervisor processes (log-only)
 	if m.livenessChecker != nil {
 		stats.suspiciousVMM = m.livenessChecker.DetectSuspiciousVMMProcesses(ctx)
 	}
@@ -735,6 +869,41 @@ func (m *manager) saveDevice(device *Device) error {
 	return os.WriteFile(m.paths.DeviceMetadata(device.Id), data, 0644)
 }
 
+// loadAllDevices loads every registered device. Caller must hold m.mu.
+func (m *manager) loadAllDevices() ([]*Device, error) {
+	entries, err := os.ReadDir(m.paths.DevicesDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read devices dir: %w", err)
+	}
+
+	var devices []*Device
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		device, err := m.loadDevice(entry.Name())
+		if err != nil {
+			continue
+		}
+		devices = append(devices, device)
+	}
+	return devices, nil
+}
+
+// poolExists reports whether any device belongs to the named pool. Caller must hold m.mu.
+func (m *manager) poolExists(name string) bool {
+	all, _ := m.loadAllDevices()
+	for _, device := range all {
+		if device.Pool == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *manager) findByName(name string) (*Device, error) {
 	entries, err := os.ReadDir(m.paths.DevicesDir())
 	if err != nil {
@@ -0,0 +1,54 @@
+package devices
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquireFromPool(t *testing.T) {
+	mgr, p, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	busy := "instance-busy"
+	createTestDevice(t, p, &Device{Id: "dev-a", Name: "gpu-a", PCIAddress: "0000:99:00.0", Pool: "l4-pool", AttachedTo: &busy, CreatedAt: time.Now()})
+	createTestDevice(t, p, &Device{Id: "dev-b", Name: "gpu-b", PCIAddress: "0000:99:01.0", Pool: "l4-pool", CreatedAt: time.Now()})
+	createTestDevice(t, p, &Device{Id: "dev-c", Name: "gpu-c", PCIAddress: "0000:99:02.0", CreatedAt: time.Now()})
+
+	device, err := mgr.AcquireFromPool(ctx, "l4-pool", "instance-1")
+	require.NoError(t, err)
+	assert.Equal(t, "dev-b", device.Id)
+
+	// Attachment is persisted, so ReconcileDevices sees it
+	stored, err := mgr.loadDevice("dev-b")
+	require.NoError(t, err)
+	require.NotNil(t, stored.AttachedTo)
+	assert.Equal(t, "instance-1", *stored.AttachedTo)
+	assert.Equal(t, "l4-pool", stored.Pool)
+
+	_, err = mgr.AcquireFromPool(ctx, "l4-pool", "instance-2")
+	assert.ErrorIs(t, err, ErrNoDeviceAvailable)
+
+	_, err = mgr.AcquireFromPool(ctx, "missing-pool", "instance-2")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestListPools(t *testing.T) {
+	mgr, p, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	createTestDevice(t, p, &Device{Id: "dev-a", Name: "gpu-a", Pool: "l4-pool", CreatedAt: time.Now()})
+	createTestDevice(t, p, &Device{Id: "dev-b", Name: "gpu-b", Pool: "h100-pool", CreatedAt: time.Now()})
+	createTestDevice(t, p, &Device{Id: "dev-c", Name: "gpu-c", Pool: "l4-pool", CreatedAt: time.Now()})
+	createTestDevice(t, p, &Device{Id: "dev-d", Name: "gpu-d", CreatedAt: time.Now()})
+
+	pools, err := mgr.ListPools(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []DevicePool{
+		{Name: "l4-pool", DeviceIDs: []string{"dev-a", "dev-c"}},
+		{Name: "h100-pool", DeviceIDs: []string{"dev-b"}},
+	}, pools)
+}
@@ -24,13 +24,25 @@ type Device struct {
 	IOMMUGroup  int        `json:"iommu_group"`  // IOMMU group number
 	BoundToVFIO bool       `json:"bound_to_vfio"` // whether device is bound to vfio-pci
 	AttachedTo  *string    `json:"attached_to"`  // instance ID if attached, nil otherwise
+	ParentPF    *string    `json:"parent_pf,omitempty"` // PCI address of the SR-IOV physical function if this is a VF
+	Pool        string     `json:"pool,omitempty"` // device pool this device belongs to, empty if none
+	NUMANode    *int       `json:"numa_node,omitempty"` // host NUMA node the device is attached to, nil if unknown
 	CreatedAt   time.Time  `json:"created_at"`
 }
 
+// DevicePool is a named set of interchangeable devices. Instances can request
+// a pool instead of a specific device and receive any free member.
+// Membership is stored on each device, so a pool exists while it has members.
+type DevicePool struct {
+	Name      string   `json:"name"`
+	DeviceIDs []string `json:"device_ids"`
+}
+
 // CreateDeviceRequest is the request to register a new device
 type CreateDeviceRequest struct {
 	Name       string `json:"name,omitempty"` // optional: globally unique name (auto-generated if not provided)
 	PCIAddress string `json:"pci_address"`    // required: PCI address (e.g., "0000:a2:00.0")
+	Pool       string `json:"pool,omitempty"` // optional: device pool to add the device to
 }
 
 // AvailableDevice represents a PCI device discovered on the host
@@ -42,6 +54,8 @@ type AvailableDevice struct {
 	DeviceName    string  `json:"device_name"`
 	IOMMUGroup    int     `json:"iommu_group"`
 	CurrentDriver *string `json:"current_driver"` // nil if no driver bound
+	ParentPF      *string `json:"parent_pf"`      // SR-IOV physical function if this is a VF, nil otherwise
+	NUMANode      *int    `json:"numa_node"`      // host NUMA node, nil if unknown or single-node host
 }
 
 // DeviceNamePattern is the regex pattern for valid device names
@@ -246,13 +246,21 @@ func (v *VFIOBinder) GetVFIOGroupPath(pciAddress string) (string, error) {
 }
 
 // CheckIOMMUGroupSafe checks if all devices in the IOMMU group are safe to pass through
-// Returns an error if there are other devices in the group that aren't being passed through
+// Returns an error if there are other devices in the group that aren't being passed through.
+// For SR-IOV, a PF with enabled VFs is rejected (unbinding its driver destroys the VFs),
+// and a VF is rejected if it shares its group with its PF, which must keep its host driver.
 func (v *VFIOBinder) CheckIOMMUGroupSafe(pciAddress string, allowedDevices []string) error {
+	if vfs, err := ListVirtualFunctions(pciAddress); err == nil && len(vfs) > 0 {
+		return fmt.Errorf("%w: %s has %d VFs; pass through a VF instead", ErrPFHasVFs, pciAddress, len(vfs))
+	}
+
 	iommuGroup, err := readIOMMUGroup(pciAddress)
 	if err != nil {
 		return fmt.Errorf("read iommu group: %w", err)
 	}
 
+	parentPF := readParentPF(pciAddress)
+
 	groupDevices, err := GetIOMMUGroupDevices(iommuGroup)
 	if err != nil {
 		return fmt.Errorf("get iommu group devices: %w", err)
@@ -266,6 +274,11 @@ func (v *VFIOBinder) CheckIOMMUGroupSafe(pciAddress string, allowedDevices []str
 
 	// Check each device in the group
 	for _, device := range groupDevices {
+		if parentPF != nil && device == *parentPF {
+			return fmt.Errorf("%w: VF %s shares IOMMU group %d with its physical function %s (platform lacks ACS isolation)",
+				ErrIOMMUGroupConflict, pciAddress, iommuGroup, device)
+		}
+
 		if allowed[device] {
 			continue
 		}
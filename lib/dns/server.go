@@ -4,6 +4,7 @@ package dns
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
@@ -32,13 +33,33 @@ const (
 	// Using a per-query timeout ensures DNS queries don't fail if the server
 	// is still running but the parent context is cancelled during shutdown.
 	resolverTimeout = 5 * time.Second
+
+	// wakeTimeout bounds how long a query waits for a standby instance to be
+	// restored. It stays under the 5s query timeout of Go's resolver, which
+	// Caddy uses, so Caddy gets SERVFAIL and answers 503 instead of retrying.
+	wakeTimeout = 4 * time.Second
 )
 
+// ErrNotRunning is returned by Lookup when the server hasn't been started
+var ErrNotRunning = errors.New("DNS server is not running")
+
+// LookupResult is the server's answer to an A query for an instance
+type LookupResult struct {
+	Hostname string // Queried name, e.g. "my-api.hypeman.internal"
+	Server   string // Address of the server that answered
+	Rcode    string // Response code, e.g. "NOERROR" or "NXDOMAIN"
+	IP       string // Resolved IPv4 address, empty if there is none
+}
+
 // InstanceResolver provides instance IP resolution.
 // This interface is implemented by the instances package.
 type InstanceResolver interface {
 	// ResolveInstanceIP resolves an instance name or ID to its IP address.
 	ResolveInstanceIP(ctx context.Context, nameOrID string) (string, error)
+
+	// WakeInstance restores an instance that idled into standby, returning once
+	// it is running. It is a no-op for any other instance.
+	WakeInstance(ctx context.Context, nameOrID string) error
 }
 
 // Server provides DNS-based instance resolution for Caddy.
@@ -46,6 +67,7 @@ type InstanceResolver interface {
 // for instances in the form "<instance>.hypeman.internal".
 type Server struct {
 	resolver InstanceResolver
+	services ServiceResolver // nil unless SRV/TXT records are enabled
 	port     int
 	server   *dns.Server
 	log      *slog.Logger
@@ -134,6 +156,39 @@ func (s *Server) IsRunning() bool {
 	return s.running
 }
 
+// Lookup sends an A query for an instance to the running server, the same
+// way Caddy resolves an upstream. Like a proxied request, it wakes an
+// instance that idled into standby.
+func (s *Server) Lookup(ctx context.Context, instance string) (*LookupResult, error) {
+	if !s.IsRunning() {
+		return nil, ErrNotRunning
+	}
+
+	hostname := instance + "." + Suffix
+	addr := fmt.Sprintf("127.0.0.1:%d", s.Port())
+	query := new(dns.Msg)
+	query.SetQuestion(dns.Fqdn(hostname), dns.TypeA)
+
+	client := &dns.Client{Timeout: resolverTimeout}
+	resp, _, err := client.ExchangeContext(ctx, query, addr)
+	if err != nil {
+		return nil, fmt.Errorf("query %s: %w", addr, err)
+	}
+
+	result := &LookupResult{
+		Hostname: hostname,
+		Server:   addr,
+		Rcode:    dns.RcodeToString[resp.Rcode],
+	}
+	for _, rr := range resp.Answer {
+		if a, ok := rr.(*dns.A); ok {
+			result.IP = a.A.String()
+			break
+		}
+	}
+	return result, nil
+}
+
 // handleQuery handles incoming DNS queries.
 func (s *Server) handleQuery(w dns.ResponseWriter, r *dns.Msg) {
 	m := new(dns.Msg)
@@ -149,6 +204,11 @@ func (s *Server) handleQuery(w dns.ResponseWriter, r *dns.Msg) {
 			// This is intentional: returning quickly with no records prevents Caddy from
 			// waiting for AAAA resolution, improving request latency. Clients will fall
 			// back to IPv4 A record resolution.
+		case dns.TypeSRV, dns.TypeTXT:
+			// Service discovery records are opt-in
+			if s.services != nil {
+				s.handleServiceQuery(m, q)
+			}
 		default:
 			// Unsupported query type - return empty response
 		}
@@ -180,6 +240,17 @@ func (s *Server) handleAQuery(m *dns.Msg, q dns.Question) {
 	ctx, cancel := context.WithTimeout(context.Background(), resolverTimeout)
 	defer cancel()
 
+	// Wake a standby instance before answering, so the request is proxied to
+	// a running VM. The restore carries on in the background if it times out.
+	wakeCtx, cancelWake := context.WithTimeout(ctx, wakeTimeout)
+	err := s.resolver.WakeInstance(wakeCtx, instanceName)
+	cancelWake()
+	if err != nil {
+		s.log.Warn("failed to wake instance", "instance", instanceName, "error", err)
+		m.Rcode = dns.RcodeServerFailure
+		return
+	}
+
 	ip, err := s.resolver.ResolveInstanceIP(ctx, instanceName)
 	if err != nil {
 		s.log.Debug("DNS resolution failed", "instance", instanceName, "error", err)
@@ -14,11 +14,14 @@ import (
 // mockResolver implements InstanceResolver for testing
 type mockResolver struct {
 	instances map[string]string
+	wakeErrs  map[string]error
+	woken     []string
 }
 
 func newMockResolver() *mockResolver {
 	return &mockResolver{
 		instances: make(map[string]string),
+		wakeErrs:  make(map[string]error),
 	}
 }
 
@@ -34,6 +37,11 @@ func (m *mockResolver) ResolveInstanceIP(ctx context.Context, nameOrID string) (
 	return ip, nil
 }
 
+func (m *mockResolver) WakeInstance(ctx context.Context, nameOrID string) error {
+	m.woken = append(m.woken, nameOrID)
+	return m.wakeErrs[nameOrID]
+}
+
 // getFreePort returns a random available port
 func getFreePort(t *testing.T) int {
 	t.Helper()
@@ -130,3 +138,131 @@ func TestDNSServer_Port(t *testing.T) {
 		assert.Equal(t, 12345, server.Port())
 	})
 }
+
+func TestDNSServer_HandleAQuery(t *testing.T) {
+	resolver := newMockResolver()
+	resolver.addInstance("my-api", "10.100.0.10")
+	resolver.addInstance("sleepy", "10.100.0.30")
+	resolver.wakeErrs["sleepy"] = context.DeadlineExceeded
+	server := NewServer(resolver, 0, nil)
+
+	query := func(name string) *dns.Msg {
+		m := new(dns.Msg)
+		q := dns.Question{Name: name + ".hypeman.internal.", Qtype: dns.TypeA, Qclass: dns.ClassINET}
+		server.handleAQuery(m, q)
+		return m
+	}
+
+	// The instance is woken before it is resolved
+	m := query("my-api")
+	require.Len(t, m.Answer, 1)
+	assert.Equal(t, "10.100.0.10", m.Answer[0].(*dns.A).A.String())
+	assert.Equal(t, []string{"my-api"}, resolver.woken)
+
+	// An instance that can't be woken in time fails the lookup instead of
+	// resolving to an address with nothing listening
+	m = query("sleepy")
+	assert.Empty(t, m.Answer)
+	assert.Equal(t, dns.RcodeServerFailure, m.Rcode)
+
+	m = query("missing")
+	assert.Empty(t, m.Answer)
+	assert.Equal(t, dns.RcodeNameError, m.Rcode)
+}
+
+func TestDNSServer_Lookup(t *testing.T) {
+	resolver := newMockResolver()
+	resolver.addInstance("my-api", "10.100.0.10")
+	server := NewServer(resolver, 0, nil)
+
+	_, err := server.Lookup(context.Background(), "my-api")
+	assert.ErrorIs(t, err, ErrNotRunning)
+
+	require.NoError(t, server.Start(context.Background()))
+	defer server.Stop()
+
+	result, err := server.Lookup(context.Background(), "my-api")
+	require.NoError(t, err)
+	assert.Equal(t, "my-api.hypeman.internal", result.Hostname)
+	assert.Equal(t, "NOERROR", result.Rcode)
+	assert.Equal(t, "10.100.0.10", result.IP)
+
+	result, err = server.Lookup(context.Background(), "missing")
+	require.NoError(t, err)
+	assert.Equal(t, "NXDOMAIN", result.Rcode)
+	assert.Empty(t, result.IP)
+}
+
+// mockServiceResolver implements ServiceResolver for testing
+type mockServiceResolver map[string]*ServiceInfo
+
+func (m mockServiceResolver) ResolveInstanceService(ctx context.Context, nameOrID string) (*ServiceInfo, error) {
+	info, ok := m[nameOrID]
+	if !ok {
+		return nil, context.DeadlineExceeded // Simulates not found
+	}
+	return info, nil
+}
+
+func TestDNSServer_ServiceRecords(t *testing.T) {
+	resolver := newMockResolver()
+	resolver.addInstance("my-api", "10.100.0.10")
+	server := NewServer(resolver, 0, nil)
+
+	query := func(name string, qtype uint16) *dns.Msg {
+		r := new(dns.Msg)
+		r.SetQuestion(name, qtype)
+		w := &recordingWriter{}
+		server.handleQuery(w, r)
+		return w.msg
+	}
+
+	// Disabled by default
+	m := query("my-api.hypeman.internal.", dns.TypeSRV)
+	assert.Empty(t, m.Answer)
+
+	server.SetServiceResolver(mockServiceResolver{
+		"my-api": {Ports: []int{80, 8080}, State: "Running", Image: "docker.io/library/nginx:latest"},
+	})
+
+	m = query("_http._tcp.my-api.hypeman.internal.", dns.TypeSRV)
+	require.Len(t, m.Answer, 2)
+	srv := m.Answer[0].(*dns.SRV)
+	assert.Equal(t, uint16(80), srv.Port)
+	assert.Equal(t, "my-api.hypeman.internal.", srv.Target)
+	assert.Equal(t, uint16(8080), m.Answer[1].(*dns.SRV).Port)
+	require.Len(t, m.Extra, 1)
+	assert.Equal(t, "10.100.0.10", m.Extra[0].(*dns.A).A.String())
+	// Discovery doesn't wake the instance
+	assert.Empty(t, resolver.woken)
+
+	m = query("my-api.hypeman.internal.", dns.TypeTXT)
+	require.Len(t, m.Answer, 1)
+	assert.Equal(t, []string{"state=Running", "image=docker.io/library/nginx:latest"}, m.Answer[0].(*dns.TXT).Txt)
+
+	m = query("missing.hypeman.internal.", dns.TypeTXT)
+	assert.Equal(t, dns.RcodeNameError, m.Rcode)
+
+	// A records are unchanged
+	m = query("my-api.hypeman.internal.", dns.TypeA)
+	require.Len(t, m.Answer, 1)
+	assert.Equal(t, "10.100.0.10", m.Answer[0].(*dns.A).A.String())
+}
+
+func TestServiceInstance(t *testing.T) {
+	assert.Equal(t, "my-api", serviceInstance("my-api.hypeman.internal."))
+	assert.Equal(t, "my-api", serviceInstance("_http._tcp.my-api.hypeman.internal."))
+	assert.Empty(t, serviceInstance("a.b.my-api.hypeman.internal."))
+	assert.Empty(t, serviceInstance("my-api.example.com."))
+}
+
+// recordingWriter is a dns.ResponseWriter that keeps the written message
+type recordingWriter struct {
+	dns.ResponseWriter
+	msg *dns.Msg
+}
+
+func (w *recordingWriter) WriteMsg(m *dns.Msg) error {
+	w.msg = m
+	return nil
+}
@@ -0,0 +1,101 @@
+package dns
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// ServiceInfo describes an instance for SRV and TXT service discovery.
+type ServiceInfo struct {
+	Ports []int  // Instance ports that ingresses route to
+	State string // Instance state, e.g. "Running"
+	Image string // OCI image the instance runs
+}
+
+// ServiceResolver provides the data behind SRV and TXT records.
+// This interface is implemented by the ingress package.
+type ServiceResolver interface {
+	// ResolveInstanceService returns the service discovery data of an
+	// instance by name or ID.
+	ResolveInstanceService(ctx context.Context, nameOrID string) (*ServiceInfo, error)
+}
+
+// SetServiceResolver enables SRV and TXT records, answered from r. Without
+// one, the server only answers A queries. Must be called before Start.
+func (s *Server) SetServiceResolver(r ServiceResolver) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.services = r
+}
+
+// serviceInstance returns the instance an SRV or TXT query is about. SRV
+// queries may carry a service and protocol, as in
+// "_http._tcp.my-api.hypeman.internal."; the service label is not checked,
+// as every routed port is returned.
+func serviceInstance(qname string) string {
+	name := strings.TrimSuffix(qname, ".")
+	name, ok := strings.CutSuffix(name, "."+Suffix)
+	if !ok {
+		return ""
+	}
+	labels := strings.Split(name, ".")
+	if len(labels) == 3 && strings.HasPrefix(labels[0], "_") && labels[1] == "_tcp" {
+		labels = labels[2:]
+	}
+	if len(labels) != 1 {
+		return ""
+	}
+	return labels[0]
+}
+
+// handleServiceQuery answers SRV and TXT queries. Unlike A queries, they
+// never wake a standby instance: discovery isn't a request to it.
+func (s *Server) handleServiceQuery(m *dns.Msg, q dns.Question) {
+	instanceName := serviceInstance(q.Name)
+	if instanceName == "" {
+		s.log.Debug("DNS service query doesn't name an instance", "name", q.Name)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), resolverTimeout)
+	defer cancel()
+
+	info, err := s.services.ResolveInstanceService(ctx, instanceName)
+	if err != nil {
+		s.log.Debug("DNS service resolution failed", "instance", instanceName, "error", err)
+		m.Rcode = dns.RcodeNameError
+		return
+	}
+
+	target := dns.Fqdn(instanceName + "." + Suffix)
+	hdr := func(rrtype uint16) dns.RR_Header {
+		return dns.RR_Header{Name: q.Name, Rrtype: rrtype, Class: dns.ClassINET, Ttl: DefaultTTL}
+	}
+
+	switch q.Qtype {
+	case dns.TypeSRV:
+		for _, port := range info.Ports {
+			m.Answer = append(m.Answer, &dns.SRV{Hdr: hdr(dns.TypeSRV), Port: uint16(port), Target: target})
+		}
+		// Save clients a lookup when the address is known, without waking
+		// the instance as an A query would
+		if len(info.Ports) > 0 {
+			if ip, err := s.resolver.ResolveInstanceIP(ctx, instanceName); err == nil {
+				if ipv4 := net.ParseIP(ip).To4(); ipv4 != nil {
+					m.Extra = append(m.Extra, &dns.A{
+						Hdr: dns.RR_Header{Name: target, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: DefaultTTL},
+						A:   ipv4,
+					})
+				}
+			}
+		}
+	case dns.TypeTXT:
+		m.Answer = append(m.Answer, &dns.TXT{
+			Hdr: hdr(dns.TypeTXT),
+			Txt: []string{"state=" + info.State, "image=" + info.Image},
+		})
+	}
+}
@@ -18,7 +18,9 @@ import (
 	securejoin "github.com/cyphar/filepath-securejoin"
 	"github.com/onkernel/hypeman/lib/hypervisor"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
@@ -51,18 +53,30 @@ var connPool = struct {
 	conns: make(map[string]*grpc.ClientConn),
 }
 
+// connBackoff keeps reconnects to a guest agent prompt. gRPC's default
+// backoff grows to two minutes, far longer than a VM takes to boot.
+var connBackoff = backoff.Config{
+	BaseDelay:  100 * time.Millisecond,
+	Multiplier: 1.6,
+	Jitter:     0.2,
+	MaxDelay:   2 * time.Second,
+}
+
 // GetOrCreateConn returns an existing connection or creates a new one using a VsockDialer.
 // This supports multiple hypervisor types (Cloud Hypervisor, QEMU, etc.).
+// Pooled connections are health checked: a closed one is replaced, and one
+// whose last connection attempt failed is told to retry now rather than
+// after its backoff.
 func GetOrCreateConn(ctx context.Context, dialer hypervisor.VsockDialer) (*grpc.ClientConn, error) {
 	key := dialer.Key()
 
 	// Try read lock first for existing connection
 	connPool.RLock()
-	if conn, ok := connPool.conns[key]; ok {
-		connPool.RUnlock()
+	conn, ok := connPool.conns[key]
+	connPool.RUnlock()
+	if ok && usableConn(conn) {
 		return conn, nil
 	}
-	connPool.RUnlock()
 
 	// Need to create new connection - acquire write lock
 	connPool.Lock()
@@ -70,7 +84,11 @@ func GetOrCreateConn(ctx context.Context, dialer hypervisor.VsockDialer) (*grpc.
 
 	// Double-check after acquiring write lock
 	if conn, ok := connPool.conns[key]; ok {
-		return conn, nil
+		if usableConn(conn) {
+			return conn, nil
+		}
+		delete(connPool.conns, key)
+		slog.Debug("replacing closed gRPC connection", "key", key)
 	}
 
 	// Create new connection using the VsockDialer
@@ -83,6 +101,10 @@ func GetOrCreateConn(ctx context.Context, dialer hypervisor.VsockDialer) (*grpc.
 			return netConn, nil
 		}),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           connBackoff,
+			MinConnectTimeout: 5 * time.Second,
+		}),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("create grpc connection: %w", err)
@@ -93,19 +115,37 @@ func GetOrCreateConn(ctx context.Context, dialer hypervisor.VsockDialer) (*grpc.
 	return conn, nil
 }
 
-// CloseConn removes a connection from the pool by key (call when VM is deleted).
-// We only remove from pool, not explicitly close - the connection will fail
-// naturally when the VM dies, and grpc will clean up.
+// usableConn reports whether a pooled connection can still be used. A
+// connection in TransientFailure is kept, but its backoff is reset so the
+// next RPC reconnects immediately (e.g. after the guest agent restarted).
+func usableConn(conn *grpc.ClientConn) bool {
+	switch conn.GetState() {
+	case connectivity.Shutdown:
+		return false
+	case connectivity.TransientFailure:
+		conn.ResetConnectBackoff()
+	}
+	return true
+}
+
+// CloseConn removes a connection from the pool by key and closes it (call
+// when the VM is stopped or deleted). RPCs still using it fail with Canceled.
 func CloseConn(dialerKey string) {
 	connPool.Lock()
-	defer connPool.Unlock()
+	conn, ok := connPool.conns[dialerKey]
+	delete(connPool.conns, dialerKey)
+	connPool.Unlock()
 
-	if _, ok := connPool.conns[dialerKey]; ok {
-		delete(connPool.conns, dialerKey)
-		slog.Debug("removed gRPC connection from pool", "key", dialerKey)
+	if ok {
+		conn.Close()
+		slog.Debug("closed gRPC connection", "key", dialerKey)
 	}
 }
 
+// ExitCodeOOMKilled is the exit code reported for a command killed for
+// exceeding its exec memory limit
+const ExitCodeOOMKilled = 137
+
 // ExitStatus represents command exit information
 type ExitStatus struct {
 	Code int
@@ -122,6 +162,19 @@ type ExecOptions struct {
 	Cwd          string            // Working directory (optional)
 	Timeout      int32             // Execution timeout in seconds (0 = no timeout)
 	WaitForAgent time.Duration     // Max time to wait for agent to be ready (0 = no wait, fail immediately)
+
+	// SeparateStreams sends stderr output to Stderr. Otherwise the command's
+	// stderr is merged into Stdout, in the order it was written.
+	SeparateStreams bool
+
+	CPUQuota    float64 // CPUs the command may use, e.g. 0.5 (0 = unlimited)
+	MemoryLimit int64   // Memory limit in bytes (0 = unlimited)
+
+	// DropCapabilities and SeccompProfile sandbox the command, e.g. for a
+	// lower-trust user's shell. Unset means the command runs with whatever
+	// the guest allows.
+	DropCapabilities []string // e.g. "CAP_SYS_ADMIN", or DropAllCapabilities
+	SeccompProfile   string   // "" or SeccompProfileDefault
 }
 
 // ExecIntoInstance executes command in instance via vsock using gRPC.
@@ -207,11 +260,16 @@ func execIntoInstanceOnce(ctx context.Context, dialer hypervisor.VsockDialer, op
 	if err := stream.Send(&ExecRequest{
 		Request: &ExecRequest_Start{
 			Start: &ExecStart{
-				Command:        opts.Command,
-				Tty:            opts.TTY,
-				Env:            opts.Env,
-				Cwd:            opts.Cwd,
-				TimeoutSeconds: opts.Timeout,
+				Command:          opts.Command,
+				Tty:              opts.TTY,
+				Env:              opts.Env,
+				Cwd:              opts.Cwd,
+				TimeoutSeconds:   opts.Timeout,
+				SeparateStreams:  opts.SeparateStreams,
+				CpuQuota:         opts.CPUQuota,
+				MemoryLimitBytes: opts.MemoryLimit,
+				DropCapabilities: opts.DropCapabilities,
+				SeccompProfile:   opts.SeccompProfile,
 			},
 		},
 	}); err != nil {
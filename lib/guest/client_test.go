@@ -0,0 +1,79 @@
+package guest
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// bufDialer connects to an in-memory guest agent
+type bufDialer struct {
+	key string
+	lis *bufconn.Listener
+}
+
+func (d *bufDialer) DialVsock(ctx context.Context, port int) (net.Conn, error) {
+	return d.lis.DialContext(ctx)
+}
+
+func (d *bufDialer) Key() string { return d.key }
+
+type statServer struct {
+	UnimplementedGuestServiceServer
+}
+
+func (statServer) StatPath(ctx context.Context, req *StatPathRequest) (*StatPathResponse, error) {
+	return &StatPathResponse{Exists: true}, nil
+}
+
+func TestGetOrCreateConn(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	RegisterGuestServiceServer(srv, statServer{})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	ctx := context.Background()
+	dialer := &bufDialer{key: "test:" + t.Name(), lis: lis}
+	defer CloseConn(dialer.Key())
+
+	stat := func(conn *grpc.ClientConn) {
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		resp, err := NewGuestServiceClient(conn).StatPath(ctx, &StatPathRequest{Path: "/"})
+		require.NoError(t, err)
+		assert.True(t, resp.Exists)
+	}
+
+	// Rapid sequential calls share one connection
+	conn, err := GetOrCreateConn(ctx, dialer)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		again, err := GetOrCreateConn(ctx, dialer)
+		require.NoError(t, err)
+		require.Same(t, conn, again)
+		stat(again)
+	}
+
+	// A connection closed out from under the pool is replaced
+	conn.Close()
+	replaced, err := GetOrCreateConn(ctx, dialer)
+	require.NoError(t, err)
+	assert.NotSame(t, conn, replaced)
+	stat(replaced)
+
+	// CloseConn closes the pooled connection
+	CloseConn(dialer.Key())
+	assert.Equal(t, connectivity.Shutdown, replaced.GetState())
+	fresh, err := GetOrCreateConn(ctx, dialer)
+	require.NoError(t, err)
+	assert.NotSame(t, replaced, fresh)
+	stat(fresh)
+}
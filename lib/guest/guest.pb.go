@@ -109,6 +109,11 @@ type ExecStart struct {
 	Env                  map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Cwd                  string            `protobuf:"bytes,4,opt,name=cwd,proto3" json:"cwd,omitempty"`
 	TimeoutSeconds       int32             `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	SeparateStreams      bool              `protobuf:"varint,6,opt,name=separate_streams,json=separateStreams,proto3" json:"separate_streams,omitempty"`
+	CpuQuota             float64           `protobuf:"fixed64,7,opt,name=cpu_quota,json=cpuQuota,proto3" json:"cpu_quota,omitempty"`
+	MemoryLimitBytes     int64             `protobuf:"varint,8,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3" json:"memory_limit_bytes,omitempty"`
+	DropCapabilities     []string          `protobuf:"bytes,9,rep,name=drop_capabilities,json=dropCapabilities,proto3" json:"drop_capabilities,omitempty"`
+	SeccompProfile       string            `protobuf:"bytes,10,opt,name=seccomp_profile,json=seccompProfile,proto3" json:"seccomp_profile,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -174,6 +179,41 @@ func (m *ExecStart) GetTimeoutSeconds() int32 {
 	return 0
 }
 
+func (m *ExecStart) GetSeparateStreams() bool {
+	if m != nil {
+		return m.SeparateStreams
+	}
+	return false
+}
+
+func (m *ExecStart) GetCpuQuota() float64 {
+	if m != nil {
+		return m.CpuQuota
+	}
+	return 0
+}
+
+func (m *ExecStart) GetMemoryLimitBytes() int64 {
+	if m != nil {
+		return m.MemoryLimitBytes
+	}
+	return 0
+}
+
+func (m *ExecStart) GetDropCapabilities() []string {
+	if m != nil {
+		return m.DropCapabilities
+	}
+	return nil
+}
+
+func (m *ExecStart) GetSeccompProfile() string {
+	if m != nil {
+		return m.SeccompProfile
+	}
+	return ""
+}
+
 // ExecResponse represents messages from server to client
 type ExecResponse struct {
 	// Types that are valid to be assigned to Response:
@@ -1040,6 +1080,966 @@ func (m *StatPathResponse) GetError() string {
 	return ""
 }
 
+// ListProcessesRequest requests the guest process list
+type ListProcessesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListProcessesRequest) Reset()         { *m = ListProcessesRequest{} }
+func (m *ListProcessesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListProcessesRequest) ProtoMessage()    {}
+func (*ListProcessesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{14}
+}
+
+func (m *ListProcessesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProcessesRequest.Unmarshal(m, b)
+}
+func (m *ListProcessesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProcessesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListProcessesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProcessesRequest.Merge(m, src)
+}
+func (m *ListProcessesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListProcessesRequest.Size(m)
+}
+func (m *ListProcessesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProcessesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProcessesRequest proto.InternalMessageInfo
+
+// ProcessInfo describes a single process in the guest
+type ProcessInfo struct {
+	Pid                  int32    `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Ppid                 int32    `protobuf:"varint,2,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Cmdline              []string `protobuf:"bytes,4,rep,name=cmdline,proto3" json:"cmdline,omitempty"`
+	State                string   `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	RssBytes             int64    `protobuf:"varint,6,opt,name=rss_bytes,json=rssBytes,proto3" json:"rss_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProcessInfo) Reset()         { *m = ProcessInfo{} }
+func (m *ProcessInfo) String() string { return proto.CompactTextString(m) }
+func (*ProcessInfo) ProtoMessage()    {}
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{15}
+}
+
+func (m *ProcessInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessInfo.Unmarshal(m, b)
+}
+func (m *ProcessInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessInfo.Marshal(b, m, deterministic)
+}
+func (m *ProcessInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessInfo.Merge(m, src)
+}
+func (m *ProcessInfo) XXX_Size() int {
+	return xxx_messageInfo_ProcessInfo.Size(m)
+}
+func (m *ProcessInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessInfo proto.InternalMessageInfo
+
+func (m *ProcessInfo) GetPid() int32 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
+}
+
+func (m *ProcessInfo) GetPpid() int32 {
+	if m != nil {
+		return m.Ppid
+	}
+	return 0
+}
+
+func (m *ProcessInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProcessInfo) GetCmdline() []string {
+	if m != nil {
+		return m.Cmdline
+	}
+	return nil
+}
+
+func (m *ProcessInfo) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ProcessInfo) GetRssBytes() int64 {
+	if m != nil {
+		return m.RssBytes
+	}
+	return 0
+}
+
+// ListProcessesResponse contains the guest process list
+type ListProcessesResponse struct {
+	Processes            []*ProcessInfo `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListProcessesResponse) Reset()         { *m = ListProcessesResponse{} }
+func (m *ListProcessesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListProcessesResponse) ProtoMessage()    {}
+func (*ListProcessesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{16}
+}
+
+func (m *ListProcessesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProcessesResponse.Unmarshal(m, b)
+}
+func (m *ListProcessesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProcessesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListProcessesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProcessesResponse.Merge(m, src)
+}
+func (m *ListProcessesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListProcessesResponse.Size(m)
+}
+func (m *ListProcessesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProcessesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProcessesResponse proto.InternalMessageInfo
+
+func (m *ListProcessesResponse) GetProcesses() []*ProcessInfo {
+	if m != nil {
+		return m.Processes
+	}
+	return nil
+}
+
+// GetGuestStatsRequest requests guest resource usage
+type GetGuestStatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGuestStatsRequest) Reset()         { *m = GetGuestStatsRequest{} }
+func (m *GetGuestStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGuestStatsRequest) ProtoMessage()    {}
+func (*GetGuestStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{17}
+}
+
+func (m *GetGuestStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGuestStatsRequest.Unmarshal(m, b)
+}
+func (m *GetGuestStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGuestStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetGuestStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGuestStatsRequest.Merge(m, src)
+}
+func (m *GetGuestStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGuestStatsRequest.Size(m)
+}
+func (m *GetGuestStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGuestStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGuestStatsRequest proto.InternalMessageInfo
+
+// DiskUsage describes usage of a mounted filesystem in the guest
+type DiskUsage struct {
+	MountPoint           string   `protobuf:"bytes,1,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	Device               string   `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	FsType               string   `protobuf:"bytes,3,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
+	TotalBytes           uint64   `protobuf:"varint,4,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	UsedBytes            uint64   `protobuf:"varint,5,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	AvailableBytes       uint64   `protobuf:"varint,6,opt,name=available_bytes,json=availableBytes,proto3" json:"available_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiskUsage) Reset()         { *m = DiskUsage{} }
+func (m *DiskUsage) String() string { return proto.CompactTextString(m) }
+func (*DiskUsage) ProtoMessage()    {}
+func (*DiskUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{18}
+}
+
+func (m *DiskUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiskUsage.Unmarshal(m, b)
+}
+func (m *DiskUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiskUsage.Marshal(b, m, deterministic)
+}
+func (m *DiskUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskUsage.Merge(m, src)
+}
+func (m *DiskUsage) XXX_Size() int {
+	return xxx_messageInfo_DiskUsage.Size(m)
+}
+func (m *DiskUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskUsage proto.InternalMessageInfo
+
+func (m *DiskUsage) GetMountPoint() string {
+	if m != nil {
+		return m.MountPoint
+	}
+	return ""
+}
+
+func (m *DiskUsage) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *DiskUsage) GetFsType() string {
+	if m != nil {
+		return m.FsType
+	}
+	return ""
+}
+
+func (m *DiskUsage) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *DiskUsage) GetUsedBytes() uint64 {
+	if m != nil {
+		return m.UsedBytes
+	}
+	return 0
+}
+
+func (m *DiskUsage) GetAvailableBytes() uint64 {
+	if m != nil {
+		return m.AvailableBytes
+	}
+	return 0
+}
+
+// GetGuestStatsResponse contains guest resource usage
+type GetGuestStatsResponse struct {
+	Load1                float64      `protobuf:"fixed64,1,opt,name=load1,proto3" json:"load1,omitempty"`
+	Load5                float64      `protobuf:"fixed64,2,opt,name=load5,proto3" json:"load5,omitempty"`
+	Load15               float64      `protobuf:"fixed64,3,opt,name=load15,proto3" json:"load15,omitempty"`
+	MemoryTotalBytes     uint64       `protobuf:"varint,4,opt,name=memory_total_bytes,json=memoryTotalBytes,proto3" json:"memory_total_bytes,omitempty"`
+	MemoryAvailableBytes uint64       `protobuf:"varint,5,opt,name=memory_available_bytes,json=memoryAvailableBytes,proto3" json:"memory_available_bytes,omitempty"`
+	MemoryFreeBytes      uint64       `protobuf:"varint,6,opt,name=memory_free_bytes,json=memoryFreeBytes,proto3" json:"memory_free_bytes,omitempty"`
+	Disks                []*DiskUsage `protobuf:"bytes,7,rep,name=disks,proto3" json:"disks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetGuestStatsResponse) Reset()         { *m = GetGuestStatsResponse{} }
+func (m *GetGuestStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGuestStatsResponse) ProtoMessage()    {}
+func (*GetGuestStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{19}
+}
+
+func (m *GetGuestStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGuestStatsResponse.Unmarshal(m, b)
+}
+func (m *GetGuestStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGuestStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetGuestStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGuestStatsResponse.Merge(m, src)
+}
+func (m *GetGuestStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGuestStatsResponse.Size(m)
+}
+func (m *GetGuestStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGuestStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGuestStatsResponse proto.InternalMessageInfo
+
+func (m *GetGuestStatsResponse) GetLoad1() float64 {
+	if m != nil {
+		return m.Load1
+	}
+	return 0
+}
+
+func (m *GetGuestStatsResponse) GetLoad5() float64 {
+	if m != nil {
+		return m.Load5
+	}
+	return 0
+}
+
+func (m *GetGuestStatsResponse) GetLoad15() float64 {
+	if m != nil {
+		return m.Load15
+	}
+	return 0
+}
+
+func (m *GetGuestStatsResponse) GetMemoryTotalBytes() uint64 {
+	if m != nil {
+		return m.MemoryTotalBytes
+	}
+	return 0
+}
+
+func (m *GetGuestStatsResponse) GetMemoryAvailableBytes() uint64 {
+	if m != nil {
+		return m.MemoryAvailableBytes
+	}
+	return 0
+}
+
+func (m *GetGuestStatsResponse) GetMemoryFreeBytes() uint64 {
+	if m != nil {
+		return m.MemoryFreeBytes
+	}
+	return 0
+}
+
+func (m *GetGuestStatsResponse) GetDisks() []*DiskUsage {
+	if m != nil {
+		return m.Disks
+	}
+	return nil
+}
+
+// FileInfo describes a file in the guest filesystem
+type FileInfo struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	IsDir                bool     `protobuf:"varint,2,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	Mode                 uint32   `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Size                 int64    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Mtime                int64    `protobuf:"varint,5,opt,name=mtime,proto3" json:"mtime,omitempty"`
+	Uid                  uint32   `protobuf:"varint,6,opt,name=uid,proto3" json:"uid,omitempty"`
+	Gid                  uint32   `protobuf:"varint,7,opt,name=gid,proto3" json:"gid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileInfo) Reset()         { *m = FileInfo{} }
+func (m *FileInfo) String() string { return proto.CompactTextString(m) }
+func (*FileInfo) ProtoMessage()    {}
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{20}
+}
+
+func (m *FileInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileInfo.Unmarshal(m, b)
+}
+func (m *FileInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileInfo.Marshal(b, m, deterministic)
+}
+func (m *FileInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileInfo.Merge(m, src)
+}
+func (m *FileInfo) XXX_Size() int {
+	return xxx_messageInfo_FileInfo.Size(m)
+}
+func (m *FileInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileInfo proto.InternalMessageInfo
+
+func (m *FileInfo) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *FileInfo) GetIsDir() bool {
+	if m != nil {
+		return m.IsDir
+	}
+	return false
+}
+
+func (m *FileInfo) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *FileInfo) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *FileInfo) GetMtime() int64 {
+	if m != nil {
+		return m.Mtime
+	}
+	return 0
+}
+
+func (m *FileInfo) GetUid() uint32 {
+	if m != nil {
+		return m.Uid
+	}
+	return 0
+}
+
+func (m *FileInfo) GetGid() uint32 {
+	if m != nil {
+		return m.Gid
+	}
+	return 0
+}
+
+// StatFileRequest requests metadata for a file
+type StatFileRequest struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatFileRequest) Reset()         { *m = StatFileRequest{} }
+func (m *StatFileRequest) String() string { return proto.CompactTextString(m) }
+func (*StatFileRequest) ProtoMessage()    {}
+func (*StatFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{21}
+}
+
+func (m *StatFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatFileRequest.Unmarshal(m, b)
+}
+func (m *StatFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatFileRequest.Marshal(b, m, deterministic)
+}
+func (m *StatFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatFileRequest.Merge(m, src)
+}
+func (m *StatFileRequest) XXX_Size() int {
+	return xxx_messageInfo_StatFileRequest.Size(m)
+}
+func (m *StatFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatFileRequest proto.InternalMessageInfo
+
+func (m *StatFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// StatFileResponse contains metadata for a file
+type StatFileResponse struct {
+	Info                 *FileInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *StatFileResponse) Reset()         { *m = StatFileResponse{} }
+func (m *StatFileResponse) String() string { return proto.CompactTextString(m) }
+func (*StatFileResponse) ProtoMessage()    {}
+func (*StatFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{22}
+}
+
+func (m *StatFileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatFileResponse.Unmarshal(m, b)
+}
+func (m *StatFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatFileResponse.Marshal(b, m, deterministic)
+}
+func (m *StatFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatFileResponse.Merge(m, src)
+}
+func (m *StatFileResponse) XXX_Size() int {
+	return xxx_messageInfo_StatFileResponse.Size(m)
+}
+func (m *StatFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatFileResponse proto.InternalMessageInfo
+
+func (m *StatFileResponse) GetInfo() *FileInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+// ReadFileRequest requests the contents of a file
+type ReadFileRequest struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	MaxBytes             int64    `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadFileRequest) Reset()         { *m = ReadFileRequest{} }
+func (m *ReadFileRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadFileRequest) ProtoMessage()    {}
+func (*ReadFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{23}
+}
+
+func (m *ReadFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadFileRequest.Unmarshal(m, b)
+}
+func (m *ReadFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadFileRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadFileRequest.Merge(m, src)
+}
+func (m *ReadFileRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadFileRequest.Size(m)
+}
+func (m *ReadFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadFileRequest proto.InternalMessageInfo
+
+func (m *ReadFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ReadFileRequest) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+// ReadFileResponse contains the contents of a file
+type ReadFileResponse struct {
+	Info                 *FileInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	Data                 []byte    `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Truncated            bool      `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReadFileResponse) Reset()         { *m = ReadFileResponse{} }
+func (m *ReadFileResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadFileResponse) ProtoMessage()    {}
+func (*ReadFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{24}
+}
+
+func (m *ReadFileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadFileResponse.Unmarshal(m, b)
+}
+func (m *ReadFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadFileResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadFileResponse.Merge(m, src)
+}
+func (m *ReadFileResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadFileResponse.Size(m)
+}
+func (m *ReadFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadFileResponse proto.InternalMessageInfo
+
+func (m *ReadFileResponse) GetInfo() *FileInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *ReadFileResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ReadFileResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+// ShutdownRequest asks the guest to power off cleanly
+type ShutdownRequest struct {
+	TimeoutSeconds       int32    `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShutdownRequest) Reset()         { *m = ShutdownRequest{} }
+func (m *ShutdownRequest) String() string { return proto.CompactTextString(m) }
+func (*ShutdownRequest) ProtoMessage()    {}
+func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{25}
+}
+
+func (m *ShutdownRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShutdownRequest.Unmarshal(m, b)
+}
+func (m *ShutdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShutdownRequest.Marshal(b, m, deterministic)
+}
+func (m *ShutdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShutdownRequest.Merge(m, src)
+}
+func (m *ShutdownRequest) XXX_Size() int {
+	return xxx_messageInfo_ShutdownRequest.Size(m)
+}
+func (m *ShutdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShutdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShutdownRequest proto.InternalMessageInfo
+
+func (m *ShutdownRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+// ShutdownResponse acknowledges a shutdown request
+type ShutdownResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShutdownResponse) Reset()         { *m = ShutdownResponse{} }
+func (m *ShutdownResponse) String() string { return proto.CompactTextString(m) }
+func (*ShutdownResponse) ProtoMessage()    {}
+func (*ShutdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{26}
+}
+
+func (m *ShutdownResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShutdownResponse.Unmarshal(m, b)
+}
+func (m *ShutdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShutdownResponse.Marshal(b, m, deterministic)
+}
+func (m *ShutdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShutdownResponse.Merge(m, src)
+}
+func (m *ShutdownResponse) XXX_Size() int {
+	return xxx_messageInfo_ShutdownResponse.Size(m)
+}
+func (m *ShutdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShutdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShutdownResponse proto.InternalMessageInfo
+
+// MountVolumeRequest asks the guest to mount a volume disk once it appears
+type MountVolumeRequest struct {
+	Serial               string   `protobuf:"bytes,1,opt,name=serial,proto3" json:"serial,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Readonly             bool     `protobuf:"varint,3,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	TimeoutSeconds       int32    `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	Discard              bool     `protobuf:"varint,5,opt,name=discard,proto3" json:"discard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MountVolumeRequest) Reset()         { *m = MountVolumeRequest{} }
+func (m *MountVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*MountVolumeRequest) ProtoMessage()    {}
+func (*MountVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{27}
+}
+
+func (m *MountVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MountVolumeRequest.Unmarshal(m, b)
+}
+func (m *MountVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MountVolumeRequest.Marshal(b, m, deterministic)
+}
+func (m *MountVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MountVolumeRequest.Merge(m, src)
+}
+func (m *MountVolumeRequest) XXX_Size() int {
+	return xxx_messageInfo_MountVolumeRequest.Size(m)
+}
+func (m *MountVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MountVolumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MountVolumeRequest proto.InternalMessageInfo
+
+func (m *MountVolumeRequest) GetSerial() string {
+	if m != nil {
+		return m.Serial
+	}
+	return ""
+}
+
+func (m *MountVolumeRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *MountVolumeRequest) GetReadonly() bool {
+	if m != nil {
+		return m.Readonly
+	}
+	return false
+}
+
+func (m *MountVolumeRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+func (m *MountVolumeRequest) GetDiscard() bool {
+	if m != nil {
+		return m.Discard
+	}
+	return false
+}
+
+// MountVolumeResponse reports where the volume was mounted from
+type MountVolumeResponse struct {
+	Device               string   `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MountVolumeResponse) Reset()         { *m = MountVolumeResponse{} }
+func (m *MountVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*MountVolumeResponse) ProtoMessage()    {}
+func (*MountVolumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{28}
+}
+
+func (m *MountVolumeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MountVolumeResponse.Unmarshal(m, b)
+}
+func (m *MountVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MountVolumeResponse.Marshal(b, m, deterministic)
+}
+func (m *MountVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MountVolumeResponse.Merge(m, src)
+}
+func (m *MountVolumeResponse) XXX_Size() int {
+	return xxx_messageInfo_MountVolumeResponse.Size(m)
+}
+func (m *MountVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MountVolumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MountVolumeResponse proto.InternalMessageInfo
+
+func (m *MountVolumeResponse) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+// UnmountVolumeRequest asks the guest to unmount a volume
+type UnmountVolumeRequest struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnmountVolumeRequest) Reset()         { *m = UnmountVolumeRequest{} }
+func (m *UnmountVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*UnmountVolumeRequest) ProtoMessage()    {}
+func (*UnmountVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{29}
+}
+
+func (m *UnmountVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnmountVolumeRequest.Unmarshal(m, b)
+}
+func (m *UnmountVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnmountVolumeRequest.Marshal(b, m, deterministic)
+}
+func (m *UnmountVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnmountVolumeRequest.Merge(m, src)
+}
+func (m *UnmountVolumeRequest) XXX_Size() int {
+	return xxx_messageInfo_UnmountVolumeRequest.Size(m)
+}
+func (m *UnmountVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnmountVolumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnmountVolumeRequest proto.InternalMessageInfo
+
+func (m *UnmountVolumeRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// UnmountVolumeResponse acknowledges an unmount
+type UnmountVolumeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnmountVolumeResponse) Reset()         { *m = UnmountVolumeResponse{} }
+func (m *UnmountVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*UnmountVolumeResponse) ProtoMessage()    {}
+func (*UnmountVolumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{30}
+}
+
+func (m *UnmountVolumeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnmountVolumeResponse.Unmarshal(m, b)
+}
+func (m *UnmountVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnmountVolumeResponse.Marshal(b, m, deterministic)
+}
+func (m *UnmountVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnmountVolumeResponse.Merge(m, src)
+}
+func (m *UnmountVolumeResponse) XXX_Size() int {
+	return xxx_messageInfo_UnmountVolumeResponse.Size(m)
+}
+func (m *UnmountVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnmountVolumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnmountVolumeResponse proto.InternalMessageInfo
+
+// SyncTimeRequest carries the host's wall clock
+type SyncTimeRequest struct {
+	UnixNanos            int64    `protobuf:"varint,1,opt,name=unix_nanos,json=unixNanos,proto3" json:"unix_nanos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncTimeRequest) Reset()         { *m = SyncTimeRequest{} }
+func (m *SyncTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncTimeRequest) ProtoMessage()    {}
+func (*SyncTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{31}
+}
+
+func (m *SyncTimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncTimeRequest.Unmarshal(m, b)
+}
+func (m *SyncTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncTimeRequest.Marshal(b, m, deterministic)
+}
+func (m *SyncTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncTimeRequest.Merge(m, src)
+}
+func (m *SyncTimeRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncTimeRequest.Size(m)
+}
+func (m *SyncTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncTimeRequest proto.InternalMessageInfo
+
+func (m *SyncTimeRequest) GetUnixNanos() int64 {
+	if m != nil {
+		return m.UnixNanos
+	}
+	return 0
+}
+
+// SyncTimeResponse reports how far the guest clock was off
+type SyncTimeResponse struct {
+	OffsetNanos          int64    `protobuf:"varint,1,opt,name=offset_nanos,json=offsetNanos,proto3" json:"offset_nanos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncTimeResponse) Reset()         { *m = SyncTimeResponse{} }
+func (m *SyncTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncTimeResponse) ProtoMessage()    {}
+func (*SyncTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c1cba55f3bcb29, []int{32}
+}
+
+func (m *SyncTimeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncTimeResponse.Unmarshal(m, b)
+}
+func (m *SyncTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncTimeResponse.Marshal(b, m, deterministic)
+}
+func (m *SyncTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncTimeResponse.Merge(m, src)
+}
+func (m *SyncTimeResponse) XXX_Size() int {
+	return xxx_messageInfo_SyncTimeResponse.Size(m)
+}
+func (m *SyncTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncTimeResponse proto.InternalMessageInfo
+
+func (m *SyncTimeResponse) GetOffsetNanos() int64 {
+	if m != nil {
+		return m.OffsetNanos
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ExecRequest)(nil), "guest.ExecRequest")
 	proto.RegisterType((*ExecStart)(nil), "guest.ExecStart")
@@ -1056,6 +2056,25 @@ func init() {
 	proto.RegisterType((*CopyFromGuestError)(nil), "guest.CopyFromGuestError")
 	proto.RegisterType((*StatPathRequest)(nil), "guest.StatPathRequest")
 	proto.RegisterType((*StatPathResponse)(nil), "guest.StatPathResponse")
+	proto.RegisterType((*ListProcessesRequest)(nil), "guest.ListProcessesRequest")
+	proto.RegisterType((*ProcessInfo)(nil), "guest.ProcessInfo")
+	proto.RegisterType((*ListProcessesResponse)(nil), "guest.ListProcessesResponse")
+	proto.RegisterType((*GetGuestStatsRequest)(nil), "guest.GetGuestStatsRequest")
+	proto.RegisterType((*DiskUsage)(nil), "guest.DiskUsage")
+	proto.RegisterType((*GetGuestStatsResponse)(nil), "guest.GetGuestStatsResponse")
+	proto.RegisterType((*FileInfo)(nil), "guest.FileInfo")
+	proto.RegisterType((*StatFileRequest)(nil), "guest.StatFileRequest")
+	proto.RegisterType((*StatFileResponse)(nil), "guest.StatFileResponse")
+	proto.RegisterType((*ReadFileRequest)(nil), "guest.ReadFileRequest")
+	proto.RegisterType((*ReadFileResponse)(nil), "guest.ReadFileResponse")
+	proto.RegisterType((*ShutdownRequest)(nil), "guest.ShutdownRequest")
+	proto.RegisterType((*ShutdownResponse)(nil), "guest.ShutdownResponse")
+	proto.RegisterType((*MountVolumeRequest)(nil), "guest.MountVolumeRequest")
+	proto.RegisterType((*MountVolumeResponse)(nil), "guest.MountVolumeResponse")
+	proto.RegisterType((*UnmountVolumeRequest)(nil), "guest.UnmountVolumeRequest")
+	proto.RegisterType((*UnmountVolumeResponse)(nil), "guest.UnmountVolumeResponse")
+	proto.RegisterType((*SyncTimeRequest)(nil), "guest.SyncTimeRequest")
+	proto.RegisterType((*SyncTimeResponse)(nil), "guest.SyncTimeResponse")
 }
 
 func init() {
@@ -1063,62 +2082,113 @@ func init() {
 }
 
 var fileDescriptor_44c1cba55f3bcb29 = []byte{
-	// 897 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0xe3, 0x44,
-	0x14, 0xad, 0xe3, 0xc4, 0xb1, 0x6f, 0xd2, 0xdd, 0x68, 0xb6, 0x1f, 0x6e, 0x60, 0x45, 0x30, 0x42,
-	0x6b, 0xb4, 0x52, 0xb3, 0x74, 0x11, 0x42, 0xf0, 0xd6, 0xa5, 0x25, 0x0f, 0x8b, 0x84, 0xa6, 0x2b,
-	0x21, 0xed, 0x4b, 0xe4, 0xda, 0xd3, 0x64, 0xa8, 0x3f, 0xc2, 0xcc, 0xa4, 0x6d, 0xf8, 0x17, 0xbc,
-	0xf0, 0xca, 0x4f, 0xe2, 0x11, 0x9e, 0xf9, 0x25, 0xe8, 0xce, 0xd8, 0xa9, 0x9d, 0x86, 0xa7, 0xee,
-	0x4b, 0x3b, 0xf7, 0xf8, 0xfa, 0xcc, 0xf5, 0x39, 0x67, 0x1c, 0xc3, 0x7e, 0xca, 0x2f, 0xc7, 0xb3,
-	0x25, 0x93, 0xca, 0xfc, 0x3d, 0x5e, 0x88, 0x42, 0x15, 0xa4, 0xa3, 0x8b, 0xe0, 0x3d, 0xf4, 0xce,
-	0xee, 0x58, 0x4c, 0xd9, 0xaf, 0x58, 0x92, 0x10, 0x3a, 0x52, 0x45, 0x42, 0xf9, 0xd6, 0xc8, 0x0a,
-	0x7b, 0x27, 0x83, 0x63, 0x73, 0x0b, 0xb6, 0x5c, 0x20, 0x3e, 0xd9, 0xa1, 0xa6, 0x81, 0x1c, 0x60,
-	0x67, 0xc2, 0x73, 0xbf, 0x35, 0xb2, 0xc2, 0xbe, 0xc1, 0x13, 0x9e, 0x9f, 0x7a, 0xd0, 0x15, 0x86,
-	0x2c, 0xf8, 0xdb, 0x02, 0x6f, 0x7d, 0x27, 0xf1, 0xa1, 0x1b, 0x17, 0x59, 0x16, 0xe5, 0x89, 0x6f,
-	0x8d, 0xec, 0xd0, 0xa3, 0x55, 0x49, 0x06, 0x60, 0x2b, 0xb5, 0xd2, 0x44, 0x2e, 0xc5, 0x25, 0x79,
-	0x09, 0x36, 0xcb, 0x6f, 0x7c, 0x7b, 0x64, 0x87, 0xbd, 0x93, 0xa3, 0xcd, 0x21, 0x8e, 0xcf, 0xf2,
-	0x9b, 0xb3, 0x5c, 0x89, 0x15, 0xc5, 0x2e, 0xbc, 0x3d, 0xbe, 0x4d, 0xfc, 0xf6, 0xc8, 0x0a, 0x3d,
-	0x8a, 0x4b, 0xf2, 0x02, 0x9e, 0x2a, 0x9e, 0xb1, 0x62, 0xa9, 0xa6, 0x92, 0xc5, 0x45, 0x9e, 0x48,
-	0xbf, 0x33, 0xb2, 0xc2, 0x0e, 0x7d, 0x52, 0xc2, 0x17, 0x06, 0x1d, 0x7e, 0x0d, 0x6e, 0xc5, 0x85,
-	0x34, 0xd7, 0x6c, 0xa5, 0x1f, 0xdc, 0xa3, 0xb8, 0x24, 0x7b, 0xd0, 0xb9, 0x89, 0xd2, 0x25, 0xd3,
-	0x93, 0x79, 0xd4, 0x14, 0xdf, 0xb6, 0xbe, 0xb1, 0x82, 0x0c, 0xfa, 0x46, 0x35, 0xb9, 0x28, 0x72,
-	0xc9, 0x88, 0x0f, 0x8e, 0x54, 0x49, 0xb1, 0x34, 0xba, 0xa1, 0x1a, 0x65, 0x5d, 0x5e, 0x61, 0x42,
-	0xac, 0x75, 0x2a, 0x6b, 0xf2, 0x1c, 0x3c, 0x76, 0xc7, 0xd5, 0x34, 0x2e, 0x12, 0xe6, 0xdb, 0x38,
-	0xde, 0x64, 0x87, 0xba, 0x08, 0xbd, 0x29, 0x12, 0x76, 0x0a, 0xe0, 0x8a, 0x92, 0x3e, 0xf8, 0xdd,
-	0x02, 0xf2, 0xa6, 0x58, 0xac, 0xde, 0x15, 0x3f, 0xa0, 0x12, 0x95, 0x59, 0xe3, 0xa6, 0x59, 0x87,
-	0xa5, 0x4e, 0xb5, 0xce, 0x0d, 0xcf, 0xf6, 0xa0, 0x9d, 0x44, 0x2a, 0x5a, 0x8f, 0xa2, 0x2b, 0xf2,
-	0x05, 0x8a, 0x9d, 0xe8, 0x11, 0x7a, 0x27, 0xfb, 0x0f, 0x49, 0xce, 0xf2, 0x64, 0xb2, 0x83, 0x52,
-	0x27, 0x75, 0x73, 0xff, 0xb4, 0x60, 0xb0, 0xb9, 0x13, 0x21, 0xd0, 0x5e, 0x44, 0x6a, 0x5e, 0x8a,
-	0xa8, 0xd7, 0x88, 0x65, 0xf8, 0x88, 0xb8, 0xe9, 0x2e, 0xd5, 0x6b, 0xb2, 0x0f, 0x0e, 0x97, 0xd3,
-	0x84, 0x0b, 0xbd, 0xab, 0x4b, 0x3b, 0x5c, 0x7e, 0xcf, 0x05, 0xb6, 0x4a, 0xfe, 0x1b, 0xd3, 0x56,
-	0xda, 0x54, 0xaf, 0xd1, 0x84, 0x0c, 0x5d, 0xd3, 0x0e, 0xda, 0xd4, 0x14, 0x68, 0xd6, 0x92, 0x27,
-	0xbe, 0xa3, 0x39, 0x71, 0x89, 0xc8, 0x8c, 0x27, 0x7e, 0xd7, 0x20, 0x33, 0x9e, 0x04, 0x03, 0x78,
-	0xd2, 0x7c, 0x8a, 0xe0, 0x17, 0x78, 0xd6, 0x90, 0x71, 0xed, 0x5e, 0x57, 0x2e, 0xe3, 0x98, 0x49,
-	0xa9, 0x07, 0x77, 0x69, 0x55, 0xe2, 0xe6, 0x4c, 0x88, 0x42, 0x54, 0x09, 0xd0, 0x05, 0xf9, 0x0c,
-	0x76, 0x2f, 0x57, 0x8a, 0xc9, 0xe9, 0xad, 0xe0, 0x4a, 0xb1, 0x5c, 0x3f, 0x84, 0x4d, 0xfb, 0x1a,
-	0xfc, 0xd9, 0x60, 0xc1, 0x8f, 0xb0, 0x87, 0x7b, 0x9d, 0x8b, 0x22, 0x6b, 0x98, 0xb6, 0x4d, 0xa2,
-	0x4f, 0xa1, 0x7f, 0x55, 0xa4, 0x69, 0x71, 0x3b, 0x4d, 0x79, 0x7e, 0x2d, 0xcb, 0x93, 0xd0, 0x33,
-	0xd8, 0x5b, 0x84, 0x82, 0xbf, 0x2c, 0xd8, 0xdf, 0xe0, 0x2b, 0xa7, 0xff, 0x0a, 0x9c, 0x39, 0x8b,
-	0x12, 0x26, 0xca, 0x18, 0x0c, 0x6b, 0x0e, 0xae, 0xbb, 0x27, 0xba, 0x03, 0xd3, 0x67, 0x7a, 0xff,
-	0x27, 0x0a, 0x2f, 0xeb, 0x51, 0x38, 0xdc, 0x46, 0x74, 0x1f, 0x06, 0xf2, 0x65, 0x25, 0x4e, 0x5b,
-	0xb7, 0x1f, 0x6d, 0x6d, 0xc7, 0x06, 0x0c, 0xa0, 0xee, 0x6c, 0x84, 0xfa, 0x5f, 0xcb, 0xb8, 0xb1,
-	0x31, 0xe3, 0x63, 0x33, 0xf4, 0x1c, 0x80, 0xcb, 0xa9, 0x5c, 0x65, 0x28, 0xa5, 0x1e, 0xcd, 0xa5,
-	0x1e, 0x97, 0x17, 0x06, 0x20, 0x9f, 0x40, 0x0f, 0xff, 0x4f, 0x55, 0x24, 0x66, 0x4c, 0xe9, 0x50,
-	0x79, 0x14, 0x10, 0x7a, 0xa7, 0x91, 0x75, 0x06, 0x9d, 0x6d, 0x19, 0xec, 0x6e, 0xc9, 0xa0, 0xfb,
-	0x20, 0x83, 0xde, 0x7d, 0x06, 0x43, 0x73, 0x48, 0xea, 0xf2, 0x21, 0xdb, 0x15, 0xcf, 0xa3, 0xb4,
-	0x0c, 0x9b, 0x29, 0x82, 0x53, 0x73, 0xc4, 0x9b, 0xca, 0x61, 0x34, 0x33, 0x26, 0x65, 0x34, 0x63,
-	0xa5, 0x1e, 0x55, 0xb9, 0x96, 0xa9, 0x75, 0x2f, 0x53, 0x30, 0x81, 0xa7, 0x17, 0x2a, 0x52, 0x3f,
-	0x45, 0x6a, 0xfe, 0xc8, 0xb8, 0xfd, 0x63, 0xc1, 0xe0, 0x9e, 0xaa, 0x4c, 0xda, 0x01, 0x38, 0xec,
-	0x8e, 0x4b, 0x55, 0x1d, 0x93, 0xb2, 0xaa, 0x39, 0xd1, 0xaa, 0x3b, 0x71, 0x08, 0x5d, 0x2e, 0xa7,
-	0x57, 0x3c, 0x65, 0xa5, 0x43, 0x0e, 0x97, 0xe7, 0x3c, 0x65, 0x1f, 0xc2, 0x22, 0x9d, 0x06, 0xa7,
-	0x96, 0x86, 0xca, 0xb6, 0x6e, 0xd3, 0x36, 0x13, 0x50, 0xb7, 0x76, 0x7a, 0x4f, 0xfe, 0x68, 0x41,
-	0xdf, 0xbc, 0xb2, 0x98, 0xb8, 0xe1, 0x31, 0x23, 0xaf, 0xa1, 0x8d, 0x2f, 0x73, 0x42, 0x6a, 0xbf,
-	0x33, 0xa5, 0x7c, 0xc3, 0x67, 0x0d, 0xcc, 0xe8, 0x10, 0x5a, 0xaf, 0x2c, 0x72, 0x0e, 0xbd, 0xda,
-	0xab, 0x84, 0x1c, 0x3d, 0x7c, 0x6d, 0x56, 0x14, 0xc3, 0x6d, 0x97, 0x2a, 0x26, 0xf2, 0x16, 0x76,
-	0x1b, 0xb6, 0x93, 0x8f, 0xb6, 0x1d, 0xa3, 0x8a, 0xeb, 0xe3, 0xed, 0x17, 0x0d, 0xdb, 0x2b, 0x8b,
-	0x7c, 0x07, 0x6e, 0xe5, 0x1a, 0x39, 0x28, 0x7b, 0x37, 0x12, 0x31, 0x3c, 0x7c, 0x80, 0x9b, 0xdb,
-	0x4f, 0x5f, 0xbc, 0xff, 0x7c, 0xc6, 0xd5, 0x7c, 0x79, 0x79, 0x1c, 0x17, 0xd9, 0xb8, 0xc8, 0xaf,
-	0x99, 0xc8, 0x59, 0x3a, 0x9e, 0xaf, 0x16, 0x2c, 0x8b, 0xf2, 0xf1, 0xfa, 0x33, 0xe2, 0xd2, 0xd1,
-	0x5f, 0x10, 0xaf, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x85, 0xa3, 0x7e, 0x65, 0x5a, 0x08, 0x00,
+	// 1713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x72, 0xdc, 0xc6,
+	0x11, 0x16, 0xb8, 0xbf, 0xe8, 0x25, 0xc5, 0xf5, 0x88, 0xe4, 0xc2, 0x6b, 0xaa, 0xbc, 0x86, 0xcb,
+	0xd1, 0xda, 0x4a, 0x44, 0x99, 0xb6, 0x92, 0x94, 0x73, 0x0a, 0x25, 0xca, 0x74, 0x4a, 0x4e, 0x29,
+	0x43, 0x3a, 0xa9, 0xf2, 0x05, 0x35, 0x04, 0x66, 0xc9, 0x09, 0x01, 0x0c, 0x8c, 0x99, 0xa5, 0xb8,
+	0x79, 0x8b, 0x54, 0x52, 0x95, 0xa3, 0x0f, 0x79, 0x85, 0x3c, 0x43, 0xae, 0xb9, 0xe6, 0x9c, 0x27,
+	0x49, 0xcd, 0x0f, 0xfe, 0x76, 0xe1, 0xa4, 0x5c, 0xf6, 0x45, 0x42, 0x7f, 0xd3, 0x33, 0xd3, 0x3f,
+	0x5f, 0x77, 0xcf, 0x12, 0xf6, 0x63, 0x76, 0x79, 0x74, 0xb5, 0xa4, 0x42, 0x9a, 0x7f, 0x9f, 0x64,
+	0x39, 0x97, 0x1c, 0xf5, 0xb4, 0xe0, 0x7f, 0x0d, 0xa3, 0xd3, 0x3b, 0x1a, 0x62, 0xfa, 0x8d, 0x12,
+	0xd1, 0x1c, 0x7a, 0x42, 0x92, 0x5c, 0x7a, 0xce, 0xcc, 0x99, 0x8f, 0x8e, 0xc7, 0x4f, 0xcc, 0x16,
+	0xa5, 0x72, 0xae, 0xf0, 0xb3, 0x7b, 0xd8, 0x28, 0xa0, 0x03, 0xa5, 0x19, 0xb1, 0xd4, 0xdb, 0x9a,
+	0x39, 0xf3, 0x6d, 0x83, 0x47, 0x2c, 0x3d, 0x71, 0x61, 0x90, 0x9b, 0xc3, 0xfc, 0xbf, 0x77, 0xc0,
+	0x2d, 0x77, 0x22, 0x0f, 0x06, 0x21, 0x4f, 0x12, 0x92, 0x46, 0x9e, 0x33, 0xeb, 0xcc, 0x5d, 0x5c,
+	0x88, 0x68, 0x0c, 0x1d, 0x29, 0x57, 0xfa, 0xa0, 0x21, 0x56, 0x9f, 0xe8, 0x31, 0x74, 0x68, 0x7a,
+	0xeb, 0x75, 0x66, 0x9d, 0xf9, 0xe8, 0xf8, 0xed, 0x75, 0x23, 0x9e, 0x9c, 0xa6, 0xb7, 0xa7, 0xa9,
+	0xcc, 0x57, 0x58, 0x69, 0xa9, 0xed, 0xe1, 0x9b, 0xc8, 0xeb, 0xce, 0x9c, 0xb9, 0x8b, 0xd5, 0x27,
+	0x7a, 0x04, 0xbb, 0x92, 0x25, 0x94, 0x2f, 0x65, 0x20, 0x68, 0xc8, 0xd3, 0x48, 0x78, 0xbd, 0x99,
+	0x33, 0xef, 0xe1, 0xfb, 0x16, 0x3e, 0x37, 0x28, 0xfa, 0x10, 0xc6, 0x82, 0x66, 0x24, 0x27, 0x92,
+	0x06, 0x42, 0xe6, 0x94, 0x24, 0xc2, 0xeb, 0x6b, 0x33, 0x76, 0x0b, 0xfc, 0xdc, 0xc0, 0xe8, 0x1d,
+	0x70, 0xc3, 0x6c, 0x19, 0x7c, 0xb3, 0xe4, 0x92, 0x78, 0x83, 0x99, 0x33, 0x77, 0xf0, 0x30, 0xcc,
+	0x96, 0xbf, 0x53, 0x32, 0xfa, 0x29, 0xa0, 0x84, 0x26, 0x3c, 0x5f, 0x05, 0x31, 0x4b, 0x98, 0x0c,
+	0x2e, 0x57, 0x92, 0x0a, 0x6f, 0x38, 0x73, 0xe6, 0x1d, 0x3c, 0x36, 0x2b, 0xaf, 0xd4, 0xc2, 0x89,
+	0xc2, 0xd1, 0x63, 0x78, 0x2b, 0xca, 0x79, 0x16, 0x84, 0x24, 0x23, 0x97, 0x2c, 0x66, 0x92, 0x51,
+	0xe1, 0xb9, 0x3a, 0x26, 0x63, 0xb5, 0xf0, 0xbc, 0x86, 0x2b, 0x5f, 0x04, 0x0d, 0x43, 0x9e, 0x64,
+	0x41, 0x96, 0xf3, 0x05, 0x8b, 0xa9, 0x07, 0xda, 0xd3, 0xfb, 0x16, 0x7e, 0x6d, 0xd0, 0xe9, 0xcf,
+	0x61, 0x58, 0xc4, 0x45, 0x85, 0xe4, 0x86, 0xae, 0x74, 0x12, 0x5d, 0xac, 0x3e, 0xd1, 0x1e, 0xf4,
+	0x6e, 0x49, 0xbc, 0xa4, 0x3a, 0xca, 0x2e, 0x36, 0xc2, 0x67, 0x5b, 0xbf, 0x74, 0xfc, 0x04, 0xb6,
+	0x0d, 0x03, 0x44, 0xc6, 0x53, 0x41, 0x91, 0x07, 0x7d, 0x21, 0x23, 0xbe, 0x34, 0x1c, 0x50, 0x99,
+	0xb5, 0xb2, 0x5d, 0xa1, 0x79, 0x5e, 0xe6, 0xdc, 0xca, 0xe8, 0x21, 0xb8, 0xf4, 0x8e, 0xc9, 0x20,
+	0xe4, 0x11, 0xf5, 0x3a, 0x2a, 0xd4, 0x67, 0xf7, 0xf0, 0x50, 0x41, 0xcf, 0x79, 0x44, 0x4f, 0x00,
+	0x86, 0xb9, 0x3d, 0xde, 0xff, 0xb3, 0x03, 0xe8, 0x39, 0xcf, 0x56, 0x17, 0xfc, 0x73, 0x95, 0xd5,
+	0x82, 0x78, 0x47, 0x4d, 0xe2, 0x4d, 0x6c, 0xce, 0x6b, 0x9a, 0x6b, 0xfc, 0xdb, 0x83, 0x6e, 0x44,
+	0x24, 0x29, 0x4d, 0xd1, 0x12, 0xfa, 0x50, 0x11, 0x27, 0xd2, 0x26, 0x8c, 0x8e, 0xf7, 0x37, 0x0f,
+	0x39, 0x4d, 0xa3, 0xb3, 0x7b, 0x8a, 0x36, 0x51, 0x9d, 0xa8, 0xdf, 0x3a, 0x30, 0x5e, 0xbf, 0x09,
+	0x21, 0xe8, 0x66, 0x44, 0x5e, 0xdb, 0x20, 0xea, 0x6f, 0x85, 0x25, 0xca, 0x45, 0x75, 0xe9, 0x0e,
+	0xd6, 0xdf, 0x68, 0x1f, 0xfa, 0x4c, 0x04, 0x11, 0xcb, 0xf5, 0xad, 0x43, 0xdc, 0x63, 0xe2, 0x05,
+	0xcb, 0x95, 0xaa, 0x60, 0x7f, 0xa2, 0x9a, 0x96, 0x1d, 0xac, 0xbf, 0x55, 0x12, 0x12, 0xc5, 0x40,
+	0xcd, 0xc6, 0x0e, 0x36, 0x82, 0x4a, 0xd6, 0x92, 0x45, 0x9a, 0x77, 0x3b, 0x58, 0x7d, 0x2a, 0xe4,
+	0x8a, 0x45, 0x9a, 0x65, 0x3b, 0x58, 0x7d, 0xfa, 0x63, 0xb8, 0xdf, 0xf4, 0xc2, 0xff, 0x23, 0x3c,
+	0x68, 0x84, 0xb1, 0xcc, 0xde, 0x40, 0x2c, 0xc3, 0x90, 0x0a, 0xa1, 0x0d, 0x1f, 0xe2, 0x42, 0x54,
+	0x97, 0xd3, 0x3c, 0xe7, 0x79, 0xc1, 0x00, 0x2d, 0xa0, 0xf7, 0x61, 0x47, 0x93, 0x35, 0x78, 0x93,
+	0x33, 0x29, 0x69, 0xaa, 0x9d, 0xe8, 0xe0, 0x6d, 0x0d, 0xfe, 0xc1, 0x60, 0xfe, 0x97, 0xb0, 0xa7,
+	0xee, 0x7a, 0x99, 0xf3, 0xa4, 0x91, 0xb4, 0xb6, 0x10, 0xbd, 0x07, 0xdb, 0x0b, 0x1e, 0xc7, 0xfc,
+	0x4d, 0x10, 0xb3, 0xf4, 0x46, 0xd8, 0xaa, 0x1e, 0x19, 0xec, 0x95, 0x82, 0xfc, 0x7f, 0x39, 0xb0,
+	0xbf, 0x76, 0x9e, 0xb5, 0xfe, 0x53, 0xe8, 0x5f, 0x53, 0x12, 0xd1, 0xdc, 0xd2, 0x60, 0x5a, 0xcb,
+	0x60, 0xa9, 0x7d, 0xa6, 0x35, 0x14, 0xfb, 0x8c, 0xee, 0x77, 0x50, 0xe1, 0x71, 0x9d, 0x0a, 0x93,
+	0xb6, 0x83, 0x2a, 0x32, 0xa0, 0x8f, 0x8b, 0xe0, 0x74, 0x67, 0x4e, 0xad, 0xe5, 0x34, 0xd5, 0x95,
+	0x82, 0x22, 0xa0, 0xd6, 0x6c, 0x90, 0xfa, 0x3f, 0x0e, 0x3c, 0x68, 0xe8, 0x1a, 0x1b, 0x7f, 0x28,
+	0x87, 0x1e, 0x02, 0x30, 0x11, 0x88, 0x55, 0xa2, 0x42, 0xa9, 0x4d, 0x1b, 0x62, 0x97, 0x89, 0x73,
+	0x03, 0xa0, 0x77, 0x61, 0xa4, 0xfe, 0x0f, 0x24, 0xc9, 0xaf, 0xa8, 0xd4, 0xa4, 0x72, 0x31, 0x28,
+	0xe8, 0x42, 0x23, 0x25, 0x07, 0xfb, 0x6d, 0x1c, 0x1c, 0xb4, 0x70, 0x70, 0xb8, 0xc1, 0x41, 0xb7,
+	0xe2, 0xe0, 0x1c, 0xc6, 0x0d, 0x1f, 0x4f, 0xd3, 0x48, 0x9d, 0xb6, 0x60, 0x29, 0x89, 0x2d, 0xd9,
+	0x8c, 0xe0, 0x9f, 0x00, 0x6a, 0x6a, 0x6a, 0xaa, 0x79, 0x30, 0x48, 0xa8, 0x10, 0xe4, 0x8a, 0xda,
+	0x78, 0x14, 0x62, 0x19, 0xa6, 0xad, 0x2a, 0x4c, 0xfe, 0x19, 0xec, 0x9e, 0x4b, 0x22, 0x5f, 0x13,
+	0x79, 0xfd, 0x03, 0xe9, 0xf6, 0x6f, 0x07, 0xc6, 0xd5, 0x51, 0x96, 0x69, 0x07, 0xd0, 0xa7, 0x77,
+	0x4c, 0xc8, 0xa2, 0x4c, 0xac, 0x54, 0xcb, 0xc4, 0x56, 0x3d, 0x13, 0x13, 0x18, 0x30, 0x11, 0xe8,
+	0xee, 0x6b, 0x32, 0xd4, 0x67, 0xe2, 0x25, 0x8b, 0xe9, 0x8f, 0x91, 0x22, 0xcd, 0x86, 0x7e, 0x8d,
+	0x0d, 0x45, 0xda, 0x06, 0xcd, 0xb4, 0x19, 0x82, 0x0e, 0x6b, 0xd5, 0xeb, 0x1f, 0xc0, 0xde, 0x2b,
+	0x26, 0xe4, 0xeb, 0x9c, 0xab, 0x12, 0xa7, 0xc2, 0x46, 0xca, 0xff, 0xab, 0x03, 0x23, 0x0b, 0x7e,
+	0x91, 0x2e, 0xb8, 0x4a, 0x66, 0xc6, 0x22, 0xed, 0x6a, 0x0f, 0xab, 0x4f, 0x1d, 0x4b, 0x05, 0x6d,
+	0x69, 0xa8, 0x9b, 0x59, 0x2c, 0x25, 0x89, 0xf1, 0xd0, 0xc5, 0xfa, 0x5b, 0x4f, 0xed, 0x24, 0x8a,
+	0x59, 0xaa, 0x3a, 0x99, 0x99, 0xda, 0x46, 0x54, 0x16, 0x09, 0x49, 0x24, 0xb5, 0x4e, 0x19, 0x41,
+	0x8d, 0xc9, 0x5c, 0x08, 0x3b, 0x00, 0x0d, 0xef, 0x86, 0xb9, 0x10, 0x7a, 0xf0, 0xf9, 0x5f, 0xc0,
+	0xfe, 0x9a, 0xb9, 0x36, 0x1b, 0x4f, 0xc1, 0xcd, 0x0a, 0x50, 0xbf, 0x0e, 0x46, 0xc7, 0xc8, 0x96,
+	0x60, 0xcd, 0x0d, 0x5c, 0x29, 0x29, 0xcf, 0x3f, 0xa7, 0xb2, 0x68, 0xd7, 0xb2, 0xf4, 0xfc, 0x9f,
+	0x0e, 0xb8, 0x2f, 0x98, 0xb8, 0xf9, 0x4a, 0x13, 0xeb, 0x5d, 0x18, 0x25, 0x7c, 0x99, 0xca, 0x20,
+	0xe3, 0x2c, 0x95, 0x96, 0x38, 0xa0, 0xa1, 0xd7, 0x0a, 0x51, 0x34, 0x88, 0xe8, 0x2d, 0x0b, 0x8b,
+	0xb9, 0x68, 0x25, 0x95, 0xef, 0x85, 0x08, 0xe4, 0x2a, 0x2b, 0xa2, 0xd1, 0x5f, 0x88, 0x8b, 0x55,
+	0xa6, 0x4f, 0x94, 0x5c, 0x92, 0xd8, 0x7a, 0xa8, 0x12, 0xde, 0xc5, 0xa0, 0x21, 0x33, 0xdc, 0x1f,
+	0x02, 0x2c, 0x05, 0x8d, 0xec, 0x7a, 0x4f, 0xaf, 0xbb, 0x0a, 0x31, 0xcb, 0x8f, 0x60, 0x97, 0xdc,
+	0x12, 0x16, 0x93, 0xcb, 0x98, 0xd6, 0xa2, 0xd4, 0xc5, 0xf7, 0x4b, 0xd8, 0xc4, 0xea, 0x2f, 0x5b,
+	0xb0, 0xbf, 0xe6, 0xa1, 0x0d, 0xd6, 0x1e, 0xf4, 0x62, 0x4e, 0xa2, 0x8f, 0xb5, 0x3b, 0x0e, 0x36,
+	0x42, 0x81, 0x3e, 0xf3, 0xb6, 0x2a, 0xf4, 0x99, 0xf2, 0x4f, 0x2f, 0x3f, 0xd3, 0x6e, 0x38, 0xd8,
+	0x4a, 0xb5, 0x07, 0xcb, 0xa6, 0x37, 0xf6, 0xc1, 0x72, 0x51, 0xf9, 0xf4, 0x29, 0x1c, 0x58, 0xed,
+	0x75, 0xdb, 0x8d, 0x7f, 0x7b, 0x66, 0xf5, 0xd7, 0x0d, 0x0f, 0xd0, 0x47, 0xf0, 0x96, 0xdd, 0xb5,
+	0xc8, 0x69, 0xd3, 0xd9, 0x5d, 0xb3, 0xf0, 0x32, 0xa7, 0x56, 0xf7, 0x27, 0xd0, 0x8b, 0x98, 0xb8,
+	0x11, 0xde, 0x60, 0xd6, 0xa9, 0xbd, 0x3b, 0xcb, 0x4c, 0x62, 0xb3, 0xec, 0xff, 0xcd, 0x81, 0xa1,
+	0xaa, 0x3b, 0xcd, 0xea, 0xb6, 0x7e, 0xf0, 0x1d, 0xf5, 0x5b, 0x94, 0x59, 0xa7, 0xa5, 0xcc, 0x7e,
+	0x9c, 0x09, 0xfd, 0x81, 0xe9, 0x57, 0xca, 0xb8, 0xff, 0xd1, 0xaf, 0xfc, 0x5f, 0xc0, 0xb8, 0x52,
+	0xb3, 0x09, 0x7d, 0x1f, 0xba, 0x2c, 0x5d, 0x70, 0x3b, 0xf3, 0x76, 0xad, 0xef, 0x85, 0x9b, 0x58,
+	0x2f, 0xfa, 0x27, 0xb0, 0x8b, 0x29, 0x89, 0xfe, 0xcf, 0xf9, 0xaa, 0xfe, 0x12, 0x72, 0x67, 0x83,
+	0xbd, 0x65, 0xea, 0x2f, 0x21, 0x77, 0x86, 0x53, 0x0c, 0xc6, 0xd5, 0x19, 0xdf, 0xe3, 0x72, 0x75,
+	0x53, 0x35, 0x61, 0xed, 0x7c, 0x3d, 0x04, 0x57, 0xe6, 0xcb, 0x34, 0x24, 0x92, 0x46, 0xb6, 0x29,
+	0x56, 0x80, 0xff, 0x19, 0xec, 0x9e, 0x5f, 0x2f, 0x65, 0xc4, 0xdf, 0xa4, 0x85, 0xb9, 0x2d, 0xaf,
+	0x72, 0xa7, 0xed, 0x55, 0xee, 0x23, 0x18, 0x57, 0x7b, 0xed, 0x84, 0xfd, 0xd6, 0x01, 0xf4, 0xa5,
+	0xaa, 0xdb, 0xdf, 0xf3, 0x78, 0x99, 0x94, 0x21, 0x38, 0x80, 0xbe, 0xa0, 0x39, 0xb3, 0x03, 0xc8,
+	0xc5, 0x56, 0x6a, 0x9b, 0x28, 0x68, 0xaa, 0x06, 0x36, 0x89, 0x78, 0x1a, 0xaf, 0xac, 0xbd, 0xa5,
+	0xdc, 0x66, 0x5b, 0xb7, 0xf5, 0x17, 0x83, 0x07, 0x83, 0x88, 0x89, 0x90, 0xe4, 0x91, 0xa6, 0xc8,
+	0x10, 0x17, 0xa2, 0xff, 0x33, 0x78, 0xd0, 0x30, 0xb0, 0x1a, 0x34, 0xb6, 0xc3, 0x38, 0xf5, 0x0e,
+	0xe3, 0x7f, 0x04, 0x7b, 0x5f, 0xa5, 0xc9, 0xa6, 0x47, 0x6d, 0xa4, 0x99, 0xc0, 0xfe, 0x9a, 0xae,
+	0x8d, 0xca, 0x53, 0xd8, 0x3d, 0x5f, 0xa5, 0xe1, 0x05, 0xab, 0xf6, 0xab, 0xfe, 0x93, 0xb2, 0xbb,
+	0x20, 0x25, 0x29, 0x37, 0x01, 0xee, 0x60, 0x57, 0x21, 0xbf, 0x55, 0x80, 0xff, 0x0c, 0xc6, 0xd5,
+	0x0e, 0x6b, 0xe2, 0x7b, 0xb0, 0xcd, 0x17, 0x0b, 0x41, 0x65, 0x63, 0xd3, 0xc8, 0x60, 0x7a, 0xdb,
+	0xf1, 0x3f, 0xfa, 0xb0, 0x6d, 0x5a, 0x11, 0xcd, 0x75, 0x83, 0xfc, 0x04, 0xba, 0xea, 0x57, 0x03,
+	0x42, 0xb5, 0x1f, 0x67, 0xd6, 0x84, 0xe9, 0x83, 0x06, 0x66, 0x2e, 0x99, 0x3b, 0x4f, 0x1d, 0xf4,
+	0x12, 0x46, 0xb5, 0x37, 0x2b, 0x7a, 0x7b, 0xf3, 0x7d, 0x5e, 0x1c, 0x31, 0x6d, 0x5b, 0x2a, 0x4e,
+	0x42, 0xaf, 0x60, 0xa7, 0xf1, 0xbe, 0x40, 0xef, 0xb4, 0xbd, 0xd7, 0x8a, 0xb3, 0x0e, 0xdb, 0x17,
+	0xcd, 0x69, 0x4f, 0x1d, 0xf4, 0x2b, 0x18, 0x16, 0xcf, 0x03, 0x74, 0x60, 0x75, 0xd7, 0x9e, 0x1e,
+	0xd3, 0xc9, 0x06, 0x6e, 0x63, 0xf7, 0x1b, 0xd8, 0x69, 0x8c, 0xb4, 0xd2, 0x94, 0xb6, 0xb9, 0x3c,
+	0x3d, 0x6c, 0x5f, 0xac, 0xce, 0x6a, 0x74, 0xfc, 0xf2, 0xac, 0xb6, 0x49, 0x37, 0x3d, 0x6c, 0x5f,
+	0xb4, 0x67, 0x59, 0xa7, 0xf4, 0x1b, 0xa5, 0xee, 0x54, 0xad, 0x7f, 0x4c, 0x27, 0x1b, 0x78, 0xb5,
+	0xb9, 0xe8, 0x13, 0xe5, 0xe6, 0xb5, 0xe6, 0x33, 0x9d, 0x6c, 0xe0, 0xb5, 0x9b, 0x6d, 0xf5, 0x56,
+	0x37, 0x37, 0x5b, 0xc1, 0x74, 0xb2, 0x81, 0xdb, 0xcd, 0x2f, 0x60, 0x54, 0x2b, 0xa2, 0x92, 0x21,
+	0x9b, 0x95, 0x3f, 0x9d, 0xb6, 0x2d, 0x55, 0x81, 0x6c, 0xd4, 0x4b, 0x19, 0xc8, 0xb6, 0x8a, 0x9b,
+	0x1e, 0xb6, 0x2f, 0xd6, 0xdc, 0xb1, 0x05, 0x53, 0xb9, 0xd3, 0xac, 0xb9, 0xe9, 0x64, 0x03, 0x37,
+	0x9b, 0x4f, 0x1e, 0x7d, 0xfd, 0xc1, 0x15, 0x93, 0xd7, 0xcb, 0xcb, 0x27, 0x21, 0x4f, 0x8e, 0x78,
+	0x7a, 0x43, 0xf3, 0x94, 0xc6, 0x47, 0xd7, 0xab, 0x8c, 0x26, 0x24, 0x3d, 0x2a, 0xff, 0x32, 0x73,
+	0xd9, 0xd7, 0x7f, 0x94, 0xf9, 0xe4, 0xbf, 0x03, 0x00, 0xe2, 0x84, 0x3c, 0x6e, 0xad, 0x11, 0x00,
 	0x00,
 }
@@ -23,6 +23,14 @@ const (
 	GuestService_CopyToGuest_FullMethodName   = "/guest.GuestService/CopyToGuest"
 	GuestService_CopyFromGuest_FullMethodName = "/guest.GuestService/CopyFromGuest"
 	GuestService_StatPath_FullMethodName      = "/guest.GuestService/StatPath"
+	GuestService_ListProcesses_FullMethodName = "/guest.GuestService/ListProcesses"
+	GuestService_GetGuestStats_FullMethodName = "/guest.GuestService/GetGuestStats"
+	GuestService_StatFile_FullMethodName      = "/guest.GuestService/StatFile"
+	GuestService_ReadFile_FullMethodName      = "/guest.GuestService/ReadFile"
+	GuestService_Shutdown_FullMethodName      = "/guest.GuestService/Shutdown"
+	GuestService_MountVolume_FullMethodName   = "/guest.GuestService/MountVolume"
+	GuestService_UnmountVolume_FullMethodName = "/guest.GuestService/UnmountVolume"
+	GuestService_SyncTime_FullMethodName      = "/guest.GuestService/SyncTime"
 )
 
 // GuestServiceClient is the client API for GuestService service.
@@ -39,6 +47,22 @@ type GuestServiceClient interface {
 	CopyFromGuest(ctx context.Context, in *CopyFromGuestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CopyFromGuestResponse], error)
 	// StatPath returns information about a path in the guest filesystem
 	StatPath(ctx context.Context, in *StatPathRequest, opts ...grpc.CallOption) (*StatPathResponse, error)
+	// ListProcesses returns the processes running in the guest
+	ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc.CallOption) (*ListProcessesResponse, error)
+	// GetGuestStats returns load average, memory and disk usage of the guest
+	GetGuestStats(ctx context.Context, in *GetGuestStatsRequest, opts ...grpc.CallOption) (*GetGuestStatsResponse, error)
+	// StatFile returns metadata for a file that may be read with ReadFile
+	StatFile(ctx context.Context, in *StatFileRequest, opts ...grpc.CallOption) (*StatFileResponse, error)
+	// ReadFile returns the contents of a small regular file (read-only)
+	ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (*ReadFileResponse, error)
+	// Shutdown syncs filesystems and powers off the guest
+	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error)
+	// MountVolume mounts a hotplugged volume disk, found by serial
+	MountVolume(ctx context.Context, in *MountVolumeRequest, opts ...grpc.CallOption) (*MountVolumeResponse, error)
+	// UnmountVolume unmounts a volume before its disk is unplugged
+	UnmountVolume(ctx context.Context, in *UnmountVolumeRequest, opts ...grpc.CallOption) (*UnmountVolumeResponse, error)
+	// SyncTime sets the guest clock from the host's
+	SyncTime(ctx context.Context, in *SyncTimeRequest, opts ...grpc.CallOption) (*SyncTimeResponse, error)
 }
 
 type guestServiceClient struct {
@@ -104,6 +128,86 @@ func (c *guestServiceClient) StatPath(ctx context.Context, in *StatPathRequest,
 	return out, nil
 }
 
+func (c *guestServiceClient) ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc.CallOption) (*ListProcessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProcessesResponse)
+	err := c.cc.Invoke(ctx, GuestService_ListProcesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) GetGuestStats(ctx context.Context, in *GetGuestStatsRequest, opts ...grpc.CallOption) (*GetGuestStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGuestStatsResponse)
+	err := c.cc.Invoke(ctx, GuestService_GetGuestStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) StatFile(ctx context.Context, in *StatFileRequest, opts ...grpc.CallOption) (*StatFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatFileResponse)
+	err := c.cc.Invoke(ctx, GuestService_StatFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (*ReadFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadFileResponse)
+	err := c.cc.Invoke(ctx, GuestService_ReadFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShutdownResponse)
+	err := c.cc.Invoke(ctx, GuestService_Shutdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) MountVolume(ctx context.Context, in *MountVolumeRequest, opts ...grpc.CallOption) (*MountVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MountVolumeResponse)
+	err := c.cc.Invoke(ctx, GuestService_MountVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) UnmountVolume(ctx context.Context, in *UnmountVolumeRequest, opts ...grpc.CallOption) (*UnmountVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmountVolumeResponse)
+	err := c.cc.Invoke(ctx, GuestService_UnmountVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) SyncTime(ctx context.Context, in *SyncTimeRequest, opts ...grpc.CallOption) (*SyncTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncTimeResponse)
+	err := c.cc.Invoke(ctx, GuestService_SyncTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GuestServiceServer is the server API for GuestService service.
 // All implementations must embed UnimplementedGuestServiceServer
 // for forward compatibility.
@@ -118,6 +222,22 @@ type GuestServiceServer interface {
 	CopyFromGuest(*CopyFromGuestRequest, grpc.ServerStreamingServer[CopyFromGuestResponse]) error
 	// StatPath returns information about a path in the guest filesystem
 	StatPath(context.Context, *StatPathRequest) (*StatPathResponse, error)
+	// ListProcesses returns the processes running in the guest
+	ListProcesses(context.Context, *ListProcessesRequest) (*ListProcessesResponse, error)
+	// GetGuestStats returns load average, memory and disk usage of the guest
+	GetGuestStats(context.Context, *GetGuestStatsRequest) (*GetGuestStatsResponse, error)
+	// StatFile returns metadata for a file that may be read with ReadFile
+	StatFile(context.Context, *StatFileRequest) (*StatFileResponse, error)
+	// ReadFile returns the contents of a small regular file (read-only)
+	ReadFile(context.Context, *ReadFileRequest) (*ReadFileResponse, error)
+	// Shutdown syncs filesystems and powers off the guest
+	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
+	// MountVolume mounts a hotplugged volume disk, found by serial
+	MountVolume(context.Context, *MountVolumeRequest) (*MountVolumeResponse, error)
+	// UnmountVolume unmounts a volume before its disk is unplugged
+	UnmountVolume(context.Context, *UnmountVolumeRequest) (*UnmountVolumeResponse, error)
+	// SyncTime sets the guest clock from the host's
+	SyncTime(context.Context, *SyncTimeRequest) (*SyncTimeResponse, error)
 	mustEmbedUnimplementedGuestServiceServer()
 }
 
@@ -140,6 +260,30 @@ func (UnimplementedGuestServiceServer) CopyFromGuest(*CopyFromGuestRequest, grpc
 func (UnimplementedGuestServiceServer) StatPath(context.Context, *StatPathRequest) (*StatPathResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method StatPath not implemented")
 }
+func (UnimplementedGuestServiceServer) ListProcesses(context.Context, *ListProcessesRequest) (*ListProcessesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProcesses not implemented")
+}
+func (UnimplementedGuestServiceServer) GetGuestStats(context.Context, *GetGuestStatsRequest) (*GetGuestStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGuestStats not implemented")
+}
+func (UnimplementedGuestServiceServer) StatFile(context.Context, *StatFileRequest) (*StatFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StatFile not implemented")
+}
+func (UnimplementedGuestServiceServer) ReadFile(context.Context, *ReadFileRequest) (*ReadFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReadFile not implemented")
+}
+func (UnimplementedGuestServiceServer) Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Shutdown not implemented")
+}
+func (UnimplementedGuestServiceServer) MountVolume(context.Context, *MountVolumeRequest) (*MountVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MountVolume not implemented")
+}
+func (UnimplementedGuestServiceServer) UnmountVolume(context.Context, *UnmountVolumeRequest) (*UnmountVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnmountVolume not implemented")
+}
+func (UnimplementedGuestServiceServer) SyncTime(context.Context, *SyncTimeRequest) (*SyncTimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SyncTime not implemented")
+}
 func (UnimplementedGuestServiceServer) mustEmbedUnimplementedGuestServiceServer() {}
 func (UnimplementedGuestServiceServer) testEmbeddedByValue()                      {}
 
@@ -204,6 +348,150 @@ func _GuestService_StatPath_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GuestService_ListProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProcessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).ListProcesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_ListProcesses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).ListProcesses(ctx, req.(*ListProcessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_GetGuestStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuestStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).GetGuestStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_GetGuestStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).GetGuestStats(ctx, req.(*GetGuestStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_StatFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).StatFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_StatFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).StatFile(ctx, req.(*StatFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_ReadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).ReadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_ReadFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).ReadFile(ctx, req.(*ReadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_Shutdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).Shutdown(ctx, req.(*ShutdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_MountVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MountVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).MountVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_MountVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).MountVolume(ctx, req.(*MountVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_UnmountVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmountVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).UnmountVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_UnmountVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).UnmountVolume(ctx, req.(*UnmountVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_SyncTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).SyncTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_SyncTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).SyncTime(ctx, req.(*SyncTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GuestService_ServiceDesc is the grpc.ServiceDesc for GuestService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -215,6 +503,38 @@ var GuestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StatPath",
 			Handler:    _GuestService_StatPath_Handler,
 		},
+		{
+			MethodName: "ListProcesses",
+			Handler:    _GuestService_ListProcesses_Handler,
+		},
+		{
+			MethodName: "GetGuestStats",
+			Handler:    _GuestService_GetGuestStats_Handler,
+		},
+		{
+			MethodName: "StatFile",
+			Handler:    _GuestService_StatFile_Handler,
+		},
+		{
+			MethodName: "ReadFile",
+			Handler:    _GuestService_ReadFile_Handler,
+		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _GuestService_Shutdown_Handler,
+		},
+		{
+			MethodName: "MountVolume",
+			Handler:    _GuestService_MountVolume_Handler,
+		},
+		{
+			MethodName: "UnmountVolume",
+			Handler:    _GuestService_UnmountVolume_Handler,
+		},
+		{
+			MethodName: "SyncTime",
+			Handler:    _GuestService_SyncTime_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,74 @@
+package guest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SeccompProfileDefault is the seccomp profile that blocks syscalls a
+// debugging session has no business making: mounting, loading kernel
+// modules or BPF programs, tracing other processes, entering namespaces,
+// rebooting and the like. Blocked calls fail with EPERM.
+const SeccompProfileDefault = "default"
+
+// DropAllCapabilities in ExecOptions.DropCapabilities drops every capability
+const DropAllCapabilities = "ALL"
+
+// capabilities are the Linux capability names, indexed by number
+var capabilities = []string{
+	"CAP_CHOWN", "CAP_DAC_OVERRIDE", "CAP_DAC_READ_SEARCH", "CAP_FOWNER",
+	"CAP_FSETID", "CAP_KILL", "CAP_SETGID", "CAP_SETUID", "CAP_SETPCAP",
+	"CAP_LINUX_IMMUTABLE", "CAP_NET_BIND_SERVICE", "CAP_NET_BROADCAST",
+	"CAP_NET_ADMIN", "CAP_NET_RAW", "CAP_IPC_LOCK", "CAP_IPC_OWNER",
+	"CAP_SYS_MODULE", "CAP_SYS_RAWIO", "CAP_SYS_CHROOT", "CAP_SYS_PTRACE",
+	"CAP_SYS_PACCT", "CAP_SYS_ADMIN", "CAP_SYS_BOOT", "CAP_SYS_NICE",
+	"CAP_SYS_RESOURCE", "CAP_SYS_TIME", "CAP_SYS_TTY_CONFIG", "CAP_MKNOD",
+	"CAP_LEASE", "CAP_AUDIT_WRITE", "CAP_AUDIT_CONTROL", "CAP_SETFCAP",
+	"CAP_MAC_OVERRIDE", "CAP_MAC_ADMIN", "CAP_SYSLOG", "CAP_WAKE_ALARM",
+	"CAP_BLOCK_SUSPEND", "CAP_AUDIT_READ", "CAP_PERFMON", "CAP_BPF",
+	"CAP_CHECKPOINT_RESTORE",
+}
+
+// CapabilityNumbers resolves capability names, with or without the "CAP_"
+// prefix and in any case, to their numbers. DropAllCapabilities resolves to
+// every capability.
+func CapabilityNumbers(names []string) ([]int, error) {
+	var numbers []int
+	for _, name := range names {
+		name = strings.ToUpper(name)
+		if name == DropAllCapabilities {
+			numbers = numbers[:0]
+			for i := range capabilities {
+				numbers = append(numbers, i)
+			}
+			return numbers, nil
+		}
+		if !strings.HasPrefix(name, "CAP_") {
+			name = "CAP_" + name
+		}
+		found := false
+		for i, c := range capabilities {
+			if c == name {
+				numbers = append(numbers, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown capability %q", name)
+		}
+	}
+	return numbers, nil
+}
+
+// ValidateExecSandbox checks the capabilities and seccomp profile of an exec
+// request are ones the guest agent knows
+func ValidateExecSandbox(dropCapabilities []string, seccompProfile string) error {
+	if _, err := CapabilityNumbers(dropCapabilities); err != nil {
+		return err
+	}
+	if seccompProfile != "" && seccompProfile != SeccompProfileDefault {
+		return fmt.Errorf("unknown seccomp profile %q (supported: %q)", seccompProfile, SeccompProfileDefault)
+	}
+	return nil
+}
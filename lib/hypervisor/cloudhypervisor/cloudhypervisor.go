@@ -30,16 +30,25 @@ func New(socketPath string) (*CloudHypervisor, error) {
 // Verify CloudHypervisor implements the interface
 var _ hypervisor.Hypervisor = (*CloudHypervisor)(nil)
 
+// capabilities lists the features supported by Cloud Hypervisor.
+var capabilities = hypervisor.Capabilities{
+	SupportsSnapshot:       true,
+	SupportsHotplugMemory:  true,
+	SupportsPause:          true,
+	SupportsVsock:          true,
+	SupportsGPUPassthrough: true,
+	SupportsDiskIOLimit:    true,
+	SupportsHotplugDevice:  true,
+	SupportsDiskSerial:     true,
+	SupportsHotplugDisk:    true,
+	SupportsQcow2:          true,
+	SupportsConsole:        true,
+	MaxDisks:               hypervisor.PrimaryBusDisks + secondaryBusDisks,
+}
+
 // Capabilities returns the features supported by Cloud Hypervisor.
 func (c *CloudHypervisor) Capabilities() hypervisor.Capabilities {
-	return hypervisor.Capabilities{
-		SupportsSnapshot:       true,
-		SupportsHotplugMemory:  true,
-		SupportsPause:          true,
-		SupportsVsock:          true,
-		SupportsGPUPassthrough: true,
-		SupportsDiskIOLimit:    true,
-	}
+	return capabilities
 }
 
 // DeleteVM removes the VM configuration from Cloud Hypervisor.
@@ -201,3 +210,60 @@ func (c *CloudHypervisor) ResizeMemoryAndWait(ctx context.Context, bytes int64,
 	// Timeout reached, but resize was requested successfully
 	return nil
 }
+
+// AddPCIDevice hotplugs a VFIO-bound PCI device into the running VM.
+func (c *CloudHypervisor) AddPCIDevice(ctx context.Context, sysfsPath string) error {
+	deviceConfig := vmm.DeviceConfig{
+		Id:   ptr(pciDeviceID(sysfsPath)),
+		Path: sysfsPath,
+	}
+	resp, err := c.client.PutVmAddDeviceWithResponse(ctx, deviceConfig)
+	if err != nil {
+		return fmt.Errorf("add device: %w", err)
+	}
+	if resp.StatusCode() != 200 && resp.StatusCode() != 204 {
+		return fmt.Errorf("add device failed with status %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// AddDisk hotplugs a disk into the running VM. It lands on the first PCI
+// segment, whichever slot is free.
+func (c *CloudHypervisor) AddDisk(ctx context.Context, disk hypervisor.DiskConfig) error {
+	if disk.Serial == "" {
+		return fmt.Errorf("add disk: serial is required")
+	}
+	resp, err := c.client.PutVmAddDiskWithResponse(ctx, toDiskConfig(disk))
+	if err != nil {
+		return fmt.Errorf("add disk: %w", err)
+	}
+	if resp.StatusCode() != 200 && resp.StatusCode() != 204 {
+		return fmt.Errorf("add disk failed with status %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// RemoveDisk hot-unplugs a disk, which was configured with the given serial.
+func (c *CloudHypervisor) RemoveDisk(ctx context.Context, serial string) error {
+	resp, err := c.client.PutVmRemoveDeviceWithResponse(ctx, vmm.VmRemoveDevice{Id: ptr(serial)})
+	if err != nil {
+		return fmt.Errorf("remove disk: %w", err)
+	}
+	if resp.StatusCode() != 204 {
+		return fmt.Errorf("remove disk failed with status %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// RemovePCIDevice hot-unplugs a passthrough PCI device from the running VM.
+func (c *CloudHypervisor) RemovePCIDevice(ctx context.Context, sysfsPath string) error {
+	req := vmm.VmRemoveDevice{Id: ptr(pciDeviceID(sysfsPath))}
+	resp, err := c.client.PutVmRemoveDeviceWithResponse(ctx, req)
+	if err != nil {
+		return fmt.Errorf("remove device: %w", err)
+	}
+	if resp.StatusCode() != 204 {
+		return fmt.Errorf("remove device failed with status %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
@@ -1,10 +1,20 @@
 package cloudhypervisor
 
 import (
+	"path/filepath"
+	"strings"
+
 	"github.com/onkernel/hypeman/lib/hypervisor"
 	"github.com/onkernel/hypeman/lib/vmm"
 )
 
+// numaMemoryZoneID names the memory zone used to bind guest memory to a host NUMA node
+const numaMemoryZoneID = "mem0"
+
+// secondaryBusDisks is how many disks fit on PCI segment 1, which holds
+// nothing else: 32 device slots less the host bridge in slot 0
+const secondaryBusDisks = 31
+
 // ToVMConfig converts hypervisor.VMConfig to Cloud Hypervisor's vmm.VmConfig.
 func ToVMConfig(cfg hypervisor.VMConfig) vmm.VmConfig {
 	// Payload configuration (kernel + initramfs)
@@ -30,6 +40,15 @@ func ToVMConfig(cfg hypervisor.VMConfig) vmm.VmConfig {
 		}
 	}
 
+	// Pin every vCPU to the host CPUs of the chosen NUMA node
+	if len(cfg.CPUAffinity) > 0 {
+		affinity := make([]vmm.CpuAffinity, cfg.VCPUs)
+		for i := range affinity {
+			affinity[i] = vmm.CpuAffinity{Vcpu: i, HostCpus: cfg.CPUAffinity}
+		}
+		cpus.Affinity = &affinity
+	}
+
 	// Memory configuration
 	memory := vmm.MemoryConfig{
 		Size: cfg.MemoryBytes,
@@ -39,29 +58,37 @@ func ToVMConfig(cfg hypervisor.VMConfig) vmm.VmConfig {
 		memory.HotplugMethod = ptr("VirtioMem")
 	}
 
+	// Bind guest memory to a host NUMA node via a single memory zone.
+	// Resizing zoned memory needs the per-zone resize API, so binding is
+	// only applied when memory hotplug is off.
+	var numa *[]vmm.NumaConfig
+	if cfg.NUMANode != nil && cfg.HotplugBytes == 0 {
+		memory.Size = 0
+		memory.Zones = &[]vmm.MemoryZoneConfig{{
+			Id:           numaMemoryZoneID,
+			Size:         cfg.MemoryBytes,
+			HostNumaNode: ptr(int32(*cfg.NUMANode)),
+		}}
+		vcpuIDs := make([]int32, cfg.VCPUs)
+		for i := range vcpuIDs {
+			vcpuIDs[i] = int32(i)
+		}
+		numa = &[]vmm.NumaConfig{{
+			GuestNumaId: 0,
+			Cpus:        &vcpuIDs,
+			MemoryZones: &[]string{numaMemoryZoneID},
+		}}
+	}
+
 	// Disk configuration
+	// Disks past the first bus go on PCI segment 1
 	disks := make([]vmm.DiskConfig, 0, len(cfg.Disks))
-	for _, d := range cfg.Disks {
-		disk := vmm.DiskConfig{
-			Path: ptr(d.Path),
-		}
-		if d.Readonly {
-			disk.Readonly = ptr(true)
-		}
-		if d.IOBps > 0 {
-			// Token bucket: Size is refilled every RefillTime ms
-			// Rate = Size / RefillTime * 1000 = Size bytes/sec (when RefillTime = 1000)
-			burstBps := d.IOBurstBps
-			if burstBps <= 0 {
-				burstBps = d.IOBps
-			}
-			disk.RateLimiterConfig = &vmm.RateLimiterConfig{
-				Bandwidth: &vmm.TokenBucket{
-					Size:         d.IOBps,                 // sustained rate (bytes/sec with 1s refill)
-					RefillTime:   1000,                    // refill over 1 second
-					OneTimeBurst: ptr(burstBps - d.IOBps), // extra burst capacity
-				},
-			}
+	var platform *vmm.PlatformConfig
+	for i, d := range cfg.Disks {
+		disk := toDiskConfig(d)
+		if i >= hypervisor.PrimaryBusDisks {
+			disk.PciSegment = ptr(int16(1))
+			platform = &vmm.PlatformConfig{NumPciSegments: ptr(int16(2))}
 		}
 		disks = append(disks, disk)
 	}
@@ -72,10 +99,16 @@ func ToVMConfig(cfg hypervisor.VMConfig) vmm.VmConfig {
 		File: ptr(cfg.SerialLogPath),
 	}
 
-	// Console off (we use serial)
+	// Virtio console for the application's output, if asked for
 	console := vmm.ConsoleConfig{
 		Mode: vmm.ConsoleConfigMode("Off"),
 	}
+	if cfg.ConsoleLogPath != "" {
+		console = vmm.ConsoleConfig{
+			Mode: vmm.ConsoleConfigMode("File"),
+			File: ptr(cfg.ConsoleLogPath),
+		}
+	}
 
 	// Network configuration
 	var nets *[]vmm.NetConfig
@@ -107,6 +140,7 @@ func ToVMConfig(cfg hypervisor.VMConfig) vmm.VmConfig {
 		deviceConfigs := make([]vmm.DeviceConfig, 0, len(cfg.PCIDevices))
 		for _, path := range cfg.PCIDevices {
 			deviceConfigs = append(deviceConfigs, vmm.DeviceConfig{
+				Id:   ptr(pciDeviceID(path)),
 				Path: path,
 			})
 		}
@@ -114,14 +148,58 @@ func ToVMConfig(cfg hypervisor.VMConfig) vmm.VmConfig {
 	}
 
 	return vmm.VmConfig{
-		Payload: payload,
-		Cpus:    &cpus,
-		Memory:  &memory,
-		Disks:   &disks,
-		Serial:  &serial,
-		Console: &console,
-		Net:     nets,
-		Vsock:   vsock,
-		Devices: devices,
+		Payload:  payload,
+		Cpus:     &cpus,
+		Memory:   &memory,
+		Disks:    &disks,
+		Serial:   &serial,
+		Console:  &console,
+		Net:      nets,
+		Vsock:    vsock,
+		Devices:  devices,
+		Numa:     numa,
+		Platform: platform,
+	}
+}
+
+// toDiskConfig converts a disk for boot or hotplug. A disk with a serial
+// also uses it as its device ID, so it can be hot-unplugged by serial.
+// Cloud Hypervisor detects qcow2 images from their header, so the disk's
+// Format needs no setting here, and it punches holes for guest discards on
+// every writable disk, so Discard needs none either.
+func toDiskConfig(d hypervisor.DiskConfig) vmm.DiskConfig {
+	disk := vmm.DiskConfig{
+		Path: ptr(d.Path),
+	}
+	if d.Readonly {
+		disk.Readonly = ptr(true)
+	}
+	if d.Serial != "" {
+		disk.Serial = ptr(d.Serial)
+		disk.Id = ptr(d.Serial)
 	}
+	if d.IOBps > 0 {
+		// Token bucket: Size is refilled every RefillTime ms
+		// Rate = Size / RefillTime * 1000 = Size bytes/sec (when RefillTime = 1000)
+		burstBps := d.IOBurstBps
+		if burstBps <= 0 {
+			burstBps = d.IOBps
+		}
+		disk.RateLimiterConfig = &vmm.RateLimiterConfig{
+			Bandwidth: &vmm.TokenBucket{
+				Size:         d.IOBps,                 // sustained rate (bytes/sec with 1s refill)
+				RefillTime:   1000,                    // refill over 1 second
+				OneTimeBurst: ptr(burstBps - d.IOBps), // extra burst capacity
+			},
+		}
+	}
+	return disk
+}
+
+// pciDeviceID derives a stable Cloud Hypervisor device ID from a passthrough
+// device's sysfs path, so devices added at boot can later be removed by path.
+// "/sys/bus/pci/devices/0000:a2:00.0/" -> "vfio-0000_a2_00_0"
+func pciDeviceID(sysfsPath string) string {
+	addr := filepath.Base(strings.TrimSuffix(sysfsPath, "/"))
+	return "vfio-" + strings.NewReplacer(":", "_", ".", "_").Replace(addr)
 }
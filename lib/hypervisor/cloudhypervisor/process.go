@@ -15,6 +15,7 @@ import (
 
 func init() {
 	hypervisor.RegisterSocketName(hypervisor.TypeCloudHypervisor, "ch.sock")
+	hypervisor.RegisterCapabilities(hypervisor.TypeCloudHypervisor, capabilities)
 }
 
 // Starter implements hypervisor.VMStarter for Cloud Hypervisor.
@@ -9,6 +9,10 @@ type VMConfig struct {
 	HotplugBytes int64
 	Topology     *CPUTopology
 
+	// Host NUMA placement (zero values = no binding)
+	NUMANode    *int  // Host NUMA node to allocate guest memory from
+	CPUAffinity []int // Host CPUs the vCPUs are pinned to
+
 	// Storage
 	Disks []DiskConfig
 
@@ -16,7 +20,8 @@ type VMConfig struct {
 	Networks []NetworkConfig
 
 	// Console
-	SerialLogPath string
+	SerialLogPath  string // Serial console output: kernel and init logs
+	ConsoleLogPath string // Virtio console output: the application's (empty = no virtio console)
 
 	// Vsock
 	VsockCID    int64
@@ -39,12 +44,30 @@ type CPUTopology struct {
 	Packages       int
 }
 
+// PrimaryBusDisks is how many disks fit on the VM's first PCI bus, next to
+// its other devices. They show up in the guest as /dev/vda to /dev/vdz in
+// the order they are configured. Hypervisors whose Capabilities.MaxDisks is
+// higher put the rest on a second bus, where the guest's probe order - and
+// so the device name - is not guaranteed; those disks must be found by Serial.
+const PrimaryBusDisks = 26
+
+// DiskFormat is the image format of a disk file
+type DiskFormat string
+
+const (
+	DiskFormatRaw   DiskFormat = "raw"
+	DiskFormatQcow2 DiskFormat = "qcow2"
+)
+
 // DiskConfig represents a disk attached to the VM
 type DiskConfig struct {
 	Path       string
 	Readonly   bool
-	IOBps      int64 // Sustained I/O rate limit in bytes/sec (0 = unlimited)
-	IOBurstBps int64 // Burst I/O rate in bytes/sec (0 = same as IOBps)
+	IOBps      int64      // Sustained I/O rate limit in bytes/sec (0 = unlimited)
+	IOBurstBps int64      // Burst I/O rate in bytes/sec (0 = same as IOBps)
+	Serial     string     // Serial number visible to the guest (max 20 bytes, empty = none)
+	Format     DiskFormat // Image format (empty = raw)
+	Discard    bool       // Pass guest discards through, freeing the file's blocks
 }
 
 // NetworkConfig represents a network interface attached to the VM
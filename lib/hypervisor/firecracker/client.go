@@ -0,0 +1,100 @@
+package firecracker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+// apiTimeout bounds individual requests to the Firecracker API socket
+const apiTimeout = 30 * time.Second
+
+// Client is a minimal JSON client for the Firecracker API served on a Unix socket.
+type Client struct {
+	http *http.Client
+}
+
+// NewClient creates a client that talks to the Firecracker API at socketPath.
+func NewClient(socketPath string) *Client {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socketPath)
+		},
+	}
+	return &Client{
+		http: &http.Client{Transport: transport, Timeout: apiTimeout},
+	}
+}
+
+// apiError is the error body returned by Firecracker for non-2xx responses
+type apiError struct {
+	FaultMessage string `json:"fault_message"`
+}
+
+// do sends a request with an optional JSON body and decodes the JSON response into out (if non-nil)
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal %s %s: %w", method, path, err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, "http://localhost"+path, reader)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr apiError
+		if json.Unmarshal(respBody, &apiErr) == nil && apiErr.FaultMessage != "" {
+			return fmt.Errorf("%s %s failed with status %d: %s", method, path, resp.StatusCode, apiErr.FaultMessage)
+		}
+		return fmt.Errorf("%s %s failed with status %d: %s", method, path, resp.StatusCode, string(respBody))
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("decode %s %s response: %w", method, path, err)
+		}
+	}
+	return nil
+}
+
+// Put sends a PUT request with a JSON body.
+func (c *Client) Put(ctx context.Context, path string, body any) error {
+	return c.do(ctx, http.MethodPut, path, body, nil)
+}
+
+// Patch sends a PATCH request with a JSON body.
+func (c *Client) Patch(ctx context.Context, path string, body any) error {
+	return c.do(ctx, http.MethodPatch, path, body, nil)
+}
+
+// Get sends a GET request and decodes the JSON response into out.
+func (c *Client) Get(ctx context.Context, path string, out any) error {
+	return c.do(ctx, http.MethodGet, path, nil, out)
+}
@@ -0,0 +1,140 @@
+package firecracker
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/onkernel/hypeman/lib/hypervisor"
+)
+
+// extraKernelArgs makes a guest reboot exit the Firecracker process and a
+// kernel panic reboot immediately, since Firecracker has no ACPI power-off.
+const extraKernelArgs = "reboot=k panic=1"
+
+// BootSource is the body of PUT /boot-source.
+type BootSource struct {
+	KernelImagePath string `json:"kernel_image_path"`
+	InitrdPath      string `json:"initrd_path,omitempty"`
+	BootArgs        string `json:"boot_args,omitempty"`
+}
+
+// MachineConfig is the body of PUT /machine-config.
+type MachineConfig struct {
+	VcpuCount  int   `json:"vcpu_count"`
+	MemSizeMib int64 `json:"mem_size_mib"`
+	Smt        bool  `json:"smt"`
+}
+
+// TokenBucket limits a resource to Size units per RefillTime milliseconds.
+type TokenBucket struct {
+	Size         int64 `json:"size"`
+	OneTimeBurst int64 `json:"one_time_burst,omitempty"`
+	RefillTime   int64 `json:"refill_time"`
+}
+
+// RateLimiter throttles a drive or network interface.
+type RateLimiter struct {
+	Bandwidth *TokenBucket `json:"bandwidth,omitempty"`
+}
+
+// Drive is the body of PUT /drives/{drive_id}.
+type Drive struct {
+	DriveID      string       `json:"drive_id"`
+	PathOnHost   string       `json:"path_on_host"`
+	IsRootDevice bool         `json:"is_root_device"`
+	IsReadOnly   bool         `json:"is_read_only"`
+	RateLimiter  *RateLimiter `json:"rate_limiter,omitempty"`
+}
+
+// NetworkInterface is the body of PUT /network-interfaces/{iface_id}.
+type NetworkInterface struct {
+	IfaceID     string `json:"iface_id"`
+	HostDevName string `json:"host_dev_name"`
+	GuestMAC    string `json:"guest_mac,omitempty"`
+}
+
+// Vsock is the body of PUT /vsock.
+type Vsock struct {
+	GuestCID int64  `json:"guest_cid"`
+	UdsPath  string `json:"uds_path"`
+}
+
+// VMConfig is the full set of pre-boot API requests for a Firecracker VM.
+type VMConfig struct {
+	BootSource        BootSource
+	MachineConfig     MachineConfig
+	Drives            []Drive
+	NetworkInterfaces []NetworkInterface
+	Vsock             *Vsock
+}
+
+// ToVMConfig converts hypervisor.VMConfig to Firecracker API requests.
+// Returns an error for settings Firecracker cannot honor, such as PCI passthrough.
+func ToVMConfig(cfg hypervisor.VMConfig) (VMConfig, error) {
+	if len(cfg.PCIDevices) > 0 {
+		return VMConfig{}, fmt.Errorf("firecracker does not support PCI device passthrough")
+	}
+
+	bootArgs := extraKernelArgs
+	if cfg.KernelArgs != "" {
+		bootArgs = cfg.KernelArgs + " " + extraKernelArgs
+	}
+
+	out := VMConfig{
+		BootSource: BootSource{
+			KernelImagePath: cfg.KernelPath,
+			InitrdPath:      cfg.InitrdPath,
+			BootArgs:        bootArgs,
+		},
+		MachineConfig: MachineConfig{
+			VcpuCount:  cfg.VCPUs,
+			MemSizeMib: cfg.MemoryBytes / (1024 * 1024),
+			Smt:        useSMT(cfg),
+		},
+	}
+
+	for i, disk := range cfg.Disks {
+		out.Drives = append(out.Drives, Drive{
+			DriveID:     fmt.Sprintf("disk%d", i),
+			PathOnHost:  disk.Path,
+			IsReadOnly:  disk.Readonly,
+			RateLimiter: diskRateLimiter(disk),
+		})
+	}
+
+	for i, n := range cfg.Networks {
+		out.NetworkInterfaces = append(out.NetworkInterfaces, NetworkInterface{
+			IfaceID:     fmt.Sprintf("net%d", i),
+			HostDevName: n.TAPDevice,
+			GuestMAC:    n.MAC,
+		})
+	}
+
+	if cfg.VsockSocket != "" && cfg.VsockCID > 0 {
+		out.Vsock = &Vsock{GuestCID: cfg.VsockCID, UdsPath: cfg.VsockSocket}
+	}
+
+	return out, nil
+}
+
+// diskRateLimiter returns a per-second bandwidth limiter for the disk, or nil if unlimited.
+// The burst above the sustained rate is granted once as Firecracker's one-time burst.
+func diskRateLimiter(disk hypervisor.DiskConfig) *RateLimiter {
+	if disk.IOBps <= 0 {
+		return nil
+	}
+	bucket := &TokenBucket{Size: disk.IOBps, RefillTime: 1000}
+	if disk.IOBurstBps > disk.IOBps {
+		bucket.OneTimeBurst = disk.IOBurstBps - disk.IOBps
+	}
+	return &RateLimiter{Bandwidth: bucket}
+}
+
+// useSMT reports whether to expose hyperthreads to the guest.
+// Firecracker only supports SMT on x86_64 and requires an even vCPU count.
+func useSMT(cfg hypervisor.VMConfig) bool {
+	if runtime.GOARCH != "amd64" || cfg.Topology == nil {
+		return false
+	}
+	return cfg.Topology.ThreadsPerCore > 1 && cfg.VCPUs%2 == 0
+}
@@ -0,0 +1,90 @@
+package firecracker
+
+import (
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/hypervisor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToVMConfig_Basic(t *testing.T) {
+	cfg := hypervisor.VMConfig{
+		VCPUs:       2,
+		MemoryBytes: 1024 * 1024 * 1024, // 1GB
+		KernelPath:  "/path/to/vmlinux",
+		InitrdPath:  "/path/to/initrd",
+		KernelArgs:  "console=ttyS0",
+	}
+
+	out, err := ToVMConfig(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/path/to/vmlinux", out.BootSource.KernelImagePath)
+	assert.Equal(t, "/path/to/initrd", out.BootSource.InitrdPath)
+	assert.Equal(t, "console=ttyS0 reboot=k panic=1", out.BootSource.BootArgs)
+	assert.Equal(t, 2, out.MachineConfig.VcpuCount)
+	assert.Equal(t, int64(1024), out.MachineConfig.MemSizeMib)
+	assert.Nil(t, out.Vsock)
+}
+
+func TestToVMConfig_Devices(t *testing.T) {
+	cfg := hypervisor.VMConfig{
+		VCPUs:       1,
+		MemoryBytes: 512 * 1024 * 1024,
+		Disks: []hypervisor.DiskConfig{
+			{Path: "/path/to/rootfs.ext4", Readonly: true},
+			{Path: "/path/to/overlay.raw", IOBps: 100 << 20, IOBurstBps: 400 << 20},
+		},
+		Networks: []hypervisor.NetworkConfig{
+			{TAPDevice: "hype-abc123", MAC: "02:00:00:ab:cd:ef"},
+		},
+		VsockCID:    42,
+		VsockSocket: "/data/guests/abc/vsock.sock",
+	}
+
+	out, err := ToVMConfig(cfg)
+	require.NoError(t, err)
+
+	require.Len(t, out.Drives, 2)
+	assert.Equal(t, Drive{DriveID: "disk0", PathOnHost: "/path/to/rootfs.ext4", IsReadOnly: true}, out.Drives[0])
+	assert.Equal(t, "disk1", out.Drives[1].DriveID)
+	assert.False(t, out.Drives[1].IsReadOnly)
+	require.NotNil(t, out.Drives[1].RateLimiter)
+	assert.Equal(t, &TokenBucket{Size: 100 << 20, OneTimeBurst: 300 << 20, RefillTime: 1000}, out.Drives[1].RateLimiter.Bandwidth)
+
+	require.Len(t, out.NetworkInterfaces, 1)
+	assert.Equal(t, NetworkInterface{IfaceID: "net0", HostDevName: "hype-abc123", GuestMAC: "02:00:00:ab:cd:ef"}, out.NetworkInterfaces[0])
+
+	assert.Equal(t, &Vsock{GuestCID: 42, UdsPath: "/data/guests/abc/vsock.sock"}, out.Vsock)
+}
+
+func TestToVMConfig_RejectsPCIPassthrough(t *testing.T) {
+	_, err := ToVMConfig(hypervisor.VMConfig{
+		VCPUs:      1,
+		PCIDevices: []string{"/sys/bus/pci/devices/0000:01:00.0"},
+	})
+	assert.Error(t, err)
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		output   string
+		expected string
+		wantErr  bool
+	}{
+		{"Firecracker v1.7.0\n\nSupported snapshot data format versions: v1.0.0", "1.7.0", false},
+		{"Firecracker v1.10", "1.10", false},
+		{"something else", "", true},
+	}
+
+	for _, tt := range tests {
+		version, err := parseVersion(tt.output)
+		if tt.wantErr {
+			assert.Error(t, err, tt.output)
+			continue
+		}
+		require.NoError(t, err, tt.output)
+		assert.Equal(t, tt.expected, version)
+	}
+}
@@ -0,0 +1,204 @@
+// Package firecracker implements the hypervisor.Hypervisor interface for Firecracker.
+package firecracker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/hypervisor"
+)
+
+// Snapshot file names within a snapshot directory
+const (
+	snapshotStateFile  = "snapshot"
+	snapshotMemoryFile = "memory"
+)
+
+// Firecracker implements hypervisor.Hypervisor for the Firecracker VMM.
+type Firecracker struct {
+	client     *Client
+	socketPath string
+}
+
+// New creates a new Firecracker client for an existing API socket.
+func New(socketPath string) (*Firecracker, error) {
+	return &Firecracker{
+		client:     NewClient(socketPath),
+		socketPath: socketPath,
+	}, nil
+}
+
+// Verify Firecracker implements the interface
+var _ hypervisor.Hypervisor = (*Firecracker)(nil)
+
+// capabilities lists the features supported by Firecracker.
+var capabilities = hypervisor.Capabilities{
+	SupportsSnapshot:       true,  // Uses Firecracker's snapshot/create and snapshot/load API
+	SupportsHotplugMemory:  false, // Firecracker only offers a balloon device, not hotplug
+	SupportsPause:          true,
+	SupportsVsock:          true,
+	SupportsGPUPassthrough: false, // No PCI passthrough
+	SupportsDiskIOLimit:    true,  // Per-drive token bucket rate limiter
+	SupportsHotplugDevice:  false, // No PCI passthrough
+	SupportsDiskSerial:     false, // Drives report an ID derived from the backing file
+	SupportsHotplugDisk:    false, // Drives are fixed at boot
+	SupportsQcow2:          false, // Drives are raw block devices
+	SupportsConsole:        false, // Serial console only
+	// Without serials disks are only found by name, so only the first bus's worth
+	MaxDisks: hypervisor.PrimaryBusDisks,
+}
+
+// Capabilities returns the features supported by Firecracker.
+func (f *Firecracker) Capabilities() hypervisor.Capabilities {
+	return capabilities
+}
+
+// instanceInfo is the response of GET /
+type instanceInfo struct {
+	State string `json:"state"`
+}
+
+// DeleteVM asks the guest to shut down.
+// Firecracker has no ACPI, so this sends Ctrl+Alt+Del, which is only available on x86_64.
+func (f *Firecracker) DeleteVM(ctx context.Context) error {
+	if err := f.client.Put(ctx, "/actions", map[string]string{"action_type": "SendCtrlAltDel"}); err != nil {
+		return fmt.Errorf("send ctrl-alt-del: %w", err)
+	}
+	return nil
+}
+
+// Shutdown stops the Firecracker process.
+// Firecracker has no API call to exit the VMM, so the process serving the
+// API socket is identified via SO_PEERCRED and sent SIGTERM.
+func (f *Firecracker) Shutdown(ctx context.Context) error {
+	pid, err := socketPeerPID(f.socketPath)
+	if err != nil {
+		return fmt.Errorf("find firecracker process: %w", err)
+	}
+	if err := syscall.Kill(pid, syscall.SIGTERM); err != nil {
+		return fmt.Errorf("signal firecracker process %d: %w", pid, err)
+	}
+	return nil
+}
+
+// GetVMInfo returns current VM state.
+func (f *Firecracker) GetVMInfo(ctx context.Context) (*hypervisor.VMInfo, error) {
+	var info instanceInfo
+	if err := f.client.Get(ctx, "/", &info); err != nil {
+		return nil, fmt.Errorf("get vm info: %w", err)
+	}
+
+	var state hypervisor.VMState
+	switch info.State {
+	case "Not started":
+		state = hypervisor.StateCreated
+	case "Running":
+		state = hypervisor.StateRunning
+	case "Paused":
+		state = hypervisor.StatePaused
+	default:
+		return nil, fmt.Errorf("unknown vm state: %s", info.State)
+	}
+
+	return &hypervisor.VMInfo{State: state}, nil
+}
+
+// Pause suspends VM execution.
+func (f *Firecracker) Pause(ctx context.Context) error {
+	if err := f.client.Patch(ctx, "/vm", map[string]string{"state": "Paused"}); err != nil {
+		return fmt.Errorf("pause vm: %w", err)
+	}
+	return nil
+}
+
+// Resume continues VM execution.
+func (f *Firecracker) Resume(ctx context.Context) error {
+	if err := f.client.Patch(ctx, "/vm", map[string]string{"state": "Resumed"}); err != nil {
+		return fmt.Errorf("resume vm: %w", err)
+	}
+	return nil
+}
+
+// Snapshot creates a full VM snapshot in destPath. The VM must be paused.
+// The VM config is copied alongside so restore can recreate the vsock socket path.
+func (f *Firecracker) Snapshot(ctx context.Context, destPath string) error {
+	req := map[string]string{
+		"snapshot_type": "Full",
+		"snapshot_path": filepath.Join(destPath, snapshotStateFile),
+		"mem_file_path": filepath.Join(destPath, snapshotMemoryFile),
+	}
+	if err := f.client.Put(ctx, "/snapshot/create", req); err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+
+	instanceDir := filepath.Dir(f.socketPath)
+	configData, err := os.ReadFile(filepath.Join(instanceDir, vmConfigFile))
+	if err != nil {
+		return fmt.Errorf("read vm config for snapshot: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(destPath, vmConfigFile), configData, 0644); err != nil {
+		return fmt.Errorf("write vm config to snapshot: %w", err)
+	}
+	return nil
+}
+
+// ResizeMemory is not supported by Firecracker.
+func (f *Firecracker) ResizeMemory(ctx context.Context, bytes int64) error {
+	return fmt.Errorf("memory resize not supported by Firecracker")
+}
+
+// ResizeMemoryAndWait is not supported by Firecracker.
+func (f *Firecracker) ResizeMemoryAndWait(ctx context.Context, bytes int64, timeout time.Duration) error {
+	return fmt.Errorf("memory resize not supported by Firecracker")
+}
+
+// AddPCIDevice is not supported by Firecracker.
+func (f *Firecracker) AddPCIDevice(ctx context.Context, sysfsPath string) error {
+	return fmt.Errorf("device hotplug not supported by Firecracker")
+}
+
+// RemovePCIDevice is not supported by Firecracker.
+func (f *Firecracker) RemovePCIDevice(ctx context.Context, sysfsPath string) error {
+	return fmt.Errorf("device hotplug not supported by Firecracker")
+}
+
+// AddDisk is not supported by Firecracker.
+func (f *Firecracker) AddDisk(ctx context.Context, disk hypervisor.DiskConfig) error {
+	return fmt.Errorf("disk hotplug not supported by Firecracker")
+}
+
+// RemoveDisk is not supported by Firecracker.
+func (f *Firecracker) RemoveDisk(ctx context.Context, serial string) error {
+	return fmt.Errorf("disk hotplug not supported by Firecracker")
+}
+
+// socketPeerPID returns the PID of the process listening on a Unix socket
+func socketPeerPID(socketPath string) (int, error) {
+	conn, err := net.DialTimeout("unix", socketPath, socketDialTimeout)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	raw, err := conn.(*net.UnixConn).SyscallConn()
+	if err != nil {
+		return 0, err
+	}
+
+	var cred *syscall.Ucred
+	var credErr error
+	if err := raw.Control(func(fd uintptr) {
+		cred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	}); err != nil {
+		return 0, err
+	}
+	if credErr != nil {
+		return 0, credErr
+	}
+	return int(cred.Pid), nil
+}
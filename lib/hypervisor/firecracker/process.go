@@ -0,0 +1,360 @@
+package firecracker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"syscall"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/hypervisor"
+	"github.com/onkernel/hypeman/lib/logger"
+	"github.com/onkernel/hypeman/lib/paths"
+	"gvisor.dev/gvisor/pkg/cleanup"
+)
+
+// Timeout constants for Firecracker operations
+const (
+	// socketWaitTimeout is how long to wait for the API socket after process start
+	socketWaitTimeout = 10 * time.Second
+
+	// socketPollInterval is how often to check if socket is ready
+	socketPollInterval = 50 * time.Millisecond
+
+	// socketDialTimeout is timeout for individual socket connection attempts
+	socketDialTimeout = 100 * time.Millisecond
+)
+
+// binaryName is the name of the Firecracker binary on the host
+const binaryName = "firecracker"
+
+func init() {
+	hypervisor.RegisterSocketName(hypervisor.TypeFirecracker, "fc.sock")
+	hypervisor.RegisterCapabilities(hypervisor.TypeFirecracker, capabilities)
+}
+
+// Starter implements hypervisor.VMStarter for Firecracker.
+type Starter struct{}
+
+// NewStarter creates a new Firecracker starter.
+func NewStarter() *Starter {
+	return &Starter{}
+}
+
+// Verify Starter implements the interface
+var _ hypervisor.VMStarter = (*Starter)(nil)
+
+// SocketName returns the socket filename for Firecracker.
+func (s *Starter) SocketName() string {
+	return "fc.sock"
+}
+
+// GetBinaryPath returns the path to the Firecracker binary.
+// Firecracker is expected to be installed on the system.
+func (s *Starter) GetBinaryPath(p *paths.Paths, version string) (string, error) {
+	candidates := []string{
+		"/usr/bin/" + binaryName,
+		"/usr/local/bin/" + binaryName,
+	}
+
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	if path, err := exec.LookPath(binaryName); err == nil {
+		return path, nil
+	}
+
+	return "", fmt.Errorf("%s not found; download a release from https://github.com/firecracker-microvm/firecracker/releases", binaryName)
+}
+
+// GetVersion returns the version of the installed Firecracker binary.
+// Parses the output of "firecracker --version" to extract the version string.
+func (s *Starter) GetVersion(p *paths.Paths) (string, error) {
+	binaryPath, err := s.GetBinaryPath(p, "")
+	if err != nil {
+		return "", err
+	}
+
+	output, err := exec.Command(binaryPath, "--version").Output()
+	if err != nil {
+		return "", fmt.Errorf("get firecracker version: %w", err)
+	}
+
+	return parseVersion(string(output))
+}
+
+// parseVersion extracts the version from "Firecracker v1.7.0" -> "1.7.0"
+func parseVersion(output string) (string, error) {
+	re := regexp.MustCompile(`Firecracker v(\d+\.\d+(?:\.\d+)?)`)
+	matches := re.FindStringSubmatch(output)
+	if len(matches) >= 2 {
+		return matches[1], nil
+	}
+	return "", fmt.Errorf("could not parse Firecracker version from: %s", output)
+}
+
+// startProcess launches Firecracker serving its API on socketPath.
+// Guest serial output goes to serialLogPath; VMM logs go to logs/vmm.log.
+// Returns the PID, hypervisor client, and a cleanup function.
+// The cleanup function must be called on error; call cleanup.Release() on success.
+func (s *Starter) startProcess(ctx context.Context, p *paths.Paths, socketPath string, serialLogPath string) (int, *Firecracker, *cleanup.Cleanup, error) {
+	log := logger.FromContext(ctx)
+
+	binaryPath, err := s.GetBinaryPath(p, "")
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("get binary: %w", err)
+	}
+
+	// Check if socket is already in use
+	if isSocketInUse(socketPath) {
+		return 0, nil, nil, fmt.Errorf("socket already in use, Firecracker may be running at %s", socketPath)
+	}
+
+	// Firecracker refuses to start if the API socket already exists
+	os.Remove(socketPath)
+
+	instanceDir := filepath.Dir(socketPath)
+	logsDir := filepath.Join(instanceDir, "logs")
+	if err := os.MkdirAll(logsDir, 0755); err != nil {
+		return 0, nil, nil, fmt.Errorf("create logs directory: %w", err)
+	}
+
+	// Firecracker expects --log-path to exist already
+	vmmLogPath := filepath.Join(logsDir, "vmm.log")
+	vmmLogFile, err := os.OpenFile(vmmLogPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("create vmm log: %w", err)
+	}
+	defer vmmLogFile.Close()
+
+	// The guest serial console is wired to Firecracker's stdout
+	serialOut := vmmLogFile
+	if serialLogPath != "" {
+		serialFile, err := os.OpenFile(serialLogPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return 0, nil, nil, fmt.Errorf("create serial log: %w", err)
+		}
+		defer serialFile.Close()
+		serialOut = serialFile
+	}
+
+	cmd := exec.Command(binaryPath,
+		"--api-sock", socketPath,
+		"--log-path", vmmLogPath,
+		"--level", "Info",
+	)
+
+	// Daemonize: detach from parent process group
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid: true,
+	}
+	cmd.Stdout = serialOut
+	cmd.Stderr = vmmLogFile
+
+	processStartTime := time.Now()
+	if err := cmd.Start(); err != nil {
+		return 0, nil, nil, fmt.Errorf("start firecracker: %w", err)
+	}
+
+	pid := cmd.Process.Pid
+	log.DebugContext(ctx, "Firecracker process started", "pid", pid, "duration_ms", time.Since(processStartTime).Milliseconds())
+
+	// Setup cleanup to kill the process if subsequent steps fail
+	cu := cleanup.Make(func() {
+		syscall.Kill(pid, syscall.SIGKILL)
+	})
+
+	if err := waitForSocket(socketPath, socketWaitTimeout); err != nil {
+		cu.Clean()
+		if logData, readErr := os.ReadFile(vmmLogPath); readErr == nil && len(logData) > 0 {
+			return 0, nil, nil, fmt.Errorf("%w; vmm.log: %s", err, string(logData))
+		}
+		return 0, nil, nil, err
+	}
+
+	hv, err := New(socketPath)
+	if err != nil {
+		cu.Clean()
+		return 0, nil, nil, fmt.Errorf("create client: %w", err)
+	}
+
+	return pid, hv, &cu, nil
+}
+
+// StartVM launches Firecracker, configures the VM via the API, and boots it.
+// Returns the process ID and a Hypervisor client for subsequent operations.
+func (s *Starter) StartVM(ctx context.Context, p *paths.Paths, version string, socketPath string, config hypervisor.VMConfig) (int, hypervisor.Hypervisor, error) {
+	log := logger.FromContext(ctx)
+
+	fcConfig, err := ToVMConfig(config)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Firecracker binds the vsock socket itself and fails if it already exists
+	if config.VsockSocket != "" {
+		os.Remove(config.VsockSocket)
+	}
+
+	// 1. Start the Firecracker process
+	pid, hv, cu, err := s.startProcess(ctx, p, socketPath, config.SerialLogPath)
+	if err != nil {
+		return 0, nil, fmt.Errorf("start process: %w", err)
+	}
+	defer cu.Clean()
+
+	// 2. Configure the VM via the API
+	if err := configureVM(ctx, hv.client, fcConfig); err != nil {
+		return 0, nil, err
+	}
+
+	// Save config for restore: snapshots do not record where the serial log
+	// and vsock socket live on the host
+	instanceDir := filepath.Dir(socketPath)
+	if err := saveVMConfig(instanceDir, config); err != nil {
+		// Non-fatal - restore just won't work
+		log.WarnContext(ctx, "failed to save VM config for restore", "error", err)
+	}
+
+	// 3. Boot the VM
+	if err := hv.client.Put(ctx, "/actions", map[string]string{"action_type": "InstanceStart"}); err != nil {
+		return 0, nil, fmt.Errorf("boot vm: %w", err)
+	}
+
+	cu.Release()
+	return pid, hv, nil
+}
+
+// configureVM sends the pre-boot configuration requests
+func configureVM(ctx context.Context, client *Client, cfg VMConfig) error {
+	if err := client.Put(ctx, "/boot-source", cfg.BootSource); err != nil {
+		return fmt.Errorf("configure boot source: %w", err)
+	}
+	if err := client.Put(ctx, "/machine-config", cfg.MachineConfig); err != nil {
+		return fmt.Errorf("configure machine: %w", err)
+	}
+	for _, drive := range cfg.Drives {
+		if err := client.Put(ctx, "/drives/"+drive.DriveID, drive); err != nil {
+			return fmt.Errorf("configure drive %s: %w", drive.DriveID, err)
+		}
+	}
+	for _, iface := range cfg.NetworkInterfaces {
+		if err := client.Put(ctx, "/network-interfaces/"+iface.IfaceID, iface); err != nil {
+			return fmt.Errorf("configure network interface %s: %w", iface.IfaceID, err)
+		}
+	}
+	if cfg.Vsock != nil {
+		if err := client.Put(ctx, "/vsock", cfg.Vsock); err != nil {
+			return fmt.Errorf("configure vsock: %w", err)
+		}
+	}
+	return nil
+}
+
+// RestoreVM starts Firecracker and loads VM state from a snapshot.
+// The VM is in paused state after restore; caller should call Resume() to continue execution.
+func (s *Starter) RestoreVM(ctx context.Context, p *paths.Paths, version string, socketPath string, snapshotPath string) (int, hypervisor.Hypervisor, error) {
+	log := logger.FromContext(ctx)
+	startTime := time.Now()
+
+	config, err := loadVMConfig(snapshotPath)
+	if err != nil {
+		return 0, nil, fmt.Errorf("load vm config from snapshot: %w", err)
+	}
+
+	// The snapshot re-binds the original vsock socket path
+	if config.VsockSocket != "" {
+		os.Remove(config.VsockSocket)
+	}
+
+	// 1. Start the Firecracker process
+	pid, hv, cu, err := s.startProcess(ctx, p, socketPath, config.SerialLogPath)
+	if err != nil {
+		return 0, nil, fmt.Errorf("start process: %w", err)
+	}
+	defer cu.Clean()
+
+	// 2. Load the snapshot, leaving the VM paused
+	loadStart := time.Now()
+	req := map[string]any{
+		"snapshot_path": filepath.Join(snapshotPath, snapshotStateFile),
+		"mem_backend": map[string]string{
+			"backend_type": "File",
+			"backend_path": filepath.Join(snapshotPath, snapshotMemoryFile),
+		},
+		"resume_vm": false,
+	}
+	if err := hv.client.Put(ctx, "/snapshot/load", req); err != nil {
+		return 0, nil, fmt.Errorf("load snapshot: %w", err)
+	}
+	log.DebugContext(ctx, "Firecracker snapshot load complete", "duration_ms", time.Since(loadStart).Milliseconds())
+
+	// Keep the config next to the socket so a later snapshot can copy it again
+	if err := saveVMConfig(filepath.Dir(socketPath), config); err != nil {
+		log.WarnContext(ctx, "failed to save VM config for restore", "error", err)
+	}
+
+	cu.Release()
+	log.DebugContext(ctx, "Firecracker restore complete", "pid", pid, "total_duration_ms", time.Since(startTime).Milliseconds())
+	return pid, hv, nil
+}
+
+// vmConfigFile is the name of the file where VM config is saved for restore.
+const vmConfigFile = "firecracker-config.json"
+
+// saveVMConfig saves the VM configuration to a file in the instance directory.
+func saveVMConfig(instanceDir string, config hypervisor.VMConfig) error {
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal config: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(instanceDir, vmConfigFile), data, 0644); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+	return nil
+}
+
+// loadVMConfig loads the VM configuration from the given directory.
+func loadVMConfig(dir string) (hypervisor.VMConfig, error) {
+	data, err := os.ReadFile(filepath.Join(dir, vmConfigFile))
+	if err != nil {
+		return hypervisor.VMConfig{}, fmt.Errorf("read config: %w", err)
+	}
+	var config hypervisor.VMConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return hypervisor.VMConfig{}, fmt.Errorf("unmarshal config: %w", err)
+	}
+	return config, nil
+}
+
+// isSocketInUse checks if a Unix socket is actively being used
+func isSocketInUse(socketPath string) bool {
+	conn, err := net.DialTimeout("unix", socketPath, socketDialTimeout)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// waitForSocket waits for the API socket to become available
+func waitForSocket(socketPath string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		conn, err := net.DialTimeout("unix", socketPath, socketDialTimeout)
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+		time.Sleep(socketPollInterval)
+	}
+	return fmt.Errorf("timeout waiting for socket")
+}
@@ -0,0 +1,106 @@
+package firecracker
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/hypervisor"
+)
+
+const (
+	// vsockDialTimeout is the timeout for connecting to the vsock Unix socket
+	vsockDialTimeout = 5 * time.Second
+	// vsockHandshakeTimeout is the timeout for the Firecracker vsock handshake
+	vsockHandshakeTimeout = 5 * time.Second
+)
+
+func init() {
+	hypervisor.RegisterVsockDialerFactory(hypervisor.TypeFirecracker, NewVsockDialer)
+}
+
+// VsockDialer implements hypervisor.VsockDialer for Firecracker.
+// Firecracker's hybrid vsock exposes guest ports through the Unix socket given
+// as uds_path, using a text handshake (CONNECT {port}\n / OK {host_port}\n).
+type VsockDialer struct {
+	socketPath string
+}
+
+// NewVsockDialer creates a new VsockDialer for Firecracker.
+// The vsockSocket parameter is the uds_path configured on the vsock device.
+// The vsockCID parameter is unused for host-initiated connections.
+func NewVsockDialer(vsockSocket string, vsockCID int64) hypervisor.VsockDialer {
+	return &VsockDialer{
+		socketPath: vsockSocket,
+	}
+}
+
+// Key returns a unique identifier for this dialer, used for connection pooling.
+func (d *VsockDialer) Key() string {
+	return "fc:" + d.socketPath
+}
+
+// DialVsock connects to the guest on the specified port.
+func (d *VsockDialer) DialVsock(ctx context.Context, port int) (net.Conn, error) {
+	slog.DebugContext(ctx, "connecting to vsock", "socket", d.socketPath, "port", port)
+
+	dialTimeout := vsockDialTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < dialTimeout {
+			dialTimeout = remaining
+		}
+	}
+
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "unix", d.socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("dial vsock socket %s: %w", d.socketPath, err)
+	}
+
+	if err := conn.SetDeadline(time.Now().Add(vsockHandshakeTimeout)); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("set handshake deadline: %w", err)
+	}
+
+	if _, err := fmt.Fprintf(conn, "CONNECT %d\n", port); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("send vsock handshake: %w", err)
+	}
+
+	reader := bufio.NewReader(conn)
+	response, err := reader.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("read vsock handshake response (is guest-agent running in guest?): %w", err)
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("clear deadline: %w", err)
+	}
+
+	response = strings.TrimSpace(response)
+	if !strings.HasPrefix(response, "OK ") {
+		conn.Close()
+		return nil, fmt.Errorf("vsock handshake failed: %s", response)
+	}
+
+	slog.DebugContext(ctx, "vsock handshake successful", "response", response)
+
+	// Keep reading through the bufio.Reader so bytes buffered during the handshake are not lost
+	return &bufferedConn{Conn: conn, reader: reader}, nil
+}
+
+// bufferedConn wraps a net.Conn so reads drain the handshake reader first
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
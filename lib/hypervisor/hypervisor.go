@@ -1,6 +1,6 @@
 // Package hypervisor provides an abstraction layer for virtual machine managers.
 // This allows the instances package to work with different hypervisors
-// (e.g., Cloud Hypervisor, QEMU) through a common interface.
+// (e.g., Cloud Hypervisor, QEMU, Firecracker) through a common interface.
 package hypervisor
 
 import (
@@ -20,6 +20,8 @@ const (
 	TypeCloudHypervisor Type = "cloud-hypervisor"
 	// TypeQEMU is the QEMU VMM
 	TypeQEMU Type = "qemu"
+	// TypeFirecracker is the Firecracker VMM
+	TypeFirecracker Type = "firecracker"
 )
 
 // socketNames maps hypervisor types to their socket filenames.
@@ -103,6 +105,22 @@ type Hypervisor interface {
 	// Check Capabilities().SupportsHotplugMemory before calling.
 	ResizeMemoryAndWait(ctx context.Context, bytes int64, timeout time.Duration) error
 
+	// AddPCIDevice hotplugs a VFIO-bound PCI device, identified by its sysfs path.
+	// Check Capabilities().SupportsHotplugDevice before calling.
+	AddPCIDevice(ctx context.Context, sysfsPath string) error
+
+	// RemovePCIDevice hot-unplugs a PCI device previously passed through by sysfs path.
+	// Check Capabilities().SupportsHotplugDevice before calling.
+	RemovePCIDevice(ctx context.Context, sysfsPath string) error
+
+	// AddDisk hotplugs a disk, which must have a Serial for the guest to find it by.
+	// Check Capabilities().SupportsHotplugDisk before calling.
+	AddDisk(ctx context.Context, disk DiskConfig) error
+
+	// RemoveDisk hot-unplugs a disk, identified by its Serial.
+	// Check Capabilities().SupportsHotplugDisk before calling.
+	RemoveDisk(ctx context.Context, serial string) error
+
 	// Capabilities returns what features this hypervisor supports.
 	Capabilities() Capabilities
 }
@@ -127,6 +145,42 @@ type Capabilities struct {
 
 	// SupportsDiskIOLimit indicates if disk I/O rate limiting is available
 	SupportsDiskIOLimit bool
+
+	// SupportsHotplugDevice indicates if AddPCIDevice/RemovePCIDevice are available
+	SupportsHotplugDevice bool
+
+	// SupportsDiskSerial indicates if DiskConfig.Serial is visible to the guest
+	SupportsDiskSerial bool
+
+	// SupportsHotplugDisk indicates if AddDisk/RemoveDisk are available
+	SupportsHotplugDisk bool
+
+	// SupportsQcow2 indicates if disks can be qcow2 images (DiskConfig.Format)
+	SupportsQcow2 bool
+
+	// SupportsConsole indicates if a virtio console is available next to the
+	// serial console (VMConfig.ConsoleLogPath)
+	SupportsConsole bool
+
+	// MaxDisks is the most disks a VM can be configured with
+	MaxDisks int
+}
+
+// capabilities maps hypervisor types to their static capabilities.
+// Registered by each hypervisor package's init() function.
+var capabilities = make(map[Type]Capabilities)
+
+// RegisterCapabilities registers the capabilities of a hypervisor type.
+// Called by each hypervisor implementation's init() function.
+func RegisterCapabilities(t Type, caps Capabilities) {
+	capabilities[t] = caps
+}
+
+// CapabilitiesForType returns the registered capabilities for a hypervisor type.
+// This allows callers to discover supported features without a running VM.
+func CapabilitiesForType(t Type) (Capabilities, bool) {
+	caps, ok := capabilities[t]
+	return caps, ok
 }
 
 // VsockDialer provides vsock connectivity to a guest VM.
@@ -8,6 +8,13 @@ import (
 	"github.com/onkernel/hypeman/lib/hypervisor"
 )
 
+const (
+	// bridgeID is the PCI bridge that holds the disks past the first bus
+	bridgeID = "pci.1"
+	// bridgeDisks is how many disks fit behind it, in slots 1-31
+	bridgeDisks = 31
+)
+
 // BuildArgs converts hypervisor.VMConfig to QEMU command-line arguments.
 func BuildArgs(cfg hypervisor.VMConfig) []string {
 	args := make([]string, 0, 64)
@@ -23,6 +30,12 @@ func BuildArgs(cfg hypervisor.VMConfig) []string {
 	memMB := cfg.MemoryBytes / (1024 * 1024)
 	args = append(args, "-m", fmt.Sprintf("%dM", memMB))
 
+	// Bind guest memory to a host NUMA node through a single guest NUMA node
+	if cfg.NUMANode != nil {
+		args = append(args, "-object", fmt.Sprintf("memory-backend-ram,id=mem0,size=%dM,host-nodes=%d,policy=bind", memMB, *cfg.NUMANode))
+		args = append(args, "-numa", fmt.Sprintf("node,nodeid=0,cpus=0-%d,memdev=mem0", cfg.VCPUs-1))
+	}
+
 	// Kernel and initrd
 	if cfg.KernelPath != "" {
 		args = append(args, "-kernel", cfg.KernelPath)
@@ -34,12 +47,22 @@ func BuildArgs(cfg hypervisor.VMConfig) []string {
 		args = append(args, "-append", cfg.KernelArgs)
 	}
 
-	// Disk configuration
+	// Disk configuration. Disks past the first bus go behind a PCI bridge.
+	if len(cfg.Disks) > hypervisor.PrimaryBusDisks {
+		args = append(args, "-device", "pci-bridge,id="+bridgeID+",chassis_nr=1")
+	}
 	for i, disk := range cfg.Disks {
-		driveOpts := fmt.Sprintf("file=%s,format=raw,if=none,id=drive%d", disk.Path, i)
+		format := disk.Format
+		if format == "" {
+			format = hypervisor.DiskFormatRaw
+		}
+		driveOpts := fmt.Sprintf("file=%s,format=%s,if=none,id=drive%d", disk.Path, format, i)
 		if disk.Readonly {
 			driveOpts += ",readonly=on"
 		}
+		if disk.Discard {
+			driveOpts += ",discard=unmap"
+		}
 		if disk.IOBps > 0 {
 			driveOpts += fmt.Sprintf(",throttling.bps-total=%d", disk.IOBps)
 			if disk.IOBurstBps > 0 && disk.IOBurstBps > disk.IOBps {
@@ -47,7 +70,16 @@ func BuildArgs(cfg hypervisor.VMConfig) []string {
 			}
 		}
 		args = append(args, "-drive", driveOpts)
-		args = append(args, "-device", fmt.Sprintf("virtio-blk-pci,drive=drive%d", i))
+
+		deviceOpts := fmt.Sprintf("virtio-blk-pci,drive=drive%d", i)
+		if disk.Serial != "" {
+			deviceOpts += ",serial=" + disk.Serial
+		}
+		if i >= hypervisor.PrimaryBusDisks {
+			// Bridge slot 0 is reserved for its hotplug controller
+			deviceOpts += fmt.Sprintf(",bus=%s,addr=%d", bridgeID, i-hypervisor.PrimaryBusDisks+1)
+		}
+		args = append(args, "-device", deviceOpts)
 	}
 
 	// Network configuration
@@ -76,6 +108,15 @@ func BuildArgs(cfg hypervisor.VMConfig) []string {
 		args = append(args, "-serial", "stdio")
 	}
 
+	// Virtio console for the application's output (hvc0 in the guest)
+	if cfg.ConsoleLogPath != "" {
+		args = append(args,
+			"-device", "virtio-serial-pci",
+			"-chardev", fmt.Sprintf("file,id=appconsole,path=%s", cfg.ConsoleLogPath),
+			"-device", "virtconsole,chardev=appconsole",
+		)
+	}
+
 	// No graphics
 	args = append(args, "-nographic")
 
@@ -1,6 +1,7 @@
 package qemu
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/onkernel/hypeman/lib/hypervisor"
@@ -78,6 +79,38 @@ func TestBuildArgs_Disks(t *testing.T) {
 	// Check virtio-blk devices
 	assert.Contains(t, args, "virtio-blk-pci,drive=drive0")
 	assert.Contains(t, args, "virtio-blk-pci,drive=drive1")
+	assert.NotContains(t, args, "pci-bridge,id=pci.1,chassis_nr=1")
+}
+
+func TestBuildArgs_Qcow2Disk(t *testing.T) {
+	cfg := hypervisor.VMConfig{
+		VCPUs:       1,
+		MemoryBytes: 512 * 1024 * 1024,
+		Disks: []hypervisor.DiskConfig{
+			{Path: "/path/to/overlay.qcow2", Format: hypervisor.DiskFormatQcow2, Discard: true},
+		},
+	}
+
+	args := BuildArgs(cfg)
+
+	assert.Contains(t, args, "file=/path/to/overlay.qcow2,format=qcow2,if=none,id=drive0,discard=unmap")
+}
+
+func TestBuildArgs_DisksPastFirstBus(t *testing.T) {
+	cfg := hypervisor.VMConfig{
+		VCPUs:       1,
+		MemoryBytes: 512 * 1024 * 1024,
+	}
+	for i := 0; i < hypervisor.PrimaryBusDisks+2; i++ {
+		cfg.Disks = append(cfg.Disks, hypervisor.DiskConfig{Path: fmt.Sprintf("/path/to/disk%d.ext4", i), Serial: fmt.Sprintf("vol%d", i)})
+	}
+
+	args := BuildArgs(cfg)
+
+	assert.Contains(t, args, "pci-bridge,id=pci.1,chassis_nr=1")
+	assert.Contains(t, args, "virtio-blk-pci,drive=drive25,serial=vol25")
+	assert.Contains(t, args, "virtio-blk-pci,drive=drive26,serial=vol26,bus=pci.1,addr=1")
+	assert.Contains(t, args, "virtio-blk-pci,drive=drive27,serial=vol27,bus=pci.1,addr=2")
 }
 
 func TestBuildArgs_Network(t *testing.T) {
@@ -135,6 +168,20 @@ func TestBuildArgs_PCIPassthrough(t *testing.T) {
 	assert.Contains(t, args, "vfio-pci,host=0000:02:00.0")
 }
 
+func TestBuildArgs_NUMANode(t *testing.T) {
+	node := 1
+	cfg := hypervisor.VMConfig{
+		VCPUs:       4,
+		MemoryBytes: 2 * 1024 * 1024 * 1024,
+		NUMANode:    &node,
+	}
+
+	args := BuildArgs(cfg)
+
+	assert.Contains(t, args, "memory-backend-ram,id=mem0,size=2048M,host-nodes=1,policy=bind")
+	assert.Contains(t, args, "node,nodeid=0,cpus=0-3,memdev=mem0")
+}
+
 func TestBuildArgs_SerialLog(t *testing.T) {
 	cfg := hypervisor.VMConfig{
 		VCPUs:         1,
@@ -159,3 +206,23 @@ func TestBuildArgs_NoSerialLog(t *testing.T) {
 	assert.Contains(t, args, "-serial")
 	assert.Contains(t, args, "stdio")
 }
+
+func TestBuildArgs_ConsoleLog(t *testing.T) {
+	cfg := hypervisor.VMConfig{
+		VCPUs:          1,
+		MemoryBytes:    512 * 1024 * 1024,
+		SerialLogPath:  "/var/log/system.log",
+		ConsoleLogPath: "/var/log/app.log",
+	}
+
+	args := BuildArgs(cfg)
+
+	assert.Contains(t, args, "file:/var/log/system.log")
+	assert.Contains(t, args, "virtio-serial-pci")
+	assert.Contains(t, args, "file,id=appconsole,path=/var/log/app.log")
+	assert.Contains(t, args, "virtconsole,chardev=appconsole")
+
+	// Without a console log path, there is only the serial console
+	cfg.ConsoleLogPath = ""
+	assert.NotContains(t, BuildArgs(cfg), "virtio-serial-pci")
+}
@@ -11,12 +11,14 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/onkernel/hypeman/lib/hypervisor"
 	"github.com/onkernel/hypeman/lib/logger"
 	"github.com/onkernel/hypeman/lib/paths"
+	"golang.org/x/sys/unix"
 	"gvisor.dev/gvisor/pkg/cleanup"
 )
 
@@ -37,6 +39,7 @@ const (
 
 func init() {
 	hypervisor.RegisterSocketName(hypervisor.TypeQEMU, "qemu.sock")
+	hypervisor.RegisterCapabilities(hypervisor.TypeQEMU, capabilities)
 }
 
 // Starter implements hypervisor.VMStarter for QEMU.
@@ -116,7 +119,7 @@ func buildQMPArgs(socketPath string) []string {
 // startQEMUProcess handles the common QEMU process startup logic.
 // Returns the PID, hypervisor client, and a cleanup function.
 // The cleanup function must be called on error; call cleanup.Release() on success.
-func (s *Starter) startQEMUProcess(ctx context.Context, p *paths.Paths, version string, socketPath string, args []string) (int, *QEMU, *cleanup.Cleanup, error) {
+func (s *Starter) startQEMUProcess(ctx context.Context, p *paths.Paths, version string, socketPath string, args []string, cpuAffinity []int) (int, *QEMU, *cleanup.Cleanup, error) {
 	log := logger.FromContext(ctx)
 
 	// Get binary path
@@ -186,6 +189,14 @@ func (s *Starter) startQEMUProcess(ctx context.Context, p *paths.Paths, version
 	}
 	log.DebugContext(ctx, "QMP socket ready", "duration_ms", time.Since(socketWaitStart).Milliseconds())
 
+	// QEMU has no vCPU pinning option, so pin the process threads directly.
+	// Threads created later inherit the main thread's affinity.
+	if len(cpuAffinity) > 0 {
+		if err := pinProcessThreads(pid, cpuAffinity); err != nil {
+			log.WarnContext(ctx, "failed to pin QEMU threads to host CPUs", "cpus", cpuAffinity, "error", err)
+		}
+	}
+
 	// Create QMP client
 	hv, err := New(socketPath)
 	if err != nil {
@@ -205,7 +216,7 @@ func (s *Starter) StartVM(ctx context.Context, p *paths.Paths, version string, s
 	args := buildQMPArgs(socketPath)
 	args = append(args, BuildArgs(config)...)
 
-	pid, hv, cu, err := s.startQEMUProcess(ctx, p, version, socketPath, args)
+	pid, hv, cu, err := s.startQEMUProcess(ctx, p, version, socketPath, args, config.CPUAffinity)
 	if err != nil {
 		return 0, nil, err
 	}
@@ -248,7 +259,7 @@ func (s *Starter) RestoreVM(ctx context.Context, p *paths.Paths, version string,
 	incomingURI := "exec:cat < " + memoryFile
 	args = append(args, "-incoming", incomingURI)
 
-	pid, hv, cu, err := s.startQEMUProcess(ctx, p, version, socketPath, args)
+	pid, hv, cu, err := s.startQEMUProcess(ctx, p, version, socketPath, args, config.CPUAffinity)
 	if err != nil {
 		return 0, nil, err
 	}
@@ -345,3 +356,26 @@ func waitForSocket(socketPath string, timeout time.Duration) error {
 	}
 	return fmt.Errorf("timeout waiting for socket")
 }
+
+// pinProcessThreads sets the CPU affinity of every thread of a process
+func pinProcessThreads(pid int, cpus []int) error {
+	var set unix.CPUSet
+	for _, cpu := range cpus {
+		set.Set(cpu)
+	}
+
+	tasks, err := os.ReadDir(fmt.Sprintf("/proc/%d/task", pid))
+	if err != nil {
+		return fmt.Errorf("list threads: %w", err)
+	}
+	for _, task := range tasks {
+		tid, err := strconv.Atoi(task.Name())
+		if err != nil {
+			continue
+		}
+		if err := unix.SchedSetaffinity(tid, &set); err != nil {
+			return fmt.Errorf("set affinity of thread %d: %w", tid, err)
+		}
+	}
+	return nil
+}
@@ -35,16 +35,25 @@ func newClient(socketPath string) (*QEMU, error) {
 // Verify QEMU implements the interface
 var _ hypervisor.Hypervisor = (*QEMU)(nil)
 
+// capabilities lists the features supported by QEMU.
+var capabilities = hypervisor.Capabilities{
+	SupportsSnapshot:       true,  // Uses QMP migrate file:// for snapshot
+	SupportsHotplugMemory:  false, // Not implemented - balloon not configured
+	SupportsPause:          true,
+	SupportsVsock:          true,
+	SupportsGPUPassthrough: true,
+	SupportsDiskIOLimit:    true,
+	SupportsHotplugDevice:  false, // Not implemented - would use QMP device_add
+	SupportsDiskSerial:     true,
+	SupportsHotplugDisk:    false, // Not implemented - would use QMP blockdev-add
+	SupportsQcow2:          true,
+	SupportsConsole:        true,
+	MaxDisks:               hypervisor.PrimaryBusDisks + bridgeDisks,
+}
+
 // Capabilities returns the features supported by QEMU.
 func (q *QEMU) Capabilities() hypervisor.Capabilities {
-	return hypervisor.Capabilities{
-		SupportsSnapshot:       true,  // Uses QMP migrate file:// for snapshot
-		SupportsHotplugMemory:  false, // Not implemented - balloon not configured
-		SupportsPause:          true,
-		SupportsVsock:          true,
-		SupportsGPUPassthrough: true,
-		SupportsDiskIOLimit:    true,
-	}
+	return capabilities
 }
 
 // DeleteVM removes the VM configuration from QEMU.
@@ -169,3 +178,27 @@ func (q *QEMU) ResizeMemory(ctx context.Context, bytes int64) error {
 func (q *QEMU) ResizeMemoryAndWait(ctx context.Context, bytes int64, timeout time.Duration) error {
 	return fmt.Errorf("memory resize not supported by QEMU implementation")
 }
+
+// AddPCIDevice hotplugs a PCI device.
+// Not implemented in first pass.
+func (q *QEMU) AddPCIDevice(ctx context.Context, sysfsPath string) error {
+	return fmt.Errorf("device hotplug not supported by QEMU implementation")
+}
+
+// RemovePCIDevice hot-unplugs a PCI device.
+// Not implemented in first pass.
+func (q *QEMU) RemovePCIDevice(ctx context.Context, sysfsPath string) error {
+	return fmt.Errorf("device hotplug not supported by QEMU implementation")
+}
+
+// AddDisk hotplugs a disk.
+// Not implemented - would use QMP blockdev-add and device_add.
+func (q *QEMU) AddDisk(ctx context.Context, disk hypervisor.DiskConfig) error {
+	return fmt.Errorf("disk hotplug not supported by QEMU implementation")
+}
+
+// RemoveDisk hot-unplugs a disk.
+// Not implemented - would use QMP device_del and blockdev-del.
+func (q *QEMU) RemoveDisk(ctx context.Context, serial string) error {
+	return fmt.Errorf("disk hotplug not supported by QEMU implementation")
+}
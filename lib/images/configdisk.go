@@ -0,0 +1,257 @@
+package images
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// Config disks hold a few small files, such as config.json, and are created
+// for every instance start and build. mkfs.ext4 and its temp directory cost
+// more than everything else in creating one, so WriteConfigDisk lays out the
+// filesystem itself: an ext2 revision 1 image, which the guest's ext4 driver
+// mounts like any ext4 disk.
+const (
+	configDiskBlockSize  = 4096
+	configDiskInodeSize  = 128
+	configDiskFirstInode = 11 // Inodes below are reserved
+	configDiskRootInode  = 2
+	configDiskLostFound  = configDiskFirstInode
+
+	// Files use direct blocks plus one indirect block
+	configDiskDirectBlocks  = 12
+	configDiskMaxFileBlocks = configDiskDirectBlocks + configDiskBlockSize/4
+
+	// Fixed layout: superblock, group descriptors, block bitmap, inode
+	// bitmap, then the inode table
+	configDiskGroupDescBlock   = 1
+	configDiskBlockBitmapBlock = 2
+	configDiskInodeBitmapBlock = 3
+	configDiskInodeTableBlock  = 4
+)
+
+// ConfigDiskMaxFileSize is the largest file WriteConfigDisk can store.
+const ConfigDiskMaxFileSize = configDiskMaxFileBlocks * configDiskBlockSize
+
+// WriteConfigDisk writes a read-only ext4-compatible disk image holding files
+// in its root directory, keyed by name, without running mkfs. It returns the
+// size of the image. Files can be up to ConfigDiskMaxFileSize; for anything
+// bigger or with subdirectories, use ExportRootfs.
+func WriteConfigDisk(diskPath string, files map[string][]byte) (int64, error) {
+	names := make([]string, 0, len(files))
+	for name, data := range files {
+		if name == "" || name == "." || name == ".." || name == "lost+found" || len(name) > 255 || filepath.Base(name) != name {
+			return 0, fmt.Errorf("invalid config disk file name %q", name)
+		}
+		if len(data) > ConfigDiskMaxFileSize {
+			return 0, fmt.Errorf("config disk file %s is %d bytes, over the %d byte limit", name, len(data), ConfigDiskMaxFileSize)
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	image, err := buildConfigDisk(names, files, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(diskPath), 0755); err != nil {
+		return 0, fmt.Errorf("create disk parent dir: %w", err)
+	}
+	if err := os.WriteFile(diskPath, image, 0644); err != nil {
+		return 0, fmt.Errorf("write config disk: %w", err)
+	}
+	return int64(len(image)), nil
+}
+
+// configDiskInode is an inode to write, with the data blocks allocated to it
+type configDiskInode struct {
+	mode   uint16
+	links  uint16
+	size   uint32
+	blocks []uint32 // Data blocks, in file order
+	extra  uint32   // Indirect block, 0 if none
+}
+
+// buildConfigDisk lays out the image in memory. Everything fits in a single
+// block group: the inode table has one inode per file plus the reserved ones,
+// and data blocks follow it.
+func buildConfigDisk(names []string, files map[string][]byte, now time.Time) ([]byte, error) {
+	// Inodes: reserved, lost+found, then one per file. Round up to fill
+	// whole inode table blocks, as mkfs does.
+	inodesPerBlock := configDiskBlockSize / configDiskInodeSize
+	inodeCount := configDiskLostFound + len(names)
+	inodeCount = (inodeCount + inodesPerBlock - 1) / inodesPerBlock * inodesPerBlock
+	inodeTableBlocks := inodeCount / inodesPerBlock
+
+	next := uint32(configDiskInodeTableBlock + inodeTableBlocks)
+	alloc := func(n int) []uint32 {
+		blocks := make([]uint32, n)
+		for i := range blocks {
+			blocks[i] = next
+			next++
+		}
+		return blocks
+	}
+
+	inodes := map[uint32]*configDiskInode{
+		configDiskRootInode: {mode: 0x4000 | 0755, links: 3, size: configDiskBlockSize, blocks: alloc(1)},
+		configDiskLostFound: {mode: 0x4000 | 0700, links: 2, size: configDiskBlockSize, blocks: alloc(1)},
+	}
+	for i, name := range names {
+		size := len(files[name])
+		ino := &configDiskInode{mode: 0x8000 | 0644, links: 1, size: uint32(size)}
+		ino.blocks = alloc((size + configDiskBlockSize - 1) / configDiskBlockSize)
+		if len(ino.blocks) > configDiskDirectBlocks {
+			ino.extra = alloc(1)[0]
+		}
+		inodes[uint32(configDiskLostFound+1+i)] = ino
+	}
+	blockCount := next
+
+	image := make([]byte, int(blockCount)*configDiskBlockSize)
+	block := func(n uint32) []byte {
+		return image[int(n)*configDiskBlockSize : int(n+1)*configDiskBlockSize]
+	}
+	le := binary.LittleEndian
+
+	// Directories
+	root := []configDiskDirent{
+		{configDiskRootInode, ".", 2},
+		{configDiskRootInode, "..", 2},
+		{configDiskLostFound, "lost+found", 2},
+	}
+	for i, name := range names {
+		root = append(root, configDiskDirent{uint32(configDiskLostFound + 1 + i), name, 1})
+	}
+	if err := writeConfigDiskDir(block(inodes[configDiskRootInode].blocks[0]), root); err != nil {
+		return nil, err
+	}
+	writeConfigDiskDir(block(inodes[configDiskLostFound].blocks[0]), []configDiskDirent{
+		{configDiskLostFound, ".", 2},
+		{configDiskRootInode, "..", 2},
+	})
+
+	// File data and indirect blocks
+	for i, name := range names {
+		ino := inodes[uint32(configDiskLostFound+1+i)]
+		data := files[name]
+		for j, b := range ino.blocks {
+			copy(block(b), data[j*configDiskBlockSize:])
+		}
+		if ino.extra != 0 {
+			indirect := block(ino.extra)
+			for j, b := range ino.blocks[configDiskDirectBlocks:] {
+				le.PutUint32(indirect[j*4:], b)
+			}
+		}
+	}
+
+	// Inode table
+	ts := uint32(now.Unix())
+	table := image[configDiskInodeTableBlock*configDiskBlockSize:]
+	for num, ino := range inodes {
+		raw := table[(num-1)*configDiskInodeSize : num*configDiskInodeSize]
+		le.PutUint16(raw[0:], ino.mode)
+		le.PutUint32(raw[4:], ino.size)
+		le.PutUint32(raw[8:], ts)  // atime
+		le.PutUint32(raw[12:], ts) // ctime
+		le.PutUint32(raw[16:], ts) // mtime
+		le.PutUint16(raw[26:], ino.links)
+		sectors := len(ino.blocks) * configDiskBlockSize / 512
+		if ino.extra != 0 {
+			sectors += configDiskBlockSize / 512
+		}
+		le.PutUint32(raw[28:], uint32(sectors))
+		for j, b := range ino.blocks[:min(len(ino.blocks), configDiskDirectBlocks)] {
+			le.PutUint32(raw[40+j*4:], b)
+		}
+		le.PutUint32(raw[40+configDiskDirectBlocks*4:], ino.extra)
+	}
+
+	// Bitmaps: everything allocated is in use, and the bits past the end of
+	// the group are set, as e2fsck expects
+	setBits := func(bitmap []byte, from, to int) {
+		for i := from; i < to; i++ {
+			bitmap[i/8] |= 1 << (i % 8)
+		}
+	}
+	bitsPerGroup := configDiskBlockSize * 8
+	setBits(block(configDiskBlockBitmapBlock), 0, int(blockCount))
+	setBits(block(configDiskBlockBitmapBlock), int(blockCount), bitsPerGroup)
+	usedInodes := configDiskLostFound + len(names)
+	setBits(block(configDiskInodeBitmapBlock), 0, usedInodes)
+	setBits(block(configDiskInodeBitmapBlock), inodeCount, bitsPerGroup)
+
+	// Group descriptor
+	gd := block(configDiskGroupDescBlock)
+	le.PutUint32(gd[0:], configDiskBlockBitmapBlock)
+	le.PutUint32(gd[4:], configDiskInodeBitmapBlock)
+	le.PutUint32(gd[8:], configDiskInodeTableBlock)
+	le.PutUint16(gd[12:], 0) // free blocks
+	le.PutUint16(gd[14:], uint16(inodeCount-usedInodes))
+	le.PutUint16(gd[16:], 2) // directories
+
+	// Superblock, at byte 1024 of block 0
+	sb := image[1024:2048]
+	le.PutUint32(sb[0:], uint32(inodeCount))
+	le.PutUint32(sb[4:], blockCount)
+	le.PutUint32(sb[12:], 0) // free blocks
+	le.PutUint32(sb[16:], uint32(inodeCount-usedInodes))
+	le.PutUint32(sb[20:], 0) // first data block (0 with 4K blocks)
+	le.PutUint32(sb[24:], 2) // log2(block size) - 10
+	le.PutUint32(sb[28:], 2) // log2(fragment size) - 10
+	le.PutUint32(sb[32:], uint32(bitsPerGroup))
+	le.PutUint32(sb[36:], uint32(bitsPerGroup))
+	le.PutUint32(sb[40:], uint32(inodeCount))
+	le.PutUint32(sb[48:], ts)     // write time
+	le.PutUint16(sb[54:], 0xFFFF) // max mount count: never check
+	le.PutUint16(sb[56:], 0xEF53) // magic
+	le.PutUint16(sb[58:], 1)      // state: clean
+	le.PutUint16(sb[60:], 1)      // errors: continue
+	le.PutUint32(sb[64:], ts)     // last check
+	le.PutUint32(sb[76:], 1)      // revision 1: dynamic inode sizes
+	le.PutUint32(sb[84:], configDiskFirstInode)
+	le.PutUint16(sb[88:], configDiskInodeSize)
+	le.PutUint32(sb[96:], 0x0002) // incompat: directory entries record file type
+	if _, err := rand.Read(sb[104:120]); err != nil {
+		return nil, fmt.Errorf("generate filesystem uuid: %w", err)
+	}
+	copy(sb[120:136], "config")
+
+	return image, nil
+}
+
+// configDiskDirent is a directory entry
+type configDiskDirent struct {
+	inode    uint32
+	name     string
+	fileType uint8 // 1 = regular file, 2 = directory
+}
+
+// writeConfigDiskDir writes directory entries into a single block, the last
+// entry taking up the rest of it
+func writeConfigDiskDir(buf []byte, entries []configDiskDirent) error {
+	le := binary.LittleEndian
+	off := 0
+	for i, e := range entries {
+		recLen := (8 + len(e.name) + 3) &^ 3
+		if off+recLen > len(buf) {
+			return fmt.Errorf("too many files for a config disk directory")
+		}
+		if i == len(entries)-1 {
+			recLen = len(buf) - off
+		}
+		le.PutUint32(buf[off:], e.inode)
+		le.PutUint16(buf[off+4:], uint16(recLen))
+		buf[off+6] = uint8(len(e.name))
+		buf[off+7] = e.fileType
+		copy(buf[off+8:], e.name)
+		off += recLen
+	}
+	return nil
+}
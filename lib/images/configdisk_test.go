@@ -0,0 +1,92 @@
+package images
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteConfigDisk(t *testing.T) {
+	if _, err := exec.LookPath("e2fsck"); err != nil {
+		t.Skip("e2fsck not available")
+	}
+	if _, err := exec.LookPath("debugfs"); err != nil {
+		t.Skip("debugfs not available")
+	}
+
+	files := map[string][]byte{
+		"config.json":   []byte(`{"entrypoint":["/bin/sh"]}`),
+		"empty":         {},
+		"metadata.json": bytes.Repeat([]byte("x"), 5*configDiskBlockSize+7),
+		// Needs the indirect block
+		"large.bin": bytes.Repeat([]byte{0xAB, 0xCD}, 20*configDiskBlockSize),
+	}
+	diskPath := filepath.Join(t.TempDir(), "config.ext4")
+
+	size, err := WriteConfigDisk(diskPath, files)
+	require.NoError(t, err)
+	info, err := os.Stat(diskPath)
+	require.NoError(t, err)
+	assert.Equal(t, info.Size(), size)
+
+	out, err := exec.Command("e2fsck", "-fn", diskPath).CombinedOutput()
+	require.NoError(t, err, "e2fsck: %s", out)
+
+	for name, want := range files {
+		got, err := exec.Command("debugfs", "-R", "cat /"+name, diskPath).Output()
+		require.NoError(t, err)
+		assert.Equal(t, want, got, name)
+	}
+}
+
+func TestWriteConfigDiskRejects(t *testing.T) {
+	diskPath := filepath.Join(t.TempDir(), "config.ext4")
+
+	_, err := WriteConfigDisk(diskPath, map[string][]byte{"dir/config.json": nil})
+	assert.Error(t, err)
+	_, err = WriteConfigDisk(diskPath, map[string][]byte{"lost+found": nil})
+	assert.Error(t, err)
+	_, err = WriteConfigDisk(diskPath, map[string][]byte{"big": make([]byte, ConfigDiskMaxFileSize+1)})
+	assert.Error(t, err)
+	assert.NoFileExists(t, diskPath)
+}
+
+func BenchmarkConfigDisk(b *testing.B) {
+	config := bytes.Repeat([]byte(`{"env":{"KEY":"value"}}`), 100)
+
+	b.Run("WriteConfigDisk", func(b *testing.B) {
+		diskPath := filepath.Join(b.TempDir(), "config.ext4")
+		for b.Loop() {
+			if _, err := WriteConfigDisk(diskPath, map[string][]byte{"config.json": config}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("ExportRootfs", func(b *testing.B) {
+		if _, err := exec.LookPath("mkfs.ext4"); err != nil {
+			b.Skip("mkfs.ext4 not available")
+		}
+		dir := b.TempDir()
+		diskPath := filepath.Join(dir, "config.ext4")
+		for b.Loop() {
+			srcDir, err := os.MkdirTemp(dir, "src-")
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(srcDir, "config.json"), config, 0644); err != nil {
+				b.Fatal(err)
+			}
+			os.Remove(diskPath)
+			if _, err := ExportRootfs(srcDir, diskPath, FormatExt4); err != nil {
+				b.Fatal(err)
+			}
+			os.RemoveAll(srcDir)
+		}
+	})
+}
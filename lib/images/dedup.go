@@ -0,0 +1,229 @@
+package images
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/maphash"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/onkernel/hypeman/lib/logger"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// dedupeBlockSize matches the ext4 block size used by convertToExt4, so
+	// file contents sit on the same boundaries in every image disk
+	dedupeBlockSize = 4096
+
+	// dedupeMaxRange is the largest range passed to one FIDEDUPERANGE call.
+	// btrfs silently truncates larger requests.
+	dedupeMaxRange = 16 * 1024 * 1024
+
+	// dedupeMaxDonors limits how many existing disks a new disk is compared against
+	dedupeMaxDonors = 2
+)
+
+// dedupeRange is a run of identical blocks in a donor and a new disk
+type dedupeRange struct {
+	srcOffset  int64
+	destOffset int64
+	length     int64
+}
+
+// blockHashes hashes every block of a file. All-zero blocks hash to 0 so
+// they're never matched; they're holes or cheap to store either way.
+func blockHashes(path string, seed maphash.Seed) ([]uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var hashes []uint64
+	buf := make([]byte, dedupeBlockSize)
+	for {
+		n, err := io.ReadFull(f, buf)
+		if n == dedupeBlockSize {
+			if isZero(buf) {
+				hashes = append(hashes, 0)
+			} else {
+				hashes = append(hashes, maphash.Bytes(seed, buf))
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// A partial tail block is never shared
+			return hashes, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// planDedupe finds runs of blocks in dest that also appear in src. Runs are
+// extended while consecutive blocks keep matching, and split at dedupeMaxRange.
+func planDedupe(src, dest []uint64) []dedupeRange {
+	index := make(map[uint64]int, len(src))
+	for i, h := range src {
+		if h == 0 {
+			continue
+		}
+		if _, ok := index[h]; !ok {
+			index[h] = i
+		}
+	}
+
+	const maxBlocks = dedupeMaxRange / dedupeBlockSize
+	var ranges []dedupeRange
+	for i := 0; i < len(dest); {
+		j, ok := index[dest[i]]
+		if dest[i] == 0 || !ok {
+			i++
+			continue
+		}
+		n := 1
+		for n < maxBlocks && i+n < len(dest) && j+n < len(src) && dest[i+n] != 0 && dest[i+n] == src[j+n] {
+			n++
+		}
+		ranges = append(ranges, dedupeRange{
+			srcOffset:  int64(j) * dedupeBlockSize,
+			destOffset: int64(i) * dedupeBlockSize,
+			length:     int64(n) * dedupeBlockSize,
+		})
+		i += n
+	}
+	return ranges
+}
+
+// dedupeFile shares blocks of destPath that are identical in srcPath using
+// FIDEDUPERANGE. The kernel compares contents before sharing, so hash
+// collisions are harmless. Returns the number of bytes now shared.
+func dedupeFile(srcPath, destPath string) (int64, error) {
+	seed := maphash.MakeSeed()
+	srcHashes, err := blockHashes(srcPath, seed)
+	if err != nil {
+		return 0, fmt.Errorf("hash %s: %w", srcPath, err)
+	}
+	destHashes, err := blockHashes(destPath, seed)
+	if err != nil {
+		return 0, fmt.Errorf("hash %s: %w", destPath, err)
+	}
+
+	ranges := planDedupe(srcHashes, destHashes)
+	if len(ranges) == 0 {
+		return 0, nil
+	}
+
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	dest, err := os.OpenFile(destPath, os.O_RDWR, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer dest.Close()
+
+	var shared int64
+	for _, r := range ranges {
+		req := unix.FileDedupeRange{
+			Src_offset: uint64(r.srcOffset),
+			Src_length: uint64(r.length),
+			Info: []unix.FileDedupeRangeInfo{{
+				Dest_fd:     int64(dest.Fd()),
+				Dest_offset: uint64(r.destOffset),
+			}},
+		}
+		if err := unix.IoctlFileDedupeRange(int(src.Fd()), &req); err != nil {
+			return shared, err
+		}
+		if req.Info[0].Status == unix.FILE_DEDUPE_RANGE_SAME {
+			shared += int64(req.Info[0].Bytes_deduped)
+		}
+	}
+	return shared, nil
+}
+
+// dedupeDisk shares blocks between a newly exported image disk and the disks
+// of ready images with the most layers in common. Filesystems without reflink
+// support (ext4, tmpfs) are skipped. Failures only cost disk space, so they
+// are logged rather than failing the build.
+func (m *manager) dedupeDisk(ctx context.Context, ref *ResolvedRef, layers []string) int64 {
+	log := logger.FromContext(ctx)
+	if len(layers) == 0 {
+		return 0
+	}
+
+	entries, err := listAllDigests(m.paths.ImagesDir())
+	if err != nil {
+		log.WarnContext(ctx, "list images for disk dedupe", "error", err)
+		return 0
+	}
+
+	type donor struct {
+		path   string
+		shared int
+	}
+	var donors []donor
+	for _, e := range entries {
+		if e.meta.Status != StatusReady || e.meta.Digest == ref.Digest() {
+			continue
+		}
+		if n := sharedPrefix(layers, e.meta.Layers); n > 0 {
+			donors = append(donors, donor{path: digestPath(m.paths, e.repository, e.digestHex), shared: n})
+		}
+	}
+	sort.SliceStable(donors, func(i, j int) bool { return donors[i].shared > donors[j].shared })
+	if len(donors) > dedupeMaxDonors {
+		donors = donors[:dedupeMaxDonors]
+	}
+
+	diskPath := digestPath(m.paths, ref.Repository(), ref.DigestHex())
+	var total int64
+	for _, d := range donors {
+		shared, err := dedupeFile(d.path, diskPath)
+		total += shared
+		if reflinkUnsupported(err) {
+			log.DebugContext(ctx, "filesystem does not support reflink, skipping disk dedupe", "error", err)
+			return total
+		}
+		if err != nil {
+			log.WarnContext(ctx, "dedupe image disk", "image", ref.String(), "donor", d.path, "error", err)
+		}
+	}
+
+	if total > 0 {
+		log.InfoContext(ctx, "shared image disk blocks with base images", "image", ref.String(), "bytes", total)
+		m.recordDedupeMetrics(ctx, total)
+	}
+	return total
+}
+
+// reflinkUnsupported reports whether a dedupe error means the filesystem
+// can't share blocks between these files at all
+func reflinkUnsupported(err error) bool {
+	return errors.Is(err, unix.EOPNOTSUPP) || errors.Is(err, unix.EINVAL) ||
+		errors.Is(err, unix.ENOTTY) || errors.Is(err, unix.EXDEV)
+}
+
+// sharedPrefix returns how many leading layers two layer chains have in common
+func sharedPrefix(a, b []string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,56 @@
+package images
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanDedupe(t *testing.T) {
+	const bs = dedupeBlockSize
+	src := []uint64{1, 2, 3, 0, 4, 5}
+	dest := []uint64{9, 2, 3, 4, 0, 1, 7}
+
+	ranges := planDedupe(src, dest)
+	assert.Equal(t, []dedupeRange{
+		{srcOffset: 1 * bs, destOffset: 1 * bs, length: 2 * bs}, // 2,3 run stops at src zero block
+		{srcOffset: 4 * bs, destOffset: 3 * bs, length: 1 * bs}, // 4 alone, dest zero block not matched
+		{srcOffset: 0, destOffset: 5 * bs, length: 1 * bs},
+	}, ranges)
+
+	assert.Empty(t, planDedupe([]uint64{0, 0}, []uint64{0, 0}), "zero blocks are never shared")
+}
+
+func TestSharedPrefix(t *testing.T) {
+	assert.Equal(t, 2, sharedPrefix([]string{"a", "b", "c"}, []string{"a", "b", "d"}))
+	assert.Equal(t, 0, sharedPrefix([]string{"a"}, []string{"b", "a"}))
+	assert.Equal(t, 1, sharedPrefix([]string{"a"}, []string{"a", "b"}))
+}
+
+func TestDedupeFile(t *testing.T) {
+	dir := t.TempDir()
+	block := func(b byte) []byte { return bytes.Repeat([]byte{b}, dedupeBlockSize) }
+
+	srcPath := filepath.Join(dir, "src.ext4")
+	destPath := filepath.Join(dir, "dest.ext4")
+	src := bytes.Join([][]byte{block(1), block(2), block(3)}, nil)
+	dest := bytes.Join([][]byte{block(9), block(2), block(3), make([]byte, dedupeBlockSize)}, nil)
+	require.NoError(t, os.WriteFile(srcPath, src, 0644))
+	require.NoError(t, os.WriteFile(destPath, dest, 0644))
+
+	shared, err := dedupeFile(srcPath, destPath)
+	if reflinkUnsupported(err) {
+		t.Skipf("filesystem does not support FIDEDUPERANGE: %v", err)
+	}
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*dedupeBlockSize), shared)
+
+	// Contents are unchanged
+	got, err := os.ReadFile(destPath)
+	require.NoError(t, err)
+	assert.Equal(t, dest, got)
+}
@@ -231,3 +231,16 @@ func CreateEmptyExt4Disk(diskPath string, sizeBytes int64) error {
 	return nil
 }
 
+// CopyDisk copies a disk image, keeping it sparse. On filesystems with
+// reflink support the copy shares blocks with the original until written.
+func CopyDisk(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("create disk parent dir: %w", err)
+	}
+	cmd := exec.Command("cp", "--sparse=always", "--reflink=auto", src, dst)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("copy disk failed: %w, output: %s", err, output)
+	}
+	return nil
+}
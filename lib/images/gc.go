@@ -0,0 +1,330 @@
+package images
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	gcr "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/onkernel/hypeman/lib/logger"
+	"github.com/onkernel/hypeman/lib/paths"
+)
+
+// gcGracePeriod protects recently written images and blobs from collection.
+// Registry pushes write blobs before the manifest that references them, and a
+// freshly imported build output may not be recorded by its build yet.
+const gcGracePeriod = time.Hour
+
+// ReferenceSource reports images in use outside the images package
+// (instances, builds). GarbageCollect keeps every image a source returns.
+type ReferenceSource interface {
+	// ImageReferences returns the digests ("sha256:...") of images in use.
+	ImageReferences(ctx context.Context) ([]string, error)
+}
+
+// BlobReferenceSource is implemented by reference sources that also hold OCI
+// cache blobs directly, such as the registry for manifests pushed to it.
+// GarbageCollect keeps every blob such a source returns.
+type BlobReferenceSource interface {
+	// BlobReferences returns the digests ("sha256:...") of blobs in use.
+	BlobReferences(ctx context.Context) ([]string, error)
+}
+
+// GCRequest controls a garbage collection run
+type GCRequest struct {
+	DryRun bool // Report what would be removed without deleting anything
+}
+
+// GCResult describes what a garbage collection run removed (or would remove)
+type GCResult struct {
+	DryRun        bool
+	RemovedImages []string // "repository@sha256:..." of removed image disks
+	RemovedBlobs  int      // Number of OCI cache blobs removed
+	FreedBytes    int64    // Bytes freed from image disks and OCI cache blobs
+}
+
+// digestEntry is an image digest directory found on disk
+type digestEntry struct {
+	repository string
+	digestHex  string
+	meta       *imageMetadata
+}
+
+// AddReferenceSource registers a source of image references for garbage collection.
+func (m *manager) AddReferenceSource(src ReferenceSource) {
+	m.gcMu.Lock()
+	defer m.gcMu.Unlock()
+	m.refSources = append(m.refSources, src)
+}
+
+// BlockGC prevents garbage collection until the returned function is called.
+func (m *manager) BlockGC() (release func()) {
+	m.gcMu.RLock()
+	return m.gcMu.RUnlock
+}
+
+// GarbageCollect removes image disks and OCI cache blobs not referenced by any
+// registered ReferenceSource. Images still building and anything written within
+// gcGracePeriod are kept.
+func (m *manager) GarbageCollect(ctx context.Context, req GCRequest) (*GCResult, error) {
+	log := logger.FromContext(ctx)
+
+	// Exclusive: waits for BlockGC holders so references are recorded before counting
+	m.gcMu.Lock()
+	defer m.gcMu.Unlock()
+	m.createMu.Lock()
+	defer m.createMu.Unlock()
+
+	if len(m.refSources) == 0 {
+		return nil, fmt.Errorf("no image reference sources registered")
+	}
+
+	referenced := make(map[string]bool)
+	referencedBlobs := make(map[string]bool)
+	for _, src := range m.refSources {
+		digests, err := src.ImageReferences(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list image references: %w", err)
+		}
+		for _, d := range digests {
+			referenced[d] = true
+		}
+
+		blobSrc, ok := src.(BlobReferenceSource)
+		if !ok {
+			continue
+		}
+		blobs, err := blobSrc.BlobReferences(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list blob references: %w", err)
+		}
+		for _, d := range blobs {
+			referencedBlobs[strings.TrimPrefix(d, "sha256:")] = true
+		}
+	}
+
+	entries, err := listAllDigests(m.paths.ImagesDir())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GCResult{DryRun: req.DryRun, RemovedImages: []string{}}
+	keptLayoutTags := make(map[string]bool)
+	for _, e := range entries {
+		if !m.collectable(e, referenced) {
+			keptLayoutTags[e.digestHex] = true
+			continue
+		}
+
+		result.RemovedImages = append(result.RemovedImages, e.repository+"@"+e.meta.Digest)
+		result.FreedBytes += fileSize(digestPath(m.paths, e.repository, e.digestHex))
+		if req.DryRun {
+			continue
+		}
+		if err := removeDigest(m.paths, e.repository, e.digestHex); err != nil {
+			return result, fmt.Errorf("remove image %s@%s: %w", e.repository, e.meta.Digest, err)
+		}
+		log.InfoContext(ctx, "garbage collected image", "repository", e.repository, "digest", e.meta.Digest)
+	}
+
+	// Rewriting the shared OCI index races with layout writes from in-flight pulls
+	if m.queue.ActiveCount()+m.queue.PendingCount() > 0 {
+		log.InfoContext(ctx, "image builds in progress, skipping OCI cache collection")
+		return result, nil
+	}
+
+	if err := m.collectOCICache(ctx, keptLayoutTags, referencedBlobs, result); err != nil {
+		return result, fmt.Errorf("collect oci cache: %w", err)
+	}
+
+	return result, nil
+}
+
+// collectable reports whether an image digest can be removed
+func (m *manager) collectable(e digestEntry, referenced map[string]bool) bool {
+	if referenced[e.meta.Digest] {
+		return false
+	}
+	switch e.meta.Status {
+	case StatusPending, StatusPulling, StatusConverting:
+		return false
+	}
+	return time.Since(e.meta.CreatedAt) >= gcGracePeriod
+}
+
+// collectOCICache drops index entries for images no longer kept and deletes
+// blobs unreachable from the remaining entries. referencedBlobs holds blob
+// digest hexes that sources hold outside the layout index.
+func (m *manager) collectOCICache(ctx context.Context, keptLayoutTags, referencedBlobs map[string]bool, result *GCResult) error {
+	log := logger.FromContext(ctx)
+
+	path, err := layout.FromPath(m.paths.SystemOCICache())
+	if err != nil {
+		// No layout yet, nothing cached
+		return nil
+	}
+	index, err := path.ImageIndex()
+	if err != nil {
+		return fmt.Errorf("get image index: %w", err)
+	}
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return fmt.Errorf("get index manifest: %w", err)
+	}
+
+	dropped := make(map[gcr.Hash]bool)
+	var kept []gcr.Descriptor
+	for _, desc := range indexManifest.Manifests {
+		tag := desc.Annotations["org.opencontainers.image.ref.name"]
+		if keptLayoutTags[tag] || recentlyModified(m.paths.OCICacheBlob(desc.Digest.Hex)) {
+			kept = append(kept, desc)
+		} else {
+			dropped[desc.Digest] = true
+		}
+	}
+
+	// Collect blobs reachable from kept manifests. If any manifest can't be
+	// read, its blobs are unknown, so skip the sweep rather than risk data loss.
+	reachable := make(map[string]bool)
+	for hex := range referencedBlobs {
+		reachable[hex] = true
+	}
+	for _, desc := range kept {
+		reachable[desc.Digest.Hex] = true
+		img, err := path.Image(desc.Digest)
+		if err != nil {
+			log.WarnContext(ctx, "unreadable manifest in OCI cache, skipping blob collection", "digest", desc.Digest.String(), "error", err)
+			return nil
+		}
+		manifest, err := img.Manifest()
+		if err != nil {
+			log.WarnContext(ctx, "unreadable manifest in OCI cache, skipping blob collection", "digest", desc.Digest.String(), "error", err)
+			return nil
+		}
+		reachable[manifest.Config.Digest.Hex] = true
+		for _, l := range manifest.Layers {
+			reachable[l.Digest.Hex] = true
+		}
+	}
+
+	if !result.DryRun && len(dropped) > 0 {
+		if err := path.RemoveDescriptors(func(desc gcr.Descriptor) bool {
+			return dropped[desc.Digest]
+		}); err != nil {
+			return fmt.Errorf("remove index entries: %w", err)
+		}
+	}
+
+	blobs, err := os.ReadDir(m.paths.OCICacheBlobDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read blobs dir: %w", err)
+	}
+	for _, blob := range blobs {
+		blobPath := m.paths.OCICacheBlob(blob.Name())
+		if blob.IsDir() || reachable[blob.Name()] || recentlyModified(blobPath) {
+			continue
+		}
+		result.RemovedBlobs++
+		result.FreedBytes += fileSize(blobPath)
+		if result.DryRun {
+			continue
+		}
+		if err := os.Remove(blobPath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove blob %s: %w", blob.Name(), err)
+		}
+	}
+
+	if result.RemovedBlobs > 0 {
+		log.InfoContext(ctx, "garbage collected OCI cache blobs", "count", result.RemovedBlobs, "dry_run", result.DryRun)
+	}
+	return nil
+}
+
+// listAllDigests finds every image digest directory, including untagged ones
+func listAllDigests(imagesDir string) ([]digestEntry, error) {
+	var entries []digestEntry
+	err := filepath.WalkDir(imagesDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != "metadata.json" {
+			return nil // Skip errors and non-metadata files
+		}
+
+		dir := filepath.Dir(path)
+		repository, err := filepath.Rel(imagesDir, filepath.Dir(dir))
+		if err != nil {
+			return nil
+		}
+
+		meta, err := readRawMetadata(path)
+		if err != nil {
+			return nil // Leave unreadable entries alone
+		}
+		entries = append(entries, digestEntry{
+			repository: repository,
+			digestHex:  filepath.Base(dir),
+			meta:       meta,
+		})
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("walk images directory: %w", err)
+	}
+	return entries, nil
+}
+
+// readRawMetadata reads a metadata file without requiring the disk to exist,
+// so digests whose disk went missing can still be collected
+func readRawMetadata(path string) (*imageMetadata, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var meta imageMetadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// removeDigest deletes a digest directory and any tags pointing at it
+func removeDigest(p *paths.Paths, repository, digestHex string) error {
+	tags, err := listTags(p, repository)
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		if target, err := resolveTag(p, repository, tag); err == nil && target == digestHex {
+			if err := deleteTag(p, repository, tag); err != nil && !errors.Is(err, ErrNotFound) {
+				return err
+			}
+		}
+	}
+	return os.RemoveAll(digestDir(p, repository, digestHex))
+}
+
+// recentlyModified reports whether a file was written within gcGracePeriod
+func recentlyModified(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return time.Since(info.ModTime()) < gcGracePeriod
+}
+
+// fileSize returns a file's size, or 0 if it can't be read
+func fileSize(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
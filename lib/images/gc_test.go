@@ -0,0 +1,120 @@
+package images
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/stretchr/testify/require"
+)
+
+type staticRefs []string
+
+func (s staticRefs) ImageReferences(ctx context.Context) ([]string, error) {
+	return s, nil
+}
+
+// writeTestImage creates a digest directory with a disk file and a tag pointing at it
+func writeTestImage(t *testing.T, p *paths.Paths, repository, tag, digest, status string, createdAt time.Time) {
+	t.Helper()
+	digestHex := strings.TrimPrefix(digest, "sha256:")
+	require.NoError(t, os.MkdirAll(digestDir(p, repository, digestHex), 0755))
+	require.NoError(t, os.WriteFile(digestPath(p, repository, digestHex), make([]byte, 4096), 0644))
+	require.NoError(t, writeMetadata(p, repository, digestHex, &imageMetadata{
+		Name:      repository + ":" + tag,
+		Digest:    digest,
+		Status:    status,
+		CreatedAt: createdAt,
+	}))
+	require.NoError(t, createTagSymlink(p, repository, tag, digestHex))
+}
+
+func TestGarbageCollect(t *testing.T) {
+	p := paths.New(t.TempDir())
+	mgr, err := NewManager(p, 1, nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	old := time.Now().Add(-2 * gcGracePeriod)
+	used := "sha256:" + strings.Repeat("a", 64)
+	unused := "sha256:" + strings.Repeat("b", 64)
+	recent := "sha256:" + strings.Repeat("c", 64)
+	pulling := "sha256:" + strings.Repeat("d", 64)
+
+	writeTestImage(t, p, "docker.io/library/used", "latest", used, StatusReady, old)
+	writeTestImage(t, p, "docker.io/library/unused", "latest", unused, StatusReady, old)
+	writeTestImage(t, p, "docker.io/library/recent", "latest", recent, StatusReady, time.Now())
+	writeTestImage(t, p, "docker.io/library/pulling", "latest", pulling, StatusPulling, old)
+
+	_, err = mgr.GarbageCollect(ctx, GCRequest{})
+	require.Error(t, err, "should refuse to run without reference sources")
+
+	mgr.AddReferenceSource(staticRefs{used})
+
+	// Dry run reports but keeps everything
+	result, err := mgr.GarbageCollect(ctx, GCRequest{DryRun: true})
+	require.NoError(t, err)
+	require.True(t, result.DryRun)
+	require.Equal(t, []string{"docker.io/library/unused@" + unused}, result.RemovedImages)
+	require.Equal(t, int64(4096), result.FreedBytes)
+	_, err = mgr.GetImage(ctx, "docker.io/library/unused:latest")
+	require.NoError(t, err)
+
+	result, err = mgr.GarbageCollect(ctx, GCRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"docker.io/library/unused@" + unused}, result.RemovedImages)
+
+	_, err = mgr.GetImage(ctx, "docker.io/library/unused:latest")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.False(t, digestExists(p, "docker.io/library/unused", strings.Repeat("b", 64)))
+
+	for _, name := range []string{"docker.io/library/used:latest", "docker.io/library/recent:latest", "docker.io/library/pulling:latest"} {
+		_, err := mgr.GetImage(ctx, name)
+		require.NoError(t, err, name)
+	}
+}
+
+type blobRefs struct {
+	staticRefs
+	blobs []string
+}
+
+func (b blobRefs) BlobReferences(ctx context.Context) ([]string, error) {
+	return b.blobs, nil
+}
+
+func TestGarbageCollectKeepsReferencedBlobs(t *testing.T) {
+	p := paths.New(t.TempDir())
+	mgr, err := NewManager(p, 1, nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = layout.Write(p.SystemOCICache(), empty.Index)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(p.OCICacheBlobDir(), 0755))
+
+	old := time.Now().Add(-2 * gcGracePeriod)
+	held := strings.Repeat("e", 64)
+	orphan := strings.Repeat("f", 64)
+	for _, hex := range []string{held, orphan} {
+		blob := p.OCICacheBlob(hex)
+		require.NoError(t, os.WriteFile(blob, []byte(hex), 0644))
+		require.NoError(t, os.Chtimes(blob, old, old))
+	}
+
+	mgr.AddReferenceSource(blobRefs{blobs: []string{"sha256:" + held}})
+
+	result, err := mgr.GarbageCollect(ctx, GCRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.RemovedBlobs)
+
+	_, err = os.Stat(p.OCICacheBlob(held))
+	require.NoError(t, err)
+	_, err = os.Stat(p.OCICacheBlob(orphan))
+	require.True(t, os.IsNotExist(err))
+}
@@ -2,6 +2,7 @@ package images
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -38,6 +39,17 @@ type Manager interface {
 	// TotalOCICacheBytes returns the total size of the OCI layer cache.
 	// Used by the resource manager for disk capacity tracking.
 	TotalOCICacheBytes(ctx context.Context) (int64, error)
+	// StreamImagePull streams status changes and layer download progress for
+	// an image build, closing once the image is ready or failed.
+	StreamImagePull(ctx context.Context, name string) (<-chan PullEvent, error)
+	// GarbageCollect removes image disks and OCI cache blobs that no
+	// registered ReferenceSource uses.
+	GarbageCollect(ctx context.Context, req GCRequest) (*GCResult, error)
+	// AddReferenceSource registers a source of in-use image digests for GarbageCollect.
+	AddReferenceSource(src ReferenceSource)
+	// BlockGC holds off garbage collection until release is called. Callers
+	// hold it between resolving an image and recording their reference to it.
+	BlockGC() (release func())
 }
 
 type manager struct {
@@ -46,6 +58,12 @@ type manager struct {
 	queue     *BuildQueue
 	createMu  sync.Mutex
 	metrics   *Metrics
+
+	gcMu       sync.RWMutex
+	refSources []ReferenceSource
+
+	pullsMu sync.RWMutex
+	pulls   map[string]*pullProgress // In-flight builds by digest
 }
 
 // NewManager creates a new image manager.
@@ -62,6 +80,7 @@ func NewManager(p *paths.Paths, maxConcurrentBuilds int, meter metric.Meter) (Ma
 		paths:     p,
 		ociClient: ociClient,
 		queue:     NewBuildQueue(maxConcurrentBuilds),
+		pulls:     make(map[string]*pullProgress),
 	}
 
 	// Initialize metrics if meter is provided
@@ -185,6 +204,8 @@ func (m *manager) createAndQueueImage(ref *ResolvedRef) (*Image, error) {
 		return nil, fmt.Errorf("write initial metadata: %w", err)
 	}
 
+	m.trackPull(ref)
+
 	// Enqueue the build using digest as the queue key for deduplication
 	queuePos := m.queue.Enqueue(ref.Digest(), CreateImageRequest{Name: ref.String()}, func() {
 		m.buildImage(context.Background(), ref)
@@ -216,7 +237,7 @@ func (m *manager) buildImage(ctx context.Context, ref *ResolvedRef) {
 	m.updateStatusByDigest(ref, StatusPulling, nil)
 
 	// Pull the image (digest is always known, uses cache if already pulled)
-	result, err := m.ociClient.pullAndExport(ctx, ref.String(), ref.Digest(), tempDir)
+	result, err := m.ociClient.pullAndExport(ctx, ref.String(), ref.Digest(), tempDir, m.pullLayerProgress(ref.Digest()))
 	if err != nil {
 		m.updateStatusByDigest(ref, StatusFailed, fmt.Errorf("pull and export: %w", err))
 		m.recordPullMetrics(ctx, "failed")
@@ -232,6 +253,7 @@ func (m *manager) buildImage(ctx context.Context, ref *ResolvedRef) {
 			if ref.Tag() != "" {
 				createTagSymlink(m.paths, ref.Repository(), ref.Tag(), ref.DigestHex())
 			}
+			m.pullStatusChanged(ref.Digest(), StatusReady, nil)
 			return
 		}
 	}
@@ -246,6 +268,9 @@ func (m *manager) buildImage(ctx context.Context, ref *ResolvedRef) {
 		return
 	}
 
+	// Share blocks with images built on the same base layers
+	sharedBytes := m.dedupeDisk(ctx, ref, result.Layers)
+
 	// Read current metadata to preserve request info
 	meta, err := readMetadata(m.paths, ref.Repository(), ref.DigestHex())
 	if err != nil {
@@ -265,6 +290,8 @@ func (m *manager) buildImage(ctx context.Context, ref *ResolvedRef) {
 	meta.Cmd = result.Metadata.Cmd
 	meta.Env = result.Metadata.Env
 	meta.WorkingDir = result.Metadata.WorkingDir
+	meta.Layers = result.Layers
+	meta.SharedBytes = sharedBytes
 
 	if err := writeMetadata(m.paths, ref.Repository(), ref.DigestHex(), meta); err != nil {
 		m.updateStatusByDigest(ref, StatusFailed, fmt.Errorf("write final metadata: %w", err))
@@ -278,6 +305,7 @@ func (m *manager) buildImage(ctx context.Context, ref *ResolvedRef) {
 			fmt.Fprintf(os.Stderr, "Warning: failed to create tag symlink: %v\n", err)
 		}
 	}
+	m.pullStatusChanged(ref.Digest(), StatusReady, nil)
 
 	m.recordBuildMetrics(ctx, buildStart, "success")
 }
@@ -302,6 +330,7 @@ func (m *manager) updateStatusByDigest(ref *ResolvedRef, status string, err erro
 	}
 
 	writeMetadata(m.paths, ref.Repository(), ref.DigestHex(), meta)
+	m.pullStatusChanged(ref.Digest(), status, err)
 }
 
 func (m *manager) RecoverInterruptedBuilds() {
@@ -326,6 +355,7 @@ func (m *manager) RecoverInterruptedBuilds() {
 				}
 				// Create a ResolvedRef since we already have the digest from metadata
 				ref := NewResolvedRef(normalized, metaCopy.Digest)
+				m.trackPull(ref)
 				m.queue.Enqueue(metaCopy.Digest, *metaCopy.Request, func() {
 					m.buildImage(context.Background(), ref)
 				})
@@ -353,6 +383,12 @@ func (m *manager) GetImage(ctx context.Context, name string) (*Image, error) {
 		tag := ref.Tag()
 
 		digestHex, err = resolveTag(m.paths, repository, tag)
+		if errors.Is(err, ErrNotFound) {
+			// Tags are only linked once ready; fall back to an in-flight build
+			if p := m.findPull(ref); p != nil {
+				digestHex, err = strings.TrimPrefix(p.digest, "sha256:"), nil
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -12,6 +12,7 @@ import (
 type Metrics struct {
 	buildDuration metric.Float64Histogram
 	pullsTotal    metric.Int64Counter
+	dedupeBytes   metric.Int64Counter
 }
 
 // newMetrics creates and registers all image metrics.
@@ -33,6 +34,15 @@ func newMetrics(meter metric.Meter, m *manager) (*Metrics, error) {
 		return nil, err
 	}
 
+	dedupeBytes, err := meter.Int64Counter(
+		"hypeman_images_dedupe_bytes_total",
+		metric.WithDescription("Total bytes of image disks shared with other image disks at build time"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Register observable gauges for queue length and total images
 	buildQueueLength, err := meter.Int64ObservableGauge(
 		"hypeman_images_build_queue_length",
@@ -50,6 +60,15 @@ func newMetrics(meter metric.Meter, m *manager) (*Metrics, error) {
 		return nil, err
 	}
 
+	sharedBytes, err := meter.Int64ObservableGauge(
+		"hypeman_images_shared_bytes",
+		metric.WithDescription("Disk space saved by blocks shared between cached image disks"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = meter.RegisterCallback(
 		func(ctx context.Context, o metric.Observer) error {
 			// Report queue length
@@ -61,9 +80,12 @@ func newMetrics(meter metric.Meter, m *manager) (*Metrics, error) {
 				return nil
 			}
 			statusCounts := make(map[string]int64)
+			var shared int64
 			for _, meta := range metas {
 				statusCounts[meta.Status]++
+				shared += meta.SharedBytes
 			}
+			o.ObserveInt64(sharedBytes, shared)
 			for status, count := range statusCounts {
 				o.ObserveInt64(imagesTotal, count,
 					metric.WithAttributes(attribute.String("status", status)))
@@ -72,6 +94,7 @@ func newMetrics(meter metric.Meter, m *manager) (*Metrics, error) {
 		},
 		buildQueueLength,
 		imagesTotal,
+		sharedBytes,
 	)
 	if err != nil {
 		return nil, err
@@ -80,6 +103,7 @@ func newMetrics(meter metric.Meter, m *manager) (*Metrics, error) {
 	return &Metrics{
 		buildDuration: buildDuration,
 		pullsTotal:    pullsTotal,
+		dedupeBytes:   dedupeBytes,
 	}, nil
 }
 
@@ -101,3 +125,11 @@ func (m *manager) recordPullMetrics(ctx context.Context, status string) {
 	m.metrics.pullsTotal.Add(ctx, 1,
 		metric.WithAttributes(attribute.String("status", status)))
 }
+
+// recordDedupeMetrics records bytes shared between image disks.
+func (m *manager) recordDedupeMetrics(ctx context.Context, bytes int64) {
+	if m.metrics == nil {
+		return
+	}
+	m.metrics.dedupeBytes.Add(ctx, bytes)
+}
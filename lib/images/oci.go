@@ -105,10 +105,13 @@ func (c *ociClient) inspectManifest(ctx context.Context, imageRef string) (strin
 // pullResult contains the metadata and digest from pulling an image
 type pullResult struct {
 	Metadata *containerMetadata
-	Digest   string // sha256:abc123...
+	Digest   string   // sha256:abc123...
+	Layers   []string // Compressed layer digests, base layer first
 }
 
-func (c *ociClient) pullAndExport(ctx context.Context, imageRef, digest, exportDir string) (*pullResult, error) {
+// pullAndExport pulls an image into the shared layout and unpacks it to exportDir.
+// If progress is non-nil it receives layer download progress.
+func (c *ociClient) pullAndExport(ctx context.Context, imageRef, digest, exportDir string, progress progressFunc) (*pullResult, error) {
 	// Use a shared OCI layout for all images to enable automatic layer caching
 	// The cacheDir itself is the OCI layout root with shared blobs/sha256/ directory
 	// The digest is ALWAYS known at this point (from inspectManifest or digest reference)
@@ -117,7 +120,7 @@ func (c *ociClient) pullAndExport(ctx context.Context, imageRef, digest, exportD
 	// Check if this digest is already cached
 	if !c.existsInLayout(layoutTag) {
 		// Not cached, pull it using digest-based tag
-		if err := c.pullToOCILayout(ctx, imageRef, layoutTag); err != nil {
+		if err := c.pullToOCILayout(ctx, imageRef, layoutTag, progress); err != nil {
 			return nil, fmt.Errorf("pull to oci layout: %w", err)
 		}
 	}
@@ -130,17 +133,19 @@ func (c *ociClient) pullAndExport(ctx context.Context, imageRef, digest, exportD
 	}
 
 	// Unpack layers to the export directory
-	if err := c.unpackLayers(ctx, layoutTag, exportDir); err != nil {
+	layers, err := c.unpackLayers(ctx, layoutTag, exportDir)
+	if err != nil {
 		return nil, fmt.Errorf("unpack layers: %w", err)
 	}
 
 	return &pullResult{
 		Metadata: meta,
 		Digest:   digest,
+		Layers:   layers,
 	}, nil
 }
 
-func (c *ociClient) pullToOCILayout(ctx context.Context, imageRef, layoutTag string) error {
+func (c *ociClient) pullToOCILayout(ctx context.Context, imageRef, layoutTag string, progress progressFunc) error {
 	ref, err := name.ParseReference(imageRef)
 	if err != nil {
 		return fmt.Errorf("parse image reference: %w", err)
@@ -157,6 +162,9 @@ func (c *ociClient) pullToOCILayout(ctx context.Context, imageRef, layoutTag str
 		// Rate limits fail here immediately (429 is not retried by default)
 		return fmt.Errorf("fetch image manifest: %w", wrapRegistryError(err))
 	}
+	if progress != nil {
+		img = &progressImage{Image: img, progress: progress}
+	}
 
 	// Open or create OCI layout directory
 	path, err := layout.FromPath(c.cacheDir)
@@ -281,24 +289,25 @@ func (c *ociClient) extractOCIMetadata(layoutTag string) (*containerMetadata, er
 
 // unpackLayers unpacks all OCI layers to a target directory using umoci
 // Uses go-containerregistry to get the manifest (handles both Docker v2 and OCI v1)
-// then converts it to OCI v1 format for umoci's layer unpacker.
-func (c *ociClient) unpackLayers(ctx context.Context, layoutTag, targetDir string) error {
+// then converts it to OCI v1 format for umoci's layer unpacker. Returns the
+// layer digests in application order.
+func (c *ociClient) unpackLayers(ctx context.Context, layoutTag, targetDir string) ([]string, error) {
 	// Open OCI layout using go-containerregistry (handles Docker v2 and OCI v1)
 	path, err := layout.FromPath(c.cacheDir)
 	if err != nil {
-		return fmt.Errorf("open oci layout: %w", err)
+		return nil, fmt.Errorf("open oci layout: %w", err)
 	}
 
 	// Get the image by annotation tag from the layout
 	img, err := imageByAnnotation(path, layoutTag)
 	if err != nil {
-		return fmt.Errorf("find image by tag %s: %w", layoutTag, err)
+		return nil, fmt.Errorf("find image by tag %s: %w", layoutTag, err)
 	}
 
 	// Get manifest from go-containerregistry
 	gcrManifest, err := img.Manifest()
 	if err != nil {
-		return fmt.Errorf("get manifest: %w", err)
+		return nil, fmt.Errorf("get manifest: %w", err)
 	}
 
 	// Convert go-containerregistry manifest to OCI v1.Manifest for umoci
@@ -307,13 +316,13 @@ func (c *ociClient) unpackLayers(ctx context.Context, layoutTag, targetDir strin
 	// Open the shared OCI layout with umoci for layer unpacking
 	casEngine, err := dir.Open(c.cacheDir)
 	if err != nil {
-		return fmt.Errorf("open oci layout for unpacking: %w", err)
+		return nil, fmt.Errorf("open oci layout for unpacking: %w", err)
 	}
 	defer casEngine.Close()
 
 	// Pre-create target directory (umoci needs it to exist)
 	if err := os.MkdirAll(targetDir, 0755); err != nil {
-		return fmt.Errorf("create target dir: %w", err)
+		return nil, fmt.Errorf("create target dir: %w", err)
 	}
 
 	// Unpack layers using umoci's layer package with rootless mode
@@ -337,10 +346,14 @@ func (c *ociClient) unpackLayers(ctx context.Context, layoutTag, targetDir strin
 
 	err = layer.UnpackRootfs(context.Background(), casEngine, targetDir, ociManifest, unpackOpts)
 	if err != nil {
-		return fmt.Errorf("unpack rootfs: %w", err)
+		return nil, fmt.Errorf("unpack rootfs: %w", err)
 	}
 
-	return nil
+	layers := make([]string, len(gcrManifest.Layers))
+	for i, l := range gcrManifest.Layers {
+		layers[i] = l.Digest.String()
+	}
+	return layers, nil
 }
 
 // convertToOCIManifest converts a go-containerregistry manifest to OCI v1.Manifest
@@ -26,7 +26,7 @@ func (c *OCIClient) InspectManifest(ctx context.Context, imageRef string) (strin
 
 // PullAndUnpack pulls an OCI image and unpacks it to a directory (public for system manager)
 func (c *OCIClient) PullAndUnpack(ctx context.Context, imageRef, digest, exportDir string) error {
-	_, err := c.client.pullAndExport(ctx, imageRef, digest, exportDir)
+	_, err := c.client.pullAndExport(ctx, imageRef, digest, exportDir, nil)
 	if err != nil {
 		return fmt.Errorf("pull and unpack: %w", err)
 	}
@@ -0,0 +1,321 @@
+package images
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	gcr "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+)
+
+// progressInterval limits how often a single layer reports download progress
+const progressInterval = 250 * time.Millisecond
+
+// progressFunc receives download progress for one layer
+type progressFunc func(layer string, downloaded, total int64)
+
+// pullProgress tracks an in-flight image build so it can be streamed.
+// Tags only resolve once an image is ready, so streaming looks pulls up here
+// by name or digest instead of on disk.
+type pullProgress struct {
+	name   string // Normalized ref the build was requested with
+	digest string
+
+	mu          sync.Mutex
+	status      string
+	errMsg      string
+	layers      []string // Layer digests in the order first seen
+	layerEvents map[string]PullEvent
+	subscribers []chan PullEvent
+	done        chan struct{}
+}
+
+// trackPull registers progress tracking for a queued build
+func (m *manager) trackPull(ref *ResolvedRef) {
+	m.pullsMu.Lock()
+	defer m.pullsMu.Unlock()
+	if _, ok := m.pulls[ref.Digest()]; ok {
+		return
+	}
+	m.pulls[ref.Digest()] = &pullProgress{
+		name:        ref.String(),
+		digest:      ref.Digest(),
+		status:      StatusPending,
+		layerEvents: make(map[string]PullEvent),
+		done:        make(chan struct{}),
+	}
+}
+
+// findPull returns the in-flight build for an image name or digest, if any
+func (m *manager) findPull(ref *NormalizedRef) *pullProgress {
+	m.pullsMu.RLock()
+	defer m.pullsMu.RUnlock()
+	if ref.IsDigest() {
+		return m.pulls[ref.Digest()]
+	}
+	for _, p := range m.pulls {
+		if p.name == ref.String() {
+			return p
+		}
+	}
+	return nil
+}
+
+// pullStatusChanged publishes a status change and, for terminal states,
+// finishes tracking the build
+func (m *manager) pullStatusChanged(digest, status string, err error) {
+	m.pullsMu.Lock()
+	p, ok := m.pulls[digest]
+	if ok && (status == StatusReady || status == StatusFailed) {
+		delete(m.pulls, digest)
+	}
+	m.pullsMu.Unlock()
+	if !ok {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.status = status
+	if err != nil {
+		p.errMsg = err.Error()
+	}
+	p.broadcast(p.statusEvent())
+	if status == StatusReady || status == StatusFailed {
+		close(p.done)
+	}
+}
+
+// pullLayerProgress returns a progressFunc that publishes layer progress for a build
+func (m *manager) pullLayerProgress(digest string) progressFunc {
+	return func(layer string, downloaded, total int64) {
+		m.pullsMu.RLock()
+		p, ok := m.pulls[digest]
+		m.pullsMu.RUnlock()
+		if !ok {
+			return
+		}
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if _, seen := p.layerEvents[layer]; !seen {
+			p.layers = append(p.layers, layer)
+		}
+		event := PullEvent{
+			Type:            PullEventTypeProgress,
+			Timestamp:       time.Now(),
+			Layer:           layer,
+			BytesDownloaded: downloaded,
+			BytesTotal:      total,
+		}
+		p.layerEvents[layer] = event
+		p.broadcast(event)
+	}
+}
+
+// statusEvent builds a status event from the current state. Caller holds p.mu.
+func (p *pullProgress) statusEvent() PullEvent {
+	return PullEvent{
+		Type:      PullEventTypeStatus,
+		Timestamp: time.Now(),
+		Status:    p.status,
+		Error:     p.errMsg,
+	}
+}
+
+// broadcast sends an event to all subscribers. Caller holds p.mu.
+func (p *pullProgress) broadcast(event PullEvent) {
+	for _, ch := range p.subscribers {
+		// Non-blocking send - drop if channel is full
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// subscribe registers ch and returns the events describing the current state
+func (p *pullProgress) subscribe(ch chan PullEvent) []PullEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.subscribers = append(p.subscribers, ch)
+
+	snapshot := []PullEvent{p.statusEvent()}
+	for _, layer := range p.layers {
+		snapshot = append(snapshot, p.layerEvents[layer])
+	}
+	return snapshot
+}
+
+// unsubscribe removes a subscriber channel
+func (p *pullProgress) unsubscribe(ch chan PullEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, sub := range p.subscribers {
+		if sub == ch {
+			p.subscribers = append(p.subscribers[:i], p.subscribers[i+1:]...)
+			break
+		}
+	}
+}
+
+// finalStatus returns the terminal status event once the build is done
+func (p *pullProgress) finalStatus() PullEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.statusEvent()
+}
+
+// StreamImagePull streams progress events for an image build.
+// In-flight builds stream status changes and per-layer download progress until
+// they finish; images that aren't building get a single status event.
+func (m *manager) StreamImagePull(ctx context.Context, name string) (<-chan PullEvent, error) {
+	ref, err := ParseNormalizedRef(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidName, err.Error())
+	}
+
+	p := m.findPull(ref)
+	if p == nil {
+		img, err := m.GetImage(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		out := make(chan PullEvent, 1)
+		event := PullEvent{
+			Type:      PullEventTypeStatus,
+			Timestamp: time.Now(),
+			Status:    img.Status,
+		}
+		if img.Error != nil {
+			event.Error = *img.Error
+		}
+		out <- event
+		close(out)
+		return out, nil
+	}
+
+	out := make(chan PullEvent, 100)
+	go func() {
+		defer close(out)
+
+		events := make(chan PullEvent, 100)
+		snapshot := p.subscribe(events)
+		defer p.unsubscribe(events)
+
+		send := func(event PullEvent) bool {
+			select {
+			case out <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for _, event := range snapshot {
+			if !send(event) {
+				return
+			}
+		}
+
+		// Heartbeat ticker (30 seconds)
+		heartbeatTicker := time.NewTicker(30 * time.Second)
+		defer heartbeatTicker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case event := <-events:
+				if !send(event) {
+					return
+				}
+				if event.Status == StatusReady || event.Status == StatusFailed {
+					return
+				}
+
+			case <-p.done:
+				// Terminal status may have been dropped from a full channel
+				send(p.finalStatus())
+				return
+
+			case <-heartbeatTicker.C:
+				if !send(PullEvent{Type: PullEventTypeHeartbeat, Timestamp: time.Now()}) {
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// progressImage wraps a remote image so layer downloads report progress
+type progressImage struct {
+	gcr.Image
+	progress progressFunc
+}
+
+func (i *progressImage) Layers() ([]gcr.Layer, error) {
+	layers, err := i.Image.Layers()
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]gcr.Layer, len(layers))
+	for idx, l := range layers {
+		wrapped[idx] = &progressLayer{Layer: l, progress: i.progress}
+	}
+	return wrapped, nil
+}
+
+// Descriptor keeps the platform and media type of the wrapped image in the layout index
+func (i *progressImage) Descriptor() (*gcr.Descriptor, error) {
+	return partial.Descriptor(i.Image)
+}
+
+// progressLayer wraps a layer so reading its compressed blob reports progress
+type progressLayer struct {
+	gcr.Layer
+	progress progressFunc
+}
+
+func (l *progressLayer) Compressed() (io.ReadCloser, error) {
+	rc, err := l.Layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	d, err := l.Digest()
+	if err != nil {
+		return rc, nil
+	}
+	size, err := l.Size()
+	if err != nil {
+		size = -1
+	}
+	// Blobs already in the layout are never read, so they report nothing
+	return &progressReader{ReadCloser: rc, layer: d.String(), total: size, progress: l.progress}, nil
+}
+
+// progressReader counts bytes read and reports them at most every progressInterval
+type progressReader struct {
+	io.ReadCloser
+	layer      string
+	total      int64
+	read       int64
+	lastReport time.Time
+	progress   progressFunc
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.read += int64(n)
+	if err == io.EOF || time.Since(r.lastReport) >= progressInterval {
+		r.lastReport = time.Now()
+		r.progress(r.layer, r.read, r.total)
+	}
+	return n, err
+}
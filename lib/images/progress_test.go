@@ -0,0 +1,77 @@
+package images
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamImagePull(t *testing.T) {
+	p := paths.New(t.TempDir())
+	m, err := NewManager(p, 1, nil)
+	require.NoError(t, err)
+	mgr := m.(*manager)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	normalized, err := ParseNormalizedRef("docker.io/library/alpine:latest")
+	require.NoError(t, err)
+	ref := NewResolvedRef(normalized, "sha256:"+strings.Repeat("a", 64))
+	require.NoError(t, writeMetadata(p, ref.Repository(), ref.DigestHex(), &imageMetadata{
+		Name:      ref.String(),
+		Digest:    ref.Digest(),
+		Status:    StatusPending,
+		CreatedAt: time.Now(),
+	}))
+	mgr.trackPull(ref)
+
+	// Tag isn't linked until ready, but the in-flight build resolves it
+	img, err := mgr.GetImage(ctx, "docker.io/library/alpine:latest")
+	require.NoError(t, err)
+	require.Equal(t, StatusPending, img.Status)
+
+	mgr.updateStatusByDigest(ref, StatusPulling, nil)
+	progress := mgr.pullLayerProgress(ref.Digest())
+	progress("sha256:layer1", 512, 1024)
+
+	events, err := mgr.StreamImagePull(ctx, "alpine")
+	require.NoError(t, err)
+
+	// Snapshot of current state first
+	ev := <-events
+	require.Equal(t, PullEventTypeStatus, ev.Type)
+	require.Equal(t, StatusPulling, ev.Status)
+	ev = <-events
+	require.Equal(t, PullEventTypeProgress, ev.Type)
+	require.Equal(t, "sha256:layer1", ev.Layer)
+	require.Equal(t, int64(512), ev.BytesDownloaded)
+	require.Equal(t, int64(1024), ev.BytesTotal)
+
+	progress("sha256:layer1", 1024, 1024)
+	ev = <-events
+	require.Equal(t, int64(1024), ev.BytesDownloaded)
+
+	mgr.updateStatusByDigest(ref, StatusFailed, context.DeadlineExceeded)
+	ev = <-events
+	require.Equal(t, StatusFailed, ev.Status)
+	require.NotEmpty(t, ev.Error)
+
+	_, ok := <-events
+	require.False(t, ok, "stream should close after a terminal status")
+
+	// Finished builds report their stored status once
+	events, err = mgr.StreamImagePull(ctx, "docker.io/library/alpine@"+ref.Digest())
+	require.NoError(t, err)
+	ev = <-events
+	require.Equal(t, StatusFailed, ev.Status)
+	_, ok = <-events
+	require.False(t, ok)
+
+	_, err = mgr.StreamImagePull(ctx, "docker.io/library/missing:latest")
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -23,6 +23,13 @@ type imageMetadata struct {
 	Env        map[string]string   `json:"env,omitempty"`
 	WorkingDir string              `json:"working_dir,omitempty"`
 	CreatedAt  time.Time           `json:"created_at"`
+
+	// Layers are the compressed layer digests, base layer first. Used to
+	// pick which existing disks to share blocks with.
+	Layers []string `json:"layers,omitempty"`
+	// SharedBytes is how much of the disk was deduplicated against other
+	// image disks when it was built
+	SharedBytes int64 `json:"shared_bytes,omitempty"`
 }
 
 func (m *imageMetadata) toImage() *Image {
@@ -22,3 +22,34 @@ type CreateImageRequest struct {
 	Name string
 }
 
+// PullEvent is a progress event streamed while an image is pulled and converted
+type PullEvent struct {
+	// Type is one of "status", "progress", or "heartbeat"
+	Type string `json:"type"`
+
+	// Timestamp is when the event occurred
+	Timestamp time.Time `json:"timestamp"`
+
+	// Status is the image build status (only for type="status")
+	Status string `json:"status,omitempty"`
+
+	// Error is the failure message (only for type="status" with status "failed")
+	Error string `json:"error,omitempty"`
+
+	// Layer is the layer digest being downloaded (only for type="progress")
+	Layer string `json:"layer,omitempty"`
+
+	// BytesDownloaded is how much of the layer has been downloaded (only for type="progress")
+	BytesDownloaded int64 `json:"bytes_downloaded,omitempty"`
+
+	// BytesTotal is the compressed layer size, or -1 if unknown (only for type="progress")
+	BytesTotal int64 `json:"bytes_total,omitempty"`
+}
+
+// PullEvent type constants
+const (
+	PullEventTypeStatus    = "status"
+	PullEventTypeProgress  = "progress"
+	PullEventTypeHeartbeat = "heartbeat"
+)
+
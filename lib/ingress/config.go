@@ -143,6 +143,14 @@ func (c *ACMEConfig) IsTLSConfigured() bool {
 	}
 }
 
+// Caddy logs proxied requests under accessLoggerName. Each entry carries the
+// target instance and ingress under these keys.
+const (
+	accessLoggerName     = "http.log.access"
+	accessLogInstanceKey = "hypeman_instance"
+	accessLogIngressKey  = "hypeman_ingress"
+)
+
 // CaddyConfigGenerator generates Caddy configuration from ingress resources.
 type CaddyConfigGenerator struct {
 	paths           *paths.Paths
@@ -151,6 +159,7 @@ type CaddyConfigGenerator struct {
 	adminPort       int
 	acme            ACMEConfig
 	dnsResolverPort int
+	defaultBackend  *IngressTarget // Serves unmatched hostnames instead of a 404
 }
 
 // NewCaddyConfigGenerator creates a new Caddy config generator.
@@ -165,6 +174,12 @@ func NewCaddyConfigGenerator(p *paths.Paths, listenAddress string, adminAddress
 	}
 }
 
+// SetDefaultBackend routes hostnames that match no ingress to target. Pass nil
+// to answer them with a 404.
+func (g *CaddyConfigGenerator) SetDefaultBackend(target *IngressTarget) {
+	g.defaultBackend = target
+}
+
 // GenerateConfig generates the Caddy JSON configuration.
 func (g *CaddyConfigGenerator) GenerateConfig(ctx context.Context, ingresses []Ingress) ([]byte, error) {
 	config := g.buildConfig(ctx, ingresses)
@@ -183,6 +198,10 @@ func (g *CaddyConfigGenerator) buildConfig(ctx context.Context, ingresses []Ingr
 
 	for _, ingress := range ingresses {
 		for _, rule := range ingress.Rules {
+			// TCP rules are served by the ingress manager's TCP proxy
+			if rule.Match.IsTCP() {
+				continue
+			}
 			port := rule.Match.GetPort()
 			listenPorts[port] = true
 
@@ -209,23 +228,38 @@ func (g *CaddyConfigGenerator) buildConfig(ctx context.Context, ingresses []Ingr
 				instanceExpr = rule.Target.Instance
 			}
 
-			// Build DNS hostname for instance resolution
-			// The instance expression may be a Caddy placeholder like {http.request.host.labels.2}
-			// This becomes e.g., "my-api.hypeman.internal" or "{http.request.host.labels.2}.hypeman.internal"
-			dnsHostname := fmt.Sprintf("%s.%s", instanceExpr, dns.Suffix)
-
-			// Build the route with DNS-based dynamic upstreams using the "a" module
-			reverseProxy := map[string]interface{}{
-				"handler": "reverse_proxy",
-				"dynamic_upstreams": map[string]interface{}{
-					"source": "a",
-					"name":   dnsHostname,
-					"port":   fmt.Sprintf("%d", rule.Target.Port),
-					"resolver": map[string]interface{}{
-						"addresses": []string{fmt.Sprintf("127.0.0.1:%d", g.dnsResolverPort)},
-					},
-				},
+			reverseProxy := g.reverseProxyHandler(instanceExpr, rule.Target.Port)
+			if len(rule.Target.Instances) > 0 {
+				reverseProxy = g.loadBalancedProxyHandler(rule.Target)
+			}
+
+			// Tag the access log entry with the upstream instance and the
+			// ingress, so requests can be attributed to them. Which of a
+			// load-balanced target's instances serves a request isn't known
+			// here, so those are attributed to the ingress alone.
+			var handlers []interface{}
+			if len(rule.Target.Instances) == 0 {
+				handlers = append(handlers, map[string]interface{}{
+					"handler": "log_append",
+					"key":     accessLogInstanceKey,
+					"value":   instanceExpr,
+				})
+			}
+			handlers = append(handlers, map[string]interface{}{
+				"handler": "log_append",
+				"key":     accessLogIngressKey,
+				"value":   ingress.ID,
+			})
+
+			// Cap the body before it reaches the proxy, which answers 413
+			// once the limit is exceeded
+			if rule.MaxRequestBodyBytes > 0 {
+				handlers = append(handlers, map[string]interface{}{
+					"handler":  "request_body",
+					"max_size": rule.MaxRequestBodyBytes,
+				})
 			}
+			handlers = append(handlers, reverseProxy)
 
 			route := map[string]interface{}{
 				"match": []interface{}{
@@ -233,7 +267,7 @@ func (g *CaddyConfigGenerator) buildConfig(ctx context.Context, ingresses []Ingr
 						"host": []string{hostnameMatch},
 					},
 				},
-				"handle": []interface{}{reverseProxy},
+				"handle": handlers,
 			}
 
 			// Add terminal to stop processing after this route matches
@@ -277,6 +311,11 @@ func (g *CaddyConfigGenerator) buildConfig(ctx context.Context, ingresses []Ingr
 	for port := range listenPorts {
 		ports = append(ports, port)
 	}
+	// A default backend serves unmatched hostnames even before any ingress
+	// exists, on the default HTTP port
+	if len(ports) == 0 && g.defaultBackend != nil {
+		ports = append(ports, 80)
+	}
 	sort.Ints(ports)
 	listenAddrs := make([]string, 0, len(ports))
 	for _, port := range ports {
@@ -302,7 +341,8 @@ func (g *CaddyConfigGenerator) buildConfig(ctx context.Context, ingresses []Ingr
 		// Use slices.Concat to avoid modifying original slices
 		allRoutes := slices.Concat(redirectRoutes, routes)
 
-		// Add catch-all route at the end to return 404 for unmatched hostnames
+		// Add catch-all route at the end for unmatched hostnames: the default
+		// backend if one is set, a 404 otherwise
 		// This must be last since routes are evaluated in order
 		catchAllRoute := map[string]interface{}{
 			"handle": []interface{}{
@@ -316,10 +356,46 @@ func (g *CaddyConfigGenerator) buildConfig(ctx context.Context, ingresses []Ingr
 				},
 			},
 		}
+		if g.defaultBackend != nil {
+			catchAllRoute = map[string]interface{}{
+				"handle": []interface{}{
+					map[string]interface{}{
+						"handler": "log_append",
+						"key":     accessLogInstanceKey,
+						"value":   g.defaultBackend.Instance,
+					},
+					g.reverseProxyHandler(g.defaultBackend.Instance, g.defaultBackend.Port),
+				},
+			}
+		}
 		allRoutes = append(allRoutes, catchAllRoute)
 
 		server["routes"] = allRoutes
 
+		// A 503 means no upstream resolved, typically because a standby instance
+		// couldn't be woken within the DNS wake timeout. The restore keeps going,
+		// so tell clients to retry once Caddy will have looked the upstream up again.
+		server["errors"] = map[string]interface{}{
+			"routes": []interface{}{
+				map[string]interface{}{
+					"match": []interface{}{
+						map[string]interface{}{"expression": "{http.error.status_code} == 503"},
+					},
+					"handle": []interface{}{
+						map[string]interface{}{
+							"handler":     "static_response",
+							"status_code": 503,
+							"headers": map[string]interface{}{
+								"Content-Type": []string{"text/plain; charset=utf-8"},
+								"Retry-After":  []string{fmt.Sprintf("%d", dns.DefaultTTL)},
+							},
+							"body": "Service Unavailable: instance for {http.request.host} is not ready, retry shortly",
+						},
+					},
+				},
+			},
+		}
+
 		// Configure automatic HTTPS settings
 		if len(tlsHostnames) > 0 {
 			// When we have TLS hostnames, disable only redirects - we handle them explicitly
@@ -333,8 +409,10 @@ func (g *CaddyConfigGenerator) buildConfig(ctx context.Context, ingresses []Ingr
 			}
 		}
 
-		// Disable access logs (per-request logs) - we only want system logs
-		server["logs"] = map[string]interface{}{}
+		// Log every request to the access logger, which writes to its own file
+		server["logs"] = map[string]interface{}{
+			"default_logger_name": "ingress",
+		}
 
 		config["apps"] = map[string]interface{}{
 			"http": map[string]interface{}{
@@ -353,6 +431,28 @@ func (g *CaddyConfigGenerator) buildConfig(ctx context.Context, ingresses []Ingr
 		config["apps"].(map[string]interface{})["tls"] = g.buildTLSConfig(tlsHostnames)
 	}
 
+	// Keep access logs out of caddy.log and write them to their own file,
+	// rolled so it can't fill the disk
+	config["logging"] = map[string]interface{}{
+		"logs": map[string]interface{}{
+			"default": map[string]interface{}{
+				"exclude": []string{accessLoggerName},
+			},
+			"access": map[string]interface{}{
+				"writer": map[string]interface{}{
+					"output":       "file",
+					"filename":     g.paths.CaddyAccessLogFile(),
+					"roll_size_mb": 100,
+					"roll_keep":    3,
+				},
+				"encoder": map[string]interface{}{
+					"format": "json",
+				},
+				"include": []string{accessLoggerName},
+			},
+		},
+	}
+
 	// Configure Caddy storage paths
 	config["storage"] = map[string]interface{}{
 		"module": "file_system",
@@ -362,6 +462,65 @@ func (g *CaddyConfigGenerator) buildConfig(ctx context.Context, ingresses []Ingr
 	return config
 }
 
+// reverseProxyHandler builds a reverse_proxy handler to an instance port. The
+// instance expression may be a Caddy placeholder like
+// {http.request.host.labels.2}. Upstreams are resolved through the internal
+// DNS server with the "a" dynamic upstreams module, e.g. as
+// "my-api.hypeman.internal" or "{http.request.host.labels.2}.hypeman.internal".
+func (g *CaddyConfigGenerator) reverseProxyHandler(instanceExpr string, port int) map[string]interface{} {
+	return map[string]interface{}{
+		"handler":           "reverse_proxy",
+		"dynamic_upstreams": g.instanceUpstreams(instanceExpr, port),
+	}
+}
+
+// loadBalancedProxyHandler builds a reverse_proxy handler that spreads
+// requests across a target's instances, each resolved like a single
+// instance's upstream. With a sticky session, Caddy's cookie policy sends a
+// client back to the instance that served its first request.
+func (g *CaddyConfigGenerator) loadBalancedProxyHandler(target IngressTarget) map[string]interface{} {
+	sources := make([]interface{}, 0, len(target.Instances))
+	for _, instance := range target.Instances {
+		sources = append(sources, g.instanceUpstreams(instance, target.Port))
+	}
+	handler := map[string]interface{}{
+		"handler": "reverse_proxy",
+		"dynamic_upstreams": map[string]interface{}{
+			"source":  "multi",
+			"sources": sources,
+		},
+	}
+	if sticky := target.StickySession; sticky != nil {
+		policy := map[string]interface{}{
+			"policy": "cookie",
+			"name":   sticky.Cookie,
+		}
+		if sticky.TTL > 0 {
+			policy["max_age"] = sticky.TTL.String()
+		}
+		handler["load_balancing"] = map[string]interface{}{
+			"selection_policy": policy,
+		}
+	}
+	return handler
+}
+
+// instanceUpstreams is the "a" dynamic upstreams source for an instance port
+func (g *CaddyConfigGenerator) instanceUpstreams(instanceExpr string, port int) map[string]interface{} {
+	return map[string]interface{}{
+		"source": "a",
+		"name":   fmt.Sprintf("%s.%s", instanceExpr, dns.Suffix),
+		"port":   fmt.Sprintf("%d", port),
+		// Re-resolve as often as the DNS TTL allows, so the first
+		// request to an instance in idle standby reaches the DNS
+		// server, which restores it
+		"refresh": fmt.Sprintf("%ds", dns.DefaultTTL),
+		"resolver": map[string]interface{}{
+			"addresses": []string{fmt.Sprintf("127.0.0.1:%d", g.dnsResolverPort)},
+		},
+	}
+}
+
 // buildTLSConfig builds the TLS automation configuration.
 func (g *CaddyConfigGenerator) buildTLSConfig(hostnames []string) map[string]interface{} {
 	issuer := map[string]interface{}{
@@ -482,11 +641,12 @@ func (g *CaddyConfigGenerator) atomicWrite(path string, data []byte) error {
 	return nil
 }
 
-// HasTLSRules checks if any ingress has TLS enabled.
+// HasTLSRules checks if any ingress has TLS enabled on an HTTP rule. TLS on
+// TCP rules is passed through, so it needs no certificate.
 func HasTLSRules(ingresses []Ingress) bool {
 	for _, ingress := range ingresses {
 		for _, rule := range ingress.Rules {
-			if rule.TLS {
+			if rule.TLS && !rule.Match.IsTCP() {
 				return true
 			}
 		}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/onkernel/hypeman/lib/paths"
 	"github.com/stretchr/testify/assert"
@@ -141,6 +142,10 @@ func TestGenerateConfig_SingleIngress(t *testing.T) {
 	// Verify catch-all 404 route is present
 	assert.Contains(t, configStr, "static_response", "config should contain static_response handler for 404")
 	assert.Contains(t, configStr, "no ingress configured for hostname", "config should contain 404 message")
+
+	// Verify unresolvable upstreams get a 503 with a retry hint
+	assert.Contains(t, configStr, "{http.error.status_code} == 503", "config should handle 503 errors")
+	assert.Contains(t, configStr, "Retry-After", "config should set Retry-After on 503")
 }
 
 func TestGenerateConfig_MultipleRules(t *testing.T) {
@@ -852,3 +857,223 @@ func TestGenerateConfig_DynamicUpstreams(t *testing.T) {
 	assert.Contains(t, configStr, "resolver")
 	assert.Contains(t, configStr, "127.0.0.1:5353")
 }
+
+func TestGenerateConfig_AccessLogs(t *testing.T) {
+	generator, p, cleanup := setupTestGenerator(t)
+	defer cleanup()
+
+	ingresses := []Ingress{
+		{
+			ID:   "ing-123",
+			Name: "test-ingress",
+			Rules: []IngressRule{
+				{
+					Match:  IngressMatch{Hostname: "api.example.com"},
+					Target: IngressTarget{Instance: "my-api", Port: 8080},
+				},
+			},
+		},
+	}
+
+	data, err := generator.GenerateConfig(context.Background(), ingresses)
+	require.NoError(t, err)
+
+	var config map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &config))
+
+	// Access logs are written to their own file and kept out of caddy.log
+	logs := config["logging"].(map[string]interface{})["logs"].(map[string]interface{})
+	access := logs["access"].(map[string]interface{})
+	assert.Equal(t, p.CaddyAccessLogFile(), access["writer"].(map[string]interface{})["filename"])
+	assert.Equal(t, []interface{}{"http.log.access"}, access["include"])
+	assert.Equal(t, []interface{}{"http.log.access"}, logs["default"].(map[string]interface{})["exclude"])
+
+	// The server logs requests, tagged with the upstream instance and ingress
+	server := config["apps"].(map[string]interface{})["http"].(map[string]interface{})["servers"].(map[string]interface{})["ingress"].(map[string]interface{})
+	assert.NotNil(t, server["logs"])
+	handle := server["routes"].([]interface{})[0].(map[string]interface{})["handle"].([]interface{})
+	require.Len(t, handle, 3)
+	assert.Equal(t, map[string]interface{}{"handler": "log_append", "key": "hypeman_instance", "value": "my-api"}, handle[0])
+	assert.Equal(t, map[string]interface{}{"handler": "log_append", "key": "hypeman_ingress", "value": "ing-123"}, handle[1])
+	assert.Equal(t, "reverse_proxy", handle[2].(map[string]interface{})["handler"])
+}
+
+func TestGenerateConfig_MaxRequestBodyBytes(t *testing.T) {
+	generator, _, cleanup := setupTestGenerator(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	ingresses := []Ingress{
+		{
+			ID:   "ing-1",
+			Name: "uploads",
+			Rules: []IngressRule{
+				{Match: IngressMatch{Hostname: "upload.example.com"}, Target: IngressTarget{Instance: "uploader", Port: 8080}, MaxRequestBodyBytes: 10 << 20},
+				{Match: IngressMatch{Hostname: "api.example.com"}, Target: IngressTarget{Instance: "api", Port: 8080}},
+			},
+		},
+	}
+
+	var firstOutput []byte
+	for i := 0; i < 5; i++ {
+		data, err := generator.GenerateConfig(ctx, ingresses)
+		require.NoError(t, err)
+		if firstOutput == nil {
+			firstOutput = data
+		} else {
+			assert.Equal(t, string(firstOutput), string(data), "config output should be deterministic on iteration %d", i)
+		}
+	}
+
+	var config map[string]interface{}
+	require.NoError(t, json.Unmarshal(firstOutput, &config))
+	server := config["apps"].(map[string]interface{})["http"].(map[string]interface{})["servers"].(map[string]interface{})["ingress"].(map[string]interface{})
+	routes := server["routes"].([]interface{})
+
+	// The limited rule gets a request_body handler right before the proxy
+	handle := routes[0].(map[string]interface{})["handle"].([]interface{})
+	require.Len(t, handle, 4)
+	assert.Equal(t, map[string]interface{}{"handler": "request_body", "max_size": float64(10 << 20)}, handle[2])
+	assert.Equal(t, "reverse_proxy", handle[3].(map[string]interface{})["handler"])
+
+	// Rules without a limit don't
+	assert.NotContains(t, string(firstOutput), `"max_size": 0`)
+	handle = routes[1].(map[string]interface{})["handle"].([]interface{})
+	require.Len(t, handle, 3)
+	assert.Equal(t, "reverse_proxy", handle[2].(map[string]interface{})["handler"])
+}
+
+func TestGenerateConfig_SkipsTCPRules(t *testing.T) {
+	generator, _, cleanup := setupTestGenerator(t)
+	defer cleanup()
+
+	ingresses := []Ingress{
+		{
+			ID:   "ing-1",
+			Name: "db",
+			Rules: []IngressRule{
+				{Match: IngressMatch{Hostname: "db.example.com", Port: 5432, Protocol: ProtocolTCP}, Target: IngressTarget{Instance: "postgres", Port: 5432}},
+			},
+		},
+	}
+
+	data, err := generator.GenerateConfig(context.Background(), ingresses)
+	require.NoError(t, err)
+
+	// TCP rules are served by the ingress manager, so Caddy gets no server
+	var config map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &config))
+	assert.Nil(t, config["apps"])
+	assert.NotContains(t, string(data), "5432")
+}
+
+func TestGenerateConfig_DefaultBackend(t *testing.T) {
+	generator, _, cleanup := setupTestGenerator(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	ingresses := []Ingress{
+		{
+			ID:   "ing-1",
+			Name: "api",
+			Rules: []IngressRule{
+				{Match: IngressMatch{Hostname: "api.example.com", Port: 8080}, Target: IngressTarget{Instance: "my-api", Port: 8080}},
+			},
+		},
+	}
+	serverOf := func(data []byte) map[string]interface{} {
+		var config map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &config))
+		return config["apps"].(map[string]interface{})["http"].(map[string]interface{})["servers"].(map[string]interface{})["ingress"].(map[string]interface{})
+	}
+
+	// Without a default backend, unmatched hostnames get a 404
+	data, err := generator.GenerateConfig(ctx, ingresses)
+	require.NoError(t, err)
+	routes := serverOf(data)["routes"].([]interface{})
+	catchAll := routes[len(routes)-1].(map[string]interface{})["handle"].([]interface{})
+	assert.Equal(t, "static_response", catchAll[0].(map[string]interface{})["handler"])
+	assert.Equal(t, float64(404), catchAll[0].(map[string]interface{})["status_code"])
+
+	// With one, they are proxied to it, after every ingress route
+	generator.SetDefaultBackend(&IngressTarget{Instance: "fallback", Port: 3000})
+	data, err = generator.GenerateConfig(ctx, ingresses)
+	require.NoError(t, err)
+	server := serverOf(data)
+	assert.Equal(t, []interface{}{"0.0.0.0:8080"}, server["listen"])
+	routes = server["routes"].([]interface{})
+	require.Len(t, routes, 2)
+	catchAll = routes[1].(map[string]interface{})["handle"].([]interface{})
+	require.Len(t, catchAll, 2)
+	assert.Equal(t, "fallback", catchAll[0].(map[string]interface{})["value"])
+	proxy := catchAll[1].(map[string]interface{})
+	assert.Equal(t, "reverse_proxy", proxy["handler"])
+	upstreams := proxy["dynamic_upstreams"].(map[string]interface{})
+	assert.Equal(t, "fallback.hypeman.internal", upstreams["name"])
+	assert.Equal(t, "3000", upstreams["port"])
+	assert.NotContains(t, string(data), "Not Found: no ingress configured")
+
+	// Without ingresses, the default backend is served on port 80
+	data, err = generator.GenerateConfig(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"0.0.0.0:80"}, serverOf(data)["listen"])
+}
+
+func TestGenerateConfig_LoadBalancedStickySession(t *testing.T) {
+	generator, _, cleanup := setupTestGenerator(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	ingresses := []Ingress{
+		{
+			ID:   "ing-1",
+			Name: "web",
+			Rules: []IngressRule{
+				{
+					Match: IngressMatch{Hostname: "web.example.com"},
+					Target: IngressTarget{
+						Instances:     []string{"web-1", "web-2"},
+						Port:          8080,
+						StickySession: &StickySession{Cookie: "hm_lb", TTL: time.Hour},
+					},
+				},
+			},
+		},
+	}
+
+	var firstOutput []byte
+	for i := 0; i < 5; i++ {
+		data, err := generator.GenerateConfig(ctx, ingresses)
+		require.NoError(t, err)
+		if firstOutput == nil {
+			firstOutput = data
+		} else {
+			assert.Equal(t, string(firstOutput), string(data), "config output should be deterministic on iteration %d", i)
+		}
+	}
+
+	var config map[string]interface{}
+	require.NoError(t, json.Unmarshal(firstOutput, &config))
+	server := config["apps"].(map[string]interface{})["http"].(map[string]interface{})["servers"].(map[string]interface{})["ingress"].(map[string]interface{})
+	handle := server["routes"].([]interface{})[0].(map[string]interface{})["handle"].([]interface{})
+
+	// Only the ingress is tagged; the instance isn't known until the proxy picks one
+	require.Len(t, handle, 2)
+	assert.Equal(t, accessLogIngressKey, handle[0].(map[string]interface{})["key"])
+	proxy := handle[1].(map[string]interface{})
+
+	upstreams := proxy["dynamic_upstreams"].(map[string]interface{})
+	assert.Equal(t, "multi", upstreams["source"])
+	sources := upstreams["sources"].([]interface{})
+	require.Len(t, sources, 2)
+	assert.Equal(t, "web-1.hypeman.internal", sources[0].(map[string]interface{})["name"])
+	assert.Equal(t, "web-2.hypeman.internal", sources[1].(map[string]interface{})["name"])
+
+	assert.Equal(t, map[string]interface{}{
+		"selection_policy": map[string]interface{}{
+			"policy":  "cookie",
+			"name":    "hm_lb",
+			"max_age": "1h0m0s",
+		},
+	}, proxy["load_balancing"])
+}
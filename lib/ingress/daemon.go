@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -27,6 +28,9 @@ const (
 	// processExitPollInterval is the interval for polling process exit during shutdown.
 	// This is faster than adminPollInterval to ensure responsive shutdown.
 	processExitPollInterval = 50 * time.Millisecond
+
+	// validateTimeout bounds a `caddy validate` run.
+	validateTimeout = 30 * time.Second
 )
 
 // CaddyDaemon manages the Caddy proxy daemon lifecycle.
@@ -37,6 +41,7 @@ type CaddyDaemon struct {
 	adminPort      int // actual port to use (resolved from config or picked fresh)
 	pid            int
 	stopOnShutdown bool
+	binaryPath     string // set once the binary has been extracted
 }
 
 // NewCaddyDaemon creates a new CaddyDaemon manager.
@@ -116,10 +121,31 @@ func (d *CaddyDaemon) readPortFromConfig() int {
 	return port
 }
 
+// binary returns the path to the Caddy binary, extracting it on first use.
+func (d *CaddyDaemon) binary() (string, error) {
+	if d.binaryPath != "" {
+		return d.binaryPath, nil
+	}
+	binaryPath, err := GetCaddyBinaryPath(d.paths)
+	if err != nil {
+		return "", err
+	}
+	d.binaryPath = binaryPath
+	return binaryPath, nil
+}
+
+// env returns the environment Caddy runs with.
+func (d *CaddyDaemon) env() []string {
+	return append(os.Environ(),
+		fmt.Sprintf("XDG_DATA_HOME=%s", d.paths.CaddyDataDir()),
+		fmt.Sprintf("XDG_CONFIG_HOME=%s", d.paths.CaddyConfigDir()),
+	)
+}
+
 // startCaddy starts a new Caddy process.
 func (d *CaddyDaemon) startCaddy(ctx context.Context) (int, error) {
 	// Get binary path (extracts if needed)
-	binaryPath, err := GetCaddyBinaryPath(d.paths)
+	binaryPath, err := d.binary()
 	if err != nil {
 		return 0, fmt.Errorf("get caddy binary: %w", err)
 	}
@@ -146,10 +172,7 @@ func (d *CaddyDaemon) startCaddy(ctx context.Context) (int, error) {
 	cmd := exec.Command(binaryPath, args...)
 
 	// Set environment for Caddy data/config paths
-	cmd.Env = append(os.Environ(),
-		fmt.Sprintf("XDG_DATA_HOME=%s", d.paths.CaddyDataDir()),
-		fmt.Sprintf("XDG_CONFIG_HOME=%s", d.paths.CaddyConfigDir()),
-	)
+	cmd.Env = d.env()
 
 	// Daemonize: create new session to fully detach from parent
 	cmd.SysProcAttr = &syscall.SysProcAttr{
@@ -304,6 +327,57 @@ func (d *CaddyDaemon) ReloadConfig(config []byte) error {
 	return nil
 }
 
+// ValidateConfig checks a config with `caddy validate`, which loads and
+// provisions every module as a /load would, without starting listeners or
+// touching the running Caddy. The admin API has no dry run, and a rejected
+// /load is only seen after the ingress change has been made. Returns
+// ErrConfigValidationFailed with Caddy's message if the config is invalid.
+func (d *CaddyDaemon) ValidateConfig(ctx context.Context, config []byte) error {
+	binaryPath, err := d.binary()
+	if err != nil {
+		return fmt.Errorf("get caddy binary: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(d.paths.CaddyDir(), "validate-*.json")
+	if err != nil {
+		return fmt.Errorf("create temp config: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(config)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("write temp config: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, validateTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, binaryPath, "validate", "--config", tmp.Name())
+	cmd.Env = d.env()
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return fmt.Errorf("run caddy validate: %w", err)
+	}
+	return fmt.Errorf("%w: %s", ErrConfigValidationFailed, validationMessage(string(output)))
+}
+
+// validationMessage extracts the error from `caddy validate` output, which
+// ends with an "Error: ..." line after its JSON logs.
+func validationMessage(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if msg, ok := strings.CutPrefix(lines[i], "Error: "); ok {
+			return msg
+		}
+	}
+	return lines[len(lines)-1]
+}
+
 // DiscoverRunning checks if Caddy is already running and returns its PID.
 func (d *CaddyDaemon) DiscoverRunning() (int, bool) {
 	// First, try to read PID file
@@ -0,0 +1,45 @@
+package ingress
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCaddy writes a script standing in for the Caddy binary
+func fakeCaddy(t *testing.T, script string) (*CaddyDaemon, string) {
+	t.Helper()
+	p := paths.New(t.TempDir())
+	require.NoError(t, os.MkdirAll(p.CaddyDir(), 0755))
+	binary := filepath.Join(t.TempDir(), "caddy")
+	require.NoError(t, os.WriteFile(binary, []byte("#!/bin/sh\n"+script), 0755))
+
+	d := NewCaddyDaemon(p, "127.0.0.1", 2019, false)
+	d.binaryPath = binary
+	return d, p.CaddyDir()
+}
+
+func TestValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	// The config is passed as a file, which is removed afterwards
+	d, caddyDir := fakeCaddy(t, `[ "$1" = validate ] && [ "$2" = --config ] && grep -q '"admin"' "$3"`)
+	require.NoError(t, d.ValidateConfig(ctx, []byte(`{"admin": {}}`)))
+	entries, err := os.ReadDir(caddyDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	// A rejected config returns Caddy's message
+	d, _ = fakeCaddy(t, `echo '{"level":"info","msg":"using config from file"}'
+echo 'Error: provisioning http app: loading handler modules: unknown module: http.handlers.bogus'
+exit 1`)
+	err = d.ValidateConfig(ctx, []byte(`{}`))
+	require.ErrorIs(t, err, ErrConfigValidationFailed)
+	assert.Contains(t, err.Error(), "unknown module: http.handlers.bogus")
+	assert.NotContains(t, err.Error(), "using config from file")
+}
@@ -0,0 +1,63 @@
+package ingress
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/onkernel/hypeman/lib/dns"
+)
+
+// Ensure manager implements dns.ServiceResolver
+var _ dns.ServiceResolver = (*manager)(nil)
+
+// ResolveInstanceService returns the data for an instance's SRV and TXT
+// records: every port an ingress routes to on it, and its state and image.
+// A rule with a pattern target like "{instance}" may route to any instance,
+// so its port is included for all of them.
+func (m *manager) ResolveInstanceService(ctx context.Context, nameOrID string) (*dns.ServiceInfo, error) {
+	_, id, err := m.instanceResolver.ResolveInstance(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	state, image, err := m.instanceResolver.InstanceInfo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	ingresses, err := m.loadAllIngresses()
+	m.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var ports []int
+	for _, ing := range ingresses {
+		for _, rule := range ing.Rules {
+			if !m.targetMayRouteTo(ctx, rule.Target, id) {
+				continue
+			}
+			if !slices.Contains(ports, rule.Target.Port) {
+				ports = append(ports, rule.Target.Port)
+			}
+		}
+	}
+	slices.Sort(ports)
+
+	return &dns.ServiceInfo{Ports: ports, State: state, Image: image}, nil
+}
+
+// targetMayRouteTo reports whether a target routes to the instance with the
+// given ID, or may, for a pattern target.
+func (m *manager) targetMayRouteTo(ctx context.Context, target IngressTarget, id string) bool {
+	for _, instance := range target.InstanceNames() {
+		if strings.Contains(instance, "{") {
+			return true
+		}
+		if _, targetID, err := m.instanceResolver.ResolveInstance(ctx, instance); err == nil && targetID == id {
+			return true
+		}
+	}
+	return false
+}
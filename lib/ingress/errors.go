@@ -28,7 +28,7 @@ var (
 	ErrHostnameInUse = errors.New("hostname already in use by another ingress")
 
 	// ErrConfigValidationFailed is returned when Caddy config validation fails.
-	// This indicates the config was rejected by Caddy's admin API.
+	// This indicates Caddy rejected the generated config; the working one is kept.
 	ErrConfigValidationFailed = errors.New("config validation failed")
 
 	// ErrPortInUse is returned when the requested port is already in use by another process.
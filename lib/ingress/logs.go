@@ -13,49 +13,76 @@ import (
 	"github.com/onkernel/hypeman/lib/paths"
 )
 
-// CaddyLogForwarder tails Caddy's system log and forwards to OTEL.
+// instanceIDCacheTTL is how long an instance name's ID is reused when
+// attributing access log entries, to avoid a lookup per request
+const instanceIDCacheTTL = 30 * time.Second
+
+// maxCachedInstanceIDs bounds the cache, since pattern hostnames let clients
+// send requests for arbitrary instance names
+const maxCachedInstanceIDs = 1024
+
+// CaddyLogForwarder tails Caddy's system and access logs and forwards to OTEL.
 type CaddyLogForwarder struct {
-	paths  *paths.Paths
-	logger *slog.Logger
-	cmd    *exec.Cmd
-	cancel context.CancelFunc
-	wg     sync.WaitGroup
+	paths    *paths.Paths
+	logger   *slog.Logger
+	resolver InstanceResolver
+	cmds     []*exec.Cmd
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+
+	idMu sync.Mutex
+	ids  map[string]cachedInstanceID
 }
 
-// NewCaddyLogForwarder creates a new log forwarder.
-func NewCaddyLogForwarder(p *paths.Paths, logger *slog.Logger) *CaddyLogForwarder {
+type cachedInstanceID struct {
+	id      string
+	expires time.Time
+}
+
+// NewCaddyLogForwarder creates a new log forwarder. The resolver maps the
+// instance names in access log entries to instance IDs.
+func NewCaddyLogForwarder(p *paths.Paths, logger *slog.Logger, resolver InstanceResolver) *CaddyLogForwarder {
 	return &CaddyLogForwarder{
-		paths:  p,
-		logger: logger,
+		paths:    p,
+		logger:   logger,
+		resolver: resolver,
+		ids:      make(map[string]cachedInstanceID),
 	}
 }
 
-// Start begins tailing Caddy's log file and forwarding to OTEL.
+// Start begins tailing Caddy's log files and forwarding to OTEL.
 func (f *CaddyLogForwarder) Start(ctx context.Context) error {
 	ctx, f.cancel = context.WithCancel(ctx)
 
 	// Caddy writes JSON logs to stderr, which daemon.go redirects to CaddyLogFile
-	logPath := f.paths.CaddyLogFile()
+	if err := f.tail(ctx, f.paths.CaddyLogFile(), f.forwardLogLine); err != nil {
+		return err
+	}
+	// Access logs go to their own file, see CaddyConfigGenerator
+	return f.tail(ctx, f.paths.CaddyAccessLogFile(), f.forwardAccessLogLine)
+}
 
+// tail follows a log file, passing each new line to forward.
+func (f *CaddyLogForwarder) tail(ctx context.Context, logPath string, forward func(context.Context, string)) error {
 	// Use tail -F (capital F) to follow file even if it's recreated
-	f.cmd = exec.CommandContext(ctx, "tail", "-F", "-n", "0", logPath)
+	cmd := exec.CommandContext(ctx, "tail", "-F", "-n", "0", logPath)
 
-	stdout, err := f.cmd.StdoutPipe()
+	stdout, err := cmd.StdoutPipe()
 	if err != nil {
 		return err
 	}
 
-	if err := f.cmd.Start(); err != nil {
+	if err := cmd.Start(); err != nil {
 		return err
 	}
+	f.cmds = append(f.cmds, cmd)
 
 	f.wg.Add(1)
 	go func() {
 		defer f.wg.Done()
 		scanner := bufio.NewScanner(stdout)
 		for scanner.Scan() {
-			line := scanner.Text()
-			f.forwardLogLine(ctx, line)
+			forward(ctx, scanner.Text())
 		}
 	}()
 
@@ -67,8 +94,11 @@ func (f *CaddyLogForwarder) Stop() {
 	if f.cancel != nil {
 		f.cancel()
 	}
-	if f.cmd != nil && f.cmd.Process != nil {
-		if err := f.cmd.Process.Kill(); err != nil && f.logger != nil {
+	for _, cmd := range f.cmds {
+		if cmd.Process == nil {
+			continue
+		}
+		if err := cmd.Process.Kill(); err != nil && f.logger != nil {
 			f.logger.Debug("failed to kill tail process", "error", err)
 		}
 	}
@@ -137,3 +167,89 @@ func (f *CaddyLogForwarder) forwardLogLine(ctx context.Context, line string) {
 		f.logger.InfoContext(ctx, msg, attrs...)
 	}
 }
+
+// caddyAccessLogEntry represents a parsed Caddy access log entry.
+type caddyAccessLogEntry struct {
+	Level   string `json:"level"`
+	Request struct {
+		RemoteIP string `json:"remote_ip"`
+		Proto    string `json:"proto"`
+		Method   string `json:"method"`
+		Host     string `json:"host"`
+		URI      string `json:"uri"`
+	} `json:"request"`
+	Status   int     `json:"status"`
+	Size     int64   `json:"size"`
+	Duration float64 `json:"duration"` // Seconds
+	Instance string  `json:"hypeman_instance"`
+	Ingress  string  `json:"hypeman_ingress"`
+}
+
+// forwardAccessLogLine parses an access log line and forwards it to the OTEL
+// logger, attributed to the upstream instance.
+func (f *CaddyLogForwarder) forwardAccessLogLine(ctx context.Context, line string) {
+	if f.logger == nil || !strings.HasPrefix(line, "{") {
+		return
+	}
+
+	var entry caddyAccessLogEntry
+	if err := json.Unmarshal([]byte(line), &entry); err != nil {
+		f.logger.DebugContext(ctx, "failed to parse caddy access log entry", "error", err)
+		return
+	}
+
+	attrs := []any{
+		"hostname", entry.Request.Host,
+		"method", entry.Request.Method,
+		"uri", entry.Request.URI,
+		"proto", entry.Request.Proto,
+		"remote_ip", entry.Request.RemoteIP,
+		"status", entry.Status,
+		"size", entry.Size,
+		"latency_ms", entry.Duration * 1000,
+	}
+	if entry.Ingress != "" {
+		attrs = append(attrs, "ingress_id", entry.Ingress)
+	}
+	if entry.Instance != "" {
+		attrs = append(attrs, "instance", entry.Instance)
+		if id := f.instanceID(ctx, entry.Instance); id != "" {
+			attrs = append(attrs, "instance_id", id)
+		}
+	}
+
+	// Caddy logs 5xx responses at error level
+	if strings.ToLower(entry.Level) == "error" {
+		f.logger.ErrorContext(ctx, "ingress request", attrs...)
+		return
+	}
+	f.logger.InfoContext(ctx, "ingress request", attrs...)
+}
+
+// instanceID returns the ID of the named instance, or "" if it can't be
+// resolved (e.g. it was deleted). Results are cached for instanceIDCacheTTL.
+func (f *CaddyLogForwarder) instanceID(ctx context.Context, name string) string {
+	if f.resolver == nil {
+		return ""
+	}
+
+	f.idMu.Lock()
+	cached, ok := f.ids[name]
+	f.idMu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.id
+	}
+
+	_, id, err := f.resolver.ResolveInstance(ctx, name)
+	if err != nil {
+		id = ""
+	}
+
+	f.idMu.Lock()
+	if len(f.ids) >= maxCachedInstanceIDs {
+		clear(f.ids)
+	}
+	f.ids[name] = cachedInstanceID{id: id, expires: time.Now().Add(instanceIDCacheTTL)}
+	f.idMu.Unlock()
+	return id
+}
@@ -0,0 +1,52 @@
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardAccessLogLine(t *testing.T) {
+	var buf bytes.Buffer
+	otelLogger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	resolver := newMockResolver()
+	resolver.AddInstanceFull("my-api", "my-api-id", "10.100.0.10")
+	f := NewCaddyLogForwarder(paths.New(t.TempDir()), otelLogger, resolver)
+
+	line := `{"level":"info","ts":1700000000.5,"logger":"http.log.access.ingress","msg":"handled request",` +
+		`"request":{"remote_ip":"203.0.113.7","proto":"HTTP/1.1","method":"GET","host":"api.example.com","uri":"/health"},` +
+		`"duration":0.0125,"size":2,"status":200,"hypeman_instance":"my-api","hypeman_ingress":"ing-123"}`
+	f.forwardAccessLogLine(context.Background(), line)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "ingress request", record["msg"])
+	assert.Equal(t, "api.example.com", record["hostname"])
+	assert.Equal(t, float64(200), record["status"])
+	assert.InDelta(t, 12.5, record["latency_ms"], 0.001)
+	assert.Equal(t, "my-api", record["instance"])
+	assert.Equal(t, "my-api-id", record["instance_id"])
+	assert.Equal(t, "ing-123", record["ingress_id"])
+
+	// A 5xx is logged at error level, and an unknown instance has no ID
+	buf.Reset()
+	f.forwardAccessLogLine(context.Background(), `{"level":"error","request":{"host":"gone.example.com"},"status":503,"hypeman_instance":"gone"}`)
+	record = nil
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "ERROR", record["level"])
+	assert.Equal(t, "gone", record["instance"])
+	assert.NotContains(t, record, "instance_id")
+
+	// Non-JSON output from tail is ignored
+	buf.Reset()
+	f.forwardAccessLogLine(context.Background(), "tail: file truncated")
+	assert.Empty(t, buf.String())
+}
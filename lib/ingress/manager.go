@@ -4,16 +4,20 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/nrednav/cuid2"
 	"github.com/onkernel/hypeman/lib/dns"
 	"github.com/onkernel/hypeman/lib/logger"
 	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/onkernel/hypeman/lib/refindex"
 )
 
 // InstanceResolver provides instance resolution capabilities.
@@ -30,6 +34,18 @@ type InstanceResolver interface {
 	// ResolveInstance resolves an instance name, ID, or ID prefix to its canonical name and ID.
 	// Returns (name, id, nil) if found, or an error if the instance doesn't exist.
 	ResolveInstance(ctx context.Context, nameOrID string) (name string, id string, err error)
+
+	// WakeInstance restores an instance that idled into standby, returning once
+	// it is running. It is a no-op for any other instance.
+	WakeInstance(ctx context.Context, nameOrID string) error
+
+	// CountResolvableInstances returns how many instances currently have an
+	// IP the DNS server can resolve.
+	CountResolvableInstances(ctx context.Context) (int, error)
+
+	// InstanceInfo returns the state and image of an instance by name, ID,
+	// or ID prefix.
+	InstanceInfo(ctx context.Context, nameOrID string) (state string, image string, err error)
 }
 
 // Manager is the interface for managing ingress resources.
@@ -49,6 +65,17 @@ type Manager interface {
 	// List returns all ingress resources.
 	List(ctx context.Context) ([]Ingress, error)
 
+	// IngressesTargeting returns the names of the ingresses with a rule that
+	// routes to the given instance, named by name or ID.
+	IngressesTargeting(ctx context.Context, name, id string) ([]string, error)
+
+	// SetReferenceIndex makes the manager record in idx the instances each
+	// ingress routes to, and consult it in IngressesTargeting.
+	SetReferenceIndex(idx *refindex.Index)
+
+	// IndexedReferences returns the instances each ingress routes to.
+	IndexedReferences(ctx context.Context) (map[refindex.Ref][]refindex.Ref, error)
+
 	// Delete removes an ingress resource by ID, name, or ID prefix.
 	// Lookup order: exact ID match -> exact name match -> ID prefix match.
 	// Returns ErrAmbiguousName if prefix matches multiple ingresses.
@@ -60,6 +87,22 @@ type Manager interface {
 	// AdminURL returns the Caddy admin API URL.
 	// Only valid after Initialize() has been called.
 	AdminURL() string
+
+	// GetDefaultBackend returns the instance that serves hostnames matching no
+	// ingress, or nil if they get a 404.
+	GetDefaultBackend(ctx context.Context) (*IngressTarget, error)
+
+	// SetDefaultBackend routes hostnames matching no ingress to port of the
+	// given instance, on every HTTP listen port (port 80 if there are none).
+	SetDefaultBackend(ctx context.Context, instance string, port int) (*IngressTarget, error)
+
+	// ClearDefaultBackend restores the 404 for hostnames matching no ingress.
+	ClearDefaultBackend(ctx context.Context) error
+
+	// LookupInstanceDNS resolves an instance's .hypeman.internal name through
+	// the internal DNS server, the way Caddy resolves upstreams. Returns
+	// dns.ErrNotRunning if the DNS server hasn't been started.
+	LookupInstanceDNS(ctx context.Context, instance string) (*DNSLookup, error)
 }
 
 // DefaultDNSPort is the default port for the internal DNS server.
@@ -84,6 +127,11 @@ type Config struct {
 	// When false, Caddy continues running independently.
 	StopOnShutdown bool
 
+	// DNSServiceRecords makes the internal DNS server also answer SRV records
+	// with the ports ingresses route to and TXT records with instance state
+	// and image, for service discovery (default: false).
+	DNSServiceRecords bool
+
 	// ACME configuration for TLS certificates
 	ACME ACMEConfig
 }
@@ -107,18 +155,23 @@ type manager struct {
 	configGenerator  *CaddyConfigGenerator
 	logForwarder     *CaddyLogForwarder
 	dnsServer        *dns.Server
+	tcpProxy         *tcpProxy
+	reloader         *configReloader
+	defaultBackend   *IngressTarget
+	refIndex         atomic.Pointer[refindex.Index]
 	mu               sync.RWMutex
 }
 
 // NewManager creates a new ingress manager.
-// If otelLogger is non-nil, Caddy system logs will be forwarded to OTEL.
+// If otelLogger is non-nil, Caddy system logs and access logs of proxied
+// requests will be forwarded to OTEL.
 func NewManager(p *paths.Paths, config Config, instanceResolver InstanceResolver, otelLogger *slog.Logger) Manager {
 	daemon := NewCaddyDaemon(p, config.AdminAddress, config.AdminPort, config.StopOnShutdown)
 
 	// Create log forwarder if OTEL logger is provided
 	var logForwarder *CaddyLogForwarder
 	if otelLogger != nil {
-		logForwarder = NewCaddyLogForwarder(p, otelLogger)
+		logForwarder = NewCaddyLogForwarder(p, otelLogger, instanceResolver)
 	}
 
 	// Create DNS server for instance resolution
@@ -137,7 +190,7 @@ func NewManager(p *paths.Paths, config Config, instanceResolver InstanceResolver
 		dnsServer.Port(),
 	)
 
-	return &manager{
+	m := &manager{
 		paths:            p,
 		config:           config,
 		instanceResolver: instanceResolver,
@@ -145,7 +198,13 @@ func NewManager(p *paths.Paths, config Config, instanceResolver InstanceResolver
 		configGenerator:  configGenerator,
 		logForwarder:     logForwarder,
 		dnsServer:        dnsServer,
+		tcpProxy:         newTCPProxy(config.ListenAddress, instanceResolver, otelLogger),
+	}
+	m.reloader = newConfigReloader(m.applyConfig)
+	if config.DNSServiceRecords {
+		dnsServer.SetServiceResolver(m)
 	}
+	return m
 }
 
 // Initialize starts the ingress subsystem.
@@ -189,6 +248,13 @@ func (m *manager) Initialize(ctx context.Context) error {
 		m.dnsServer.Port(),
 	)
 
+	defaultBackend, err := loadDefaultBackend(m.paths)
+	if err != nil {
+		return fmt.Errorf("load default backend: %w", err)
+	}
+	m.defaultBackend = defaultBackend
+	m.configGenerator.SetDefaultBackend(defaultBackend)
+
 	// Load existing ingresses
 	ingresses, err := m.loadAllIngresses()
 	if err != nil {
@@ -206,7 +272,7 @@ func (m *manager) Initialize(ctx context.Context) error {
 	for _, ing := range ingresses {
 		var validRules []IngressRule
 		for _, rule := range ing.Rules {
-			if rule.TLS && !m.config.ACME.IsDomainAllowed(rule.Match.Hostname) {
+			if rule.TLS && !rule.Match.IsTCP() && !m.config.ACME.IsDomainAllowed(rule.Match.Hostname) {
 				log.WarnContext(ctx, "skipping TLS ingress rule with hostname not in allowed domains list",
 					"ingress", ing.Name,
 					"hostname", rule.Match.Hostname,
@@ -237,7 +303,15 @@ func (m *manager) Initialize(ctx context.Context) error {
 		return fmt.Errorf("start caddy: %w", err)
 	}
 
-	// Start log forwarder (if configured) to forward Caddy system logs to OTEL
+	// Caddy loaded the config on start; later changes are reloaded from here
+	m.reloader.start(context.WithoutCancel(ctx))
+
+	// Serve TCP rules. A port that can't be bound only takes down its own rules.
+	if err := m.tcpProxy.update(validIngresses); err != nil {
+		log.ErrorContext(ctx, "failed to serve some TCP ingress rules", "error", err)
+	}
+
+	// Start log forwarder (if configured) to forward Caddy system and access logs to OTEL
 	if m.logForwarder != nil {
 		if err := m.logForwarder.Start(ctx); err != nil {
 			log.WarnContext(ctx, "failed to start caddy log forwarder", "error", err)
@@ -272,7 +346,7 @@ func (m *manager) Create(ctx context.Context, req CreateIngressRequest) (*Ingres
 
 	// Check if TLS is requested but ACME isn't configured, and validate allowed domains
 	for _, rule := range req.Rules {
-		if rule.TLS {
+		if rule.TLS && !rule.Match.IsTCP() {
 			if !m.config.ACME.IsTLSConfigured() {
 				return nil, fmt.Errorf("%w: TLS requested but ACME is not configured (set ACME_EMAIL and ACME_DNS_PROVIDER)", ErrInvalidRequest)
 			}
@@ -298,14 +372,22 @@ func (m *manager) Create(ctx context.Context, req CreateIngressRequest) (*Ingres
 	for i, rule := range req.Rules {
 		if !rule.Match.IsPattern() {
 			// Literal hostname - validate instance exists and resolve to canonical name + ID
-			resolvedName, resolvedID, err := m.instanceResolver.ResolveInstance(ctx, rule.Target.Instance)
-			if err != nil {
-				return nil, fmt.Errorf("%w: instance %q not found", ErrInstanceNotFound, rule.Target.Instance)
+			resolvedNames := make([]string, 0, len(rule.Target.Instances))
+			for _, instance := range rule.Target.InstanceNames() {
+				resolvedName, resolvedID, err := m.instanceResolver.ResolveInstance(ctx, instance)
+				if err != nil {
+					return nil, fmt.Errorf("%w: instance %q not found", ErrInstanceNotFound, instance)
+				}
+				resolvedNames = append(resolvedNames, resolvedName)
+				// Track ID for logging (instance directories are by ID)
+				resolvedInstanceIDs = append(resolvedInstanceIDs, resolvedID)
+			}
+			// Update the rule with the resolved instance names (human-readable for config)
+			if len(rule.Target.Instances) > 0 {
+				req.Rules[i].Target.Instances = resolvedNames
+			} else {
+				req.Rules[i].Target.Instance = resolvedNames[0]
 			}
-			// Update the rule with the resolved instance name (human-readable for config)
-			req.Rules[i].Target.Instance = resolvedName
-			// Track ID for logging (instance directories are by ID)
-			resolvedInstanceIDs = append(resolvedInstanceIDs, resolvedID)
 		}
 		// For pattern hostnames, instance validation happens at request time via the upstream resolver
 	}
@@ -328,6 +410,10 @@ func (m *manager) Create(ctx context.Context, req CreateIngressRequest) (*Ingres
 		}
 	}
 
+	if err := checkPortConflicts(req.Rules, existingIngresses); err != nil {
+		return nil, err
+	}
+
 	// Generate ID
 	id := cuid2.Generate()
 
@@ -348,15 +434,26 @@ func (m *manager) Create(ctx context.Context, req CreateIngressRequest) (*Ingres
 		return nil, fmt.Errorf("generate config: %w", err)
 	}
 
-	// Apply config to Caddy - this validates and applies atomically
-	// If Caddy rejects the config, we don't persist the ingress
-	if m.daemon.IsRunning() {
-		if err := m.daemon.ReloadConfig(configData); err != nil {
-			return nil, fmt.Errorf("%w: %v", ErrConfigValidationFailed, err)
-		}
+	// Have Caddy check the config before anything is persisted, so a config
+	// it would reject leaves the working one in place
+	if err := m.validateConfig(ctx, configData); err != nil {
+		return nil, err
+	}
+
+	// Caddy is reloaded in the background, so catch the common reason it
+	// would reject the config now: a new HTTP port bound by another process
+	if err := m.checkHTTPPortsFree(req.Rules, existingIngresses); err != nil {
+		return nil, err
 	}
 
-	// Config accepted - save ingress to storage
+	// Bind TCP ports first, so a port in use fails the create before anything
+	// is persisted
+	if err := m.tcpProxy.update(allIngresses); err != nil {
+		m.restoreTCPProxy(ctx, existingIngresses)
+		return nil, err
+	}
+
+	// Save ingress to storage
 	stored := &storedIngress{
 		ID:        ingress.ID,
 		Name:      ingress.Name,
@@ -365,6 +462,7 @@ func (m *manager) Create(ctx context.Context, req CreateIngressRequest) (*Ingres
 	}
 
 	if err := saveIngress(m.paths, stored); err != nil {
+		m.restoreTCPProxy(ctx, existingIngresses)
 		return nil, fmt.Errorf("save ingress: %w", err)
 	}
 
@@ -372,10 +470,16 @@ func (m *manager) Create(ctx context.Context, req CreateIngressRequest) (*Ingres
 	if err := m.configGenerator.WriteConfig(ctx, allIngresses); err != nil {
 		// Try to clean up the saved ingress
 		deleteIngressData(m.paths, id)
+		m.restoreTCPProxy(ctx, existingIngresses)
 		log.ErrorContext(ctx, "failed to write config after create", "error", err)
 		return nil, fmt.Errorf("write config: %w", err)
 	}
 
+	// Apply to Caddy, coalesced with other changes made around the same time
+	m.reloader.schedule()
+
+	m.refIndex.Load().Set(ingressRef(ingress.ID), targetRefs(ingress.Rules, resolvedInstanceIDs))
+
 	// Log creation with ingress_id and instance_id(s) for audit trail
 	// Each resolved instance gets the log in their hypeman.log (routed by instance_id)
 	for _, instanceID := range resolvedInstanceIDs {
@@ -460,6 +564,30 @@ func (m *manager) List(ctx context.Context) ([]Ingress, error) {
 	return m.loadAllIngresses()
 }
 
+// IngressesTargeting returns the names of the ingresses routing to an instance.
+// Pattern targets are resolved per request, so they aren't counted. With a
+// reference index, only the ingresses it lists are read.
+func (m *manager) IngressesTargeting(ctx context.Context, name, id string) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if names, ok, err := m.indexedIngressesTargeting(ctx, name, id); ok {
+		return names, err
+	}
+
+	ingresses, err := m.loadAllIngresses()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, ing := range ingresses {
+		if ing.Targets(name, id) {
+			names = append(names, ing.Name)
+		}
+	}
+	return names, nil
+}
+
 // Delete removes an ingress resource by ID, name, or ID prefix.
 func (m *manager) Delete(ctx context.Context, idOrName string) error {
 	m.mu.Lock()
@@ -474,35 +602,37 @@ func (m *manager) Delete(ctx context.Context, idOrName string) error {
 	}
 	id := ingress.ID
 
-	// Delete from storage
-	if err := deleteIngressData(m.paths, id); err != nil {
-		return fmt.Errorf("delete ingress data: %w", err)
-	}
-
 	// Regenerate config without the deleted ingress
-	ingresses, err := m.loadAllIngresses()
+	allIngresses, err := m.loadAllIngresses()
 	if err != nil {
 		return fmt.Errorf("load ingresses: %w", err)
 	}
+	ingresses := slices.DeleteFunc(allIngresses, func(ing Ingress) bool { return ing.ID == id })
 
-	// Generate and validate new config
 	configData, err := m.configGenerator.GenerateConfig(ctx, ingresses)
 	if err != nil {
 		return fmt.Errorf("generate config: %w", err)
 	}
+	if err := m.validateConfig(ctx, configData); err != nil {
+		return err
+	}
 
-	// Apply new config
-	if m.daemon.IsRunning() {
-		if err := m.daemon.ReloadConfig(configData); err != nil {
-			log.ErrorContext(ctx, "failed to reload caddy config after delete", "error", err)
-			return ErrConfigValidationFailed
-		}
+	// Delete from storage
+	if err := deleteIngressData(m.paths, id); err != nil {
+		return fmt.Errorf("delete ingress data: %w", err)
 	}
+	m.refIndex.Load().Remove(ingressRef(id))
 
-	// Write config to disk
+	// Write config to disk, and apply it to Caddy in the background
 	if err := m.configGenerator.WriteConfig(ctx, ingresses); err != nil {
 		log.ErrorContext(ctx, "failed to write config after delete", "error", err)
 	}
+	m.reloader.schedule()
+
+	// Release the deleted ingress's TCP ports
+	if err := m.tcpProxy.update(ingresses); err != nil {
+		log.ErrorContext(ctx, "failed to update TCP ingress rules after delete", "error", err)
+	}
 
 	// Log deletion with instance_id(s) for audit trail
 	// Resolve instance names to IDs for hypeman.log routing
@@ -510,21 +640,23 @@ func (m *manager) Delete(ctx context.Context, idOrName string) error {
 	for _, rule := range ingress.Rules {
 		if !rule.Match.IsPattern() {
 			hasLiteralHostname = true
-			// Resolve instance name to ID for logging (instance may have been deleted, so ignore errors)
-			_, instanceID, err := m.instanceResolver.ResolveInstance(ctx, rule.Target.Instance)
-			if err == nil {
-				log.InfoContext(ctx, "ingress deleted",
-					"ingress_id", ingress.ID,
-					"ingress_name", ingress.Name,
-					"instance_id", instanceID,
-				)
-			} else {
-				// Instance doesn't exist anymore, log without instance_id
-				log.InfoContext(ctx, "ingress deleted",
-					"ingress_id", ingress.ID,
-					"ingress_name", ingress.Name,
-					"instance_name", rule.Target.Instance,
-				)
+			for _, instance := range rule.Target.InstanceNames() {
+				// Resolve instance name to ID for logging (instance may have been deleted, so ignore errors)
+				_, instanceID, err := m.instanceResolver.ResolveInstance(ctx, instance)
+				if err == nil {
+					log.InfoContext(ctx, "ingress deleted",
+						"ingress_id", ingress.ID,
+						"ingress_name", ingress.Name,
+						"instance_id", instanceID,
+					)
+				} else {
+					// Instance doesn't exist anymore, log without instance_id
+					log.InfoContext(ctx, "ingress deleted",
+						"ingress_id", ingress.ID,
+						"ingress_name", ingress.Name,
+						"instance_name", instance,
+					)
+				}
 			}
 		}
 	}
@@ -541,6 +673,9 @@ func (m *manager) Delete(ctx context.Context, idOrName string) error {
 
 // Shutdown gracefully stops the ingress subsystem.
 func (m *manager) Shutdown(ctx context.Context) error {
+	// Flush a pending reload first: it needs the lock to read the ingresses
+	m.reloader.stop(ctx)
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -551,6 +686,10 @@ func (m *manager) Shutdown(ctx context.Context) error {
 		m.logForwarder.Stop()
 	}
 
+	// TCP listeners live in this process, so they stop with it regardless of
+	// CADDY_STOP_ON_SHUTDOWN
+	m.tcpProxy.stop()
+
 	// Stop DNS server
 	if m.dnsServer != nil {
 		log.InfoContext(ctx, "stopping DNS server")
@@ -581,6 +720,131 @@ func (m *manager) AdminURL() string {
 	return m.daemon.AdminURL()
 }
 
+// GetDefaultBackend returns the default backend, or nil if none is set.
+func (m *manager) GetDefaultBackend(ctx context.Context) (*IngressTarget, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.defaultBackend == nil {
+		return nil, nil
+	}
+	target := *m.defaultBackend
+	return &target, nil
+}
+
+// SetDefaultBackend sets the instance that serves unmatched hostnames.
+func (m *manager) SetDefaultBackend(ctx context.Context, instance string, port int) (*IngressTarget, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if instance == "" {
+		return nil, fmt.Errorf("%w: instance is required", ErrInvalidRequest)
+	}
+	if port <= 0 || port > 65535 {
+		return nil, fmt.Errorf("%w: port must be between 1 and 65535", ErrInvalidRequest)
+	}
+	name, _, err := m.instanceResolver.ResolveInstance(ctx, instance)
+	if err != nil {
+		return nil, fmt.Errorf("%w: instance %q not found", ErrInstanceNotFound, instance)
+	}
+	target := &IngressTarget{Instance: name, Port: port}
+
+	ingresses, err := m.loadAllIngresses()
+	if err != nil {
+		return nil, fmt.Errorf("load ingresses: %w", err)
+	}
+
+	// Without HTTP rules, the default backend adds a listener on port 80
+	if !hasHTTPRules(ingresses) {
+		if err := m.checkHTTPPortsFree([]IngressRule{{Match: IngressMatch{Port: 80}}}, ingresses); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.applyDefaultBackend(ctx, ingresses, target); err != nil {
+		return nil, err
+	}
+	if err := saveDefaultBackend(m.paths, target); err != nil {
+		m.applyDefaultBackend(ctx, ingresses, m.defaultBackend)
+		return nil, err
+	}
+	m.defaultBackend = target
+
+	logger.FromContext(ctx).InfoContext(ctx, "ingress default backend set", "instance", target.Instance, "port", target.Port)
+	result := *target
+	return &result, nil
+}
+
+// ClearDefaultBackend removes the default backend.
+func (m *manager) ClearDefaultBackend(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.defaultBackend == nil {
+		return nil
+	}
+
+	ingresses, err := m.loadAllIngresses()
+	if err != nil {
+		return fmt.Errorf("load ingresses: %w", err)
+	}
+	if err := m.applyDefaultBackend(ctx, ingresses, nil); err != nil {
+		return err
+	}
+	if err := deleteDefaultBackend(m.paths); err != nil {
+		m.applyDefaultBackend(ctx, ingresses, m.defaultBackend)
+		return err
+	}
+	m.defaultBackend = nil
+
+	logger.FromContext(ctx).InfoContext(ctx, "ingress default backend cleared")
+	return nil
+}
+
+// applyDefaultBackend validates and writes the config with target as the
+// default backend, then schedules a reload. On a validation error the
+// generator is left unchanged.
+func (m *manager) applyDefaultBackend(ctx context.Context, ingresses []Ingress, target *IngressTarget) error {
+	previous := m.configGenerator.defaultBackend
+	m.configGenerator.SetDefaultBackend(target)
+
+	configData, err := m.configGenerator.GenerateConfig(ctx, ingresses)
+	if err == nil {
+		err = m.validateConfig(ctx, configData)
+	}
+	if err == nil {
+		err = m.configGenerator.WriteConfig(ctx, ingresses)
+	}
+	if err != nil {
+		m.configGenerator.SetDefaultBackend(previous)
+		return err
+	}
+
+	m.reloader.schedule()
+	return nil
+}
+
+// LookupInstanceDNS resolves an instance name through the internal DNS server.
+func (m *manager) LookupInstanceDNS(ctx context.Context, instance string) (*DNSLookup, error) {
+	result, err := m.dnsServer.Lookup(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+
+	known, err := m.instanceResolver.CountResolvableInstances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count resolvable instances: %w", err)
+	}
+
+	return &DNSLookup{
+		Hostname:       result.Hostname,
+		Server:         result.Server,
+		Rcode:          result.Rcode,
+		IP:             result.IP,
+		KnownInstances: known,
+	}, nil
+}
+
 // loadAllIngresses loads all ingresses and converts them to the Ingress type.
 func (m *manager) loadAllIngresses() ([]Ingress, error) {
 	storedList, err := loadAllIngresses(m.paths)
@@ -596,6 +860,130 @@ func (m *manager) loadAllIngresses() ([]Ingress, error) {
 	return ingresses, nil
 }
 
+// applyConfig generates the Caddy config from the stored ingresses and
+// reloads Caddy with it. It is the configReloader's apply function.
+func (m *manager) applyConfig(ctx context.Context) error {
+	m.mu.RLock()
+	ingresses, err := m.loadAllIngresses()
+	if err != nil {
+		m.mu.RUnlock()
+		return fmt.Errorf("load ingresses: %w", err)
+	}
+	configData, err := m.configGenerator.GenerateConfig(ctx, ingresses)
+	m.mu.RUnlock()
+	if err != nil {
+		return fmt.Errorf("generate config: %w", err)
+	}
+
+	// A stopped Caddy loads the config file written by Create/Delete when it
+	// starts, so there's nothing to reload
+	if !m.daemon.IsRunning() {
+		return nil
+	}
+	return m.daemon.ReloadConfig(configData)
+}
+
+// validateConfig has Caddy validate a generated config. Nothing is checked
+// while Caddy isn't running; it validates the config file when it starts.
+func (m *manager) validateConfig(ctx context.Context, configData []byte) error {
+	if !m.daemon.IsRunning() {
+		return nil
+	}
+	return m.daemon.ValidateConfig(ctx, configData)
+}
+
+// checkHTTPPortsFree checks that the HTTP ports rules add to Caddy's
+// listeners aren't bound by another process. Ports Caddy already listens on
+// are skipped. Nothing is checked while Caddy isn't running.
+func (m *manager) checkHTTPPortsFree(rules []IngressRule, existing []Ingress) error {
+	inUse := make(map[int]bool)
+	for _, ing := range existing {
+		for _, rule := range ing.Rules {
+			if !rule.Match.IsTCP() {
+				inUse[rule.Match.GetPort()] = true
+			}
+		}
+	}
+
+	var newPorts []int
+	for _, rule := range rules {
+		if port := rule.Match.GetPort(); !rule.Match.IsTCP() && !inUse[port] {
+			inUse[port] = true
+			newPorts = append(newPorts, port)
+		}
+	}
+	if len(newPorts) == 0 || !m.daemon.IsRunning() {
+		return nil
+	}
+
+	for _, port := range newPorts {
+		ln, err := net.Listen("tcp", net.JoinHostPort(m.config.ListenAddress, strconv.Itoa(port)))
+		if err != nil {
+			return fmt.Errorf("%w: port %d is already bound by another process", ErrPortInUse, port)
+		}
+		ln.Close()
+	}
+	return nil
+}
+
+// hasHTTPRules reports whether any ingress has a rule served by Caddy.
+func hasHTTPRules(ingresses []Ingress) bool {
+	for _, ing := range ingresses {
+		for _, rule := range ing.Rules {
+			if !rule.Match.IsTCP() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// restoreTCPProxy puts the TCP proxy back to serving ingresses after a
+// failed create.
+func (m *manager) restoreTCPProxy(ctx context.Context, ingresses []Ingress) {
+	if err := m.tcpProxy.update(ingresses); err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "failed to restore TCP ingress rules", "error", err)
+	}
+}
+
+// checkPortConflicts checks that the rules of a new ingress can share listen
+// ports with each other and with existing ingresses. HTTP and TCP rules can't
+// share a port, and a TCP rule without TLS has nothing to route by, so it
+// must be alone on its port.
+func checkPortConflicts(rules []IngressRule, existing []Ingress) error {
+	type portRule struct {
+		rule    IngressRule
+		ingress string
+	}
+	var all []portRule
+	for _, ing := range existing {
+		for _, rule := range ing.Rules {
+			all = append(all, portRule{rule: rule, ingress: ing.Name})
+		}
+	}
+
+	for i, rule := range rules {
+		port := rule.Match.GetPort()
+		for _, other := range all {
+			if other.rule.Match.GetPort() != port {
+				continue
+			}
+			owner := "another rule of this ingress"
+			if other.ingress != "" {
+				owner = fmt.Sprintf("ingress %q", other.ingress)
+			}
+			if other.rule.Match.IsTCP() != rule.Match.IsTCP() {
+				return fmt.Errorf("%w: port %d can't serve both http and tcp rules, it is used by %s", ErrPortInUse, port, owner)
+			}
+			if rule.Match.IsTCP() && (!rule.TLS || !other.rule.TLS) {
+				return fmt.Errorf("%w: tcp port %d without tls can only have one rule, it is used by %s", ErrPortInUse, port, owner)
+			}
+		}
+		all = append(all, portRule{rule: rules[i]})
+	}
+	return nil
+}
+
 // regenerateConfig regenerates the Caddy config file from the given ingresses.
 func (m *manager) regenerateConfig(ctx context.Context, ingresses []Ingress) error {
 	return m.configGenerator.WriteConfig(ctx, ingresses)
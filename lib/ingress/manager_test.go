@@ -2,10 +2,13 @@ package ingress
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/onkernel/hypeman/lib/dns"
 	"github.com/onkernel/hypeman/lib/paths"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -52,6 +55,27 @@ func (m *mockInstanceResolver) InstanceExists(ctx context.Context, nameOrID stri
 	return ok, nil
 }
 
+func (m *mockInstanceResolver) WakeInstance(ctx context.Context, nameOrID string) error {
+	return nil
+}
+
+func (m *mockInstanceResolver) InstanceInfo(ctx context.Context, nameOrID string) (string, string, error) {
+	if _, ok := m.instances[nameOrID]; !ok {
+		return "", "", ErrInstanceNotFound
+	}
+	return "Running", "docker.io/library/nginx:latest", nil
+}
+
+func (m *mockInstanceResolver) CountResolvableInstances(ctx context.Context) (int, error) {
+	ids := make(map[string]bool)
+	for _, inst := range m.instances {
+		if inst.ip != "" {
+			ids[inst.id] = true
+		}
+	}
+	return len(ids), nil
+}
+
 func (m *mockInstanceResolver) ResolveInstance(ctx context.Context, nameOrID string) (string, string, error) {
 	inst, ok := m.instances[nameOrID]
 	if !ok {
@@ -320,6 +344,43 @@ func TestCreateIngress_DuplicateHostname(t *testing.T) {
 	assert.ErrorIs(t, err, ErrHostnameInUse)
 }
 
+func TestCreateIngress_TCP(t *testing.T) {
+	mgr, _, _, cleanup := setupTestManager(t)
+	defer cleanup()
+	defer mgr.(*manager).tcpProxy.stop()
+	ctx := context.Background()
+
+	port, err := pickAvailablePort("127.0.0.1")
+	require.NoError(t, err)
+
+	_, err = mgr.Create(ctx, CreateIngressRequest{
+		Name: "db",
+		Rules: []IngressRule{
+			{Match: IngressMatch{Hostname: "db.example.com", Port: port, Protocol: ProtocolTCP}, Target: IngressTarget{Instance: "my-api", Port: 5432}},
+		},
+	})
+	require.NoError(t, err)
+
+	// The manager listens on the port itself
+	conn, err := net.DialTimeout("tcp", fmt.Sprintf("127.0.0.1:%d", port), time.Second)
+	require.NoError(t, err)
+	conn.Close()
+
+	// An HTTP rule can't share the port
+	_, err = mgr.Create(ctx, CreateIngressRequest{
+		Name: "web",
+		Rules: []IngressRule{
+			{Match: IngressMatch{Hostname: "web.example.com", Port: port}, Target: IngressTarget{Instance: "web-app", Port: 8080}},
+		},
+	})
+	assert.ErrorIs(t, err, ErrPortInUse)
+
+	// Deleting the ingress releases the port
+	require.NoError(t, mgr.Delete(ctx, "db"))
+	_, err = net.DialTimeout("tcp", fmt.Sprintf("127.0.0.1:%d", port), time.Second)
+	assert.Error(t, err)
+}
+
 func TestGetIngress_ByID(t *testing.T) {
 	manager, _, _, cleanup := setupTestManager(t)
 	defer cleanup()
@@ -462,6 +523,114 @@ func TestDeleteIngress_NotFound(t *testing.T) {
 	assert.ErrorIs(t, err, ErrNotFound)
 }
 
+func TestDefaultBackend(t *testing.T) {
+	mgr, resolver, p, cleanup := setupTestManager(t)
+	defer cleanup()
+	ctx := context.Background()
+	resolver.AddInstanceFull("fallback", "fallback-id", "10.100.0.40")
+
+	target, err := mgr.GetDefaultBackend(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, target)
+
+	_, err = mgr.SetDefaultBackend(ctx, "fallback", 0)
+	assert.ErrorIs(t, err, ErrInvalidRequest)
+	_, err = mgr.SetDefaultBackend(ctx, "missing", 8080)
+	assert.ErrorIs(t, err, ErrInstanceNotFound)
+
+	// Targets by ID are stored by name, like ingress targets are resolved
+	target, err = mgr.SetDefaultBackend(ctx, "fallback-id", 3000)
+	require.NoError(t, err)
+	assert.Equal(t, &IngressTarget{Instance: "fallback", Port: 3000}, target)
+
+	target, err = mgr.GetDefaultBackend(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &IngressTarget{Instance: "fallback", Port: 3000}, target)
+
+	stored, err := loadDefaultBackend(p)
+	require.NoError(t, err)
+	assert.Equal(t, target, stored)
+	config, err := os.ReadFile(p.CaddyConfig())
+	require.NoError(t, err)
+	assert.Contains(t, string(config), "fallback.hypeman.internal")
+
+	// The setting isn't listed as an ingress
+	ingresses, err := mgr.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, ingresses)
+
+	require.NoError(t, mgr.ClearDefaultBackend(ctx))
+	target, err = mgr.GetDefaultBackend(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, target)
+	assert.NoFileExists(t, p.IngressDefaultBackend())
+	config, err = os.ReadFile(p.CaddyConfig())
+	require.NoError(t, err)
+	assert.NotContains(t, string(config), "fallback.hypeman.internal")
+}
+
+func TestLookupInstanceDNS(t *testing.T) {
+	mgr, _, _, cleanup := setupTestManager(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := mgr.LookupInstanceDNS(ctx, "my-api")
+	assert.ErrorIs(t, err, dns.ErrNotRunning)
+
+	// Start only the DNS server, Caddy isn't needed for resolution
+	dnsServer := mgr.(*manager).dnsServer
+	require.NoError(t, dnsServer.Start(ctx))
+	defer dnsServer.Stop()
+
+	lookup, err := mgr.LookupInstanceDNS(ctx, "my-api")
+	require.NoError(t, err)
+	assert.Equal(t, "my-api.hypeman.internal", lookup.Hostname)
+	assert.Equal(t, "NOERROR", lookup.Rcode)
+	assert.Equal(t, "10.100.0.10", lookup.IP)
+	assert.Equal(t, 2, lookup.KnownInstances)
+
+	lookup, err = mgr.LookupInstanceDNS(ctx, "missing")
+	require.NoError(t, err)
+	assert.Equal(t, "NXDOMAIN", lookup.Rcode)
+	assert.Empty(t, lookup.IP)
+}
+
+func TestResolveInstanceService(t *testing.T) {
+	mgr, resolver, _, cleanup := setupTestManager(t)
+	defer cleanup()
+	ctx := context.Background()
+	resolver.AddInstanceFull("db", "db-id", "10.100.0.30")
+
+	_, err := mgr.Create(ctx, CreateIngressRequest{
+		Name: "api",
+		Rules: []IngressRule{
+			{Match: IngressMatch{Hostname: "api.example.com"}, Target: IngressTarget{Instance: "my-api", Port: 8080}},
+			{Match: IngressMatch{Hostname: "admin.example.com"}, Target: IngressTarget{Instance: "my-api", Port: 9000}},
+			{Match: IngressMatch{Hostname: "db.example.com"}, Target: IngressTarget{Instance: "db-id", Port: 5432}},
+		},
+	})
+	require.NoError(t, err)
+	_, err = mgr.Create(ctx, CreateIngressRequest{
+		Name:  "wildcard",
+		Rules: []IngressRule{{Match: IngressMatch{Hostname: "{instance}.apps.example.com"}, Target: IngressTarget{Instance: "{instance}", Port: 80}}},
+	})
+	require.NoError(t, err)
+
+	info, err := mgr.(*manager).ResolveInstanceService(ctx, "my-api")
+	require.NoError(t, err)
+	assert.Equal(t, []int{80, 8080, 9000}, info.Ports)
+	assert.Equal(t, "Running", info.State)
+	assert.Equal(t, "docker.io/library/nginx:latest", info.Image)
+
+	// Targets by ID match lookups by name
+	info, err = mgr.(*manager).ResolveInstanceService(ctx, "db")
+	require.NoError(t, err)
+	assert.Equal(t, []int{80, 5432}, info.Ports)
+
+	_, err = mgr.(*manager).ResolveInstanceService(ctx, "missing")
+	assert.Error(t, err)
+}
+
 func TestValidateName(t *testing.T) {
 	validNames := []string{
 		"a",
@@ -639,6 +808,126 @@ func TestCreateIngressRequest_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid tcp",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "db.example.com", Port: 5432, Protocol: ProtocolTCP}, Target: IngressTarget{Instance: "my-api", Port: 5432}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "tcp without port",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "db.example.com", Protocol: ProtocolTCP}, Target: IngressTarget{Instance: "my-api", Port: 5432}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tcp with pattern hostname",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "{instance}.example.com", Port: 5432, Protocol: ProtocolTCP}, Target: IngressTarget{Instance: "{instance}", Port: 5432}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown protocol",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "db.example.com", Port: 5432, Protocol: "udp"}, Target: IngressTarget{Instance: "my-api", Port: 5432}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative max request body",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "test.example.com"}, Target: IngressTarget{Instance: "my-api", Port: 8080}, MaxRequestBodyBytes: -1},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid max request body",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "test.example.com"}, Target: IngressTarget{Instance: "my-api", Port: 8080}, MaxRequestBodyBytes: 1 << 20},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid load-balanced target with sticky session",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "test.example.com"}, Target: IngressTarget{Instances: []string{"web-1", "web-2"}, Port: 8080, StickySession: &StickySession{Cookie: "hm_lb", TTL: time.Hour}}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "instance and instances",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "test.example.com"}, Target: IngressTarget{Instance: "web-1", Instances: []string{"web-1", "web-2"}, Port: 8080}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "single load-balanced instance",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "test.example.com"}, Target: IngressTarget{Instances: []string{"web-1"}, Port: 8080}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "load-balanced tcp rule",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "db.example.com", Port: 5432, Protocol: ProtocolTCP}, Target: IngressTarget{Instances: []string{"db-1", "db-2"}, Port: 5432}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "sticky session with a single instance",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "test.example.com"}, Target: IngressTarget{Instance: "my-api", Port: 8080, StickySession: &StickySession{Cookie: "hm_lb"}}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid sticky cookie name",
+			req: CreateIngressRequest{
+				Name: "valid",
+				Rules: []IngressRule{
+					{Match: IngressMatch{Hostname: "test.example.com"}, Target: IngressTarget{Instances: []string{"web-1", "web-2"}, Port: 8080, StickySession: &StickySession{Cookie: "bad cookie"}}},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,99 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/onkernel/hypeman/lib/refindex"
+)
+
+// SetReferenceIndex sets the index that records which instances each ingress
+// routes to. It is updated as ingresses are created and deleted.
+func (m *manager) SetReferenceIndex(idx *refindex.Index) {
+	m.refIndex.Store(idx)
+}
+
+// IndexedReferences returns the instances each ingress routes to, for
+// rebuilding the reference index. Pattern targets are left out; see
+// targetRefs for the rest.
+func (m *manager) IndexedReferences(ctx context.Context) (map[refindex.Ref][]refindex.Ref, error) {
+	m.mu.RLock()
+	ingresses, err := m.loadAllIngresses()
+	m.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make(map[refindex.Ref][]refindex.Ref, len(ingresses))
+	for _, ing := range ingresses {
+		var instanceIDs []string
+		for _, rule := range ing.Rules {
+			if rule.Match.IsPattern() {
+				continue
+			}
+			for _, instance := range rule.Target.InstanceNames() {
+				if _, id, err := m.instanceResolver.ResolveInstance(ctx, instance); err == nil {
+					instanceIDs = append(instanceIDs, id)
+				}
+			}
+		}
+		refs[ingressRef(ing.ID)] = targetRefs(ing.Rules, instanceIDs)
+	}
+	return refs, nil
+}
+
+// indexedIngressesTargeting looks up the ingresses routing to an instance,
+// named by name or ID, in the reference index. Each candidate is checked
+// against its stored rules. ok is false when there is no index to consult.
+func (m *manager) indexedIngressesTargeting(ctx context.Context, name, id string) (names []string, ok bool, err error) {
+	idx := m.refIndex.Load()
+	if idx == nil {
+		return nil, false, nil
+	}
+	seen := make(map[string]bool)
+	for _, dep := range append(idx.GetDependents(ctx, refindex.TypeInstance, id), idx.GetDependents(ctx, refindex.TypeInstance, name)...) {
+		if dep.Type != refindex.TypeIngress || seen[dep.ID] {
+			continue
+		}
+		seen[dep.ID] = true
+		stored, err := loadIngress(m.paths, dep.ID)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, true, err
+		}
+		ing := Ingress{Rules: stored.Rules}
+		if ing.Targets(name, id) {
+			names = append(names, stored.Name)
+		}
+	}
+	return names, true, nil
+}
+
+// targetRefs returns the index references for an ingress's rules: the IDs
+// its targets resolved to, and each non-pattern target as written. Rules keep
+// the instance's name, which outlives the instance it first resolved to, and
+// a target that doesn't resolve now still routes to whatever takes the name.
+func targetRefs(rules []IngressRule, resolvedIDs []string) []refindex.Ref {
+	targets := slices.Clone(resolvedIDs)
+	for _, rule := range rules {
+		if !rule.Match.IsPattern() {
+			targets = append(targets, rule.Target.InstanceNames()...)
+		}
+	}
+	return instanceRefs(targets)
+}
+
+func ingressRef(id string) refindex.Ref {
+	return refindex.Ref{Type: refindex.TypeIngress, ID: id}
+}
+
+func instanceRefs(ids []string) []refindex.Ref {
+	refs := make([]refindex.Ref, 0, len(ids))
+	for _, id := range ids {
+		refs = append(refs, refindex.Ref{Type: refindex.TypeInstance, ID: id})
+	}
+	return refs
+}
@@ -0,0 +1,52 @@
+package ingress
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/refindex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIngressesTargeting_InstanceRecreatedUnderSameName(t *testing.T) {
+	manager, resolver, p, cleanup := setupTestManager(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	idx, err := refindex.Open(filepath.Join(p.DataDir(), "references.json"))
+	require.NoError(t, err)
+	manager.SetReferenceIndex(idx)
+
+	resolver.AddInstanceFull("web", "inst-old", "10.100.0.30")
+	_, err = manager.Create(ctx, CreateIngressRequest{
+		Name: "web-public",
+		Rules: []IngressRule{
+			{Match: IngressMatch{Hostname: "web.example.com"}, Target: IngressTarget{Instance: "web", Port: 8080}},
+		},
+	})
+	require.NoError(t, err)
+
+	names, err := manager.IngressesTargeting(ctx, "web", "inst-old")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web-public"}, names)
+
+	// Force-deleted and recreated: the rule names the new instance too
+	resolver.AddInstanceFull("web", "inst-new", "10.100.0.31")
+	names, err = manager.IngressesTargeting(ctx, "web", "inst-new")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web-public"}, names)
+
+	// A rebuild keeps targets that don't resolve to an instance right now
+	delete(resolver.instances, "web")
+	delete(resolver.instances, "inst-new")
+	require.NoError(t, idx.Rebuild(ctx, manager))
+	names, err = manager.IngressesTargeting(ctx, "web", "inst-newer")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web-public"}, names)
+
+	names, err = manager.IngressesTargeting(ctx, "api", "inst-api")
+	require.NoError(t, err)
+	assert.Empty(t, names)
+}
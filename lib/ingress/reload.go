@@ -0,0 +1,157 @@
+package ingress
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+const (
+	// reloadDebounce is how long the reloader waits for further changes
+	// before applying the config
+	reloadDebounce = 200 * time.Millisecond
+
+	// reloadMaxDelay caps how long a continuous burst of changes can hold
+	// off a reload
+	reloadMaxDelay = 2 * time.Second
+
+	// reloadRetryMin and reloadRetryMax bound the backoff between attempts
+	// when a reload fails
+	reloadRetryMin = 1 * time.Second
+	reloadRetryMax = 30 * time.Second
+)
+
+// configReloader applies the ingress config to Caddy in the background,
+// coalescing bursts of changes into a single admin API reload. Each reload
+// generates the config from the ingresses stored at that moment, so the last
+// change is always applied no matter how many were coalesced.
+type configReloader struct {
+	apply func(ctx context.Context) error
+
+	debounce time.Duration
+	maxDelay time.Duration
+	retryMin time.Duration
+	retryMax time.Duration
+
+	// pending holds at most one wakeup; a change made while a reload is
+	// running leaves it set, so that change gets a reload of its own
+	pending chan struct{}
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func newConfigReloader(apply func(ctx context.Context) error) *configReloader {
+	return &configReloader{
+		apply:    apply,
+		debounce: reloadDebounce,
+		maxDelay: reloadMaxDelay,
+		retryMin: reloadRetryMin,
+		retryMax: reloadRetryMax,
+		pending:  make(chan struct{}, 1),
+	}
+}
+
+// schedule requests a reload. It never blocks.
+func (r *configReloader) schedule() {
+	select {
+	case r.pending <- struct{}{}:
+	default:
+	}
+}
+
+// start runs the reload loop until stop is called.
+func (r *configReloader) start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run(ctx)
+	}()
+}
+
+// stop ends the reload loop, then applies a change that was still waiting,
+// once and without retrying.
+func (r *configReloader) stop(ctx context.Context) {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	r.wg.Wait()
+
+	select {
+	case <-r.pending:
+		if err := r.apply(ctx); err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "failed to apply pending ingress config on shutdown", "error", err)
+		}
+	default:
+	}
+}
+
+func (r *configReloader) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.pending:
+		}
+
+		if !r.wait(ctx) {
+			return
+		}
+		if !r.applyWithRetry(ctx) {
+			return
+		}
+	}
+}
+
+// wait returns once no change has been scheduled for the debounce window, or
+// maxDelay after the first change. Returns false if ctx is done.
+func (r *configReloader) wait(ctx context.Context) bool {
+	deadline := time.Now().Add(r.maxDelay)
+	timer := time.NewTimer(r.debounce)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Leave the change pending for stop to apply
+			r.schedule()
+			return false
+		case <-r.pending:
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return true
+			}
+			timer.Stop()
+			timer.Reset(min(r.debounce, remaining))
+		case <-timer.C:
+			return true
+		}
+	}
+}
+
+// applyWithRetry applies the config, retrying with backoff until it succeeds.
+// A change scheduled while retrying cuts the backoff short, since the new
+// config may not have the problem. Returns false if ctx is done.
+func (r *configReloader) applyWithRetry(ctx context.Context) bool {
+	backoff := r.retryMin
+	for {
+		err := r.apply(ctx)
+		if err == nil {
+			return true
+		}
+		logger.FromContext(ctx).ErrorContext(ctx, "failed to reload ingress config, will retry", "error", err, "retry_in", backoff)
+
+		select {
+		case <-ctx.Done():
+			r.schedule()
+			return false
+		case <-r.pending:
+			backoff = r.retryMin
+		case <-time.After(backoff):
+			backoff = min(backoff*2, r.retryMax)
+		}
+	}
+}
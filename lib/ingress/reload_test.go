@@ -0,0 +1,94 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReloader(apply func(ctx context.Context) error) *configReloader {
+	r := newConfigReloader(apply)
+	r.debounce = 20 * time.Millisecond
+	r.maxDelay = 200 * time.Millisecond
+	r.retryMin = 10 * time.Millisecond
+	r.retryMax = 40 * time.Millisecond
+	return r
+}
+
+func TestConfigReloader_CoalescesBurst(t *testing.T) {
+	var applied atomic.Int32
+	var state, appliedState atomic.Int32
+	r := newTestReloader(func(ctx context.Context) error {
+		applied.Add(1)
+		appliedState.Store(state.Load())
+		return nil
+	})
+	r.start(context.Background())
+	defer r.stop(context.Background())
+
+	for i := 1; i <= 20; i++ {
+		state.Store(int32(i))
+		r.schedule()
+	}
+
+	require.Eventually(t, func() bool { return appliedState.Load() == 20 }, 2*time.Second, 5*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(1), applied.Load(), "a burst should be applied with one reload")
+}
+
+func TestConfigReloader_RetriesFailedReload(t *testing.T) {
+	var attempts atomic.Int32
+	r := newTestReloader(func(ctx context.Context) error {
+		if attempts.Add(1) < 3 {
+			return errors.New("admin API unreachable")
+		}
+		return nil
+	})
+	r.start(context.Background())
+	defer r.stop(context.Background())
+
+	r.schedule()
+	require.Eventually(t, func() bool { return attempts.Load() == 3 }, 2*time.Second, 5*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(3), attempts.Load(), "no reloads after one succeeds")
+}
+
+func TestConfigReloader_ChangeDuringReload(t *testing.T) {
+	release := make(chan struct{})
+	var applied atomic.Int32
+	r := newTestReloader(func(ctx context.Context) error {
+		if applied.Add(1) == 1 {
+			<-release
+		}
+		return nil
+	})
+	r.start(context.Background())
+	defer r.stop(context.Background())
+
+	// A change made while a reload is running gets a reload of its own
+	r.schedule()
+	require.Eventually(t, func() bool { return applied.Load() == 1 }, 2*time.Second, 5*time.Millisecond)
+	r.schedule()
+	close(release)
+	require.Eventually(t, func() bool { return applied.Load() == 2 }, 2*time.Second, 5*time.Millisecond)
+}
+
+func TestConfigReloader_StopFlushesPending(t *testing.T) {
+	var applied atomic.Int32
+	r := newTestReloader(func(ctx context.Context) error {
+		applied.Add(1)
+		return nil
+	})
+	r.debounce = time.Hour
+	r.maxDelay = time.Hour
+	r.start(context.Background())
+
+	r.schedule()
+	r.stop(context.Background())
+	assert.Equal(t, int32(1), applied.Load())
+}
@@ -11,6 +11,7 @@ import (
 
 // Filesystem structure:
 // {dataDir}/ingresses/{ingress-id}.json
+// {dataDir}/ingress-default-backend.json
 
 // storedIngress represents ingress data that is persisted to disk.
 type storedIngress struct {
@@ -158,3 +159,40 @@ func findIngressByName(p *paths.Paths, name string) (*storedIngress, error) {
 
 	return nil, ErrNotFound
 }
+
+// loadDefaultBackend loads the default backend, or returns nil if none is set.
+func loadDefaultBackend(p *paths.Paths) (*IngressTarget, error) {
+	data, err := os.ReadFile(p.IngressDefaultBackend())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read default backend: %w", err)
+	}
+
+	var target IngressTarget
+	if err := json.Unmarshal(data, &target); err != nil {
+		return nil, fmt.Errorf("unmarshal default backend: %w", err)
+	}
+	return &target, nil
+}
+
+// saveDefaultBackend saves the default backend to disk.
+func saveDefaultBackend(p *paths.Paths, target *IngressTarget) error {
+	data, err := json.MarshalIndent(target, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal default backend: %w", err)
+	}
+	if err := os.WriteFile(p.IngressDefaultBackend(), data, 0644); err != nil {
+		return fmt.Errorf("write default backend: %w", err)
+	}
+	return nil
+}
+
+// deleteDefaultBackend removes the default backend from disk.
+func deleteDefaultBackend(p *paths.Paths) error {
+	if err := os.Remove(p.IngressDefaultBackend()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove default backend: %w", err)
+	}
+	return nil
+}
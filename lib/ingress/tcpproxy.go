@@ -0,0 +1,301 @@
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// tcpClientHelloTimeout bounds how long a client on an SNI-routed port
+	// has to send its TLS ClientHello
+	tcpClientHelloTimeout = 10 * time.Second
+
+	// tcpWakeTimeout bounds how long a connection waits for a standby
+	// instance to be restored, as the DNS server does for HTTP rules
+	tcpWakeTimeout = 4 * time.Second
+
+	// tcpDialTimeout bounds resolving and connecting to the target instance
+	tcpDialTimeout = 10 * time.Second
+)
+
+// errClientHelloRead stops the TLS handshake once the ClientHello is read
+var errClientHelloRead = errors.New("client hello read")
+
+// tcpRoute is where a TCP rule sends connections
+type tcpRoute struct {
+	ingressID string
+	instance  string
+	port      int
+}
+
+// tcpRoutes is the routing table of one listen port: a single plain route,
+// or TLS routes keyed by lowercased SNI hostname
+type tcpRoutes struct {
+	plain *tcpRoute
+	sni   map[string]tcpRoute
+}
+
+// tcpListener serves one listen port
+type tcpListener struct {
+	ln     net.Listener
+	mu     sync.RWMutex
+	routes tcpRoutes
+}
+
+func (l *tcpListener) getRoutes() tcpRoutes {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.routes
+}
+
+func (l *tcpListener) setRoutes(routes tcpRoutes) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.routes = routes
+}
+
+// tcpProxy serves TCP ingress rules. Caddy's HTTP app only proxies HTTP, so
+// TCP rules get listeners owned by the ingress manager. Target instances are
+// resolved per connection, so an instance that changes IP or idles into
+// standby is handled like it is for HTTP rules.
+type tcpProxy struct {
+	listenAddress string
+	resolver      InstanceResolver
+	log           *slog.Logger
+
+	mu        sync.Mutex
+	listeners map[int]*tcpListener
+	wg        sync.WaitGroup
+}
+
+func newTCPProxy(listenAddress string, resolver InstanceResolver, log *slog.Logger) *tcpProxy {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &tcpProxy{
+		listenAddress: listenAddress,
+		resolver:      resolver,
+		log:           log,
+		listeners:     make(map[int]*tcpListener),
+	}
+}
+
+// buildTCPRoutes collects the routing tables of the TCP rules of ingresses,
+// keyed by listen port
+func buildTCPRoutes(ingresses []Ingress) map[int]tcpRoutes {
+	tables := make(map[int]tcpRoutes)
+	for _, ing := range ingresses {
+		for _, rule := range ing.Rules {
+			if !rule.Match.IsTCP() {
+				continue
+			}
+			port := rule.Match.GetPort()
+			table := tables[port]
+			route := tcpRoute{ingressID: ing.ID, instance: rule.Target.Instance, port: rule.Target.Port}
+			if rule.TLS {
+				if table.sni == nil {
+					table.sni = make(map[string]tcpRoute)
+				}
+				table.sni[strings.ToLower(rule.Match.Hostname)] = route
+			} else {
+				table.plain = &route
+			}
+			tables[port] = table
+		}
+	}
+	return tables
+}
+
+// update makes the proxy serve the TCP rules of ingresses: it opens
+// listeners for new ports, swaps the routes of existing ones and closes
+// ports no longer used. Ports that fail to listen are reported in the
+// returned error; the others are still served.
+func (p *tcpProxy) update(ingresses []Ingress) error {
+	tables := buildTCPRoutes(ingresses)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for port, l := range p.listeners {
+		if _, ok := tables[port]; !ok {
+			l.ln.Close()
+			delete(p.listeners, port)
+		}
+	}
+
+	var errs []error
+	for port, routes := range tables {
+		if l, ok := p.listeners[port]; ok {
+			l.setRoutes(routes)
+			continue
+		}
+		ln, err := net.Listen("tcp", net.JoinHostPort(p.listenAddress, strconv.Itoa(port)))
+		if err != nil {
+			if errors.Is(err, syscall.EADDRINUSE) {
+				err = fmt.Errorf("%w: port %d is already bound by another process", ErrPortInUse, port)
+			}
+			errs = append(errs, fmt.Errorf("listen on port %d: %w", port, err))
+			continue
+		}
+		l := &tcpListener{ln: ln, routes: routes}
+		p.listeners[port] = l
+		p.wg.Add(1)
+		go p.serve(l)
+	}
+	return errors.Join(errs...)
+}
+
+// stop closes every listener and waits for their accept loops to end.
+// Connections already proxied are left to finish.
+func (p *tcpProxy) stop() {
+	p.mu.Lock()
+	for port, l := range p.listeners {
+		l.ln.Close()
+		delete(p.listeners, port)
+	}
+	p.mu.Unlock()
+	p.wg.Wait()
+}
+
+// serve accepts connections until the listener is closed
+func (p *tcpProxy) serve(l *tcpListener) {
+	defer p.wg.Done()
+	for {
+		conn, err := l.ln.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				p.log.Warn("TCP ingress accept failed", "addr", l.ln.Addr().String(), "error", err)
+			}
+			return
+		}
+		go p.handle(l, conn)
+	}
+}
+
+// handle routes one client connection and proxies it to its instance
+func (p *tcpProxy) handle(l *tcpListener, conn net.Conn) {
+	defer conn.Close()
+
+	routes := l.getRoutes()
+	route := routes.plain
+	var peeked []byte
+	if route == nil {
+		conn.SetReadDeadline(time.Now().Add(tcpClientHelloTimeout))
+		serverName, hello, err := peekServerName(conn)
+		conn.SetReadDeadline(time.Time{})
+		if err != nil {
+			p.log.Debug("TCP ingress failed to read TLS server name", "remote", conn.RemoteAddr().String(), "error", err)
+			return
+		}
+		r, ok := routes.sni[strings.ToLower(serverName)]
+		if !ok {
+			p.log.Debug("TCP ingress has no rule for server name", "server_name", serverName)
+			return
+		}
+		route, peeked = &r, hello
+	}
+
+	upstream, err := p.dial(route)
+	if err != nil {
+		p.log.Warn("TCP ingress failed to reach instance",
+			"ingress_id", route.ingressID,
+			"instance", route.instance,
+			"error", err,
+		)
+		return
+	}
+	defer upstream.Close()
+
+	// Replay the ClientHello consumed while routing
+	if len(peeked) > 0 {
+		if _, err := upstream.Write(peeked); err != nil {
+			return
+		}
+	}
+	pipe(conn, upstream)
+}
+
+// dial wakes the route's instance if it idled into standby, then connects to it
+func (p *tcpProxy) dial(route *tcpRoute) (net.Conn, error) {
+	wakeCtx, cancelWake := context.WithTimeout(context.Background(), tcpWakeTimeout)
+	err := p.resolver.WakeInstance(wakeCtx, route.instance)
+	cancelWake()
+	if err != nil {
+		return nil, fmt.Errorf("wake instance: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), tcpDialTimeout)
+	defer cancel()
+	ip, err := p.resolver.ResolveInstanceIP(ctx, route.instance)
+	if err != nil {
+		return nil, fmt.Errorf("resolve instance: %w", err)
+	}
+	var d net.Dialer
+	return d.DialContext(ctx, "tcp", net.JoinHostPort(ip, strconv.Itoa(route.port)))
+}
+
+// pipe copies data both ways until both directions are done, passing on
+// half-closes so protocols that shut down their write side still work
+func pipe(client, upstream net.Conn) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	copyHalf := func(dst, src net.Conn) {
+		defer wg.Done()
+		io.Copy(dst, src)
+		if cw, ok := dst.(interface{ CloseWrite() error }); ok {
+			cw.CloseWrite()
+		} else {
+			dst.Close()
+		}
+	}
+	go copyHalf(upstream, client)
+	go copyHalf(client, upstream)
+	wg.Wait()
+}
+
+// peekServerName reads a TLS ClientHello from r and returns its SNI server
+// name, along with the bytes read so they can be replayed to the upstream.
+// TLS isn't terminated: the handshake is aborted once the hello is parsed.
+func peekServerName(r io.Reader) (string, []byte, error) {
+	var buf bytes.Buffer
+	var serverName string
+	err := tls.Server(readOnlyConn{r: io.TeeReader(r, &buf)}, &tls.Config{
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			serverName = hello.ServerName
+			return nil, errClientHelloRead
+		},
+	}).Handshake()
+	if !errors.Is(err, errClientHelloRead) {
+		return "", nil, fmt.Errorf("read client hello: %w", err)
+	}
+	if serverName == "" {
+		return "", nil, errors.New("client hello has no server name")
+	}
+	return serverName, buf.Bytes(), nil
+}
+
+// readOnlyConn lets crypto/tls parse a ClientHello from a reader without
+// writing anything back to the client
+type readOnlyConn struct {
+	r io.Reader
+}
+
+func (c readOnlyConn) Read(p []byte) (int, error)         { return c.r.Read(p) }
+func (c readOnlyConn) Write(p []byte) (int, error)        { return 0, io.ErrClosedPipe }
+func (c readOnlyConn) Close() error                       { return nil }
+func (c readOnlyConn) LocalAddr() net.Addr                { return nil }
+func (c readOnlyConn) RemoteAddr() net.Addr               { return nil }
+func (c readOnlyConn) SetDeadline(t time.Time) error      { return nil }
+func (c readOnlyConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c readOnlyConn) SetWriteDeadline(t time.Time) error { return nil }
@@ -0,0 +1,155 @@
+package ingress
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startEchoServer starts a TCP server that handles each connection with
+// serve, returning its port
+func startEchoServer(t *testing.T, serve func(net.Conn)) int {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				serve(conn)
+			}()
+		}
+	}()
+	return ln.Addr().(*net.TCPAddr).Port
+}
+
+func TestTCPProxy_PlainRoute(t *testing.T) {
+	upstreamPort := startEchoServer(t, func(conn net.Conn) {
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		conn.Write([]byte("echo: " + line))
+	})
+
+	resolver := newMockResolver()
+	resolver.AddInstance("postgres", "127.0.0.1")
+	proxy := newTCPProxy("127.0.0.1", resolver, nil)
+	defer proxy.stop()
+
+	listenPort, err := pickAvailablePort("127.0.0.1")
+	require.NoError(t, err)
+	ingresses := []Ingress{{
+		ID: "ing-1",
+		Rules: []IngressRule{{
+			Match:  IngressMatch{Hostname: "db.example.com", Port: listenPort, Protocol: ProtocolTCP},
+			Target: IngressTarget{Instance: "postgres", Port: upstreamPort},
+		}},
+	}}
+	require.NoError(t, proxy.update(ingresses))
+
+	conn, err := net.DialTimeout("tcp", "127.0.0.1:"+strconv.Itoa(listenPort), time.Second)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("hello\n"))
+	require.NoError(t, err)
+	reply, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "echo: hello\n", reply)
+
+	// Removing the rule closes the port
+	require.NoError(t, proxy.update(nil))
+	_, err = net.DialTimeout("tcp", "127.0.0.1:"+strconv.Itoa(listenPort), time.Second)
+	assert.Error(t, err)
+}
+
+func TestTCPProxy_SNIRoute(t *testing.T) {
+	// Each upstream reports the server name of the ClientHello it was
+	// passed, proving the hello is replayed untouched
+	serverNames := make(chan string, 2)
+	reportSNI := func(conn net.Conn) {
+		name, _, err := peekServerName(conn)
+		if err != nil {
+			name = "error: " + err.Error()
+		}
+		serverNames <- name
+	}
+	apiPort := startEchoServer(t, reportSNI)
+	adminPort := startEchoServer(t, reportSNI)
+
+	resolver := newMockResolver()
+	resolver.AddInstance("api", "127.0.0.1")
+	resolver.AddInstance("admin", "127.0.0.1")
+	proxy := newTCPProxy("127.0.0.1", resolver, nil)
+	defer proxy.stop()
+
+	listenPort, err := pickAvailablePort("127.0.0.1")
+	require.NoError(t, err)
+	ingresses := []Ingress{{
+		ID: "ing-1",
+		Rules: []IngressRule{
+			{Match: IngressMatch{Hostname: "api.example.com", Port: listenPort, Protocol: ProtocolTCP}, Target: IngressTarget{Instance: "api", Port: apiPort}, TLS: true},
+			{Match: IngressMatch{Hostname: "admin.example.com", Port: listenPort, Protocol: ProtocolTCP}, Target: IngressTarget{Instance: "admin", Port: adminPort}, TLS: true},
+		},
+	}}
+	require.NoError(t, proxy.update(ingresses))
+
+	for _, host := range []string{"api.example.com", "ADMIN.example.com"} {
+		conn, err := net.DialTimeout("tcp", "127.0.0.1:"+strconv.Itoa(listenPort), time.Second)
+		require.NoError(t, err)
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+		// The handshake can't complete; only the hello matters
+		go tls.Client(conn, &tls.Config{ServerName: host}).HandshakeContext(context.Background())
+
+		select {
+		case name := <-serverNames:
+			assert.Equal(t, host, name)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no upstream received the connection for %s", host)
+		}
+		conn.Close()
+	}
+
+	// A server name without a rule is dropped
+	conn, err := net.DialTimeout("tcp", "127.0.0.1:"+strconv.Itoa(listenPort), time.Second)
+	require.NoError(t, err)
+	defer conn.Close()
+	go tls.Client(conn, &tls.Config{ServerName: "other.example.com"}).HandshakeContext(context.Background())
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = conn.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestCheckPortConflicts(t *testing.T) {
+	existing := []Ingress{{
+		Name: "web",
+		Rules: []IngressRule{
+			{Match: IngressMatch{Hostname: "web.example.com", Port: 80}, Target: IngressTarget{Instance: "web", Port: 8080}},
+			{Match: IngressMatch{Hostname: "db.example.com", Port: 5432, Protocol: ProtocolTCP}, Target: IngressTarget{Instance: "db", Port: 5432}},
+			{Match: IngressMatch{Hostname: "a.example.com", Port: 8443, Protocol: ProtocolTCP}, Target: IngressTarget{Instance: "a", Port: 443}, TLS: true},
+		},
+	}}
+	tcp := func(host string, port int, tls bool) IngressRule {
+		return IngressRule{Match: IngressMatch{Hostname: host, Port: port, Protocol: ProtocolTCP}, Target: IngressTarget{Instance: "x", Port: 1}, TLS: tls}
+	}
+
+	// TCP on an HTTP port, and HTTP on a TCP port
+	assert.ErrorIs(t, checkPortConflicts([]IngressRule{tcp("x.example.com", 80, false)}, existing), ErrPortInUse)
+	assert.ErrorIs(t, checkPortConflicts([]IngressRule{{Match: IngressMatch{Hostname: "x.example.com", Port: 5432}}}, existing), ErrPortInUse)
+	// A second rule on a plain TCP port
+	assert.ErrorIs(t, checkPortConflicts([]IngressRule{tcp("x.example.com", 5432, true)}, existing), ErrPortInUse)
+	assert.ErrorIs(t, checkPortConflicts([]IngressRule{tcp("x.example.com", 9000, false), tcp("y.example.com", 9000, false)}, nil), ErrPortInUse)
+	// SNI-routed rules share a port
+	assert.NoError(t, checkPortConflicts([]IngressRule{tcp("b.example.com", 8443, true)}, existing))
+	assert.NoError(t, checkPortConflicts([]IngressRule{tcp("redis.example.com", 6379, false)}, existing))
+}
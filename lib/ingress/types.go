@@ -39,8 +39,26 @@ type IngressRule struct {
 	// RedirectHTTP creates an automatic HTTP to HTTPS redirect for this hostname.
 	// Only applies when TLS is enabled.
 	RedirectHTTP bool `json:"redirect_http,omitempty"`
+
+	// MaxRequestBodyBytes caps the size of request bodies proxied to the
+	// target. Larger requests are rejected with 413. Zero means no limit.
+	MaxRequestBodyBytes int64 `json:"max_request_body_bytes,omitempty"`
 }
 
+// IngressProtocol is the protocol an ingress rule proxies.
+type IngressProtocol string
+
+const (
+	// ProtocolHTTP routes HTTP requests by Host header (or SNI with TLS)
+	// through Caddy. This is the default.
+	ProtocolHTTP IngressProtocol = "http"
+
+	// ProtocolTCP proxies raw TCP connections by listen port. With TLS, the
+	// connection is routed by SNI hostname and TLS is passed through to the
+	// instance rather than terminated.
+	ProtocolTCP IngressProtocol = "tcp"
+)
+
 // IngressMatch specifies the conditions for matching incoming requests.
 type IngressMatch struct {
 	// Hostname is the hostname to match. Can be:
@@ -53,11 +71,19 @@ type IngressMatch struct {
 	// If not specified, defaults to 80.
 	Port int `json:"port,omitempty"`
 
+	// Protocol is the protocol to proxy. If not specified, defaults to "http".
+	Protocol IngressProtocol `json:"protocol,omitempty"`
+
 	// PathPrefix is the path prefix to match (optional, for future L7 routing).
 	// If empty, matches all paths.
 	// PathPrefix string `json:"path_prefix,omitempty"`
 }
 
+// IsTCP returns true if this match proxies TCP rather than HTTP.
+func (m *IngressMatch) IsTCP() bool {
+	return m.Protocol == ProtocolTCP
+}
+
 // captureRegex matches {name} captures in hostname patterns
 var captureRegex = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)\}`)
 
@@ -161,10 +187,94 @@ func (p *HostnamePattern) ResolveInstance(targetInstance string) string {
 // IngressTarget specifies the target for routing matched requests.
 type IngressTarget struct {
 	// Instance is the name or ID of the target instance.
-	Instance string `json:"instance"`
+	Instance string `json:"instance,omitempty"`
+
+	// Instances are the names or IDs of instances to load-balance across,
+	// set instead of Instance. HTTP rules with literal hostnames only.
+	Instances []string `json:"instances,omitempty"`
 
 	// Port is the port on the target instance.
 	Port int `json:"port"`
+
+	// StickySession pins each client to one of Instances with a cookie.
+	StickySession *StickySession `json:"sticky_session,omitempty"`
+}
+
+// StickySession configures cookie-based session affinity for a target that
+// load-balances several instances.
+type StickySession struct {
+	// Cookie is the name of the cookie that records the client's instance.
+	Cookie string `json:"cookie"`
+
+	// TTL is how long the cookie lasts. Zero makes it a session cookie.
+	TTL time.Duration `json:"ttl,omitempty"`
+}
+
+// InstanceNames returns the instances the target routes to, in order.
+func (t *IngressTarget) InstanceNames() []string {
+	if len(t.Instances) > 0 {
+		return t.Instances
+	}
+	return []string{t.Instance}
+}
+
+// targetsInstance returns true if the target routes to the given instance,
+// named by name or ID. A pattern target routes to whichever instance the
+// hostname names, so it never is.
+func (t *IngressTarget) targetsInstance(name, id string) bool {
+	for _, instance := range t.InstanceNames() {
+		if !captureRegex.MatchString(instance) && (instance == name || instance == id) {
+			return true
+		}
+	}
+	return false
+}
+
+// targetsOnlyInstance returns true if every instance the target routes to is
+// the given one, named by name or ID.
+func (t *IngressTarget) targetsOnlyInstance(name, id string) bool {
+	for _, instance := range t.InstanceNames() {
+		if captureRegex.MatchString(instance) || (instance != name && instance != id) {
+			return false
+		}
+	}
+	return true
+}
+
+// Targets returns true if any rule of the ingress routes to the given
+// instance, named by name or ID.
+func (i *Ingress) Targets(name, id string) bool {
+	for _, rule := range i.Rules {
+		if rule.Target.targetsInstance(name, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// TargetsOnly returns true if every rule of the ingress routes to the given
+// instance, named by name or ID, so an ingress with a pattern target never
+// qualifies.
+func (i *Ingress) TargetsOnly(name, id string) bool {
+	if len(i.Rules) == 0 {
+		return false
+	}
+	for _, rule := range i.Rules {
+		if !rule.Target.targetsOnlyInstance(name, id) {
+			return false
+		}
+	}
+	return true
+}
+
+// DNSLookup is the internal DNS server's answer for an instance name, used to
+// tell a broken upstream resolution apart from an instance that isn't serving.
+type DNSLookup struct {
+	Hostname       string // Queried name, e.g. "my-api.hypeman.internal"
+	Server         string // Address of the DNS server that answered
+	Rcode          string // DNS response code, e.g. "NOERROR" or "NXDOMAIN"
+	IP             string // Resolved IPv4 address, empty if the name didn't resolve
+	KnownInstances int    // Instances the resolver can currently resolve
 }
 
 // CreateIngressRequest is the request body for creating a new ingress.
@@ -223,8 +333,8 @@ func (r *CreateIngressRequest) Validate() error {
 		if rule.Match.Port != 0 && (rule.Match.Port < 1 || rule.Match.Port > 65535) {
 			return &ValidationError{Field: "rules", Message: "match.port must be between 1 and 65535 in rule " + strconv.Itoa(i)}
 		}
-		if rule.Target.Instance == "" {
-			return &ValidationError{Field: "rules", Message: "instance is required in rule " + strconv.Itoa(i)}
+		if err := validateTarget(i, rule); err != nil {
+			return err
 		}
 		if rule.Target.Port <= 0 || rule.Target.Port > 65535 {
 			return &ValidationError{Field: "rules", Message: "target.port must be between 1 and 65535 in rule " + strconv.Itoa(i)}
@@ -233,8 +343,84 @@ func (r *CreateIngressRequest) Validate() error {
 		if rule.RedirectHTTP && !rule.TLS {
 			return &ValidationError{Field: "rules", Message: "redirect_http requires tls to be enabled in rule " + strconv.Itoa(i)}
 		}
+		if rule.MaxRequestBodyBytes < 0 {
+			return &ValidationError{Field: "rules", Message: "max_request_body_bytes must not be negative in rule " + strconv.Itoa(i)}
+		}
+		if err := validateProtocol(i, rule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTarget checks a rule's instances and sticky session. Load-balancing
+// several instances needs a hostname that names no instance and Caddy's
+// proxy, so it is limited to HTTP rules with literal hostnames.
+func validateTarget(i int, rule IngressRule) error {
+	target := rule.Target
+	switch {
+	case target.Instance == "" && len(target.Instances) == 0:
+		return &ValidationError{Field: "rules", Message: "instance is required in rule " + strconv.Itoa(i)}
+	case target.Instance != "" && len(target.Instances) > 0:
+		return &ValidationError{Field: "rules", Message: "target.instance and target.instances can't both be set in rule " + strconv.Itoa(i)}
+	}
+
+	if len(target.Instances) > 0 {
+		if len(target.Instances) < 2 {
+			return &ValidationError{Field: "rules", Message: "target.instances needs at least two instances, use target.instance for one, in rule " + strconv.Itoa(i)}
+		}
+		if rule.Match.IsPattern() || rule.Match.IsTCP() {
+			return &ValidationError{Field: "rules", Message: "target.instances is only supported for http rules with literal hostnames in rule " + strconv.Itoa(i)}
+		}
+		for _, instance := range target.Instances {
+			if instance == "" || captureRegex.MatchString(instance) {
+				return &ValidationError{Field: "rules", Message: fmt.Sprintf("target.instances must be instance names or IDs, got %q in rule %d", instance, i)}
+			}
+		}
 	}
 
+	if sticky := target.StickySession; sticky != nil {
+		if len(target.Instances) == 0 {
+			return &ValidationError{Field: "rules", Message: "sticky_session requires target.instances in rule " + strconv.Itoa(i)}
+		}
+		if !cookieNameRegex.MatchString(sticky.Cookie) {
+			return &ValidationError{Field: "rules", Message: fmt.Sprintf("sticky_session.cookie %q is not a valid cookie name in rule %d", sticky.Cookie, i)}
+		}
+		if sticky.TTL < 0 {
+			return &ValidationError{Field: "rules", Message: "sticky_session.ttl must not be negative in rule " + strconv.Itoa(i)}
+		}
+	}
+	return nil
+}
+
+// cookieNameRegex matches cookie names made of RFC 6265 token characters
+var cookieNameRegex = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// validateProtocol checks the fields that depend on a rule's protocol.
+func validateProtocol(i int, rule IngressRule) error {
+	switch rule.Match.Protocol {
+	case "", ProtocolHTTP:
+		return nil
+	case ProtocolTCP:
+	default:
+		return &ValidationError{Field: "rules", Message: fmt.Sprintf("match.protocol must be %q or %q in rule %d", ProtocolHTTP, ProtocolTCP, i)}
+	}
+
+	// TCP has no default port to fall back on and nothing to route a
+	// pattern hostname by
+	if rule.Match.Port == 0 {
+		return &ValidationError{Field: "rules", Message: "tcp rules require match.port in rule " + strconv.Itoa(i)}
+	}
+	if rule.Match.IsPattern() {
+		return &ValidationError{Field: "rules", Message: "tcp rules don't support pattern hostnames in rule " + strconv.Itoa(i)}
+	}
+	if rule.RedirectHTTP {
+		return &ValidationError{Field: "rules", Message: "redirect_http is not supported for tcp rules in rule " + strconv.Itoa(i)}
+	}
+	if rule.MaxRequestBodyBytes != 0 {
+		return &ValidationError{Field: "rules", Message: "max_request_body_bytes is not supported for tcp rules in rule " + strconv.Itoa(i)}
+	}
 	return nil
 }
 
@@ -12,6 +12,39 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestIngressTargetsOnly(t *testing.T) {
+	rule := func(instance string) IngressRule {
+		return IngressRule{Match: IngressMatch{Hostname: "a.example.com"}, Target: IngressTarget{Instance: instance, Port: 80}}
+	}
+	tests := []struct {
+		name  string
+		rules []IngressRule
+		want  bool
+	}{
+		{"by name", []IngressRule{rule("web")}, true},
+		{"by ID", []IngressRule{rule("inst-1"), rule("web")}, true},
+		{"another instance too", []IngressRule{rule("web"), rule("api")}, false},
+		{"pattern", []IngressRule{rule("{instance}")}, false},
+		{"no rules", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ing := Ingress{Rules: tt.rules}
+			assert.Equal(t, tt.want, ing.TargetsOnly("web", "inst-1"))
+		})
+	}
+
+	shared := Ingress{Rules: []IngressRule{rule("api"), rule("web")}}
+	assert.True(t, shared.Targets("web", "inst-1"))
+	assert.False(t, shared.Targets("db", "inst-2"))
+	pattern := Ingress{Rules: []IngressRule{rule("{instance}")}}
+	assert.False(t, pattern.Targets("web", "inst-1"))
+
+	balanced := Ingress{Rules: []IngressRule{{Match: IngressMatch{Hostname: "a.example.com"}, Target: IngressTarget{Instances: []string{"api", "web"}, Port: 80}}}}
+	assert.True(t, balanced.Targets("web", "inst-1"))
+	assert.False(t, balanced.TargetsOnly("web", "inst-1"), "the other instance still serves it")
+}
+
 // TestPatternParsing tests the hostname pattern parsing functionality.
 func TestPatternParsing(t *testing.T) {
 	t.Run("IsPattern", func(t *testing.T) {
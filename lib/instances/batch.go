@@ -0,0 +1,123 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/onkernel/hypeman/lib/images"
+	"github.com/onkernel/hypeman/lib/logger"
+	"gvisor.dev/gvisor/pkg/cleanup"
+)
+
+// MaxBatchSize is the most instances a single CreateInstances call creates
+const MaxBatchSize = 100
+
+// BatchMemberError is the failure of one member of a batch create
+type BatchMemberError struct {
+	Name string
+	Err  error
+}
+
+// BatchCreateResult is the outcome of CreateInstances
+type BatchCreateResult struct {
+	// Instances holds the created members. After a failure it holds only the
+	// members that could not be rolled back.
+	Instances []Instance
+	// Errors holds the member that failed and any members whose rollback failed
+	Errors []BatchMemberError
+}
+
+// batchMemberNames returns the names of a batch's members: <name>-1 to <name>-<count>
+func batchMemberNames(name string, count int) []string {
+	names := make([]string, count)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s-%d", name, i+1)
+	}
+	return names
+}
+
+// CreateInstances creates count instances from one template, named
+// <name>-1 to <name>-<count>. The whole batch is checked against the
+// aggregate resource limits before anything is created, and if a member
+// fails the members created so far are deleted again, so a batch either
+// fits entirely or leaves nothing behind. Members are created one at a time;
+// a concurrent create that takes the remaining capacity fails the batch.
+func (m *manager) CreateInstances(ctx context.Context, req CreateInstanceRequest, count int) (*BatchCreateResult, error) {
+	log := logger.FromContext(ctx)
+
+	if count < 1 || count > MaxBatchSize {
+		return nil, fmt.Errorf("%w: count must be between 1 and %d", ErrInvalidBatch, MaxBatchSize)
+	}
+	names := batchMemberNames(req.Name, count)
+	// The longest name is the last one
+	last := req
+	last.Name = names[count-1]
+	if err := validateCreateRequest(last); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBatch, err)
+	}
+
+	existing, err := m.listInstances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list instances: %w", err)
+	}
+	for _, inst := range existing {
+		if slices.Contains(names, inst.Name) {
+			return nil, fmt.Errorf("%w: %s", ErrNameExists, inst.Name)
+		}
+	}
+
+	// Fail fast on an image every member would be rejected for
+	imageInfo, err := m.imageManager.GetImage(ctx, req.Image)
+	if err != nil {
+		if err == images.ErrNotFound {
+			return nil, fmt.Errorf("image %s: %w", req.Image, err)
+		}
+		return nil, fmt.Errorf("get image: %w", err)
+	}
+	if imageInfo.Status != images.StatusReady {
+		return nil, fmt.Errorf("%w: image status is %s", ErrImageNotReady, imageInfo.Status)
+	}
+
+	size, hotplugSize, _, vcpus := resourceDefaults(req)
+	if err := m.checkAggregateLimits(ctx, vcpus*count, (size+hotplugSize)*int64(count)); err != nil {
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "creating instance batch", "name", req.Name, "count", count, "image", req.Image)
+
+	result := &BatchCreateResult{}
+	cu := cleanup.Make(func() {})
+	defer cu.Clean()
+
+	for _, name := range names {
+		member := req
+		member.Name = name
+		member.Env = maps.Clone(req.Env)
+
+		inst, err := m.CreateInstance(ctx, member)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to create batch member, rolling back batch", "name", name, "error", err)
+			result.Errors = append(result.Errors, BatchMemberError{Name: name, Err: err})
+			cu.Clean()
+			return result, fmt.Errorf("create %s: %w", name, err)
+		}
+		result.Instances = append(result.Instances, *inst)
+
+		id := inst.Id
+		cu.Add(func() {
+			// Roll back even if the request that started the batch went away
+			if err := m.DeleteOwnedInstance(context.WithoutCancel(ctx), id); err != nil {
+				log.ErrorContext(ctx, "failed to roll back batch member", "instance_id", id, "name", name, "error", err)
+				result.Errors = append(result.Errors, BatchMemberError{Name: name, Err: fmt.Errorf("roll back: %w", err)})
+				return
+			}
+			result.Instances = slices.DeleteFunc(result.Instances, func(i Instance) bool { return i.Id == id })
+		})
+	}
+
+	cu.Release()
+	log.InfoContext(ctx, "instance batch created", "name", req.Name, "count", count)
+	return result, nil
+}
@@ -0,0 +1,55 @@
+package instances
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/images"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchMemberNames(t *testing.T) {
+	assert.Equal(t, []string{"worker-1", "worker-2", "worker-3"}, batchMemberNames("worker", 3))
+}
+
+func TestCreateInstancesValidation(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+	req := CreateInstanceRequest{Name: "worker", Image: "docker.io/library/alpine:latest"}
+
+	for _, count := range []int{0, -1, MaxBatchSize + 1} {
+		_, err := mgr.CreateInstances(ctx, req, count)
+		assert.ErrorIs(t, err, ErrInvalidBatch, "count %d", count)
+	}
+
+	// Suffixed names must still be valid instance names
+	long := req
+	long.Name = strings.Repeat("a", 62)
+	_, err := mgr.CreateInstances(ctx, long, 2)
+	assert.ErrorIs(t, err, ErrInvalidBatch)
+
+	// No member may take a name that is already in use
+	require.NoError(t, mgr.ensureDirectories("existing"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "existing", Name: "worker-2"}}))
+	_, err = mgr.CreateInstances(ctx, req, 3)
+	assert.ErrorIs(t, err, ErrNameExists)
+
+	// A missing image fails the batch before any member is created
+	_, err = mgr.CreateInstances(ctx, req, 1)
+	assert.ErrorIs(t, err, images.ErrNotFound)
+}
+
+func TestCheckAggregateLimits(t *testing.T) {
+	mgr := createTestManager(t, ResourceLimits{MaxTotalVcpus: 8, MaxTotalMemory: 16 * 1024 * 1024 * 1024})
+	ctx := context.Background()
+
+	assert.NoError(t, mgr.checkAggregateLimits(ctx, 8, 16*1024*1024*1024))
+	assert.ErrorIs(t, mgr.checkAggregateLimits(ctx, 4*2, 4*5*1024*1024*1024), ErrLimitExceeded)
+	assert.ErrorIs(t, mgr.checkAggregateLimits(ctx, 10, 0), ErrLimitExceeded)
+
+	// Zero limits are unlimited
+	unlimited := createTestManager(t, ResourceLimits{})
+	assert.NoError(t, unlimited.checkAggregateLimits(ctx, 1000, 1<<50))
+}
@@ -0,0 +1,82 @@
+package instances
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/onkernel/hypeman/lib/vmconfig"
+)
+
+// BootState is how far the last boot of an instance got
+type BootState string
+
+const (
+	// BootStateUnknown means the serial console log has no boot markers: the instance
+	// never booted, its initrd predates them, or they were rotated out
+	BootStateUnknown BootState = "unknown"
+	// BootStateBooting means init started but hasn't started the workload yet
+	BootStateBooting BootState = "booting"
+	// BootStateComplete means the workload was started
+	BootStateComplete BootState = "complete"
+	// BootStateFailed means a boot phase failed and the guest powered off
+	BootStateFailed BootState = "failed"
+)
+
+// BootStatus reports the outcome of the last boot, read from the markers the
+// guest init writes to the serial console
+type BootStatus struct {
+	State       BootState
+	FailedPhase string // Boot phase that failed (only when State is BootStateFailed)
+	Error       string // Why it failed (only when State is BootStateFailed)
+}
+
+// GetBootStatus returns how far the last boot of an instance got
+func (m *manager) GetBootStatus(ctx context.Context, id string) (*BootStatus, error) {
+	if _, err := m.loadMetadata(id); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(m.serialLogPath(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return &BootStatus{State: BootStateUnknown}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open serial console log: %w", err)
+	}
+	defer f.Close()
+	return parseBootStatus(f)
+}
+
+// parseBootStatus scans a serial console log for boot markers. The last one
+// wins, since the log spans every boot of the instance.
+func parseBootStatus(r io.Reader) (*BootStatus, error) {
+	status := &BootStatus{State: BootStateUnknown}
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadString('\n')
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == vmconfig.BootStartedMarker:
+			status = &BootStatus{State: BootStateBooting}
+		case line == vmconfig.BootCompleteMarker:
+			status = &BootStatus{State: BootStateComplete}
+		case strings.HasPrefix(line, vmconfig.BootFailedMarker):
+			var failure vmconfig.BootFailure
+			if json.Unmarshal([]byte(strings.TrimPrefix(line, vmconfig.BootFailedMarker)), &failure) == nil {
+				status = &BootStatus{State: BootStateFailed, FailedPhase: failure.Phase, Error: failure.Error}
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return status, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read serial console log: %w", err)
+		}
+	}
+}
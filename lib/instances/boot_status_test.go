@@ -0,0 +1,41 @@
+package instances
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBootStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		log  string
+		want BootStatus
+	}{
+		{"empty", "", BootStatus{State: BootStateUnknown}},
+		{"no markers", "2025-01-01T00:00:00Z [INFO] [boot] init starting\n", BootStatus{State: BootStateUnknown}},
+		{"booting", "HYPEMAN_BOOT_STARTED\n[INFO] [overlay] mounting\n", BootStatus{State: BootStateBooting}},
+		{"complete", "HYPEMAN_BOOT_STARTED\r\nHYPEMAN_BOOT_COMPLETE\r\napp output\n", BootStatus{State: BootStateComplete}},
+		{
+			"failed",
+			"HYPEMAN_BOOT_STARTED\n" + `HYPEMAN_BOOT_FAILED {"phase":"overlay","error":"failed to setup overlay: mount /dev/vdb: no such device"}` + "\n",
+			BootStatus{State: BootStateFailed, FailedPhase: "overlay", Error: "failed to setup overlay: mount /dev/vdb: no such device"},
+		},
+		{
+			"reboot after failure",
+			`HYPEMAN_BOOT_FAILED {"phase":"config","error":"boom"}` + "\nHYPEMAN_BOOT_STARTED\n",
+			BootStatus{State: BootStateBooting},
+		},
+		{"malformed failure is ignored", "HYPEMAN_BOOT_STARTED\nHYPEMAN_BOOT_FAILED {not json\n", BootStatus{State: BootStateBooting}},
+		{"no trailing newline", "HYPEMAN_BOOT_STARTED\nHYPEMAN_BOOT_COMPLETE", BootStatus{State: BootStateComplete}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseBootStatus(strings.NewReader(tt.log))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, *got)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/nrednav/cuid2"
+	"github.com/onkernel/hypeman/lib/images"
+	"github.com/onkernel/hypeman/lib/logger"
+	"gvisor.dev/gvisor/pkg/cleanup"
+)
+
+// CloneInstance creates a new instance from an existing one: same image and
+// configuration, a copy of its overlay disk, and a fresh network allocation.
+// The clone boots from the copied disk rather than the source's memory, so
+// copying a running source (paused for the copy) is like a power cut.
+// Passthrough devices are not cloned. The clone's ParentID records the source.
+func (m *manager) CloneInstance(ctx context.Context, id string, req CloneInstanceRequest) (*Instance, error) {
+	log := logger.FromContext(ctx)
+	log.InfoContext(ctx, "cloning instance", "instance_id", id, "name", req.Name, "clone_volumes", req.CloneVolumes)
+
+	createReq, from, removeCopies, err := m.copyForClone(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	inst, err := m.createInstance(ctx, createReq, from)
+	if err != nil {
+		removeCopies()
+		return nil, err
+	}
+	return inst, nil
+}
+
+// copyForClone copies a source instance's overlay disk and, if requested, its
+// writable volumes, pausing a running source while they are copied. It
+// returns the request to create the clone with and a func that removes the
+// copies if that fails.
+func (m *manager) copyForClone(ctx context.Context, id string, req CloneInstanceRequest) (CreateInstanceRequest, *cloneSource, func(), error) {
+	log := logger.FromContext(ctx)
+
+	lock := m.getInstanceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	meta, err := m.loadMetadata(id)
+	if err != nil {
+		return CreateInstanceRequest{}, nil, nil, err
+	}
+	inst := m.toInstance(ctx, meta)
+	stored := &meta.StoredMetadata
+
+	switch inst.State {
+	case StateRunning, StatePaused, StateStandby, StateStopped:
+	default:
+		return CreateInstanceRequest{}, nil, nil, fmt.Errorf("%w: cannot clone from state %s", ErrInvalidState, inst.State)
+	}
+
+	createReq := CreateInstanceRequest{
+		Name:                     req.Name,
+		Image:                    stored.Image,
+		Size:                     stored.Size,
+		HotplugSize:              stored.HotplugSize,
+		OverlaySize:              stored.OverlaySize,
+		OverlayFormat:            stored.OverlayFormat,
+		Discard:                  &stored.Discard,
+		Vcpus:                    stored.Vcpus,
+		NetworkBandwidthDownload: stored.NetworkBandwidthDownload,
+		NetworkBandwidthUpload:   stored.NetworkBandwidthUpload,
+		DiskIOBps:                stored.DiskIOBps,
+		Env:                      maps.Clone(stored.Env),
+		NetworkEnabled:           stored.NetworkEnabled,
+		MTU:                      stored.MTU,
+		DNSServers:               slices.Clone(stored.DNSServers),
+		SearchDomains:            slices.Clone(stored.SearchDomains),
+		Hypervisor:               stored.HypervisorType,
+		LogRetention:             stored.LogRetention,
+		IdleTimeout:              stored.IdleTimeout,
+		IdleAction:               stored.IdleAction,
+		KernelVersion:            stored.KernelVersion,
+		KernelModules:            slices.Clone(stored.KernelModules),
+		InitMode:                 stored.InitMode,
+		Systemd:                  stored.Systemd,
+	}
+	if err := validateCreateRequest(createReq); err != nil {
+		return CreateInstanceRequest{}, nil, nil, err
+	}
+	if len(stored.Devices) > 0 {
+		log.InfoContext(ctx, "not cloning passthrough devices", "instance_id", id, "devices", stored.Devices)
+	}
+
+	cu := cleanup.Make(func() {})
+	defer cu.Clean()
+
+	// Pause a running source so its disks don't change while being copied
+	if inst.State == StateRunning {
+		hv, err := m.getHypervisor(inst.SocketPath, stored.HypervisorType)
+		if err != nil {
+			return CreateInstanceRequest{}, nil, nil, fmt.Errorf("create hypervisor client: %w", err)
+		}
+		log.DebugContext(ctx, "pausing source for clone", "instance_id", id)
+		if err := hv.Pause(ctx); err != nil {
+			return CreateInstanceRequest{}, nil, nil, fmt.Errorf("pause source vm: %w", err)
+		}
+		defer func() {
+			if err := hv.Resume(context.WithoutCancel(ctx)); err != nil {
+				log.ErrorContext(ctx, "failed to resume source after clone", "instance_id", id, "error", err)
+			}
+		}()
+	}
+
+	overlay := filepath.Join(m.paths.InstanceDir(id), "clone-"+cuid2.Generate()+filepath.Ext(m.overlayPath(id, stored.OverlayFormat)))
+	if err := images.CopyDisk(m.overlayPath(id, stored.OverlayFormat), overlay); err != nil {
+		return CreateInstanceRequest{}, nil, nil, fmt.Errorf("copy overlay disk: %w", err)
+	}
+	cu.Add(func() { os.Remove(overlay) })
+
+	// Read-only volumes can be shared; a writable volume must never be
+	// attached to both instances, so it is copied or left out
+	for _, vol := range stored.Volumes {
+		if vol.Readonly {
+			createReq.Volumes = append(createReq.Volumes, vol)
+			continue
+		}
+		if !req.CloneVolumes {
+			log.InfoContext(ctx, "not attaching writable volume to clone", "instance_id", id, "volume_id", vol.VolumeID)
+			continue
+		}
+
+		source, err := m.volumeManager.GetVolume(ctx, vol.VolumeID)
+		if err != nil {
+			return CreateInstanceRequest{}, nil, nil, fmt.Errorf("volume %s: %w", vol.VolumeID, err)
+		}
+		copied, err := m.volumeManager.CloneVolume(ctx, vol.VolumeID, source.Name+"-"+req.Name)
+		if err != nil {
+			return CreateInstanceRequest{}, nil, nil, fmt.Errorf("clone volume %s: %w", vol.VolumeID, err)
+		}
+		cu.Add(func() { m.volumeManager.DeleteVolume(context.WithoutCancel(ctx), copied.Id) })
+		log.DebugContext(ctx, "cloned volume", "instance_id", id, "volume_id", vol.VolumeID, "clone_volume_id", copied.Id)
+
+		vol.VolumeID = copied.Id
+		createReq.Volumes = append(createReq.Volumes, vol)
+	}
+
+	return createReq, &cloneSource{parentID: id, overlay: overlay}, cu.Release(), nil
+}
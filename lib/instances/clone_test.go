@@ -0,0 +1,81 @@
+package instances
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/volumes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyForClone(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	// A stopped source with one writable and one read-only volume
+	rw, err := mgr.volumeManager.CreateVolume(ctx, volumes.CreateVolumeRequest{Name: "data", SizeGb: 1})
+	require.NoError(t, err)
+	ro, err := mgr.volumeManager.CreateVolume(ctx, volumes.CreateVolumeRequest{Name: "models", SizeGb: 1})
+	require.NoError(t, err)
+
+	id := "source"
+	require.NoError(t, mgr.ensureDirectories(id))
+	require.NoError(t, os.WriteFile(mgr.paths.InstanceOverlay(id), []byte("overlay"), 0644))
+	attachments := []VolumeAttachment{
+		{VolumeID: rw.Id, MountPath: "/data"},
+		{VolumeID: ro.Id, MountPath: "/models", Readonly: true},
+	}
+	for _, vol := range attachments {
+		require.NoError(t, mgr.volumeManager.AttachVolume(ctx, vol.VolumeID, volumes.AttachVolumeRequest{
+			InstanceID: id, MountPath: vol.MountPath, Readonly: vol.Readonly,
+		}))
+	}
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{
+		Id:          id,
+		Name:        "source",
+		Image:       "docker.io/library/alpine:latest",
+		Vcpus:       2,
+		OverlaySize: 1024,
+		Env:         map[string]string{"DEBUG": "1"},
+		Volumes:     attachments,
+	}}))
+
+	// Without CloneVolumes the writable volume is left out
+	req, from, removeCopies, err := mgr.copyForClone(ctx, id, CloneInstanceRequest{Name: "repro"})
+	require.NoError(t, err)
+	assert.Equal(t, "repro", req.Name)
+	assert.Equal(t, "docker.io/library/alpine:latest", req.Image)
+	assert.Equal(t, map[string]string{"DEBUG": "1"}, req.Env)
+	assert.Equal(t, []VolumeAttachment{attachments[1]}, req.Volumes)
+	assert.Equal(t, id, from.parentID)
+	data, err := os.ReadFile(from.overlay)
+	require.NoError(t, err)
+	assert.Equal(t, "overlay", string(data))
+
+	removeCopies()
+	assert.NoFileExists(t, from.overlay)
+
+	// With CloneVolumes the clone gets its own copy of the writable volume
+	req, from, removeCopies, err = mgr.copyForClone(ctx, id, CloneInstanceRequest{Name: "repro", CloneVolumes: true})
+	require.NoError(t, err)
+	require.Len(t, req.Volumes, 2)
+	copied := req.Volumes[0]
+	assert.NotEqual(t, rw.Id, copied.VolumeID)
+	assert.Equal(t, "/data", copied.MountPath)
+	assert.False(t, copied.Readonly)
+	vol, err := mgr.volumeManager.GetVolume(ctx, copied.VolumeID)
+	require.NoError(t, err)
+	assert.Equal(t, "data-repro", vol.Name)
+	assert.Empty(t, vol.Attachments)
+
+	removeCopies()
+	_, err = mgr.volumeManager.GetVolume(ctx, copied.VolumeID)
+	assert.ErrorIs(t, err, volumes.ErrNotFound)
+	assert.NoFileExists(t, from.overlay)
+
+	// The clone's name is validated before anything is copied
+	_, _, _, err = mgr.copyForClone(ctx, id, CloneInstanceRequest{Name: "Not Valid"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,157 @@
+package instances
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"syscall"
+
+	"github.com/onkernel/hypeman/lib/logger"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sys/unix"
+)
+
+// compactChunkSize is how much of a raw overlay is checked for zeroes at a
+// time; it is a multiple of every filesystem block size in use
+const compactChunkSize = 1024 * 1024
+
+// CompactResult reports the host disk an overlay compaction reclaimed
+type CompactResult struct {
+	Format      OverlayFormat
+	BytesBefore int64 // Host disk allocated to the overlay before compacting
+	BytesAfter  int64
+}
+
+// compactOverlay frees the host disk held by blocks of a stopped instance's
+// overlay that read as zeroes: a qcow2 overlay is rewritten with qemu-img
+// convert, which leaves out zero and discarded clusters, and a raw overlay
+// has its zero ranges punched out in place. Blocks of deleted files are only
+// zero if the guest discarded them, so this pairs with disk_discard.
+//
+// The caller holds the instance lock.
+func (m *manager) compactOverlay(ctx context.Context, id string) (*CompactResult, error) {
+	log := logger.FromContext(ctx)
+	log.InfoContext(ctx, "compacting instance overlay", "instance_id", id)
+
+	if m.metrics != nil && m.metrics.tracer != nil {
+		var span trace.Span
+		ctx, span = m.metrics.tracer.Start(ctx, "CompactOverlay")
+		defer span.End()
+	}
+
+	meta, err := m.loadMetadata(id)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to load instance metadata", "instance_id", id, "error", err)
+		return nil, err
+	}
+	inst := m.toInstance(ctx, meta)
+
+	// A running VMM writes to the disk, and a standby snapshot refers to its
+	// current layout
+	if inst.State != StateStopped {
+		return nil, fmt.Errorf("%w: cannot compact overlay from state %s, must be Stopped", ErrInvalidState, inst.State)
+	}
+
+	format := inst.OverlayFormat
+	if format == "" {
+		format = OverlayFormatRaw
+	}
+	path := m.overlayPath(id, format)
+	result := &CompactResult{Format: format}
+	if result.BytesBefore, err = allocatedBytes(path); err != nil {
+		return nil, fmt.Errorf("stat overlay disk: %w", err)
+	}
+
+	if format == OverlayFormatQcow2 {
+		err = compactQcow2(path)
+	} else {
+		err = punchZeroRanges(path)
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to compact overlay", "instance_id", id, "error", err)
+		return nil, fmt.Errorf("compact overlay disk: %w", err)
+	}
+
+	if result.BytesAfter, err = allocatedBytes(path); err != nil {
+		return nil, fmt.Errorf("stat overlay disk: %w", err)
+	}
+	log.InfoContext(ctx, "overlay compacted", "instance_id", id, "format", format,
+		"bytes_before", result.BytesBefore, "bytes_after", result.BytesAfter)
+	return result, nil
+}
+
+// compactQcow2 rewrites a qcow2 image without its zero clusters, replacing
+// it only once the copy is complete
+func compactQcow2(path string) error {
+	compacted := path + ".compact"
+	defer os.Remove(compacted)
+	cmd := exec.Command("qemu-img", "convert", "-f", "qcow2", "-O", "qcow2", path, compacted)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("qemu-img convert failed: %w, output: %s", err, output)
+	}
+	return os.Rename(compacted, path)
+}
+
+// punchZeroRanges deallocates the all-zero chunks of a sparse file, leaving
+// its size and contents as they read. Holes are skipped, so only allocated
+// data is read.
+func punchZeroRanges(path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fd := int(f.Fd())
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+
+	buf := make([]byte, compactChunkSize)
+	zero := make([]byte, compactChunkSize)
+	offset := int64(0)
+	for offset < size {
+		data, err := unix.Seek(fd, offset, unix.SEEK_DATA)
+		if errors.Is(err, syscall.ENXIO) {
+			break // Only a hole is left
+		}
+		if err != nil {
+			return fmt.Errorf("seek data: %w", err)
+		}
+		hole, err := unix.Seek(fd, data, unix.SEEK_HOLE)
+		if err != nil {
+			return fmt.Errorf("seek hole: %w", err)
+		}
+
+		// Punch whole chunks only, aligned down so they match block boundaries
+		for chunk := data - data%compactChunkSize; chunk < hole; chunk += compactChunkSize {
+			n, err := f.ReadAt(buf, chunk)
+			if err != nil && !errors.Is(err, io.EOF) {
+				return fmt.Errorf("read at %d: %w", chunk, err)
+			}
+			if n == 0 || !bytes.Equal(buf[:n], zero[:n]) {
+				continue
+			}
+			if err := unix.Fallocate(fd, unix.FALLOC_FL_PUNCH_HOLE|unix.FALLOC_FL_KEEP_SIZE, chunk, int64(n)); err != nil {
+				return fmt.Errorf("punch hole at %d: %w", chunk, err)
+			}
+		}
+		offset = hole
+	}
+	return f.Sync()
+}
+
+// allocatedBytes returns the host disk a file takes, as opposed to its size
+func allocatedBytes(path string) (int64, error) {
+	var st syscall.Stat_t
+	if err := syscall.Stat(path, &st); err != nil {
+		return 0, err
+	}
+	return st.Blocks * 512, nil
+}
@@ -0,0 +1,58 @@
+package instances
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPunchZeroRanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "overlay.raw")
+	data := bytes.Repeat([]byte{0xab}, compactChunkSize)
+
+	// Data, written zeroes, a hole, then data again
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	_, err = f.WriteAt(data, 0)
+	require.NoError(t, err)
+	_, err = f.WriteAt(make([]byte, 4*compactChunkSize), compactChunkSize)
+	require.NoError(t, err)
+	_, err = f.WriteAt(data, 8*compactChunkSize)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	before, err := allocatedBytes(path)
+	require.NoError(t, err)
+	require.NoError(t, punchZeroRanges(path))
+	after, err := allocatedBytes(path)
+	require.NoError(t, err)
+
+	assert.Less(t, after, before)
+	assert.LessOrEqual(t, after, int64(2*compactChunkSize+64*1024))
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, contents, 9*compactChunkSize)
+	assert.Equal(t, data, contents[:compactChunkSize])
+	assert.Equal(t, make([]byte, 7*compactChunkSize), contents[compactChunkSize:8*compactChunkSize])
+	assert.Equal(t, data, contents[8*compactChunkSize:])
+}
+
+func TestCompactOverlay_Stopped(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+
+	require.NoError(t, mgr.ensureDirectories("inst-compact"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "inst-compact", Name: "compact"}}))
+	overlay := mgr.paths.InstanceOverlay("inst-compact")
+	require.NoError(t, os.WriteFile(overlay, make([]byte, 4*compactChunkSize), 0644))
+
+	result, err := mgr.CompactOverlay(context.Background(), "inst-compact")
+	require.NoError(t, err)
+	assert.Equal(t, OverlayFormatRaw, result.Format)
+	assert.Less(t, result.BytesAfter, result.BytesBefore)
+}
@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -18,27 +16,19 @@ import (
 // createConfigDisk generates an ext4 disk with instance configuration.
 // The disk contains /config.json read by the guest init binary.
 func (m *manager) createConfigDisk(ctx context.Context, inst *Instance, imageInfo *images.Image, netConfig *network.NetworkConfig) error {
-	// Create temporary directory for config files
-	tmpDir, err := os.MkdirTemp("", "hypeman-config-*")
+	// Generate config.json
+	cfg, err := m.buildGuestConfig(ctx, inst, imageInfo, netConfig)
 	if err != nil {
-		return fmt.Errorf("create temp dir: %w", err)
+		return err
 	}
-	defer os.RemoveAll(tmpDir)
-
-	// Generate config.json
-	cfg := m.buildGuestConfig(ctx, inst, imageInfo, netConfig)
 	configData, err := json.MarshalIndent(cfg, "", "  ")
 	if err != nil {
 		return fmt.Errorf("marshal config: %w", err)
 	}
-	configPath := filepath.Join(tmpDir, "config.json")
-	if err := os.WriteFile(configPath, configData, 0644); err != nil {
-		return fmt.Errorf("write config.json: %w", err)
-	}
 
 	// Create ext4 disk with config files
 	diskPath := m.paths.InstanceConfigDisk(inst.Id)
-	_, err = images.ExportRootfs(tmpDir, diskPath, images.FormatExt4)
+	_, err = images.WriteConfigDisk(diskPath, map[string][]byte{"config.json": configData})
 	if err != nil {
 		return fmt.Errorf("create config disk: %w", err)
 	}
@@ -47,7 +37,7 @@ func (m *manager) createConfigDisk(ctx context.Context, inst *Instance, imageInf
 }
 
 // buildGuestConfig creates the vmconfig.Config struct for the guest init binary.
-func (m *manager) buildGuestConfig(ctx context.Context, inst *Instance, imageInfo *images.Image, netConfig *network.NetworkConfig) *vmconfig.Config {
+func (m *manager) buildGuestConfig(ctx context.Context, inst *Instance, imageInfo *images.Image, netConfig *network.NetworkConfig) (*vmconfig.Config, error) {
 	cfg := &vmconfig.Config{
 		Entrypoint: imageInfo.Entrypoint,
 		Cmd:        imageInfo.Cmd,
@@ -67,6 +57,9 @@ func (m *manager) buildGuestConfig(ctx context.Context, inst *Instance, imageInf
 		cfg.GuestCIDR = netmaskToCIDR(netConfig.Netmask)
 		cfg.GuestGW = netConfig.Gateway
 		cfg.GuestDNS = netConfig.DNS
+		cfg.GuestMTU = netConfig.MTU
+		cfg.DNSServers = inst.DNSServers
+		cfg.SearchDomains = inst.SearchDomains
 	}
 
 	// GPU passthrough - check if any attached device is a GPU
@@ -78,36 +71,62 @@ func (m *manager) buildGuestConfig(ctx context.Context, inst *Instance, imageInf
 		}
 	}
 
+	// Extra kernel modules, loaded by the guest after volumes are mounted
+	cfg.KernelModules = inst.KernelModules
+
 	// Volume mounts
-	// Volumes are attached as /dev/vdd, /dev/vde, etc. (after vda=rootfs, vdb=overlay, vdc=config)
-	deviceIdx := 0
-	for _, vol := range inst.Volumes {
-		device := fmt.Sprintf("/dev/vd%c", 'd'+deviceIdx)
+	// Volumes are attached as /dev/vdd, /dev/vde, etc. (after vda=rootfs, vdb=overlay, vdc=config).
+	// Where the hypervisor passes serials on, the guest mounts them by serial instead.
+	volDevices, err := assignVolumeDevices(inst.Volumes, maxDisks(inst.HypervisorType))
+	if err != nil {
+		return nil, err
+	}
+	if !supportsDiskSerial(inst.HypervisorType) {
+		for i := range volDevices {
+			volDevices[i].Serial, volDevices[i].OverlaySerial = "", ""
+		}
+	}
+	for i, vol := range inst.Volumes {
 		mount := vmconfig.VolumeMount{
-			Device: device,
+			Device: volDevices[i].Device,
+			Serial: volDevices[i].Serial,
 			Path:   vol.MountPath,
 		}
 		if vol.Overlay {
 			mount.Mode = "overlay"
-			mount.OverlayDevice = fmt.Sprintf("/dev/vd%c", 'd'+deviceIdx+1)
-			deviceIdx += 2
+			mount.OverlayDevice = volDevices[i].OverlayDevice
+			mount.OverlaySerial = volDevices[i].OverlaySerial
+		} else if vol.Readonly {
+			mount.Mode = "ro"
 		} else {
-			if vol.Readonly {
-				mount.Mode = "ro"
-			} else {
-				mount.Mode = "rw"
-			}
-			deviceIdx++
+			mount.Mode = "rw"
 		}
+		mount.Discard = inst.Discard && mount.Mode != "ro"
 		cfg.VolumeMounts = append(cfg.VolumeMounts, mount)
 	}
+	cfg.Discard = inst.Discard
 
-	// Determine init mode based on image CMD
-	if images.IsSystemdImage(imageInfo.Entrypoint, imageInfo.Cmd) {
+	// Determine init mode: as requested, or based on image CMD
+	switch inst.InitMode {
+	case InitModeSystemd:
 		cfg.InitMode = "systemd"
+		// An image whose command isn't systemd boots /sbin/init instead, so
+		// its services must be installed as units
+		if !images.IsSystemdImage(imageInfo.Entrypoint, imageInfo.Cmd) {
+			cfg.Entrypoint, cfg.Cmd = nil, []string{"/sbin/init"}
+		}
+	case InitModeExec:
+	default:
+		if images.IsSystemdImage(imageInfo.Entrypoint, imageInfo.Cmd) {
+			cfg.InitMode = "systemd"
+		}
+	}
+	if cfg.InitMode == "systemd" && inst.Systemd != nil {
+		cfg.SystemdTarget = inst.Systemd.DefaultTarget
+		cfg.SystemdMaskUnits = inst.Systemd.MaskUnits
 	}
 
-	return cfg
+	return cfg, nil
 }
 
 // mergeEnv merges image environment variables with instance overrides.
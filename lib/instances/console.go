@@ -0,0 +1,51 @@
+package instances
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/onkernel/hypeman/lib/hypervisor"
+)
+
+// DefaultConsoleBaudRate is the serial console's baud rate unless configured
+const DefaultConsoleBaudRate = 115200
+
+// ConsoleConfig configures the guest's serial console
+type ConsoleConfig struct {
+	BaudRate         int   // Serial console baud rate (0 = DefaultConsoleBaudRate)
+	KernelLogBufSize int64 // Kernel log buffer size in bytes (0 = the kernel's default)
+}
+
+// kernelArgs returns the kernel command line for the console settings
+func (c ConsoleConfig) kernelArgs() string {
+	baud := c.BaudRate
+	if baud <= 0 {
+		baud = DefaultConsoleBaudRate
+	}
+	args := fmt.Sprintf("console=ttyS0,%d", baud)
+	if c.KernelLogBufSize > 0 {
+		args += fmt.Sprintf(" log_buf_len=%d", c.KernelLogBufSize)
+	}
+	return args
+}
+
+// consoleLogPaths returns where an instance's serial and virtio console
+// output is written. Where the hypervisor has a virtio console, the
+// application's output goes to it and the app log, and the serial console's
+// kernel and init output to the system log. Otherwise everything is written
+// to the app log through the serial console.
+func (m *manager) consoleLogPaths(id string, hvType hypervisor.Type) (serial, console string) {
+	if caps, ok := hypervisor.CapabilitiesForType(hvType); ok && caps.SupportsConsole {
+		return m.paths.InstanceSystemLog(id), m.paths.InstanceAppLog(id)
+	}
+	return m.paths.InstanceAppLog(id), ""
+}
+
+// serialLogPath returns the log of an instance's serial console: the system
+// log, or the app log for an instance that never had a virtio console
+func (m *manager) serialLogPath(id string) string {
+	if _, err := os.Stat(m.paths.InstanceSystemLog(id)); err == nil {
+		return m.paths.InstanceSystemLog(id)
+	}
+	return m.paths.InstanceAppLog(id)
+}
@@ -2,10 +2,14 @@ package instances
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/nrednav/cuid2"
@@ -22,11 +26,12 @@ import (
 )
 
 const (
-	// MaxVolumesPerInstance is the maximum number of volumes that can be attached
-	// to a single instance. This limit exists because volume devices are named
-	// /dev/vdd, /dev/vde, ... /dev/vdz (letters d-z = 23 devices).
-	// Devices a-c are reserved for rootfs, overlay, and config disk.
-	MaxVolumesPerInstance = 23
+	// MaxVolumesPerInstance is the maximum number of volume disks that can be
+	// attached to a single instance (overlay volumes count as 2). Cloud
+	// Hypervisor and QEMU put disks past /dev/vdz on a second PCI bus, for
+	// 3 + 54 = 57 disks; Firecracker stops at /dev/vdz, which leaves 23 after
+	// the rootfs, overlay and config disks.
+	MaxVolumesPerInstance = 54
 )
 
 // systemDirectories are paths that cannot be used as volume mount points
@@ -73,28 +78,84 @@ func (m *manager) calculateAggregateUsage(ctx context.Context) (AggregateUsage,
 	return usage, nil
 }
 
-// generateVsockCID converts first 8 chars of instance ID to a unique CID
-// CIDs 0-2 are reserved (hypervisor, loopback, host)
-// Returns value in range 3 to 4294967295
-func generateVsockCID(instanceID string) int64 {
-	idPrefix := instanceID
-	if len(idPrefix) > 8 {
-		idPrefix = idPrefix[:8]
+// reserveName claims an instance name for a create in progress. It fails
+// with ErrNameExists if an instance or another create already has the name.
+func (m *manager) reserveName(ctx context.Context, name string) (func(), error) {
+	if _, taken := m.pendingNames.LoadOrStore(name, struct{}{}); taken {
+		return nil, fmt.Errorf("%w: %s", ErrNameExists, name)
 	}
+	release := func() { m.pendingNames.Delete(name) }
 
-	var sum int64
-	for _, c := range idPrefix {
-		sum = sum*37 + int64(c)
+	instances, err := m.listInstances(ctx)
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("list instances: %w", err)
+	}
+	for _, inst := range instances {
+		if inst.Name == name {
+			release()
+			return nil, fmt.Errorf("%w: %s", ErrNameExists, name)
+		}
+	}
+	return release, nil
+}
+
+// resourceDefaults returns the size, hotplug size, overlay size and vCPUs an
+// instance is created with, filling in defaults for unset values
+func resourceDefaults(req CreateInstanceRequest) (size, hotplugSize, overlaySize int64, vcpus int) {
+	size = req.Size
+	if size == 0 {
+		size = 1 * 1024 * 1024 * 1024 // 1GB default
+	}
+	hotplugSize = req.HotplugSize
+	if hotplugSize == 0 {
+		hotplugSize = 3 * 1024 * 1024 * 1024 // 3GB default
+	}
+	overlaySize = req.OverlaySize
+	if overlaySize == 0 {
+		overlaySize = 10 * 1024 * 1024 * 1024 // 10GB default
+	}
+	vcpus = req.Vcpus
+	if vcpus == 0 {
+		vcpus = 2
 	}
+	return size, hotplugSize, overlaySize, vcpus
+}
+
+// checkAggregateLimits checks that adding vcpus and memory (in bytes) to the
+// current usage stays within the aggregate resource limits
+func (m *manager) checkAggregateLimits(ctx context.Context, vcpus int, memory int64) error {
+	if m.limits.MaxTotalVcpus <= 0 && m.limits.MaxTotalMemory <= 0 {
+		return nil
+	}
+	usage, err := m.calculateAggregateUsage(ctx)
+	if err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "failed to calculate aggregate usage, skipping limit check", "error", err)
+		return nil
+	}
+	if m.limits.MaxTotalVcpus > 0 && usage.TotalVcpus+vcpus > m.limits.MaxTotalVcpus {
+		return fmt.Errorf("%w: total vcpus would be %d, exceeds aggregate limit of %d", ErrLimitExceeded, usage.TotalVcpus+vcpus, m.limits.MaxTotalVcpus)
+	}
+	if m.limits.MaxTotalMemory > 0 && usage.TotalMemory+memory > m.limits.MaxTotalMemory {
+		return fmt.Errorf("%w: total memory would be %d, exceeds aggregate limit of %d", ErrLimitExceeded, usage.TotalMemory+memory, m.limits.MaxTotalMemory)
+	}
+	return nil
+}
 
-	return (sum % 4294967292) + 3
+// cloneSource is the parent of an instance being created by CloneInstance
+type cloneSource struct {
+	parentID string
+	overlay  string // copy of the parent's overlay disk, moved into place
 }
 
-// createInstance creates and starts a new instance
+// createInstance creates and starts a new instance. With from set, the
+// instance starts with a copy of its parent's overlay disk instead of an
+// empty one.
 // Multi-hop orchestration: Stopped → Created → Running
 func (m *manager) createInstance(
 	ctx context.Context,
 	req CreateInstanceRequest,
+	from *cloneSource,
 ) (*Instance, error) {
 	start := time.Now()
 	log := logger.FromContext(ctx)
@@ -113,7 +174,20 @@ func (m *manager) createInstance(
 		return nil, err
 	}
 
-	// 2. Validate image exists and is ready
+	// Names must be unique: ingress and name lookups resolve by name. The
+	// name is held until this create returns, by which time the metadata
+	// claiming it has been saved or cleaned up.
+	releaseName, err := m.reserveName(ctx, req.Name)
+	if err != nil {
+		log.ErrorContext(ctx, "instance name unavailable", "name", req.Name, "error", err)
+		return nil, err
+	}
+	defer releaseName()
+
+	// 2. Validate image exists and is ready. Image GC is held off until the
+	// instance metadata referencing the image is saved.
+	releaseGC := sync.OnceFunc(m.imageManager.BlockGC())
+	defer releaseGC()
 	log.DebugContext(ctx, "validating image", "image", req.Image)
 	imageInfo, err := m.imageManager.GetImage(ctx, req.Image)
 	if err != nil {
@@ -133,8 +207,14 @@ func (m *manager) createInstance(
 	id := cuid2.Generate()
 	log.DebugContext(ctx, "generated instance ID", "instance_id", id)
 
-	// 4. Generate vsock configuration
-	vsockCID := generateVsockCID(id)
+	// 4. Generate vsock configuration. The CID is held until this create
+	// returns, like the name.
+	vsockCID, releaseCID, err := m.reserveVsockCID(ctx, id)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to assign vsock CID", "instance_id", id, "error", err)
+		return nil, fmt.Errorf("assign vsock cid: %w", err)
+	}
+	defer releaseCID()
 	vsockSocket := m.paths.InstanceVsockSocket(id)
 	log.DebugContext(ctx, "generated vsock config", "instance_id", id, "cid", vsockCID)
 
@@ -144,49 +224,31 @@ func (m *manager) createInstance(
 	}
 
 	// 6. Apply defaults
-	size := req.Size
-	if size == 0 {
-		size = 1 * 1024 * 1024 * 1024 // 1GB default
-	}
-	hotplugSize := req.HotplugSize
-	if hotplugSize == 0 {
-		hotplugSize = 3 * 1024 * 1024 * 1024 // 3GB default
-	}
-	overlaySize := req.OverlaySize
-	if overlaySize == 0 {
-		overlaySize = 10 * 1024 * 1024 * 1024 // 10GB default
-	}
+	size, hotplugSize, overlaySize, vcpus := resourceDefaults(req)
 	// Validate overlay size against max
 	if overlaySize > m.limits.MaxOverlaySize {
 		return nil, fmt.Errorf("overlay size %d exceeds maximum allowed size %d", overlaySize, m.limits.MaxOverlaySize)
 	}
-	vcpus := req.Vcpus
-	if vcpus == 0 {
-		vcpus = 2
-	}
 
 	// Validate per-instance resource limits
 	if m.limits.MaxVcpusPerInstance > 0 && vcpus > m.limits.MaxVcpusPerInstance {
 		return nil, fmt.Errorf("vcpus %d exceeds maximum allowed %d per instance", vcpus, m.limits.MaxVcpusPerInstance)
 	}
+	topologyWarnings, err := checkGuestTopology(vcpus, m.hostTopology, m.numaNodes)
+	if err != nil {
+		return nil, err
+	}
+	for _, warning := range topologyWarnings {
+		log.WarnContext(ctx, "guest CPU topology does not follow the host's", "name", req.Name, "vcpus", vcpus, "reason", warning)
+	}
 	totalMemory := size + hotplugSize
 	if m.limits.MaxMemoryPerInstance > 0 && totalMemory > m.limits.MaxMemoryPerInstance {
 		return nil, fmt.Errorf("total memory %d (size + hotplug_size) exceeds maximum allowed %d per instance", totalMemory, m.limits.MaxMemoryPerInstance)
 	}
 
 	// Validate aggregate resource limits
-	if m.limits.MaxTotalVcpus > 0 || m.limits.MaxTotalMemory > 0 {
-		usage, err := m.calculateAggregateUsage(ctx)
-		if err != nil {
-			log.WarnContext(ctx, "failed to calculate aggregate usage, skipping limit check", "error", err)
-		} else {
-			if m.limits.MaxTotalVcpus > 0 && usage.TotalVcpus+vcpus > m.limits.MaxTotalVcpus {
-				return nil, fmt.Errorf("total vcpus would be %d, exceeds aggregate limit of %d", usage.TotalVcpus+vcpus, m.limits.MaxTotalVcpus)
-			}
-			if m.limits.MaxTotalMemory > 0 && usage.TotalMemory+totalMemory > m.limits.MaxTotalMemory {
-				return nil, fmt.Errorf("total memory would be %d, exceeds aggregate limit of %d", usage.TotalMemory+totalMemory, m.limits.MaxTotalMemory)
-			}
-		}
+	if err := m.checkAggregateLimits(ctx, vcpus, totalMemory); err != nil {
+		return nil, err
 	}
 
 	if req.Env == nil {
@@ -199,8 +261,22 @@ func (m *manager) createInstance(
 		networkName = "default"
 	}
 
-	// 8. Get default kernel version
+	// 8. Resolve kernel version: the requested one, downloaded on first use, or the default
 	kernelVer := m.systemManager.GetDefaultKernelVersion()
+	if req.KernelVersion != "" {
+		kernelVer = system.KernelVersion(req.KernelVersion)
+		if _, err := m.systemManager.EnsureKernel(kernelVer); err != nil {
+			log.ErrorContext(ctx, "failed to ensure kernel", "kernel_version", kernelVer, "error", err)
+			if errors.Is(err, system.ErrUnsupportedVersion) {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidKernel, err)
+			}
+			return nil, fmt.Errorf("ensure kernel %s: %w", kernelVer, err)
+		}
+	}
+	// The initrd only carries NVIDIA modules built for the default kernel
+	if len(req.Devices) > 0 && kernelVer != m.systemManager.GetDefaultKernelVersion() {
+		return nil, fmt.Errorf("%w: device passthrough requires the default kernel %s", ErrInvalidKernel, m.systemManager.GetDefaultKernelVersion())
+	}
 
 	// 9. Get process manager for hypervisor type (needed for socket name)
 	hvType := req.Hypervisor
@@ -224,6 +300,20 @@ func (m *manager) createInstance(
 		return nil, fmt.Errorf("get vm starter for %s: %w", hvType, err)
 	}
 
+	// Reject device passthrough up front for hypervisors that cannot do it
+	if caps, ok := hypervisor.CapabilitiesForType(hvType); ok && len(req.Devices) > 0 && !caps.SupportsGPUPassthrough {
+		log.ErrorContext(ctx, "hypervisor does not support device passthrough", "devices", req.Devices)
+		return nil, fmt.Errorf("hypervisor %s does not support device passthrough", hvType)
+	}
+	if caps, ok := hypervisor.CapabilitiesForType(hvType); ok && req.OverlayFormat == OverlayFormatQcow2 && !caps.SupportsQcow2 {
+		log.ErrorContext(ctx, "hypervisor does not support qcow2 disks", "hypervisor", hvType)
+		return nil, fmt.Errorf("hypervisor %s does not support qcow2 overlay disks", hvType)
+	}
+	if _, err := assignVolumeDevices(req.Volumes, maxDisks(hvType)); err != nil {
+		log.ErrorContext(ctx, "too many volumes for hypervisor", "error", err)
+		return nil, err
+	}
+
 	// Get hypervisor version
 	hvVersion, err := starter.GetVersion(m.paths)
 	if err != nil {
@@ -237,6 +327,7 @@ func (m *manager) createInstance(
 	// whatever devices have been attached when cleanup runs.
 	var attachedDeviceIDs []string
 	var resolvedDeviceIDs []string
+	var resolvedDevices []*devices.Device
 
 	// Setup cleanup stack early so device attachment errors trigger cleanup
 	cu := cleanup.Make(func() {
@@ -257,15 +348,13 @@ func (m *manager) createInstance(
 
 	if len(req.Devices) > 0 && m.deviceManager != nil {
 		for _, deviceRef := range req.Devices {
-			device, err := m.deviceManager.GetDevice(ctx, deviceRef)
+			// Resolve the device (or pick one from a pool) and mark it attached to this instance
+			device, err := m.acquireDevice(ctx, deviceRef, id)
 			if err != nil {
-				log.ErrorContext(ctx, "failed to get device", "device", deviceRef, "error", err)
-				return nil, fmt.Errorf("device %s: %w", deviceRef, err)
-			}
-			if device.AttachedTo != nil {
-				log.ErrorContext(ctx, "device already attached", "device", deviceRef, "instance", *device.AttachedTo)
-				return nil, fmt.Errorf("device %s is already attached to instance %s", deviceRef, *device.AttachedTo)
+				log.ErrorContext(ctx, "failed to acquire device", "device", deviceRef, "error", err)
+				return nil, err
 			}
+			attachedDeviceIDs = append(attachedDeviceIDs, device.Id)
 			// Auto-bind to VFIO if not already bound
 			if !device.BoundToVFIO {
 				log.InfoContext(ctx, "auto-binding device to VFIO", "device", deviceRef, "pci_address", device.PCIAddress)
@@ -274,18 +363,18 @@ func (m *manager) createInstance(
 					return nil, fmt.Errorf("bind device %s to VFIO: %w", deviceRef, err)
 				}
 			}
-			// Mark device as attached to this instance
-			if err := m.deviceManager.MarkAttached(ctx, device.Id, id); err != nil {
-				log.ErrorContext(ctx, "failed to mark device as attached", "device", deviceRef, "error", err)
-				return nil, fmt.Errorf("mark device %s as attached: %w", deviceRef, err)
-			}
-			attachedDeviceIDs = append(attachedDeviceIDs, device.Id)
 			resolvedDeviceIDs = append(resolvedDeviceIDs, device.Id)
+			resolvedDevices = append(resolvedDevices, device)
 		}
 		log.DebugContext(ctx, "validated devices for passthrough", "id", id, "devices", resolvedDeviceIDs)
 	}
 
-	// 11. Create instance metadata
+	// 11. Create instance metadata. Discard is on by default only for qcow2,
+	// which is chosen to save host disk; for raw it costs write throughput.
+	discard := req.OverlayFormat == OverlayFormatQcow2
+	if req.Discard != nil {
+		discard = *req.Discard
+	}
 	stored := &StoredMetadata{
 		Id:                       id,
 		Name:                     req.Name,
@@ -293,16 +382,24 @@ func (m *manager) createInstance(
 		Size:                     size,
 		HotplugSize:              hotplugSize,
 		OverlaySize:              overlaySize,
+		OverlayFormat:            req.OverlayFormat,
+		Discard:                  discard,
 		Vcpus:                    vcpus,
 		NetworkBandwidthDownload: req.NetworkBandwidthDownload, // Will be set by caller if using resource manager
 		NetworkBandwidthUpload:   req.NetworkBandwidthUpload,   // Will be set by caller if using resource manager
 		DiskIOBps:                req.DiskIOBps,                // Will be set by caller if using resource manager
 		Env:                      req.Env,
 		NetworkEnabled:           req.NetworkEnabled,
+		MTU:                      req.MTU,
+		DNSServers:               req.DNSServers,
+		SearchDomains:            req.SearchDomains,
 		CreatedAt:                time.Now(),
 		StartedAt:                nil,
 		StoppedAt:                nil,
 		KernelVersion:            string(kernelVer),
+		KernelModules:            req.KernelModules,
+		InitMode:                 req.InitMode,
+		Systemd:                  req.Systemd,
 		HypervisorType:           hvType,
 		HypervisorVersion:        hvVersion,
 		SocketPath:               m.paths.InstanceSocket(id, starter.SocketName()),
@@ -310,6 +407,13 @@ func (m *manager) createInstance(
 		VsockCID:                 vsockCID,
 		VsockSocket:              vsockSocket,
 		Devices:                  resolvedDeviceIDs,
+		NUMANode:                 m.selectNUMANode(ctx, resolvedDevices, vcpus),
+		LogRetention:             req.LogRetention,
+		IdleTimeout:              req.IdleTimeout,
+		IdleAction:               req.IdleAction,
+	}
+	if from != nil {
+		stored.ParentID = from.parentID
 	}
 
 	// 12. Ensure directories
@@ -319,11 +423,19 @@ func (m *manager) createInstance(
 		return nil, fmt.Errorf("ensure directories: %w", err)
 	}
 
-	// 13. Create overlay disk with specified size
-	log.DebugContext(ctx, "creating overlay disk", "instance_id", id, "size_bytes", stored.OverlaySize)
-	if err := m.createOverlayDisk(id, stored.OverlaySize); err != nil {
-		log.ErrorContext(ctx, "failed to create overlay disk", "instance_id", id, "error", err)
-		return nil, fmt.Errorf("create overlay disk: %w", err)
+	// 13. Create overlay disk with specified size, or take over the parent's copy
+	if from != nil {
+		log.DebugContext(ctx, "using cloned overlay disk", "instance_id", id, "parent_id", from.parentID)
+		if err := os.Rename(from.overlay, m.overlayPath(id, stored.OverlayFormat)); err != nil {
+			log.ErrorContext(ctx, "failed to move cloned overlay disk", "instance_id", id, "error", err)
+			return nil, fmt.Errorf("move cloned overlay disk: %w", err)
+		}
+	} else {
+		log.DebugContext(ctx, "creating overlay disk", "instance_id", id, "size_bytes", stored.OverlaySize, "format", stored.OverlayFormat)
+		if err := m.createOverlayDisk(id, stored.OverlaySize, stored.OverlayFormat); err != nil {
+			log.ErrorContext(ctx, "failed to create overlay disk", "instance_id", id, "error", err)
+			return nil, fmt.Errorf("create overlay disk: %w", err)
+		}
 	}
 
 	// 14. Allocate network (if network enabled)
@@ -337,6 +449,7 @@ func (m *manager) createInstance(
 			DownloadBps:   stored.NetworkBandwidthDownload,
 			UploadBps:     stored.NetworkBandwidthUpload,
 			UploadCeilBps: stored.NetworkBandwidthUpload * int64(m.networkManager.GetUploadBurstMultiplier()),
+			MTU:           stored.MTU,
 		})
 		if err != nil {
 			log.ErrorContext(ctx, "failed to allocate network", "instance_id", id, "network", networkName, "error", err)
@@ -410,6 +523,7 @@ func (m *manager) createInstance(
 		log.ErrorContext(ctx, "failed to save metadata", "instance_id", id, "error", err)
 		return nil, fmt.Errorf("save metadata: %w", err)
 	}
+	releaseGC()
 
 	// 18. Start VMM and boot VM
 	log.InfoContext(ctx, "starting VMM and booting VM", "instance_id", id)
@@ -417,16 +531,22 @@ func (m *manager) createInstance(
 		log.ErrorContext(ctx, "failed to start and boot VM", "instance_id", id, "error", err)
 		return nil, err
 	}
+	// Kill the VMM if anything fails from here on. The cleanup stack runs in
+	// reverse, so it is gone before its TAP device and disks are removed.
+	cu.Add(func() {
+		m.killHypervisor(ctx, &Instance{StoredMetadata: *stored})
+	})
 
-	// 19. Update timestamp after VM is running
+	// 19. Record the PID and start time. Without them in the metadata the
+	// VMM couldn't be found to stop or delete the instance, so failing to
+	// save them fails the create.
 	now := time.Now()
 	stored.StartedAt = &now
 
 	meta = &metadata{StoredMetadata: *stored}
 	if err := m.saveMetadata(meta); err != nil {
-		// VM is running but metadata failed - log but don't fail
-		// Instance is recoverable, state will be derived
-		log.WarnContext(ctx, "failed to update metadata after VM start", "instance_id", id, "error", err)
+		log.ErrorContext(ctx, "failed to save metadata after VM start", "instance_id", id, "error", err)
+		return nil, fmt.Errorf("save metadata: %w", err)
 	}
 
 	// Success - release cleanup stack (prevent cleanup)
@@ -437,6 +557,7 @@ func (m *manager) createInstance(
 		m.recordDuration(ctx, m.metrics.createDuration, start, "success", hvType)
 		m.recordStateTransition(ctx, "stopped", string(StateRunning), hvType)
 	}
+	m.publishEvent(EventCreated, stored, "", StateRunning)
 
 	// Return instance with derived state
 	finalInst := m.toInstance(ctx, meta)
@@ -470,30 +591,146 @@ func validateCreateRequest(req CreateInstanceRequest) error {
 	if req.OverlaySize < 0 {
 		return fmt.Errorf("overlay_size cannot be negative")
 	}
+	switch req.OverlayFormat {
+	case "", OverlayFormatRaw, OverlayFormatQcow2:
+	default:
+		return fmt.Errorf("overlay_format must be %q or %q, got %q", OverlayFormatRaw, OverlayFormatQcow2, req.OverlayFormat)
+	}
 	if req.Vcpus < 0 {
 		return fmt.Errorf("vcpus cannot be negative")
 	}
+	if req.LogRetention != nil && (req.LogRetention.MaxAge < 0 || req.LogRetention.MaxFiles < 0) {
+		return fmt.Errorf("log retention cannot be negative")
+	}
+	if req.IdleTimeout < 0 {
+		return fmt.Errorf("idle_timeout cannot be negative")
+	}
+	switch req.IdleAction {
+	case "", IdleActionStop, IdleActionStandby:
+	default:
+		return fmt.Errorf("idle_action must be %q or %q, got %q", IdleActionStop, IdleActionStandby, req.IdleAction)
+	}
+	if req.MTU != 0 && (req.MTU < network.MinMTU || req.MTU > network.MaxMTU) {
+		return fmt.Errorf("mtu must be between %d and %d", network.MinMTU, network.MaxMTU)
+	}
 
 	// Validate volume attachments
 	if err := validateVolumeAttachments(req.Volumes); err != nil {
 		return err
 	}
 
+	if err := validateDNSConfig(req); err != nil {
+		return err
+	}
+
+	if err := validateKernelModules(req.KernelModules); err != nil {
+		return err
+	}
+
+	if err := validateInitMode(req.InitMode, req.Systemd); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// validateVolumeAttachments validates volume attachment requests
-func validateVolumeAttachments(volumes []VolumeAttachment) error {
-	// Count total devices needed (each overlay volume needs 2 devices: base + overlay)
-	totalDevices := 0
-	for _, vol := range volumes {
-		totalDevices++
-		if vol.Overlay {
-			totalDevices++ // Overlay needs an additional device
+var (
+	systemdTargetPattern = regexp.MustCompile(`^[a-zA-Z0-9@_.:-]+\.target$`)
+	systemdUnitPattern   = regexp.MustCompile(`^[a-zA-Z0-9@_.:-]+\.(service|socket|target|timer|path|mount|automount|swap|slice|device)$`)
+)
+
+// validateInitMode checks the boot mode and that systemd options name units
+// rather than paths, and aren't given for an instance that won't run systemd
+func validateInitMode(mode InitMode, opts *SystemdOptions) error {
+	switch mode {
+	case "", InitModeExec, InitModeSystemd:
+	default:
+		return fmt.Errorf("init_mode must be %q or %q, got %q", InitModeExec, InitModeSystemd, mode)
+	}
+	if opts == nil {
+		return nil
+	}
+	if mode == InitModeExec {
+		return fmt.Errorf("systemd options require init_mode %q", InitModeSystemd)
+	}
+	if opts.DefaultTarget != "" && !systemdTargetPattern.MatchString(opts.DefaultTarget) {
+		return fmt.Errorf("invalid systemd default target %q: must be a unit name ending in .target", opts.DefaultTarget)
+	}
+	for _, unit := range opts.MaskUnits {
+		if !systemdUnitPattern.MatchString(unit) {
+			return fmt.Errorf("invalid systemd unit %q: must be a unit name with its type suffix", unit)
 		}
+		if unit == "hypeman-agent.service" {
+			return fmt.Errorf("cannot mask hypeman-agent.service: exec and file operations need it")
+		}
+	}
+	return nil
+}
+
+// Limits of the guest resolver: glibc reads at most 3 nameservers, and
+// older glibc releases at most 6 search domains
+const (
+	maxDNSServers    = 3
+	maxSearchDomains = 6
+)
+
+var searchDomainPattern = regexp.MustCompile(`(?i)^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*$`)
+
+// validateDNSConfig checks the guest resolver settings, which are written
+// verbatim into the guest's resolv.conf
+func validateDNSConfig(req CreateInstanceRequest) error {
+	if (len(req.DNSServers) > 0 || len(req.SearchDomains) > 0) && !req.NetworkEnabled {
+		return fmt.Errorf("dns_servers and search_domains require networking")
 	}
-	if totalDevices > MaxVolumesPerInstance {
-		return fmt.Errorf("cannot attach more than %d volume devices per instance (overlay volumes count as 2)", MaxVolumesPerInstance)
+	if len(req.DNSServers) > maxDNSServers {
+		return fmt.Errorf("cannot use more than %d DNS servers", maxDNSServers)
+	}
+	for _, server := range req.DNSServers {
+		if net.ParseIP(server) == nil {
+			return fmt.Errorf("invalid DNS server %q: must be an IP address", server)
+		}
+	}
+	if len(req.SearchDomains) > maxSearchDomains {
+		return fmt.Errorf("cannot use more than %d search domains", maxSearchDomains)
+	}
+	for _, domain := range req.SearchDomains {
+		if len(domain) > 253 || !searchDomainPattern.MatchString(domain) {
+			return fmt.Errorf("invalid search domain %q", domain)
+		}
+	}
+	return nil
+}
+
+// maxKernelModules caps the kernel modules one instance can ask for
+const maxKernelModules = 32
+
+var kernelModulePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,63}$`)
+
+// validateKernelModules checks that kernel module names are plain module
+// names, so the guest never resolves one to a path
+func validateKernelModules(modules []string) error {
+	if len(modules) > maxKernelModules {
+		return fmt.Errorf("cannot load more than %d kernel modules", maxKernelModules)
+	}
+	seen := make(map[string]bool)
+	for _, name := range modules {
+		if !kernelModulePattern.MatchString(name) {
+			return fmt.Errorf("invalid kernel module name %q: must be letters, digits, dashes and underscores", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate kernel module %q", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// validateVolumeAttachments validates volume attachment requests
+func validateVolumeAttachments(volumes []VolumeAttachment) error {
+	// Every volume disk needs a guest device slot (overlay volumes take 2).
+	// The hypervisor's own limit is checked once it is known.
+	if _, err := assignVolumeDevices(volumes, firstVolumeDisk+MaxVolumesPerInstance); err != nil {
+		return err
 	}
 
 	seenPaths := make(map[string]bool)
@@ -592,6 +829,14 @@ func (m *manager) startAndBootVM(
 	return nil
 }
 
+// diskIOLimits returns the sustained and burst rate of an instance's disks
+func diskIOLimits(ioBps int64) (int64, int64) {
+	if ioBps <= 0 {
+		return 0, 0
+	}
+	return ioBps, ioBps * 4 // Burst is 4x sustained
+}
+
 // buildHypervisorConfig creates a hypervisor-agnostic VM configuration
 func (m *manager) buildHypervisorConfig(ctx context.Context, inst *Instance, imageInfo *images.Image, netConfig *network.NetworkConfig) (hypervisor.VMConfig, error) {
 	// Get system file paths
@@ -606,23 +851,24 @@ func (m *manager) buildHypervisorConfig(ctx context.Context, inst *Instance, ima
 	}
 
 	// Get disk I/O limits (same for all disks in this VM)
-	ioBps := inst.DiskIOBps
-	burstBps := ioBps * 4 // Burst is 4x sustained
-	if ioBps <= 0 {
-		burstBps = 0
-	}
+	ioBps, burstBps := diskIOLimits(inst.DiskIOBps)
 
 	disks := []hypervisor.DiskConfig{
 		// Rootfs (from image, read-only)
 		{Path: rootfsPath, Readonly: true, IOBps: ioBps, IOBurstBps: burstBps},
 		// Overlay disk (writable)
-		{Path: m.paths.InstanceOverlay(inst.Id), Readonly: false, IOBps: ioBps, IOBurstBps: burstBps},
+		{Path: m.overlayPath(inst.Id, inst.OverlayFormat), Readonly: false, IOBps: ioBps, IOBurstBps: burstBps, Format: hypervisor.DiskFormat(inst.OverlayFormat), Discard: inst.Discard},
 		// Config disk (read-only)
 		{Path: m.paths.InstanceConfigDisk(inst.Id), Readonly: true, IOBps: ioBps, IOBurstBps: burstBps},
 	}
 
-	// Add attached volumes as additional disks
-	for _, volAttach := range inst.Volumes {
+	// Add attached volumes as additional disks, in the order their guest
+	// devices were assigned
+	volDevices, err := assignVolumeDevices(inst.Volumes, maxDisks(inst.HypervisorType))
+	if err != nil {
+		return hypervisor.VMConfig{}, err
+	}
+	for i, volAttach := range inst.Volumes {
 		volumePath := m.volumeManager.GetVolumePath(volAttach.VolumeID)
 		if volAttach.Overlay {
 			// Base volume is always read-only when overlay is enabled
@@ -631,6 +877,7 @@ func (m *manager) buildHypervisorConfig(ctx context.Context, inst *Instance, ima
 				Readonly:   true,
 				IOBps:      ioBps,
 				IOBurstBps: burstBps,
+				Serial:     volDevices[i].Serial,
 			})
 			// Overlay disk is writable
 			overlayPath := m.paths.InstanceVolumeOverlay(inst.Id, volAttach.VolumeID)
@@ -639,6 +886,8 @@ func (m *manager) buildHypervisorConfig(ctx context.Context, inst *Instance, ima
 				Readonly:   false,
 				IOBps:      ioBps,
 				IOBurstBps: burstBps,
+				Serial:     volDevices[i].OverlaySerial,
+				Discard:    inst.Discard,
 			})
 		} else {
 			disks = append(disks, hypervisor.DiskConfig{
@@ -646,6 +895,8 @@ func (m *manager) buildHypervisorConfig(ctx context.Context, inst *Instance, ima
 				Readonly:   volAttach.Readonly,
 				IOBps:      ioBps,
 				IOBurstBps: burstBps,
+				Serial:     volDevices[i].Serial,
+				Discard:    inst.Discard && !volAttach.Readonly,
 			})
 		}
 	}
@@ -691,20 +942,39 @@ func (m *manager) buildHypervisorConfig(ctx context.Context, inst *Instance, ima
 		}
 	}
 
+	// Bind to the NUMA node chosen at creation for device locality
+	log := logger.FromContext(ctx)
+	var cpuAffinity []int
+	numaNode := inst.NUMANode
+	if numaNode != nil {
+		cpuAffinity = m.numaNodes[*numaNode]
+		if len(cpuAffinity) == 0 {
+			log.WarnContext(ctx, "NUMA node no longer present on host, starting unbound", "instance_id", inst.Id, "numa_node", *numaNode)
+			numaNode = nil
+		} else if inst.HypervisorType == hypervisor.TypeCloudHypervisor && inst.HotplugSize > 0 {
+			log.WarnContext(ctx, "memory hotplug enabled, guest memory not bound to NUMA node (vCPUs still pinned)", "instance_id", inst.Id, "numa_node", *numaNode)
+		}
+	}
+
+	serialLog, consoleLog := m.consoleLogPaths(inst.Id, inst.HypervisorType)
+
 	return hypervisor.VMConfig{
-		VCPUs:         inst.Vcpus,
-		MemoryBytes:   inst.Size,
-		HotplugBytes:  inst.HotplugSize,
-		Topology:      topology,
-		Disks:         disks,
-		Networks:      networks,
-		SerialLogPath: m.paths.InstanceAppLog(inst.Id),
-		VsockCID:      inst.VsockCID,
-		VsockSocket:   inst.VsockSocket,
-		PCIDevices:    pciDevices,
-		KernelPath:    kernelPath,
-		InitrdPath:    initrdPath,
-		KernelArgs:    "console=ttyS0",
+		VCPUs:          inst.Vcpus,
+		MemoryBytes:    inst.Size,
+		HotplugBytes:   inst.HotplugSize,
+		Topology:       topology,
+		NUMANode:       numaNode,
+		CPUAffinity:    cpuAffinity,
+		Disks:          disks,
+		Networks:       networks,
+		SerialLogPath:  serialLog,
+		ConsoleLogPath: consoleLog,
+		VsockCID:       inst.VsockCID,
+		VsockSocket:    inst.VsockSocket,
+		PCIDevices:     pciDevices,
+		KernelPath:     kernelPath,
+		InitrdPath:     initrdPath,
+		KernelArgs:     m.console.kernelArgs(),
 	}, nil
 }
 
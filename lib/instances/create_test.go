@@ -0,0 +1,194 @@
+package instances
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/hypervisor"
+	"github.com/onkernel/hypeman/lib/hypervisor/cloudhypervisor"
+	"github.com/onkernel/hypeman/lib/images"
+	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/onkernel/hypeman/lib/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readyImageManager reports every image as pulled and ready
+type readyImageManager struct {
+	images.Manager
+}
+
+func (readyImageManager) GetImage(ctx context.Context, name string) (*images.Image, error) {
+	return &images.Image{Name: name, Digest: "sha256:0123456789abcdef", Status: images.StatusReady}, nil
+}
+
+func (readyImageManager) BlockGC() func() {
+	return func() {}
+}
+
+// stubHypervisor is the client returned for a stand-in VMM process
+type stubHypervisor struct {
+	hypervisor.Hypervisor
+}
+
+func (stubHypervisor) Capabilities() hypervisor.Capabilities {
+	return hypervisor.Capabilities{}
+}
+
+// metadataFailingStarter starts a stand-in process for the VMM, then makes
+// the next metadata save of the instance fail, like a full disk would
+type metadataFailingStarter struct {
+	hypervisor.VMStarter
+	paths *paths.Paths
+	pid   int
+}
+
+func (s *metadataFailingStarter) StartVM(ctx context.Context, p *paths.Paths, version string, socketPath string, config hypervisor.VMConfig) (int, hypervisor.Hypervisor, error) {
+	cmd := exec.Command("sleep", "60")
+	if err := cmd.Start(); err != nil {
+		return 0, nil, err
+	}
+	s.pid = cmd.Process.Pid
+
+	// A directory in the way of the temp file fails the write
+	id := filepath.Base(filepath.Dir(socketPath))
+	if err := os.Mkdir(s.paths.InstanceMetadata(id)+".tmp", 0755); err != nil {
+		return 0, nil, err
+	}
+	return s.pid, stubHypervisor{}, nil
+}
+
+func TestCreateInstance_MetadataFailureAfterStart(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	starter := &metadataFailingStarter{VMStarter: cloudhypervisor.NewStarter(), paths: mgr.paths}
+	mgr.imageManager = readyImageManager{}
+	mgr.vmStarters[hypervisor.TypeCloudHypervisor] = starter
+
+	_, err := mgr.CreateInstance(ctx, CreateInstanceRequest{
+		Name:        "orphan-check",
+		Image:       "docker.io/library/alpine:latest",
+		OverlaySize: 64 * 1024 * 1024,
+	})
+	require.Error(t, err)
+	require.NotZero(t, starter.pid, "VMM should have been started")
+
+	// The VMM is killed and everything the create made is gone
+	assert.True(t, WaitForProcessExit(starter.pid, 5*time.Second), "VMM process left running")
+	entries, err := os.ReadDir(mgr.paths.GuestsDir())
+	if !os.IsNotExist(err) {
+		require.NoError(t, err)
+		assert.Empty(t, entries, "instance directory (overlay, config disk) left behind")
+	}
+	insts, err := mgr.ListInstances(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, insts)
+}
+
+func TestCreateInstance_UnknownKernel(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	mgr.imageManager = readyImageManager{}
+
+	_, err := mgr.CreateInstance(context.Background(), CreateInstanceRequest{
+		Name:          "kernel-check",
+		Image:         "docker.io/library/alpine:latest",
+		KernelVersion: "ch-6.1.0-nope",
+	})
+	require.ErrorIs(t, err, ErrInvalidKernel)
+	assert.Contains(t, err.Error(), string(system.DefaultKernelVersion), "error should list available versions")
+}
+
+func TestValidateKernelModules(t *testing.T) {
+	assert.NoError(t, validateKernelModules([]string{"fuse", "nbd", "nf_tables", "wireguard"}))
+
+	for _, modules := range [][]string{
+		{"../fuse"},
+		{"fuse.ko"},
+		{"-fuse"},
+		{""},
+		{"fuse", "fuse"},
+	} {
+		assert.Error(t, validateKernelModules(modules), "%q", modules)
+	}
+}
+
+func TestValidateCreateRequest_MTU(t *testing.T) {
+	for mtu, valid := range map[int]bool{0: true, 576: true, 1500: true, 9000: true, 575: false, 9001: false, -1: false} {
+		err := validateCreateRequest(CreateInstanceRequest{Name: "web", Image: "nginx:latest", MTU: mtu})
+		assert.Equal(t, valid, err == nil, "mtu %d: %v", mtu, err)
+	}
+}
+
+func TestValidateDNSConfig(t *testing.T) {
+	valid := CreateInstanceRequest{
+		NetworkEnabled: true,
+		DNSServers:     []string{"10.0.0.2", "fd00::53"},
+		SearchDomains:  []string{"svc.internal", "hypeman.internal", "Corp.Example.com"},
+	}
+	assert.NoError(t, validateDNSConfig(valid))
+	assert.NoError(t, validateDNSConfig(CreateInstanceRequest{}))
+
+	for name, req := range map[string]CreateInstanceRequest{
+		"no network":      {DNSServers: []string{"10.0.0.2"}},
+		"hostname server": {NetworkEnabled: true, DNSServers: []string{"dns.google"}},
+		"too many":        {NetworkEnabled: true, DNSServers: []string{"1.1.1.1", "1.0.0.1", "8.8.8.8", "8.8.4.4"}},
+		"newline":         {NetworkEnabled: true, SearchDomains: []string{"svc.internal\nnameserver 6.6.6.6"}},
+		"leading dot":     {NetworkEnabled: true, SearchDomains: []string{".internal"}},
+	} {
+		assert.Error(t, validateDNSConfig(req), name)
+	}
+}
+
+func TestValidateInitMode(t *testing.T) {
+	assert.NoError(t, validateInitMode("", nil))
+	assert.NoError(t, validateInitMode(InitModeSystemd, &SystemdOptions{
+		DefaultTarget: "multi-user.target",
+		MaskUnits:     []string{"getty@tty1.service", "systemd-resolved.service"},
+	}))
+	// Options are allowed when the mode is detected from the image
+	assert.NoError(t, validateInitMode("", &SystemdOptions{DefaultTarget: "rescue.target"}))
+
+	assert.Error(t, validateInitMode("upstart", nil))
+	assert.Error(t, validateInitMode(InitModeExec, &SystemdOptions{DefaultTarget: "multi-user.target"}))
+	assert.Error(t, validateInitMode(InitModeSystemd, &SystemdOptions{DefaultTarget: "nginx.service"}))
+	assert.Error(t, validateInitMode(InitModeSystemd, &SystemdOptions{MaskUnits: []string{"../../bin/sh.service"}}))
+	assert.Error(t, validateInitMode(InitModeSystemd, &SystemdOptions{MaskUnits: []string{"hypeman-agent.service"}}))
+}
+
+func TestBuildGuestConfig_InitMode(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+	nginx := &images.Image{Cmd: []string{"nginx", "-g", "daemon off;"}}
+	systemd := &images.Image{Cmd: []string{"/sbin/init"}}
+	opts := &SystemdOptions{DefaultTarget: "multi-user.target", MaskUnits: []string{"getty@tty1.service"}}
+
+	// Detected from the image; systemd options only apply in systemd mode
+	cfg, err := mgr.buildGuestConfig(ctx, &Instance{StoredMetadata: StoredMetadata{Systemd: opts}}, nginx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "exec", cfg.InitMode)
+	assert.Empty(t, cfg.SystemdTarget)
+
+	cfg, err = mgr.buildGuestConfig(ctx, &Instance{}, systemd, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "systemd", cfg.InitMode)
+
+	// Forced systemd boots /sbin/init in place of the image's command
+	inst := &Instance{StoredMetadata: StoredMetadata{InitMode: InitModeSystemd, Systemd: opts}}
+	cfg, err = mgr.buildGuestConfig(ctx, inst, nginx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "systemd", cfg.InitMode)
+	assert.Equal(t, []string{"/sbin/init"}, append(cfg.Entrypoint, cfg.Cmd...))
+	assert.Equal(t, "multi-user.target", cfg.SystemdTarget)
+	assert.Equal(t, []string{"getty@tty1.service"}, cfg.SystemdMaskUnits)
+
+	// Forced exec runs a systemd image's command as a plain process
+	inst = &Instance{StoredMetadata: StoredMetadata{InitMode: InitModeExec}}
+	cfg, err = mgr.buildGuestConfig(ctx, inst, systemd, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "exec", cfg.InitMode)
+}
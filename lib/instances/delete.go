@@ -2,8 +2,11 @@ package instances
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
 
@@ -11,12 +14,15 @@ import (
 	"github.com/onkernel/hypeman/lib/hypervisor"
 	"github.com/onkernel/hypeman/lib/logger"
 	"github.com/onkernel/hypeman/lib/network"
+	"github.com/onkernel/hypeman/lib/volumes"
 )
 
-// deleteInstance stops and deletes an instance
+// deleteInstance stops and deletes an instance. With preserveDir set, the
+// instance directory is moved there instead of being removed.
 func (m *manager) deleteInstance(
 	ctx context.Context,
 	id string,
+	preserveDir string,
 ) error {
 	log := logger.FromContext(ctx)
 	log.InfoContext(ctx, "deleting instance", "instance_id", id)
@@ -95,16 +101,146 @@ func (m *manager) deleteInstance(
 	}
 
 	// 7. Delete all instance data
-	log.DebugContext(ctx, "deleting instance data", "instance_id", id)
+	if preserveDir != "" {
+		log.DebugContext(ctx, "moving instance data to preserved", "instance_id", id, "dir", preserveDir)
+		if err := os.Rename(m.paths.InstanceDir(id), preserveDir); err != nil {
+			log.ErrorContext(ctx, "failed to preserve instance data", "instance_id", id, "error", err)
+			return fmt.Errorf("preserve instance data: %w", err)
+		}
+		m.unindexReferences(id)
+	} else {
+		log.DebugContext(ctx, "deleting instance data", "instance_id", id)
+		if err := m.deleteInstanceData(id); err != nil {
+			log.ErrorContext(ctx, "failed to delete instance data", "instance_id", id, "error", err)
+			return fmt.Errorf("delete instance data: %w", err)
+		}
+	}
+
+	m.publishEvent(EventDeleted, &inst.StoredMetadata, inst.State, "")
+	log.InfoContext(ctx, "instance deleted successfully", "instance_id", id)
+	return nil
+}
+
+// forceDeleteInstance deletes an instance without talking to its VMM. The VMM
+// is killed by the PID in metadata, and the TAP device, devices and volumes
+// are found through their own managers, so cleanup also works when the
+// metadata is gone or unreadable. The data directory is removed last, and
+// only once everything else is released, so re-running after a failure
+// finishes the job.
+func (m *manager) forceDeleteInstance(ctx context.Context, id string) error {
+	log := logger.FromContext(ctx)
+	log.InfoContext(ctx, "force deleting instance", "instance_id", id)
+
+	// 1. Load whatever metadata is left
+	instDir := m.paths.InstanceDir(id)
+	stored := &StoredMetadata{Id: id, DataDir: instDir}
+	meta, err := m.loadMetadata(id)
+	switch {
+	case err == nil:
+		stored = &meta.StoredMetadata
+	case errors.Is(err, ErrNotFound):
+		if _, statErr := os.Stat(instDir); os.IsNotExist(statErr) {
+			return ErrNotFound
+		}
+		log.WarnContext(ctx, "instance metadata missing, cleaning up without it", "instance_id", id)
+	default:
+		log.WarnContext(ctx, "failed to load instance metadata, cleaning up without it", "instance_id", id, "error", err)
+	}
+
+	// 2. Kill the VMM by PID. The PID is only trusted while it still belongs
+	// to this instance's VMM, as it may have been reused since.
+	if dialer, err := hypervisor.NewVsockDialer(stored.HypervisorType, stored.VsockSocket, stored.VsockCID); err == nil {
+		guest.CloseConn(dialer.Key())
+	}
+	inst := &Instance{StoredMetadata: *stored}
+	if inst.HypervisorPID != nil && !isVMMProcessOf(*inst.HypervisorPID, instDir) {
+		log.DebugContext(ctx, "hypervisor PID no longer belongs to instance", "instance_id", id, "pid", *inst.HypervisorPID)
+		inst.HypervisorPID = nil
+	}
+	m.killHypervisor(ctx, inst)
+
+	var errs []error
+
+	// 3. Delete the TAP device, unless another instance's TAP has the same name
+	if stored.NetworkEnabled || meta == nil {
+		tap := network.TAPName(id)
+		allocs, err := m.networkManager.ListAllocations(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("list network allocations: %w", err))
+		} else if slices.ContainsFunc(allocs, func(a network.Allocation) bool { return a.TAPDevice == tap && a.InstanceID != id }) {
+			log.WarnContext(ctx, "TAP device name is shared with another instance, leaving it", "instance_id", id, "tap", tap)
+		} else if err := m.networkManager.ReleaseAllocation(ctx, &network.Allocation{InstanceID: id, TAPDevice: tap}); err != nil {
+			errs = append(errs, fmt.Errorf("release network: %w", err))
+		}
+	}
+
+	// 4. Detach and auto-unbind devices still attached to the instance
+	if m.deviceManager != nil {
+		devs, err := m.deviceManager.ListDevices(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("list devices: %w", err))
+		}
+		for _, dev := range devs {
+			attached := dev.AttachedTo != nil && *dev.AttachedTo == id
+			if !attached && !slices.Contains(stored.Devices, dev.Id) {
+				continue
+			}
+			if attached {
+				if err := m.deviceManager.MarkDetached(ctx, dev.Id); err != nil {
+					errs = append(errs, fmt.Errorf("detach device %s: %w", dev.Id, err))
+				}
+			}
+			if dev.BoundToVFIO {
+				if err := m.deviceManager.UnbindFromVFIO(ctx, dev.Id); err != nil {
+					// Same as a regular delete: the native driver may reclaim it later
+					log.WarnContext(ctx, "failed to unbind device from VFIO", "instance_id", id, "device", dev.Id, "error", err)
+				}
+			}
+		}
+	}
+
+	// 5. Detach volumes still attached to the instance
+	vols, err := m.volumeManager.ListVolumes(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("list volumes: %w", err))
+	}
+	for _, vol := range vols {
+		if !slices.ContainsFunc(vol.Attachments, func(a volumes.Attachment) bool { return a.InstanceID == id }) {
+			continue
+		}
+		if err := m.volumeManager.DetachVolume(ctx, vol.Id, id); err != nil {
+			errs = append(errs, fmt.Errorf("detach volume %s: %w", vol.Id, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		err := errors.Join(errs...)
+		log.ErrorContext(ctx, "force delete left resources behind, keeping instance data for a retry", "instance_id", id, "error", err)
+		return fmt.Errorf("release instance resources: %w", err)
+	}
+
+	// 6. Delete all instance data
 	if err := m.deleteInstanceData(id); err != nil {
 		log.ErrorContext(ctx, "failed to delete instance data", "instance_id", id, "error", err)
 		return fmt.Errorf("delete instance data: %w", err)
 	}
 
-	log.InfoContext(ctx, "instance deleted successfully", "instance_id", id)
+	m.publishEvent(EventDeleted, stored, "", "")
+	log.InfoContext(ctx, "instance force deleted", "instance_id", id)
 	return nil
 }
 
+// isVMMProcessOf reports whether pid is a VMM serving the instance in
+// instDir. Every hypervisor is started with its API socket inside the
+// instance directory, so the path appears on its command line.
+func isVMMProcessOf(pid int, instDir string) bool {
+	cmdline, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil {
+		return false
+	}
+	return strings.Contains(string(cmdline), instDir+"/")
+}
+
 // killHypervisor force kills the hypervisor process without graceful shutdown
 // Used only for delete operations where we're removing all data anyway.
 // For operations that need graceful shutdown (like standby), use the hypervisor API directly.
@@ -0,0 +1,196 @@
+package instances
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/onkernel/hypeman/lib/devices"
+	"github.com/onkernel/hypeman/lib/logger"
+	"gvisor.dev/gvisor/pkg/cleanup"
+)
+
+// attachDevice hotplugs a passthrough device into a running instance
+func (m *manager) attachDevice(ctx context.Context, id string, deviceRef string) (*Instance, error) {
+	log := logger.FromContext(ctx)
+	log.InfoContext(ctx, "attaching device", "instance_id", id, "device", deviceRef)
+
+	if m.deviceManager == nil {
+		return nil, fmt.Errorf("device management is not enabled")
+	}
+
+	// 1. Load instance
+	meta, err := m.loadMetadata(id)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to load instance metadata", "instance_id", id, "error", err)
+		return nil, err
+	}
+
+	inst := m.toInstance(ctx, meta)
+	stored := &meta.StoredMetadata
+
+	// 2. Validate state (must be Running to hotplug)
+	if inst.State != StateRunning {
+		log.ErrorContext(ctx, "invalid state for device attach", "instance_id", id, "state", inst.State)
+		return nil, fmt.Errorf("%w: cannot attach device in state %s, must be Running", ErrInvalidState, inst.State)
+	}
+
+	// 3. Check the hypervisor can hotplug devices
+	hv, err := m.getHypervisor(inst.SocketPath, stored.HypervisorType)
+	if err != nil {
+		return nil, fmt.Errorf("create hypervisor client: %w", err)
+	}
+	if !hv.Capabilities().SupportsHotplugDevice {
+		return nil, fmt.Errorf("%w: %s does not support device hotplug", ErrNotSupported, stored.HypervisorType)
+	}
+
+	cu := cleanup.Make(func() {})
+	defer cu.Clean()
+
+	// 4. Resolve the device (or pick one from a pool) and mark it attached
+	device, err := m.acquireDevice(ctx, deviceRef, id)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to acquire device", "device", deviceRef, "error", err)
+		return nil, err
+	}
+
+	// Release in one step: the device must be detached before it can be unbound
+	boundHere := false
+	cu.Add(func() {
+		log.DebugContext(ctx, "detaching device on cleanup", "instance_id", id, "device", device.Id)
+		m.deviceManager.MarkDetached(ctx, device.Id)
+		if boundHere {
+			m.deviceManager.UnbindFromVFIO(ctx, device.Id)
+		}
+	})
+
+	// 5. Auto-bind to VFIO if not already bound
+	if !device.BoundToVFIO {
+		log.InfoContext(ctx, "auto-binding device to VFIO", "device", deviceRef, "pci_address", device.PCIAddress)
+		if err := m.deviceManager.BindToVFIO(ctx, device.Id); err != nil {
+			log.ErrorContext(ctx, "failed to bind device to VFIO", "device", deviceRef, "error", err)
+			return nil, fmt.Errorf("bind device %s to VFIO: %w", deviceRef, err)
+		}
+		boundHere = true
+	}
+
+	// 6. Hotplug into the guest
+	sysfsPath := devices.GetDeviceSysfsPath(device.PCIAddress)
+	if err := hv.AddPCIDevice(ctx, sysfsPath); err != nil {
+		log.ErrorContext(ctx, "failed to hotplug device", "instance_id", id, "device", deviceRef, "error", err)
+		return nil, fmt.Errorf("hotplug device %s: %w", deviceRef, err)
+	}
+	cu.Add(func() {
+		log.DebugContext(ctx, "removing hotplugged device on cleanup", "instance_id", id, "device", device.Id)
+		hv.RemovePCIDevice(ctx, sysfsPath)
+	})
+
+	// 7. Persist
+	stored.Devices = append(stored.Devices, device.Id)
+	if err := m.saveMetadata(&metadata{StoredMetadata: *stored}); err != nil {
+		log.ErrorContext(ctx, "failed to save metadata", "instance_id", id, "error", err)
+		return nil, fmt.Errorf("save metadata: %w", err)
+	}
+
+	cu.Release()
+
+	finalInst := m.toInstance(ctx, &metadata{StoredMetadata: *stored})
+	log.InfoContext(ctx, "device attached", "instance_id", id, "device", device.Id)
+	return &finalInst, nil
+}
+
+// acquireDevice resolves deviceRef to a free device and marks it attached to instanceID.
+// deviceRef is a device ID or name, or the name of a device pool from which any
+// free member is taken.
+func (m *manager) acquireDevice(ctx context.Context, deviceRef, instanceID string) (*devices.Device, error) {
+	device, err := m.deviceManager.GetDevice(ctx, deviceRef)
+	if errors.Is(err, devices.ErrNotFound) {
+		device, err = m.deviceManager.AcquireFromPool(ctx, deviceRef, instanceID)
+		if err != nil {
+			return nil, fmt.Errorf("device %s: %w", deviceRef, err)
+		}
+		return device, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("device %s: %w", deviceRef, err)
+	}
+
+	if device.AttachedTo != nil {
+		return nil, fmt.Errorf("%w: device %s is already attached to instance %s", devices.ErrInUse, deviceRef, *device.AttachedTo)
+	}
+	if err := m.deviceManager.MarkAttached(ctx, device.Id, instanceID); err != nil {
+		return nil, fmt.Errorf("mark device %s as attached: %w", deviceRef, err)
+	}
+	return device, nil
+}
+
+// detachDevice hot-unplugs a passthrough device from a running instance
+func (m *manager) detachDevice(ctx context.Context, id string, deviceRef string) (*Instance, error) {
+	log := logger.FromContext(ctx)
+	log.InfoContext(ctx, "detaching device", "instance_id", id, "device", deviceRef)
+
+	if m.deviceManager == nil {
+		return nil, fmt.Errorf("device management is not enabled")
+	}
+
+	// 1. Load instance
+	meta, err := m.loadMetadata(id)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to load instance metadata", "instance_id", id, "error", err)
+		return nil, err
+	}
+
+	inst := m.toInstance(ctx, meta)
+	stored := &meta.StoredMetadata
+
+	// 2. Resolve device and make sure it belongs to this instance
+	device, err := m.deviceManager.GetDevice(ctx, deviceRef)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get device", "device", deviceRef, "error", err)
+		return nil, fmt.Errorf("device %s: %w", deviceRef, err)
+	}
+	idx := slices.Index(stored.Devices, device.Id)
+	if idx < 0 {
+		return nil, fmt.Errorf("%w: device %s is not attached to instance %s", devices.ErrNotFound, deviceRef, id)
+	}
+
+	// 3. Validate state (must be Running to hot-unplug)
+	if inst.State != StateRunning {
+		log.ErrorContext(ctx, "invalid state for device detach", "instance_id", id, "state", inst.State)
+		return nil, fmt.Errorf("%w: cannot detach device in state %s, must be Running", ErrInvalidState, inst.State)
+	}
+
+	hv, err := m.getHypervisor(inst.SocketPath, stored.HypervisorType)
+	if err != nil {
+		return nil, fmt.Errorf("create hypervisor client: %w", err)
+	}
+	if !hv.Capabilities().SupportsHotplugDevice {
+		return nil, fmt.Errorf("%w: %s does not support device hotplug", ErrNotSupported, stored.HypervisorType)
+	}
+
+	// 4. Remove from the guest
+	if err := hv.RemovePCIDevice(ctx, devices.GetDeviceSysfsPath(device.PCIAddress)); err != nil {
+		log.ErrorContext(ctx, "failed to hot-unplug device", "instance_id", id, "device", deviceRef, "error", err)
+		return nil, fmt.Errorf("hot-unplug device %s: %w", deviceRef, err)
+	}
+
+	// 5. Persist
+	stored.Devices = slices.Delete(stored.Devices, idx, idx+1)
+	if err := m.saveMetadata(&metadata{StoredMetadata: *stored}); err != nil {
+		log.ErrorContext(ctx, "failed to save metadata", "instance_id", id, "error", err)
+		return nil, fmt.Errorf("save metadata: %w", err)
+	}
+
+	// 6. Release the device back to the host (best effort, the guest no longer sees it)
+	if err := m.deviceManager.MarkDetached(ctx, device.Id); err != nil {
+		log.WarnContext(ctx, "failed to mark device as detached", "device", device.Id, "error", err)
+	}
+	if err := m.deviceManager.UnbindFromVFIO(ctx, device.Id); err != nil {
+		log.WarnContext(ctx, "failed to unbind device from VFIO", "device", device.Id, "error", err)
+	}
+
+	finalInst := m.toInstance(ctx, &metadata{StoredMetadata: *stored})
+	log.InfoContext(ctx, "device detached", "instance_id", id, "device", device.Id)
+	return &finalInst, nil
+}
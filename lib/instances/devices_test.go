@@ -0,0 +1,153 @@
+package instances
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/devices"
+	"github.com/onkernel/hypeman/lib/hypervisor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hotplugHypervisor is a running VM that records the devices hotplugged into it
+type hotplugHypervisor struct {
+	hypervisor.Hypervisor
+	addErr  error
+	added   []string
+	removed []string
+}
+
+func (h *hotplugHypervisor) Capabilities() hypervisor.Capabilities {
+	return hypervisor.Capabilities{SupportsHotplugDevice: true}
+}
+
+func (h *hotplugHypervisor) GetVMInfo(ctx context.Context) (*hypervisor.VMInfo, error) {
+	return &hypervisor.VMInfo{State: hypervisor.StateRunning}, nil
+}
+
+func (h *hotplugHypervisor) AddPCIDevice(ctx context.Context, sysfsPath string) error {
+	if h.addErr != nil {
+		return h.addErr
+	}
+	h.added = append(h.added, sysfsPath)
+	return nil
+}
+
+func (h *hotplugHypervisor) RemovePCIDevice(ctx context.Context, sysfsPath string) error {
+	h.removed = append(h.removed, sysfsPath)
+	return nil
+}
+
+// fakeDeviceManager holds devices in memory and records VFIO binding
+type fakeDeviceManager struct {
+	devices.Manager
+	devices map[string]*devices.Device
+	unbound []string
+}
+
+func (f *fakeDeviceManager) GetDevice(ctx context.Context, idOrName string) (*devices.Device, error) {
+	for _, d := range f.devices {
+		if d.Id == idOrName || d.Name == idOrName {
+			copied := *d
+			return &copied, nil
+		}
+	}
+	return nil, devices.ErrNotFound
+}
+
+func (f *fakeDeviceManager) MarkAttached(ctx context.Context, deviceID, instanceID string) error {
+	f.devices[deviceID].AttachedTo = &instanceID
+	return nil
+}
+
+func (f *fakeDeviceManager) MarkDetached(ctx context.Context, deviceID string) error {
+	f.devices[deviceID].AttachedTo = nil
+	return nil
+}
+
+func (f *fakeDeviceManager) BindToVFIO(ctx context.Context, id string) error {
+	f.devices[id].BoundToVFIO = true
+	return nil
+}
+
+func (f *fakeDeviceManager) UnbindFromVFIO(ctx context.Context, id string) error {
+	f.devices[id].BoundToVFIO = false
+	f.unbound = append(f.unbound, id)
+	return nil
+}
+
+// setupHotplugInstance saves a running instance backed by hv and a device
+// manager with one GPU that isn't bound to VFIO
+func setupHotplugInstance(t *testing.T, hv *hotplugHypervisor) (*manager, *fakeDeviceManager) {
+	t.Helper()
+	mgr, tmpDir := setupTestManager(t)
+
+	devMgr := &fakeDeviceManager{devices: map[string]*devices.Device{
+		"dev-gpu": {Id: "dev-gpu", Name: "gpu0", PCIAddress: "0000:a2:00.0"},
+	}}
+	mgr.deviceManager = devMgr
+	mgr.hvClients[hypervisor.TypeCloudHypervisor] = func(string) (hypervisor.Hypervisor, error) { return hv, nil }
+
+	socketPath := filepath.Join(tmpDir, "ch.sock")
+	require.NoError(t, os.WriteFile(socketPath, nil, 0600))
+	require.NoError(t, mgr.ensureDirectories("inst-gpu"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{
+		Id:             "inst-gpu",
+		Name:           "gpu",
+		Image:          "docker.io/library/alpine:latest",
+		HypervisorType: hypervisor.TypeCloudHypervisor,
+		SocketPath:     socketPath,
+	}}))
+	return mgr, devMgr
+}
+
+func TestAttachDevice(t *testing.T) {
+	hv := &hotplugHypervisor{}
+	mgr, devMgr := setupHotplugInstance(t, hv)
+	ctx := context.Background()
+
+	inst, err := mgr.attachDevice(ctx, "inst-gpu", "gpu0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev-gpu"}, inst.Devices)
+	assert.Equal(t, []string{devices.GetDeviceSysfsPath("0000:a2:00.0")}, hv.added)
+
+	dev := devMgr.devices["dev-gpu"]
+	assert.True(t, dev.BoundToVFIO)
+	require.NotNil(t, dev.AttachedTo)
+	assert.Equal(t, "inst-gpu", *dev.AttachedTo)
+
+	meta, err := mgr.loadMetadata("inst-gpu")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev-gpu"}, meta.Devices)
+}
+
+func TestAttachDevice_HotplugFailureRollsBack(t *testing.T) {
+	hv := &hotplugHypervisor{addErr: errors.New("vfio: device busy")}
+	mgr, devMgr := setupHotplugInstance(t, hv)
+
+	_, err := mgr.attachDevice(context.Background(), "inst-gpu", "gpu0")
+	require.ErrorContains(t, err, "vfio: device busy")
+
+	dev := devMgr.devices["dev-gpu"]
+	assert.Nil(t, dev.AttachedTo, "the device is released")
+	assert.False(t, dev.BoundToVFIO, "the binding made for the attach is undone")
+	assert.Equal(t, []string{"dev-gpu"}, devMgr.unbound)
+
+	meta, err := mgr.loadMetadata("inst-gpu")
+	require.NoError(t, err)
+	assert.Empty(t, meta.Devices)
+}
+
+func TestDetachDevice_NotAttached(t *testing.T) {
+	hv := &hotplugHypervisor{}
+	mgr, devMgr := setupHotplugInstance(t, hv)
+
+	_, err := mgr.detachDevice(context.Background(), "inst-gpu", "gpu0")
+	require.ErrorIs(t, err, devices.ErrNotFound)
+	assert.Empty(t, hv.removed, "nothing is unplugged")
+	assert.Empty(t, devMgr.unbound)
+}
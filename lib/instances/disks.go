@@ -0,0 +1,93 @@
+package instances
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/onkernel/hypeman/lib/hypervisor"
+)
+
+// firstVolumeDisk is the index of the first volume disk. Disks 0-2 are the
+// rootfs, overlay and config disks (/dev/vda-/dev/vdc).
+const firstVolumeDisk = 3
+
+// volumeDevices are the guest devices of one volume attachment. The device
+// names are where the disks land when they are probed in order, which
+// depends on the order of attachment and only holds on the first PCI bus.
+// The serials are derived from the volume ID, so the guest can find a
+// volume's disk regardless of either.
+type volumeDevices struct {
+	Device        string // Volume disk
+	Serial        string
+	OverlayDevice string // Per-instance overlay disk (overlay mode only)
+	OverlaySerial string
+}
+
+// maxDisks returns how many disks an instance on the given hypervisor can
+// have, falling back to what fits on the first PCI bus
+func maxDisks(hvType hypervisor.Type) int {
+	if caps, ok := hypervisor.CapabilitiesForType(hvType); ok && caps.MaxDisks > 0 {
+		return caps.MaxDisks
+	}
+	return hypervisor.PrimaryBusDisks
+}
+
+// supportsDiskSerial reports whether the hypervisor passes disk serials on
+// to the guest
+func supportsDiskSerial(hvType hypervisor.Type) bool {
+	caps, ok := hypervisor.CapabilitiesForType(hvType)
+	return ok && caps.SupportsDiskSerial
+}
+
+// volumeSerial returns the serial of a volume's disk, or of its per-instance
+// overlay disk. Volume IDs don't fit the 20-byte serial, so it is a hash.
+func volumeSerial(volumeID string, overlay bool) string {
+	prefix, key := "hv", volumeID
+	if overlay {
+		prefix, key = "ho", volumeID+"/overlay"
+	}
+	sum := sha256.Sum256([]byte(key))
+	return prefix + hex.EncodeToString(sum[:])[:18]
+}
+
+// guestDiskName returns the name Linux gives the index'th virtio-blk disk:
+// vda-vdz, then vdaa, vdab, ...
+func guestDiskName(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('a'+(index-1)%26)) + name
+	}
+	return "vd" + name
+}
+
+// assignVolumeDevices assigns guest devices to volume attachments, in the
+// order buildHypervisorConfig adds their disks: each volume's disk, followed
+// by its overlay disk in overlay mode. It fails, naming the volume, if the
+// disks don't fit in maxDisks.
+func assignVolumeDevices(vols []VolumeAttachment, maxDisks int) ([]volumeDevices, error) {
+	next := firstVolumeDisk
+	take := func(vol VolumeAttachment, overlay bool) (string, string, error) {
+		if next >= maxDisks {
+			return "", "", fmt.Errorf("volume %s: cannot attach more than %d volume devices per instance on this hypervisor (overlay volumes count as 2)",
+				vol.VolumeID, maxDisks-firstVolumeDisk)
+		}
+		device := "/dev/" + guestDiskName(next)
+		next++
+		return device, volumeSerial(vol.VolumeID, overlay), nil
+	}
+
+	assigned := make([]volumeDevices, len(vols))
+	for i, vol := range vols {
+		var err error
+		if assigned[i].Device, assigned[i].Serial, err = take(vol, false); err != nil {
+			return nil, err
+		}
+		if vol.Overlay {
+			if assigned[i].OverlayDevice, assigned[i].OverlaySerial, err = take(vol, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return assigned, nil
+}
@@ -15,6 +15,30 @@ var (
 	// ErrImageNotReady is returned when the image is not ready for use
 	ErrImageNotReady = errors.New("image not ready")
 
+	// ErrNameExists is returned when creating an instance with a name that is taken
+	ErrNameExists = errors.New("instance name already in use")
+
 	// ErrAmbiguousName is returned when multiple instances have the same name
 	ErrAmbiguousName = errors.New("multiple instances with the same name")
+
+	// ErrNotSupported is returned when the instance's hypervisor lacks a required capability
+	ErrNotSupported = errors.New("operation not supported by hypervisor")
+
+	// ErrLimitExceeded is returned when creating instances would exceed the aggregate resource limits
+	ErrLimitExceeded = errors.New("resource limit exceeded")
+
+	// ErrInvalidBatch is returned when a batch create request is invalid
+	ErrInvalidBatch = errors.New("invalid batch request")
+
+	// ErrInvalidVolume is returned when a volume attachment is invalid
+	ErrInvalidVolume = errors.New("invalid volume attachment")
+
+	// ErrInvalidKernel is returned when the requested kernel version is unknown or unusable
+	ErrInvalidKernel = errors.New("invalid kernel version")
+
+	// ErrInUse is returned when deleting an instance that ingresses route to
+	ErrInUse = errors.New("instance is in use")
+
+	// ErrRecordingNotFound is returned when an exec session has no recording
+	ErrRecordingNotFound = errors.New("exec session recording not found")
 )
@@ -0,0 +1,104 @@
+package instances
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// EventType is the kind of state change an instance event reports
+type EventType string
+
+const (
+	EventCreated EventType = "created" // Instance created and booted
+	EventRunning EventType = "running" // Started or restored from standby
+	EventStopped EventType = "stopped"
+	EventStandby EventType = "standby"
+	EventDeleted EventType = "deleted"
+)
+
+// watchBufferSize is how many events a watcher can fall behind by before
+// further events are dropped for it
+const watchBufferSize = 64
+
+// Event is a state change of an instance
+type Event struct {
+	Type       EventType `json:"type"`
+	InstanceID string    `json:"instance_id"`
+	Name       string    `json:"name"`
+	OldState   State     `json:"old_state,omitempty"` // Empty for created and forced deletes
+	NewState   State     `json:"new_state,omitempty"` // Empty for deleted
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// WatchSelector picks the events a watch receives. Zero fields match
+// everything.
+type WatchSelector struct {
+	InstanceID string      // Only events of this instance
+	Types      []EventType // Only events of these types
+}
+
+func (s WatchSelector) matches(ev Event) bool {
+	if s.InstanceID != "" && s.InstanceID != ev.InstanceID {
+		return false
+	}
+	return len(s.Types) == 0 || slices.Contains(s.Types, ev.Type)
+}
+
+// eventBus fans instance events out to watchers. The zero value is ready
+// to use.
+type eventBus struct {
+	mu       sync.RWMutex
+	watchers map[chan Event]WatchSelector
+}
+
+// publish sends ev to every matching watcher. It never blocks: a watcher
+// whose buffer is full misses the event.
+func (b *eventBus) publish(ev Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch, sel := range b.watchers {
+		if !sel.matches(ev) {
+			continue
+		}
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+// watch registers a watcher until ctx is done, then closes its channel
+func (b *eventBus) watch(ctx context.Context, sel WatchSelector) <-chan Event {
+	ch := make(chan Event, watchBufferSize)
+
+	b.mu.Lock()
+	if b.watchers == nil {
+		b.watchers = make(map[chan Event]WatchSelector)
+	}
+	b.watchers[ch] = sel
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.watchers, ch)
+		b.mu.Unlock()
+		close(ch)
+	}()
+	return ch
+}
+
+// publishEvent reports a state change of an instance to watchers
+func (m *manager) publishEvent(typ EventType, stored *StoredMetadata, oldState, newState State) {
+	m.events.publish(Event{
+		Type:       typ,
+		InstanceID: stored.Id,
+		Name:       stored.Name,
+		OldState:   oldState,
+		NewState:   newState,
+		Timestamp:  time.Now(),
+	})
+}
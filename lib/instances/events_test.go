@@ -0,0 +1,77 @@
+package instances
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receive(t *testing.T, ch <-chan Event) Event {
+	t.Helper()
+	select {
+	case ev, ok := <-ch:
+		require.True(t, ok, "watch channel closed")
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+		return Event{}
+	}
+}
+
+func TestEventBus(t *testing.T) {
+	var bus eventBus
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	all := bus.watch(ctx, WatchSelector{})
+	one := bus.watch(ctx, WatchSelector{InstanceID: "b"})
+	stops := bus.watch(ctx, WatchSelector{Types: []EventType{EventStopped}})
+
+	bus.publish(Event{Type: EventRunning, InstanceID: "a"})
+	bus.publish(Event{Type: EventStopped, InstanceID: "b"})
+
+	assert.Equal(t, "a", receive(t, all).InstanceID)
+	assert.Equal(t, "b", receive(t, all).InstanceID)
+	assert.Equal(t, EventStopped, receive(t, one).Type)
+	assert.Equal(t, "b", receive(t, stops).InstanceID)
+
+	// A watcher that doesn't keep up misses events instead of blocking
+	for i := 0; i < watchBufferSize+10; i++ {
+		bus.publish(Event{Type: EventRunning, InstanceID: "b"})
+	}
+	assert.Len(t, one, watchBufferSize)
+
+	// Cancelling the watch closes its channel
+	cancel()
+	require.Eventually(t, func() bool {
+		select {
+		case _, ok := <-stops:
+			return !ok
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestWatchInstances_Deleted(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, mgr.ensureDirectories("inst-1"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "inst-1", Name: "web"}}))
+
+	events := mgr.WatchInstances(ctx, WatchSelector{InstanceID: "inst-1"})
+	require.NoError(t, mgr.DeleteInstance(ctx, "inst-1"))
+
+	ev := receive(t, events)
+	assert.Equal(t, EventDeleted, ev.Type)
+	assert.Equal(t, "inst-1", ev.InstanceID)
+	assert.Equal(t, "web", ev.Name)
+	assert.Equal(t, StateStopped, ev.OldState)
+	assert.Empty(t, ev.NewState)
+	assert.False(t, ev.Timestamp.IsZero())
+}
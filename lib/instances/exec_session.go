@@ -0,0 +1,259 @@
+package instances
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/nrednav/cuid2"
+)
+
+// ExecSession is the audit record of an exec session
+type ExecSession struct {
+	ID         string     `json:"id"`
+	InstanceID string     `json:"instance_id"`
+	Subject    string     `json:"subject"` // Who opened the session
+	Command    []string   `json:"command"`
+	TTY        bool       `json:"tty"`
+	StartedAt  time.Time  `json:"started_at"`
+	EndedAt    *time.Time `json:"ended_at,omitempty"`  // Unset while the session is open, or if the server stopped during it
+	ExitCode   *int       `json:"exit_code,omitempty"` // Unset until the session ends
+	Recorded   bool       `json:"recorded"`            // Whether the TTY output was recorded
+}
+
+// ExecSessionRequest describes an exec session to audit
+type ExecSessionRequest struct {
+	Subject string
+	Command []string
+	TTY     bool
+	Record  bool // Record the output; only TTY sessions are recorded
+}
+
+// Terminal size written to recordings: the exec API doesn't know the
+// client's, and players reflow to their own
+const (
+	recordingWidth  = 80
+	recordingHeight = 24
+)
+
+// ExecSessionRecorder logs an exec session while it runs. The session's
+// output written to it goes to the recording, if the session is recorded.
+// Recording errors don't interrupt the session; Finish reports them.
+type ExecSessionRecorder struct {
+	session ExecSession
+	path    string
+
+	mu      sync.Mutex
+	cast    *os.File
+	partial []byte // Incomplete UTF-8 sequence held back from the last write
+	err     error
+}
+
+// StartExecSession writes the audit record of an exec session starting on
+// an instance, and opens its recording if it is recorded. Call Finish on
+// the returned recorder when the session ends.
+func (m *manager) StartExecSession(ctx context.Context, id string, req ExecSessionRequest) (*ExecSessionRecorder, error) {
+	if _, err := m.loadMetadata(id); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(m.paths.InstanceExecSessions(id), 0700); err != nil {
+		return nil, fmt.Errorf("create exec sessions directory: %w", err)
+	}
+
+	r := &ExecSessionRecorder{
+		session: ExecSession{
+			ID:         cuid2.Generate(),
+			InstanceID: id,
+			Subject:    req.Subject,
+			Command:    req.Command,
+			TTY:        req.TTY,
+			StartedAt:  time.Now().UTC(),
+			Recorded:   req.Record && req.TTY,
+		},
+	}
+	r.path = m.paths.InstanceExecSession(id, r.session.ID)
+
+	if r.session.Recorded {
+		cast, err := os.OpenFile(m.paths.InstanceExecRecording(id, r.session.ID), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("create exec recording: %w", err)
+		}
+		header, _ := json.Marshal(map[string]any{
+			"version":   2,
+			"width":     recordingWidth,
+			"height":    recordingHeight,
+			"timestamp": r.session.StartedAt.Unix(),
+			"command":   strings.Join(req.Command, " "),
+		})
+		if _, err := cast.Write(append(header, '\n')); err != nil {
+			cast.Close()
+			return nil, fmt.Errorf("write exec recording: %w", err)
+		}
+		r.cast = cast
+	}
+
+	if err := r.save(); err != nil {
+		if r.cast != nil {
+			r.cast.Close()
+		}
+		return nil, err
+	}
+	return r, nil
+}
+
+// Session returns the session's audit record as it stands
+func (r *ExecSessionRecorder) Session() ExecSession {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.session
+}
+
+// Write records session output as an asciicast output event. It always
+// succeeds, so it can sit in the output path of the session.
+func (r *ExecSessionRecorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cast == nil || r.err != nil {
+		return len(p), nil
+	}
+
+	// Events are JSON strings, so a UTF-8 sequence split across writes is
+	// held back until it is complete
+	data := append(r.partial, p...)
+	data, r.partial = splitIncompleteRune(data)
+	if len(data) == 0 {
+		return len(p), nil
+	}
+
+	elapsed := time.Since(r.session.StartedAt).Seconds()
+	event, _ := json.Marshal([]any{elapsed, "o", string(data)})
+	if _, err := r.cast.Write(append(event, '\n')); err != nil {
+		r.err = fmt.Errorf("write exec recording: %w", err)
+	}
+	return len(p), nil
+}
+
+// Finish records the session's end and exit code and closes its recording
+func (r *ExecSessionRecorder) Finish(exitCode int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+	r.session.EndedAt = &now
+	r.session.ExitCode = &exitCode
+
+	err := r.err
+	if r.cast != nil {
+		if len(r.partial) > 0 {
+			elapsed := now.Sub(r.session.StartedAt).Seconds()
+			event, _ := json.Marshal([]any{elapsed, "o", string(r.partial)})
+			r.cast.Write(append(event, '\n'))
+		}
+		if cerr := r.cast.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("close exec recording: %w", cerr)
+		}
+		r.cast = nil
+	}
+	if serr := r.save(); serr != nil && err == nil {
+		err = serr
+	}
+	return err
+}
+
+// save writes the session's audit record
+func (r *ExecSessionRecorder) save() error {
+	data, err := json.MarshalIndent(r.session, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal exec session: %w", err)
+	}
+	tmpPath := r.path + ".tmp"
+	if err := writeFileSync(tmpPath, data); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("write exec session: %w", err)
+	}
+	if err := os.Rename(tmpPath, r.path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("write exec session: %w", err)
+	}
+	return nil
+}
+
+// splitIncompleteRune splits a trailing incomplete UTF-8 sequence off b
+func splitIncompleteRune(b []byte) ([]byte, []byte) {
+	for i := 1; i < utf8.UTFMax && i <= len(b); i++ {
+		c := b[len(b)-i]
+		if !utf8.RuneStart(c) {
+			continue
+		}
+		if !utf8.FullRune(b[len(b)-i:]) {
+			return b[:len(b)-i], append([]byte(nil), b[len(b)-i:]...)
+		}
+		break
+	}
+	return b, nil
+}
+
+// ListExecSessions returns the exec sessions opened on an instance, oldest first
+func (m *manager) ListExecSessions(ctx context.Context, id string) ([]ExecSession, error) {
+	if _, err := m.loadMetadata(id); err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(m.paths.InstanceExecSessions(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return []ExecSession{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read exec sessions: %w", err)
+	}
+
+	sessions := []ExecSession{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(m.paths.InstanceExecSessions(id), entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read exec session: %w", err)
+		}
+		var session ExecSession
+		if err := json.Unmarshal(data, &session); err != nil {
+			continue // Skip records that don't parse rather than hide the rest
+		}
+		sessions = append(sessions, session)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].StartedAt.Before(sessions[j].StartedAt)
+	})
+	return sessions, nil
+}
+
+// OpenExecRecording opens the recording of an exec session, in asciicast v2
+// format, for replay with e.g. `asciinema play`
+func (m *manager) OpenExecRecording(ctx context.Context, id, sessionID string) (io.ReadCloser, error) {
+	if _, err := m.loadMetadata(id); err != nil {
+		return nil, err
+	}
+	// Session IDs are cuid2s; anything else must not escape the directory
+	if sessionID == "" || strings.ContainsAny(sessionID, `/\.`) {
+		return nil, ErrRecordingNotFound
+	}
+
+	f, err := os.Open(m.paths.InstanceExecRecording(id, sessionID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrRecordingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open exec recording: %w", err)
+	}
+	return f, nil
+}
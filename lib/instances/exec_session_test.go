@@ -0,0 +1,76 @@
+package instances
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecSessions_RecordAndReplay(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	require.NoError(t, mgr.ensureDirectories("inst-exec"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "inst-exec", Name: "exec"}}))
+
+	sessions, err := mgr.ListExecSessions(ctx, "inst-exec")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+
+	// A recorded TTY session, with "é" split across two writes
+	rec, err := mgr.StartExecSession(ctx, "inst-exec", ExecSessionRequest{Subject: "user-1", Command: []string{"/bin/sh"}, TTY: true, Record: true})
+	require.NoError(t, err)
+	assert.True(t, rec.Session().Recorded)
+	rec.Write([]byte("caf\xc3"))
+	rec.Write([]byte("\xa9\r\n"))
+	require.NoError(t, rec.Finish(3))
+
+	// Sessions without a TTY are audited but never recorded
+	plain, err := mgr.StartExecSession(ctx, "inst-exec", ExecSessionRequest{Subject: "user-2", Command: []string{"ls"}, Record: true})
+	require.NoError(t, err)
+	assert.False(t, plain.Session().Recorded)
+	plain.Write([]byte("ignored"))
+
+	sessions, err = mgr.ListExecSessions(ctx, "inst-exec")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	first := sessions[0]
+	assert.Equal(t, rec.Session().ID, first.ID)
+	assert.Equal(t, "user-1", first.Subject)
+	assert.Equal(t, []string{"/bin/sh"}, first.Command)
+	require.NotNil(t, first.ExitCode)
+	assert.Equal(t, 3, *first.ExitCode)
+	require.NotNil(t, first.EndedAt)
+	assert.Nil(t, sessions[1].EndedAt, "a session still open has no end")
+
+	recording, err := mgr.OpenExecRecording(ctx, "inst-exec", first.ID)
+	require.NoError(t, err)
+	defer recording.Close()
+	scanner := bufio.NewScanner(recording)
+	require.True(t, scanner.Scan())
+	var header map[string]any
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &header))
+	assert.EqualValues(t, 2, header["version"])
+	assert.Equal(t, "/bin/sh", header["command"])
+
+	var output string
+	for scanner.Scan() {
+		var event []any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		require.Len(t, event, 3)
+		assert.Equal(t, "o", event[1])
+		output += event[2].(string)
+	}
+	assert.Equal(t, "café\r\n", output)
+
+	_, err = mgr.OpenExecRecording(ctx, "inst-exec", sessions[1].ID)
+	assert.ErrorIs(t, err, ErrRecordingNotFound)
+	_, err = mgr.OpenExecRecording(ctx, "inst-exec", "../metadata")
+	assert.ErrorIs(t, err, ErrRecordingNotFound)
+	_, err = mgr.ListExecSessions(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
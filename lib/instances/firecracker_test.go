@@ -0,0 +1,190 @@
+package instances
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onkernel/hypeman/cmd/api/config"
+	"github.com/onkernel/hypeman/lib/devices"
+	"github.com/onkernel/hypeman/lib/guest"
+	"github.com/onkernel/hypeman/lib/hypervisor"
+	"github.com/onkernel/hypeman/lib/hypervisor/firecracker"
+	"github.com/onkernel/hypeman/lib/images"
+	"github.com/onkernel/hypeman/lib/network"
+	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/onkernel/hypeman/lib/system"
+	"github.com/onkernel/hypeman/lib/volumes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTestManagerForFirecracker creates a manager configured to use Firecracker as the default hypervisor
+func setupTestManagerForFirecracker(t *testing.T) (*manager, string) {
+	tmpDir := t.TempDir()
+
+	cfg := &config.Config{
+		DataDir:    tmpDir,
+		BridgeName: "vmbr0",
+		SubnetCIDR: "10.100.0.0/16",
+		DNSServer:  "1.1.1.1",
+	}
+
+	p := paths.New(tmpDir)
+	imageManager, err := images.NewManager(p, 1, nil)
+	require.NoError(t, err)
+
+	systemManager := system.NewManager(p)
+	networkManager := network.NewManager(p, cfg, nil)
+	deviceManager := devices.NewManager(p)
+	volumeManager := volumes.NewManager(p, 0, nil)
+	limits := ResourceLimits{
+		MaxOverlaySize: 100 * 1024 * 1024 * 1024, // 100GB
+	}
+	mgr := NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, 0, ConsoleConfig{}, hypervisor.TypeFirecracker, nil, nil).(*manager)
+
+	// Reuse the QEMU cleanup: it kills any hypervisor PID recorded in metadata
+	t.Cleanup(func() {
+		cleanupOrphanedQEMUProcesses(t, mgr)
+	})
+
+	return mgr, tmpDir
+}
+
+// waitForFirecrackerReady polls the Firecracker API until the VM is running or times out
+func waitForFirecrackerReady(ctx context.Context, socketPath string, timeout time.Duration) error {
+	hv, err := firecracker.New(socketPath)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		info, err := hv.GetVMInfo(ctx)
+		if err == nil && info.State == hypervisor.StateRunning {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return fmt.Errorf("Firecracker VM did not reach running state within %v", timeout)
+}
+
+// TestFirecrackerLifecycle tests create, exec, standby/restore and delete with Firecracker.
+// Skipped unless /dev/kvm and the firecracker binary are available.
+func TestFirecrackerLifecycle(t *testing.T) {
+	if _, err := os.Stat("/dev/kvm"); os.IsNotExist(err) {
+		t.Skip("/dev/kvm not available")
+	}
+	if _, err := firecracker.NewStarter().GetBinaryPath(nil, ""); err != nil {
+		t.Skipf("Firecracker not available: %v", err)
+	}
+
+	manager, tmpDir := setupTestManagerForFirecracker(t)
+	ctx := context.Background()
+	p := paths.New(tmpDir)
+
+	// Pull image
+	imageManager, err := images.NewManager(p, 1, nil)
+	require.NoError(t, err)
+
+	t.Log("Pulling nginx:alpine image...")
+	img, err := imageManager.CreateImage(ctx, images.CreateImageRequest{
+		Name: "docker.io/library/nginx:alpine",
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 60; i++ {
+		img, err = imageManager.GetImage(ctx, img.Name)
+		if err == nil && img.Status == images.StatusReady {
+			break
+		}
+		if err == nil && img.Status == images.StatusFailed {
+			t.Fatalf("Image build failed: %s", *img.Error)
+		}
+		time.Sleep(1 * time.Second)
+	}
+	require.Equal(t, images.StatusReady, img.Status, "Image should be ready after 60 seconds")
+
+	// Ensure system files
+	systemManager := system.NewManager(p)
+	t.Log("Ensuring system files...")
+	require.NoError(t, systemManager.EnsureSystemFiles(ctx))
+
+	// Create instance with Firecracker (no network for simpler test)
+	inst, err := manager.CreateInstance(ctx, CreateInstanceRequest{
+		Name:           "test-firecracker",
+		Image:          "docker.io/library/nginx:alpine",
+		Size:           1024 * 1024 * 1024,     // 1GB
+		OverlaySize:    5 * 1024 * 1024 * 1024, // 5GB
+		Vcpus:          1,
+		NetworkEnabled: false,
+		Hypervisor:     hypervisor.TypeFirecracker,
+		Env:            map[string]string{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, StateRunning, inst.State)
+	assert.Equal(t, hypervisor.TypeFirecracker, inst.HypervisorType)
+	assert.NotEqual(t, "unknown", inst.HypervisorVersion)
+	t.Logf("Instance created: %s (firecracker %s)", inst.Id, inst.HypervisorVersion)
+
+	require.NoError(t, waitForFirecrackerReady(ctx, inst.SocketPath, 10*time.Second))
+
+	// Exec through the hybrid vsock socket
+	runCmd := func(command ...string) (string, error) {
+		dialer, err := hypervisor.NewVsockDialer(inst.HypervisorType, inst.VsockSocket, inst.VsockCID)
+		if err != nil {
+			return "", err
+		}
+
+		var lastErr error
+		for attempt := 0; attempt < 20; attempt++ {
+			var stdout bytes.Buffer
+			exit, err := guest.ExecIntoInstance(ctx, dialer, guest.ExecOptions{
+				Command: command,
+				Stdout:  &stdout,
+			})
+			if err == nil && exit.Code == 0 {
+				return strings.TrimSpace(stdout.String()), nil
+			}
+			lastErr = err
+			time.Sleep(500 * time.Millisecond)
+		}
+		return "", lastErr
+	}
+
+	output, err := runCmd("echo", "hello-from-firecracker")
+	require.NoError(t, err)
+	assert.Equal(t, "hello-from-firecracker", output)
+
+	// Standby and restore via Firecracker snapshots
+	t.Log("Standing by instance...")
+	inst, err = manager.StandbyInstance(ctx, inst.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StateStandby, inst.State)
+
+	snapshotDir := p.InstanceSnapshotLatest(inst.Id)
+	assert.FileExists(t, filepath.Join(snapshotDir, "snapshot"))
+	assert.FileExists(t, filepath.Join(snapshotDir, "memory"))
+	assert.FileExists(t, filepath.Join(snapshotDir, "firecracker-config.json"))
+
+	t.Log("Restoring instance...")
+	inst, err = manager.RestoreInstance(ctx, inst.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StateRunning, inst.State)
+	require.NoError(t, waitForFirecrackerReady(ctx, inst.SocketPath, 10*time.Second))
+
+	output, err = runCmd("echo", "restored")
+	require.NoError(t, err)
+	assert.Equal(t, "restored", output)
+
+	// Delete
+	require.NoError(t, manager.DeleteInstance(ctx, inst.Id))
+	assert.NoDirExists(t, p.InstanceDir(inst.Id))
+
+	t.Log("Firecracker lifecycle test complete!")
+}
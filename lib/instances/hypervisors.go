@@ -0,0 +1,34 @@
+package instances
+
+import (
+	"context"
+	"sort"
+
+	"github.com/onkernel/hypeman/lib/hypervisor"
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+// listHypervisors returns the hypervisors this manager can start, with their
+// detected versions and capabilities, sorted by type
+func (m *manager) listHypervisors(ctx context.Context) []HypervisorInfo {
+	log := logger.FromContext(ctx)
+
+	infos := make([]HypervisorInfo, 0, len(m.vmStarters))
+	for hvType, starter := range m.vmStarters {
+		info := HypervisorInfo{Type: hvType}
+		info.Capabilities, _ = hypervisor.CapabilitiesForType(hvType)
+
+		version, err := starter.GetVersion(m.paths)
+		if err != nil {
+			log.DebugContext(ctx, "failed to detect hypervisor version", "hypervisor", hvType, "error", err)
+			info.Error = err.Error()
+		} else {
+			info.Version = version
+			info.Available = true
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Type < infos[j].Type })
+	return infos
+}
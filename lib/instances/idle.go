@@ -0,0 +1,199 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+// sysClassNet is where the kernel exposes network interface statistics
+var sysClassNet = "/sys/class/net"
+
+// activityTracker holds the in-memory half of idle tracking: open exec
+// sessions and the TAP byte counters seen at the last idle check. The last
+// activity time itself is persisted in metadata.
+type activityTracker struct {
+	mu       sync.Mutex
+	sessions map[string]int    // instance ID -> open exec sessions
+	traffic  map[string]uint64 // instance ID -> TAP rx+tx bytes at last check
+}
+
+// begin records the start of an exec session and returns a func ending it
+func (t *activityTracker) begin(id string) func() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.sessions == nil {
+		t.sessions = make(map[string]int)
+	}
+	t.sessions[id]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			if t.sessions[id]--; t.sessions[id] <= 0 {
+				delete(t.sessions, id)
+			}
+		})
+	}
+}
+
+// openSessions returns the number of exec sessions open on an instance
+func (t *activityTracker) openSessions(id string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.sessions[id]
+}
+
+// trafficChanged records an instance's TAP byte count and reports whether
+// it moved since the last check. The first observation is not activity.
+func (t *activityTracker) trafficChanged(id string, bytes uint64) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.traffic == nil {
+		t.traffic = make(map[string]uint64)
+	}
+	prev, seen := t.traffic[id]
+	t.traffic[id] = bytes
+	return seen && prev != bytes
+}
+
+// forget drops the traffic counter of an instance that isn't being tracked,
+// so a restarted TAP device doesn't look like activity later
+func (t *activityTracker) forget(id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.traffic, id)
+}
+
+// TrackExecSession marks an instance as active until the returned func is called
+func (m *manager) TrackExecSession(id string) func() {
+	return m.activity.begin(id)
+}
+
+// StopIdleInstances stops, or puts in standby, running instances that have
+// an IdleTimeout and have seen no network traffic or exec session for that
+// long. Network traffic is measured on the TAP device, so it includes
+// requests routed to the instance through ingress.
+func (m *manager) StopIdleInstances(ctx context.Context) error {
+	instances, err := m.listInstances(ctx)
+	if err != nil {
+		return fmt.Errorf("list instances for idle check: %w", err)
+	}
+
+	var lastErr error
+	for _, inst := range instances {
+		if inst.IdleTimeout <= 0 || inst.State != StateRunning {
+			m.activity.forget(inst.Id)
+			continue
+		}
+		if err := m.stopIfIdle(ctx, inst.Id, time.Now()); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// stopIfIdle records any new activity on an instance, then stops it or
+// puts it in standby if it has been idle for longer than its IdleTimeout
+func (m *manager) stopIfIdle(ctx context.Context, id string, now time.Time) error {
+	log := logger.FromContext(ctx)
+
+	idleFor, stored, err := m.updateActivity(ctx, id, now)
+	if err != nil || idleFor < stored.IdleTimeout {
+		return err
+	}
+	// An exec may have started since the check
+	if m.activity.openSessions(id) > 0 {
+		return nil
+	}
+
+	action := stored.IdleAction
+	if action == "" {
+		action = IdleActionStop
+	}
+	log.InfoContext(ctx, "idle timeout reached", "instance_id", id, "action", string(action),
+		"idle_for", idleFor.Round(time.Second).String(), "idle_timeout", stored.IdleTimeout.String())
+
+	if action == IdleActionStandby {
+		_, err = m.StandbyInstance(ctx, id)
+	} else {
+		_, err = m.StopInstance(ctx, id)
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to act on idle instance", "instance_id", id, "action", string(action), "error", err)
+		return fmt.Errorf("%s idle instance %s: %w", action, id, err)
+	}
+	m.activity.forget(id)
+	m.recordIdleStop(ctx, action, stored.HypervisorType)
+	return nil
+}
+
+// updateActivity persists the last activity time if the instance is active
+// now, and returns how long it has been idle along with its metadata
+func (m *manager) updateActivity(ctx context.Context, id string, now time.Time) (time.Duration, *StoredMetadata, error) {
+	lock := m.getInstanceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	meta, err := m.loadMetadata(id)
+	if err != nil {
+		return 0, nil, err
+	}
+	stored := &meta.StoredMetadata
+
+	active := m.activity.openSessions(id) > 0
+	if stored.NetworkEnabled {
+		if alloc, err := m.networkManager.GetAllocation(ctx, id); err == nil && alloc != nil {
+			if bytes, err := readTAPBytes(alloc.TAPDevice); err == nil && m.activity.trafficChanged(id, bytes) {
+				active = true
+			}
+		}
+	}
+
+	if active {
+		stored.LastActivityAt = &now
+		if err := m.saveMetadata(meta); err != nil {
+			return 0, nil, fmt.Errorf("save last activity: %w", err)
+		}
+	}
+	return now.Sub(lastActivity(stored)), stored, nil
+}
+
+// lastActivity returns when an instance was last active. Starting the VM
+// counts, so activity from before a stop doesn't carry over.
+func lastActivity(stored *StoredMetadata) time.Time {
+	last := stored.CreatedAt
+	if stored.StartedAt != nil && stored.StartedAt.After(last) {
+		last = *stored.StartedAt
+	}
+	if stored.LastActivityAt != nil && stored.LastActivityAt.After(last) {
+		last = *stored.LastActivityAt
+	}
+	return last
+}
+
+// readTAPBytes returns the bytes received plus sent on a TAP device
+func readTAPBytes(tapName string) (uint64, error) {
+	var total uint64
+	for _, counter := range []string{"rx_bytes", "tx_bytes"} {
+		data, err := os.ReadFile(filepath.Join(sysClassNet, tapName, "statistics", counter))
+		if err != nil {
+			return 0, err
+		}
+		n, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse %s: %w", counter, err)
+		}
+		total += n
+	}
+	return total, nil
+}
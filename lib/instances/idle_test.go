@@ -0,0 +1,139 @@
+package instances
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateActivity(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	// Round(0) drops the monotonic reading, which metadata doesn't keep
+	started := time.Now().Add(-time.Hour).Round(0)
+	id := "idle-instance"
+	require.NoError(t, mgr.ensureDirectories(id))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{
+		Id:          id,
+		CreatedAt:   started.Add(-time.Hour),
+		StartedAt:   &started,
+		IdleTimeout: 30 * time.Minute,
+	}}))
+
+	// Idle time counts from the last start when nothing has happened since
+	now := time.Now().Round(0)
+	idleFor, stored, err := mgr.updateActivity(ctx, id, now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Sub(started), idleFor)
+	assert.Equal(t, 30*time.Minute, stored.IdleTimeout)
+
+	// An open exec session is activity, and is persisted
+	done := mgr.TrackExecSession(id)
+	idleFor, _, err = mgr.updateActivity(ctx, id, now)
+	require.NoError(t, err)
+	assert.Zero(t, idleFor)
+	meta, err := mgr.loadMetadata(id)
+	require.NoError(t, err)
+	require.NotNil(t, meta.LastActivityAt)
+	assert.True(t, meta.LastActivityAt.Equal(now))
+
+	// Ending the session (even twice) leaves the instance idle from then on
+	done()
+	done()
+	assert.Zero(t, mgr.activity.openSessions(id))
+	idleFor, _, err = mgr.updateActivity(ctx, id, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, idleFor)
+}
+
+func TestActivityTrackerTraffic(t *testing.T) {
+	var tracker activityTracker
+	assert.False(t, tracker.trafficChanged("a", 100), "first observation is a baseline")
+	assert.False(t, tracker.trafficChanged("a", 100))
+	assert.True(t, tracker.trafficChanged("a", 250))
+
+	tracker.forget("a")
+	assert.False(t, tracker.trafficChanged("a", 10))
+}
+
+func TestReadTAPBytes(t *testing.T) {
+	sysClassNet = t.TempDir()
+	t.Cleanup(func() { sysClassNet = "/sys/class/net" })
+
+	stats := filepath.Join(sysClassNet, "hype-abc", "statistics")
+	require.NoError(t, os.MkdirAll(stats, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(stats, "rx_bytes"), []byte("1200\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(stats, "tx_bytes"), []byte("34\n"), 0644))
+
+	n, err := readTAPBytes("hype-abc")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1234), n)
+
+	_, err = readTAPBytes("hype-missing")
+	assert.Error(t, err)
+}
+
+// wakeManager is a Manager whose only instance sits in standby until restored
+type wakeManager struct {
+	Manager
+	inst     Instance
+	restored atomic.Int32
+	slow     chan struct{} // if set, restores wait for it to close
+}
+
+func (m *wakeManager) GetInstance(ctx context.Context, idOrName string) (*Instance, error) {
+	inst := m.inst
+	if m.restored.Load() > 0 {
+		inst.State = StateRunning
+	}
+	return &inst, nil
+}
+
+func (m *wakeManager) RestoreInstance(ctx context.Context, id string) (*Instance, error) {
+	if m.slow != nil {
+		<-m.slow
+	}
+	m.restored.Add(1)
+	return m.GetInstance(ctx, id)
+}
+
+func TestIngressResolverWake(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(action IdleAction) *wakeManager {
+		return &wakeManager{inst: Instance{
+			StoredMetadata: StoredMetadata{Id: "abc", NetworkEnabled: true, IP: "10.100.0.5", IdleAction: action},
+			State:          StateStandby,
+		}}
+	}
+
+	// An instance that idled into standby is restored
+	mgr := newManager(IdleActionStandby)
+	resolver := NewIngressResolver(mgr)
+	require.NoError(t, resolver.WakeInstance(ctx, "abc"))
+	assert.Equal(t, int32(1), mgr.restored.Load())
+	ip, err := resolver.ResolveInstanceIP(ctx, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, "10.100.0.5", ip)
+
+	// Instances that stop when idle aren't started by traffic
+	mgr = newManager(IdleActionStop)
+	require.NoError(t, NewIngressResolver(mgr).WakeInstance(ctx, "abc"))
+	assert.Zero(t, mgr.restored.Load())
+
+	// A slow restore gives up waiting but still completes
+	mgr = newManager(IdleActionStandby)
+	mgr.slow = make(chan struct{})
+	waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	err = NewIngressResolver(mgr).WakeInstance(waitCtx, "abc")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	close(mgr.slow)
+	assert.Eventually(t, func() bool { return mgr.restored.Load() == 1 }, time.Second, 10*time.Millisecond)
+}
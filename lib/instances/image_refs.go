@@ -0,0 +1,49 @@
+package instances
+
+import (
+	"context"
+
+	"github.com/onkernel/hypeman/lib/images"
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+// Ensure imageReferenceAdapter implements the interface
+var _ images.ReferenceSource = (*imageReferenceAdapter)(nil)
+
+// imageReferenceAdapter adapts instances.Manager to images.ReferenceSource
+type imageReferenceAdapter struct {
+	manager *manager
+}
+
+// NewImageReferenceSource creates an images.ReferenceSource reporting the
+// images used by instances, so image garbage collection keeps them.
+func NewImageReferenceSource(m Manager) images.ReferenceSource {
+	mgr, ok := m.(*manager)
+	if !ok {
+		return nil
+	}
+	return &imageReferenceAdapter{manager: mgr}
+}
+
+// ImageReferences returns the digest of every instance's image, whatever its state.
+// Instances store the image name, so it is resolved the same way start does.
+func (a *imageReferenceAdapter) ImageReferences(ctx context.Context) ([]string, error) {
+	log := logger.FromContext(ctx)
+
+	insts, err := a.manager.listInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	digests := make([]string, 0, len(insts))
+	for _, inst := range insts {
+		img, err := a.manager.imageManager.GetImage(ctx, inst.Image)
+		if err != nil {
+			// Image already gone, nothing to protect
+			log.DebugContext(ctx, "instance image not resolvable", "instance_id", inst.Id, "image", inst.Image, "error", err)
+			continue
+		}
+		digests = append(digests, img.Digest)
+	}
+	return digests, nil
+}
@@ -51,3 +51,67 @@ func (r *IngressResolver) ResolveInstance(ctx context.Context, nameOrID string)
 	}
 	return inst.Name, inst.Id, nil
 }
+
+// CountResolvableInstances returns how many instances have a network IP.
+func (r *IngressResolver) CountResolvableInstances(ctx context.Context) (int, error) {
+	insts, err := r.manager.ListInstances(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, inst := range insts {
+		if inst.NetworkEnabled && inst.IP != "" {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// InstanceInfo returns the state and image of an instance by name, ID, or ID prefix.
+func (r *IngressResolver) InstanceInfo(ctx context.Context, nameOrID string) (string, string, error) {
+	inst, err := r.manager.GetInstance(ctx, nameOrID)
+	if err != nil {
+		return "", "", fmt.Errorf("instance not found: %s", nameOrID)
+	}
+	return string(inst.State), inst.Image, nil
+}
+
+// WakeInstance restores an instance that idled into standby
+// (IdleActionStandby), returning once it is running again. A restored VM
+// resumes from its snapshot with its services already listening, so running
+// means ready. Any other instance, or one that doesn't exist, is left alone.
+func (r *IngressResolver) WakeInstance(ctx context.Context, nameOrID string) error {
+	inst, err := r.manager.GetInstance(ctx, nameOrID)
+	if err != nil || inst.State != StateStandby || inst.IdleAction != IdleActionStandby {
+		return nil
+	}
+	if err := r.wake(ctx, inst.Id); err != nil {
+		return fmt.Errorf("wake instance %s: %w", nameOrID, err)
+	}
+	return nil
+}
+
+// wake restores a standby instance for an incoming request. The restore
+// itself isn't cancelled with ctx, so a slow restore still completes for
+// the client's next attempt.
+func (r *IngressResolver) wake(ctx context.Context, id string) error {
+	done := make(chan error, 1)
+	go func() {
+		restoreCtx := context.WithoutCancel(ctx)
+		_, err := r.manager.RestoreInstance(restoreCtx, id)
+		if err != nil {
+			// A concurrent request may have restored it first
+			if inst, getErr := r.manager.GetInstance(restoreCtx, id); getErr == nil && inst.State == StateRunning {
+				err = nil
+			}
+		}
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -44,6 +44,19 @@ func (a *instanceLivenessAdapter) IsInstanceRunning(ctx context.Context, instanc
 	switch inst.State {
 	case StateRunning, StatePaused, StateCreated:
 		return true
+	case StateUnknown:
+		// Its devices are treated as orphaned and released, so record why
+		// the state couldn't be determined
+		var stateErr string
+		if inst.StateError != nil {
+			stateErr = *inst.StateError
+		}
+		logger.FromContext(ctx).WarnContext(ctx, "instance state is unknown, treating it as not running",
+			"instance_id", instanceID,
+			"reason", inst.StateReason,
+			"error", stateErr,
+		)
+		return false
 	default:
 		// StateStopped, StateStandby, StateShutdown, StateUnknown
 		return false
@@ -82,7 +95,7 @@ func (a *instanceLivenessAdapter) ListAllInstanceDevices(ctx context.Context) ma
 	return result
 }
 
-// DetectSuspiciousVMMProcesses finds cloud-hypervisor processes that don't match
+// DetectSuspiciousVMMProcesses finds hypervisor processes that don't match
 // known instances and logs warnings. Returns the count of suspicious processes found.
 // This uses ListInstances (all instances) rather than ListAllInstanceDevices to avoid
 // false positives for instances without GPU devices attached.
@@ -93,63 +106,81 @@ func (a *instanceLivenessAdapter) DetectSuspiciousVMMProcesses(ctx context.Conte
 		return 0
 	}
 
-	// Find all cloud-hypervisor processes
-	cmd := exec.Command("pgrep", "-a", "cloud-hypervisor")
+	// Find all hypervisor processes
+	cmd := exec.Command("pgrep", "-a", vmmProcessPattern)
 	output, err := cmd.Output()
 	if err != nil {
 		// pgrep returns exit code 1 if no processes found - that's fine
 		return 0
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-	if len(lines) == 0 || (len(lines) == 1 && lines[0] == "") {
+	// Match processes by the API socket of each running instance
+	instances, err := a.manager.listInstances(ctx)
+	if err != nil {
+		log.WarnContext(ctx, "failed to list instances, skipping VMM process check", "error", err)
 		return 0
 	}
+	runningSockets := make(map[string]bool)
+	for _, inst := range instances {
+		switch inst.State {
+		case StateRunning, StatePaused, StateCreated:
+			runningSockets[inst.SocketPath] = true
+		}
+	}
 
-	suspiciousCount := 0
-	for _, line := range lines {
+	suspicious := untrackedVMMProcesses(string(output), runningSockets)
+	for _, proc := range suspicious {
+		log.WarnContext(ctx, "detected untracked hypervisor process",
+			"process_info", proc.line,
+			"socket_path", proc.socketPath,
+			"remediation", "Run lib/devices/scripts/gpu-reset.sh for manual recovery if needed",
+		)
+	}
+	return len(suspicious)
+}
+
+// suspiciousVMMProcess is a `pgrep -a` line that no running instance accounts for
+type suspiciousVMMProcess struct {
+	line       string
+	socketPath string
+}
+
+// untrackedVMMProcesses returns the hypervisor processes in `pgrep -a` output
+// whose API socket isn't one of runningSockets
+func untrackedVMMProcesses(output string, runningSockets map[string]bool) []suspiciousVMMProcess {
+	var suspicious []suspiciousVMMProcess
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
 		if line == "" {
 			continue
 		}
-
-		// Try to extract socket path from command line to match against known instances
-		// cloud-hypervisor command typically includes --api-socket <path>
-		socketPath := ""
-		parts := strings.Fields(line)
-		for i, part := range parts {
-			if part == "--api-socket" && i+1 < len(parts) {
-				socketPath = parts[i+1]
-				break
-			}
+		socketPath := vmmSocketPath(strings.Fields(line))
+		if socketPath != "" && runningSockets[socketPath] {
+			continue
 		}
+		suspicious = append(suspicious, suspiciousVMMProcess{line: line, socketPath: socketPath})
+	}
+	return suspicious
+}
 
-		// Check if this socket path matches any known instance
-		matched := false
-		if socketPath != "" {
-			// Socket path is typically like /var/lib/hypeman/guests/<id>/ch.sock
-			// Try to extract instance ID
-			if strings.Contains(socketPath, "/guests/") {
-				pathParts := strings.Split(socketPath, "/guests/")
-				if len(pathParts) > 1 {
-					instancePath := pathParts[1]
-					instanceID := strings.Split(instancePath, "/")[0]
-					if a.IsInstanceRunning(ctx, instanceID) {
-						matched = true
-					}
+// vmmSocketPath extracts the API socket path from a hypervisor's arguments:
+// --api-socket for Cloud Hypervisor, --api-sock for Firecracker, and the QMP
+// chardev's path for QEMU. Returns "" if there is none.
+func vmmSocketPath(args []string) string {
+	for i := 0; i+1 < len(args); i++ {
+		switch args[i] {
+		case "--api-socket", "--api-sock":
+			return args[i+1]
+		case "-chardev":
+			if !strings.HasPrefix(args[i+1], "socket,id=qmp,") {
+				continue
+			}
+			for _, opt := range strings.Split(args[i+1], ",") {
+				if path, ok := strings.CutPrefix(opt, "path="); ok {
+					return path
 				}
 			}
 		}
-
-		if !matched {
-			log.WarnContext(ctx, "detected untracked cloud-hypervisor process",
-				"process_info", line,
-				"socket_path", socketPath,
-				"remediation", "Run lib/devices/scripts/gpu-reset.sh for manual recovery if needed",
-			)
-			suspiciousCount++
-		}
 	}
-
-	return suspiciousCount
+	return ""
 }
 
@@ -0,0 +1,39 @@
+package instances
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUntrackedVMMProcesses(t *testing.T) {
+	output := `101 /var/lib/hypeman/system/binaries/cloud-hypervisor --api-socket /var/lib/hypeman/guests/inst-a/ch.sock --seccomp true
+202 /usr/bin/qemu-system-x86_64 -machine q35 -chardev socket,id=qmp,path=/var/lib/hypeman/guests/inst-b/qemu.sock,server=on,wait=off -mon chardev=qmp,mode=control
+303 /usr/bin/qemu-system-x86_64 -chardev socket,id=qmp,path=/var/lib/hypeman/guests/inst-gone/qemu.sock,server=on,wait=off
+404 cloud-hypervisor --api-socket /var/lib/hypeman/guests/inst-stopped/ch.sock
+`
+	running := map[string]bool{
+		"/var/lib/hypeman/guests/inst-a/ch.sock":   true,
+		"/var/lib/hypeman/guests/inst-b/qemu.sock": true,
+	}
+
+	suspicious := untrackedVMMProcesses(output, running)
+	require.Len(t, suspicious, 2)
+	assert.Equal(t, "/var/lib/hypeman/guests/inst-gone/qemu.sock", suspicious[0].socketPath)
+	assert.Equal(t, "/var/lib/hypeman/guests/inst-stopped/ch.sock", suspicious[1].socketPath)
+
+	// A process without a recognizable socket is always suspicious
+	suspicious = untrackedVMMProcesses("505 cloud-hypervisor --kernel vmlinux", running)
+	require.Len(t, suspicious, 1)
+	assert.Empty(t, suspicious[0].socketPath)
+}
+
+func TestVMMSocketPath(t *testing.T) {
+	assert.Equal(t, "/run/ch.sock", vmmSocketPath([]string{"cloud-hypervisor", "--api-socket", "/run/ch.sock"}))
+	assert.Equal(t, "/run/fc.sock", vmmSocketPath([]string{"firecracker", "--api-sock", "/run/fc.sock"}))
+	assert.Equal(t, "/run/qemu.sock", vmmSocketPath([]string{"qemu-system-x86_64", "-chardev", "socket,id=qmp,path=/run/qemu.sock,server=on,wait=off"}))
+	// Other QEMU chardevs (e.g. the serial console) are not the API socket
+	assert.Empty(t, vmmSocketPath([]string{"qemu-system-x86_64", "-chardev", "socket,id=serial,path=/run/serial.sock"}))
+	assert.Empty(t, vmmSocketPath([]string{"cloud-hypervisor", "--api-socket"}))
+}
@@ -2,12 +2,18 @@ package instances
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/onkernel/hypeman/lib/logger"
 )
@@ -16,8 +22,13 @@ import (
 type LogSource string
 
 const (
-	// LogSourceApp is the guest application log (serial console)
+	// LogSourceApp is the guest application's output (virtio console, or
+	// the serial console where the hypervisor has no virtio console)
 	LogSourceApp LogSource = "app"
+	// LogSourceKernel is the guest kernel's messages (serial console)
+	LogSourceKernel LogSource = "kernel"
+	// LogSourceSystem is what guest init and the guest agent log (serial console)
+	LogSourceSystem LogSource = "system"
 	// LogSourceVMM is the Cloud Hypervisor VMM log
 	LogSourceVMM LogSource = "vmm"
 	// LogSourceHypeman is the hypeman operations log
@@ -30,6 +41,23 @@ var ErrTailNotFound = fmt.Errorf("tail command not found: required for log strea
 // ErrLogNotFound is returned when the requested log file doesn't exist
 var ErrLogNotFound = fmt.Errorf("log file not found")
 
+// kernelLogLine matches a kernel message on the serial console, which the
+// kernel prefixes with the time since boot: "[    0.000000] Linux version ..."
+var kernelLogLine = regexp.MustCompile(`^\[\s*\d+\.\d+\]`)
+
+// logFilter returns which lines of its log file a source keeps, or nil if it
+// keeps them all. The kernel and system sources share the serial console.
+func logFilter(source LogSource) func(line string) bool {
+	switch source {
+	case LogSourceKernel:
+		return kernelLogLine.MatchString
+	case LogSourceSystem:
+		return func(line string) bool { return !kernelLogLine.MatchString(line) }
+	default:
+		return nil
+	}
+}
+
 // streamInstanceLogs streams instance logs from the specified source
 // Returns last N lines, then continues following if follow=true
 func (m *manager) streamInstanceLogs(ctx context.Context, id string, tail int, follow bool, source LogSource) (<-chan string, error) {
@@ -54,6 +82,8 @@ func (m *manager) streamInstanceLogs(ctx context.Context, id string, tail int, f
 		logPath = m.paths.InstanceVMMLog(id)
 	case LogSourceHypeman:
 		logPath = m.paths.InstanceHypemanLog(id)
+	case LogSourceKernel, LogSourceSystem:
+		logPath = m.serialLogPath(id)
 	default:
 		// Default to app log for backwards compatibility
 		logPath = m.paths.InstanceAppLog(id)
@@ -64,8 +94,28 @@ func (m *manager) streamInstanceLogs(ctx context.Context, id string, tail int, f
 		return nil, ErrLogNotFound
 	}
 
-	// Build tail command
+	// Lines sent before tail's output
+	var earlier []string
 	args := []string{"-n", strconv.Itoa(tail)}
+	filter := logFilter(source)
+	if filter != nil {
+		// tail counts every line, so the last lines the source keeps are
+		// found by reading the live log, and tail carries on from there
+		lines, offset, err := readFilteredTail(logPath, tail, filter)
+		if err != nil {
+			return nil, fmt.Errorf("read log: %w", err)
+		}
+		earlier = lines
+		args = []string{"-c", "+" + strconv.FormatInt(offset+1, 10)}
+	} else if current, err := countLines(logPath); err == nil && current < tail {
+		// A tail longer than the live log continues into the rotated backups
+		earlier, err = readRotatedTail(logPath, tail-current)
+		if err != nil {
+			log.WarnContext(ctx, "failed to read rotated logs", "instance_id", id, "error", err)
+		}
+	}
+
+	// Build tail command
 	if follow {
 		args = append(args, "-f")
 	}
@@ -88,8 +138,19 @@ func (m *manager) streamInstanceLogs(ctx context.Context, id string, tail int, f
 		defer close(out)
 		defer cmd.Process.Kill()
 
+		for _, line := range earlier {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- line:
+			}
+		}
+
 		scanner := bufio.NewScanner(stdout)
 		for scanner.Scan() {
+			if filter != nil && !filter(scanner.Text()) {
+				continue
+			}
 			select {
 			case <-ctx.Done():
 				log.DebugContext(ctx, "log stream cancelled", "instance_id", id)
@@ -110,8 +171,9 @@ func (m *manager) streamInstanceLogs(ctx context.Context, id string, tail int, f
 }
 
 // rotateLogIfNeeded performs copytruncate rotation if file exceeds maxBytes
-// Keeps up to maxFiles old backups (.1, .2, etc.)
-func rotateLogIfNeeded(path string, maxBytes int64, maxFiles int) error {
+// Keeps up to maxFiles old backups (.1, .2, etc.). With compress, backups
+// from .2 on are gzipped (.2.gz); .1 stays plain as it is read most often.
+func rotateLogIfNeeded(path string, maxBytes int64, maxFiles int, compress bool) error {
 	info, err := os.Stat(path)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -124,17 +186,19 @@ func rotateLogIfNeeded(path string, maxBytes int64, maxFiles int) error {
 		return nil // Under limit, nothing to do
 	}
 
-	// Shift old backups (.1 -> .2, .2 -> .3, etc.)
+	// Shift old backups (.1 -> .2, .2.gz -> .3.gz, etc.)
 	for i := maxFiles; i >= 1; i-- {
-		oldPath := fmt.Sprintf("%s.%d", path, i)
-		newPath := fmt.Sprintf("%s.%d", path, i+1)
+		for _, ext := range []string{"", ".gz"} {
+			oldPath := fmt.Sprintf("%s.%d%s", path, i, ext)
+			newPath := fmt.Sprintf("%s.%d%s", path, i+1, ext)
 
-		if i == maxFiles {
-			// Delete the oldest backup
-			os.Remove(oldPath)
-		} else {
-			// Shift to next number
-			os.Rename(oldPath, newPath)
+			if i == maxFiles {
+				// Delete the oldest backup
+				os.Remove(oldPath)
+			} else {
+				// Shift to next number
+				os.Rename(oldPath, newPath)
+			}
 		}
 	}
 
@@ -162,5 +226,203 @@ func rotateLogIfNeeded(path string, maxBytes int64, maxFiles int) error {
 		return fmt.Errorf("truncate log: %w", err)
 	}
 
+	// Compress the backup that was just shifted out of .1
+	if compress && maxFiles >= 2 {
+		if err := gzipLog(path + ".2"); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("compress rotated log: %w", err)
+		}
+	}
+
 	return nil
 }
+
+// gzipLog compresses path to path.gz and removes path. The compressed file
+// keeps the original's modification time, which age-based retention uses.
+func gzipLog(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(path + ".gz")
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(dst)
+	_, err = io.Copy(zw, src)
+	if closeErr := zw.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path + ".gz")
+		return err
+	}
+
+	os.Chtimes(path+".gz", info.ModTime(), info.ModTime())
+	return os.Remove(path)
+}
+
+// rotatedLogPath returns the backup n of path, compressed or not, or "" if
+// there is none
+func rotatedLogPath(path string, n int) string {
+	for _, ext := range []string{"", ".gz"} {
+		backup := fmt.Sprintf("%s.%d%s", path, n, ext)
+		if _, err := os.Stat(backup); err == nil {
+			return backup
+		}
+	}
+	return ""
+}
+
+// readRotatedTail returns the last n lines of the rotated backups of path,
+// oldest first, reading backups newest first until it has enough and
+// decompressing gzipped ones
+func readRotatedTail(path string, n int) ([]string, error) {
+	var lines []string
+	for i := 1; len(lines) < n; i++ {
+		backup := rotatedLogPath(path, i)
+		if backup == "" {
+			break
+		}
+		backupLines, err := readLastLines(backup, n-len(lines))
+		if err != nil {
+			return nil, err
+		}
+		lines = append(backupLines, lines...)
+	}
+	return lines, nil
+}
+
+// readLastLines returns up to the last n lines of a plain or gzipped file
+func readLastLines(path string, n int) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("open %s: %w", filepath.Base(path), err)
+		}
+		defer zr.Close()
+		r = zr
+	}
+
+	// Keep a ring of the last n lines
+	ring := make([]string, 0, n)
+	start := 0
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(ring) < n {
+			ring = append(ring, scanner.Text())
+		} else {
+			ring[start] = scanner.Text()
+			start = (start + 1) % n
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read %s: %w", filepath.Base(path), err)
+	}
+	return append(ring[start:], ring[:start]...), nil
+}
+
+// readFilteredTail returns up to the last n lines of the file at path that
+// keep returns true for, and the offset after the last complete line read.
+// Rotated backups aren't read.
+func readFilteredTail(path string, n int, keep func(line string) bool) ([]string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+
+	var lines []string
+	var offset int64
+	br := bufio.NewReader(f)
+	for {
+		line, err := br.ReadString('\n')
+		if err == io.EOF {
+			// A partial last line is left for tail to finish
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += int64(len(line))
+		if line = strings.TrimRight(line, "\r\n"); keep(line) && n > 0 {
+			if len(lines) == n {
+				lines = lines[1:]
+			}
+			lines = append(lines, line)
+		}
+	}
+	return lines, offset, nil
+}
+
+// countLines returns the number of lines in a file
+func countLines(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	count := 0
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := f.Read(buf)
+		count += bytes.Count(buf[:n], []byte{'\n'})
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// pruneRotatedLogs deletes rotated backups of path (.1, .2.gz, etc.) beyond
+// retention.MaxFiles, or last written before retention.MaxAge ago. Rotation
+// only deletes the one backup pushed past maxFiles, so this also cleans up
+// after the limit is lowered.
+func pruneRotatedLogs(path string, retention LogRetention, now time.Time) error {
+	backups, err := filepath.Glob(path + ".*")
+	if err != nil {
+		return fmt.Errorf("list rotated logs: %w", err)
+	}
+
+	var lastErr error
+	for _, backup := range backups {
+		n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(backup, path+"."), ".gz"))
+		if err != nil || n < 1 {
+			continue // Not a rotated backup
+		}
+
+		expired := retention.MaxFiles > 0 && n > retention.MaxFiles
+		if !expired && retention.MaxAge > 0 {
+			info, err := os.Stat(backup)
+			if err != nil {
+				continue
+			}
+			expired = now.Sub(info.ModTime()) > retention.MaxAge
+		}
+		if expired {
+			if err := os.Remove(backup); err != nil && !os.IsNotExist(err) {
+				lastErr = fmt.Errorf("remove rotated log: %w", err)
+			}
+		}
+	}
+	return lastErr
+}
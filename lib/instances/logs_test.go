@@ -0,0 +1,156 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/vmconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneRotatedLogs(t *testing.T) {
+	now := time.Now()
+	logPath := filepath.Join(t.TempDir(), "app.log")
+
+	// app.log.N was rotated N days ago
+	writeLogs := func() {
+		require.NoError(t, os.WriteFile(logPath, []byte("current"), 0644))
+		for i := 1; i <= 4; i++ {
+			backup := fmt.Sprintf("%s.%d", logPath, i)
+			require.NoError(t, os.WriteFile(backup, []byte("old"), 0644))
+			mtime := now.Add(-time.Duration(i) * 24 * time.Hour)
+			require.NoError(t, os.Chtimes(backup, mtime, mtime))
+		}
+	}
+	remaining := func() []string {
+		matches, err := filepath.Glob(logPath + "*")
+		require.NoError(t, err)
+		for i := range matches {
+			matches[i] = filepath.Base(matches[i])
+		}
+		return matches
+	}
+
+	writeLogs()
+	require.NoError(t, pruneRotatedLogs(logPath, LogRetention{MaxAge: 60 * time.Hour}, now))
+	assert.Equal(t, []string{"app.log", "app.log.1", "app.log.2"}, remaining())
+
+	// Lowering the count cap removes the extra backups too
+	writeLogs()
+	require.NoError(t, pruneRotatedLogs(logPath, LogRetention{MaxFiles: 1}, now))
+	assert.Equal(t, []string{"app.log", "app.log.1"}, remaining())
+
+	// No limits keeps everything
+	writeLogs()
+	require.NoError(t, pruneRotatedLogs(logPath, LogRetention{}, now))
+	assert.Len(t, remaining(), 5)
+}
+
+func TestLogRetentionOverride(t *testing.T) {
+	global := LogRetention{MaxAge: 7 * 24 * time.Hour, MaxFiles: 3}
+
+	assert.Equal(t, global, global.Override(nil))
+	assert.Equal(t, LogRetention{MaxAge: 7 * 24 * time.Hour, MaxFiles: 10}, global.Override(&LogRetention{MaxFiles: 10}))
+	assert.Equal(t, LogRetention{MaxAge: time.Hour, MaxFiles: 3}, global.Override(&LogRetention{MaxAge: time.Hour}))
+}
+
+func TestRotateLogIfNeeded_Compress(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "app.log")
+
+	// Rotate four times, keeping up to three backups
+	for _, content := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		require.NoError(t, os.WriteFile(logPath, []byte(content), 0644))
+		require.NoError(t, rotateLogIfNeeded(logPath, 1, 3, true))
+	}
+
+	// The live log and .1 stay plain; older backups are gzipped
+	data, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	assert.Empty(t, data)
+	data, err = os.ReadFile(logPath + ".1")
+	require.NoError(t, err)
+	assert.Equal(t, "fourth\n", string(data))
+	assert.Equal(t, logPath+".2.gz", rotatedLogPath(logPath, 2))
+	assert.Equal(t, logPath+".3.gz", rotatedLogPath(logPath, 3))
+	assert.Empty(t, rotatedLogPath(logPath, 4))
+
+	lines, err := readLastLines(logPath+".3.gz", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"second"}, lines)
+}
+
+func TestReadRotatedTail(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "app.log")
+	for _, content := range []string{"1\n2\n3\n", "4\n5\n", "6\n7\n"} {
+		require.NoError(t, os.WriteFile(logPath, []byte(content), 0644))
+		require.NoError(t, rotateLogIfNeeded(logPath, 1, 5, true))
+	}
+
+	// Backups are read newest first and returned in log order
+	lines, err := readRotatedTail(logPath, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "4", "5", "6", "7"}, lines)
+
+	lines, err = readRotatedTail(logPath, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5", "6", "7"}, lines)
+
+	n, err := countLines(logPath + ".1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func TestStreamInstanceLogs_SerialConsoleSources(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	require.NoError(t, mgr.ensureDirectories("inst-logs"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "inst-logs", Name: "logs"}}))
+	require.NoError(t, os.MkdirAll(mgr.paths.InstanceLogs("inst-logs"), 0755))
+
+	collect := func(source LogSource, tail int) []string {
+		ch, err := mgr.StreamInstanceLogs(ctx, "inst-logs", tail, false, source)
+		require.NoError(t, err)
+		var lines []string
+		for line := range ch {
+			lines = append(lines, line)
+		}
+		return lines
+	}
+
+	// Without a system log, the serial console was written to the app log
+	require.NoError(t, os.WriteFile(mgr.paths.InstanceAppLog("inst-logs"), []byte("[    0.000000] Linux version 6.12\napp output\n"), 0644))
+	assert.Equal(t, []string{"[    0.000000] Linux version 6.12"}, collect(LogSourceKernel, 10))
+
+	serial := "[    0.000000] Linux version 6.12\n" +
+		vmconfig.BootStartedMarker + "\n" +
+		"2025-01-01T00:00:00Z [INFO] [mount] mounted devpts/shm\n" +
+		"[    0.912345] virtio_blk virtio1: [vda] 2097152 512-byte logical blocks\n" +
+		"2025/01/01 00:00:01 [guest-agent] listening on vsock port 2222\n" +
+		"[    1.500000] EXT4-fs (vdb): mounted filesystem\n" +
+		"2025-01-01T00:00:02Z [INFO] [exec] launch" // Partial line, still being written
+	require.NoError(t, os.WriteFile(mgr.paths.InstanceSystemLog("inst-logs"), []byte(serial), 0644))
+	require.NoError(t, os.WriteFile(mgr.paths.InstanceAppLog("inst-logs"), []byte("app output\n"), 0644))
+
+	assert.Equal(t, []string{"app output"}, collect(LogSourceApp, 10))
+	assert.Equal(t, []string{
+		"[    0.912345] virtio_blk virtio1: [vda] 2097152 512-byte logical blocks",
+		"[    1.500000] EXT4-fs (vdb): mounted filesystem",
+	}, collect(LogSourceKernel, 2))
+	assert.Equal(t, []string{
+		vmconfig.BootStartedMarker,
+		"2025-01-01T00:00:00Z [INFO] [mount] mounted devpts/shm",
+		"2025/01/01 00:00:01 [guest-agent] listening on vsock port 2222",
+		"2025-01-01T00:00:02Z [INFO] [exec] launch",
+	}, collect(LogSourceSystem, 10))
+
+	// Boot markers are read from the serial console
+	status, err := mgr.GetBootStatus(ctx, "inst-logs")
+	require.NoError(t, err)
+	assert.Equal(t, BootStateBooting, status.State)
+}
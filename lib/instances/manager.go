@@ -3,15 +3,21 @@ package instances
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/onkernel/hypeman/lib/devices"
 	"github.com/onkernel/hypeman/lib/hypervisor"
 	"github.com/onkernel/hypeman/lib/hypervisor/cloudhypervisor"
+	"github.com/onkernel/hypeman/lib/hypervisor/firecracker"
 	"github.com/onkernel/hypeman/lib/hypervisor/qemu"
 	"github.com/onkernel/hypeman/lib/images"
 	"github.com/onkernel/hypeman/lib/network"
+	"github.com/onkernel/hypeman/lib/pagination"
 	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/onkernel/hypeman/lib/refindex"
 	"github.com/onkernel/hypeman/lib/resources"
 	"github.com/onkernel/hypeman/lib/system"
 	"github.com/onkernel/hypeman/lib/volumes"
@@ -21,23 +27,99 @@ import (
 
 type Manager interface {
 	ListInstances(ctx context.Context) ([]Instance, error)
+	// ListInstancesPage returns one page of instances, sorted by created_at
+	// (default), name or state.
+	ListInstancesPage(ctx context.Context, req pagination.Request) (*pagination.Page[Instance], error)
 	CreateInstance(ctx context.Context, req CreateInstanceRequest) (*Instance, error)
+	// CreateInstances creates count instances from one template, all or nothing.
+	CreateInstances(ctx context.Context, req CreateInstanceRequest, count int) (*BatchCreateResult, error)
+	// CloneInstance creates a new instance from a copy of an existing one's disk.
+	CloneInstance(ctx context.Context, id string, req CloneInstanceRequest) (*Instance, error)
 	// GetInstance returns an instance by ID, name, or ID prefix.
 	// Lookup order: exact ID match -> exact name match -> ID prefix match.
 	// Returns ErrAmbiguousName if prefix matches multiple instances.
 	GetInstance(ctx context.Context, idOrName string) (*Instance, error)
 	DeleteInstance(ctx context.Context, id string) error
+	// DeleteOwnedInstance deletes an instance its caller created and manages,
+	// such as a builder VM, without the reference check: it has to go even
+	// if an ingress names it.
+	DeleteOwnedInstance(ctx context.Context, id string) error
+	// SnapshotAndDeleteInstance deletes an instance, keeping its disk and, if
+	// it was running, a standby snapshot of it. They are listed by
+	// ListPreservedSnapshots until PrunePreservedSnapshots removes them.
+	SnapshotAndDeleteInstance(ctx context.Context, id string) (*PreservedSnapshot, error)
+	// ListPreservedSnapshots returns the instances kept by
+	// SnapshotAndDeleteInstance, most recently preserved first.
+	ListPreservedSnapshots(ctx context.Context) ([]PreservedSnapshot, error)
+	// PrunePreservedSnapshots removes preserved instances older than maxAge.
+	PrunePreservedSnapshots(ctx context.Context, maxAge time.Duration) error
+	// ForceDeleteInstance deletes an instance without relying on its VMM,
+	// killing it by PID and releasing everything the instance held. Safe to
+	// re-run after a partial failure.
+	ForceDeleteInstance(ctx context.Context, id string) error
+	// SetReferenceChecker makes DeleteInstance and SnapshotAndDeleteInstance
+	// refuse, with ErrInUse, to delete an instance an ingress routes to.
+	SetReferenceChecker(checker ReferenceChecker)
+	// SetReferenceIndex makes the manager record in idx the volumes and
+	// devices each instance uses.
+	SetReferenceIndex(idx *refindex.Index)
+	// IndexedReferences returns the volumes and devices each instance uses.
+	IndexedReferences(ctx context.Context) (map[refindex.Ref][]refindex.Ref, error)
+	// Reconcile kills hypervisor processes of instances that no longer exist
+	// and removes instance directories without metadata. Run at startup,
+	// before any instance is created or started.
+	Reconcile(ctx context.Context) error
 	StandbyInstance(ctx context.Context, id string) (*Instance, error)
 	RestoreInstance(ctx context.Context, id string) (*Instance, error)
 	StopInstance(ctx context.Context, id string) (*Instance, error)
 	StartInstance(ctx context.Context, id string) (*Instance, error)
+	// ResetOverlay discards the changes written to an instance's overlay
+	// disk, rebooting a running instance with the same IP.
+	ResetOverlay(ctx context.Context, id string) (*Instance, error)
+	// CompactOverlay frees the host disk held by zeroed or discarded blocks
+	// of a stopped instance's overlay disk.
+	CompactOverlay(ctx context.Context, id string) (*CompactResult, error)
 	StreamInstanceLogs(ctx context.Context, id string, tail int, follow bool, source LogSource) (<-chan string, error)
-	RotateLogs(ctx context.Context, maxBytes int64, maxFiles int) error
+	// GetBootStatus reports how far the last boot got, and which phase failed
+	// if it did, from the markers on the instance's serial console.
+	GetBootStatus(ctx context.Context, id string) (*BootStatus, error)
+	RotateLogs(ctx context.Context, maxBytes int64, retention LogRetention, compress bool) error
+	// TrackExecSession marks an instance active for idle auto-stop until the
+	// returned func is called.
+	TrackExecSession(id string) func()
+	// StartExecSession writes the audit record of an exec session and, if it
+	// is recorded, opens the recording its output is written to.
+	StartExecSession(ctx context.Context, id string, req ExecSessionRequest) (*ExecSessionRecorder, error)
+	// ListExecSessions returns the exec sessions opened on an instance.
+	ListExecSessions(ctx context.Context, id string) ([]ExecSession, error)
+	// OpenExecRecording opens the asciicast recording of an exec session.
+	OpenExecRecording(ctx context.Context, id, sessionID string) (io.ReadCloser, error)
+	// StopIdleInstances stops instances that have been idle past their IdleTimeout.
+	StopIdleInstances(ctx context.Context) error
+	// SyncTime steps a running instance's guest clock to the host's, returning
+	// the correction applied (host minus guest).
+	SyncTime(ctx context.Context, id string) (time.Duration, error)
+	// SyncGuestClocks steps the guest clock of every running instance.
+	SyncGuestClocks(ctx context.Context) error
+	// GetTopology describes the host's CPU topology and NUMA layout and, if
+	// vcpus is above 0, the guest topology an instance of that size gets.
+	GetTopology(vcpus int) (*TopologyReport, error)
 	AttachVolume(ctx context.Context, id string, volumeId string, req AttachVolumeRequest) (*Instance, error)
 	DetachVolume(ctx context.Context, id string, volumeId string) (*Instance, error)
+	// AttachDevice hotplugs a passthrough device (by ID or name) into a running instance.
+	AttachDevice(ctx context.Context, id string, deviceRef string) (*Instance, error)
+	// DetachDevice hot-unplugs a passthrough device (by ID or name) from a running instance.
+	DetachDevice(ctx context.Context, id string, deviceRef string) (*Instance, error)
 	// ListInstanceAllocations returns resource allocations for all instances.
 	// Used by the resource manager for capacity tracking.
 	ListInstanceAllocations(ctx context.Context) ([]resources.InstanceAllocation, error)
+	// ListHypervisors returns the supported hypervisors with their versions and capabilities.
+	ListHypervisors(ctx context.Context) []HypervisorInfo
+	// DefaultHypervisor returns the hypervisor used when a request does not specify one.
+	DefaultHypervisor() hypervisor.Type
+	// WatchInstances streams the state changes of instances matching the
+	// selector until ctx is done, when the channel is closed.
+	WatchInstances(ctx context.Context, sel WatchSelector) <-chan Event
 }
 
 // ResourceLimits contains configurable resource limits for instances
@@ -50,44 +132,67 @@ type ResourceLimits struct {
 }
 
 type manager struct {
-	paths          *paths.Paths
-	imageManager   images.Manager
-	systemManager  system.Manager
-	networkManager network.Manager
-	deviceManager  devices.Manager
-	volumeManager  volumes.Manager
-	limits         ResourceLimits
-	instanceLocks  sync.Map      // map[string]*sync.RWMutex - per-instance locks
-	hostTopology   *HostTopology // Cached host CPU topology
-	metrics        *Metrics
+	paths           *paths.Paths
+	imageManager    images.Manager
+	systemManager   system.Manager
+	networkManager  network.Manager
+	deviceManager   devices.Manager
+	volumeManager   volumes.Manager
+	limits          ResourceLimits
+	stopGracePeriod time.Duration // Time to wait for a clean guest shutdown on stop (0 = skip)
+	console         ConsoleConfig
+	instanceLocks   sync.Map      // map[string]*sync.RWMutex - per-instance locks
+	hostTopology    *HostTopology // Cached host CPU topology
+	numaNodes       map[int][]int // Cached host NUMA node -> CPUs (nil if unavailable)
+	metrics         *Metrics
+	activity        activityTracker // Exec sessions and traffic counters for idle auto-stop
+	pendingNames    sync.Map        // map[string]struct{} - names of instances being created
+	pendingCIDs     sync.Map        // map[int64]struct{} - vsock CIDs of instances being created
+	events          eventBus        // State changes for WatchInstances
+
+	referencesMu sync.RWMutex
+	references   ReferenceChecker // Consulted before a delete (nil = unchecked)
+	refIndex     atomic.Pointer[refindex.Index]
 
 	// Hypervisor support
 	vmStarters        map[hypervisor.Type]hypervisor.VMStarter
+	hvClients         map[hypervisor.Type]func(socketPath string) (hypervisor.Hypervisor, error)
 	defaultHypervisor hypervisor.Type // Default hypervisor type when not specified in request
 }
 
 // NewManager creates a new instances manager.
 // If meter is nil, metrics are disabled.
+// stopGracePeriod is how long StopInstance waits for the guest to power off cleanly (0 = skip).
+// console configures the guest's serial console.
 // defaultHypervisor specifies which hypervisor to use when not specified in requests.
-func NewManager(p *paths.Paths, imageManager images.Manager, systemManager system.Manager, networkManager network.Manager, deviceManager devices.Manager, volumeManager volumes.Manager, limits ResourceLimits, defaultHypervisor hypervisor.Type, meter metric.Meter, tracer trace.Tracer) Manager {
+func NewManager(p *paths.Paths, imageManager images.Manager, systemManager system.Manager, networkManager network.Manager, deviceManager devices.Manager, volumeManager volumes.Manager, limits ResourceLimits, stopGracePeriod time.Duration, console ConsoleConfig, defaultHypervisor hypervisor.Type, meter metric.Meter, tracer trace.Tracer) Manager {
 	// Validate and default the hypervisor type
 	if defaultHypervisor == "" {
 		defaultHypervisor = hypervisor.TypeCloudHypervisor
 	}
 
 	m := &manager{
-		paths:          p,
-		imageManager:   imageManager,
-		systemManager:  systemManager,
-		networkManager: networkManager,
-		deviceManager:  deviceManager,
-		volumeManager:  volumeManager,
-		limits:         limits,
-		instanceLocks:  sync.Map{},
-		hostTopology:   detectHostTopology(), // Detect and cache host topology
+		paths:           p,
+		imageManager:    imageManager,
+		systemManager:   systemManager,
+		networkManager:  networkManager,
+		deviceManager:   deviceManager,
+		volumeManager:   volumeManager,
+		limits:          limits,
+		stopGracePeriod: stopGracePeriod,
+		console:         console,
+		instanceLocks:   sync.Map{},
+		hostTopology:    detectHostTopology(), // Detect and cache host topology
+		numaNodes:       detectNUMANodes(),
 		vmStarters: map[hypervisor.Type]hypervisor.VMStarter{
 			hypervisor.TypeCloudHypervisor: cloudhypervisor.NewStarter(),
 			hypervisor.TypeQEMU:            qemu.NewStarter(),
+			hypervisor.TypeFirecracker:     firecracker.NewStarter(),
+		},
+		hvClients: map[hypervisor.Type]func(string) (hypervisor.Hypervisor, error){
+			hypervisor.TypeCloudHypervisor: func(socketPath string) (hypervisor.Hypervisor, error) { return cloudhypervisor.New(socketPath) },
+			hypervisor.TypeQEMU:            func(socketPath string) (hypervisor.Hypervisor, error) { return qemu.New(socketPath) },
+			hypervisor.TypeFirecracker:     func(socketPath string) (hypervisor.Hypervisor, error) { return firecracker.New(socketPath) },
 		},
 		defaultHypervisor: defaultHypervisor,
 	}
@@ -106,14 +211,11 @@ func NewManager(p *paths.Paths, imageManager images.Manager, systemManager syste
 // getHypervisor creates a hypervisor client for the given socket and type.
 // Used for connecting to already-running VMs (e.g., for state queries).
 func (m *manager) getHypervisor(socketPath string, hvType hypervisor.Type) (hypervisor.Hypervisor, error) {
-	switch hvType {
-	case hypervisor.TypeCloudHypervisor:
-		return cloudhypervisor.New(socketPath)
-	case hypervisor.TypeQEMU:
-		return qemu.New(socketPath)
-	default:
+	newClient, ok := m.hvClients[hvType]
+	if !ok {
 		return nil, fmt.Errorf("unsupported hypervisor type: %s", hvType)
 	}
+	return newClient(socketPath)
 }
 
 // getVMStarter returns the VM starter for the given hypervisor type.
@@ -138,7 +240,7 @@ func (m *manager) CreateInstance(ctx context.Context, req CreateInstanceRequest)
 	// 1. ULID generation is unique
 	// 2. Filesystem mkdir is atomic per instance directory
 	// 3. Concurrent creates of different instances don't conflict
-	return m.createInstance(ctx, req)
+	return m.createInstance(ctx, req, nil)
 }
 
 // DeleteInstance stops and deletes an instance
@@ -147,7 +249,10 @@ func (m *manager) DeleteInstance(ctx context.Context, id string) error {
 	lock.Lock()
 	defer lock.Unlock()
 
-	err := m.deleteInstance(ctx, id)
+	if err := m.checkReferences(ctx, id); err != nil {
+		return err
+	}
+	err := m.deleteInstance(ctx, id, "")
 	if err == nil {
 		// Clean up the lock after successful deletion
 		m.instanceLocks.Delete(id)
@@ -155,6 +260,54 @@ func (m *manager) DeleteInstance(ctx context.Context, id string) error {
 	return err
 }
 
+// DeleteOwnedInstance stops and deletes an instance without checking what
+// routes to it
+func (m *manager) DeleteOwnedInstance(ctx context.Context, id string) error {
+	lock := m.getInstanceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	err := m.deleteInstance(ctx, id, "")
+	if err == nil {
+		m.instanceLocks.Delete(id)
+	}
+	return err
+}
+
+// SnapshotAndDeleteInstance deletes an instance, preserving its state
+func (m *manager) SnapshotAndDeleteInstance(ctx context.Context, id string) (*PreservedSnapshot, error) {
+	lock := m.getInstanceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := m.checkReferences(ctx, id); err != nil {
+		return nil, err
+	}
+	preserved, err := m.snapshotAndDeleteInstance(ctx, id)
+	if err == nil {
+		m.instanceLocks.Delete(id)
+	}
+	return preserved, err
+}
+
+// ForceDeleteInstance deletes an instance even when its VMM is wedged
+func (m *manager) ForceDeleteInstance(ctx context.Context, id string) error {
+	lock := m.getInstanceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	err := m.forceDeleteInstance(ctx, id)
+	if err == nil {
+		m.instanceLocks.Delete(id)
+	}
+	return err
+}
+
+// Reconcile cleans up instance state left behind by a crash
+func (m *manager) Reconcile(ctx context.Context) error {
+	return m.reconcile(ctx)
+}
+
 // StandbyInstance puts an instance in standby (pause, snapshot, delete VMM)
 func (m *manager) StandbyInstance(ctx context.Context, id string) (*Instance, error) {
 	lock := m.getInstanceLock(id)
@@ -187,6 +340,22 @@ func (m *manager) StartInstance(ctx context.Context, id string) (*Instance, erro
 	return m.startInstance(ctx, id)
 }
 
+// ResetOverlay resets an instance's overlay disk to empty
+func (m *manager) ResetOverlay(ctx context.Context, id string) (*Instance, error) {
+	lock := m.getInstanceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+	return m.resetOverlay(ctx, id)
+}
+
+// CompactOverlay reclaims unused space in a stopped instance's overlay disk
+func (m *manager) CompactOverlay(ctx context.Context, id string) (*CompactResult, error) {
+	lock := m.getInstanceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+	return m.compactOverlay(ctx, id)
+}
+
 // ListInstances returns all instances
 func (m *manager) ListInstances(ctx context.Context) ([]Instance, error) {
 	// No lock - eventual consistency is acceptable for list operations.
@@ -194,6 +363,19 @@ func (m *manager) ListInstances(ctx context.Context) ([]Instance, error) {
 	return m.listInstances(ctx)
 }
 
+// ListInstancesPage returns one page of instances
+func (m *manager) ListInstancesPage(ctx context.Context, req pagination.Request) (*pagination.Page[Instance], error) {
+	// No lock, as for ListInstances
+	return m.listInstancesPage(ctx, req)
+}
+
+// WatchInstances streams instance state changes. Events are published after
+// each transition completes, and a watcher that falls too far behind misses
+// events rather than holding up the operations publishing them.
+func (m *manager) WatchInstances(ctx context.Context, sel WatchSelector) <-chan Event {
+	return m.events.watch(ctx, sel)
+}
+
 // GetInstance returns an instance by ID, name, or ID prefix.
 // Lookup order: exact ID match -> exact name match -> ID prefix match.
 // Returns ErrAmbiguousName if prefix matches multiple instances.
@@ -252,38 +434,70 @@ func (m *manager) StreamInstanceLogs(ctx context.Context, id string, tail int, f
 	return m.streamInstanceLogs(ctx, id, tail, follow, source)
 }
 
-// RotateLogs rotates all instance logs (app, vmm, hypeman) that exceed maxBytes
-func (m *manager) RotateLogs(ctx context.Context, maxBytes int64, maxFiles int) error {
+// RotateLogs rotates all instance logs (app, system, vmm, hypeman) that exceed maxBytes,
+// then prunes rotated logs past the retention limits. Instances created with
+// their own LogRetention use it in place of the global one. With compress,
+// older backups are gzipped.
+func (m *manager) RotateLogs(ctx context.Context, maxBytes int64, retention LogRetention, compress bool) error {
 	instances, err := m.listInstances(ctx)
 	if err != nil {
 		return fmt.Errorf("list instances for rotation: %w", err)
 	}
 
 	var lastErr error
+	now := time.Now()
 	for _, inst := range instances {
-		// Rotate all three log types
+		instRetention := retention.Override(inst.LogRetention)
+
+		// Rotate every log type
 		logPaths := []string{
 			m.paths.InstanceAppLog(inst.Id),
+			m.paths.InstanceSystemLog(inst.Id),
 			m.paths.InstanceVMMLog(inst.Id),
 			m.paths.InstanceHypemanLog(inst.Id),
 		}
 		for _, logPath := range logPaths {
-			if err := rotateLogIfNeeded(logPath, maxBytes, maxFiles); err != nil {
+			if err := rotateLogIfNeeded(logPath, maxBytes, instRetention.MaxFiles, compress); err != nil {
 				lastErr = err // Continue with other logs, but track error
 			}
+			if err := pruneRotatedLogs(logPath, instRetention, now); err != nil {
+				lastErr = err
+			}
 		}
 	}
 	return lastErr
 }
 
-// AttachVolume attaches a volume to an instance (not yet implemented)
+// AttachVolume attaches a volume to an instance, hotplugging it if the instance is running
 func (m *manager) AttachVolume(ctx context.Context, id string, volumeId string, req AttachVolumeRequest) (*Instance, error) {
-	return nil, fmt.Errorf("attach volume not yet implemented")
+	lock := m.getInstanceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+	return m.attachVolume(ctx, id, volumeId, req)
 }
 
-// DetachVolume detaches a volume from an instance (not yet implemented)
+// DetachVolume detaches a volume from an instance, hot-unplugging it if the instance is running
 func (m *manager) DetachVolume(ctx context.Context, id string, volumeId string) (*Instance, error) {
-	return nil, fmt.Errorf("detach volume not yet implemented")
+	lock := m.getInstanceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+	return m.detachVolume(ctx, id, volumeId)
+}
+
+// AttachDevice hotplugs a passthrough device into a running instance
+func (m *manager) AttachDevice(ctx context.Context, id string, deviceRef string) (*Instance, error) {
+	lock := m.getInstanceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+	return m.attachDevice(ctx, id, deviceRef)
+}
+
+// DetachDevice hot-unplugs a passthrough device from a running instance
+func (m *manager) DetachDevice(ctx context.Context, id string, deviceRef string) (*Instance, error) {
+	lock := m.getInstanceLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+	return m.detachDevice(ctx, id, deviceRef)
 }
 
 // ListInstanceAllocations returns resource allocations for all instances.
@@ -328,3 +542,13 @@ func (m *manager) ListInstanceAllocations(ctx context.Context) ([]resources.Inst
 
 	return allocations, nil
 }
+
+// ListHypervisors returns the supported hypervisors with their versions and capabilities.
+func (m *manager) ListHypervisors(ctx context.Context) []HypervisorInfo {
+	return m.listHypervisors(ctx)
+}
+
+// DefaultHypervisor returns the hypervisor used when a request does not specify one.
+func (m *manager) DefaultHypervisor() hypervisor.Type {
+	return m.defaultHypervisor
+}
@@ -9,6 +9,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"syscall"
@@ -23,6 +24,7 @@ import (
 	"github.com/onkernel/hypeman/lib/images"
 	"github.com/onkernel/hypeman/lib/ingress"
 	"github.com/onkernel/hypeman/lib/network"
+	"github.com/onkernel/hypeman/lib/pagination"
 	"github.com/onkernel/hypeman/lib/paths"
 	"github.com/onkernel/hypeman/lib/system"
 	"github.com/onkernel/hypeman/lib/vmm"
@@ -57,7 +59,7 @@ func setupTestManager(t *testing.T) (*manager, string) {
 		MaxTotalVcpus:        0,                        // unlimited
 		MaxTotalMemory:       0,                        // unlimited
 	}
-	mgr := NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, "", nil, nil).(*manager)
+	mgr := NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, 5*time.Second, ConsoleConfig{}, "", nil, nil).(*manager)
 
 	// Register cleanup to kill any orphaned Cloud Hypervisor processes
 	t.Cleanup(func() {
@@ -771,7 +773,7 @@ func TestStorageOperations(t *testing.T) {
 		MaxTotalVcpus:        0,                        // unlimited
 		MaxTotalMemory:       0,                        // unlimited
 	}
-	manager := NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, "", nil, nil).(*manager)
+	manager := NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, 0, ConsoleConfig{}, "", nil, nil).(*manager)
 
 	// Test metadata doesn't exist initially
 	_, err := manager.loadMetadata("nonexistent")
@@ -824,6 +826,169 @@ func TestStorageOperations(t *testing.T) {
 	assert.ErrorIs(t, err, ErrNotFound)
 }
 
+func TestListInstancesPage(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	base := time.Now()
+	for i, name := range []string{"web", "api", "db"} {
+		id := "inst-" + name
+		require.NoError(t, mgr.ensureDirectories(id))
+		require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{
+			Id:        id,
+			Name:      name,
+			CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		}}))
+	}
+
+	names := func(page *pagination.Page[Instance]) []string {
+		var out []string
+		for _, inst := range page.Items {
+			out = append(out, inst.Name)
+		}
+		return out
+	}
+
+	// Oldest first by default
+	page, err := mgr.ListInstancesPage(ctx, pagination.Request{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web", "api", "db"}, names(page))
+	assert.Equal(t, 3, page.Total)
+	assert.Empty(t, page.NextCursor)
+
+	page, err = mgr.ListInstancesPage(ctx, pagination.Request{Limit: 2, Sort: "name"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"api", "db"}, names(page))
+	assert.Equal(t, StateStopped, page.Items[0].State)
+
+	page, err = mgr.ListInstancesPage(ctx, pagination.Request{Limit: 2, Sort: "name", Cursor: page.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web"}, names(page))
+
+	// Sorting by state derives it for every instance, ties go by ID
+	page, err = mgr.ListInstancesPage(ctx, pagination.Request{Sort: "-state"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web", "db", "api"}, names(page))
+
+	_, err = mgr.ListInstancesPage(ctx, pagination.Request{Sort: "image"})
+	assert.ErrorIs(t, err, pagination.ErrInvalidSort)
+}
+
+func TestDeriveState_UnknownReasons(t *testing.T) {
+	mgr, tmpDir := setupTestManager(t)
+	ctx := context.Background()
+
+	save := func(id string, pid *int) *StoredMetadata {
+		require.NoError(t, mgr.ensureDirectories(id))
+		stored := StoredMetadata{
+			Id:             id,
+			Name:           id,
+			DataDir:        mgr.paths.InstanceDir(id),
+			SocketPath:     filepath.Join(tmpDir, id+".sock"),
+			HypervisorType: hypervisor.TypeCloudHypervisor,
+			HypervisorPID:  pid,
+			CreatedAt:      time.Now(),
+		}
+		require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: stored}))
+		return &stored
+	}
+
+	// A VMM that exited without cleaning up leaves a stale socket
+	cmd := exec.Command("true")
+	require.NoError(t, cmd.Run())
+	deadPID := cmd.Process.Pid
+	stored := save("inst-crashed", &deadPID)
+	require.NoError(t, os.WriteFile(stored.SocketPath, nil, 0644))
+	inst, err := mgr.GetInstance(ctx, stored.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StateUnknown, inst.State)
+	assert.Equal(t, StateReasonVMMExited, inst.StateReason)
+	require.NotNil(t, inst.StateError)
+
+	// Without a PID to check, the VMM is only known to be unreachable
+	stored = save("inst-unreachable", nil)
+	require.NoError(t, os.WriteFile(stored.SocketPath, nil, 0644))
+	inst, err = mgr.GetInstance(ctx, stored.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StateReasonVMMUnreachable, inst.StateReason)
+
+	// A snapshot interrupted mid-write is neither standby nor stopped
+	stored = save("inst-snapshotting", nil)
+	snapshotDir := filepath.Join(stored.DataDir, "snapshots", "snapshot-latest")
+	require.NoError(t, os.MkdirAll(snapshotDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(snapshotDir, "state.json"), []byte("{"), 0644))
+	marker := filepath.Join(stored.DataDir, "snapshots", snapshotIncompleteMarker)
+	require.NoError(t, os.WriteFile(marker, nil, 0644))
+	inst, err = mgr.GetInstance(ctx, stored.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StateUnknown, inst.State)
+	assert.Equal(t, StateReasonSnapshotIncomplete, inst.StateReason)
+	assert.False(t, inst.HasSnapshot)
+
+	// Once the snapshot completes it is a regular standby instance
+	require.NoError(t, os.Remove(marker))
+	inst, err = mgr.GetInstance(ctx, stored.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StateStandby, inst.State)
+	assert.Empty(t, inst.StateReason)
+	assert.Nil(t, inst.StateError)
+}
+
+func TestForceDeleteInstance(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	vol, err := mgr.volumeManager.CreateVolume(ctx, volumes.CreateVolumeRequest{Name: "data", SizeGb: 1})
+	require.NoError(t, err)
+	attach := func(id string) []VolumeAttachment {
+		require.NoError(t, mgr.volumeManager.AttachVolume(ctx, vol.Id, volumes.AttachVolumeRequest{InstanceID: id, MountPath: "/data"}))
+		return []VolumeAttachment{{VolumeID: vol.Id, MountPath: "/data"}}
+	}
+
+	// A "VMM" whose command line points into the instance directory is killed
+	id := "inst-wedged"
+	require.NoError(t, mgr.ensureDirectories(id))
+	logPath := filepath.Join(mgr.paths.InstanceDir(id), "vmm.log")
+	require.NoError(t, os.WriteFile(logPath, nil, 0644))
+	vmmProc := exec.Command("tail", "-f", logPath)
+	require.NoError(t, vmmProc.Start())
+	pid := vmmProc.Process.Pid
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{
+		Id: id, Name: id, HypervisorPID: &pid, Volumes: attach(id),
+	}}))
+
+	require.NoError(t, mgr.ForceDeleteInstance(ctx, id))
+	assert.True(t, WaitForProcessExit(pid, time.Second))
+	assert.NoDirExists(t, mgr.paths.InstanceDir(id))
+	got, err := mgr.volumeManager.GetVolume(ctx, vol.Id)
+	require.NoError(t, err)
+	assert.Empty(t, got.Attachments)
+
+	// A PID that was reused by an unrelated process is left alone
+	other := exec.Command("sleep", "60")
+	require.NoError(t, other.Start())
+	t.Cleanup(func() { other.Process.Kill(); other.Wait() })
+	otherPID := other.Process.Pid
+	id = "inst-reused-pid"
+	require.NoError(t, mgr.ensureDirectories(id))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: id, Name: id, HypervisorPID: &otherPID}}))
+	require.NoError(t, mgr.ForceDeleteInstance(ctx, id))
+	assert.NoError(t, syscall.Kill(otherPID, 0))
+
+	// Unreadable metadata doesn't stop the cleanup, and a re-run after it
+	// completed finds nothing left
+	id = "inst-corrupt"
+	require.NoError(t, mgr.ensureDirectories(id))
+	attach(id)
+	require.NoError(t, os.WriteFile(mgr.paths.InstanceMetadata(id), []byte("{"), 0644))
+	require.NoError(t, mgr.ForceDeleteInstance(ctx, id))
+	assert.NoDirExists(t, mgr.paths.InstanceDir(id))
+	got, err = mgr.volumeManager.GetVolume(ctx, vol.Id)
+	require.NoError(t, err)
+	assert.Empty(t, got.Attachments)
+	assert.ErrorIs(t, mgr.ForceDeleteInstance(ctx, id), ErrNotFound)
+}
+
 func TestStandbyAndRestore(t *testing.T) {
 	// Require KVM access (don't skip, fail informatively)
 	if _, err := os.Stat("/dev/kvm"); os.IsNotExist(err) {
@@ -994,6 +1159,24 @@ func (r *testInstanceResolver) InstanceExists(ctx context.Context, nameOrID stri
 	return r.exists, nil
 }
 
+func (r *testInstanceResolver) WakeInstance(ctx context.Context, nameOrID string) error {
+	return nil
+}
+
+func (r *testInstanceResolver) InstanceInfo(ctx context.Context, nameOrID string) (string, string, error) {
+	if !r.exists {
+		return "", "", fmt.Errorf("instance not found: %s", nameOrID)
+	}
+	return "Running", "", nil
+}
+
+func (r *testInstanceResolver) CountResolvableInstances(ctx context.Context) (int, error) {
+	if r.ip == "" {
+		return 0, nil
+	}
+	return 1, nil
+}
+
 func (r *testInstanceResolver) ResolveInstance(ctx context.Context, nameOrID string) (string, string, error) {
 	if !r.exists {
 		return "", "", fmt.Errorf("instance not found: %s", nameOrID)
@@ -18,7 +18,11 @@ type Metrics struct {
 	standbyDuration  metric.Float64Histogram
 	stopDuration     metric.Float64Histogram
 	startDuration    metric.Float64Histogram
+	phaseDuration    metric.Float64Histogram
+	snapshotSize     metric.Int64Histogram
 	stateTransitions metric.Int64Counter
+	idleStops        metric.Int64Counter
+	cidCollisions    metric.Int64Counter
 	tracer           trace.Tracer
 }
 
@@ -69,6 +73,24 @@ func newInstanceMetrics(meter metric.Meter, tracer trace.Tracer, m *manager) (*M
 		return nil, err
 	}
 
+	phaseDuration, err := meter.Float64Histogram(
+		"hypeman_instances_snapshot_phase_duration_seconds",
+		metric.WithDescription("Time spent in each phase of putting an instance in standby or restoring it"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotSize, err := meter.Int64Histogram(
+		"hypeman_instances_snapshot_size_bytes",
+		metric.WithDescription("Size of the snapshot written when an instance goes into standby"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	stateTransitions, err := meter.Int64Counter(
 		"hypeman_instances_state_transitions_total",
 		metric.WithDescription("Total number of instance state transitions"),
@@ -77,6 +99,22 @@ func newInstanceMetrics(meter metric.Meter, tracer trace.Tracer, m *manager) (*M
 		return nil, err
 	}
 
+	idleStops, err := meter.Int64Counter(
+		"hypeman_instances_idle_stops_total",
+		metric.WithDescription("Total number of instances stopped or put in standby for exceeding their idle timeout"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cidCollisions, err := meter.Int64Counter(
+		"hypeman_instances_vsock_cid_collisions_total",
+		metric.WithDescription("Total number of instances whose hashed vsock CID was taken and got the next free one"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Register observable gauge for instance counts by state
 	instancesTotal, err := meter.Int64ObservableGauge(
 		"hypeman_instances_total",
@@ -126,7 +164,11 @@ func newInstanceMetrics(meter metric.Meter, tracer trace.Tracer, m *manager) (*M
 		standbyDuration:  standbyDuration,
 		stopDuration:     stopDuration,
 		startDuration:    startDuration,
+		phaseDuration:    phaseDuration,
+		snapshotSize:     snapshotSize,
 		stateTransitions: stateTransitions,
+		idleStops:        idleStops,
+		cidCollisions:    cidCollisions,
 		tracer:           tracer,
 	}, nil
 }
@@ -155,6 +197,34 @@ func (m *manager) recordDuration(ctx context.Context, histogram metric.Float64Hi
 	histogram.Record(ctx, duration, metric.WithAttributes(attrs...))
 }
 
+// recordPhaseDuration records how long one phase of a standby or restore
+// took, labelled with the operation and phase.
+func (m *manager) recordPhaseDuration(ctx context.Context, operation, phase string, duration time.Duration, hvType hypervisor.Type) {
+	if m.metrics == nil {
+		return
+	}
+	attrs := []attribute.KeyValue{
+		attribute.String("operation", operation),
+		attribute.String("phase", phase),
+	}
+	if hvType != "" {
+		attrs = append(attrs, attribute.String("hypervisor", string(hvType)))
+	}
+	m.metrics.phaseDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
+}
+
+// recordSnapshotSize records the size of a standby snapshot with hypervisor label.
+func (m *manager) recordSnapshotSize(ctx context.Context, stats snapshotStats, hvType hypervisor.Type) {
+	if m.metrics == nil {
+		return
+	}
+	var attrs []attribute.KeyValue
+	if hvType != "" {
+		attrs = append(attrs, attribute.String("hypervisor", string(hvType)))
+	}
+	m.metrics.snapshotSize.Record(ctx, stats.MemoryBytes+stats.StateBytes, metric.WithAttributes(attrs...))
+}
+
 // recordStateTransition records a state transition with hypervisor label.
 func (m *manager) recordStateTransition(ctx context.Context, fromState, toState string, hvType hypervisor.Type) {
 	if m.metrics == nil {
@@ -169,3 +239,25 @@ func (m *manager) recordStateTransition(ctx context.Context, fromState, toState
 	}
 	m.metrics.stateTransitions.Add(ctx, 1, metric.WithAttributes(attrs...))
 }
+
+// recordIdleStop counts an idle auto-stop with action and hypervisor labels.
+func (m *manager) recordIdleStop(ctx context.Context, action IdleAction, hvType hypervisor.Type) {
+	if m.metrics == nil {
+		return
+	}
+	attrs := []attribute.KeyValue{
+		attribute.String("action", string(action)),
+	}
+	if hvType != "" {
+		attrs = append(attrs, attribute.String("hypervisor", string(hvType)))
+	}
+	m.metrics.idleStops.Add(ctx, 1, metric.WithAttributes(attrs...))
+}
+
+// recordVsockCIDCollision counts a new instance whose hashed vsock CID was taken.
+func (m *manager) recordVsockCIDCollision(ctx context.Context) {
+	if m.metrics == nil {
+		return
+	}
+	m.metrics.cidCollisions.Add(ctx, 1)
+}
@@ -0,0 +1,120 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/onkernel/hypeman/lib/devices"
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+// sysfsNodePath is the sysfs directory listing host NUMA nodes
+var sysfsNodePath = "/sys/devices/system/node"
+
+// detectNUMANodes reads the host CPUs belonging to each NUMA node.
+// Returns nil if NUMA information is unavailable.
+func detectNUMANodes() map[int][]int {
+	entries, err := os.ReadDir(sysfsNodePath)
+	if err != nil {
+		return nil
+	}
+
+	nodes := make(map[int][]int)
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, "node") {
+			continue
+		}
+		id, err := strconv.Atoi(strings.TrimPrefix(name, "node"))
+		if err != nil {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(sysfsNodePath, name, "cpulist"))
+		if err != nil {
+			continue
+		}
+		cpus, err := parseCPUList(strings.TrimSpace(string(data)))
+		if err != nil || len(cpus) == 0 {
+			// Memory-only nodes have no CPUs to pin to
+			continue
+		}
+		nodes[id] = cpus
+	}
+
+	if len(nodes) == 0 {
+		return nil
+	}
+	return nodes
+}
+
+// parseCPUList parses a kernel CPU list such as "0-3,8,10-11"
+func parseCPUList(list string) ([]int, error) {
+	var cpus []int
+	if list == "" {
+		return cpus, nil
+	}
+	for _, part := range strings.Split(list, ",") {
+		lo, hi, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(lo)
+		if err != nil {
+			return nil, fmt.Errorf("parse cpu %q: %w", part, err)
+		}
+		end := start
+		if isRange {
+			end, err = strconv.Atoi(hi)
+			if err != nil {
+				return nil, fmt.Errorf("parse cpu range %q: %w", part, err)
+			}
+		}
+		for cpu := start; cpu <= end; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	return cpus, nil
+}
+
+// selectNUMANode picks the host NUMA node for an instance from the locality of
+// its passthrough devices. Returns nil when there is nothing to optimize (single-node
+// host, no devices with a known node) or locality cannot be honored.
+func (m *manager) selectNUMANode(ctx context.Context, devs []*devices.Device, vcpus int) *int {
+	log := logger.FromContext(ctx)
+
+	if len(m.numaNodes) < 2 || len(devs) == 0 {
+		return nil
+	}
+
+	var node *int
+	for _, device := range devs {
+		if device.NUMANode == nil {
+			continue
+		}
+		if node != nil && *node != *device.NUMANode {
+			log.WarnContext(ctx, "devices span multiple NUMA nodes, not binding instance to a node",
+				"nodes", []int{*node, *device.NUMANode})
+			return nil
+		}
+		node = device.NUMANode
+	}
+	if node == nil {
+		log.WarnContext(ctx, "device NUMA node unknown, not binding instance to a node")
+		return nil
+	}
+
+	cpus, ok := m.numaNodes[*node]
+	if !ok {
+		log.WarnContext(ctx, "device NUMA node has no host CPUs, not binding instance to a node", "numa_node", *node)
+		return nil
+	}
+	if vcpus > len(cpus) {
+		log.WarnContext(ctx, "instance has more vCPUs than the device's NUMA node, not binding instance to a node",
+			"numa_node", *node, "vcpus", vcpus, "node_cpus", len(cpus))
+		return nil
+	}
+
+	log.InfoContext(ctx, "binding instance to device NUMA node", "numa_node", *node)
+	return node
+}
@@ -0,0 +1,64 @@
+package instances
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/devices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCPUList(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []int
+	}{
+		{"0", []int{0}},
+		{"0-3", []int{0, 1, 2, 3}},
+		{"0-1,8,10-11", []int{0, 1, 8, 10, 11}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			cpus, err := parseCPUList(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cpus)
+		})
+	}
+
+	_, err := parseCPUList("0-x")
+	assert.Error(t, err)
+}
+
+func TestSelectNUMANode(t *testing.T) {
+	node0, node1 := 0, 1
+	m := &manager{numaNodes: map[int][]int{0: {0, 1, 2, 3}, 1: {4, 5, 6, 7}}}
+	ctx := context.Background()
+
+	gpu := func(node *int) *devices.Device { return &devices.Device{NUMANode: node} }
+
+	tests := []struct {
+		name     string
+		devs     []*devices.Device
+		vcpus    int
+		expected *int
+	}{
+		{"no devices", nil, 2, nil},
+		{"device on node 1", []*devices.Device{gpu(&node1)}, 2, &node1},
+		{"unknown node ignored", []*devices.Device{gpu(nil), gpu(&node0)}, 2, &node0},
+		{"all unknown", []*devices.Device{gpu(nil)}, 2, nil},
+		{"devices on different nodes", []*devices.Device{gpu(&node0), gpu(&node1)}, 2, nil},
+		{"too many vcpus for node", []*devices.Device{gpu(&node1)}, 8, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, m.selectNUMANode(ctx, tt.devs, tt.vcpus))
+		})
+	}
+
+	single := &manager{numaNodes: map[int][]int{0: {0, 1, 2, 3}}}
+	assert.Nil(t, single.selectNUMANode(ctx, []*devices.Device{gpu(&node0)}, 2), "single-node hosts are not bound")
+}
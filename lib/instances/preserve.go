@@ -0,0 +1,199 @@
+package instances
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"syscall"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/hypervisor"
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+// PreservedSnapshot is a deleted instance whose state was kept, as a safety
+// net for deletes that turn out to be mistakes. The preserved directory is
+// the instance directory as it was at deletion: metadata, overlay disk,
+// logs and, for an instance that was running, its standby snapshot.
+type PreservedSnapshot struct {
+	InstanceID  string
+	Name        string
+	Image       string
+	Hypervisor  hypervisor.Type
+	HasMemory   bool  // Whether a memory snapshot was kept, or only the disks
+	SizeBytes   int64 // Disk space used by the preserved files
+	PreservedAt time.Time
+}
+
+// snapshotAndDeleteInstance puts a running instance in standby, then deletes
+// it, moving its directory to the preserved area. Volumes are detached as on
+// a normal delete; they outlive the instance anyway.
+func (m *manager) snapshotAndDeleteInstance(ctx context.Context, id string) (*PreservedSnapshot, error) {
+	log := logger.FromContext(ctx)
+
+	meta, err := m.loadMetadata(id)
+	if err != nil {
+		return nil, err
+	}
+	inst := m.toInstance(ctx, meta)
+
+	switch inst.State {
+	case StateRunning:
+		log.InfoContext(ctx, "snapshotting instance before delete", "instance_id", id)
+		if _, err := m.standbyInstance(ctx, id); err != nil {
+			return nil, fmt.Errorf("standby before delete: %w", err)
+		}
+	case StateStandby, StateStopped:
+	default:
+		return nil, fmt.Errorf("%w: cannot snapshot before delete from state %s", ErrInvalidState, inst.State)
+	}
+
+	if err := os.MkdirAll(m.paths.PreservedDir(), 0755); err != nil {
+		return nil, fmt.Errorf("create preserved directory: %w", err)
+	}
+	preserveDir := m.paths.PreservedInstanceDir(id)
+	if err := m.deleteInstance(ctx, id, preserveDir); err != nil {
+		return nil, err
+	}
+
+	size, err := diskUsage(preserveDir)
+	if err != nil {
+		log.WarnContext(ctx, "failed to measure preserved instance", "instance_id", id, "error", err)
+	}
+	preserved := &PreservedSnapshot{
+		InstanceID:  id,
+		Name:        inst.Name,
+		Image:       inst.Image,
+		Hypervisor:  inst.HypervisorType,
+		HasMemory:   inst.State == StateRunning || inst.State == StateStandby,
+		SizeBytes:   size,
+		PreservedAt: time.Now(),
+	}
+	data, err := json.MarshalIndent(preserved, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal preserved info: %w", err)
+	}
+	// The instance is already gone, so a missing info file only costs the
+	// listing: retention still finds the directory by its modification time
+	if err := os.WriteFile(m.paths.PreservedInfo(id), data, 0644); err != nil {
+		log.ErrorContext(ctx, "failed to write preserved info", "instance_id", id, "error", err)
+	}
+
+	log.InfoContext(ctx, "instance preserved", "instance_id", id, "dir", preserveDir, "has_memory", preserved.HasMemory, "size_bytes", size)
+	return preserved, nil
+}
+
+// ListPreservedSnapshots returns the preserved instances, newest first.
+// Directories without a readable preserved.json are skipped.
+func (m *manager) ListPreservedSnapshots(ctx context.Context) ([]PreservedSnapshot, error) {
+	entries, err := os.ReadDir(m.paths.PreservedDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []PreservedSnapshot{}, nil
+		}
+		return nil, fmt.Errorf("read preserved directory: %w", err)
+	}
+
+	preserved := []PreservedSnapshot{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		p, err := m.loadPreservedInfo(entry.Name())
+		if err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "skipping preserved instance", "instance_id", entry.Name(), "error", err)
+			continue
+		}
+		preserved = append(preserved, *p)
+	}
+	slices.SortFunc(preserved, func(a, b PreservedSnapshot) int {
+		return b.PreservedAt.Compare(a.PreservedAt)
+	})
+	return preserved, nil
+}
+
+// PrunePreservedSnapshots removes preserved instances older than maxAge. A
+// directory without a readable preserved.json is aged by its modification
+// time, so a partly written one is still collected.
+func (m *manager) PrunePreservedSnapshots(ctx context.Context, maxAge time.Duration) error {
+	log := logger.FromContext(ctx)
+
+	entries, err := os.ReadDir(m.paths.PreservedDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read preserved directory: %w", err)
+	}
+
+	var errs []error
+	now := time.Now()
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		id := entry.Name()
+
+		var preservedAt time.Time
+		if p, err := m.loadPreservedInfo(id); err == nil {
+			preservedAt = p.PreservedAt
+		} else if info, err := entry.Info(); err == nil {
+			preservedAt = info.ModTime()
+		} else {
+			errs = append(errs, fmt.Errorf("stat preserved instance %s: %w", id, err))
+			continue
+		}
+		if now.Sub(preservedAt) <= maxAge {
+			continue
+		}
+
+		if err := os.RemoveAll(m.paths.PreservedInstanceDir(id)); err != nil {
+			errs = append(errs, fmt.Errorf("remove preserved instance %s: %w", id, err))
+			continue
+		}
+		log.InfoContext(ctx, "removed expired preserved instance", "instance_id", id, "preserved_at", preservedAt)
+	}
+	return errors.Join(errs...)
+}
+
+// loadPreservedInfo reads a preserved instance's preserved.json
+func (m *manager) loadPreservedInfo(id string) (*PreservedSnapshot, error) {
+	data, err := os.ReadFile(m.paths.PreservedInfo(id))
+	if err != nil {
+		return nil, fmt.Errorf("read preserved info: %w", err)
+	}
+	var p PreservedSnapshot
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("unmarshal preserved info: %w", err)
+	}
+	return &p, nil
+}
+
+// diskUsage returns the disk space used by the files under path. Overlay
+// disks are sparse, so this counts allocated blocks rather than file sizes.
+func diskUsage(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if st, ok := info.Sys().(*syscall.Stat_t); ok {
+			size += st.Blocks * 512 // Blocks are in 512-byte units
+		} else {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
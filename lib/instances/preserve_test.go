@@ -0,0 +1,69 @@
+package instances
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotAndDeleteInstance_Stopped(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	require.NoError(t, mgr.ensureDirectories("inst-keep"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "inst-keep", Name: "keep", Image: "docker.io/library/alpine:latest"}}))
+	require.NoError(t, os.WriteFile(mgr.paths.InstanceOverlay("inst-keep"), []byte("overlay"), 0644))
+
+	preserved, err := mgr.SnapshotAndDeleteInstance(ctx, "inst-keep")
+	require.NoError(t, err)
+	assert.Equal(t, "keep", preserved.Name)
+	assert.False(t, preserved.HasMemory, "a stopped instance has no memory to snapshot")
+
+	// The instance is gone, its disk moved to the preserved area
+	_, err = mgr.GetInstance(ctx, "inst-keep")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoDirExists(t, mgr.paths.InstanceDir("inst-keep"))
+	data, err := os.ReadFile(filepath.Join(mgr.paths.PreservedInstanceDir("inst-keep"), "overlay.raw"))
+	require.NoError(t, err)
+	assert.Equal(t, "overlay", string(data))
+
+	list, err := mgr.ListPreservedSnapshots(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "inst-keep", list[0].InstanceID)
+}
+
+func TestPrunePreservedSnapshots(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	writePreserved := func(id string, age time.Duration) {
+		require.NoError(t, os.MkdirAll(mgr.paths.PreservedInstanceDir(id), 0755))
+		data, err := json.Marshal(PreservedSnapshot{InstanceID: id, PreservedAt: time.Now().Add(-age)})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(mgr.paths.PreservedInfo(id), data, 0644))
+	}
+	writePreserved("inst-new", time.Hour)
+	writePreserved("inst-old", 48*time.Hour)
+
+	// A directory whose info was never written is aged by its mtime
+	require.NoError(t, os.MkdirAll(mgr.paths.PreservedInstanceDir("inst-partial"), 0755))
+	old := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, os.Chtimes(mgr.paths.PreservedInstanceDir("inst-partial"), old, old))
+
+	list, err := mgr.ListPreservedSnapshots(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "inst-new", list[0].InstanceID, "newest first")
+
+	require.NoError(t, mgr.PrunePreservedSnapshots(ctx, 24*time.Hour))
+	assert.DirExists(t, mgr.paths.PreservedInstanceDir("inst-new"))
+	assert.NoDirExists(t, mgr.paths.PreservedInstanceDir("inst-old"))
+	assert.NoDirExists(t, mgr.paths.PreservedInstanceDir("inst-partial"))
+}
@@ -55,7 +55,7 @@ func setupTestManagerForQEMU(t *testing.T) (*manager, string) {
 		MaxTotalVcpus:        0,                        // unlimited
 		MaxTotalMemory:       0,                        // unlimited
 	}
-	mgr := NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, hypervisor.TypeQEMU, nil, nil).(*manager)
+	mgr := NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, 0, ConsoleConfig{}, hypervisor.TypeQEMU, nil, nil).(*manager)
 
 	// Register cleanup to kill any orphaned QEMU processes
 	t.Cleanup(func() {
@@ -149,6 +149,24 @@ func (r *qemuInstanceResolver) InstanceExists(ctx context.Context, nameOrID stri
 	return r.exists, nil
 }
 
+func (r *qemuInstanceResolver) WakeInstance(ctx context.Context, nameOrID string) error {
+	return nil
+}
+
+func (r *qemuInstanceResolver) InstanceInfo(ctx context.Context, nameOrID string) (string, string, error) {
+	if !r.exists {
+		return "", "", fmt.Errorf("instance not found: %s", nameOrID)
+	}
+	return "Running", "", nil
+}
+
+func (r *qemuInstanceResolver) CountResolvableInstances(ctx context.Context) (int, error) {
+	if r.ip == "" {
+		return 0, nil
+	}
+	return 1, nil
+}
+
 func (r *qemuInstanceResolver) ResolveInstance(ctx context.Context, nameOrID string) (string, string, error) {
 	if !r.exists {
 		return "", "", fmt.Errorf("instance not found: %s", nameOrID)
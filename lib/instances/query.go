@@ -5,25 +5,43 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"syscall"
+	"time"
 
 	"github.com/onkernel/hypeman/lib/hypervisor"
 	"github.com/onkernel/hypeman/lib/logger"
+	"github.com/onkernel/hypeman/lib/pagination"
 )
 
+// vmmQueryTimeout bounds the state query, so a wedged VMM reads as Unknown
+// instead of hanging every request that looks at the instance
+const vmmQueryTimeout = 5 * time.Second
+
 // stateResult holds the result of state derivation
 type stateResult struct {
-	State State
-	Error *string // Non-nil if state couldn't be determined
+	State  State
+	Error  *string     // Non-nil if state couldn't be determined
+	Reason StateReason // Set if state couldn't be determined
+}
+
+// unknownState returns an Unknown state result with its reason and message
+func unknownState(reason StateReason, format string, args ...any) stateResult {
+	errMsg := fmt.Sprintf(format, args...)
+	return stateResult{State: StateUnknown, Error: &errMsg, Reason: reason}
 }
 
 // deriveState determines instance state by checking socket and querying the hypervisor.
-// Returns StateUnknown with an error message if the socket exists but hypervisor is unreachable.
+// Returns StateUnknown with an error message and reason if the socket exists but
+// the hypervisor is unreachable, or a standby snapshot was left half-written.
 func (m *manager) deriveState(ctx context.Context, stored *StoredMetadata) stateResult {
 	log := logger.FromContext(ctx)
 
 	// 1. Check if socket exists
 	if _, err := os.Stat(stored.SocketPath); err != nil {
 		// No socket - check for snapshot to distinguish Stopped vs Standby
+		if snapshotIncomplete(stored.DataDir) {
+			return unknownState(StateReasonSnapshotIncomplete, "standby snapshot was interrupted before it completed")
+		}
 		if m.hasSnapshot(stored.DataDir) {
 			return stateResult{State: StateStandby}
 		}
@@ -34,25 +52,34 @@ func (m *manager) deriveState(ctx context.Context, stored *StoredMetadata) state
 	hv, err := m.getHypervisor(stored.SocketPath, stored.HypervisorType)
 	if err != nil {
 		// Failed to create client - this is unexpected if socket exists
-		errMsg := fmt.Sprintf("failed to create hypervisor client: %v", err)
 		log.WarnContext(ctx, "failed to determine instance state",
 			"instance_id", stored.Id,
 			"socket", stored.SocketPath,
 			"error", err,
 		)
-		return stateResult{State: StateUnknown, Error: &errMsg}
+		return unknownState(StateReasonHypervisorClient, "failed to create hypervisor client: %v", err)
 	}
 
-	info, err := hv.GetVMInfo(ctx)
+	queryCtx, cancel := context.WithTimeout(ctx, vmmQueryTimeout)
+	defer cancel()
+	info, err := hv.GetVMInfo(queryCtx)
 	if err != nil {
-		// Socket exists but hypervisor is unreachable - this is unexpected
-		errMsg := fmt.Sprintf("failed to query hypervisor: %v", err)
+		// Socket exists but hypervisor is unreachable - this is unexpected.
+		// A dead VMM process leaves its socket behind when it crashes.
+		reason := StateReasonVMMUnreachable
+		if stored.HypervisorPID != nil && !processAlive(*stored.HypervisorPID) {
+			reason = StateReasonVMMExited
+		}
 		log.WarnContext(ctx, "failed to query hypervisor state",
 			"instance_id", stored.Id,
 			"socket", stored.SocketPath,
+			"reason", reason,
 			"error", err,
 		)
-		return stateResult{State: StateUnknown, Error: &errMsg}
+		if reason == StateReasonVMMExited {
+			return unknownState(reason, "hypervisor process %d has exited, its socket is stale: %v", *stored.HypervisorPID, err)
+		}
+		return unknownState(reason, "failed to query hypervisor: %v", err)
 	}
 
 	// 3. Map hypervisor state to our state
@@ -67,17 +94,34 @@ func (m *manager) deriveState(ctx context.Context, stored *StoredMetadata) state
 		return stateResult{State: StateShutdown}
 	default:
 		// Unknown state - log and return Unknown
-		errMsg := fmt.Sprintf("unexpected hypervisor state: %s", info.State)
 		log.WarnContext(ctx, "hypervisor returned unexpected state",
 			"instance_id", stored.Id,
 			"hypervisor_state", info.State,
 		)
-		return stateResult{State: StateUnknown, Error: &errMsg}
+		return unknownState(StateReasonUnexpectedState, "unexpected hypervisor state: %s", info.State)
 	}
 }
 
-// hasSnapshot checks if a snapshot exists for an instance
+// processAlive reports whether a process with the given PID exists
+func processAlive(pid int) bool {
+	return syscall.Kill(pid, 0) == nil
+}
+
+// snapshotIncompleteMarker is created next to the latest snapshot while it
+// is being written and removed once it is complete
+const snapshotIncompleteMarker = "snapshot-latest.incomplete"
+
+// snapshotIncomplete reports whether a snapshot was interrupted mid-write
+func snapshotIncomplete(dataDir string) bool {
+	_, err := os.Stat(filepath.Join(dataDir, "snapshots", snapshotIncompleteMarker))
+	return err == nil
+}
+
+// hasSnapshot checks if a complete snapshot exists for an instance
 func (m *manager) hasSnapshot(dataDir string) bool {
+	if snapshotIncomplete(dataDir) {
+		return false
+	}
 	snapshotDir := filepath.Join(dataDir, "snapshots", "snapshot-latest")
 	info, err := os.Stat(snapshotDir)
 	if err != nil {
@@ -102,6 +146,7 @@ func (m *manager) toInstance(ctx context.Context, meta *metadata) Instance {
 		StoredMetadata: meta.StoredMetadata,
 		State:          result.State,
 		StateError:     result.Error,
+		StateReason:    result.Reason,
 		HasSnapshot:    m.hasSnapshot(meta.StoredMetadata.DataDir),
 	}
 	return inst
@@ -112,13 +157,65 @@ func (m *manager) listInstances(ctx context.Context) ([]Instance, error) {
 	log := logger.FromContext(ctx)
 	log.DebugContext(ctx, "listing all instances")
 
+	metas, err := m.listMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Instance, 0, len(metas))
+	for _, meta := range metas {
+		result = append(result, m.toInstance(ctx, meta))
+	}
+
+	log.DebugContext(ctx, "listed instances", "count", len(result))
+	return result, nil
+}
+
+// instanceSortKeys are the fields instances can be sorted by from their
+// metadata alone. Sorting by state has to derive every instance's state.
+var instanceSortKeys = pagination.SortKeys[*metadata]{
+	"created_at": func(meta *metadata) string { return pagination.TimeKey(meta.CreatedAt) },
+	"name":       func(meta *metadata) string { return meta.Name },
+}
+
+// listInstancesPage returns one page of instances, oldest first by default.
+// Unless sorting by state, only the instances on the page have their state
+// derived, which saves querying every hypervisor for a small page.
+func (m *manager) listInstancesPage(ctx context.Context, req pagination.Request) (*pagination.Page[Instance], error) {
+	metas, err := m.listMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pagination.SortField(req.Sort) == "state" {
+		insts := make([]Instance, len(metas))
+		for i, meta := range metas {
+			insts[i] = m.toInstance(ctx, meta)
+		}
+		return pagination.Paginate(insts, req, pagination.SortKeys[Instance]{
+			"state": func(inst Instance) string { return string(inst.State) },
+		}, "state", func(inst Instance) string { return inst.Id })
+	}
+
+	page, err := pagination.Paginate(metas, req, instanceSortKeys, "created_at", func(meta *metadata) string { return meta.Id })
+	if err != nil {
+		return nil, err
+	}
+	return pagination.Map(page, func(meta *metadata) Instance { return m.toInstance(ctx, meta) }), nil
+}
+
+// listMetadata loads the metadata of all instances, skipping any that
+// can't be read
+func (m *manager) listMetadata(ctx context.Context) ([]*metadata, error) {
+	log := logger.FromContext(ctx)
+
 	files, err := m.listMetadataFiles()
 	if err != nil {
 		log.ErrorContext(ctx, "failed to list metadata files", "error", err)
 		return nil, err
 	}
 
-	result := make([]Instance, 0, len(files))
+	metas := make([]*metadata, 0, len(files))
 	for _, file := range files {
 		// Extract instance ID from path
 		// Path format: {dataDir}/guests/{id}/metadata.json
@@ -130,13 +227,9 @@ func (m *manager) listInstances(ctx context.Context) ([]Instance, error) {
 			log.WarnContext(ctx, "skipping instance with invalid metadata", "instance_id", id, "error", err)
 			continue
 		}
-
-		inst := m.toInstance(ctx, meta)
-		result = append(result, inst)
+		metas = append(metas, meta)
 	}
-
-	log.DebugContext(ctx, "listed instances", "count", len(result))
-	return result, nil
+	return metas, nil
 }
 
 // getInstance returns a single instance by ID
@@ -0,0 +1,171 @@
+package instances
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+// vmmProcessPattern matches the process names of every supported hypervisor.
+// pgrep matches against the kernel's 15-character comm, which cuts
+// cloud-hypervisor short.
+const vmmProcessPattern = "^(cloud-hyperviso|qemu-system|firecracker)"
+
+// vmmProcess is a running hypervisor process serving a hypeman instance
+type vmmProcess struct {
+	PID        int
+	InstanceID string
+	Cmdline    string
+}
+
+// instanceReconcileStats tracks reconciliation metrics
+type instanceReconcileStats struct {
+	untrackedVMMs  int
+	vmmsKilled     int
+	strayDirs      int
+	strayDirsFreed int
+	errors         int
+}
+
+// reconcile cleans up instance state left behind by a crash or an interrupted
+// delete. It must run at startup, before any instance is created or started:
+//  1. Kills hypervisor processes that serve an instance directory but aren't
+//     the VMM recorded in that instance's metadata (metadata deleted, or a
+//     VMM that was started but never recorded)
+//  2. Removes instance directories that have no metadata, along with their
+//     overlay and config disks
+func (m *manager) reconcile(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+	log.InfoContext(ctx, "reconciling instance state")
+
+	var stats instanceReconcileStats
+	// Instances whose VMM is still running, so their directory is in use
+	live := make(map[string]bool)
+
+	// Phase 1: Kill untracked VMMs
+	procs, err := m.listVMMProcesses()
+	if err != nil {
+		log.WarnContext(ctx, "failed to list hypervisor processes, skipping VMM reconciliation", "error", err)
+		stats.errors++
+	}
+	for _, proc := range procs {
+		meta, err := m.loadMetadata(proc.InstanceID)
+		if err == nil && meta.HypervisorPID != nil && *meta.HypervisorPID == proc.PID {
+			live[proc.InstanceID] = true
+			continue
+		}
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			// Unreadable metadata may still belong to a live instance, so
+			// leave its VMM for a force delete to handle
+			log.WarnContext(ctx, "failed to load metadata for hypervisor process, leaving it running",
+				"instance_id", proc.InstanceID,
+				"pid", proc.PID,
+				"error", err,
+			)
+			stats.errors++
+			live[proc.InstanceID] = true
+			continue
+		}
+
+		stats.untrackedVMMs++
+		log.WarnContext(ctx, "killing untracked hypervisor process",
+			"instance_id", proc.InstanceID,
+			"pid", proc.PID,
+			"process_info", proc.Cmdline,
+		)
+		pid := proc.PID
+		m.killHypervisor(ctx, &Instance{StoredMetadata: StoredMetadata{Id: proc.InstanceID, HypervisorPID: &pid}})
+		// A VMM left by a previous hypeman isn't our child to reap, so wait
+		// for it to go away
+		if !WaitForProcessExit(pid, 5*time.Second) {
+			log.ErrorContext(ctx, "untracked hypervisor process survived SIGKILL", "instance_id", proc.InstanceID, "pid", pid)
+			stats.errors++
+			live[proc.InstanceID] = true
+			continue
+		}
+		stats.vmmsKilled++
+	}
+
+	// Phase 2: Garbage-collect instance directories without metadata
+	entries, err := os.ReadDir(m.paths.GuestsDir())
+	if err != nil && !os.IsNotExist(err) {
+		log.WarnContext(ctx, "failed to read guests directory, skipping stray directory cleanup", "error", err)
+		stats.errors++
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		id := entry.Name()
+		if _, err := os.Stat(m.paths.InstanceMetadata(id)); !os.IsNotExist(err) || live[id] {
+			continue
+		}
+
+		stats.strayDirs++
+		log.WarnContext(ctx, "removing instance directory without metadata", "instance_id", id)
+		if err := m.deleteInstanceData(id); err != nil {
+			log.ErrorContext(ctx, "failed to remove stray instance directory", "instance_id", id, "error", err)
+			stats.errors++
+			continue
+		}
+		stats.strayDirsFreed++
+	}
+
+	log.InfoContext(ctx, "instance reconciliation complete",
+		"untracked_vmms", stats.untrackedVMMs,
+		"vmms_killed", stats.vmmsKilled,
+		"stray_dirs", stats.strayDirs,
+		"stray_dirs_removed", stats.strayDirsFreed,
+		"errors", stats.errors,
+	)
+	return nil
+}
+
+// listVMMProcesses returns the hypervisor processes serving an instance
+// directory of this hypeman
+func (m *manager) listVMMProcesses() ([]vmmProcess, error) {
+	output, err := exec.Command("pgrep", "-a", vmmProcessPattern).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			// No processes matched
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseVMMProcesses(string(output), m.paths.GuestsDir()), nil
+}
+
+// parseVMMProcesses parses `pgrep -a` output, keeping the processes whose
+// command line points into guestsDir. Every hypervisor is started with its
+// API socket inside the instance directory.
+func parseVMMProcesses(output, guestsDir string) []vmmProcess {
+	prefix := guestsDir + "/"
+	var procs []vmmProcess
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		pidStr, cmdline, ok := strings.Cut(line, " ")
+		if !ok {
+			continue
+		}
+		pid, err := strconv.Atoi(pidStr)
+		if err != nil {
+			continue
+		}
+		_, rest, ok := strings.Cut(cmdline, prefix)
+		if !ok {
+			continue
+		}
+		id, _, _ := strings.Cut(rest, "/")
+		if id == "" {
+			continue
+		}
+		procs = append(procs, vmmProcess{PID: pid, InstanceID: id, Cmdline: cmdline})
+	}
+	return procs
+}
@@ -0,0 +1,44 @@
+package instances
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVMMProcesses(t *testing.T) {
+	output := `101 /var/lib/hypeman/system/binaries/cloud-hypervisor --api-socket /var/lib/hypeman/guests/inst-a/ch.sock
+202 /usr/bin/qemu-system-x86_64 -chardev socket,id=qmp,path=/var/lib/hypeman/guests/inst-b/qemu.sock,server=on,wait=off
+303 firecracker --api-sock /var/lib/hypeman/guests/inst-c/fc.sock
+404 cloud-hypervisor --api-socket /tmp/someone-else.sock
+`
+	procs := parseVMMProcesses(output, "/var/lib/hypeman/guests")
+	require.Len(t, procs, 3)
+	assert.Equal(t, 101, procs[0].PID)
+	assert.Equal(t, "inst-a", procs[0].InstanceID)
+	assert.Equal(t, "inst-b", procs[1].InstanceID)
+	assert.Equal(t, "inst-c", procs[2].InstanceID)
+
+	assert.Empty(t, parseVMMProcesses("", "/var/lib/hypeman/guests"))
+}
+
+func TestReconcile_StrayDirectories(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	// A tracked instance is kept
+	require.NoError(t, mgr.ensureDirectories("inst-kept"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "inst-kept", Name: "kept"}}))
+
+	// A directory whose metadata was deleted, with disks left behind
+	require.NoError(t, mgr.ensureDirectories("inst-stray"))
+	require.NoError(t, os.WriteFile(mgr.paths.InstanceOverlay("inst-stray"), []byte("overlay"), 0644))
+	require.NoError(t, os.WriteFile(mgr.paths.InstanceConfigDisk("inst-stray"), []byte("config"), 0644))
+
+	require.NoError(t, mgr.Reconcile(ctx))
+	assert.FileExists(t, mgr.paths.InstanceMetadata("inst-kept"))
+	assert.NoDirExists(t, mgr.paths.InstanceDir("inst-stray"))
+}
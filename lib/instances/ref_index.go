@@ -0,0 +1,49 @@
+package instances
+
+import (
+	"context"
+
+	"github.com/onkernel/hypeman/lib/refindex"
+)
+
+// SetReferenceIndex sets the index that records which volumes and devices
+// each instance uses. It is kept up to date as metadata is saved.
+func (m *manager) SetReferenceIndex(idx *refindex.Index) {
+	m.refIndex.Store(idx)
+}
+
+// IndexedReferences returns the volumes and devices each instance uses, for
+// rebuilding the reference index
+func (m *manager) IndexedReferences(ctx context.Context) (map[refindex.Ref][]refindex.Ref, error) {
+	metas, err := m.listMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs := make(map[refindex.Ref][]refindex.Ref, len(metas))
+	for _, meta := range metas {
+		refs[refindex.Ref{Type: refindex.TypeInstance, ID: meta.Id}] = instanceReferences(meta)
+	}
+	return refs, nil
+}
+
+// indexReferences records what an instance uses. The index is rebuilt from
+// metadata at startup, so a failed write only leaves its file stale until then.
+func (m *manager) indexReferences(meta *metadata) {
+	m.refIndex.Load().Set(refindex.Ref{Type: refindex.TypeInstance, ID: meta.Id}, instanceReferences(meta))
+}
+
+// unindexReferences drops a deleted instance from the index
+func (m *manager) unindexReferences(id string) {
+	m.refIndex.Load().Remove(refindex.Ref{Type: refindex.TypeInstance, ID: id})
+}
+
+func instanceReferences(meta *metadata) []refindex.Ref {
+	var refs []refindex.Ref
+	for _, vol := range meta.Volumes {
+		refs = append(refs, refindex.Ref{Type: refindex.TypeVolume, ID: vol.VolumeID})
+	}
+	for _, dev := range meta.Devices {
+		refs = append(refs, refindex.Ref{Type: refindex.TypeDevice, ID: dev})
+	}
+	return refs
+}
@@ -0,0 +1,41 @@
+package instances
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/onkernel/hypeman/lib/refindex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferenceIndex_TracksInstanceVolumes(t *testing.T) {
+	mgr, tmpDir := setupTestManager(t)
+	ctx := context.Background()
+
+	idx, err := refindex.Open(filepath.Join(tmpDir, "references.json"))
+	require.NoError(t, err)
+	mgr.SetReferenceIndex(idx)
+
+	inst := refindex.Ref{Type: refindex.TypeInstance, ID: "inst-db"}
+	require.NoError(t, mgr.ensureDirectories("inst-db"))
+	meta := &metadata{StoredMetadata: StoredMetadata{Id: "inst-db", Name: "db", Image: "docker.io/library/postgres:16"}}
+	require.NoError(t, mgr.saveMetadata(meta))
+	assert.Empty(t, idx.GetDependents(ctx, refindex.TypeVolume, "vol-data"))
+
+	// Attaching a volume is saved to metadata, which updates the index
+	meta.Volumes = []VolumeAttachment{{VolumeID: "vol-data", MountPath: "/var/lib/postgresql"}}
+	require.NoError(t, mgr.saveMetadata(meta))
+	assert.Equal(t, []refindex.Ref{inst}, idx.GetDependents(ctx, refindex.TypeVolume, "vol-data"))
+
+	refs, err := mgr.IndexedReferences(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []refindex.Ref{{Type: refindex.TypeVolume, ID: "vol-data"}}, refs[inst])
+
+	require.NoError(t, mgr.DeleteInstance(ctx, "inst-db"))
+	assert.Empty(t, idx.GetDependents(ctx, refindex.TypeVolume, "vol-data"))
+	refs, err = mgr.IndexedReferences(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, refs, inst)
+}
@@ -0,0 +1,48 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ReferenceChecker reports what routes traffic to an instance, so deleting it
+// doesn't silently break routing. ingress.Manager implements it.
+type ReferenceChecker interface {
+	// IngressesTargeting returns the names of the ingresses that route to
+	// the instance, named by name or ID.
+	IngressesTargeting(ctx context.Context, name, id string) ([]string, error)
+}
+
+// SetReferenceChecker sets what DeleteInstance and SnapshotAndDeleteInstance
+// consult before deleting. Without one, deletes aren't checked.
+func (m *manager) SetReferenceChecker(checker ReferenceChecker) {
+	m.referencesMu.Lock()
+	defer m.referencesMu.Unlock()
+	m.references = checker
+}
+
+// checkReferences returns ErrInUse, naming the ingresses, if any ingress
+// still routes to the instance
+func (m *manager) checkReferences(ctx context.Context, id string) error {
+	m.referencesMu.RLock()
+	checker := m.references
+	m.referencesMu.RUnlock()
+	if checker == nil {
+		return nil
+	}
+
+	meta, err := m.loadMetadata(id)
+	if err != nil {
+		return err
+	}
+	names, err := checker.IngressesTargeting(ctx, meta.Name, meta.Id)
+	if err != nil {
+		return fmt.Errorf("check ingresses routing to instance: %w", err)
+	}
+	if len(names) > 0 {
+		return fmt.Errorf("%w: routed to by ingress %s; delete the ingresses first, or delete with cascade or force",
+			ErrInUse, strings.Join(names, ", "))
+	}
+	return nil
+}
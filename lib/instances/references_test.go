@@ -0,0 +1,51 @@
+package instances
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReferences routes the given ingresses to every instance
+type fakeReferences []string
+
+func (f fakeReferences) IngressesTargeting(ctx context.Context, name, id string) ([]string, error) {
+	return f, nil
+}
+
+func TestDeleteInstance_RefusedWhileIngressRoutesToIt(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	require.NoError(t, mgr.ensureDirectories("inst-web"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "inst-web", Name: "web", Image: "docker.io/library/alpine:latest"}}))
+
+	mgr.SetReferenceChecker(fakeReferences{"web-public", "web-admin"})
+	err := mgr.DeleteInstance(ctx, "inst-web")
+	require.ErrorIs(t, err, ErrInUse)
+	assert.Contains(t, err.Error(), "web-public, web-admin")
+	_, err = mgr.SnapshotAndDeleteInstance(ctx, "inst-web")
+	require.ErrorIs(t, err, ErrInUse)
+	_, err = mgr.GetInstance(ctx, "inst-web")
+	require.NoError(t, err, "a refused delete leaves the instance")
+
+	mgr.SetReferenceChecker(fakeReferences{})
+	require.NoError(t, mgr.DeleteInstance(ctx, "inst-web"))
+	_, err = mgr.GetInstance(ctx, "inst-web")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestDeleteOwnedInstance_SkipsReferenceCheck(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	require.NoError(t, mgr.ensureDirectories("inst-builder"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "inst-builder", Name: "builder", Image: "docker.io/library/alpine:latest"}}))
+
+	mgr.SetReferenceChecker(fakeReferences{"builder-public"})
+	require.NoError(t, mgr.DeleteOwnedInstance(ctx, "inst-builder"))
+	_, err := mgr.GetInstance(ctx, "inst-builder")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,149 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/guest"
+	"github.com/onkernel/hypeman/lib/hypervisor"
+	"github.com/onkernel/hypeman/lib/images"
+	"github.com/onkernel/hypeman/lib/logger"
+	"github.com/onkernel/hypeman/lib/network"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// resetOverlay discards everything written to an instance's overlay disk.
+// A running instance is killed and booted again on its existing TAP device,
+// so it keeps its IP and MAC; a stopped one just gets the fresh overlay.
+// Volumes, including their per-instance overlays, are left alone.
+//
+// The caller holds the instance lock, so lookups by ID (and with them new exec
+// and log requests) wait until the reset is done. Exec sessions already open
+// would be cut off with the VM, so the reset is refused while there are any.
+func (m *manager) resetOverlay(ctx context.Context, id string) (*Instance, error) {
+	log := logger.FromContext(ctx)
+	log.InfoContext(ctx, "resetting instance overlay", "instance_id", id)
+
+	if m.metrics != nil && m.metrics.tracer != nil {
+		var span trace.Span
+		ctx, span = m.metrics.tracer.Start(ctx, "ResetOverlay")
+		defer span.End()
+	}
+
+	// 1. Load instance
+	meta, err := m.loadMetadata(id)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to load instance metadata", "instance_id", id, "error", err)
+		return nil, err
+	}
+	inst := m.toInstance(ctx, meta)
+	stored := &meta.StoredMetadata
+
+	// 2. Validate state. A standby snapshot holds guest memory that refers to
+	// the current overlay, so it can't survive a reset.
+	if inst.State != StateRunning && inst.State != StateStopped {
+		return nil, fmt.Errorf("%w: cannot reset overlay from state %s, must be Running or Stopped", ErrInvalidState, inst.State)
+	}
+	if n := m.activity.openSessions(id); n > 0 {
+		return nil, fmt.Errorf("%w: instance has %d open exec sessions", ErrInvalidState, n)
+	}
+
+	// 3. Collect what is needed to boot again before the VMM goes away
+	var netConfig *network.NetworkConfig
+	var imageInfo *images.Image
+	if inst.State == StateRunning {
+		imageInfo, err = m.imageManager.GetImage(ctx, stored.Image)
+		if err != nil {
+			return nil, fmt.Errorf("get image: %w", err)
+		}
+		if stored.NetworkEnabled {
+			alloc, err := m.networkManager.GetAllocation(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("get network allocation: %w", err)
+			}
+			if alloc != nil {
+				netConfig = &network.NetworkConfig{
+					IP:        alloc.IP,
+					MAC:       alloc.MAC,
+					Gateway:   alloc.Gateway,
+					Netmask:   alloc.Netmask,
+					TAPDevice: alloc.TAPDevice,
+				}
+			}
+		}
+
+		// 4. Kill the VMM; nothing on the overlay needs flushing. The TAP
+		// device stays, as the new VMM attaches to it.
+		if dialer, err := hypervisor.NewVsockDialer(inst.HypervisorType, inst.VsockSocket, inst.VsockCID); err == nil {
+			guest.CloseConn(dialer.Key())
+		}
+		log.DebugContext(ctx, "stopping hypervisor for overlay reset", "instance_id", id)
+		m.killHypervisor(ctx, &inst)
+		stored.HypervisorPID = nil
+	}
+
+	// 5. Create the fresh overlay next to the old one and swap it in, so a
+	// failed mkfs leaves the instance with its old disk
+	overlay := m.overlayPath(id, stored.OverlayFormat)
+	fresh := overlay + ".reset"
+	log.DebugContext(ctx, "creating fresh overlay disk", "instance_id", id, "size_bytes", stored.OverlaySize)
+	if err := createEmptyOverlay(fresh, stored.OverlaySize, stored.OverlayFormat); err != nil {
+		os.Remove(fresh)
+		return nil, m.afterFailedReset(ctx, stored, inst.State == StateRunning, fmt.Errorf("create overlay disk: %w", err))
+	}
+	if err := os.Rename(fresh, overlay); err != nil {
+		os.Remove(fresh)
+		return nil, m.afterFailedReset(ctx, stored, inst.State == StateRunning, fmt.Errorf("replace overlay disk: %w", err))
+	}
+
+	// 6. Boot again from the clean overlay. The config disk is unchanged, as
+	// the network configuration is.
+	if inst.State == StateRunning {
+		log.InfoContext(ctx, "booting VM from fresh overlay", "instance_id", id)
+		if err := m.startAndBootVM(ctx, stored, imageInfo, netConfig); err != nil {
+			log.ErrorContext(ctx, "failed to boot after overlay reset", "instance_id", id, "error", err)
+			return nil, m.afterFailedReset(ctx, stored, inst.State == StateRunning, err)
+		}
+		now := time.Now()
+		stored.StartedAt = &now
+	}
+
+	meta = &metadata{StoredMetadata: *stored}
+	if err := m.saveMetadata(meta); err != nil {
+		log.ErrorContext(ctx, "failed to save metadata", "instance_id", id, "error", err)
+		return nil, fmt.Errorf("save metadata: %w", err)
+	}
+	if inst.State == StateRunning {
+		m.publishEvent(EventRunning, stored, StateRunning, StateRunning)
+	}
+
+	finalInst := m.toInstance(ctx, meta)
+	log.InfoContext(ctx, "instance overlay reset", "instance_id", id, "state", finalInst.State)
+	return &finalInst, nil
+}
+
+// afterFailedReset handles a reset that failed. If the VMM was already
+// killed, the instance is left stopped with its TAP device released, ready
+// for a regular start. Returns err for the caller to return.
+func (m *manager) afterFailedReset(ctx context.Context, stored *StoredMetadata, wasRunning bool, err error) error {
+	if !wasRunning {
+		return err
+	}
+	log := logger.FromContext(ctx)
+
+	if stored.NetworkEnabled {
+		if alloc, allocErr := m.networkManager.GetAllocation(ctx, stored.Id); allocErr == nil && alloc != nil {
+			if relErr := m.networkManager.ReleaseAllocation(ctx, alloc); relErr != nil {
+				log.WarnContext(ctx, "failed to release network after failed reset", "instance_id", stored.Id, "error", relErr)
+			}
+		}
+	}
+	now := time.Now()
+	stored.StoppedAt = &now
+	if saveErr := m.saveMetadata(&metadata{StoredMetadata: *stored}); saveErr != nil {
+		log.WarnContext(ctx, "failed to save metadata after failed reset", "instance_id", stored.Id, "error", saveErr)
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+package instances
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetOverlay_Stopped(t *testing.T) {
+	if _, err := exec.LookPath("mkfs.ext4"); err != nil {
+		t.Skip("mkfs.ext4 not available")
+	}
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	require.NoError(t, mgr.ensureDirectories("inst-reset"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "inst-reset", Name: "reset", OverlaySize: 16 * 1024 * 1024}}))
+	overlay := mgr.paths.InstanceOverlay("inst-reset")
+	require.NoError(t, os.WriteFile(overlay, []byte("changes"), 0644))
+
+	inst, err := mgr.ResetOverlay(ctx, "inst-reset")
+	require.NoError(t, err)
+	assert.Equal(t, StateStopped, inst.State)
+
+	info, err := os.Stat(overlay)
+	require.NoError(t, err)
+	assert.Equal(t, int64(16*1024*1024), info.Size(), "overlay is recreated at its configured size")
+	assert.NoFileExists(t, overlay+".reset")
+}
+
+func TestResetOverlay_Refused(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	require.NoError(t, mgr.ensureDirectories("inst-busy"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "inst-busy", Name: "busy", DataDir: mgr.paths.InstanceDir("inst-busy")}}))
+	require.NoError(t, os.WriteFile(mgr.paths.InstanceOverlay("inst-busy"), []byte("changes"), 0644))
+
+	// An open exec session would be cut off
+	done := mgr.TrackExecSession("inst-busy")
+	_, err := mgr.ResetOverlay(ctx, "inst-busy")
+	assert.ErrorIs(t, err, ErrInvalidState)
+	done()
+
+	// A standby snapshot depends on the current overlay
+	require.NoError(t, os.MkdirAll(mgr.paths.InstanceSnapshotLatest("inst-busy"), 0755))
+	require.NoError(t, os.WriteFile(mgr.paths.InstanceSnapshotConfig("inst-busy"), []byte("{}"), 0644))
+	_, err = mgr.ResetOverlay(ctx, "inst-busy")
+	assert.ErrorIs(t, err, ErrInvalidState)
+
+	data, err := os.ReadFile(mgr.paths.InstanceOverlay("inst-busy"))
+	require.NoError(t, err)
+	assert.Equal(t, "changes", string(data))
+}
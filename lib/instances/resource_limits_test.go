@@ -2,6 +2,7 @@ package instances
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"syscall"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/onkernel/hypeman/cmd/api/config"
 	"github.com/onkernel/hypeman/lib/devices"
+	"github.com/onkernel/hypeman/lib/hypervisor"
 	"github.com/onkernel/hypeman/lib/images"
 	"github.com/onkernel/hypeman/lib/network"
 	"github.com/onkernel/hypeman/lib/paths"
@@ -19,18 +21,20 @@ import (
 )
 
 func TestValidateVolumeAttachments_MaxVolumes(t *testing.T) {
-	// Create 24 volumes (exceeds limit of 23)
-	volumes := make([]VolumeAttachment, 24)
+	// Create 55 volumes (exceeds limit of 54)
+	volumes := make([]VolumeAttachment, 55)
 	for i := range volumes {
 		volumes[i] = VolumeAttachment{
-			VolumeID:  "vol-" + string(rune('a'+i)),
-			MountPath: "/mnt/vol" + string(rune('a'+i)),
+			VolumeID:  fmt.Sprintf("vol-%d", i),
+			MountPath: fmt.Sprintf("/mnt/vol%d", i),
 		}
 	}
 
 	err := validateVolumeAttachments(volumes)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "cannot attach more than 23")
+	assert.Contains(t, err.Error(), "cannot attach more than 54")
+
+	assert.NoError(t, validateVolumeAttachments(volumes[:54]))
 }
 
 func TestValidateVolumeAttachments_SystemDirectory(t *testing.T) {
@@ -129,14 +133,13 @@ func TestValidateVolumeAttachments_OverlayValid(t *testing.T) {
 }
 
 func TestValidateVolumeAttachments_OverlayCountsAsTwoDevices(t *testing.T) {
-	// 12 regular volumes + 12 overlay volumes = 12 + 24 = 36 devices (exceeds 23)
-	// But let's be more precise: 11 overlay volumes = 22 devices, + 1 regular = 23 (at limit)
-	// 12 overlay volumes = 24 devices (exceeds limit)
-	volumes := make([]VolumeAttachment, 12)
+	// 27 overlay volumes = 54 devices (at limit)
+	// 28 overlay volumes = 56 devices (exceeds limit)
+	volumes := make([]VolumeAttachment, 28)
 	for i := range volumes {
 		volumes[i] = VolumeAttachment{
-			VolumeID:    "vol-" + string(rune('a'+i)),
-			MountPath:   "/mnt/vol" + string(rune('a'+i)),
+			VolumeID:    fmt.Sprintf("vol-%d", i),
+			MountPath:   fmt.Sprintf("/mnt/vol%d", i),
 			Readonly:    true,
 			Overlay:     true,
 			OverlaySize: 100 * 1024 * 1024,
@@ -145,7 +148,9 @@ func TestValidateVolumeAttachments_OverlayCountsAsTwoDevices(t *testing.T) {
 
 	err := validateVolumeAttachments(volumes)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "cannot attach more than 23")
+	assert.Contains(t, err.Error(), "cannot attach more than 54")
+
+	assert.NoError(t, validateVolumeAttachments(volumes[:27]))
 }
 
 // createTestManager creates a manager with specified limits for testing
@@ -163,7 +168,7 @@ func createTestManager(t *testing.T, limits ResourceLimits) *manager {
 	deviceMgr := devices.NewManager(p)
 	volumeMgr := volumes.NewManager(p, 0, nil)
 
-	return NewManager(p, imageMgr, systemMgr, networkMgr, deviceMgr, volumeMgr, limits, "", nil, nil).(*manager)
+	return NewManager(p, imageMgr, systemMgr, networkMgr, deviceMgr, volumeMgr, limits, 0, ConsoleConfig{}, "", nil, nil).(*manager)
 }
 
 func TestResourceLimits_StructValues(t *testing.T) {
@@ -267,7 +272,7 @@ func TestAggregateLimits_EnforcedAtRuntime(t *testing.T) {
 		MaxTotalMemory:       6 * 1024 * 1024 * 1024,   // aggregate: only 6GB total (allows first 2.5GB VM)
 	}
 
-	mgr := NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, "", nil, nil).(*manager)
+	mgr := NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, 0, ConsoleConfig{}, "", nil, nil).(*manager)
 
 	// Cleanup any orphaned processes on test end
 	t.Cleanup(func() {
@@ -378,3 +383,100 @@ func cleanupTestProcesses(t *testing.T, mgr *manager) {
 		}
 	}
 }
+
+func TestCreateInstance_DuplicateName(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+	req := CreateInstanceRequest{Name: "web", Image: "docker.io/library/alpine:latest"}
+
+	// A create still in progress holds its name
+	release, err := mgr.reserveName(ctx, "web")
+	require.NoError(t, err)
+	_, err = mgr.CreateInstance(ctx, req)
+	assert.ErrorIs(t, err, ErrNameExists)
+
+	// Once released the name is free again, and the create moves on to
+	// looking up the image
+	release()
+	_, err = mgr.CreateInstance(ctx, req)
+	assert.ErrorIs(t, err, images.ErrNotFound)
+
+	// An existing instance holds its name
+	require.NoError(t, mgr.ensureDirectories("existing"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "existing", Name: "web"}}))
+	_, err = mgr.CreateInstance(ctx, req)
+	assert.ErrorIs(t, err, ErrNameExists)
+	_, err = mgr.reserveName(ctx, "web")
+	assert.ErrorIs(t, err, ErrNameExists)
+}
+
+func TestAssignVolumeDevices(t *testing.T) {
+	vol := func(id string, overlay bool) VolumeAttachment {
+		return VolumeAttachment{VolumeID: id, MountPath: "/mnt/" + id, Readonly: overlay, Overlay: overlay}
+	}
+
+	firecracker := maxDisks(hypervisor.TypeFirecracker)
+	require.Equal(t, hypervisor.PrimaryBusDisks, firecracker)
+
+	assigned, err := assignVolumeDevices([]VolumeAttachment{vol("a", false), vol("b", true), vol("c", false)}, firecracker)
+	require.NoError(t, err)
+	assert.Equal(t, []volumeDevices{
+		{Device: "/dev/vdd", Serial: volumeSerial("a", false)},
+		{Device: "/dev/vde", Serial: volumeSerial("b", false), OverlayDevice: "/dev/vdf", OverlaySerial: volumeSerial("b", true)},
+		{Device: "/dev/vdg", Serial: volumeSerial("c", false)},
+	}, assigned)
+
+	// Serials follow the volume, not its position
+	reordered, err := assignVolumeDevices([]VolumeAttachment{vol("c", false), vol("a", false)}, firecracker)
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/vdd", reordered[0].Device)
+	assert.Equal(t, assigned[2].Serial, reordered[0].Serial)
+	assert.Equal(t, assigned[0].Serial, reordered[1].Serial)
+
+	// 21 plain volumes fill vdd-vdx; an overlay volume then takes vdy and vdz
+	vols := make([]VolumeAttachment, 0, 23)
+	for i := 0; i < 21; i++ {
+		vols = append(vols, vol(fmt.Sprintf("plain-%d", i), false))
+	}
+	vols = append(vols, vol("last", true))
+	assigned, err = assignVolumeDevices(vols, firecracker)
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/vdy", assigned[21].Device)
+	assert.Equal(t, "/dev/vdz", assigned[21].OverlayDevice)
+
+	// One more disk is past vdz, and the error names the volume that doesn't fit
+	_, err = assignVolumeDevices(append(vols, vol("extra", false)), firecracker)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "volume extra")
+	assert.Contains(t, err.Error(), "more than 23")
+
+	// An overlay volume whose base fits in vdz but whose overlay doesn't
+	plain := append(vols[:21:21], vol("plain-21", false))
+	_, err = assignVolumeDevices(append(plain, vol("split", true)), firecracker)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "volume split")
+
+	// Hypervisors with a second disk bus take volumes past vdz
+	assigned, err = assignVolumeDevices(append(vols, vol("extra", false)), maxDisks(hypervisor.TypeCloudHypervisor))
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/vdaa", assigned[22].Device)
+}
+
+func TestVolumeSerial(t *testing.T) {
+	serial := volumeSerial("vol-abc123", false)
+	assert.Len(t, serial, 20)
+	assert.Equal(t, serial, volumeSerial("vol-abc123", false))
+	assert.NotEqual(t, serial, volumeSerial("vol-abc123", true))
+	assert.NotEqual(t, serial, volumeSerial("vol-abc124", false))
+
+	assert.True(t, supportsDiskSerial(hypervisor.TypeCloudHypervisor))
+	assert.False(t, supportsDiskSerial(hypervisor.TypeFirecracker))
+}
+
+func TestGuestDiskName(t *testing.T) {
+	assert.Equal(t, "vda", guestDiskName(0))
+	assert.Equal(t, "vdz", guestDiskName(25))
+	assert.Equal(t, "vdaa", guestDiskName(26))
+	assert.Equal(t, "vdaz", guestDiskName(51))
+	assert.Equal(t, "vdba", guestDiskName(52))
+}
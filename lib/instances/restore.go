@@ -55,14 +55,16 @@ func (m *manager) restoreInstance(
 	snapshotDir := m.paths.InstanceSnapshotLatest(id)
 
 	// 4. Recreate TAP device if network enabled
+	var networkDuration time.Duration
 	if stored.NetworkEnabled {
+		networkStart := time.Now()
 		var networkSpan trace.Span
 		if m.metrics != nil && m.metrics.tracer != nil {
 			ctx, networkSpan = m.metrics.tracer.Start(ctx, "RestoreNetwork")
 		}
 		log.InfoContext(ctx, "recreating network for restore", "instance_id", id, "network", "default",
 			"download_bps", stored.NetworkBandwidthDownload, "upload_bps", stored.NetworkBandwidthUpload)
-		if err := m.networkManager.RecreateAllocation(ctx, id, stored.NetworkBandwidthDownload, stored.NetworkBandwidthUpload); err != nil {
+		if err := m.networkManager.RecreateAllocation(ctx, id, stored.NetworkBandwidthDownload, stored.NetworkBandwidthUpload, stored.MTU); err != nil {
 			if networkSpan != nil {
 				networkSpan.End()
 			}
@@ -72,6 +74,7 @@ func (m *manager) restoreInstance(
 		if networkSpan != nil {
 			networkSpan.End()
 		}
+		networkDuration = time.Since(networkStart)
 	}
 
 	// 5. Transition: Standby → Paused (start hypervisor + restore)
@@ -80,7 +83,9 @@ func (m *manager) restoreInstance(
 		ctx, restoreSpan = m.metrics.tracer.Start(ctx, "RestoreFromSnapshot")
 	}
 	log.InfoContext(ctx, "restoring from snapshot", "instance_id", id, "snapshot_dir", snapshotDir, "hypervisor", stored.HypervisorType)
+	loadStart := time.Now()
 	pid, hv, err := m.restoreFromSnapshot(ctx, stored, snapshotDir)
+	loadDuration := time.Since(loadStart)
 	if restoreSpan != nil {
 		restoreSpan.End()
 	}
@@ -103,6 +108,7 @@ func (m *manager) restoreInstance(
 		ctx, resumeSpan = m.metrics.tracer.Start(ctx, "ResumeVM")
 	}
 	log.InfoContext(ctx, "resuming VM", "instance_id", id)
+	resumeStart := time.Now()
 	if err := hv.Resume(ctx); err != nil {
 		if resumeSpan != nil {
 			resumeSpan.End()
@@ -119,6 +125,7 @@ func (m *manager) restoreInstance(
 	if resumeSpan != nil {
 		resumeSpan.End()
 	}
+	resumeDuration := time.Since(resumeStart)
 
 	// 8. Delete snapshot after successful restore
 	log.InfoContext(ctx, "deleting snapshot after successful restore", "instance_id", id)
@@ -137,12 +144,24 @@ func (m *manager) restoreInstance(
 	// Record metrics
 	if m.metrics != nil {
 		m.recordDuration(ctx, m.metrics.restoreDuration, start, "success", stored.HypervisorType)
+		if stored.NetworkEnabled {
+			m.recordPhaseDuration(ctx, "restore", "network", networkDuration, stored.HypervisorType)
+		}
+		m.recordPhaseDuration(ctx, "restore", "snapshot", loadDuration, stored.HypervisorType)
+		m.recordPhaseDuration(ctx, "restore", "resume", resumeDuration, stored.HypervisorType)
 		m.recordStateTransition(ctx, string(StateStandby), string(StateRunning), stored.HypervisorType)
 	}
+	m.publishEvent(EventRunning, stored, StateStandby, StateRunning)
 
 	// Return instance with derived state (should be Running now)
 	finalInst := m.toInstance(ctx, meta)
-	log.InfoContext(ctx, "instance restored successfully", "instance_id", id, "state", finalInst.State)
+	log.InfoContext(ctx, "instance restored successfully", "instance_id", id, "state", finalInst.State,
+		"duration", time.Since(start), "network", networkDuration, "snapshot", loadDuration, "resume", resumeDuration)
+
+	// The guest clock is frozen at the time of the snapshot; correct it
+	// without holding up the restore
+	go m.syncTimeAfterRestore(context.WithoutCancel(ctx), &finalInst)
+
 	return &finalInst, nil
 }
 
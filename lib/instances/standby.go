@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/onkernel/hypeman/lib/hypervisor"
@@ -73,15 +75,18 @@ func (m *manager) standbyInstance(
 
 	// 6. Transition: Running → Paused
 	log.DebugContext(ctx, "pausing VM", "instance_id", id)
+	pauseStart := time.Now()
 	if err := hv.Pause(ctx); err != nil {
 		log.ErrorContext(ctx, "failed to pause VM", "instance_id", id, "error", err)
 		return nil, fmt.Errorf("pause vm failed: %w", err)
 	}
+	pauseDuration := time.Since(pauseStart)
 
 	// 7. Create snapshot
 	snapshotDir := m.paths.InstanceSnapshotLatest(id)
 	log.DebugContext(ctx, "creating snapshot", "instance_id", id, "snapshot_dir", snapshotDir)
-	if err := createSnapshot(ctx, hv, snapshotDir); err != nil {
+	stats, err := createSnapshot(ctx, hv, snapshotDir)
+	if err != nil {
 		// Snapshot failed - try to resume VM
 		log.ErrorContext(ctx, "snapshot failed, attempting to resume VM", "instance_id", id, "error", err)
 		hv.Resume(ctx)
@@ -90,10 +95,12 @@ func (m *manager) standbyInstance(
 
 	// 8. Stop VMM gracefully (snapshot is complete)
 	log.DebugContext(ctx, "shutting down hypervisor", "instance_id", id)
+	shutdownStart := time.Now()
 	if err := m.shutdownHypervisor(ctx, &inst); err != nil {
 		// Log but continue - snapshot was created successfully
 		log.WarnContext(ctx, "failed to shutdown hypervisor gracefully, snapshot still valid", "instance_id", id, "error", err)
 	}
+	shutdownDuration := time.Since(shutdownStart)
 
 	// 9. Release network allocation (delete TAP device)
 	// TAP devices with explicit Owner/Group fields do NOT auto-delete when VMM exits
@@ -120,35 +127,135 @@ func (m *manager) standbyInstance(
 	// Record metrics
 	if m.metrics != nil {
 		m.recordDuration(ctx, m.metrics.standbyDuration, start, "success", stored.HypervisorType)
+		m.recordPhaseDuration(ctx, "standby", "pause", pauseDuration, stored.HypervisorType)
+		m.recordPhaseDuration(ctx, "standby", "snapshot", stats.Dump, stored.HypervisorType)
+		m.recordPhaseDuration(ctx, "standby", "disk_sync", stats.Sync, stored.HypervisorType)
+		m.recordPhaseDuration(ctx, "standby", "shutdown", shutdownDuration, stored.HypervisorType)
+		m.recordSnapshotSize(ctx, stats, stored.HypervisorType)
 		m.recordStateTransition(ctx, string(StateRunning), string(StateStandby), stored.HypervisorType)
 	}
+	m.publishEvent(EventStandby, stored, StateRunning, StateStandby)
 
 	// Return instance with derived state (should be Standby now)
 	finalInst := m.toInstance(ctx, meta)
-	log.InfoContext(ctx, "instance put in standby successfully", "instance_id", id, "state", finalInst.State)
+	log.InfoContext(ctx, "instance put in standby successfully", "instance_id", id, "state", finalInst.State,
+		"duration", time.Since(start), "pause", pauseDuration, "snapshot", stats.Dump, "disk_sync", stats.Sync,
+		"shutdown", shutdownDuration, "memory_bytes", stats.MemoryBytes, "device_state_bytes", stats.StateBytes)
 	return &finalInst, nil
 }
 
+// snapshotStats breaks down how long a snapshot took and what it wrote.
+// Hypervisors write guest memory and device state in one call, so the two
+// are told apart by size only.
+type snapshotStats struct {
+	Dump        time.Duration // Hypervisor writing guest memory and device state
+	Sync        time.Duration // Flushing the snapshot files to disk
+	MemoryBytes int64         // Guest memory dump
+	StateBytes  int64         // Device state and VM config
+}
+
 // createSnapshot creates a snapshot using the hypervisor interface
-func createSnapshot(ctx context.Context, hv hypervisor.Hypervisor, snapshotDir string) error {
+func createSnapshot(ctx context.Context, hv hypervisor.Hypervisor, snapshotDir string) (snapshotStats, error) {
 	log := logger.FromContext(ctx)
+	var stats snapshotStats
+
+	// Mark the snapshot incomplete until the hypervisor has written all of
+	// it, so a crash in between doesn't leave a half-written snapshot that
+	// looks restorable
+	marker := filepath.Join(filepath.Dir(snapshotDir), snapshotIncompleteMarker)
+	if err := os.MkdirAll(filepath.Dir(snapshotDir), 0755); err != nil {
+		return stats, fmt.Errorf("create snapshots dir: %w", err)
+	}
+	if err := os.WriteFile(marker, nil, 0644); err != nil {
+		return stats, fmt.Errorf("mark snapshot incomplete: %w", err)
+	}
 
 	// Remove old snapshot
 	os.RemoveAll(snapshotDir)
 
 	// Create snapshot directory
 	if err := os.MkdirAll(snapshotDir, 0755); err != nil {
-		return fmt.Errorf("create snapshot dir: %w", err)
+		return stats, fmt.Errorf("create snapshot dir: %w", err)
 	}
 
 	// Create snapshot via hypervisor API
 	log.DebugContext(ctx, "invoking hypervisor snapshot API", "snapshot_dir", snapshotDir)
+	dumpStart := time.Now()
 	if err := hv.Snapshot(ctx, snapshotDir); err != nil {
-		return fmt.Errorf("snapshot: %w", err)
+		// The VM is resumed, so drop the partial snapshot rather than
+		// leaving it to be mistaken for a standby snapshot later
+		os.RemoveAll(snapshotDir)
+		os.Remove(marker)
+		return stats, fmt.Errorf("snapshot: %w", err)
+	}
+	stats.Dump = time.Since(dumpStart)
+
+	// Flush the snapshot before marking it complete, so a host crash can't
+	// leave a complete-looking snapshot with its memory dump still in the
+	// page cache
+	syncStart := time.Now()
+	memoryBytes, stateBytes, err := syncSnapshot(snapshotDir)
+	if err != nil {
+		os.RemoveAll(snapshotDir)
+		os.Remove(marker)
+		return stats, fmt.Errorf("sync snapshot: %w", err)
+	}
+	stats.Sync = time.Since(syncStart)
+	stats.MemoryBytes = memoryBytes
+	stats.StateBytes = stateBytes
+
+	if err := os.Remove(marker); err != nil {
+		return stats, fmt.Errorf("mark snapshot complete: %w", err)
 	}
 
 	log.DebugContext(ctx, "snapshot created successfully", "snapshot_dir", snapshotDir)
-	return nil
+	return stats, nil
+}
+
+// syncSnapshot syncs every file in a snapshot directory to disk and returns
+// the bytes in its memory dump and in the rest of it. Memory dumps are the
+// files named memory* (memory-ranges for Cloud Hypervisor, memory for QEMU
+// and Firecracker).
+func syncSnapshot(snapshotDir string) (memoryBytes, stateBytes int64, err error) {
+	entries, err := os.ReadDir(snapshotDir)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		size, err := syncFile(filepath.Join(snapshotDir, entry.Name()))
+		if err != nil {
+			return 0, 0, err
+		}
+		if strings.HasPrefix(entry.Name(), "memory") {
+			memoryBytes += size
+		} else {
+			stateBytes += size
+		}
+	}
+	if _, err := syncFile(snapshotDir); err != nil {
+		return 0, 0, err
+	}
+	return memoryBytes, stateBytes, nil
+}
+
+// syncFile syncs a file or directory to disk and returns its size
+func syncFile(path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	if err := f.Sync(); err != nil {
+		return 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
 }
 
 // shutdownHypervisor gracefully shuts down the hypervisor process via API
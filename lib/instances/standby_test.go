@@ -0,0 +1,26 @@
+package instances
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncSnapshot_SplitsMemoryFromState(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory-ranges"), make([]byte, 4096), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "state.json"), []byte(`{"devices":[]}`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.json"), []byte(`{}`), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "subdir"), 0755))
+
+	memoryBytes, stateBytes, err := syncSnapshot(dir)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4096), memoryBytes)
+	assert.Equal(t, int64(16), stateBytes)
+
+	_, _, err = syncSnapshot(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
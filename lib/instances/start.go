@@ -64,6 +64,7 @@ func (m *manager) startInstance(
 		netConfig, err = m.networkManager.CreateAllocation(ctx, network.AllocateRequest{
 			InstanceID:   id,
 			InstanceName: stored.Name,
+			MTU:          stored.MTU,
 		})
 		if err != nil {
 			log.ErrorContext(ctx, "failed to allocate network", "instance_id", id, "error", err)
@@ -95,25 +96,30 @@ func (m *manager) startInstance(
 		log.ErrorContext(ctx, "failed to start and boot VM", "instance_id", id, "error", err)
 		return nil, err
 	}
+	cu.Add(func() {
+		m.killHypervisor(ctx, &Instance{StoredMetadata: *stored})
+	})
 
-	// Success - release cleanup stack (prevent cleanup)
-	cu.Release()
-
-	// 7. Update metadata (set PID, StartedAt)
+	// 7. Update metadata (set PID, StartedAt). A VMM whose PID isn't saved
+	// would be orphaned, so it is killed if the save fails.
 	now := time.Now()
 	stored.StartedAt = &now
 
 	meta = &metadata{StoredMetadata: *stored}
 	if err := m.saveMetadata(meta); err != nil {
-		// VM is running but metadata failed - log but don't fail
-		log.WarnContext(ctx, "failed to update metadata after VM start", "instance_id", id, "error", err)
+		log.ErrorContext(ctx, "failed to save metadata after VM start", "instance_id", id, "error", err)
+		return nil, fmt.Errorf("save metadata: %w", err)
 	}
 
+	// Success - release cleanup stack (prevent cleanup)
+	cu.Release()
+
 	// Record metrics
 	if m.metrics != nil {
 		m.recordDuration(ctx, m.metrics.startDuration, start, "success", stored.HypervisorType)
 		m.recordStateTransition(ctx, string(StateStopped), string(StateRunning), stored.HypervisorType)
 	}
+	m.publishEvent(EventRunning, stored, StateStopped, StateRunning)
 
 	// Return instance with derived state (should be Running now)
 	finalInst := m.toInstance(ctx, meta)
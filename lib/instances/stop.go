@@ -3,13 +3,19 @@ package instances
 import (
 	"context"
 	"fmt"
+	"syscall"
 	"time"
 
+	"github.com/onkernel/hypeman/lib/guest"
+	"github.com/onkernel/hypeman/lib/hypervisor"
 	"github.com/onkernel/hypeman/lib/logger"
 	"github.com/onkernel/hypeman/lib/network"
 	"go.opentelemetry.io/otel/trace"
 )
 
+// guestShutdownRPCTimeout bounds connecting to the guest agent and sending the shutdown request
+const guestShutdownRPCTimeout = 5 * time.Second
+
 // stopInstance gracefully stops a running instance
 // Multi-hop orchestration: Running → Shutdown → Stopped
 func (m *manager) stopInstance(
@@ -54,15 +60,25 @@ func (m *manager) stopInstance(
 		}
 	}
 
-	// 4. Shutdown hypervisor process
-	// TODO: Add graceful shutdown via vsock signal to allow app to clean up
-	log.DebugContext(ctx, "shutting down hypervisor", "instance_id", id)
-	if err := m.shutdownHypervisor(ctx, &inst); err != nil {
-		// Log but continue - try to clean up anyway
-		log.WarnContext(ctx, "failed to shutdown hypervisor gracefully", "instance_id", id, "error", err)
+	// 4. Ask the guest to power off cleanly so apps can flush and filesystems are synced
+	if !m.shutdownGuest(ctx, &inst) {
+		// 5. Fall back to shutting down the hypervisor process
+		log.DebugContext(ctx, "shutting down hypervisor", "instance_id", id)
+		if err := m.shutdownHypervisor(ctx, &inst); err != nil {
+			// Log but continue - try to clean up anyway
+			log.WarnContext(ctx, "failed to shutdown hypervisor gracefully", "instance_id", id, "error", err)
+		}
+
+		// Kill the VMM if it is still around after graceful shutdown
+		if inst.HypervisorPID != nil && !hypervisorExited(*inst.HypervisorPID) {
+			log.WarnContext(ctx, "hypervisor still running after shutdown, killing", "instance_id", id, "pid", *inst.HypervisorPID)
+			if err := m.killHypervisor(ctx, &inst); err != nil {
+				log.WarnContext(ctx, "failed to kill hypervisor", "instance_id", id, "error", err)
+			}
+		}
 	}
 
-	// 5. Release network allocation (delete TAP device)
+	// 6. Release network allocation (delete TAP device)
 	if inst.NetworkEnabled && networkAlloc != nil {
 		log.DebugContext(ctx, "releasing network", "instance_id", id, "network", "default")
 		if err := m.networkManager.ReleaseAllocation(ctx, networkAlloc); err != nil {
@@ -71,7 +87,7 @@ func (m *manager) stopInstance(
 		}
 	}
 
-	// 6. Update metadata (clear PID, set StoppedAt)
+	// 7. Update metadata (clear PID, set StoppedAt)
 	now := time.Now()
 	stored.StoppedAt = &now
 	stored.HypervisorPID = nil
@@ -87,9 +103,87 @@ func (m *manager) stopInstance(
 		m.recordDuration(ctx, m.metrics.stopDuration, start, "success", stored.HypervisorType)
 		m.recordStateTransition(ctx, string(StateRunning), string(StateStopped), stored.HypervisorType)
 	}
+	m.publishEvent(EventStopped, stored, StateRunning, StateStopped)
 
 	// Return instance with derived state (should be Stopped now)
 	finalInst := m.toInstance(ctx, meta)
 	log.InfoContext(ctx, "instance stopped successfully", "instance_id", id, "state", finalInst.State)
 	return &finalInst, nil
 }
+
+// shutdownGuest asks the guest agent to sync filesystems and power off, then
+// waits up to the stop grace period for the hypervisor process to exit.
+// Returns true if the hypervisor exited on its own.
+func (m *manager) shutdownGuest(ctx context.Context, inst *Instance) bool {
+	log := logger.FromContext(ctx)
+
+	if m.stopGracePeriod <= 0 || inst.HypervisorPID == nil {
+		return false
+	}
+
+	dialer, err := hypervisor.NewVsockDialer(inst.HypervisorType, inst.VsockSocket, inst.VsockCID)
+	if err != nil {
+		log.WarnContext(ctx, "failed to create vsock dialer for guest shutdown", "instance_id", inst.Id, "error", err)
+		return false
+	}
+	// The guest is going away, so never reuse this connection
+	defer guest.CloseConn(dialer.Key())
+
+	rpcCtx, cancel := context.WithTimeout(ctx, guestShutdownRPCTimeout)
+	defer cancel()
+
+	conn, err := guest.GetOrCreateConn(rpcCtx, dialer)
+	if err != nil {
+		log.WarnContext(ctx, "failed to connect to guest agent for shutdown", "instance_id", inst.Id, "error", err)
+		return false
+	}
+
+	// Leave part of the grace period for sync and power off after processes exit
+	guestTimeout := m.stopGracePeriod - m.stopGracePeriod/5
+	log.DebugContext(ctx, "requesting guest shutdown", "instance_id", inst.Id, "grace_period", m.stopGracePeriod)
+	if _, err := guest.NewGuestServiceClient(conn).Shutdown(rpcCtx, &guest.ShutdownRequest{
+		TimeoutSeconds: int32(guestTimeout / time.Second),
+	}); err != nil {
+		log.WarnContext(ctx, "guest shutdown request failed", "instance_id", inst.Id, "error", err)
+		return false
+	}
+
+	if !waitForHypervisorExit(*inst.HypervisorPID, m.stopGracePeriod) {
+		log.WarnContext(ctx, "guest did not shut down within grace period", "instance_id", inst.Id, "grace_period", m.stopGracePeriod)
+		return false
+	}
+
+	log.DebugContext(ctx, "guest shut down cleanly", "instance_id", inst.Id)
+	return true
+}
+
+// waitForHypervisorExit waits for the hypervisor process to exit, reaping it
+// if it is our child. Returns false if it is still running after timeout.
+func waitForHypervisorExit(pid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if hypervisorExited(pid) {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// hypervisorExited checks whether the hypervisor process has exited. The VMM
+// is started as our child, so after it exits it stays a zombie (and still
+// answers signal 0) until reaped.
+func hypervisorExited(pid int) bool {
+	var wstatus syscall.WaitStatus
+	wpid, err := syscall.Wait4(pid, &wstatus, syscall.WNOHANG, nil)
+	if wpid == pid {
+		return true
+	}
+	if err != nil {
+		// Not our child (e.g. hypeman restarted) - fall back to an existence check
+		return syscall.Kill(pid, 0) != nil
+	}
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 
 	"github.com/onkernel/hypeman/lib/images"
@@ -13,10 +14,12 @@ import (
 // {dataDir}/guests/{instance-id}/
 //   metadata.json      # Instance metadata
 //   overlay.raw        # Configurable sparse overlay disk (default 10GB)
+//   overlay.qcow2      # Overlay disk instead, when created as qcow2
 //   config.ext4        # Read-only config disk (generated)
 //   ch.sock            # Hypervisor API socket (abbreviated name for SUN_LEN limit)
 //   logs/
-//     app.log          # Guest application log (serial console output)
+//     app.log          # Guest application log (virtio console output; serial without one)
+//     system.log       # Guest kernel and init log (serial console output)
 //     vmm.log          # Hypervisor log (stdout+stderr combined)
 //     hypeman.log      # Hypeman operations log (actions taken on this instance)
 //   snapshots/
@@ -66,7 +69,9 @@ func (m *manager) loadMetadata(id string) (*metadata, error) {
 	return &meta, nil
 }
 
-// saveMetadata saves instance metadata to disk
+// saveMetadata saves instance metadata to disk. It is synced before
+// returning: a VMM is only started once the metadata that lets it be found
+// again after a crash is durable.
 func (m *manager) saveMetadata(meta *metadata) error {
 	metaPath := m.paths.InstanceMetadata(meta.Id)
 
@@ -75,17 +80,73 @@ func (m *manager) saveMetadata(meta *metadata) error {
 		return fmt.Errorf("marshal metadata: %w", err)
 	}
 
-	if err := os.WriteFile(metaPath, data, 0644); err != nil {
+	// Write to a temp file and rename, so a crash never leaves a torn file
+	tmpPath := metaPath + ".tmp"
+	if err := writeFileSync(tmpPath, data); err != nil {
+		os.Remove(tmpPath)
 		return fmt.Errorf("write metadata: %w", err)
 	}
+	if err := os.Rename(tmpPath, metaPath); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("write metadata: %w", err)
+	}
+	if dir, err := os.Open(filepath.Dir(metaPath)); err == nil {
+		dir.Sync() // Persist the rename; best effort
+		dir.Close()
+	}
+	m.indexReferences(meta)
 
 	return nil
 }
 
+// writeFileSync writes data to path and syncs it to disk
+func writeFileSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// overlayPath returns the path of an instance's overlay disk in the given format
+func (m *manager) overlayPath(id string, format OverlayFormat) string {
+	if format == OverlayFormatQcow2 {
+		return m.paths.InstanceOverlayQcow2(id)
+	}
+	return m.paths.InstanceOverlay(id)
+}
+
 // createOverlayDisk creates a sparse overlay disk for the instance
-func (m *manager) createOverlayDisk(id string, sizeBytes int64) error {
-	overlayPath := m.paths.InstanceOverlay(id)
-	return images.CreateEmptyExt4Disk(overlayPath, sizeBytes)
+func (m *manager) createOverlayDisk(id string, sizeBytes int64, format OverlayFormat) error {
+	return createEmptyOverlay(m.overlayPath(id, format), sizeBytes, format)
+}
+
+// createEmptyOverlay creates an empty ext4 overlay disk at path. A qcow2
+// overlay is formatted as a raw file first and then converted, which keeps
+// only the blocks mkfs wrote.
+func createEmptyOverlay(path string, sizeBytes int64, format OverlayFormat) error {
+	if format != OverlayFormatQcow2 {
+		return images.CreateEmptyExt4Disk(path, sizeBytes)
+	}
+
+	raw := path + ".tmp"
+	defer os.Remove(raw)
+	if err := images.CreateEmptyExt4Disk(raw, sizeBytes); err != nil {
+		return err
+	}
+	cmd := exec.Command("qemu-img", "convert", "-f", "raw", "-O", "qcow2", raw, path)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("qemu-img convert failed: %w, output: %s", err, output)
+	}
+	return nil
 }
 
 // createVolumeOverlayDisk creates a sparse overlay disk for a volume attachment.
@@ -110,6 +171,7 @@ func (m *manager) deleteInstanceData(id string) error {
 	if err := os.RemoveAll(instDir); err != nil {
 		return fmt.Errorf("remove instance directory: %w", err)
 	}
+	m.unindexReferences(id)
 
 	return nil
 }
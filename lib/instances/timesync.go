@@ -0,0 +1,98 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/guest"
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+const (
+	// guestTimeSyncRPCTimeout bounds one SyncTime call to the guest agent
+	guestTimeSyncRPCTimeout = 5 * time.Second
+
+	// restoreTimeSyncWindow is how long the clock sync after a restore keeps
+	// retrying while the guest agent's connection comes back
+	restoreTimeSyncWindow = 15 * time.Second
+
+	// clockDriftLogThreshold is the correction above which a periodic sync
+	// is logged
+	clockDriftLogThreshold = time.Second
+)
+
+// SyncTime steps the guest clock of a running instance to the host's and
+// returns how far it was off (host minus guest)
+func (m *manager) SyncTime(ctx context.Context, id string) (time.Duration, error) {
+	meta, err := m.loadMetadata(id)
+	if err != nil {
+		return 0, err
+	}
+	inst := m.toInstance(ctx, meta)
+	if inst.State != StateRunning {
+		return 0, fmt.Errorf("%w: cannot sync time in state %s", ErrInvalidState, inst.State)
+	}
+	return m.syncGuestTime(ctx, &inst)
+}
+
+// SyncGuestClocks steps the clock of every running instance to the host's.
+// Guests that don't answer are skipped until the next run.
+func (m *manager) SyncGuestClocks(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+
+	instances, err := m.listInstances(ctx)
+	if err != nil {
+		return fmt.Errorf("list instances for time sync: %w", err)
+	}
+	for _, inst := range instances {
+		if inst.State != StateRunning {
+			continue
+		}
+		offset, err := m.syncGuestTime(ctx, &inst)
+		if err != nil {
+			log.DebugContext(ctx, "guest time sync failed", "instance_id", inst.Id, "error", err)
+			continue
+		}
+		if offset.Abs() > clockDriftLogThreshold {
+			log.InfoContext(ctx, "corrected guest clock drift", "instance_id", inst.Id, "offset", offset)
+		}
+	}
+	return nil
+}
+
+// syncTimeAfterRestore steps the clock of a just-restored instance, which
+// resumes with the time it was paused at. It retries until the guest agent
+// answers, since the agent connection from before standby is stale.
+func (m *manager) syncTimeAfterRestore(ctx context.Context, inst *Instance) {
+	log := logger.FromContext(ctx)
+
+	deadline := time.Now().Add(restoreTimeSyncWindow)
+	for {
+		offset, err := m.syncGuestTime(ctx, inst)
+		if err == nil {
+			log.InfoContext(ctx, "synced guest clock after restore", "instance_id", inst.Id, "offset", offset)
+			return
+		}
+		if time.Now().After(deadline) || ctx.Err() != nil {
+			log.WarnContext(ctx, "failed to sync guest clock after restore", "instance_id", inst.Id, "error", err)
+			return
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+// syncGuestTime sends the host time to the guest agent
+func (m *manager) syncGuestTime(ctx context.Context, inst *Instance) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, guestTimeSyncRPCTimeout)
+	defer cancel()
+	client, err := guestClient(ctx, inst)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.SyncTime(ctx, &guest.SyncTimeRequest{UnixNanos: time.Now().UnixNano()})
+	if err != nil {
+		return 0, fmt.Errorf("sync guest time: %w", err)
+	}
+	return time.Duration(resp.OffsetNanos), nil
+}
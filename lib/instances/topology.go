@@ -0,0 +1,137 @@
+package instances
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TopologyReport describes the host's CPU layout and, when asked for a vCPU
+// count, the guest topology an instance of that size gets
+type TopologyReport struct {
+	Host      *HostTopology // nil if /proc/cpuinfo could not be parsed
+	NUMANodes []NUMANode    // Empty if the host reports no NUMA layout
+	Guest     *GuestTopology
+}
+
+// NUMANode is one NUMA node of the host that has CPUs
+type NUMANode struct {
+	ID          int
+	CPUs        []int
+	MemoryBytes int64 // Memory local to the node
+}
+
+// GuestTopology is the CPU topology an instance with a given vCPU count gets
+// on this host. The counts are 0 when the hypervisor's flat default applies.
+type GuestTopology struct {
+	Vcpus          int
+	ThreadsPerCore int
+	CoresPerDie    int
+	DiesPerPackage int
+	Packages       int
+	Warnings       []string // Where the layout can't follow the host's
+}
+
+// GetTopology describes the host's CPU topology and NUMA layout, and the guest
+// topology for vcpus if it is above 0
+func (m *manager) GetTopology(vcpus int) (*TopologyReport, error) {
+	report := &TopologyReport{Host: m.hostTopology}
+	for id, cpus := range m.numaNodes {
+		report.NUMANodes = append(report.NUMANodes, NUMANode{
+			ID:          id,
+			CPUs:        cpus,
+			MemoryBytes: readNodeMemory(filepath.Join(sysfsNodePath, fmt.Sprintf("node%d", id), "meminfo")),
+		})
+	}
+	sort.Slice(report.NUMANodes, func(i, j int) bool { return report.NUMANodes[i].ID < report.NUMANodes[j].ID })
+
+	if vcpus <= 0 {
+		return report, nil
+	}
+	warnings, err := checkGuestTopology(vcpus, m.hostTopology, m.numaNodes)
+	if err != nil {
+		return nil, err
+	}
+	report.Guest = &GuestTopology{Vcpus: vcpus, Warnings: warnings}
+	if topo := calculateGuestTopology(vcpus, m.hostTopology); topo != nil {
+		report.Guest.ThreadsPerCore = *topo.ThreadsPerCore
+		report.Guest.CoresPerDie = *topo.CoresPerDie
+		report.Guest.DiesPerPackage = *topo.DiesPerPackage
+		report.Guest.Packages = *topo.Packages
+	}
+	return report, nil
+}
+
+// maxGuestVcpus is the most vCPUs a guest topology can describe: Cloud
+// Hypervisor counts vCPUs in a u8
+const maxGuestVcpus = 255
+
+// checkGuestTopology checks that vcpus can be laid out as a guest topology.
+// Layouts that can't follow the host's threads, sockets or NUMA nodes, or
+// that have more vCPUs than the host has threads, come back as warnings.
+func checkGuestTopology(vcpus int, host *HostTopology, numaNodes map[int][]int) ([]string, error) {
+	if vcpus > maxGuestVcpus {
+		return nil, fmt.Errorf("vcpus %d exceeds the maximum of %d per guest", vcpus, maxGuestVcpus)
+	}
+	if host == nil {
+		return nil, nil
+	}
+	var warnings []string
+	if logical := host.ThreadsPerCore * host.CoresPerSocket * host.Sockets; vcpus > logical {
+		warnings = append(warnings, fmt.Sprintf("%d vCPUs exceed the host's %d logical CPUs, so they share host threads",
+			vcpus, logical))
+	}
+	// calculateGuestTopology leaves these to the hypervisor's defaults
+	if vcpus <= 2 {
+		return warnings, nil
+	}
+
+	cores := vcpus
+	if host.ThreadsPerCore > 1 {
+		if vcpus%host.ThreadsPerCore == 0 {
+			cores = vcpus / host.ThreadsPerCore
+		} else {
+			warnings = append(warnings, fmt.Sprintf("%d vCPUs do not divide into the host's %d threads per core, so the guest sees one thread per core",
+				vcpus, host.ThreadsPerCore))
+		}
+	}
+	if cores > host.CoresPerSocket && cores%host.CoresPerSocket != 0 {
+		warnings = append(warnings, fmt.Sprintf("%d cores do not fill whole host sockets of %d cores, so the guest sees a single socket",
+			cores, host.CoresPerSocket))
+	}
+	if len(numaNodes) > 1 {
+		largest := 0
+		for _, cpus := range numaNodes {
+			largest = max(largest, len(cpus))
+		}
+		if vcpus > largest {
+			warnings = append(warnings, fmt.Sprintf("%d vCPUs exceed the %d CPUs of a host NUMA node, so memory access crosses nodes",
+				vcpus, largest))
+		}
+	}
+	return warnings, nil
+}
+
+// readNodeMemory returns MemTotal from a NUMA node's meminfo, whose lines read
+// like "Node 0 MemTotal:       32768000 kB". Returns 0 if it can't be read.
+func readNodeMemory(path string) int64 {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 4 && fields[2] == "MemTotal:" {
+			kb, _ := strconv.ParseInt(fields[3], 10, 64)
+			return kb * 1024
+		}
+	}
+	return 0
+}
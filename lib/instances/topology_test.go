@@ -0,0 +1,53 @@
+package instances
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckGuestTopology(t *testing.T) {
+	// 2 sockets of 8 cores with SMT, one NUMA node per socket
+	host := &HostTopology{ThreadsPerCore: 2, CoresPerSocket: 8, Sockets: 2}
+	numa := map[int][]int{0: make([]int, 16), 1: make([]int, 16)}
+
+	warnings, err := checkGuestTopology(8, host, numa)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	// Unknown hosts and small guests are left to the hypervisor
+	warnings, err = checkGuestTopology(64, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	warnings, err = checkGuestTopology(1, host, numa)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	warnings, err = checkGuestTopology(7, host, numa)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "threads per core")
+
+	// 24 vCPUs are 12 cores: more than a socket, and more than a NUMA node
+	warnings, err = checkGuestTopology(24, host, numa)
+	require.NoError(t, err)
+	assert.Len(t, warnings, 2)
+
+	warnings, err = checkGuestTopology(2, &HostTopology{ThreadsPerCore: 1, CoresPerSocket: 1, Sockets: 1}, nil)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "1 logical CPUs")
+
+	_, err = checkGuestTopology(256, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestReadNodeMemory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "meminfo")
+	require.NoError(t, os.WriteFile(path, []byte("Node 1 MemTotal:       32768000 kB\nNode 1 MemFree:        1024 kB\n"), 0644))
+	assert.Equal(t, int64(32768000*1024), readNodeMemory(path))
+	assert.Zero(t, readNodeMemory(filepath.Join(t.TempDir(), "missing")))
+}
@@ -44,12 +44,22 @@ type StoredMetadata struct {
 	NetworkBandwidthUpload   int64 // Upload rate limit in bytes/sec (VM→external), 0 = auto
 	DiskIOBps                int64 // Disk I/O rate limit in bytes/sec, 0 = auto
 
+	// Overlay disk image format (empty = raw), and whether guest discards on
+	// the overlay and writable volumes free host disk
+	OverlayFormat OverlayFormat
+	Discard       bool
+
 	// Configuration
 	Env            map[string]string
 	NetworkEnabled bool   // Whether instance has networking enabled (uses default network)
+	MTU            int    // Guest NIC and TAP device MTU (0 = NETWORK_MTU)
 	IP             string // Assigned IP address (empty if NetworkEnabled=false)
 	MAC            string // Assigned MAC address (empty if NetworkEnabled=false)
 
+	// Guest resolvers and search domains (empty = DNS_SERVER and no search)
+	DNSServers    []string
+	SearchDomains []string
+
 	// Attached volumes
 	Volumes []VolumeAttachment // Volumes attached to this instance
 
@@ -61,6 +71,14 @@ type StoredMetadata struct {
 	// Versions
 	KernelVersion string // Kernel version (e.g., "ch-v6.12.9")
 
+	// Extra kernel modules the guest loads at boot (e.g., "fuse", "nbd")
+	KernelModules []string
+
+	// Boot mode (empty = detected from the image's command) and the options
+	// used when it is systemd
+	InitMode InitMode
+	Systemd  *SystemdOptions
+
 	// Hypervisor configuration
 	HypervisorType    hypervisor.Type // Hypervisor type (e.g., "cloud-hypervisor")
 	HypervisorVersion string          // Hypervisor version (e.g., "v49.0")
@@ -76,16 +94,53 @@ type StoredMetadata struct {
 
 	// Attached devices (GPU passthrough)
 	Devices []string // Device IDs attached to this instance
+
+	// Host NUMA node the instance's vCPUs and memory are bound to (nil = unbound)
+	NUMANode *int
+
+	// Per-instance override of the global log retention (nil = use global)
+	LogRetention *LogRetention
+
+	// Idle auto-stop: the instance is stopped (or put in standby, per
+	// IdleAction) once it has had no network traffic or exec sessions for
+	// IdleTimeout (0 = never)
+	IdleTimeout    time.Duration
+	IdleAction     IdleAction // Empty means IdleActionStop
+	LastActivityAt *time.Time // Last network traffic or exec session seen
+
+	// Instance this one was cloned from (empty if not a clone)
+	ParentID string
 }
 
+// StateReason says why an instance's state is Unknown
+type StateReason string
+
+const (
+	// StateReasonHypervisorClient means no client could be created for the
+	// instance's hypervisor type
+	StateReasonHypervisorClient StateReason = "hypervisor_client_error"
+	// StateReasonVMMUnreachable means the VMM process is alive (or its PID is
+	// unknown) but its API socket didn't answer, which may be transient
+	StateReasonVMMUnreachable StateReason = "vmm_unreachable"
+	// StateReasonVMMExited means the API socket is left behind but the VMM
+	// process is gone, usually because it crashed
+	StateReasonVMMExited StateReason = "vmm_exited"
+	// StateReasonUnexpectedState means the VMM reported a state we don't map
+	StateReasonUnexpectedState StateReason = "unexpected_vmm_state"
+	// StateReasonSnapshotIncomplete means a standby snapshot was interrupted
+	// before it was written out, so the instance can't be restored
+	StateReasonSnapshotIncomplete StateReason = "snapshot_incomplete"
+)
+
 // Instance represents a virtual machine instance with derived runtime state
 type Instance struct {
 	StoredMetadata
 
 	// Derived fields (not stored in metadata.json)
-	State       State   // Derived from socket + VMM query
-	StateError  *string // Error message if state couldn't be determined (non-nil when State=Unknown)
-	HasSnapshot bool    // Derived from filesystem check
+	State       State       // Derived from socket + VMM query
+	StateError  *string     // Error message if state couldn't be determined (non-nil when State=Unknown)
+	StateReason StateReason // Why state couldn't be determined (set when State=Unknown)
+	HasSnapshot bool        // Derived from filesystem check
 }
 
 // GetHypervisorType returns the hypervisor type as a string.
@@ -101,15 +156,93 @@ type CreateInstanceRequest struct {
 	Size                     int64              // Base memory in bytes (default: 1GB)
 	HotplugSize              int64              // Hotplug memory in bytes (default: 3GB)
 	OverlaySize              int64              // Overlay disk size in bytes (default: 10GB)
+	OverlayFormat            OverlayFormat      // Optional: raw or qcow2 (defaults to raw)
+	Discard                  *bool              // Optional: pass guest discards to the host (defaults to on for qcow2)
 	Vcpus                    int                // Default 2
 	NetworkBandwidthDownload int64              // Download rate limit bytes/sec (0 = auto, proportional to CPU)
 	NetworkBandwidthUpload   int64              // Upload rate limit bytes/sec (0 = auto, proportional to CPU)
 	DiskIOBps                int64              // Disk I/O rate limit bytes/sec (0 = auto, proportional to CPU)
 	Env                      map[string]string  // Optional environment variables
 	NetworkEnabled           bool               // Whether to enable networking (uses default network)
+	MTU                      int                // Optional: guest NIC and TAP device MTU (0 = NETWORK_MTU)
+	DNSServers               []string           // Optional: guest resolvers (defaults to DNS_SERVER)
+	SearchDomains            []string           // Optional: guest resolv.conf search domains
 	Devices                  []string           // Device IDs or names to attach (GPU passthrough)
 	Volumes                  []VolumeAttachment // Volumes to attach at creation time
 	Hypervisor               hypervisor.Type    // Optional: hypervisor type (defaults to config)
+	LogRetention             *LogRetention      // Optional: overrides the global log retention
+	IdleTimeout              time.Duration      // Optional: stop the instance after this long without activity (0 = never)
+	IdleAction               IdleAction         // Optional: what to do when idle (defaults to IdleActionStop)
+	KernelVersion            string             // Optional: guest kernel version (defaults to the system default)
+	KernelModules            []string           // Optional: extra kernel modules the guest loads at boot
+	InitMode                 InitMode           // Optional: exec or systemd (defaults to detection from the image)
+	Systemd                  *SystemdOptions    // Optional: boot options for systemd mode
+}
+
+// CloneInstanceRequest is the domain request for cloning an instance
+type CloneInstanceRequest struct {
+	Name string // Required: name of the clone
+	// CloneVolumes gives the clone copies of the source's writable volumes.
+	// Without it the clone gets none of them; read-only volumes are shared.
+	CloneVolumes bool
+}
+
+// IdleAction is what happens to an instance that exceeds its idle timeout
+type IdleAction string
+
+const (
+	// IdleActionStop stops the VM; the next start boots it from scratch
+	IdleActionStop IdleAction = "stop"
+	// IdleActionStandby snapshots the VM; an ingress request restores it
+	IdleActionStandby IdleAction = "standby"
+)
+
+// OverlayFormat is the image format of an instance's overlay disk
+type OverlayFormat string
+
+const (
+	// OverlayFormatRaw is a sparse file, the fastest for I/O
+	OverlayFormatRaw OverlayFormat = "raw"
+	// OverlayFormatQcow2 allocates clusters as the guest writes them, so a
+	// large overlay costs little until it is used and copies stay small
+	OverlayFormatQcow2 OverlayFormat = "qcow2"
+)
+
+// InitMode is how the guest init runs the image's command
+type InitMode string
+
+const (
+	// InitModeExec runs the command as a child of init, like a container
+	InitModeExec InitMode = "exec"
+	// InitModeSystemd hands PID 1 to systemd for a full-VM workload
+	InitModeSystemd InitMode = "systemd"
+)
+
+// SystemdOptions configure the boot of an instance in systemd mode
+type SystemdOptions struct {
+	DefaultTarget string   // Target to boot into instead of default.target (e.g., "multi-user.target")
+	MaskUnits     []string // Units masked so they never start (e.g., "getty@tty1.service")
+}
+
+// LogRetention controls how long rotated instance logs (.1, .2, ...) are kept.
+// Zero fields mean no limit globally, or "use the global value" in an override.
+type LogRetention struct {
+	MaxAge   time.Duration // Delete rotated logs last written longer ago than this
+	MaxFiles int           // Rotated logs to keep per log file
+}
+
+// Override returns r with the non-zero fields of o applied
+func (r LogRetention) Override(o *LogRetention) LogRetention {
+	if o == nil {
+		return r
+	}
+	if o.MaxAge > 0 {
+		r.MaxAge = o.MaxAge
+	}
+	if o.MaxFiles > 0 {
+		r.MaxFiles = o.MaxFiles
+	}
+	return r
 }
 
 // AttachVolumeRequest is the domain request for attaching a volume (used for API compatibility)
@@ -117,3 +250,12 @@ type AttachVolumeRequest struct {
 	MountPath string
 	Readonly  bool
 }
+
+// HypervisorInfo describes a hypervisor the manager can start instances with
+type HypervisorInfo struct {
+	Type         hypervisor.Type
+	Version      string // Detected version (empty if detection failed)
+	Available    bool   // Whether the hypervisor binary could be located
+	Error        string // Why version detection failed (empty if available)
+	Capabilities hypervisor.Capabilities
+}
@@ -0,0 +1,254 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/guest"
+	"github.com/onkernel/hypeman/lib/hypervisor"
+	"github.com/onkernel/hypeman/lib/logger"
+	"github.com/onkernel/hypeman/lib/volumes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gvisor.dev/gvisor/pkg/cleanup"
+)
+
+// guestVolumeRPCTimeout bounds a guest mount or unmount, including the wait
+// for a hotplugged disk to appear
+const guestVolumeRPCTimeout = 15 * time.Second
+
+// attachVolume attaches a volume to a running or stopped instance. On a
+// running instance whose hypervisor can hotplug disks the disk is added live
+// and mounted by the guest agent; otherwise the attachment is recorded and
+// takes effect the next time the instance boots.
+func (m *manager) attachVolume(ctx context.Context, id string, volumeID string, req AttachVolumeRequest) (*Instance, error) {
+	log := logger.FromContext(ctx)
+	log.InfoContext(ctx, "attaching volume", "instance_id", id, "volume_id", volumeID, "mount_path", req.MountPath)
+
+	// 1. Load instance
+	meta, err := m.loadMetadata(id)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to load instance metadata", "instance_id", id, "error", err)
+		return nil, err
+	}
+	inst := m.toInstance(ctx, meta)
+	stored := &meta.StoredMetadata
+
+	// 2. Validate state. A standby snapshot holds the disks it was taken
+	// with, so its disk set can't change until it is restored.
+	if inst.State != StateRunning && inst.State != StateStopped {
+		log.ErrorContext(ctx, "invalid state for volume attach", "instance_id", id, "state", inst.State)
+		return nil, fmt.Errorf("%w: cannot attach volume in state %s, must be Running or Stopped", ErrInvalidState, inst.State)
+	}
+
+	// 3. Validate the attachment alongside the existing ones, including the
+	// hypervisor's device-slot limit
+	if _, err := m.volumeManager.GetVolume(ctx, volumeID); err != nil {
+		return nil, fmt.Errorf("volume %s: %w", volumeID, err)
+	}
+	if slices.ContainsFunc(stored.Volumes, func(v VolumeAttachment) bool { return v.VolumeID == volumeID }) {
+		return nil, fmt.Errorf("%w: volume %s is already attached to instance %s", volumes.ErrInUse, volumeID, id)
+	}
+	attachment := VolumeAttachment{VolumeID: volumeID, MountPath: req.MountPath, Readonly: req.Readonly}
+	attached := append(slices.Clone(stored.Volumes), attachment)
+	if err := validateVolumeAttachments(attached); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidVolume, err)
+	}
+	volDevices, err := assignVolumeDevices(attached, maxDisks(stored.HypervisorType))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidVolume, err)
+	}
+	serial := volDevices[len(attached)-1].Serial
+
+	cu := cleanup.Make(func() {})
+	defer cu.Clean()
+
+	// 4. Mark the volume attached (enforces the multi-attach rules)
+	if err := m.volumeManager.AttachVolume(ctx, volumeID, volumes.AttachVolumeRequest{
+		InstanceID: id,
+		MountPath:  req.MountPath,
+		Readonly:   req.Readonly,
+	}); err != nil {
+		log.ErrorContext(ctx, "failed to attach volume", "instance_id", id, "volume_id", volumeID, "error", err)
+		return nil, fmt.Errorf("attach volume %s: %w", volumeID, err)
+	}
+	cu.Add(func() { m.volumeManager.DetachVolume(context.WithoutCancel(ctx), volumeID, id) })
+
+	// 5. Hotplug and mount in a running guest
+	caps, _ := hypervisor.CapabilitiesForType(stored.HypervisorType)
+	if inst.State == StateRunning && caps.SupportsHotplugDisk {
+		hv, err := m.getHypervisor(inst.SocketPath, stored.HypervisorType)
+		if err != nil {
+			return nil, fmt.Errorf("create hypervisor client: %w", err)
+		}
+		ioBps, burstBps := diskIOLimits(stored.DiskIOBps)
+		if err := hv.AddDisk(ctx, hypervisor.DiskConfig{
+			Path:       m.volumeManager.GetVolumePath(volumeID),
+			Readonly:   req.Readonly,
+			IOBps:      ioBps,
+			IOBurstBps: burstBps,
+			Serial:     serial,
+			Discard:    stored.Discard && !req.Readonly,
+		}); err != nil {
+			log.ErrorContext(ctx, "failed to hotplug volume disk", "instance_id", id, "volume_id", volumeID, "error", err)
+			return nil, fmt.Errorf("hotplug volume %s: %w", volumeID, err)
+		}
+		cu.Add(func() {
+			log.DebugContext(ctx, "removing hotplugged volume disk on cleanup", "instance_id", id, "volume_id", volumeID)
+			hv.RemoveDisk(context.WithoutCancel(ctx), serial)
+		})
+
+		if err := m.mountGuestVolume(ctx, &inst, serial, req.MountPath, req.Readonly); err != nil {
+			log.ErrorContext(ctx, "guest failed to mount volume", "instance_id", id, "volume_id", volumeID, "error", err)
+			return nil, fmt.Errorf("mount volume %s in guest: %w", volumeID, err)
+		}
+	} else if inst.State == StateRunning {
+		log.InfoContext(ctx, "hypervisor cannot hotplug disks, volume attaches on next boot", "instance_id", id, "volume_id", volumeID, "hypervisor", stored.HypervisorType)
+	}
+
+	// 6. Persist
+	stored.Volumes = attached
+	if err := m.saveMetadata(&metadata{StoredMetadata: *stored}); err != nil {
+		log.ErrorContext(ctx, "failed to save metadata", "instance_id", id, "error", err)
+		return nil, fmt.Errorf("save metadata: %w", err)
+	}
+
+	cu.Release()
+
+	finalInst := m.toInstance(ctx, &metadata{StoredMetadata: *stored})
+	log.InfoContext(ctx, "volume attached", "instance_id", id, "volume_id", volumeID)
+	return &finalInst, nil
+}
+
+// detachVolume detaches a volume from a running or stopped instance. A
+// running instance must be able to hot-unplug the disk, and the guest must be
+// able to unmount it (nothing may be using it).
+func (m *manager) detachVolume(ctx context.Context, id string, volumeID string) (*Instance, error) {
+	log := logger.FromContext(ctx)
+	log.InfoContext(ctx, "detaching volume", "instance_id", id, "volume_id", volumeID)
+
+	// 1. Load instance
+	meta, err := m.loadMetadata(id)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to load instance metadata", "instance_id", id, "error", err)
+		return nil, err
+	}
+	inst := m.toInstance(ctx, meta)
+	stored := &meta.StoredMetadata
+
+	// 2. Find the attachment
+	idx := slices.IndexFunc(stored.Volumes, func(v VolumeAttachment) bool { return v.VolumeID == volumeID })
+	if idx < 0 {
+		return nil, fmt.Errorf("%w: volume %s is not attached to instance %s", volumes.ErrNotFound, volumeID, id)
+	}
+	attachment := stored.Volumes[idx]
+
+	// 3. Validate state
+	if inst.State != StateRunning && inst.State != StateStopped {
+		log.ErrorContext(ctx, "invalid state for volume detach", "instance_id", id, "state", inst.State)
+		return nil, fmt.Errorf("%w: cannot detach volume in state %s, must be Running or Stopped", ErrInvalidState, inst.State)
+	}
+
+	// 4. Unmount and unplug from a running guest
+	if inst.State == StateRunning {
+		caps, _ := hypervisor.CapabilitiesForType(stored.HypervisorType)
+		if !caps.SupportsHotplugDisk {
+			return nil, fmt.Errorf("%w: %s cannot hot-unplug disks, stop the instance to detach the volume", ErrNotSupported, stored.HypervisorType)
+		}
+		if attachment.Overlay {
+			// Its base and overlay disks are mounted by init, outside the
+			// guest agent's root
+			return nil, fmt.Errorf("%w: overlay volumes can only be detached from a stopped instance", ErrNotSupported)
+		}
+		volDevices, err := assignVolumeDevices(stored.Volumes, maxDisks(stored.HypervisorType))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := m.unmountGuestVolume(ctx, &inst, attachment.MountPath); err != nil {
+			log.ErrorContext(ctx, "guest failed to unmount volume", "instance_id", id, "volume_id", volumeID, "error", err)
+			if status.Code(err) == codes.FailedPrecondition {
+				return nil, fmt.Errorf("%w: unmount volume %s in guest: %v", volumes.ErrInUse, volumeID, err)
+			}
+			return nil, fmt.Errorf("unmount volume %s in guest: %w", volumeID, err)
+		}
+		hv, err := m.getHypervisor(inst.SocketPath, stored.HypervisorType)
+		if err != nil {
+			return nil, fmt.Errorf("create hypervisor client: %w", err)
+		}
+		if err := hv.RemoveDisk(ctx, volDevices[idx].Serial); err != nil {
+			log.ErrorContext(ctx, "failed to hot-unplug volume disk", "instance_id", id, "volume_id", volumeID, "error", err)
+			return nil, fmt.Errorf("hot-unplug volume %s: %w", volumeID, err)
+		}
+	}
+
+	// 5. Persist
+	stored.Volumes = slices.Delete(slices.Clone(stored.Volumes), idx, idx+1)
+	if err := m.saveMetadata(&metadata{StoredMetadata: *stored}); err != nil {
+		log.ErrorContext(ctx, "failed to save metadata", "instance_id", id, "error", err)
+		return nil, fmt.Errorf("save metadata: %w", err)
+	}
+
+	// 6. Release the volume (best effort, the instance no longer uses it)
+	if err := m.volumeManager.DetachVolume(ctx, volumeID, id); err != nil {
+		log.WarnContext(ctx, "failed to mark volume as detached", "volume_id", volumeID, "error", err)
+	}
+	if attachment.Overlay {
+		if err := os.Remove(m.paths.InstanceVolumeOverlay(id, volumeID)); err != nil && !os.IsNotExist(err) {
+			log.WarnContext(ctx, "failed to remove volume overlay disk", "volume_id", volumeID, "error", err)
+		}
+	}
+
+	finalInst := m.toInstance(ctx, &metadata{StoredMetadata: *stored})
+	log.InfoContext(ctx, "volume detached", "instance_id", id, "volume_id", volumeID)
+	return &finalInst, nil
+}
+
+// mountGuestVolume asks the guest agent to mount the disk with the given serial
+func (m *manager) mountGuestVolume(ctx context.Context, inst *Instance, serial, path string, readonly bool) error {
+	ctx, cancel := context.WithTimeout(ctx, guestVolumeRPCTimeout)
+	defer cancel()
+	client, err := guestClient(ctx, inst)
+	if err != nil {
+		return err
+	}
+
+	// Leave the guest's wait for the disk some room within the RPC deadline
+	_, err = client.MountVolume(ctx, &guest.MountVolumeRequest{
+		Serial:         serial,
+		Path:           path,
+		Readonly:       readonly,
+		Discard:        inst.Discard && !readonly,
+		TimeoutSeconds: int32((guestVolumeRPCTimeout - 5*time.Second) / time.Second),
+	})
+	return err
+}
+
+// unmountGuestVolume asks the guest agent to unmount a volume
+func (m *manager) unmountGuestVolume(ctx context.Context, inst *Instance, path string) error {
+	ctx, cancel := context.WithTimeout(ctx, guestVolumeRPCTimeout)
+	defer cancel()
+	client, err := guestClient(ctx, inst)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.UnmountVolume(ctx, &guest.UnmountVolumeRequest{Path: path})
+	return err
+}
+
+// guestClient connects to an instance's guest agent
+func guestClient(ctx context.Context, inst *Instance) (guest.GuestServiceClient, error) {
+	dialer, err := hypervisor.NewVsockDialer(inst.HypervisorType, inst.VsockSocket, inst.VsockCID)
+	if err != nil {
+		return nil, fmt.Errorf("create vsock dialer: %w", err)
+	}
+	conn, err := guest.GetOrCreateConn(ctx, dialer)
+	if err != nil {
+		return nil, fmt.Errorf("connect to guest agent: %w", err)
+	}
+	return guest.NewGuestServiceClient(conn), nil
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/onkernel/hypeman/lib/hypervisor"
 	"github.com/onkernel/hypeman/lib/images"
 	"github.com/onkernel/hypeman/lib/paths"
 	"github.com/onkernel/hypeman/lib/system"
@@ -451,3 +452,53 @@ func TestVolumeFromArchive(t *testing.T) {
 	manager.DeleteInstance(ctx, inst.Id)
 	volumeManager.DeleteVolume(ctx, vol.Id)
 }
+
+func TestAttachDetachVolumeStopped(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	vol, err := mgr.volumeManager.CreateVolume(ctx, volumes.CreateVolumeRequest{Name: "data", SizeGb: 1})
+	require.NoError(t, err)
+
+	id := "stopped"
+	require.NoError(t, mgr.ensureDirectories(id))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{
+		Id:             id,
+		Name:           "stopped",
+		HypervisorType: hypervisor.TypeCloudHypervisor,
+	}}))
+
+	// A stopped instance records the attachment for its next boot
+	inst, err := mgr.AttachVolume(ctx, id, vol.Id, AttachVolumeRequest{MountPath: "/data"})
+	require.NoError(t, err)
+	assert.Equal(t, []VolumeAttachment{{VolumeID: vol.Id, MountPath: "/data"}}, inst.Volumes)
+	got, err := mgr.volumeManager.GetVolume(ctx, vol.Id)
+	require.NoError(t, err)
+	require.Len(t, got.Attachments, 1)
+	assert.Equal(t, id, got.Attachments[0].InstanceID)
+
+	_, err = mgr.AttachVolume(ctx, id, vol.Id, AttachVolumeRequest{MountPath: "/other"})
+	assert.ErrorIs(t, err, volumes.ErrInUse)
+
+	other, err := mgr.volumeManager.CreateVolume(ctx, volumes.CreateVolumeRequest{Name: "other", SizeGb: 1})
+	require.NoError(t, err)
+	_, err = mgr.AttachVolume(ctx, id, other.Id, AttachVolumeRequest{MountPath: "/data"})
+	assert.ErrorIs(t, err, ErrInvalidVolume)
+	got, err = mgr.volumeManager.GetVolume(ctx, other.Id)
+	require.NoError(t, err)
+	assert.Empty(t, got.Attachments)
+
+	_, err = mgr.AttachVolume(ctx, id, "missing", AttachVolumeRequest{MountPath: "/missing"})
+	assert.ErrorIs(t, err, volumes.ErrNotFound)
+
+	// Detaching releases the volume
+	inst, err = mgr.DetachVolume(ctx, id, vol.Id)
+	require.NoError(t, err)
+	assert.Empty(t, inst.Volumes)
+	got, err = mgr.volumeManager.GetVolume(ctx, vol.Id)
+	require.NoError(t, err)
+	assert.Empty(t, got.Attachments)
+
+	_, err = mgr.DetachVolume(ctx, id, vol.Id)
+	assert.ErrorIs(t, err, volumes.ErrNotFound)
+}
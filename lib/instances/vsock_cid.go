@@ -0,0 +1,74 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+// Guest vsock CIDs range over 3 to 2^32-2: 0-2 are reserved (hypervisor,
+// loopback, host) and 2^32-1 is VMADDR_CID_ANY
+const (
+	minVsockCID int64 = 3
+	maxVsockCID int64 = 4294967294
+)
+
+// generateVsockCID converts first 8 chars of instance ID to a CID. Different
+// IDs can hash to the same CID; reserveVsockCID resolves that.
+// Returns value in range 3 to 4294967294
+func generateVsockCID(instanceID string) int64 {
+	idPrefix := instanceID
+	if len(idPrefix) > 8 {
+		idPrefix = idPrefix[:8]
+	}
+
+	var sum int64
+	for _, c := range idPrefix {
+		sum = sum*37 + int64(c)
+	}
+
+	return (sum % (maxVsockCID - minVsockCID + 1)) + minVsockCID
+}
+
+// nextVsockCID returns the CID after cid, wrapping around the valid range
+func nextVsockCID(cid int64) int64 {
+	if cid >= maxVsockCID {
+		return minVsockCID
+	}
+	return cid + 1
+}
+
+// reserveVsockCID picks the vsock CID for a new instance: the hash of its
+// ID, or the next CID after it that no other instance has. Two guests with
+// the same CID would have their vsock connections crossed. The CIDs in use
+// are those in the instances' metadata, plus CIDs held by creates in
+// progress; the returned release drops the hold, once the new instance's
+// metadata is saved or cleaned up.
+func (m *manager) reserveVsockCID(ctx context.Context, id string) (int64, func(), error) {
+	metas, err := m.listMetadata(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("list instances: %w", err)
+	}
+	assigned := make(map[int64]string, len(metas))
+	for _, meta := range metas {
+		assigned[meta.VsockCID] = meta.Id
+	}
+
+	hashed := generateVsockCID(id)
+	cid := hashed
+	for probes := int64(0); probes <= maxVsockCID-minVsockCID; probes++ {
+		if _, used := assigned[cid]; !used {
+			if _, pending := m.pendingCIDs.LoadOrStore(cid, struct{}{}); !pending {
+				if probes > 0 {
+					logger.FromContext(ctx).WarnContext(ctx, "vsock CID collision, assigned next free CID",
+						"instance_id", id, "hashed_cid", hashed, "cid", cid, "taken_by", assigned[hashed])
+					m.recordVsockCIDCollision(ctx)
+				}
+				return cid, func() { m.pendingCIDs.Delete(cid) }, nil
+			}
+		}
+		cid = nextVsockCID(cid)
+	}
+	return 0, nil, fmt.Errorf("no free vsock CID")
+}
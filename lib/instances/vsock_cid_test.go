@@ -0,0 +1,41 @@
+package instances
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReserveVsockCID_ProbesPastCollisions(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	// IDs sharing their first 8 characters hash to the same CID
+	hashed := generateVsockCID("collide0-existing")
+	require.Equal(t, hashed, generateVsockCID("collide0-new"))
+
+	require.NoError(t, mgr.ensureDirectories("collide0-existing"))
+	require.NoError(t, mgr.saveMetadata(&metadata{StoredMetadata: StoredMetadata{Id: "collide0-existing", Name: "existing", VsockCID: hashed}}))
+
+	cid, release, err := mgr.reserveVsockCID(ctx, "collide0-new")
+	require.NoError(t, err)
+	assert.Equal(t, nextVsockCID(hashed), cid, "a CID in an instance's metadata is skipped")
+
+	other, releaseOther, err := mgr.reserveVsockCID(ctx, "collide0-other")
+	require.NoError(t, err)
+	assert.Equal(t, nextVsockCID(cid), other, "a CID held by a create in progress is skipped")
+	releaseOther()
+
+	release()
+	again, releaseAgain, err := mgr.reserveVsockCID(ctx, "collide0-again")
+	require.NoError(t, err)
+	defer releaseAgain()
+	assert.Equal(t, cid, again, "a released CID is free again")
+}
+
+func TestNextVsockCID_Wraps(t *testing.T) {
+	assert.Equal(t, int64(4), nextVsockCID(3))
+	assert.Equal(t, minVsockCID, nextVsockCID(maxVsockCID))
+}
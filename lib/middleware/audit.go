@@ -0,0 +1,362 @@
+package middleware
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+// auditMaxCapture bounds how much of a create response is buffered to find
+// the new resource's ID
+const auditMaxCapture = 64 * 1024
+
+// AuditEntry is one line of the audit log.
+type AuditEntry struct {
+	Time         time.Time         `json:"time"`
+	RequestID    string            `json:"request_id,omitempty"`
+	User         string            `json:"user"`
+	Operation    string            `json:"operation"`
+	Method       string            `json:"method"`
+	Path         string            `json:"path"`
+	ResourceType string            `json:"resource_type,omitempty"`
+	ResourceID   string            `json:"resource_id,omitempty"`
+	Params       map[string]string `json:"params,omitempty"`
+	Status       int               `json:"status"`
+	DurationMS   int64             `json:"duration_ms"`
+	RemoteAddr   string            `json:"remote_addr,omitempty"`
+}
+
+// AuditConfig configures the audit log.
+type AuditConfig struct {
+	// Path is the audit log file. It is opened append-only.
+	Path string
+	// MaxBytes rotates the log once it would grow past this size (0 = never)
+	MaxBytes int64
+	// MaxFiles is how many rotated files (.1, .2, ...) are kept
+	MaxFiles int
+	// IncludeReads also records GET, HEAD and OPTIONS requests
+	IncludeReads bool
+	// Spec names operations by their OpenAPI operationId. Routes not in the
+	// spec are named "<METHOD> <route pattern>".
+	Spec *openapi3.T
+}
+
+// AuditLogger appends JSON audit entries to a file, rotating it by size.
+type AuditLogger struct {
+	mu           sync.Mutex
+	path         string
+	file         *os.File
+	size         int64
+	maxBytes     int64
+	maxFiles     int
+	includeReads bool
+	operations   map[string]string // "METHOD /pattern" -> operationId
+}
+
+// NewAuditLogger opens the audit log for appending.
+func NewAuditLogger(cfg AuditConfig) (*AuditLogger, error) {
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), 0755); err != nil {
+		return nil, fmt.Errorf("create audit log directory: %w", err)
+	}
+
+	l := &AuditLogger{
+		path:         cfg.Path,
+		maxBytes:     cfg.MaxBytes,
+		maxFiles:     cfg.MaxFiles,
+		includeReads: cfg.IncludeReads,
+		operations:   map[string]string{},
+	}
+	if cfg.Spec != nil && cfg.Spec.Paths != nil {
+		for pattern, item := range cfg.Spec.Paths.Map() {
+			for method, op := range item.Operations() {
+				if op.OperationID != "" {
+					l.operations[method+" "+pattern] = op.OperationID
+				}
+			}
+		}
+	}
+
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *AuditLogger) open() error {
+	f, err := os.OpenFile(l.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("stat audit log: %w", err)
+	}
+	l.file = f
+	l.size = info.Size()
+	return nil
+}
+
+// Record appends an entry to the log.
+func (l *AuditLogger) Record(entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return fmt.Errorf("audit log is closed")
+	}
+	if l.maxBytes > 0 && l.size > 0 && l.size+int64(len(line)) > l.maxBytes {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	return err
+}
+
+// rotate shifts old logs (.1 -> .2, ...), dropping the oldest, and moves the
+// current file to .1. Files are renamed rather than truncated so nothing
+// already written is ever modified.
+func (l *AuditLogger) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("close audit log: %w", err)
+	}
+	l.file = nil
+
+	if l.maxFiles > 0 {
+		os.Remove(fmt.Sprintf("%s.%d", l.path, l.maxFiles))
+		for i := l.maxFiles - 1; i >= 1; i-- {
+			os.Rename(fmt.Sprintf("%s.%d", l.path, i), fmt.Sprintf("%s.%d", l.path, i+1))
+		}
+		if err := os.Rename(l.path, l.path+".1"); err != nil {
+			return fmt.Errorf("rotate audit log: %w", err)
+		}
+	} else if err := os.Remove(l.path); err != nil {
+		return fmt.Errorf("rotate audit log: %w", err)
+	}
+
+	return l.open()
+}
+
+// Close closes the log file.
+func (l *AuditLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// operationName returns the OpenAPI operationId for a route, if known
+func (l *AuditLogger) operationName(method, pattern string) string {
+	if op, ok := l.operations[method+" "+pattern]; ok {
+		return op
+	}
+	return method + " " + pattern
+}
+
+// auditRecordKey is the context key for the in-flight audit record
+type auditRecordKey struct{}
+
+// auditRecord collects details set by inner middleware (authentication,
+// resource resolution) that the audit middleware can't see from outside,
+// since they only reach the request context passed further down the chain.
+type auditRecord struct {
+	user         string
+	resourceType string
+	resourceID   string
+}
+
+// recordAuditUser notes the authenticated user on the in-flight audit record
+func recordAuditUser(ctx context.Context, user string) {
+	if rec, ok := ctx.Value(auditRecordKey{}).(*auditRecord); ok {
+		rec.user = user
+	}
+}
+
+// recordAuditResource notes the resolved resource on the in-flight audit record
+func recordAuditResource(ctx context.Context, resourceType, id string) {
+	if rec, ok := ctx.Value(auditRecordKey{}).(*auditRecord); ok {
+		rec.resourceType = resourceType
+		rec.resourceID = id
+	}
+}
+
+// Audit returns middleware that records mutating requests to the audit log.
+// Read-only methods are skipped unless IncludeReads is set. It should run
+// before authentication and ResolveResource so that rejected requests are
+// recorded too, and so those middlewares can fill in the user and resource.
+// A nil logger disables auditing.
+func Audit(l *AuditLogger) func(http.Handler) http.Handler {
+	return audit(l, "")
+}
+
+// AuditOperation is like Audit but always records requests under a fixed
+// operation name. It is meant for routes outside the OpenAPI spec, like the
+// exec and cp WebSockets, that act on resources despite being GETs.
+func AuditOperation(l *AuditLogger, operation string) func(http.Handler) http.Handler {
+	return audit(l, operation)
+}
+
+func audit(l *AuditLogger, operation string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if l == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if operation == "" && !l.includeReads && isReadOnlyMethod(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			start := time.Now()
+			rec := &auditRecord{}
+			ctx := context.WithValue(r.Context(), auditRecordKey{}, rec)
+
+			wrapped := &auditResponseWriter{responseWriter: wrapResponseWriter(w)}
+			if r.Method == http.MethodPost {
+				wrapped.capture = &strings.Builder{}
+			}
+
+			next.ServeHTTP(wrapped, r.WithContext(ctx))
+
+			entry := AuditEntry{
+				Time:         start.UTC(),
+				RequestID:    middleware.GetReqID(r.Context()),
+				User:         rec.user,
+				Operation:    operation,
+				Method:       r.Method,
+				Path:         r.URL.Path,
+				ResourceType: rec.resourceType,
+				ResourceID:   rec.resourceID,
+				Status:       wrapped.Status(),
+				DurationMS:   time.Since(start).Milliseconds(),
+				RemoteAddr:   r.RemoteAddr,
+			}
+			if wrapped.hijacked {
+				entry.Status = http.StatusSwitchingProtocols
+			}
+
+			pattern := r.URL.Path
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				if p := rctx.RoutePattern(); p != "" {
+					pattern = p
+				}
+				for i, key := range rctx.URLParams.Keys {
+					if key == "*" {
+						continue
+					}
+					if entry.Params == nil {
+						entry.Params = map[string]string{}
+					}
+					entry.Params[key] = rctx.URLParams.Values[i]
+				}
+			}
+			if entry.Operation == "" {
+				entry.Operation = l.operationName(r.Method, pattern)
+			}
+			if entry.ResourceType == "" {
+				entry.ResourceType = resourceTypeFromPath(r.URL.Path)
+			}
+			if entry.ResourceID == "" {
+				entry.ResourceID = entry.Params["id"]
+			}
+			if entry.ResourceID == "" && wrapped.capture != nil && entry.Status < 300 {
+				entry.ResourceID = createdResourceID(wrapped.capture.String())
+			}
+
+			if err := l.Record(entry); err != nil {
+				logger.FromContext(r.Context()).ErrorContext(r.Context(), "failed to write audit log", "error", err, "operation", entry.Operation)
+			}
+		})
+	}
+}
+
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// resourceTypeFromPath names the resource a path addresses by its first
+// segment, for routes ResolveResource doesn't handle (builds, devices)
+func resourceTypeFromPath(path string) string {
+	segment, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	switch segment {
+	case "instances":
+		return "instance"
+	case "volumes":
+		return "volume"
+	case "ingresses":
+		return "ingress"
+	case "images":
+		return "image"
+	case "builds":
+		return "build"
+	case "devices":
+		return "device"
+	case "api-keys":
+		return "api_key"
+	}
+	return ""
+}
+
+// createdResourceID reads the ID of a newly created resource from a JSON
+// response body. Images are identified by name rather than ID.
+func createdResourceID(body string) string {
+	var created struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(body), &created); err != nil {
+		return ""
+	}
+	if created.ID != "" {
+		return created.ID
+	}
+	return created.Name
+}
+
+// auditResponseWriter captures the start of create responses and notes
+// WebSocket upgrades, which never write a status through the ResponseWriter.
+type auditResponseWriter struct {
+	*responseWriter
+	capture  *strings.Builder
+	hijacked bool
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if w.capture != nil && w.capture.Len() < auditMaxCapture {
+		w.capture.Write(b[:min(len(b), auditMaxCapture-w.capture.Len())])
+	}
+	return w.responseWriter.Write(b)
+}
+
+func (w *auditResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := w.responseWriter.Hijack()
+	if err == nil {
+		w.hijacked = true
+	}
+	return conn, rw, err
+}
@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubResolver resolves any name to "resolved-<name>"
+type stubResolver struct{}
+
+func (stubResolver) Resolve(ctx context.Context, idOrName string) (string, any, error) {
+	return "resolved-" + idOrName, nil, nil
+}
+
+func readAuditLog(t *testing.T, path string) []AuditEntry {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e AuditEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		entries = append(entries, e)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func newAuditRouter(t *testing.T, cfg AuditConfig) (http.Handler, string) {
+	cfg.Path = filepath.Join(t.TempDir(), "logs", "audit.log")
+	auditLogger, err := NewAuditLogger(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { auditLogger.Close() })
+
+	r := chi.NewRouter()
+	r.Group(func(r chi.Router) {
+		r.Use(Audit(auditLogger))
+		r.Use(JwtAuth(HMACVerifier(testJWTSecret), nil))
+		r.Use(ResolveResource(Resolvers{Instance: stubResolver{}}, func(w http.ResponseWriter, err error, lookup string) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		r.Post("/instances", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"new-instance","name":"web"}`))
+		})
+		r.Get("/instances/{id}", func(w http.ResponseWriter, r *http.Request) {})
+		r.Post("/instances/{id}/standby", func(w http.ResponseWriter, r *http.Request) {})
+		r.Delete("/builds/{id}", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+	})
+	return r, cfg.Path
+}
+
+func doAudited(t *testing.T, h http.Handler, method, path, token string) int {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	return rr.Code
+}
+
+func TestAudit(t *testing.T) {
+	spec := &openapi3.T{Paths: openapi3.NewPaths(
+		openapi3.WithPath("/instances", &openapi3.PathItem{Post: &openapi3.Operation{OperationID: "createInstance"}}),
+		openapi3.WithPath("/instances/{id}/standby", &openapi3.PathItem{Post: &openapi3.Operation{OperationID: "standbyInstance"}}),
+	)}
+	h, path := newAuditRouter(t, AuditConfig{Spec: spec})
+	token := generateUserToken(t, "user-123")
+
+	assert.Equal(t, http.StatusOK, doAudited(t, h, http.MethodPost, "/instances/web/standby", token))
+	assert.Equal(t, http.StatusCreated, doAudited(t, h, http.MethodPost, "/instances", token))
+	assert.Equal(t, http.StatusNoContent, doAudited(t, h, http.MethodDelete, "/builds/b1", token))
+	assert.Equal(t, http.StatusUnauthorized, doAudited(t, h, http.MethodPost, "/instances/web/standby", ""))
+	// Reads are skipped by default
+	assert.Equal(t, http.StatusOK, doAudited(t, h, http.MethodGet, "/instances/web", token))
+
+	entries := readAuditLog(t, path)
+	require.Len(t, entries, 4)
+
+	// The resolved ID is logged, not the name from the path
+	assert.Equal(t, "user-123", entries[0].User)
+	assert.Equal(t, "standbyInstance", entries[0].Operation)
+	assert.Equal(t, "instance", entries[0].ResourceType)
+	assert.Equal(t, "resolved-web", entries[0].ResourceID)
+	assert.Equal(t, map[string]string{"id": "web"}, entries[0].Params)
+	assert.Equal(t, http.StatusOK, entries[0].Status)
+
+	// Creates take the ID from the response
+	assert.Equal(t, "createInstance", entries[1].Operation)
+	assert.Equal(t, "new-instance", entries[1].ResourceID)
+
+	// Routes outside the spec and without a resolver still get a name and ID
+	assert.Equal(t, "DELETE /builds/{id}", entries[2].Operation)
+	assert.Equal(t, "build", entries[2].ResourceType)
+	assert.Equal(t, "b1", entries[2].ResourceID)
+
+	// Rejected requests are recorded without a user
+	assert.Equal(t, "", entries[3].User)
+	assert.Equal(t, http.StatusUnauthorized, entries[3].Status)
+}
+
+func TestAuditIncludeReads(t *testing.T) {
+	h, path := newAuditRouter(t, AuditConfig{IncludeReads: true})
+	token := generateUserToken(t, "user-123")
+
+	doAudited(t, h, http.MethodGet, "/instances/web", token)
+
+	entries := readAuditLog(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "GET /instances/{id}", entries[0].Operation)
+	assert.Equal(t, "resolved-web", entries[0].ResourceID)
+}
+
+func TestAuditLoggerRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := NewAuditLogger(AuditConfig{Path: path, MaxBytes: 200, MaxFiles: 2})
+	require.NoError(t, err)
+	defer l.Close()
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, l.Record(AuditEntry{User: "user-123", Operation: "deleteInstance", Method: http.MethodDelete, Path: "/instances/abc"}))
+	}
+
+	for _, p := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(p)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(200))
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWT_SECRET holds one secret, or a comma-separated keyset to rotate it
+// without invalidating every token at once. The first secret is the primary:
+// new tokens (registry tokens, gen-jwt) are signed with it. Tokens signed with
+// any secret in the set verify. To rotate:
+//
+//  1. Prepend the new secret: JWT_SECRET=new,old. Tokens signed with old
+//     keep working while new ones are signed with new.
+//  2. Wait until tokens signed with old have expired or been reissued.
+//  3. Drop the old secret: JWT_SECRET=new.
+
+// SplitJWTSecrets returns the secrets of a JWT_SECRET keyset, primary first.
+// Surrounding spaces and empty entries are dropped.
+func SplitJWTSecrets(keyset string) []string {
+	var secrets []string
+	for _, s := range strings.Split(keyset, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			secrets = append(secrets, s)
+		}
+	}
+	return secrets
+}
+
+// PrimaryJWTSecret returns the secret new tokens are signed with, or "" if
+// the keyset is empty.
+func PrimaryJWTSecret(keyset string) string {
+	secrets := SplitJWTSecrets(keyset)
+	if len(secrets) == 0 {
+		return ""
+	}
+	return secrets[0]
+}
+
+// jwtKeyfunc verifies HMAC-signed tokens against every secret of a keyset
+func jwtKeyfunc(keyset string) jwt.Keyfunc {
+	var keys jwt.VerificationKeySet
+	for _, s := range SplitJWTSecrets(keyset) {
+		keys.Keys = append(keys.Keys, []byte(s))
+	}
+	return func(token *jwt.Token) (interface{}, error) {
+		// Validate signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		if len(keys.Keys) == 0 {
+			return nil, errors.New("no JWT secret configured")
+		}
+		return keys, nil
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signToken(t *testing.T, secret string, claims jwt.MapClaims) string {
+	t.Helper()
+	claims["iat"] = time.Now().Unix()
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return s
+}
+
+func TestSplitJWTSecrets(t *testing.T) {
+	assert.Equal(t, []string{"new", "old"}, SplitJWTSecrets(" new , old,"))
+	assert.Equal(t, []string{"only"}, SplitJWTSecrets("only"))
+	assert.Empty(t, SplitJWTSecrets(" , "))
+
+	assert.Equal(t, "new", PrimaryJWTSecret("new,old"))
+	assert.Empty(t, PrimaryJWTSecret(""))
+}
+
+func TestJwtAuth_KeyRotation(t *testing.T) {
+	handler := JwtAuth(HMACVerifier("new-secret,old-secret"), nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	do := func(path, token string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	// User tokens signed with the primary or the previous secret verify
+	assert.Equal(t, http.StatusOK, do("/instances", signToken(t, "new-secret", jwt.MapClaims{"sub": "user"})))
+	assert.Equal(t, http.StatusOK, do("/instances", signToken(t, "old-secret", jwt.MapClaims{"sub": "user"})))
+	assert.Equal(t, http.StatusUnauthorized, do("/instances", signToken(t, "retired-secret", jwt.MapClaims{"sub": "user"})))
+
+	// So do registry tokens of builds that started before the rotation
+	registryToken := signToken(t, "old-secret", jwt.MapClaims{
+		"sub":      "builder-b1",
+		"build_id": "b1",
+		"repos":    []string{"builds/b1"},
+		"scope":    "push",
+	})
+	assert.Equal(t, http.StatusOK, do("/v2/builds/b1/manifests/latest", registryToken))
+}
+
+func TestJwtAuth_NoSecret(t *testing.T) {
+	handler := JwtAuth(HMACVerifier(""), nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/instances", nil)
+	req.Header.Set("Authorization", "Bearer "+signToken(t, "", jwt.MapClaims{"sub": "user"}))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
@@ -0,0 +1,415 @@
+package middleware
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/sync/singleflight"
+)
+
+// Signing algorithms user tokens may be configured to use
+const (
+	JWTAlgorithmHS256 = "HS256"
+	JWTAlgorithmRS256 = "RS256"
+	JWTAlgorithmES256 = "ES256"
+)
+
+const (
+	// jwksMinRefresh limits how often a token with an unknown key ID can
+	// make the verifier refetch the JWKS
+	jwksMinRefresh = time.Minute
+
+	// jwksMaxAge is how long fetched keys are used before being refetched
+	jwksMaxAge = time.Hour
+
+	jwksFetchTimeout = 10 * time.Second
+)
+
+// JWTConfig configures how user tokens are verified.
+type JWTConfig struct {
+	// Secret is the JWT_SECRET keyset. HS256 user tokens are verified
+	// against it, and registry tokens always are, whatever Algorithm is.
+	Secret string
+
+	// Algorithm user tokens must be signed with: HS256 (default, any HMAC
+	// variant), RS256 or ES256. Tokens signed with anything else are rejected.
+	Algorithm string
+
+	// PublicKeyPEM verifies RS256 and ES256 tokens. Exactly one of it and
+	// JWKSURL must be set for those algorithms.
+	PublicKeyPEM []byte
+
+	// JWKSURL is where an identity provider publishes its signing keys.
+	// Tokens pick their key with the "kid" header.
+	JWKSURL string
+
+	// Audience, when set, must be in a user token's "aud" claim, so tokens
+	// the identity provider minted for other services are rejected.
+	Audience string
+
+	// Issuer, when set, must be a user token's "iss" claim.
+	Issuer string
+}
+
+// JWTVerifier checks the signatures of the JWTs the API accepts. With an
+// asymmetric algorithm, user tokens are minted by an external identity
+// provider and hypeman can only verify them; registry tokens for builder VMs
+// are still minted by hypeman with the shared secret.
+type JWTVerifier struct {
+	secret    string
+	algorithm string
+	publicKey any        // Set for RS256/ES256 with a static key
+	jwks      *jwksCache // Set for RS256/ES256 with a JWKS URL
+	audience  string
+	issuer    string
+}
+
+// NewJWTVerifier returns a verifier for cfg.
+func NewJWTVerifier(cfg JWTConfig) (*JWTVerifier, error) {
+	v := &JWTVerifier{
+		secret:    cfg.Secret,
+		algorithm: strings.ToUpper(cfg.Algorithm),
+		audience:  cfg.Audience,
+		issuer:    cfg.Issuer,
+	}
+	if v.algorithm == "" {
+		v.algorithm = JWTAlgorithmHS256
+	}
+
+	switch v.algorithm {
+	case JWTAlgorithmHS256:
+		if len(cfg.PublicKeyPEM) > 0 || cfg.JWKSURL != "" {
+			return nil, errors.New("a JWT public key or JWKS URL needs algorithm RS256 or ES256")
+		}
+		return v, nil
+	case JWTAlgorithmRS256, JWTAlgorithmES256:
+	default:
+		return nil, fmt.Errorf("unsupported JWT algorithm %q (want HS256, RS256 or ES256)", cfg.Algorithm)
+	}
+
+	switch {
+	case len(cfg.PublicKeyPEM) > 0 && cfg.JWKSURL != "":
+		return nil, errors.New("set either a JWT public key or a JWKS URL, not both")
+	case len(cfg.PublicKeyPEM) > 0:
+		var err error
+		if v.algorithm == JWTAlgorithmRS256 {
+			v.publicKey, err = jwt.ParseRSAPublicKeyFromPEM(cfg.PublicKeyPEM)
+		} else {
+			v.publicKey, err = jwt.ParseECPublicKeyFromPEM(cfg.PublicKeyPEM)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse JWT public key: %w", err)
+		}
+	case cfg.JWKSURL != "":
+		v.jwks = &jwksCache{url: cfg.JWKSURL, client: &http.Client{Timeout: jwksFetchTimeout}}
+	default:
+		return nil, fmt.Errorf("algorithm %s needs a JWT public key or JWKS URL", v.algorithm)
+	}
+	return v, nil
+}
+
+// HMACVerifier returns a verifier of HS256 tokens signed with a secret of
+// the keyset.
+func HMACVerifier(secret string) *JWTVerifier {
+	return &JWTVerifier{secret: secret, algorithm: JWTAlgorithmHS256}
+}
+
+// Algorithm returns the algorithm user tokens must be signed with.
+func (v *JWTVerifier) Algorithm() string {
+	return v.algorithm
+}
+
+// userKeyfunc returns the keys user tokens are verified against, rejecting
+// tokens signed with another algorithm
+func (v *JWTVerifier) userKeyfunc(token *jwt.Token) (interface{}, error) {
+	if v.algorithm == JWTAlgorithmHS256 {
+		return jwtKeyfunc(v.secret)(token)
+	}
+	if token.Method.Alg() != v.algorithm {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	if v.publicKey != nil {
+		return v.publicKey, nil
+	}
+	kid, _ := token.Header["kid"].(string)
+	return v.jwks.key(kid)
+}
+
+// parseUserToken parses tokenString into claims and verifies its signature,
+// expiry, audience and issuer. Audience and issuer mismatches are returned as
+// errInvalidAudience and errInvalidIssuer.
+func (v *JWTVerifier) parseUserToken(tokenString string, claims jwt.Claims) (*jwt.Token, error) {
+	token, err := jwt.ParseWithClaims(tokenString, claims, v.userKeyfunc)
+	if err != nil {
+		return nil, err
+	}
+	if v.audience != "" {
+		aud, err := claims.GetAudience()
+		if err != nil || !slices.Contains(aud, v.audience) {
+			return nil, errInvalidAudience
+		}
+	}
+	if v.issuer != "" {
+		iss, err := claims.GetIssuer()
+		if err != nil || iss != v.issuer {
+			return nil, errInvalidIssuer
+		}
+	}
+	return token, nil
+}
+
+// TokenInspection describes a token and whether the API would accept it.
+type TokenInspection struct {
+	Valid     bool
+	Error     string // Why the token is rejected, empty if Valid
+	Type      string // "user", or "registry" for registry tokens of builder VMs
+	Algorithm string
+	KeyID     string
+	Subject   string
+	Issuer    string
+	Audience  []string
+	IssuedAt  *time.Time
+	NotBefore *time.Time
+	ExpiresAt *time.Time
+}
+
+// Inspect decodes a token's claims whether or not it is valid, and verifies
+// it the way JwtAuth would: registry tokens as on registry paths, others as
+// user tokens. It returns an error only if tokenString is not a JWT at all.
+func (v *JWTVerifier) Inspect(tokenString string) (*TokenInspection, error) {
+	claims := jwt.MapClaims{}
+	token, _, err := jwt.NewParser().ParseUnverified(tokenString, claims)
+	if err != nil {
+		return nil, fmt.Errorf("decode token: %w", err)
+	}
+
+	ti := &TokenInspection{Type: "user", Algorithm: token.Method.Alg()}
+	ti.KeyID, _ = token.Header["kid"].(string)
+	ti.Subject, _ = claims.GetSubject()
+	ti.Issuer, _ = claims.GetIssuer()
+	ti.Audience, _ = claims.GetAudience()
+	ti.IssuedAt = numericDateTime(claims.GetIssuedAt())
+	ti.NotBefore = numericDateTime(claims.GetNotBefore())
+	ti.ExpiresAt = numericDateTime(claims.GetExpirationTime())
+
+	if isRegistryClaims(claims) {
+		ti.Type = "registry"
+		_, _, err = validateRegistryToken(tokenString, v)
+	} else {
+		_, err = v.parseUserToken(tokenString, jwt.MapClaims{})
+		if err == nil && strings.HasPrefix(ti.Subject, "builder-") {
+			err = errors.New("builder subjects can't authenticate to the API")
+		}
+	}
+	if err != nil {
+		ti.Error = err.Error()
+	}
+	ti.Valid = err == nil
+	return ti, nil
+}
+
+func numericDateTime(d *jwt.NumericDate, err error) *time.Time {
+	if err != nil || d == nil {
+		return nil
+	}
+	return &d.Time
+}
+
+// isRegistryClaims reports whether claims are those of a registry token,
+// which JwtAuth rejects for API authentication
+func isRegistryClaims(claims jwt.MapClaims) bool {
+	for _, claim := range []string{"repos", "scope", "build_id"} {
+		if _, ok := claims[claim]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// registryKeyfunc returns the keys registry tokens are verified against
+func (v *JWTVerifier) registryKeyfunc(token *jwt.Token) (interface{}, error) {
+	return jwtKeyfunc(v.secret)(token)
+}
+
+// jwksCache holds the keys of a JWKS URL by key ID
+type jwksCache struct {
+	url     string
+	client  *http.Client
+	fetches singleflight.Group // Joins concurrent refreshes into one fetch
+
+	mu      sync.Mutex
+	keys    map[string]any
+	fetched time.Time
+	lastTry time.Time
+}
+
+// key returns the key with ID kid, fetching the JWKS when it has none yet,
+// its keys are stale, or kid is unknown (the provider may have rotated).
+// Refetches are limited to one per jwksMinRefresh. The fetch runs without
+// holding the lock, so lookups of cached keys never wait on the provider.
+func (c *jwksCache) key(kid string) (any, error) {
+	c.mu.Lock()
+	k, ok := c.lookup(kid)
+	fresh := ok && time.Since(c.fetched) < jwksMaxAge
+	c.mu.Unlock()
+	if fresh {
+		return k, nil
+	}
+
+	if _, err, _ := c.fetches.Do("jwks", c.refresh); err != nil && !errors.Is(err, errJWKSRefreshLimited) {
+		if ok {
+			return k, nil // Keep using a stale key while the provider is unreachable
+		}
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if k, ok := c.lookup(kid); ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("no key with ID %q in JWKS", kid)
+}
+
+// lookup finds a key by ID. A token without a key ID matches a JWKS with a
+// single key.
+func (c *jwksCache) lookup(kid string) (any, bool) {
+	if k, ok := c.keys[kid]; ok {
+		return k, true
+	}
+	if kid == "" && len(c.keys) == 1 {
+		for _, k := range c.keys {
+			return k, true
+		}
+	}
+	return nil, false
+}
+
+// errJWKSRefreshLimited is returned by refresh when the JWKS was fetched
+// too recently to fetch it again
+var errJWKSRefreshLimited = errors.New("JWKS refreshed too recently")
+
+// refresh fetches the JWKS and swaps in its keys. Callers join concurrent
+// refreshes through c.fetches; the lock is only held to update the cache.
+func (c *jwksCache) refresh() (any, error) {
+	c.mu.Lock()
+	if !c.lastTry.IsZero() && time.Since(c.lastTry) < jwksMinRefresh {
+		c.mu.Unlock()
+		return nil, errJWKSRefreshLimited
+	}
+	c.lastTry = time.Now()
+	c.mu.Unlock()
+
+	keys, err := c.fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.keys = keys
+	c.fetched = time.Now()
+	c.mu.Unlock()
+	return nil, nil
+}
+
+// fetch downloads the JWKS and parses its signing keys
+func (c *jwksCache) fetch() (map[string]any, error) {
+	resp, err := c.client.Get(c.url)
+	if err != nil {
+		return nil, fmt.Errorf("fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch JWKS: unexpected status %s", resp.Status)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]any, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue // Skip keys of unsupported types
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+// jsonWebKey is an RSA or EC public key from a JWKS (RFC 7517)
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (any, error) {
+	decode := func(s string) (*big.Int, error) {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetBytes(b), nil
+	}
+
+	switch k.Kty {
+	case "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
@@ -0,0 +1,261 @@
+package middleware
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signWith(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	claims["iat"] = time.Now().Unix()
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	require.NoError(t, err)
+	return s
+}
+
+func authStatus(handler http.Handler, path, token string) int {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr.Code
+}
+
+func okHandler(verifier *JWTVerifier) http.Handler {
+	return JwtAuth(verifier, nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestJWTVerifier_RS256PublicKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	verifier, err := NewJWTVerifier(JWTConfig{
+		Secret:       testJWTSecret,
+		Algorithm:    "RS256",
+		PublicKeyPEM: pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}),
+	})
+	require.NoError(t, err)
+	handler := okHandler(verifier)
+
+	assert.Equal(t, http.StatusOK, authStatus(handler, "/instances", signWith(t, jwt.SigningMethodRS256, key, "", jwt.MapClaims{"sub": "user"})))
+
+	// A token signed with the shared secret is rejected: only the identity
+	// provider can mint user tokens
+	assert.Equal(t, http.StatusUnauthorized, authStatus(handler, "/instances", signWith(t, jwt.SigningMethodHS256, []byte(testJWTSecret), "", jwt.MapClaims{"sub": "user"})))
+
+	// Registry tokens minted by hypeman still use the shared secret
+	registryToken := signWith(t, jwt.SigningMethodHS256, []byte(testJWTSecret), "", jwt.MapClaims{
+		"sub":      "builder-b1",
+		"build_id": "b1",
+		"repos":    []string{"builds/b1"},
+		"scope":    "push",
+	})
+	assert.Equal(t, http.StatusOK, authStatus(handler, "/v2/builds/b1/manifests/latest", registryToken))
+}
+
+func TestJWTVerifier_ES256JWKS(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	fetches := 0
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		enc := base64.RawURLEncoding
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "EC",
+			"kid": "key-1",
+			"use": "sig",
+			"crv": "P-256",
+			"x":   enc.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			"y":   enc.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		}}})
+	}))
+	defer jwks.Close()
+
+	verifier, err := NewJWTVerifier(JWTConfig{Algorithm: "ES256", JWKSURL: jwks.URL})
+	require.NoError(t, err)
+	handler := okHandler(verifier)
+
+	assert.Equal(t, http.StatusOK, authStatus(handler, "/instances", signWith(t, jwt.SigningMethodES256, key, "key-1", jwt.MapClaims{"sub": "user"})))
+	assert.Equal(t, http.StatusOK, authStatus(handler, "/instances", signWith(t, jwt.SigningMethodES256, key, "key-1", jwt.MapClaims{"sub": "user"})))
+	assert.Equal(t, 1, fetches, "keys are cached")
+
+	// Unknown key IDs and keys that don't match fail; the unknown ID doesn't
+	// refetch again within the refresh interval
+	assert.Equal(t, http.StatusUnauthorized, authStatus(handler, "/instances", signWith(t, jwt.SigningMethodES256, key, "key-2", jwt.MapClaims{"sub": "user"})))
+	assert.Equal(t, http.StatusUnauthorized, authStatus(handler, "/instances", signWith(t, jwt.SigningMethodES256, other, "key-1", jwt.MapClaims{"sub": "user"})))
+	assert.Equal(t, 1, fetches)
+}
+
+func TestJWKSCache_RefreshDoesNotBlockCachedKeys(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fetches.Add(1) > 1 {
+			<-release // Hold refetches until the test lets them finish
+		}
+		enc := base64.RawURLEncoding
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "EC",
+			"kid": "key-1",
+			"crv": "P-256",
+			"x":   enc.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			"y":   enc.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		}}})
+	}))
+	defer jwks.Close()
+
+	c := &jwksCache{url: jwks.URL, client: jwks.Client()}
+	_, err = c.key("key-1")
+	require.NoError(t, err)
+
+	// Let unknown key IDs refetch right away
+	c.mu.Lock()
+	c.lastTry = time.Time{}
+	c.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.key("key-2")
+			assert.Error(t, err)
+		}()
+	}
+	require.Eventually(t, func() bool { return fetches.Load() == 2 }, time.Second, 10*time.Millisecond)
+
+	// A cached key is served while the refetch is in flight
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.key("key-1")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("cached key lookup waited on the JWKS fetch")
+	}
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(2), fetches.Load(), "concurrent refetches share one fetch")
+}
+
+func TestNewJWTVerifier_Errors(t *testing.T) {
+	_, err := NewJWTVerifier(JWTConfig{Algorithm: "none"})
+	assert.Error(t, err)
+	_, err = NewJWTVerifier(JWTConfig{Algorithm: "RS256"})
+	assert.Error(t, err, "asymmetric algorithms need a key")
+	_, err = NewJWTVerifier(JWTConfig{Algorithm: "HS256", JWKSURL: "https://idp.example.com/jwks"})
+	assert.Error(t, err)
+	_, err = NewJWTVerifier(JWTConfig{Algorithm: "ES256", PublicKeyPEM: []byte("not a key")})
+	assert.Error(t, err)
+
+	verifier, err := NewJWTVerifier(JWTConfig{Secret: "s"})
+	require.NoError(t, err)
+	assert.Equal(t, JWTAlgorithmHS256, verifier.Algorithm())
+}
+
+func TestJWTVerifier_AudienceAndIssuer(t *testing.T) {
+	verifier, err := NewJWTVerifier(JWTConfig{Secret: testJWTSecret, Audience: "hypeman", Issuer: "https://idp.example.com"})
+	require.NoError(t, err)
+	handler := okHandler(verifier)
+
+	tests := []struct {
+		name     string
+		claims   jwt.MapClaims
+		wantCode string
+	}{
+		{"valid", jwt.MapClaims{"aud": "hypeman", "iss": "https://idp.example.com"}, ""},
+		{"audience list", jwt.MapClaims{"aud": []string{"billing", "hypeman"}, "iss": "https://idp.example.com"}, ""},
+		{"missing audience", jwt.MapClaims{"iss": "https://idp.example.com"}, "invalid_audience"},
+		{"wrong audience", jwt.MapClaims{"aud": "billing", "iss": "https://idp.example.com"}, "invalid_audience"},
+		{"missing issuer", jwt.MapClaims{"aud": "hypeman"}, "invalid_issuer"},
+		{"wrong issuer", jwt.MapClaims{"aud": "hypeman", "iss": "https://other.example.com"}, "invalid_issuer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.claims["sub"] = "user"
+			req := httptest.NewRequest(http.MethodGet, "/instances", nil)
+			req.Header.Set("Authorization", "Bearer "+signWith(t, jwt.SigningMethodHS256, []byte(testJWTSecret), "", tt.claims))
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			if tt.wantCode == "" {
+				assert.Equal(t, http.StatusOK, rr.Code)
+				return
+			}
+			assert.Equal(t, http.StatusUnauthorized, rr.Code)
+			var body struct {
+				Code string `json:"code"`
+			}
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+			assert.Equal(t, tt.wantCode, body.Code)
+		})
+	}
+
+	// Without an audience or issuer configured, neither claim is required
+	assert.Equal(t, http.StatusOK, authStatus(okHandler(HMACVerifier(testJWTSecret)), "/instances",
+		signWith(t, jwt.SigningMethodHS256, []byte(testJWTSecret), "", jwt.MapClaims{"sub": "user"})))
+}
+
+func TestJWTVerifier_Inspect(t *testing.T) {
+	verifier, err := NewJWTVerifier(JWTConfig{Secret: testJWTSecret, Audience: "hypeman"})
+	require.NoError(t, err)
+
+	// Registry tokens are verified as on registry paths
+	ti, err := verifier.Inspect(signWith(t, jwt.SigningMethodHS256, []byte(testJWTSecret), "", jwt.MapClaims{
+		"sub":      "builder-b1",
+		"build_id": "b1",
+		"repos":    []string{"builds/b1"},
+		"scope":    "push",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "registry", ti.Type)
+	assert.True(t, ti.Valid, ti.Error)
+
+	// User tokens go through the audience check
+	ti, err = verifier.Inspect(signWith(t, jwt.SigningMethodHS256, []byte(testJWTSecret), "kid-1", jwt.MapClaims{"sub": "user", "aud": "billing"}))
+	require.NoError(t, err)
+	assert.Equal(t, "user", ti.Type)
+	assert.False(t, ti.Valid)
+	assert.Equal(t, errInvalidAudience.Error(), ti.Error)
+	assert.Equal(t, "kid-1", ti.KeyID)
+	assert.Equal(t, []string{"billing"}, ti.Audience)
+	assert.NotNil(t, ti.ExpiresAt)
+
+	_, err = verifier.Inspect("not.a.jwt")
+	assert.Error(t, err)
+}
@@ -3,13 +3,17 @@ package middleware
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
 	"github.com/golang-jwt/jwt/v5"
+	nethttpmiddleware "github.com/oapi-codegen/nethttp-middleware"
+	"github.com/onkernel/hypeman/lib/apikeys"
 	"github.com/onkernel/hypeman/lib/logger"
 )
 
@@ -17,8 +21,28 @@ type contextKey string
 
 const userIDKey contextKey = "user_id"
 
-// registryPathPattern matches /v2/{repository}/... paths
-var registryPathPattern = regexp.MustCompile(`^/v2/([^/]+(?:/[^/]+)?)/`)
+// registryPathPattern matches /v2/{repository}/{manifests,blobs,tags,referrers}/... paths.
+// Repository names may have any number of components, so match up to the last endpoint segment.
+var registryPathPattern = regexp.MustCompile(`^/v2/(.+)/(?:manifests|blobs|tags|referrers)/`)
+
+// authError is an authentication failure reported with its own error code
+// rather than the generic "Unauthorized"
+type authError struct {
+	code    string
+	message string
+}
+
+func (e *authError) Error() string {
+	return e.message
+}
+
+var (
+	// errInvalidAudience is returned for a user token without the configured audience
+	errInvalidAudience = &authError{code: "invalid_audience", message: "token audience is not accepted by this service"}
+
+	// errInvalidIssuer is returned for a user token without the configured issuer
+	errInvalidIssuer = &authError{code: "invalid_issuer", message: "token issuer is not trusted"}
+)
 
 // RegistryTokenClaims contains the claims for a scoped registry access token.
 // This mirrors the type in lib/builds/registry_token.go to avoid circular imports.
@@ -31,7 +55,8 @@ type RegistryTokenClaims struct {
 
 // OapiAuthenticationFunc creates an AuthenticationFunc compatible with nethttp-middleware
 // that validates JWT bearer tokens for endpoints with security requirements.
-func OapiAuthenticationFunc(jwtSecret string) openapi3filter.AuthenticationFunc {
+// "Authorization: ApiKey <key>" is accepted too when apiKeys is non-nil.
+func OapiAuthenticationFunc(verifier *JWTVerifier, apiKeys apikeys.Manager) openapi3filter.AuthenticationFunc {
 	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
 		log := logger.FromContext(ctx)
 
@@ -52,6 +77,17 @@ func OapiAuthenticationFunc(jwtSecret string) openapi3filter.AuthenticationFunc
 			return fmt.Errorf("authorization header required")
 		}
 
+		// API keys are an alternative to JWTs
+		if key, ok := extractAPIKey(authHeader); ok {
+			req := input.RequestValidationInput.Request
+			newCtx, err := authenticateAPIKey(ctx, apiKeys, key, req)
+			if err != nil {
+				return err
+			}
+			*req = *req.WithContext(newCtx)
+			return nil
+		}
+
 		// Extract bearer token
 		token, err := extractBearerToken(authHeader)
 		if err != nil {
@@ -61,16 +97,14 @@ func OapiAuthenticationFunc(jwtSecret string) openapi3filter.AuthenticationFunc
 
 		// Parse and validate JWT
 		claims := jwt.MapClaims{}
-		parsedToken, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
-			// Validate signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(jwtSecret), nil
-		})
+		parsedToken, err := verifier.parseUserToken(token, claims)
 
 		if err != nil {
 			log.DebugContext(ctx, "failed to parse JWT", "error", err)
+			var authErr *authError
+			if errors.As(err, &authErr) {
+				return authErr
+			}
 			return fmt.Errorf("invalid token")
 		}
 
@@ -102,6 +136,7 @@ func OapiAuthenticationFunc(jwtSecret string) openapi3filter.AuthenticationFunc
 
 		// Update the context with user ID
 		newCtx := context.WithValue(ctx, userIDKey, userID)
+		recordAuditUser(ctx, userID)
 
 		// Update the request with the new context
 		*input.RequestValidationInput.Request = *input.RequestValidationInput.Request.WithContext(newCtx)
@@ -113,13 +148,38 @@ func OapiAuthenticationFunc(jwtSecret string) openapi3filter.AuthenticationFunc
 // OapiErrorHandler creates a custom error handler for nethttp-middleware
 // that returns consistent error responses.
 func OapiErrorHandler(w http.ResponseWriter, message string, statusCode int) {
+	writeError(w, http.StatusText(statusCode), message, statusCode)
+}
+
+// OapiErrorHandlerWithOpts is OapiErrorHandler for nethttp-middleware's
+// ErrorHandlerWithOpts, which is given the error itself so authentication
+// failures can report their own error code. Other errors get the same
+// responses as from OapiErrorHandler.
+func OapiErrorHandlerWithOpts(ctx context.Context, err error, w http.ResponseWriter, r *http.Request, opts nethttpmiddleware.ErrorHandlerOpts) {
+	var authErr *authError
+	if errors.As(err, &authErr) {
+		writeError(w, authErr.code, authErr.message, http.StatusUnauthorized)
+		return
+	}
+
+	statusCode := opts.StatusCode
+	if errors.Is(err, routers.ErrMethodNotAllowed) {
+		statusCode = http.StatusMethodNotAllowed
+	}
+	message := err.Error()
+	var reqErr *openapi3filter.RequestError
+	if errors.As(err, &reqErr) {
+		// Request errors span several lines; the first one is the useful part
+		message, _, _ = strings.Cut(message, "\n")
+	}
+	OapiErrorHandler(w, message, statusCode)
+}
+
+// writeError writes a JSON error response matching our Error schema
+func writeError(w http.ResponseWriter, code, message string, statusCode int) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
-
-	// Return a simple JSON error response matching our Error schema
-	fmt.Fprintf(w, `{"code":"%s","message":"%s"}`,
-		http.StatusText(statusCode),
-		message)
+	fmt.Fprintf(w, `{"code":"%s","message":"%s"}`, code, message)
 }
 
 // extractBearerToken extracts the token from "Bearer <token>" format
@@ -214,62 +274,35 @@ func isWriteOperation(method string) bool {
 	return method == http.MethodPut || method == http.MethodPost || method == http.MethodPatch || method == http.MethodDelete
 }
 
-// validateRegistryToken validates a registry-scoped JWT token and checks repository access.
-// Returns the claims if valid, nil otherwise.
-func validateRegistryToken(tokenString, jwtSecret, requestPath, method string) (*RegistryTokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &RegistryTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(jwtSecret), nil
-	})
+// validateRegistryToken validates a registry-scoped JWT token and returns its
+// claims and the repository access it grants. Authorization against a specific
+// request is checked separately with registryScopes.authorize.
+func validateRegistryToken(tokenString string, verifier *JWTVerifier) (*RegistryTokenClaims, registryScopes, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &RegistryTokenClaims{}, verifier.registryKeyfunc)
 
 	if err != nil {
-		return nil, fmt.Errorf("parse token: %w", err)
+		return nil, nil, fmt.Errorf("parse token: %w", err)
 	}
 
 	claims, ok := token.Claims.(*RegistryTokenClaims)
 	if !ok || !token.Valid {
-		return nil, fmt.Errorf("invalid token")
-	}
-
-	// Check if this is a registry token (has repos claim)
-	if len(claims.Repositories) == 0 {
-		return nil, fmt.Errorf("not a registry token")
-	}
-
-	// Extract repository from request path
-	repo := extractRepoFromPath(requestPath)
-	if repo == "" {
-		// Allow /v2/ (base path check) without repo validation
-		if requestPath == "/v2/" || requestPath == "/v2" {
-			return claims, nil
-		}
-		return nil, fmt.Errorf("could not extract repository from path")
-	}
-
-	// Check if the repository is allowed by the token
-	allowed := false
-	for _, allowedRepo := range claims.Repositories {
-		if allowedRepo == repo {
-			allowed = true
-			break
-		}
-	}
-	if !allowed {
-		return nil, fmt.Errorf("repository %s not allowed by token", repo)
+		return nil, nil, fmt.Errorf("invalid token")
 	}
 
-	// Check scope for write operations
-	if isWriteOperation(method) && claims.Scope != "push" {
-		return nil, fmt.Errorf("token does not allow write operations")
+	// Registry tokens carry either a repos claim (build tokens) or
+	// repository scopes; anything else is not a registry token
+	scopes, err := parseRegistryScopes(claims)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return claims, nil
+	return claims, scopes, nil
 }
 
-// JwtAuth creates a chi middleware that validates JWT bearer tokens
-func JwtAuth(jwtSecret string) func(http.Handler) http.Handler {
+// JwtAuth creates a chi middleware that validates JWT bearer tokens.
+// Registry paths also accept the registry tokens hypeman gives builder VMs.
+// "Authorization: ApiKey <key>" is accepted too when apiKeys is non-nil.
+func JwtAuth(verifier *JWTVerifier, apiKeys apikeys.Manager) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			log := logger.FromContext(r.Context())
@@ -286,14 +319,30 @@ func JwtAuth(jwtSecret string) func(http.Handler) http.Handler {
 						log.DebugContext(r.Context(), "extracted token for registry request", "auth_type", authType)
 
 						// Try to validate as a registry-scoped token
-						registryClaims, err := validateRegistryToken(token, jwtSecret, r.URL.Path, r.Method)
+						registryClaims, scopes, err := validateRegistryToken(token, verifier)
 						if err == nil {
-							// Valid registry token - set build ID as user for audit trail
+							// A valid token that doesn't cover this request is denied outright,
+							// never retried through the IP fallback below
+							if err := scopes.authorize(r.URL.Path, r.Method); err != nil {
+								log.DebugContext(r.Context(), "registry request forbidden",
+									"error", err,
+									"sub", registryClaims.Subject,
+									"scope", registryClaims.Scope)
+								OapiErrorHandler(w, "token does not grant access to this repository", http.StatusForbidden)
+								return
+							}
+
 							log.DebugContext(r.Context(), "registry token validated",
 								"build_id", registryClaims.BuildID,
 								"repos", registryClaims.Repositories,
 								"scope", registryClaims.Scope)
-							ctx := context.WithValue(r.Context(), userIDKey, "builder-"+registryClaims.BuildID)
+							// Build tokens are attributed to their build for the audit trail
+							userID := registryClaims.Subject
+							if registryClaims.BuildID != "" {
+								userID = "builder-" + registryClaims.BuildID
+							}
+							ctx := context.WithValue(r.Context(), userIDKey, userID)
+							recordAuditUser(ctx, userID)
 							next.ServeHTTP(w, r.WithContext(ctx))
 							return
 						}
@@ -310,6 +359,7 @@ func JwtAuth(jwtSecret string) func(http.Handler) http.Handler {
 						"remote_addr", r.RemoteAddr,
 						"path", r.URL.Path)
 					ctx := context.WithValue(r.Context(), userIDKey, "internal-builder-legacy")
+					recordAuditUser(ctx, "internal-builder-legacy")
 					next.ServeHTTP(w, r.WithContext(ctx))
 					return
 				}
@@ -327,6 +377,20 @@ func JwtAuth(jwtSecret string) func(http.Handler) http.Handler {
 				return
 			}
 
+			// API keys are an alternative to JWTs
+			if key, ok := extractAPIKey(authHeader); ok {
+				ctx, err := authenticateAPIKey(r.Context(), apiKeys, key, r)
+				switch {
+				case errors.Is(err, errInsufficientScope):
+					OapiErrorHandler(w, err.Error(), http.StatusForbidden)
+				case err != nil:
+					OapiErrorHandler(w, err.Error(), http.StatusUnauthorized)
+				default:
+					next.ServeHTTP(w, r.WithContext(ctx))
+				}
+				return
+			}
+
 			// Extract bearer token
 			token, err := extractBearerToken(authHeader)
 			if err != nil {
@@ -337,16 +401,15 @@ func JwtAuth(jwtSecret string) func(http.Handler) http.Handler {
 
 			// Parse and validate as regular user JWT
 			claims := jwt.MapClaims{}
-			parsedToken, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
-				// Validate signing method
-				if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-					return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-				}
-				return []byte(jwtSecret), nil
-			})
+			parsedToken, err := verifier.parseUserToken(token, claims)
 
 			if err != nil {
 				log.DebugContext(r.Context(), "failed to parse JWT", "error", err)
+				var authErr *authError
+				if errors.As(err, &authErr) {
+					writeError(w, authErr.code, authErr.message, http.StatusUnauthorized)
+					return
+				}
 				OapiErrorHandler(w, "invalid token", http.StatusUnauthorized)
 				return
 			}
@@ -390,6 +453,7 @@ func JwtAuth(jwtSecret string) func(http.Handler) http.Handler {
 
 			// Update the context with user ID
 			newCtx := context.WithValue(r.Context(), userIDKey, userID)
+			recordAuditUser(newCtx, userID)
 
 			// Call next handler with updated context
 			next.ServeHTTP(w, r.WithContext(newCtx))
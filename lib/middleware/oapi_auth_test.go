@@ -1,12 +1,15 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/onkernel/hypeman/lib/apikeys"
+	"github.com/onkernel/hypeman/lib/paths"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -48,7 +51,7 @@ func TestJwtAuth_RejectsRegistryTokens(t *testing.T) {
 	})
 
 	// Wrap with JwtAuth middleware
-	handler := JwtAuth(testJWTSecret)(nextHandler)
+	handler := JwtAuth(HMACVerifier(testJWTSecret), nil)(nextHandler)
 
 	t.Run("valid user token is accepted", func(t *testing.T) {
 		userToken := generateUserToken(t, "user-123")
@@ -140,7 +143,7 @@ func TestJwtAuth_RequiresAuthorization(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	handler := JwtAuth(testJWTSecret)(nextHandler)
+	handler := JwtAuth(HMACVerifier(testJWTSecret), nil)(nextHandler)
 
 	t.Run("missing authorization header is rejected", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/instances", nil)
@@ -202,3 +205,175 @@ func TestJwtAuth_RequiresAuthorization(t *testing.T) {
 	})
 }
 
+
+// generateScopedToken creates a token with a repository scope claim
+func generateScopedToken(t *testing.T, scope string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":   "user-123",
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"scope": scope,
+	})
+	tokenString, err := token.SignedString([]byte(testJWTSecret))
+	require.NoError(t, err)
+	return tokenString
+}
+
+func TestJwtAuth_RegistryScopes(t *testing.T) {
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := JwtAuth(HMACVerifier(testJWTSecret), nil)(nextHandler)
+
+	serve := func(method, path, token string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	t.Run("pull-only scope allows reads and forbids writes", func(t *testing.T) {
+		token := generateScopedToken(t, "repository:builds/abc:pull")
+
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v2/builds/abc/manifests/latest", token))
+		assert.Equal(t, http.StatusOK, serve(http.MethodHead, "/v2/builds/abc/blobs/sha256:abc", token))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodPut, "/v2/builds/abc/manifests/latest", token))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "/v2/builds/abc/blobs/uploads/", token))
+	})
+
+	t.Run("push scope allows writes", func(t *testing.T) {
+		token := generateScopedToken(t, "repository:builds/abc:push,pull")
+
+		assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/v2/builds/abc/blobs/uploads/", token))
+		assert.Equal(t, http.StatusOK, serve(http.MethodPut, "/v2/builds/abc/manifests/latest", token))
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v2/builds/abc/manifests/latest", token))
+	})
+
+	t.Run("deletes need push scope", func(t *testing.T) {
+		pushToken := generateScopedToken(t, "repository:builds/abc:push,pull")
+		pullToken := generateScopedToken(t, "repository:builds/abc:pull")
+		digest := "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+		assert.Equal(t, http.StatusOK, serve(http.MethodDelete, "/v2/builds/abc/manifests/latest", pushToken))
+		assert.Equal(t, http.StatusOK, serve(http.MethodDelete, "/v2/builds/abc/manifests/"+digest, pushToken))
+		assert.Equal(t, http.StatusOK, serve(http.MethodDelete, "/v2/builds/abc/blobs/"+digest, pushToken))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodDelete, "/v2/builds/other/manifests/latest", pushToken))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodDelete, "/v2/builds/abc/manifests/latest", pullToken))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodDelete, "/v2/builds/abc/blobs/"+digest, pullToken))
+	})
+
+	t.Run("push-only scope does not imply pull", func(t *testing.T) {
+		token := generateScopedToken(t, "repository:builds/abc:push")
+
+		assert.Equal(t, http.StatusOK, serve(http.MethodPut, "/v2/builds/abc/manifests/latest", token))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodGet, "/v2/builds/abc/manifests/latest", token))
+	})
+
+	t.Run("other repositories are forbidden", func(t *testing.T) {
+		token := generateScopedToken(t, "repository:builds/abc:push,pull repository:cache/x:pull")
+
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v2/cache/x/manifests/latest", token))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodPut, "/v2/cache/x/manifests/latest", token))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodGet, "/v2/builds/other/manifests/latest", token))
+		// A scope on a repository doesn't extend to nested repositories
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodGet, "/v2/builds/abc/nested/manifests/latest", token))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodGet, "/v2/_catalog", token))
+	})
+
+	t.Run("version check is allowed for any scope", func(t *testing.T) {
+		token := generateScopedToken(t, "repository:builds/abc:pull")
+
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v2/", token))
+	})
+
+	t.Run("build tokens keep push and pull on their repositories", func(t *testing.T) {
+		token := generateRegistryToken(t, "build-abc123")
+
+		assert.Equal(t, http.StatusOK, serve(http.MethodPut, "/v2/builds/build-abc123/manifests/latest", token))
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v2/builds/build-abc123/manifests/latest", token))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodPut, "/v2/builds/other/manifests/latest", token))
+	})
+
+	t.Run("malformed scope is unauthorized", func(t *testing.T) {
+		token := generateScopedToken(t, "repository:builds/abc:delete")
+
+		assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/v2/builds/abc/manifests/latest", token))
+	})
+
+	t.Run("token without scope is unauthorized", func(t *testing.T) {
+		token := generateUserToken(t, "user-123")
+
+		assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/v2/builds/abc/manifests/latest", token))
+	})
+
+	t.Run("denied token does not fall back to VM network access", func(t *testing.T) {
+		token := generateScopedToken(t, "repository:builds/abc:pull")
+
+		req := httptest.NewRequest(http.MethodPut, "/v2/builds/abc/manifests/latest", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.RemoteAddr = "10.102.0.5:41234"
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestJwtAuth_APIKeys(t *testing.T) {
+	keys := apikeys.NewManager(paths.New(t.TempDir()))
+	ctx := context.Background()
+
+	full, fullSecret, err := keys.Create(ctx, apikeys.CreateAPIKeyRequest{Subject: "ci-bot"})
+	require.NoError(t, err)
+	_, readSecret, err := keys.Create(ctx, apikeys.CreateAPIKeyRequest{Subject: "viewer", Scopes: []string{"*:read"}})
+	require.NoError(t, err)
+
+	var gotUser string
+	handler := JwtAuth(HMACVerifier(testJWTSecret), keys)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUser = GetUserIDFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+	do := func(method, path, auth string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", auth)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, "/instances", "ApiKey "+fullSecret))
+	assert.Equal(t, "ci-bot", gotUser)
+
+	t.Run("scopes are enforced", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, do(http.MethodGet, "/instances/abc/logs", "ApiKey "+readSecret))
+		assert.Equal(t, http.StatusForbidden, do(http.MethodPost, "/instances", "ApiKey "+readSecret))
+	})
+
+	t.Run("exec and cp to the guest need write scope", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/instances/abc/exec", "ApiKey "+readSecret))
+		assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/instances/abc/cp", "ApiKey "+readSecret))
+		assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/instances/abc/cp?direction=to", "ApiKey "+readSecret))
+		assert.Equal(t, http.StatusOK, do(http.MethodGet, "/instances/abc/cp?direction=from", "ApiKey "+readSecret))
+		assert.Equal(t, http.StatusOK, do(http.MethodGet, "/instances/exec", "ApiKey "+readSecret))
+	})
+
+	t.Run("revoked keys are rejected immediately", func(t *testing.T) {
+		_, err := keys.Revoke(ctx, full.ID)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, do(http.MethodPost, "/instances", "ApiKey "+fullSecret))
+	})
+
+	t.Run("invalid keys are rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, do(http.MethodGet, "/instances", "ApiKey hm_nope_nope"))
+	})
+
+	t.Run("api keys are rejected when not configured", func(t *testing.T) {
+		h := JwtAuth(HMACVerifier(testJWTSecret), nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		req := httptest.NewRequest(http.MethodGet, "/instances", nil)
+		req.Header.Set("Authorization", "ApiKey "+readSecret)
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+// rateLimitIdleTimeout is how long a subject's state is kept after its last
+// request. Idle subjects have a full bucket and nothing in flight, so
+// forgetting them changes nothing.
+const rateLimitIdleTimeout = 10 * time.Minute
+
+// RateLimitConfig configures per-subject API limits. Zero values disable
+// the corresponding limit.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained request rate allowed per subject
+	RequestsPerSecond float64
+	// Burst is how many requests a subject can make at once before the rate
+	// applies. Defaults to RequestsPerSecond rounded up.
+	Burst int
+	// MaxInFlightMutations caps concurrent non-read requests per subject,
+	// e.g. how many CreateInstance calls can be booting VMs at once
+	MaxInFlightMutations int
+}
+
+// RateLimiter tracks request rates and in-flight mutations per JWT subject.
+type RateLimiter struct {
+	cfg RateLimitConfig
+
+	mu        sync.Mutex
+	subjects  map[string]*subjectLimit
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// subjectLimit is a token bucket plus an in-flight counter for one subject
+type subjectLimit struct {
+	tokens   float64
+	updated  time.Time
+	inFlight int
+}
+
+// NewRateLimiter creates a rate limiter. Returns nil if cfg sets no limits,
+// which RateLimit treats as disabled.
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	if cfg.RequestsPerSecond <= 0 && cfg.MaxInFlightMutations <= 0 {
+		return nil
+	}
+	if cfg.Burst <= 0 {
+		cfg.Burst = int(math.Ceil(cfg.RequestsPerSecond))
+	}
+	return &RateLimiter{
+		cfg:      cfg,
+		subjects: make(map[string]*subjectLimit),
+		now:      time.Now,
+	}
+}
+
+// acquire takes a request token for subject and, for mutations, an in-flight
+// slot. If the request is denied it returns how long to wait before retrying.
+// Allowed mutations must call release when done.
+func (l *RateLimiter) acquire(subject string, mutation bool) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	s, ok := l.subjects[subject]
+	if !ok {
+		s = &subjectLimit{tokens: float64(l.cfg.Burst), updated: now}
+		l.subjects[subject] = s
+	}
+
+	if l.cfg.RequestsPerSecond > 0 {
+		s.tokens = math.Min(float64(l.cfg.Burst), s.tokens+now.Sub(s.updated).Seconds()*l.cfg.RequestsPerSecond)
+	}
+	s.updated = now
+
+	if mutation && l.cfg.MaxInFlightMutations > 0 && s.inFlight >= l.cfg.MaxInFlightMutations {
+		return false, time.Second
+	}
+	if l.cfg.RequestsPerSecond > 0 {
+		if s.tokens < 1 {
+			wait := time.Duration((1 - s.tokens) / l.cfg.RequestsPerSecond * float64(time.Second))
+			return false, wait
+		}
+		s.tokens--
+	}
+	if mutation {
+		s.inFlight++
+	}
+	return true, 0
+}
+
+// release frees an in-flight mutation slot taken by acquire
+func (l *RateLimiter) release(subject string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if s, ok := l.subjects[subject]; ok && s.inFlight > 0 {
+		s.inFlight--
+		s.updated = l.now()
+	}
+}
+
+// sweep drops idle subjects so the map doesn't grow without bound.
+// Called with mu held.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitIdleTimeout {
+		return
+	}
+	l.lastSweep = now
+	for subject, s := range l.subjects {
+		if s.inFlight == 0 && now.Sub(s.updated) > rateLimitIdleTimeout {
+			delete(l.subjects, subject)
+		}
+	}
+}
+
+// RateLimit returns middleware that enforces per-subject limits, answering
+// 429 with a Retry-After header when one is exceeded. It must run after
+// authentication so the JWT subject is in the context; requests without one
+// (endpoints with no security requirement) are limited by client IP.
+// A nil limiter disables limiting.
+func RateLimit(l *RateLimiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if l == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			subject := GetUserIDFromContext(r.Context())
+			if subject == "" {
+				subject = "ip:" + clientIP(r)
+			}
+			mutation := !isReadOnlyMethod(r.Method)
+
+			ok, wait := l.acquire(subject, mutation)
+			if !ok {
+				retryAfter := int(math.Ceil(wait.Seconds()))
+				if retryAfter < 1 {
+					retryAfter = 1
+				}
+				logger.FromContext(r.Context()).WarnContext(r.Context(), "rate limit exceeded",
+					"subject", subject, "method", r.Method, "path", r.URL.Path, "retry_after", retryAfter)
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+				OapiErrorHandler(w, "rate limit exceeded, retry later", http.StatusTooManyRequests)
+				return
+			}
+			if mutation {
+				defer l.release(subject)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientIP returns the host part of the request's remote address (already
+// rewritten by chi's RealIP middleware when behind a proxy)
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
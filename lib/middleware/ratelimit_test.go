@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_RequestRate(t *testing.T) {
+	l := NewRateLimiter(RateLimitConfig{RequestsPerSecond: 2, Burst: 2})
+	now := time.Now()
+	l.now = func() time.Time { return now }
+
+	ok, _ := l.acquire("alice", false)
+	assert.True(t, ok)
+	ok, _ = l.acquire("alice", false)
+	assert.True(t, ok)
+	ok, wait := l.acquire("alice", false)
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, wait)
+
+	// Other subjects have their own bucket
+	ok, _ = l.acquire("bob", false)
+	assert.True(t, ok)
+
+	now = now.Add(500 * time.Millisecond)
+	ok, _ = l.acquire("alice", false)
+	assert.True(t, ok)
+}
+
+func TestRateLimiter_InFlightMutations(t *testing.T) {
+	l := NewRateLimiter(RateLimitConfig{MaxInFlightMutations: 1})
+
+	ok, _ := l.acquire("alice", true)
+	require.True(t, ok)
+	ok, _ = l.acquire("alice", true)
+	assert.False(t, ok)
+
+	// Reads aren't counted against the mutation limit
+	ok, _ = l.acquire("alice", false)
+	assert.True(t, ok)
+
+	l.release("alice")
+	ok, _ = l.acquire("alice", true)
+	assert.True(t, ok)
+}
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	assert.Nil(t, NewRateLimiter(RateLimitConfig{}))
+
+	called := false
+	h := RateLimit(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true }))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/instances", nil))
+	assert.True(t, called)
+}
+
+func TestRateLimit_Middleware(t *testing.T) {
+	l := NewRateLimiter(RateLimitConfig{MaxInFlightMutations: 1})
+
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	h := RateLimit(l)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-unblock
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	request := func(user string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/instances", nil)
+		return req.WithContext(context.WithValue(req.Context(), userIDKey, user))
+	}
+
+	done := make(chan int)
+	go func() {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, request("alice"))
+		done <- rr.Code
+	}()
+	<-started
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, request("alice"))
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+	assert.Contains(t, rr.Body.String(), "rate limit exceeded")
+
+	close(unblock)
+	assert.Equal(t, http.StatusCreated, <-done)
+}
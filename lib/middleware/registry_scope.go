@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Registry actions a token can grant on a repository
+const (
+	registryActionPull = "pull"
+	registryActionPush = "push"
+)
+
+// errRegistryAccessDenied is returned when a valid registry token doesn't
+// grant the repository or action a request needs
+var errRegistryAccessDenied = errors.New("registry access denied")
+
+// registryScopes maps repository names to the actions a token grants on them
+type registryScopes map[string]map[string]bool
+
+// parseRegistryScopes reads the repositories and actions a registry token grants.
+//
+// The scope claim is a space-separated list of entries in the form
+// "repository:<name>:<actions>", e.g. "repository:builds/abc:push,pull".
+// Build tokens instead list repositories in the repos claim with a bare
+// "push" or "pull" scope; push implies pull for those.
+func parseRegistryScopes(claims *RegistryTokenClaims) (registryScopes, error) {
+	scopes := make(registryScopes)
+
+	if len(claims.Repositories) > 0 {
+		actions := map[string]bool{}
+		switch claims.Scope {
+		case registryActionPush:
+			actions[registryActionPush] = true
+			actions[registryActionPull] = true
+		case registryActionPull:
+			actions[registryActionPull] = true
+		default:
+			return nil, fmt.Errorf("unsupported scope %q for repos claim", claims.Scope)
+		}
+		for _, repo := range claims.Repositories {
+			scopes[repo] = actions
+		}
+		return scopes, nil
+	}
+
+	for _, entry := range strings.Fields(claims.Scope) {
+		rest, ok := strings.CutPrefix(entry, "repository:")
+		if !ok {
+			return nil, fmt.Errorf("unsupported scope entry %q", entry)
+		}
+		idx := strings.LastIndex(rest, ":")
+		if idx <= 0 {
+			return nil, fmt.Errorf("invalid scope entry %q: expected repository:<name>:<actions>", entry)
+		}
+		repo, actionList := rest[:idx], rest[idx+1:]
+
+		if scopes[repo] == nil {
+			scopes[repo] = map[string]bool{}
+		}
+		for _, action := range strings.Split(actionList, ",") {
+			switch action {
+			case registryActionPull, registryActionPush:
+				scopes[repo][action] = true
+			case "*":
+				scopes[repo][registryActionPull] = true
+				scopes[repo][registryActionPush] = true
+			default:
+				return nil, fmt.Errorf("invalid scope entry %q: unknown action %q", entry, action)
+			}
+		}
+	}
+
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("not a registry token")
+	}
+	return scopes, nil
+}
+
+// authorize checks that the scopes allow a request. Writes need push and
+// everything else needs pull on the repository in the path. The /v2/ version
+// check is allowed for any registry token.
+func (s registryScopes) authorize(path, method string) error {
+	repo := extractRepoFromPath(path)
+	if repo == "" {
+		if path == "/v2/" || path == "/v2" {
+			return nil
+		}
+		return fmt.Errorf("%w: could not extract repository from path", errRegistryAccessDenied)
+	}
+
+	action := registryActionPull
+	if isWriteOperation(method) {
+		action = registryActionPush
+	}
+	if !s[repo][action] {
+		return fmt.Errorf("%w: %s not allowed on repository %s", errRegistryAccessDenied, action, repo)
+	}
+	return nil
+}
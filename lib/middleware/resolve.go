@@ -41,6 +41,7 @@ type Resolvers struct {
 	Volume   ResourceResolver
 	Ingress  ResourceResolver
 	Image    ResourceResolver
+	Build    ResourceResolver
 }
 
 // ErrorResponder handles resolver errors by writing HTTP responses.
@@ -55,6 +56,7 @@ type ErrorResponder func(w http.ResponseWriter, err error, lookup string)
 //   - /volumes/{id}/* -> uses Volume resolver
 //   - /ingresses/{id}/* -> uses Ingress resolver
 //   - /images/{name}/* -> uses Image resolver (by name, not ID)
+//   - /builds/{id}/* -> uses Build resolver (by ID or prefix, builds have no names)
 func ResolveResource(resolvers Resolvers, errResponder ErrorResponder) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -83,6 +85,10 @@ func ResolveResource(resolvers Resolvers, errResponder ErrorResponder) func(http
 				resolver = resolvers.Image
 				resourceType = "image"
 				paramName = "name"
+			case strings.HasPrefix(path, "/builds/"):
+				resolver = resolvers.Build
+				resourceType = "build"
+				paramName = "id"
 			default:
 				// No resource to resolve (e.g., list endpoints, health)
 				next.ServeHTTP(w, r)
@@ -110,6 +116,8 @@ func ResolveResource(resolvers Resolvers, errResponder ErrorResponder) func(http
 				return
 			}
 
+			recordAuditResource(ctx, resourceType, resolvedID)
+
 			// Store resolved resource in context
 			ctx = context.WithValue(ctx, resolvedResourceKey{resourceType}, ResolvedResource{
 				ID:       resolvedID,
@@ -171,6 +179,12 @@ func GetResolvedImage[T any](ctx context.Context) *T {
 	return getResolved[T](ctx, "image")
 }
 
+// GetResolvedBuild retrieves the resolved build from context.
+// Returns nil if not found or wrong type.
+func GetResolvedBuild[T any](ctx context.Context) *T {
+	return getResolved[T](ctx, "build")
+}
+
 // GetResolvedID retrieves just the resolved ID for a resource type.
 func GetResolvedID(ctx context.Context, resourceType string) string {
 	if resolved, ok := ctx.Value(resolvedResourceKey{resourceType}).(ResolvedResource); ok {
@@ -220,3 +234,8 @@ func WithResolvedIngress(ctx context.Context, id string, ing any) context.Contex
 func WithResolvedImage(ctx context.Context, id string, img any) context.Context {
 	return context.WithValue(ctx, resolvedResourceKey{"image"}, ResolvedResource{ID: id, Resource: img})
 }
+
+// WithResolvedBuild returns a context with the given build set as resolved.
+func WithResolvedBuild(ctx context.Context, id string, build any) context.Context {
+	return context.WithValue(ctx, resolvedResourceKey{"build"}, ResolvedResource{ID: id, Resource: build})
+}
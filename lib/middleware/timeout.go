@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// RequestTimeouts configures how long API requests may run, by class, before
+// their context is canceled and they get 504. Zero disables a class's timeout.
+type RequestTimeouts struct {
+	// Read applies to GET, HEAD and OPTIONS requests
+	Read time.Duration
+	// Write applies to other requests
+	Write time.Duration
+	// Long applies to requests that boot VMs, run commands or builds, or
+	// transfer files (see longRunningRoutes)
+	Long time.Duration
+}
+
+// longRunningRoutes are the operations under RequestTimeouts.Long, keyed by
+// method and chi route pattern
+var longRunningRoutes = map[string]bool{
+	"POST /instances":               true,
+	"POST /instances/batch":         true,
+	"POST /instances/{id}/clone":    true,
+	"POST /instances/{id}/restore":  true,
+	"POST /instances/{id}/start":    true,
+	"POST /instances/{id}/standby":  true,
+	"POST /instances/{id}/exec/run": true,
+	"GET /instances/{id}/files":     true,
+	"POST /volumes":                 true,
+	"POST /builds":                  true,
+	"GET /builds/{id}/artifact":     true,
+}
+
+// Timeout applies the RequestTimeouts class of each request. It must run
+// after routing, as it classifies requests by their route pattern. Log and
+// event streams last as long as the client stays connected and have no
+// timeout.
+func Timeout(timeouts RequestTimeouts) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		withTimeout := func(d time.Duration) http.Handler {
+			if d <= 0 {
+				return next
+			}
+			return chimiddleware.Timeout(d)(next)
+		}
+		read := withTimeout(timeouts.Read)
+		write := withTimeout(timeouts.Write)
+		long := withTimeout(timeouts.Long)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			pattern := ""
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				pattern = rctx.RoutePattern()
+			}
+
+			switch {
+			case strings.HasSuffix(pattern, "/logs") || strings.HasSuffix(pattern, "/events"):
+				next.ServeHTTP(w, r)
+			case longRunningRoutes[r.Method+" "+pattern]:
+				long.ServeHTTP(w, r)
+			case isReadOnlyMethod(r.Method):
+				read.ServeHTTP(w, r)
+			default:
+				write.ServeHTTP(w, r)
+			}
+		})
+	}
+}
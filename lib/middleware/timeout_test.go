@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeout(t *testing.T) {
+	timeouts := RequestTimeouts{Read: time.Second, Write: time.Minute, Long: time.Hour}
+
+	var got time.Duration
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		got = 0
+		if deadline, ok := r.Context().Deadline(); ok {
+			got = time.Until(deadline).Round(time.Second)
+		}
+	}
+
+	r := chi.NewRouter()
+	r.Group(func(r chi.Router) {
+		r.Use(Timeout(timeouts))
+		r.Get("/instances/{id}", handler)
+		r.Delete("/instances/{id}", handler)
+		r.Post("/instances", handler)
+		r.Post("/instances/{id}/start", handler)
+		r.Get("/instances/{id}/files", handler)
+		r.Get("/instances/{id}/logs", handler)
+		r.Get("/builds/{id}/events", handler)
+	})
+
+	tests := []struct {
+		method, path string
+		want         time.Duration
+	}{
+		{http.MethodGet, "/instances/abc", time.Second},
+		{http.MethodDelete, "/instances/abc", time.Minute},
+		{http.MethodPost, "/instances", time.Hour},
+		{http.MethodPost, "/instances/abc/start", time.Hour},
+		{http.MethodGet, "/instances/abc/files", time.Hour},
+		{http.MethodGet, "/instances/abc/logs", 0},
+		{http.MethodGet, "/builds/abc/events", 0},
+	}
+	for _, tt := range tests {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, tt.path, nil))
+		assert.Equal(t, tt.want, got, "%s %s", tt.method, tt.path)
+	}
+
+	// A zero timeout disables its class
+	r = chi.NewRouter()
+	r.With(Timeout(RequestTimeouts{Write: time.Minute})).Get("/instances/{id}", handler)
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/instances/abc", nil))
+	assert.Equal(t, time.Duration(0), got)
+}
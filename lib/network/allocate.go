@@ -56,7 +56,8 @@ func (m *manager) CreateAllocation(ctx context.Context, req AllocateRequest) (*N
 	tap := generateTAPName(req.InstanceID)
 
 	// 6. Create TAP device with bidirectional rate limiting
-	if err := m.createTAPDevice(tap, network.Bridge, network.Isolated, req.DownloadBps, req.UploadBps, req.UploadCeilBps); err != nil {
+	mtu := m.tapMTU(req.MTU)
+	if err := m.createTAPDevice(tap, network.Bridge, network.Isolated, req.DownloadBps, req.UploadBps, req.UploadCeilBps, mtu); err != nil {
 		return nil, fmt.Errorf("create TAP device: %w", err)
 	}
 	m.recordTAPOperation(ctx, "create")
@@ -69,7 +70,8 @@ func (m *manager) CreateAllocation(ctx context.Context, req AllocateRequest) (*N
 		"mac", mac,
 		"tap", tap,
 		"download_bps", req.DownloadBps,
-		"upload_bps", req.UploadBps)
+		"upload_bps", req.UploadBps,
+		"mtu", mtu)
 
 	// 7. Calculate netmask from subnet
 	_, ipNet, _ := net.ParseCIDR(network.Subnet)
@@ -83,6 +85,7 @@ func (m *manager) CreateAllocation(ctx context.Context, req AllocateRequest) (*N
 		Netmask:   netmask,
 		DNS:       m.config.DNSServer,
 		TAPDevice: tap,
+		MTU:       mtu,
 	}, nil
 }
 
@@ -91,7 +94,7 @@ func (m *manager) CreateAllocation(ctx context.Context, req AllocateRequest) (*N
 // 1. Doesn't allocate new IPs (reuses existing from snapshot)
 // 2. Is already protected by instance-level locking
 // 3. Uses deterministic TAP names that can't conflict
-func (m *manager) RecreateAllocation(ctx context.Context, instanceID string, downloadBps, uploadBps int64) error {
+func (m *manager) RecreateAllocation(ctx context.Context, instanceID string, downloadBps, uploadBps int64, mtu int) error {
 	log := logger.FromContext(ctx)
 
 	// 1. Derive allocation from snapshot
@@ -110,9 +113,9 @@ func (m *manager) RecreateAllocation(ctx context.Context, instanceID string, dow
 		return fmt.Errorf("get default network: %w", err)
 	}
 
-	// 3. Recreate TAP device with same name and rate limits from instance metadata
+	// 3. Recreate TAP device with same name, rate limits and MTU from instance metadata
 	uploadCeilBps := uploadBps * int64(m.GetUploadBurstMultiplier())
-	if err := m.createTAPDevice(alloc.TAPDevice, network.Bridge, network.Isolated, downloadBps, uploadBps, uploadCeilBps); err != nil {
+	if err := m.createTAPDevice(alloc.TAPDevice, network.Bridge, network.Isolated, downloadBps, uploadBps, uploadCeilBps, m.tapMTU(mtu)); err != nil {
 		return fmt.Errorf("create TAP device: %w", err)
 	}
 	m.recordTAPOperation(ctx, "create")
@@ -127,6 +130,15 @@ func (m *manager) RecreateAllocation(ctx context.Context, instanceID string, dow
 	return nil
 }
 
+// tapMTU returns the MTU for an instance's TAP device: its own, or the
+// network's (0 leaves the kernel default)
+func (m *manager) tapMTU(mtu int) int {
+	if mtu > 0 {
+		return mtu
+	}
+	return m.config.NetworkMTU
+}
+
 // ReleaseAllocation cleans up network allocation (shutdown/delete)
 // Takes the allocation directly since it should be retrieved before the VMM is killed.
 // If alloc is nil, this is a no-op (network not allocated or already released).
@@ -269,6 +281,12 @@ func generateMAC() (string, error) {
 // TAPPrefix is the prefix used for hypeman TAP devices
 const TAPPrefix = "hype-"
 
+// TAPName returns the TAP device name of an instance. It lets cleanup find
+// the device without the instance's metadata.
+func TAPName(instanceID string) string {
+	return generateTAPName(instanceID)
+}
+
 // generateTAPName generates TAP device name from instance ID
 func generateTAPName(instanceID string) string {
 	// Use first 8 chars of instance ID
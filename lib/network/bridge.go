@@ -127,6 +127,9 @@ func (m *manager) createBridge(ctx context.Context, name, gateway, subnet string
 		}
 
 		// Bridge exists with correct IP, verify it's up
+		if err := m.setBridgeMTU(existing); err != nil {
+			return err
+		}
 		if err := netlink.LinkSetUp(existing); err != nil {
 			return fmt.Errorf("set bridge up: %w", err)
 		}
@@ -150,7 +153,10 @@ func (m *manager) createBridge(ctx context.Context, name, gateway, subnet string
 		return fmt.Errorf("create bridge: %w", err)
 	}
 
-	// 4. Set bridge up
+	// 4. Set bridge MTU and bring it up
+	if err := m.setBridgeMTU(bridge); err != nil {
+		return err
+	}
 	if err := netlink.LinkSetUp(bridge); err != nil {
 		return fmt.Errorf("set bridge up: %w", err)
 	}
@@ -182,6 +188,18 @@ func (m *manager) createBridge(ctx context.Context, name, gateway, subnet string
 	return nil
 }
 
+// setBridgeMTU applies NETWORK_MTU to the bridge. Set explicitly, the bridge
+// keeps it rather than following the smallest MTU of its ports.
+func (m *manager) setBridgeMTU(bridge netlink.Link) error {
+	if m.config.NetworkMTU <= 0 || bridge.Attrs().MTU == m.config.NetworkMTU {
+		return nil
+	}
+	if err := netlink.LinkSetMTU(bridge, m.config.NetworkMTU); err != nil {
+		return fmt.Errorf("set bridge MTU %d: %w", m.config.NetworkMTU, err)
+	}
+	return nil
+}
+
 // Rule comments for identifying hypeman iptables rules
 const (
 	commentNAT    = "hypeman-nat"
@@ -426,7 +444,8 @@ func (m *manager) deleteForwardRuleByComment(comment string) {
 // createTAPDevice creates TAP device and attaches to bridge.
 // downloadBps: rate limit for download (external→VM), applied as TBF on TAP egress
 // uploadBps/uploadCeilBps: rate limit for upload (VM→external), applied as HTB class on bridge
-func (m *manager) createTAPDevice(tapName, bridgeName string, isolated bool, downloadBps, uploadBps, uploadCeilBps int64) error {
+// mtu: TAP device MTU (0 = kernel default)
+func (m *manager) createTAPDevice(tapName, bridgeName string, isolated bool, downloadBps, uploadBps, uploadCeilBps int64, mtu int) error {
 	// 1. Check if TAP already exists
 	if _, err := netlink.LinkByName(tapName); err == nil {
 		// TAP already exists, delete it first
@@ -453,12 +472,18 @@ func (m *manager) createTAPDevice(tapName, bridgeName string, isolated bool, dow
 		return fmt.Errorf("create TAP device: %w", err)
 	}
 
-	// 3. Set TAP up
+	// 3. Set TAP MTU and bring it up
 	tapLink, err := netlink.LinkByName(tapName)
 	if err != nil {
 		return fmt.Errorf("get TAP link: %w", err)
 	}
 
+	if mtu > 0 {
+		if err := netlink.LinkSetMTU(tapLink, mtu); err != nil {
+			return fmt.Errorf("set TAP MTU %d: %w", mtu, err)
+		}
+	}
+
 	if err := netlink.LinkSetUp(tapLink); err != nil {
 		return fmt.Errorf("set TAP up: %w", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/onkernel/hypeman/cmd/api/config"
@@ -19,7 +20,7 @@ type Manager interface {
 
 	// Instance allocation operations (called by instance manager)
 	CreateAllocation(ctx context.Context, req AllocateRequest) (*NetworkConfig, error)
-	RecreateAllocation(ctx context.Context, instanceID string, downloadBps, uploadBps int64) error
+	RecreateAllocation(ctx context.Context, instanceID string, downloadBps, uploadBps int64, mtu int) error
 	ReleaseAllocation(ctx context.Context, alloc *Allocation) error
 
 	// SetupHTB initializes HTB qdisc on the bridge for upload fair sharing.
@@ -36,6 +37,9 @@ type Manager interface {
 
 	// GetDownloadBurstMultiplier returns the configured multiplier for download burst bucket.
 	GetDownloadBurstMultiplier() int
+
+	// Initialized reports whether Initialize has completed successfully.
+	Initialized() bool
 }
 
 // manager implements the Manager interface
@@ -44,6 +48,8 @@ type manager struct {
 	config  *config.Config
 	mu      sync.Mutex // Protects network allocation operations (IP allocation)
 	metrics *Metrics
+
+	initialized atomic.Bool
 }
 
 // NewManager creates a new network manager.
@@ -106,10 +112,16 @@ func (m *manager) Initialize(ctx context.Context, runningInstanceIDs []string) e
 		log.InfoContext(ctx, "cleaned up orphaned HTB classes", "count", deleted)
 	}
 
+	m.initialized.Store(true)
 	log.InfoContext(ctx, "network manager initialized")
 	return nil
 }
 
+// Initialized reports whether Initialize has completed successfully.
+func (m *manager) Initialized() bool {
+	return m.initialized.Load()
+}
+
 // getDefaultNetwork gets the default network details from kernel state
 func (m *manager) getDefaultNetwork(ctx context.Context) (*Network, error) {
 	// Query from kernel
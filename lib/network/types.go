@@ -13,6 +13,13 @@ const DefaultUploadBurstMultiplier = 4
 // Configurable via DOWNLOAD_BURST_MULTIPLIER environment variable.
 const DefaultDownloadBurstMultiplier = 4
 
+// MTU bounds for the bridge, TAP devices and guest NICs: 576 is the smallest
+// datagram IPv4 hosts must accept, 9000 a common jumbo frame size
+const (
+	MinMTU = 576
+	MaxMTU = 9000
+)
+
 // Network represents a virtual network for instances
 type Network struct {
 	Name      string // "default", "internal"
@@ -45,6 +52,7 @@ type NetworkConfig struct {
 	Netmask   string
 	DNS       string
 	TAPDevice string
+	MTU       int // MTU of the TAP device, for the guest NIC (0 = kernel default)
 }
 
 // AllocateRequest is the request to allocate network for an instance
@@ -55,4 +63,5 @@ type AllocateRequest struct {
 	DownloadBps   int64 // Download rate limit in bytes/sec (external→VM, TAP egress TBF)
 	UploadBps     int64 // Upload rate limit in bytes/sec (VM→external, HTB class rate)
 	UploadCeilBps int64 // Upload ceiling in bytes/sec (HTB burst when bandwidth available, 0 = same as UploadBps)
+	MTU           int   // TAP device MTU (0 = NETWORK_MTU)
 }
@@ -29,11 +29,26 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for BootStatusState.
+const (
+	BootStatusStateBooting  BootStatusState = "booting"
+	BootStatusStateComplete BootStatusState = "complete"
+	BootStatusStateFailed   BootStatusState = "failed"
+	BootStatusStateUnknown  BootStatusState = "unknown"
+)
+
+// Defines values for BuildOutputType.
+const (
+	BuildOutputTypeImage BuildOutputType = "image"
+	BuildOutputTypeLocal BuildOutputType = "local"
+	BuildOutputTypeTar   BuildOutputType = "tar"
+)
+
 // Defines values for BuildEventType.
 const (
-	Heartbeat BuildEventType = "heartbeat"
-	Log       BuildEventType = "log"
-	Status    BuildEventType = "status"
+	BuildEventTypeHeartbeat BuildEventType = "heartbeat"
+	BuildEventTypeLog       BuildEventType = "log"
+	BuildEventTypeStatus    BuildEventType = "status"
 )
 
 // Defines values for BuildStatus.
@@ -49,20 +64,64 @@ const (
 // Defines values for CreateInstanceRequestHypervisor.
 const (
 	CreateInstanceRequestHypervisorCloudHypervisor CreateInstanceRequestHypervisor = "cloud-hypervisor"
+	CreateInstanceRequestHypervisorFirecracker     CreateInstanceRequestHypervisor = "firecracker"
 	CreateInstanceRequestHypervisorQemu            CreateInstanceRequestHypervisor = "qemu"
 )
 
+// Defines values for CreateInstanceRequestIdleAction.
+const (
+	CreateInstanceRequestIdleActionStandby CreateInstanceRequestIdleAction = "standby"
+	CreateInstanceRequestIdleActionStop    CreateInstanceRequestIdleAction = "stop"
+)
+
+// Defines values for CreateInstanceRequestInitMode.
+const (
+	CreateInstanceRequestInitModeExec    CreateInstanceRequestInitMode = "exec"
+	CreateInstanceRequestInitModeSystemd CreateInstanceRequestInitMode = "systemd"
+)
+
+// Defines values for CreateInstanceRequestOverlayFormat.
+const (
+	CreateInstanceRequestOverlayFormatQcow2 CreateInstanceRequestOverlayFormat = "qcow2"
+	CreateInstanceRequestOverlayFormatRaw   CreateInstanceRequestOverlayFormat = "raw"
+)
+
 // Defines values for DeviceType.
 const (
 	Gpu DeviceType = "gpu"
 	Pci DeviceType = "pci"
 )
 
+// Defines values for ExecRunRequestSeccompProfile.
+const (
+	Default ExecRunRequestSeccompProfile = "default"
+)
+
+// Defines values for GuestFileEncoding.
+const (
+	Base64 GuestFileEncoding = "base64"
+	Utf8   GuestFileEncoding = "utf-8"
+)
+
 // Defines values for HealthStatus.
 const (
 	Ok HealthStatus = "ok"
 )
 
+// Defines values for HypervisorInfoType.
+const (
+	HypervisorInfoTypeCloudHypervisor HypervisorInfoType = "cloud-hypervisor"
+	HypervisorInfoTypeFirecracker     HypervisorInfoType = "firecracker"
+	HypervisorInfoTypeQemu            HypervisorInfoType = "qemu"
+)
+
+// Defines values for HypervisorListDefault.
+const (
+	HypervisorListDefaultCloudHypervisor HypervisorListDefault = "cloud-hypervisor"
+	HypervisorListDefaultFirecracker     HypervisorListDefault = "firecracker"
+	HypervisorListDefaultQemu            HypervisorListDefault = "qemu"
+)
+
 // Defines values for ImageStatus.
 const (
 	ImageStatusConverting ImageStatus = "converting"
@@ -72,30 +131,174 @@ const (
 	ImageStatusReady      ImageStatus = "ready"
 )
 
+// Defines values for ImagePullEventStatus.
+const (
+	ImagePullEventStatusConverting ImagePullEventStatus = "converting"
+	ImagePullEventStatusFailed     ImagePullEventStatus = "failed"
+	ImagePullEventStatusPending    ImagePullEventStatus = "pending"
+	ImagePullEventStatusPulling    ImagePullEventStatus = "pulling"
+	ImagePullEventStatusReady      ImagePullEventStatus = "ready"
+)
+
+// Defines values for ImagePullEventType.
+const (
+	ImagePullEventTypeHeartbeat ImagePullEventType = "heartbeat"
+	ImagePullEventTypeProgress  ImagePullEventType = "progress"
+	ImagePullEventTypeStatus    ImagePullEventType = "status"
+)
+
+// Defines values for IngressMatchProtocol.
+const (
+	Http IngressMatchProtocol = "http"
+	Tcp  IngressMatchProtocol = "tcp"
+)
+
 // Defines values for InstanceHypervisor.
 const (
 	InstanceHypervisorCloudHypervisor InstanceHypervisor = "cloud-hypervisor"
+	InstanceHypervisorFirecracker     InstanceHypervisor = "firecracker"
 	InstanceHypervisorQemu            InstanceHypervisor = "qemu"
 )
 
+// Defines values for InstanceIdleAction.
+const (
+	InstanceIdleActionStandby InstanceIdleAction = "standby"
+	InstanceIdleActionStop    InstanceIdleAction = "stop"
+)
+
+// Defines values for InstanceInitMode.
+const (
+	InstanceInitModeExec    InstanceInitMode = "exec"
+	InstanceInitModeSystemd InstanceInitMode = "systemd"
+)
+
+// Defines values for InstanceOverlayFormat.
+const (
+	InstanceOverlayFormatQcow2 InstanceOverlayFormat = "qcow2"
+	InstanceOverlayFormatRaw   InstanceOverlayFormat = "raw"
+)
+
+// Defines values for InstanceStateReason.
+const (
+	HypervisorClientError InstanceStateReason = "hypervisor_client_error"
+	SnapshotIncomplete    InstanceStateReason = "snapshot_incomplete"
+	UnexpectedVmmState    InstanceStateReason = "unexpected_vmm_state"
+	VmmExited             InstanceStateReason = "vmm_exited"
+	VmmUnreachable        InstanceStateReason = "vmm_unreachable"
+)
+
+// Defines values for InstanceEventType.
+const (
+	InstanceEventTypeCreated InstanceEventType = "created"
+	InstanceEventTypeDeleted InstanceEventType = "deleted"
+	InstanceEventTypeRunning InstanceEventType = "running"
+	InstanceEventTypeStandby InstanceEventType = "standby"
+	InstanceEventTypeStopped InstanceEventType = "stopped"
+)
+
 // Defines values for InstanceState.
 const (
-	Created  InstanceState = "Created"
-	Paused   InstanceState = "Paused"
-	Running  InstanceState = "Running"
-	Shutdown InstanceState = "Shutdown"
-	Standby  InstanceState = "Standby"
-	Stopped  InstanceState = "Stopped"
-	Unknown  InstanceState = "Unknown"
+	InstanceStateCreated  InstanceState = "Created"
+	InstanceStatePaused   InstanceState = "Paused"
+	InstanceStateRunning  InstanceState = "Running"
+	InstanceStateShutdown InstanceState = "Shutdown"
+	InstanceStateStandby  InstanceState = "Standby"
+	InstanceStateStopped  InstanceState = "Stopped"
+	InstanceStateUnknown  InstanceState = "Unknown"
+)
+
+// Defines values for OverlayCompactionOverlayFormat.
+const (
+	Qcow2 OverlayCompactionOverlayFormat = "qcow2"
+	Raw   OverlayCompactionOverlayFormat = "raw"
+)
+
+// Defines values for PreservedSnapshotHypervisor.
+const (
+	CloudHypervisor PreservedSnapshotHypervisor = "cloud-hypervisor"
+	Firecracker     PreservedSnapshotHypervisor = "firecracker"
+	Qemu            PreservedSnapshotHypervisor = "qemu"
+)
+
+// Defines values for TokenVerificationType.
+const (
+	Registry TokenVerificationType = "registry"
+	User     TokenVerificationType = "user"
+)
+
+// Defines values for ListBuildsParamsSort.
+const (
+	ListBuildsParamsSortCreatedAt      ListBuildsParamsSort = "created_at"
+	ListBuildsParamsSortMinusCreatedAt ListBuildsParamsSort = "-created_at"
+	ListBuildsParamsSortMinusStatus    ListBuildsParamsSort = "-status"
+	ListBuildsParamsSortStatus         ListBuildsParamsSort = "status"
+)
+
+// Defines values for CreateBuildMultipartBodyOutputType.
+const (
+	CreateBuildMultipartBodyOutputTypeImage CreateBuildMultipartBodyOutputType = "image"
+	CreateBuildMultipartBodyOutputTypeLocal CreateBuildMultipartBodyOutputType = "local"
+	CreateBuildMultipartBodyOutputTypeTar   CreateBuildMultipartBodyOutputType = "tar"
+)
+
+// Defines values for ListDevicesParamsSort.
+const (
+	ListDevicesParamsSortCreatedAt      ListDevicesParamsSort = "created_at"
+	ListDevicesParamsSortMinusCreatedAt ListDevicesParamsSort = "-created_at"
+	ListDevicesParamsSortMinusName      ListDevicesParamsSort = "-name"
+	ListDevicesParamsSortName           ListDevicesParamsSort = "name"
+)
+
+// Defines values for ListInstancesParamsSort.
+const (
+	ListInstancesParamsSortCreatedAt      ListInstancesParamsSort = "created_at"
+	ListInstancesParamsSortMinusCreatedAt ListInstancesParamsSort = "-created_at"
+	ListInstancesParamsSortMinusName      ListInstancesParamsSort = "-name"
+	ListInstancesParamsSortMinusState     ListInstancesParamsSort = "-state"
+	ListInstancesParamsSortName           ListInstancesParamsSort = "name"
+	ListInstancesParamsSortState          ListInstancesParamsSort = "state"
 )
 
 // Defines values for GetInstanceLogsParamsSource.
 const (
 	App     GetInstanceLogsParamsSource = "app"
 	Hypeman GetInstanceLogsParamsSource = "hypeman"
+	Kernel  GetInstanceLogsParamsSource = "kernel"
+	System  GetInstanceLogsParamsSource = "system"
 	Vmm     GetInstanceLogsParamsSource = "vmm"
 )
 
+// Defines values for ListVolumesParamsSort.
+const (
+	ListVolumesParamsSortCreatedAt      ListVolumesParamsSort = "created_at"
+	ListVolumesParamsSortMinusCreatedAt ListVolumesParamsSort = "-created_at"
+	ListVolumesParamsSortMinusName      ListVolumesParamsSort = "-name"
+	ListVolumesParamsSortName           ListVolumesParamsSort = "name"
+)
+
+// ApiKey defines model for ApiKey.
+type ApiKey struct {
+	// CreatedAt Creation timestamp (RFC3339)
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Auto-generated unique identifier (CUID2 format)
+	Id string `json:"id"`
+
+	// Name Human-readable label
+	Name *string `json:"name,omitempty"`
+
+	// RevokedAt When the key was revoked, if it has been
+	RevokedAt *time.Time `json:"revoked_at"`
+
+	// Scopes Granted scopes as "<resource>:<read|write>", where resource is the first API path
+	// segment (e.g. instances, builds, api-keys) or "*". Write implies read.
+	// Empty means full access.
+	Scopes []string `json:"scopes"`
+
+	// Subject User the key acts as (like a JWT's sub claim)
+	Subject string `json:"subject"`
+}
+
 // AttachVolumeRequest defines model for AttachVolumeRequest.
 type AttachVolumeRequest struct {
 	// MountPath Path where volume should be mounted
@@ -119,6 +322,12 @@ type AvailableDevice struct {
 	// IommuGroup IOMMU group number
 	IommuGroup int `json:"iommu_group"`
 
+	// NumaNode Host NUMA node the device is attached to (null if unknown or single-node host)
+	NumaNode *int `json:"numa_node"`
+
+	// ParentPf PCI address of the SR-IOV physical function, if this device is a virtual function
+	ParentPf *string `json:"parent_pf"`
+
 	// PciAddress PCI address
 	PciAddress string `json:"pci_address"`
 
@@ -129,8 +338,48 @@ type AvailableDevice struct {
 	VendorName *string `json:"vendor_name,omitempty"`
 }
 
+// BatchMemberError defines model for BatchMemberError.
+type BatchMemberError struct {
+	// Code Error code, as returned by createInstance
+	Code string `json:"code"`
+
+	// Message Error message
+	Message string `json:"message"`
+
+	// Name Name of the batch member
+	Name string `json:"name"`
+}
+
+// BootStatus defines model for BootStatus.
+type BootStatus struct {
+	// Error Why the phase failed (only when state is failed)
+	Error *string `json:"error,omitempty"`
+
+	// FailedPhase Boot phase that failed (only when state is failed)
+	FailedPhase *string `json:"failed_phase,omitempty"`
+
+	// State How far the last boot got:
+	// - unknown: No boot markers in the app log (never booted, older initrd, or rotated out)
+	// - booting: Init started but has not started the workload yet
+	// - complete: The workload was started
+	// - failed: A boot phase failed and the guest powered off
+	State BootStatusState `json:"state"`
+}
+
+// BootStatusState How far the last boot got:
+// - unknown: No boot markers in the app log (never booted, older initrd, or rotated out)
+// - booting: Init started but has not started the workload yet
+// - complete: The workload was started
+// - failed: A boot phase failed and the guest powered off
+type BootStatusState string
+
 // Build defines model for Build.
 type Build struct {
+	// CacheStats How many Dockerfile steps BuildKit took from its cache and how many it
+	// executed. Misses on steps expected to be cached mean an earlier input
+	// changed or the cache scope isn't being imported.
+	CacheStats *BuildCacheStats `json:"cache_stats,omitempty"`
+
 	// CompletedAt Build completion timestamp
 	CompletedAt *time.Time `json:"completed_at"`
 
@@ -150,7 +399,10 @@ type Build struct {
 	ImageDigest *string `json:"image_digest"`
 
 	// ImageRef Full image reference (only when status is ready)
-	ImageRef   *string          `json:"image_ref"`
+	ImageRef *string `json:"image_ref"`
+
+	// OutputType What the build produces (files for local and tar are at /builds/{id}/artifact)
+	OutputType *BuildOutputType `json:"output_type,omitempty"`
 	Provenance *BuildProvenance `json:"provenance,omitempty"`
 
 	// QueuePosition Position in build queue (only when status is queued)
@@ -163,6 +415,23 @@ type Build struct {
 	Status BuildStatus `json:"status"`
 }
 
+// BuildOutputType What the build produces (files for local and tar are at /builds/{id}/artifact)
+type BuildOutputType string
+
+// BuildCacheStats How many Dockerfile steps BuildKit took from its cache and how many it
+// executed. Misses on steps expected to be cached mean an earlier input
+// changed or the cache scope isn't being imported.
+type BuildCacheStats struct {
+	// CacheHits Steps taken from the cache
+	CacheHits int `json:"cache_hits"`
+
+	// CacheMisses Steps executed
+	CacheMisses int `json:"cache_misses"`
+
+	// CachedBytes Size of cached layers fetched from the registry cache (layers already on the builder are not counted)
+	CachedBytes int64 `json:"cached_bytes"`
+}
+
 // BuildEvent defines model for BuildEvent.
 type BuildEvent struct {
 	// Content Log line content (only for type=log)
@@ -189,9 +458,18 @@ type BuildProvenance struct {
 	// BuildkitVersion BuildKit version used
 	BuildkitVersion *string `json:"buildkit_version,omitempty"`
 
+	// CacheStats How many Dockerfile steps BuildKit took from its cache and how many it
+	// executed. Misses on steps expected to be cached mean an earlier input
+	// changed or the cache scope isn't being imported.
+	CacheStats *BuildCacheStats `json:"cache_stats,omitempty"`
+
 	// LockfileHashes Map of lockfile names to SHA256 hashes
 	LockfileHashes *map[string]string `json:"lockfile_hashes,omitempty"`
 
+	// SecretScan Check of the pushed image for leaked build secrets, run when a build
+	// uses secrets. The build fails if any leak is found.
+	SecretScan *SecretScan `json:"secret_scan,omitempty"`
+
 	// SourceHash SHA256 hash of source tarball
 	SourceHash *string `json:"source_hash,omitempty"`
 
@@ -199,9 +477,64 @@ type BuildProvenance struct {
 	Timestamp *time.Time `json:"timestamp,omitempty"`
 }
 
+// BuildQueueStats Builds running and waiting for a slot. Slots are shared fairly between
+// tenants (the authenticated subjects that submitted the builds), so a
+// tenant's builds may start ahead of older builds from a tenant that
+// already has more running.
+type BuildQueueStats struct {
+	// Active Builds running
+	Active int `json:"active"`
+
+	// MaxConcurrent Builds that can run at once
+	MaxConcurrent int `json:"max_concurrent"`
+
+	// Pending Builds waiting for a slot
+	Pending int `json:"pending"`
+
+	// Tenants Tenants with running or waiting builds
+	Tenants []TenantBuildQueueStats `json:"tenants"`
+}
+
 // BuildStatus Build job status
 type BuildStatus string
 
+// CascadeDeleteResult defines model for CascadeDeleteResult.
+type CascadeDeleteResult struct {
+	// DeletedIngresses Ingresses deleted because every rule targeted the instance
+	DeletedIngresses []DeletedResource `json:"deleted_ingresses"`
+
+	// DeletedVolumes Volumes deleted because no other instance had them attached
+	DeletedVolumes []DeletedResource `json:"deleted_volumes"`
+
+	// InstanceId ID of the deleted instance
+	InstanceId string `json:"instance_id"`
+}
+
+// CloneInstanceRequest defines model for CloneInstanceRequest.
+type CloneInstanceRequest struct {
+	// CloneVolumes Give the clone copies of the source's writable volumes. Without this the
+	// clone gets none of them. Read-only volumes are always shared.
+	CloneVolumes *bool `json:"clone_volumes,omitempty"`
+
+	// Name Name of the clone
+	Name string `json:"name"`
+}
+
+// CreateApiKeyRequest defines model for CreateApiKeyRequest.
+type CreateApiKeyRequest struct {
+	// Name Human-readable label
+	Name *string `json:"name,omitempty"`
+
+	// Scopes Scopes as "<resource>:<read|write>" (e.g. "instances:write", "*:read").
+	// Omit for full access. Keys created with a scoped API key must request a
+	// subset of its scopes.
+	Scopes *[]string `json:"scopes,omitempty"`
+
+	// Subject User the key acts as. Defaults to the caller. Keys created with an API key
+	// always act as that key's subject.
+	Subject *string `json:"subject,omitempty"`
+}
+
 // CreateDeviceRequest defines model for CreateDeviceRequest.
 type CreateDeviceRequest struct {
 	// Name Optional globally unique device name. If not provided, a name is auto-generated from the PCI address (e.g., "pci-0000-a2-00-0")
@@ -209,6 +542,9 @@ type CreateDeviceRequest struct {
 
 	// PciAddress PCI address of the device (required, e.g., "0000:a2:00.0")
 	PciAddress string `json:"pci_address"`
+
+	// Pool Optional device pool to add the device to. Pool names share the device name namespace.
+	Pool *string `json:"pool,omitempty"`
 }
 
 // CreateImageRequest defines model for CreateImageRequest.
@@ -228,9 +564,14 @@ type CreateIngressRequest struct {
 
 // CreateInstanceRequest defines model for CreateInstanceRequest.
 type CreateInstanceRequest struct {
-	// Devices Device IDs or names to attach for GPU/PCI passthrough
+	// Devices Device IDs or names to attach for GPU/PCI passthrough. A device pool name attaches any free device from that pool.
 	Devices *[]string `json:"devices,omitempty"`
 
+	// DiskDiscard Pass discards (TRIM) from the guest to the overlay and writable volumes, so files
+	// the guest deletes free host disk. The guest mounts them with online discard, which
+	// slows deletes and some writes. Defaults to true for qcow2 overlays and false for raw.
+	DiskDiscard *bool `json:"disk_discard,omitempty"`
+
 	// DiskIoBps Disk I/O rate limit (e.g., "100MB/s", "500MB/s"). Defaults to proportional share based on CPU allocation if configured.
 	DiskIoBps *string `json:"disk_io_bps,omitempty"`
 
@@ -243,9 +584,41 @@ type CreateInstanceRequest struct {
 	// Hypervisor Hypervisor to use for this instance. Defaults to server configuration.
 	Hypervisor *CreateInstanceRequestHypervisor `json:"hypervisor,omitempty"`
 
+	// IdleAction What happens when idle_timeout is reached. "standby" snapshots the instance
+	// instead of stopping it, and the next ingress request to it restores it
+	// before being proxied (scale to zero with fast wake).
+	IdleAction *CreateInstanceRequestIdleAction `json:"idle_action,omitempty"`
+
+	// IdleTimeout Stop the instance after it has had no network traffic (including ingress
+	// requests) and no exec sessions for this long (Go duration, e.g. "30m").
+	// Unset means the instance never stops for being idle.
+	IdleTimeout *string `json:"idle_timeout,omitempty"`
+
 	// Image OCI image reference
 	Image string `json:"image"`
 
+	// InitMode How the guest runs the image. "exec" runs the image's command as a single
+	// process, like a container. "systemd" boots systemd as PID 1 for a full-VM
+	// workload with multiple services; an image whose command isn't systemd boots
+	// /sbin/init. Defaults to "systemd" if the image's command is systemd, else "exec".
+	InitMode *CreateInstanceRequestInitMode `json:"init_mode,omitempty"`
+
+	// KernelModules Extra kernel modules the guest loads at boot, by name. Modules come from the
+	// kernel's module tree in the initrd, or from a volume mounted at /lib/modules
+	// holding a tree for the guest kernel. A missing module is logged in the
+	// instance's logs and skipped.
+	KernelModules *[]string `json:"kernel_modules,omitempty"`
+
+	// KernelVersion Guest kernel version to boot. Defaults to the server's default kernel. A
+	// supported version not yet on the host is downloaded on first use; an unknown
+	// one is rejected with the list of available versions. Device passthrough
+	// requires the default kernel.
+	KernelVersion *string `json:"kernel_version,omitempty"`
+
+	// LogRetention How long rotated logs of an instance are kept. Unset fields use the server's
+	// LOG_MAX_AGE and LOG_MAX_FILES.
+	LogRetention *LogRetention `json:"log_retention,omitempty"`
+
 	// Name Human-readable name (lowercase letters, digits, and dashes only; cannot start or end with a dash)
 	Name string `json:"name"`
 
@@ -257,16 +630,33 @@ type CreateInstanceRequest struct {
 		// BandwidthUpload Upload bandwidth limit (VM→external, e.g., "1Gbps", "125MB/s"). Defaults to proportional share based on CPU allocation.
 		BandwidthUpload *string `json:"bandwidth_upload,omitempty"`
 
+		// DnsServers Resolvers written into the guest's /etc/resolv.conf. Defaults to the server's DNS_SERVER.
+		DnsServers *[]string `json:"dns_servers,omitempty"`
+
 		// Enabled Whether to attach instance to the default network
 		Enabled *bool `json:"enabled,omitempty"`
+
+		// Mtu MTU of the instance's TAP device and guest NIC. Defaults to the server's NETWORK_MTU.
+		Mtu *int `json:"mtu,omitempty"`
+
+		// SearchDomains Search domains written into the guest's /etc/resolv.conf
+		SearchDomains *[]string `json:"search_domains,omitempty"`
 	} `json:"network,omitempty"`
 
+	// OverlayFormat Image format of the overlay disk. raw is the fastest; qcow2 only takes host disk
+	// space for the blocks the guest writes. qcow2 needs a hypervisor with the qcow2
+	// capability.
+	OverlayFormat *CreateInstanceRequestOverlayFormat `json:"overlay_format,omitempty"`
+
 	// OverlaySize Writable overlay disk size (human-readable format like "10GB", "50G")
 	OverlaySize *string `json:"overlay_size,omitempty"`
 
 	// Size Base memory size (human-readable format like "1GB", "512MB", "2G")
 	Size *string `json:"size,omitempty"`
 
+	// Systemd Boot options for an instance in systemd mode
+	Systemd *SystemdOptions `json:"systemd,omitempty"`
+
 	// Vcpus Number of virtual CPUs
 	Vcpus *int `json:"vcpus,omitempty"`
 
@@ -277,6 +667,40 @@ type CreateInstanceRequest struct {
 // CreateInstanceRequestHypervisor Hypervisor to use for this instance. Defaults to server configuration.
 type CreateInstanceRequestHypervisor string
 
+// CreateInstanceRequestIdleAction What happens when idle_timeout is reached. "standby" snapshots the instance
+// instead of stopping it, and the next ingress request to it restores it
+// before being proxied (scale to zero with fast wake).
+type CreateInstanceRequestIdleAction string
+
+// CreateInstanceRequestInitMode How the guest runs the image. "exec" runs the image's command as a single
+// process, like a container. "systemd" boots systemd as PID 1 for a full-VM
+// workload with multiple services; an image whose command isn't systemd boots
+// /sbin/init. Defaults to "systemd" if the image's command is systemd, else "exec".
+type CreateInstanceRequestInitMode string
+
+// CreateInstanceRequestOverlayFormat Image format of the overlay disk. raw is the fastest; qcow2 only takes host disk
+// space for the blocks the guest writes. qcow2 needs a hypervisor with the qcow2
+// capability.
+type CreateInstanceRequestOverlayFormat string
+
+// CreateInstancesRequest defines model for CreateInstancesRequest.
+type CreateInstancesRequest struct {
+	// Count Number of instances to create. Members are named after the template,
+	// suffixed with -1 through -<count>.
+	Count    int                   `json:"count"`
+	Template CreateInstanceRequest `json:"template"`
+}
+
+// CreateInstancesResult defines model for CreateInstancesResult.
+type CreateInstancesResult struct {
+	// Errors The member that failed, and any members whose rollback failed
+	Errors []BatchMemberError `json:"errors"`
+
+	// Instances Created instances. When the batch failed, only members that could not
+	// be rolled back.
+	Instances []Instance `json:"instances"`
+}
+
 // CreateVolumeRequest defines model for CreateVolumeRequest.
 type CreateVolumeRequest struct {
 	// Id Optional custom identifier (auto-generated if not provided)
@@ -289,6 +713,41 @@ type CreateVolumeRequest struct {
 	SizeGb int `json:"size_gb"`
 }
 
+// CreatedApiKey defines model for CreatedApiKey.
+type CreatedApiKey struct {
+	// CreatedAt Creation timestamp (RFC3339)
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Auto-generated unique identifier (CUID2 format)
+	Id string `json:"id"`
+
+	// Key The API key. Only returned on creation; store it securely.
+	Key string `json:"key"`
+
+	// Name Human-readable label
+	Name *string `json:"name,omitempty"`
+
+	// RevokedAt When the key was revoked, if it has been
+	RevokedAt *time.Time `json:"revoked_at"`
+
+	// Scopes Granted scopes as "<resource>:<read|write>", where resource is the first API path
+	// segment (e.g. instances, builds, api-keys) or "*". Write implies read.
+	// Empty means full access.
+	Scopes []string `json:"scopes"`
+
+	// Subject User the key acts as (like a JWT's sub claim)
+	Subject string `json:"subject"`
+}
+
+// DeletedResource defines model for DeletedResource.
+type DeletedResource struct {
+	// Id Resource ID
+	Id string `json:"id"`
+
+	// Name Resource name
+	Name string `json:"name"`
+}
+
 // Device defines model for Device.
 type Device struct {
 	// AttachedTo Instance ID if attached
@@ -314,9 +773,18 @@ type Device struct {
 	// Name Device name (user-provided or auto-generated from PCI address)
 	Name *string `json:"name,omitempty"`
 
+	// NumaNode Host NUMA node the device is attached to, if known
+	NumaNode *int `json:"numa_node,omitempty"`
+
+	// ParentPf PCI address of the SR-IOV physical function, if this device is a virtual function
+	ParentPf *string `json:"parent_pf"`
+
 	// PciAddress PCI address
 	PciAddress string `json:"pci_address"`
 
+	// Pool Device pool this device belongs to, if any
+	Pool *string `json:"pool,omitempty"`
+
 	// Type Type of PCI device
 	Type DeviceType `json:"type"`
 
@@ -324,6 +792,15 @@ type Device struct {
 	VendorId string `json:"vendor_id"`
 }
 
+// DevicePool defines model for DevicePool.
+type DevicePool struct {
+	// DeviceIds IDs of the devices in the pool
+	DeviceIds []string `json:"device_ids"`
+
+	// Name Pool name
+	Name string `json:"name"`
+}
+
 // DeviceType Type of PCI device
 type DeviceType string
 
@@ -342,6 +819,27 @@ type DiskBreakdown struct {
 	VolumesBytes *int64 `json:"volumes_bytes,omitempty"`
 }
 
+// DrainRequest defines model for DrainRequest.
+type DrainRequest struct {
+	// Draining true to start draining, false to resume accepting new instances and builds
+	Draining bool `json:"draining"`
+}
+
+// DrainStatus defines model for DrainStatus.
+type DrainStatus struct {
+	// ActiveBuilds Builds queued or in progress, which are allowed to finish
+	ActiveBuilds int `json:"active_builds"`
+
+	// Draining Whether new instances and builds are being rejected
+	Draining bool `json:"draining"`
+
+	// RunningInstances Instances still running on this host
+	RunningInstances int `json:"running_instances"`
+
+	// Since When draining started
+	Since *time.Time `json:"since"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code Application-specific error code (machine-readable)
@@ -364,106 +862,468 @@ type ErrorDetail struct {
 	Message *string `json:"message,omitempty"`
 }
 
-// Health defines model for Health.
-type Health struct {
-	Status HealthStatus `json:"status"`
-}
+// ExecRunRequest defines model for ExecRunRequest.
+type ExecRunRequest struct {
+	// Command Command and arguments to run
+	Command []string `json:"command"`
 
-// HealthStatus defines model for Health.Status.
-type HealthStatus string
+	// CpuQuota CPUs the command may use (unset = unlimited)
+	CpuQuota *float64 `json:"cpu_quota,omitempty"`
 
-// Image defines model for Image.
-type Image struct {
-	// Cmd CMD from container metadata
-	Cmd *[]string `json:"cmd"`
+	// Cwd Working directory
+	Cwd *string `json:"cwd,omitempty"`
 
-	// CreatedAt Creation timestamp (RFC3339)
-	CreatedAt time.Time `json:"created_at"`
+	// DropCapabilities Capabilities the command runs without, e.g. CAP_SYS_ADMIN, or ALL.
+	// Commands run by scoped API keys never have CAP_SYS_ADMIN.
+	DropCapabilities *[]string `json:"drop_capabilities,omitempty"`
 
-	// Digest Resolved manifest digest
-	Digest string `json:"digest"`
+	// Env Additional environment variables
+	Env *map[string]string `json:"env,omitempty"`
 
-	// Entrypoint Entrypoint from container metadata
-	Entrypoint *[]string `json:"entrypoint"`
+	// MemoryLimit Memory limit for the command (unset = unlimited). A command killed for exceeding it exits with code 137.
+	MemoryLimit *string `json:"memory_limit,omitempty"`
 
-	// Env Environment variables from container metadata
-	Env *map[string]string `json:"env,omitempty"`
+	// SeccompProfile Seccomp profile to run the command under. "default" fails mounts,
+	// module and BPF loading, ptrace, namespace changes, reboots and
+	// clock changes with EPERM. Unset means no filter, except for
+	// scoped API keys, whose commands always run under "default".
+	SeccompProfile *ExecRunRequestSeccompProfile `json:"seccomp_profile,omitempty"`
 
-	// Error Error message if status is failed
-	Error *string `json:"error"`
+	// Stdin Data written to the command's stdin, which is then closed
+	Stdin *string `json:"stdin,omitempty"`
 
-	// Name Normalized OCI image reference (tag or digest)
-	Name string `json:"name"`
+	// Timeout Execution timeout in seconds (0 = no timeout). A command that times out exits with code 124.
+	Timeout *int32 `json:"timeout,omitempty"`
 
-	// QueuePosition Position in build queue (null if not queued)
-	QueuePosition *int `json:"queue_position"`
+	// WaitForAgent Seconds to wait for the guest agent to be ready
+	WaitForAgent *int32 `json:"wait_for_agent,omitempty"`
+}
 
-	// SizeBytes Disk size in bytes (null until ready)
-	SizeBytes *int64 `json:"size_bytes"`
+// ExecRunRequestSeccompProfile Seccomp profile to run the command under. "default" fails mounts,
+// module and BPF loading, ptrace, namespace changes, reboots and
+// clock changes with EPERM. Unset means no filter, except for
+// scoped API keys, whose commands always run under "default".
+type ExecRunRequestSeccompProfile string
 
-	// Status Build status
-	Status ImageStatus `json:"status"`
+// ExecRunResult defines model for ExecRunResult.
+type ExecRunResult struct {
+	// ExitCode Command exit code
+	ExitCode int `json:"exit_code"`
 
-	// WorkingDir Working directory from container metadata
-	WorkingDir *string `json:"working_dir"`
+	// Stderr Standard error, up to 1MB
+	Stderr string `json:"stderr"`
+
+	// Stdout Standard output, up to 1MB
+	Stdout string `json:"stdout"`
+
+	// Truncated True if stdout or stderr exceeded the size cap and was cut short
+	Truncated bool `json:"truncated"`
 }
 
-// ImageStatus Build status
-type ImageStatus string
+// ExecSession defines model for ExecSession.
+type ExecSession struct {
+	// Command Command the session ran
+	Command []string `json:"command"`
 
-// Ingress defines model for Ingress.
-type Ingress struct {
-	// CreatedAt Creation timestamp (RFC3339)
-	CreatedAt time.Time `json:"created_at"`
+	// EndedAt When the session ended. Unset while it is open, or if the server stopped during it.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
 
-	// Id Auto-generated unique identifier
+	// ExitCode Exit code of the command (127 if it could not be run). Unset until the session ends.
+	ExitCode *int `json:"exit_code,omitempty"`
+
+	// Id Session identifier
 	Id string `json:"id"`
 
-	// Name Human-readable name
-	Name string `json:"name"`
+	// Recorded Whether the session's output was recorded and can be replayed
+	Recorded bool `json:"recorded"`
 
-	// Rules Routing rules for this ingress
-	Rules []IngressRule `json:"rules"`
-}
+	// StartedAt When the session started
+	StartedAt time.Time `json:"started_at"`
 
-// IngressMatch defines model for IngressMatch.
-type IngressMatch struct {
-	// Hostname Hostname to match. Can be:
-	// - Literal: "api.example.com" (exact match on Host header)
-	// - Pattern: "{instance}.example.com" (dynamic routing based on subdomain)
-	//
-	// Pattern hostnames use named captures in curly braces (e.g., {instance}, {app})
-	// that extract parts of the hostname for routing. The extracted values can be
-	// referenced in the target.instance field.
-	Hostname string `json:"hostname"`
+	// Subject User or API key that opened the session ("unknown" if unauthenticated)
+	Subject string `json:"subject"`
 
-	// Port Host port to listen on for this rule (default 80)
-	Port *int `json:"port,omitempty"`
+	// Tty Whether the session had a TTY
+	Tty bool `json:"tty"`
 }
 
-// IngressRule defines model for IngressRule.
-type IngressRule struct {
-	Match IngressMatch `json:"match"`
+// GuestDiskUsage defines model for GuestDiskUsage.
+type GuestDiskUsage struct {
+	// AvailableBytes Bytes available to unprivileged users
+	AvailableBytes int64 `json:"available_bytes"`
 
-	// RedirectHttp Auto-create HTTP to HTTPS redirect for this hostname (only applies when tls is enabled)
-	RedirectHttp *bool         `json:"redirect_http,omitempty"`
-	Target       IngressTarget `json:"target"`
+	// Device Source device
+	Device string `json:"device"`
 
-	// Tls Enable TLS termination (certificate auto-issued via ACME).
-	Tls *bool `json:"tls,omitempty"`
+	// FsType Filesystem type
+	FsType string `json:"fs_type"`
+
+	// MountPoint Mount point in the guest
+	MountPoint string `json:"mount_point"`
+
+	// TotalBytes Total size in bytes
+	TotalBytes int64 `json:"total_bytes"`
+
+	// UsedBytes Used bytes
+	UsedBytes int64 `json:"used_bytes"`
 }
 
-// IngressTarget defines model for IngressTarget.
-type IngressTarget struct {
-	// Instance Target instance name, ID, or capture reference.
-	// - For literal hostnames: Use the instance name or ID directly (e.g., "my-api")
-	// - For pattern hostnames: Reference a capture from the hostname (e.g., "{instance}")
-	//
-	// When using pattern hostnames, the instance is resolved dynamically at request time.
-	Instance string `json:"instance"`
+// GuestFile defines model for GuestFile.
+type GuestFile struct {
+	// Content File content (omitted when stat_only=true or for directories)
+	Content *string `json:"content"`
+
+	// Encoding Encoding of content. Files that are not valid UTF-8 are base64 encoded.
+	Encoding *GuestFileEncoding `json:"encoding"`
+
+	// Gid Owner group ID
+	Gid int `json:"gid"`
+
+	// IsDir True if this is a directory
+	IsDir bool `json:"is_dir"`
+
+	// Mode File mode (Unix permissions)
+	Mode int `json:"mode"`
+
+	// Mtime Modification time
+	Mtime time.Time `json:"mtime"`
+
+	// Path Path in the guest with symbolic links resolved
+	Path string `json:"path"`
+
+	// Size File size in bytes
+	Size int64 `json:"size"`
+
+	// Truncated True if the file is larger than the 1MB read limit and content was cut off
+	Truncated *bool `json:"truncated,omitempty"`
+
+	// Uid Owner user ID
+	Uid int `json:"uid"`
+}
+
+// GuestFileEncoding Encoding of content. Files that are not valid UTF-8 are base64 encoded.
+type GuestFileEncoding string
+
+// GuestProcess defines model for GuestProcess.
+type GuestProcess struct {
+	// Cmdline Command line arguments (empty for kernel threads)
+	Cmdline []string `json:"cmdline"`
+
+	// Name Executable name
+	Name string `json:"name"`
+
+	// Pid Process ID
+	Pid int `json:"pid"`
+
+	// Ppid Parent process ID
+	Ppid int `json:"ppid"`
+
+	// RssBytes Resident set size in bytes
+	RssBytes int64 `json:"rss_bytes"`
+
+	// State Process state (R=running, S=sleeping, D=disk sleep, Z=zombie, ...)
+	State string `json:"state"`
+}
+
+// GuestStats defines model for GuestStats.
+type GuestStats struct {
+	// Disks Per-mount disk usage
+	Disks []GuestDiskUsage `json:"disks"`
+
+	// Load1 1 minute load average
+	Load1 float32 `json:"load1"`
+
+	// Load15 15 minute load average
+	Load15 float32 `json:"load15"`
+
+	// Load5 5 minute load average
+	Load5 float32 `json:"load5"`
+
+	// MemoryAvailableBytes Memory available for new allocations in bytes
+	MemoryAvailableBytes int64 `json:"memory_available_bytes"`
+
+	// MemoryFreeBytes Unused memory in bytes
+	MemoryFreeBytes int64 `json:"memory_free_bytes"`
+
+	// MemoryTotalBytes Total guest memory in bytes
+	MemoryTotalBytes int64 `json:"memory_total_bytes"`
+}
+
+// GuestTopology CPU topology an instance with the requested vCPUs gets on this host
+type GuestTopology struct {
+	CoresPerDie    *int `json:"cores_per_die,omitempty"`
+	DiesPerPackage *int `json:"dies_per_package,omitempty"`
+	Packages       *int `json:"packages,omitempty"`
+
+	// ThreadsPerCore Absent when the hypervisor's flat default topology applies
+	ThreadsPerCore *int `json:"threads_per_core,omitempty"`
+	Vcpus          int  `json:"vcpus"`
+
+	// Warnings Where the guest layout can't follow the host's threads, sockets or NUMA nodes, or oversubscribes its CPUs
+	Warnings []string `json:"warnings"`
+}
+
+// Health defines model for Health.
+type Health struct {
+	Status HealthStatus `json:"status"`
+}
+
+// HealthStatus defines model for Health.Status.
+type HealthStatus string
+
+// HostTopology defines model for HostTopology.
+type HostTopology struct {
+	CoresPerSocket *int `json:"cores_per_socket,omitempty"`
+
+	// Guest CPU topology an instance with the requested vCPUs gets on this host
+	Guest *GuestTopology `json:"guest,omitempty"`
+
+	// LogicalCpus Hardware threads across all sockets
+	LogicalCpus *int `json:"logical_cpus,omitempty"`
+
+	// NumaNodes NUMA nodes that have CPUs (empty if the host reports none)
+	NumaNodes []NUMANode `json:"numa_nodes"`
+
+	// Sockets CPU sockets (absent if /proc/cpuinfo could not be read)
+	Sockets        *int `json:"sockets,omitempty"`
+	ThreadsPerCore *int `json:"threads_per_core,omitempty"`
+}
+
+// HypervisorCapabilities defines model for HypervisorCapabilities.
+type HypervisorCapabilities struct {
+	// DiskIoLimit Supports disk I/O rate limiting
+	DiskIoLimit bool `json:"disk_io_limit"`
+
+	// GpuPassthrough Supports PCI device passthrough
+	GpuPassthrough bool `json:"gpu_passthrough"`
+
+	// HotplugDevice Supports attaching and detaching PCI devices at runtime
+	HotplugDevice bool `json:"hotplug_device"`
+
+	// HotplugDisk Supports attaching and detaching volumes at runtime
+	HotplugDisk bool `json:"hotplug_disk"`
+
+	// HotplugMemory Supports resizing memory at runtime
+	HotplugMemory bool `json:"hotplug_memory"`
+
+	// Pause Supports pause/resume
+	Pause bool `json:"pause"`
+
+	// Qcow2 Supports qcow2 overlay disks
+	Qcow2 bool `json:"qcow2"`
+
+	// Snapshot Supports snapshot/restore (standby)
+	Snapshot bool `json:"snapshot"`
+
+	// Vsock Supports host-guest vsock communication
+	Vsock bool `json:"vsock"`
+}
+
+// HypervisorInfo defines model for HypervisorInfo.
+type HypervisorInfo struct {
+	// Available Whether the hypervisor binary was found and responded to a version query
+	Available    bool                   `json:"available"`
+	Capabilities HypervisorCapabilities `json:"capabilities"`
+
+	// Error Why version detection failed (absent if available)
+	Error *string `json:"error,omitempty"`
+
+	// Type Hypervisor type
+	Type HypervisorInfoType `json:"type"`
+
+	// Version Detected hypervisor version (absent if detection failed)
+	Version *string `json:"version,omitempty"`
+}
+
+// HypervisorInfoType Hypervisor type
+type HypervisorInfoType string
+
+// HypervisorList defines model for HypervisorList.
+type HypervisorList struct {
+	// Default Hypervisor used when a create request does not specify one
+	Default     HypervisorListDefault `json:"default"`
+	Hypervisors []HypervisorInfo      `json:"hypervisors"`
+}
+
+// HypervisorListDefault Hypervisor used when a create request does not specify one
+type HypervisorListDefault string
+
+// Image defines model for Image.
+type Image struct {
+	// Cmd CMD from container metadata
+	Cmd *[]string `json:"cmd"`
+
+	// CreatedAt Creation timestamp (RFC3339)
+	CreatedAt time.Time `json:"created_at"`
+
+	// Digest Resolved manifest digest
+	Digest string `json:"digest"`
+
+	// Entrypoint Entrypoint from container metadata
+	Entrypoint *[]string `json:"entrypoint"`
+
+	// Env Environment variables from container metadata
+	Env *map[string]string `json:"env,omitempty"`
+
+	// Error Error message if status is failed
+	Error *string `json:"error"`
+
+	// Name Normalized OCI image reference (tag or digest)
+	Name string `json:"name"`
+
+	// QueuePosition Position in build queue (null if not queued)
+	QueuePosition *int `json:"queue_position"`
+
+	// SizeBytes Disk size in bytes (null until ready)
+	SizeBytes *int64 `json:"size_bytes"`
+
+	// Status Build status
+	Status ImageStatus `json:"status"`
+
+	// WorkingDir Working directory from container metadata
+	WorkingDir *string `json:"working_dir"`
+}
+
+// ImageStatus Build status
+type ImageStatus string
+
+// ImageGCResult defines model for ImageGCResult.
+type ImageGCResult struct {
+	// DryRun True if nothing was deleted and the result lists what would be removed
+	DryRun bool `json:"dry_run"`
+
+	// FreedBytes Disk space freed in bytes
+	FreedBytes int64 `json:"freed_bytes"`
+
+	// RemovedBlobs Number of unreferenced blobs removed from the OCI layer cache
+	RemovedBlobs int `json:"removed_blobs"`
+
+	// RemovedImages Image digests removed from disk, as repository@digest
+	RemovedImages []string `json:"removed_images"`
+}
+
+// ImagePullEvent defines model for ImagePullEvent.
+type ImagePullEvent struct {
+	// BytesDownloaded Compressed bytes of the layer downloaded so far (only for type=progress)
+	BytesDownloaded *int64 `json:"bytes_downloaded,omitempty"`
+
+	// BytesTotal Compressed layer size, or -1 if unknown (only for type=progress)
+	BytesTotal *int64 `json:"bytes_total,omitempty"`
+
+	// Error Failure message (only for type=status with status=failed)
+	Error *string `json:"error,omitempty"`
+
+	// Layer Digest of the layer being downloaded (only for type=progress)
+	Layer *string `json:"layer,omitempty"`
+
+	// Status Image build status (only for type=status)
+	Status *ImagePullEventStatus `json:"status,omitempty"`
+
+	// Timestamp Event timestamp
+	Timestamp time.Time `json:"timestamp"`
+
+	// Type Event type
+	Type ImagePullEventType `json:"type"`
+}
+
+// ImagePullEventStatus Image build status (only for type=status)
+type ImagePullEventStatus string
+
+// ImagePullEventType Event type
+type ImagePullEventType string
+
+// Ingress defines model for Ingress.
+type Ingress struct {
+	// CreatedAt Creation timestamp (RFC3339)
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Auto-generated unique identifier
+	Id string `json:"id"`
+
+	// Name Human-readable name
+	Name string `json:"name"`
+
+	// Rules Routing rules for this ingress
+	Rules []IngressRule `json:"rules"`
+}
+
+// IngressDNSLookup defines model for IngressDNSLookup.
+type IngressDNSLookup struct {
+	// DnsServer Address of the internal DNS server that answered
+	DnsServer string `json:"dns_server"`
+
+	// Hostname Name that was queried
+	Hostname string `json:"hostname"`
+
+	// Ip Resolved IPv4 address, absent if the name didn't resolve
+	Ip *string `json:"ip,omitempty"`
+
+	// KnownInstances Number of instances the DNS server can currently resolve
+	KnownInstances int `json:"known_instances"`
+
+	// Rcode DNS response code. NXDOMAIN means the instance doesn't exist or has no IP; SERVFAIL means waking it from standby failed.
+	Rcode string `json:"rcode"`
+}
+
+// IngressMatch defines model for IngressMatch.
+type IngressMatch struct {
+	// Hostname Hostname to match. Can be:
+	// - Literal: "api.example.com" (exact match on Host header)
+	// - Pattern: "{instance}.example.com" (dynamic routing based on subdomain)
+	//
+	// Pattern hostnames use named captures in curly braces (e.g., {instance}, {app})
+	// that extract parts of the hostname for routing. The extracted values can be
+	// referenced in the target.instance field.
+	Hostname string `json:"hostname"`
+
+	// Port Host port to listen on for this rule (default 80, required for tcp)
+	Port *int `json:"port,omitempty"`
+
+	// Protocol Protocol to proxy (default http). tcp rules proxy raw connections by listen port.
+	// With tls enabled, a tcp rule is routed by the TLS SNI hostname and TLS is passed
+	// through to the instance; without it, the rule must be the only one on its port.
+	Protocol *IngressMatchProtocol `json:"protocol,omitempty"`
+}
+
+// IngressMatchProtocol Protocol to proxy (default http). tcp rules proxy raw connections by listen port.
+// With tls enabled, a tcp rule is routed by the TLS SNI hostname and TLS is passed
+// through to the instance; without it, the rule must be the only one on its port.
+type IngressMatchProtocol string
+
+// IngressRule defines model for IngressRule.
+type IngressRule struct {
+	Match IngressMatch `json:"match"`
+
+	// MaxRequestBodyBytes Maximum request body size in bytes proxied to the target. Larger requests are rejected with 413. 0 or absent means no limit.
+	MaxRequestBodyBytes *int64 `json:"max_request_body_bytes,omitempty"`
+
+	// RedirectHttp Auto-create HTTP to HTTPS redirect for this hostname (only applies when tls is enabled)
+	RedirectHttp *bool         `json:"redirect_http,omitempty"`
+	Target       IngressTarget `json:"target"`
+
+	// Tls Enable TLS termination (certificate auto-issued via ACME). For tcp rules, route by SNI hostname and pass TLS through instead.
+	Tls *bool `json:"tls,omitempty"`
+}
+
+// IngressTarget defines model for IngressTarget.
+type IngressTarget struct {
+	// Instance Target instance name, ID, or capture reference. Required unless instances is set.
+	// - For literal hostnames: Use the instance name or ID directly (e.g., "my-api")
+	// - For pattern hostnames: Reference a capture from the hostname (e.g., "{instance}")
+	//
+	// When using pattern hostnames, the instance is resolved dynamically at request time.
+	Instance *string `json:"instance,omitempty"`
+
+	// Instances Names or IDs of two or more instances to load-balance across, set instead
+	// of instance. Only for HTTP rules with literal hostnames.
+	Instances *[]string `json:"instances,omitempty"`
 
 	// Port Target port on the instance
 	Port int `json:"port"`
+
+	// StickySession Pins each client to one of a target's instances with a cookie, for apps that
+	// keep session state in memory. Requires instances.
+	StickySession *StickySession `json:"sticky_session,omitempty"`
 }
 
 // Instance defines model for Instance.
@@ -471,6 +1331,9 @@ type Instance struct {
 	// CreatedAt Creation timestamp (RFC3339)
 	CreatedAt time.Time `json:"created_at"`
 
+	// DiskDiscard Whether guest discards free host disk
+	DiskDiscard *bool `json:"disk_discard,omitempty"`
+
 	// DiskIoBps Disk I/O rate limit (human-readable, e.g., "100MB/s")
 	DiskIoBps *string `json:"disk_io_bps,omitempty"`
 
@@ -489,9 +1352,31 @@ type Instance struct {
 	// Id Auto-generated unique identifier (CUID2 format)
 	Id string `json:"id"`
 
+	// IdleAction What happens when idle_timeout is reached (only set with idle_timeout)
+	IdleAction *InstanceIdleAction `json:"idle_action,omitempty"`
+
+	// IdleTimeout Idle time after which the instance is stopped automatically (absent if never)
+	IdleTimeout *string `json:"idle_timeout,omitempty"`
+
 	// Image OCI image reference
 	Image string `json:"image"`
 
+	// InitMode Init mode requested at create (absent if detected from the image)
+	InitMode *InstanceInitMode `json:"init_mode,omitempty"`
+
+	// KernelModules Extra kernel modules the guest loads at boot
+	KernelModules *[]string `json:"kernel_modules,omitempty"`
+
+	// KernelVersion Guest kernel version the instance boots with
+	KernelVersion *string `json:"kernel_version,omitempty"`
+
+	// LastActivityAt Last network traffic or exec session seen by the idle tracker (only tracked with idle_timeout)
+	LastActivityAt *time.Time `json:"last_activity_at"`
+
+	// LogRetention How long rotated logs of an instance are kept. Unset fields use the server's
+	// LOG_MAX_AGE and LOG_MAX_FILES.
+	LogRetention *LogRetention `json:"log_retention,omitempty"`
+
 	// Name Human-readable name
 	Name string `json:"name"`
 
@@ -503,6 +1388,9 @@ type Instance struct {
 		// BandwidthUpload Upload bandwidth limit (human-readable, e.g., "1Gbps", "125MB/s")
 		BandwidthUpload *string `json:"bandwidth_upload,omitempty"`
 
+		// DnsServers Resolvers requested at create (absent if the server default applies)
+		DnsServers *[]string `json:"dns_servers,omitempty"`
+
 		// Enabled Whether instance is attached to the default network
 		Enabled *bool `json:"enabled,omitempty"`
 
@@ -512,13 +1400,28 @@ type Instance struct {
 		// Mac Assigned MAC address (null if no network)
 		Mac *string `json:"mac"`
 
+		// Mtu MTU requested at create (absent if the server default applies)
+		Mtu *int `json:"mtu,omitempty"`
+
 		// Name Network name (always "default" when enabled)
 		Name *string `json:"name,omitempty"`
+
+		// SearchDomains Search domains of the guest's resolver
+		SearchDomains *[]string `json:"search_domains,omitempty"`
 	} `json:"network,omitempty"`
 
+	// NumaNode Host NUMA node the instance's vCPUs and memory are bound to, chosen for locality with its passthrough devices. Absent if unbound.
+	NumaNode *int `json:"numa_node,omitempty"`
+
+	// OverlayFormat Image format of the overlay disk
+	OverlayFormat *InstanceOverlayFormat `json:"overlay_format,omitempty"`
+
 	// OverlaySize Writable overlay disk size (human-readable)
 	OverlaySize *string `json:"overlay_size,omitempty"`
 
+	// ParentId ID of the instance this one was cloned from
+	ParentId *string `json:"parent_id,omitempty"`
+
 	// Size Base memory size (human-readable)
 	Size *string `json:"size,omitempty"`
 
@@ -532,15 +1435,26 @@ type Instance struct {
 	// - Shutdown: VM shut down but VMM exists (Cloud Hypervisor native)
 	// - Stopped: No VMM running, no snapshot exists
 	// - Standby: No VMM running, snapshot exists (can be restored)
-	// - Unknown: Failed to determine state (see state_error for details)
+	// - Unknown: Failed to determine state (see state_reason and state_error for details)
 	State InstanceState `json:"state"`
 
 	// StateError Error message if state couldn't be determined (only set when state is Unknown)
 	StateError *string `json:"state_error"`
 
+	// StateReason Why the state couldn't be determined (only set when state is Unknown):
+	// - hypervisor_client_error: No client could be created for the VMM socket
+	// - vmm_unreachable: The VMM socket exists but the VMM doesn't respond
+	// - vmm_exited: The VMM process is gone but its socket was left behind
+	// - unexpected_vmm_state: The VMM reported a state hypeman doesn't recognize
+	// - snapshot_incomplete: A standby snapshot was interrupted before it was fully written
+	StateReason *InstanceStateReason `json:"state_reason"`
+
 	// StoppedAt Stop timestamp (RFC3339)
 	StoppedAt *time.Time `json:"stopped_at"`
 
+	// Systemd Boot options for an instance in systemd mode
+	Systemd *SystemdOptions `json:"systemd,omitempty"`
+
 	// Vcpus Number of virtual CPUs
 	Vcpus *int `json:"vcpus,omitempty"`
 
@@ -551,6 +1465,78 @@ type Instance struct {
 // InstanceHypervisor Hypervisor running this instance
 type InstanceHypervisor string
 
+// InstanceIdleAction What happens when idle_timeout is reached (only set with idle_timeout)
+type InstanceIdleAction string
+
+// InstanceInitMode Init mode requested at create (absent if detected from the image)
+type InstanceInitMode string
+
+// InstanceOverlayFormat Image format of the overlay disk
+type InstanceOverlayFormat string
+
+// InstanceStateReason Why the state couldn't be determined (only set when state is Unknown):
+// - hypervisor_client_error: No client could be created for the VMM socket
+// - vmm_unreachable: The VMM socket exists but the VMM doesn't respond
+// - vmm_exited: The VMM process is gone but its socket was left behind
+// - unexpected_vmm_state: The VMM reported a state hypeman doesn't recognize
+// - snapshot_incomplete: A standby snapshot was interrupted before it was fully written
+type InstanceStateReason string
+
+// InstanceEvent defines model for InstanceEvent.
+type InstanceEvent struct {
+	// InstanceId Instance identifier
+	InstanceId string `json:"instance_id"`
+
+	// Name Instance name
+	Name string `json:"name"`
+
+	// NewState Instance state:
+	// - Created: VMM created but not started (Cloud Hypervisor native)
+	// - Running: VM is actively running (Cloud Hypervisor native)
+	// - Paused: VM is paused (Cloud Hypervisor native)
+	// - Shutdown: VM shut down but VMM exists (Cloud Hypervisor native)
+	// - Stopped: No VMM running, no snapshot exists
+	// - Standby: No VMM running, snapshot exists (can be restored)
+	// - Unknown: Failed to determine state (see state_reason and state_error for details)
+	NewState *InstanceState `json:"new_state,omitempty"`
+
+	// OldState Instance state:
+	// - Created: VMM created but not started (Cloud Hypervisor native)
+	// - Running: VM is actively running (Cloud Hypervisor native)
+	// - Paused: VM is paused (Cloud Hypervisor native)
+	// - Shutdown: VM shut down but VMM exists (Cloud Hypervisor native)
+	// - Stopped: No VMM running, no snapshot exists
+	// - Standby: No VMM running, snapshot exists (can be restored)
+	// - Unknown: Failed to determine state (see state_reason and state_error for details)
+	OldState *InstanceState `json:"old_state,omitempty"`
+
+	// Timestamp When the change completed
+	Timestamp time.Time `json:"timestamp"`
+
+	// Type Kind of state change:
+	// - created: Instance created and booted
+	// - running: Started, or restored from standby
+	// - stopped: Stopped
+	// - standby: Put in standby
+	// - deleted: Deleted
+	Type InstanceEventType `json:"type"`
+}
+
+// InstanceEventType Kind of state change:
+// - created: Instance created and booted
+// - running: Started, or restored from standby
+// - stopped: Stopped
+// - standby: Put in standby
+// - deleted: Deleted
+type InstanceEventType string
+
+// InstanceProcesses defines model for InstanceProcesses.
+type InstanceProcesses struct {
+	// Processes Processes running in the guest, ordered by PID
+	Processes []GuestProcess `json:"processes"`
+	Stats     GuestStats     `json:"stats"`
+}
+
 // InstanceState Instance state:
 // - Created: VMM created but not started (Cloud Hypervisor native)
 // - Running: VM is actively running (Cloud Hypervisor native)
@@ -558,9 +1544,44 @@ type InstanceHypervisor string
 // - Shutdown: VM shut down but VMM exists (Cloud Hypervisor native)
 // - Stopped: No VMM running, no snapshot exists
 // - Standby: No VMM running, snapshot exists (can be restored)
-// - Unknown: Failed to determine state (see state_error for details)
+// - Unknown: Failed to determine state (see state_reason and state_error for details)
 type InstanceState string
 
+// LogRetention How long rotated logs of an instance are kept. Unset fields use the server's
+// LOG_MAX_AGE and LOG_MAX_FILES.
+type LogRetention struct {
+	// MaxAge Delete rotated logs older than this (Go duration, e.g. "168h")
+	MaxAge *string `json:"max_age,omitempty"`
+
+	// MaxFiles Rotated files to keep per log
+	MaxFiles *int `json:"max_files,omitempty"`
+}
+
+// NUMANode defines model for NUMANode.
+type NUMANode struct {
+	// Cpus Logical CPUs of the node
+	Cpus []int `json:"cpus"`
+	Id   int   `json:"id"`
+
+	// MemoryBytes Memory local to the node
+	MemoryBytes *int64 `json:"memory_bytes,omitempty"`
+}
+
+// OverlayCompaction defines model for OverlayCompaction.
+type OverlayCompaction struct {
+	// BytesAfter Host disk allocated to the overlay after compacting
+	BytesAfter int64 `json:"bytes_after"`
+
+	// BytesBefore Host disk allocated to the overlay before compacting
+	BytesBefore int64 `json:"bytes_before"`
+
+	// OverlayFormat Format of the compacted overlay disk
+	OverlayFormat OverlayCompactionOverlayFormat `json:"overlay_format"`
+}
+
+// OverlayCompactionOverlayFormat Format of the compacted overlay disk
+type OverlayCompactionOverlayFormat string
+
 // PathInfo defines model for PathInfo.
 type PathInfo struct {
 	// Error Error message if stat failed (e.g., permission denied). Only set when exists is false due to an error rather than the path not existing.
@@ -588,6 +1609,33 @@ type PathInfo struct {
 	Size *int64 `json:"size,omitempty"`
 }
 
+// PreservedSnapshot defines model for PreservedSnapshot.
+type PreservedSnapshot struct {
+	// HasMemory Whether a memory snapshot was kept. False if the instance was stopped, so only its disk was kept.
+	HasMemory bool `json:"has_memory"`
+
+	// Hypervisor Hypervisor the instance ran on
+	Hypervisor PreservedSnapshotHypervisor `json:"hypervisor"`
+
+	// Image OCI image the instance ran
+	Image string `json:"image"`
+
+	// InstanceId ID the instance had
+	InstanceId string `json:"instance_id"`
+
+	// Name Name the instance had
+	Name string `json:"name"`
+
+	// PreservedAt When the instance was deleted
+	PreservedAt time.Time `json:"preserved_at"`
+
+	// SizeBytes Disk space used by the preserved state
+	SizeBytes int64 `json:"size_bytes"`
+}
+
+// PreservedSnapshotHypervisor Hypervisor the instance ran on
+type PreservedSnapshotHypervisor string
+
 // ResourceAllocation defines model for ResourceAllocation.
 type ResourceAllocation struct {
 	// Cpu vCPUs allocated
@@ -646,9 +1694,101 @@ type Resources struct {
 	Network       ResourceStatus       `json:"network"`
 }
 
-// Volume defines model for Volume.
-type Volume struct {
-	// Attachments List of current attachments (empty if not attached)
+// SecretScan Check of the pushed image for leaked build secrets, run when a build
+// uses secrets. The build fails if any leak is found.
+type SecretScan struct {
+	// LayersScanned Number of distinct image layers checked
+	LayersScanned int `json:"layers_scanned"`
+
+	// Leaks Each leak found, naming the layer, file and secret ID (never the value)
+	Leaks *[]string `json:"leaks,omitempty"`
+
+	// Passed True if no file under /run/secrets and no secret value was found in the image
+	Passed bool `json:"passed"`
+}
+
+// StickySession Pins each client to one of a target's instances with a cookie, for apps that
+// keep session state in memory. Requires instances.
+type StickySession struct {
+	// Cookie Name of the cookie that records the client's instance
+	Cookie string `json:"cookie"`
+
+	// Ttl How long the cookie lasts (Go duration, e.g. "1h"). Unset makes it a session cookie.
+	Ttl *string `json:"ttl,omitempty"`
+}
+
+// SystemdOptions Boot options for an instance in systemd mode
+type SystemdOptions struct {
+	// DefaultTarget Target to boot into instead of default.target
+	DefaultTarget *string `json:"default_target,omitempty"`
+
+	// MaskUnits Units masked so they never start, with their type suffix
+	MaskUnits *[]string `json:"mask_units,omitempty"`
+}
+
+// TenantBuildQueueStats defines model for TenantBuildQueueStats.
+type TenantBuildQueueStats struct {
+	// Active The tenant's running builds
+	Active int `json:"active"`
+
+	// Pending The tenant's builds waiting for a slot
+	Pending int `json:"pending"`
+
+	// Tenant Subject of the tenant's API token
+	Tenant string `json:"tenant"`
+}
+
+// TimeSync defines model for TimeSync.
+type TimeSync struct {
+	// OffsetMs Correction applied to the guest clock (host minus guest time, in milliseconds)
+	OffsetMs int64 `json:"offset_ms"`
+
+	// SyncedAt Host time the guest clock was set to
+	SyncedAt time.Time `json:"synced_at"`
+}
+
+// TokenVerification defines model for TokenVerification.
+type TokenVerification struct {
+	// Algorithm Signing algorithm from the token header
+	Algorithm string    `json:"algorithm"`
+	Audience  *[]string `json:"audience,omitempty"`
+
+	// Error Why the token is rejected
+	Error     *string    `json:"error,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// ExpiresInSeconds Seconds until the token expires by the server's clock, negative once expired
+	ExpiresInSeconds *int64     `json:"expires_in_seconds,omitempty"`
+	IssuedAt         *time.Time `json:"issued_at,omitempty"`
+	Issuer           *string    `json:"issuer,omitempty"`
+
+	// KeyId Key ID from the token header
+	KeyId     *string    `json:"key_id,omitempty"`
+	NotBefore *time.Time `json:"not_before,omitempty"`
+
+	// ServerTime Server time the token was checked against, for spotting clock skew
+	ServerTime time.Time `json:"server_time"`
+	Subject    *string   `json:"subject,omitempty"`
+
+	// Type User token, or registry token of a builder VM (accepted on /v2 only)
+	Type TokenVerificationType `json:"type"`
+
+	// Valid Whether the API would accept the token
+	Valid bool `json:"valid"`
+}
+
+// TokenVerificationType User token, or registry token of a builder VM (accepted on /v2 only)
+type TokenVerificationType string
+
+// VerifyTokenRequest defines model for VerifyTokenRequest.
+type VerifyTokenRequest struct {
+	// Token JWT to verify, without the "Bearer " prefix
+	Token string `json:"token"`
+}
+
+// Volume defines model for Volume.
+type Volume struct {
+	// Attachments List of current attachments (empty if not attached)
 	Attachments *[]VolumeAttachment `json:"attachments,omitempty"`
 
 	// CreatedAt Creation timestamp (RFC3339)
@@ -694,45 +1834,200 @@ type VolumeMount struct {
 	VolumeId string `json:"volume_id"`
 }
 
+// ListCursor defines model for ListCursor.
+type ListCursor = string
+
+// ListLimit defines model for ListLimit.
+type ListLimit = int
+
+// ListBuildsParams defines parameters for ListBuilds.
+type ListBuildsParams struct {
+	// Limit Maximum number of items to return
+	Limit *ListLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Continue after the previous page. Pass the X-Next-Cursor header of that
+	// page, with the same sort.
+	Cursor *ListCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Field to sort by, prefixed with "-" for descending order
+	Sort *ListBuildsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListBuildsParamsSort defines parameters for ListBuilds.
+type ListBuildsParamsSort string
+
 // CreateBuildMultipartBody defines parameters for CreateBuild.
 type CreateBuildMultipartBody struct {
 	// BaseImageDigest Optional pinned base image digest
 	BaseImageDigest *string `json:"base_image_digest,omitempty"`
 
+	// CacheImports Additional cache scopes to import from, e.g. a base-layer cache shared
+	// between projects. Repeat the field for each scope. These caches are only
+	// read; the build exports its cache to cache_scope alone.
+	CacheImports *[]string `json:"cache_imports,omitempty"`
+
 	// CacheScope Tenant-specific cache key prefix
 	CacheScope *string `json:"cache_scope,omitempty"`
 
 	// Dockerfile Dockerfile content. Required if not included in the source tarball.
 	Dockerfile *string `json:"dockerfile,omitempty"`
 
+	// Frontend BuildKit frontend. Defaults to the built-in dockerfile.v0. Otherwise a
+	// frontend image such as docker/dockerfile:1.7 or a buildpacks frontend,
+	// which must be in the server's BUILD_ALLOWED_FRONTENDS allowlist.
+	Frontend *string `json:"frontend,omitempty"`
+
+	// FrontendOpts JSON object of extra options passed to the frontend (buildctl --opt).
+	// Example: {"target": "production"}
+	FrontendOpts *string `json:"frontend_opts,omitempty"`
+
+	// MemoryMb Memory for the builder VM in MB (default 2048). Must be within the
+	// server's BUILD_MIN_MEMORY_MB and BUILD_MAX_MEMORY_MB. A build that runs
+	// out of it fails with an "out of memory" error.
+	MemoryMb *int `json:"memory_mb,omitempty"`
+
+	// OutputType What the build produces. "image" pushes an image to the registry.
+	// "local" and "tar" export the final stage's files instead, which are
+	// downloaded from GET /builds/{id}/artifact.
+	OutputType *CreateBuildMultipartBodyOutputType `json:"output_type,omitempty"`
+
 	// Secrets JSON array of secret references to inject during build.
 	// Each object has "id" (required) for use with --mount=type=secret,id=...
 	// Example: [{"id": "npm_token"}, {"id": "github_token"}]
 	Secrets *string `json:"secrets,omitempty"`
 
-	// Source Source tarball (tar.gz) containing application code and optionally a Dockerfile
+	// SkipDockerfileValidation Skip the syntax check of the dockerfile field that runs before a builder VM
+	// is started, e.g. for syntax only an experimental frontend understands.
+	// Dockerfiles with a "# syntax=" directive are never checked.
+	SkipDockerfileValidation *bool `json:"skip_dockerfile_validation,omitempty"`
+
+	// Source Source tarball (tar.gz) containing application code and optionally a Dockerfile.
+	// It is streamed to disk and may be up to the server's BUILD_MAX_SOURCE_SIZE.
+	// Its part's Content-Type, if set, must be application/gzip (or x-gzip),
+	// application/x-compressed-tar or application/octet-stream; an uncompressed
+	// tar is refused.
 	Source openapi_types.File `json:"source"`
 
 	// TimeoutSeconds Build timeout (default 600)
 	TimeoutSeconds *int `json:"timeout_seconds,omitempty"`
 }
 
+// CreateBuildMultipartBodyOutputType defines parameters for CreateBuild.
+type CreateBuildMultipartBodyOutputType string
+
 // GetBuildEventsParams defines parameters for GetBuildEvents.
 type GetBuildEventsParams struct {
 	// Follow Continue streaming new events after initial output
 	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
 }
 
+// ListDevicesParams defines parameters for ListDevices.
+type ListDevicesParams struct {
+	// Limit Maximum number of items to return
+	Limit *ListLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Continue after the previous page. Pass the X-Next-Cursor header of that
+	// page, with the same sort.
+	Cursor *ListCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Field to sort by, prefixed with "-" for descending order
+	Sort *ListDevicesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListDevicesParamsSort defines parameters for ListDevices.
+type ListDevicesParamsSort string
+
+// GetHostTopologyParams defines parameters for GetHostTopology.
+type GetHostTopologyParams struct {
+	// Vcpus vCPU count to plan a guest topology for
+	Vcpus *int `form:"vcpus,omitempty" json:"vcpus,omitempty"`
+}
+
+// CollectImageGarbageParams defines parameters for CollectImageGarbage.
+type CollectImageGarbageParams struct {
+	// DryRun Report what would be removed without deleting anything
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// LookupIngressDNSParams defines parameters for LookupIngressDNS.
+type LookupIngressDNSParams struct {
+	// Instance Instance name or ID, as used in an ingress target
+	Instance string `form:"instance" json:"instance"`
+}
+
+// ListInstancesParams defines parameters for ListInstances.
+type ListInstancesParams struct {
+	// Limit Maximum number of items to return
+	Limit *ListLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Continue after the previous page. Pass the X-Next-Cursor header of that
+	// page, with the same sort.
+	Cursor *ListCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Field to sort by, prefixed with "-" for descending order
+	Sort *ListInstancesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListInstancesParamsSort defines parameters for ListInstances.
+type ListInstancesParamsSort string
+
+// WatchInstancesParams defines parameters for WatchInstances.
+type WatchInstancesParams struct {
+	// Instance Only watch this instance (ID, name, or ID prefix)
+	Instance *string `form:"instance,omitempty" json:"instance,omitempty"`
+
+	// Type Only send events of these types
+	Type *[]InstanceEventType `form:"type,omitempty" json:"type,omitempty"`
+}
+
+// DeleteInstanceParams defines parameters for DeleteInstance.
+type DeleteInstanceParams struct {
+	// Force Kill the VMM by PID and release the instance's network, devices and
+	// volumes without relying on the VMM responding. Use for instances whose
+	// VMM is hung. Safe to retry if it fails part way. Also deletes an
+	// instance that ingresses still route to.
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// Snapshot Keep the instance's disk and, if it is running, a standby snapshot of
+	// it, listed under /instances/preserved until the retention period
+	// (PRESERVED_SNAPSHOT_RETENTION) ends. Defaults to the server's
+	// SNAPSHOT_BEFORE_DELETE setting. Can't be combined with force.
+	Snapshot *bool `form:"snapshot,omitempty" json:"snapshot,omitempty"`
+
+	// Cascade Also delete the ingresses whose rules all target this instance, which
+	// would otherwise answer 503. If an ingress routes to other instances
+	// too, nothing is deleted and the request fails with 409; ingresses
+	// routing to an instance named by a hostname pattern are ignored. The
+	// response lists what was deleted. The ingresses are deleted first; if
+	// the instance then can't be deleted, the error message names them.
+	Cascade *bool `form:"cascade,omitempty" json:"cascade,omitempty"`
+
+	// CascadeVolumes With cascade, also delete the volumes attached to this instance and no
+	// other. Their data is lost.
+	CascadeVolumes *bool `form:"cascade_volumes,omitempty" json:"cascade_volumes,omitempty"`
+}
+
+// GetInstanceFileParams defines parameters for GetInstanceFile.
+type GetInstanceFileParams struct {
+	// Path Absolute path of the file in the guest filesystem
+	Path string `form:"path" json:"path"`
+
+	// StatOnly Return only metadata without file content
+	StatOnly *bool `form:"stat_only,omitempty" json:"stat_only,omitempty"`
+}
+
 // GetInstanceLogsParams defines parameters for GetInstanceLogs.
 type GetInstanceLogsParams struct {
-	// Tail Number of lines to return from end
+	// Tail Number of lines to return from end. Continues into rotated log files if the current one is shorter.
 	Tail *int `form:"tail,omitempty" json:"tail,omitempty"`
 
 	// Follow Continue streaming new lines after initial output
 	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
 
 	// Source Log source to stream:
-	// - app: Guest application logs (serial console output)
+	// - app: Guest application output (virtio console; the serial console under Firecracker)
+	// - kernel: Guest kernel messages (serial console)
+	// - system: Guest init and guest agent logs (serial console)
 	// - vmm: Cloud Hypervisor VMM logs (hypervisor stdout+stderr)
 	// - hypeman: Hypeman operations log (actions taken on this instance)
 	Source *GetInstanceLogsParamsSource `form:"source,omitempty" json:"source,omitempty"`
@@ -750,21 +2045,46 @@ type StatInstancePathParams struct {
 	FollowLinks *bool `form:"follow_links,omitempty" json:"follow_links,omitempty"`
 }
 
+// ListVolumesParams defines parameters for ListVolumes.
+type ListVolumesParams struct {
+	// Limit Maximum number of items to return
+	Limit *ListLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Continue after the previous page. Pass the X-Next-Cursor header of that
+	// page, with the same sort.
+	Cursor *ListCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Field to sort by, prefixed with "-" for descending order
+	Sort *ListVolumesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListVolumesParamsSort defines parameters for ListVolumes.
+type ListVolumesParamsSort string
+
 // CreateVolumeMultipartBody defines parameters for CreateVolume.
 type CreateVolumeMultipartBody struct {
 	// Content tar.gz archive file containing the volume content
-	Content openapi_types.File `json:"content"`
+	Content *openapi_types.File `json:"content,omitempty"`
 
 	// Id Optional custom volume ID (auto-generated if not provided)
 	Id *string `json:"id,omitempty"`
 
 	// Name Volume name
-	Name string `json:"name"`
+	Name *string `json:"name,omitempty"`
 
 	// SizeGb Maximum size in GB (extraction fails if content exceeds this)
-	SizeGb int `json:"size_gb"`
+	SizeGb *int `json:"size_gb,omitempty"`
 }
 
+// VerifyTokenJSONRequestBody defines body for VerifyToken for application/json ContentType.
+type VerifyTokenJSONRequestBody = VerifyTokenRequest
+
+// SetDrainJSONRequestBody defines body for SetDrain for application/json ContentType.
+type SetDrainJSONRequestBody = DrainRequest
+
+// CreateApiKeyJSONRequestBody defines body for CreateApiKey for application/json ContentType.
+type CreateApiKeyJSONRequestBody = CreateApiKeyRequest
+
 // CreateBuildMultipartRequestBody defines body for CreateBuild for multipart/form-data ContentType.
 type CreateBuildMultipartRequestBody CreateBuildMultipartBody
 
@@ -777,9 +2097,21 @@ type CreateImageJSONRequestBody = CreateImageRequest
 // CreateIngressJSONRequestBody defines body for CreateIngress for application/json ContentType.
 type CreateIngressJSONRequestBody = CreateIngressRequest
 
+// SetIngressDefaultBackendJSONRequestBody defines body for SetIngressDefaultBackend for application/json ContentType.
+type SetIngressDefaultBackendJSONRequestBody = IngressTarget
+
 // CreateInstanceJSONRequestBody defines body for CreateInstance for application/json ContentType.
 type CreateInstanceJSONRequestBody = CreateInstanceRequest
 
+// CreateInstancesJSONRequestBody defines body for CreateInstances for application/json ContentType.
+type CreateInstancesJSONRequestBody = CreateInstancesRequest
+
+// CloneInstanceJSONRequestBody defines body for CloneInstance for application/json ContentType.
+type CloneInstanceJSONRequestBody = CloneInstanceRequest
+
+// RunInstanceCommandJSONRequestBody defines body for RunInstanceCommand for application/json ContentType.
+type RunInstanceCommandJSONRequestBody = ExecRunRequest
+
 // AttachVolumeJSONRequestBody defines body for AttachVolume for application/json ContentType.
 type AttachVolumeJSONRequestBody = AttachVolumeRequest
 
@@ -862,23 +2194,59 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// VerifyTokenWithBody request with any body
+	VerifyTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyToken(ctx context.Context, body VerifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDrainStatus request
+	GetDrainStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetDrainWithBody request with any body
+	SetDrainWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetDrain(ctx context.Context, body SetDrainJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListApiKeys request
+	ListApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateApiKeyWithBody request with any body
+	CreateApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateApiKey(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeApiKey request
+	RevokeApiKey(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiKey request
+	GetApiKey(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListBuilds request
-	ListBuilds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListBuilds(ctx context.Context, params *ListBuildsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateBuildWithBody request with any body
 	CreateBuildWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetBuildQueue request
+	GetBuildQueue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CancelBuild request
 	CancelBuild(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetBuild request
 	GetBuild(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetBuildArtifact request
+	GetBuildArtifact(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestBuildCancel request
+	RequestBuildCancel(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetBuildEvents request
 	GetBuildEvents(ctx context.Context, id string, params *GetBuildEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListDevices request
-	ListDevices(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListDevices(ctx context.Context, params *ListDevicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateDeviceWithBody request with any body
 	CreateDeviceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -888,6 +2256,9 @@ type ClientInterface interface {
 	// ListAvailableDevices request
 	ListAvailableDevices(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListDevicePools request
+	ListDevicePools(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteDevice request
 	DeleteDevice(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -897,6 +2268,12 @@ type ClientInterface interface {
 	// GetHealth request
 	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetHostTopology request
+	GetHostTopology(ctx context.Context, params *GetHostTopologyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListHypervisors request
+	ListHypervisors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListImages request
 	ListImages(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -905,12 +2282,18 @@ type ClientInterface interface {
 
 	CreateImage(ctx context.Context, body CreateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CollectImageGarbage request
+	CollectImageGarbage(ctx context.Context, params *CollectImageGarbageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteImage request
 	DeleteImage(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetImage request
 	GetImage(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetImageEvents request
+	GetImageEvents(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListIngresses request
 	ListIngresses(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -919,6 +2302,20 @@ type ClientInterface interface {
 
 	CreateIngress(ctx context.Context, body CreateIngressJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ClearIngressDefaultBackend request
+	ClearIngressDefaultBackend(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIngressDefaultBackend request
+	GetIngressDefaultBackend(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetIngressDefaultBackendWithBody request with any body
+	SetIngressDefaultBackendWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetIngressDefaultBackend(ctx context.Context, body SetIngressDefaultBackendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LookupIngressDNS request
+	LookupIngressDNS(ctx context.Context, params *LookupIngressDNSParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteIngress request
 	DeleteIngress(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -926,22 +2323,70 @@ type ClientInterface interface {
 	GetIngress(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListInstances request
-	ListInstances(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListInstances(ctx context.Context, params *ListInstancesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateInstanceWithBody request with any body
 	CreateInstanceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateInstance(ctx context.Context, body CreateInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateInstancesWithBody request with any body
+	CreateInstancesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInstances(ctx context.Context, body CreateInstancesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WatchInstances request
+	WatchInstances(ctx context.Context, params *WatchInstancesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPreservedSnapshots request
+	ListPreservedSnapshots(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteInstance request
-	DeleteInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteInstance(ctx context.Context, id string, params *DeleteInstanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetInstance request
 	GetInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetInstanceBootStatus request
+	GetInstanceBootStatus(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloneInstanceWithBody request with any body
+	CloneInstanceWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CloneInstance(ctx context.Context, id string, body CloneInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompactInstanceOverlay request
+	CompactInstanceOverlay(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DetachInstanceDevice request
+	DetachInstanceDevice(ctx context.Context, id string, deviceId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AttachInstanceDevice request
+	AttachInstanceDevice(ctx context.Context, id string, deviceId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListExecSessions request
+	ListExecSessions(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExecSessionRecording request
+	GetExecSessionRecording(ctx context.Context, id string, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RunInstanceCommandWithBody request with any body
+	RunInstanceCommandWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RunInstanceCommand(ctx context.Context, id string, body RunInstanceCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInstanceFile request
+	GetInstanceFile(ctx context.Context, id string, params *GetInstanceFileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetInstanceLogs request
 	GetInstanceLogs(ctx context.Context, id string, params *GetInstanceLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListInstanceProcesses request
+	ListInstanceProcesses(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetInstanceOverlay request
+	ResetInstanceOverlay(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RestoreInstance request
 	RestoreInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -957,6 +2402,9 @@ type ClientInterface interface {
 	// StopInstance request
 	StopInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SyncInstanceTime request
+	SyncInstanceTime(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DetachVolume request
 	DetachVolume(ctx context.Context, id string, volumeId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -969,7 +2417,7 @@ type ClientInterface interface {
 	GetResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListVolumes request
-	ListVolumes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListVolumes(ctx context.Context, params *ListVolumesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateVolumeWithBody request with any body
 	CreateVolumeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -983,8 +2431,8 @@ type ClientInterface interface {
 	GetVolume(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) ListBuilds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListBuildsRequest(c.Server)
+func (c *Client) VerifyTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyTokenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -995,8 +2443,8 @@ func (c *Client) ListBuilds(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateBuildWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateBuildRequestWithBody(c.Server, contentType, body)
+func (c *Client) VerifyToken(ctx context.Context, body VerifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyTokenRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1007,8 +2455,8 @@ func (c *Client) CreateBuildWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) CancelBuild(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCancelBuildRequest(c.Server, id)
+func (c *Client) GetDrainStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDrainStatusRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1019,8 +2467,8 @@ func (c *Client) CancelBuild(ctx context.Context, id string, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetBuild(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetBuildRequest(c.Server, id)
+func (c *Client) SetDrainWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetDrainRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1031,8 +2479,8 @@ func (c *Client) GetBuild(ctx context.Context, id string, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetBuildEvents(ctx context.Context, id string, params *GetBuildEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetBuildEventsRequest(c.Server, id, params)
+func (c *Client) SetDrain(ctx context.Context, body SetDrainJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetDrainRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1043,8 +2491,8 @@ func (c *Client) GetBuildEvents(ctx context.Context, id string, params *GetBuild
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDevices(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDevicesRequest(c.Server)
+func (c *Client) ListApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListApiKeysRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1055,8 +2503,8 @@ func (c *Client) ListDevices(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDeviceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDeviceRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApiKeyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1067,8 +2515,8 @@ func (c *Client) CreateDeviceWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDevice(ctx context.Context, body CreateDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDeviceRequest(c.Server, body)
+func (c *Client) CreateApiKey(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApiKeyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1079,8 +2527,8 @@ func (c *Client) CreateDevice(ctx context.Context, body CreateDeviceJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListAvailableDevices(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListAvailableDevicesRequest(c.Server)
+func (c *Client) RevokeApiKey(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeApiKeyRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1091,8 +2539,8 @@ func (c *Client) ListAvailableDevices(ctx context.Context, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDevice(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDeviceRequest(c.Server, id)
+func (c *Client) GetApiKey(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiKeyRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1103,8 +2551,8 @@ func (c *Client) DeleteDevice(ctx context.Context, id string, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDevice(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDeviceRequest(c.Server, id)
+func (c *Client) ListBuilds(ctx context.Context, params *ListBuildsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBuildsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1115,8 +2563,8 @@ func (c *Client) GetDevice(ctx context.Context, id string, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetHealthRequest(c.Server)
+func (c *Client) CreateBuildWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBuildRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1127,8 +2575,8 @@ func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListImages(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListImagesRequest(c.Server)
+func (c *Client) GetBuildQueue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildQueueRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1139,8 +2587,8 @@ func (c *Client) ListImages(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateImageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateImageRequestWithBody(c.Server, contentType, body)
+func (c *Client) CancelBuild(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelBuildRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1151,8 +2599,8 @@ func (c *Client) CreateImageWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateImage(ctx context.Context, body CreateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateImageRequest(c.Server, body)
+func (c *Client) GetBuild(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1163,8 +2611,8 @@ func (c *Client) CreateImage(ctx context.Context, body CreateImageJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteImage(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteImageRequest(c.Server, name)
+func (c *Client) GetBuildArtifact(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildArtifactRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1175,8 +2623,8 @@ func (c *Client) DeleteImage(ctx context.Context, name string, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetImage(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetImageRequest(c.Server, name)
+func (c *Client) RequestBuildCancel(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestBuildCancelRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1187,8 +2635,8 @@ func (c *Client) GetImage(ctx context.Context, name string, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListIngresses(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListIngressesRequest(c.Server)
+func (c *Client) GetBuildEvents(ctx context.Context, id string, params *GetBuildEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildEventsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1199,8 +2647,8 @@ func (c *Client) ListIngresses(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateIngressWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateIngressRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListDevices(ctx context.Context, params *ListDevicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDevicesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1211,8 +2659,8 @@ func (c *Client) CreateIngressWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateIngress(ctx context.Context, body CreateIngressJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateIngressRequest(c.Server, body)
+func (c *Client) CreateDeviceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDeviceRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1223,8 +2671,8 @@ func (c *Client) CreateIngress(ctx context.Context, body CreateIngressJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteIngress(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteIngressRequest(c.Server, id)
+func (c *Client) CreateDevice(ctx context.Context, body CreateDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDeviceRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1235,8 +2683,8 @@ func (c *Client) DeleteIngress(ctx context.Context, id string, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetIngress(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetIngressRequest(c.Server, id)
+func (c *Client) ListAvailableDevices(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAvailableDevicesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1247,8 +2695,8 @@ func (c *Client) GetIngress(ctx context.Context, id string, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListInstances(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListInstancesRequest(c.Server)
+func (c *Client) ListDevicePools(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDevicePoolsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1259,8 +2707,8 @@ func (c *Client) ListInstances(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateInstanceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateInstanceRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeleteDevice(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDeviceRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1271,8 +2719,8 @@ func (c *Client) CreateInstanceWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateInstance(ctx context.Context, body CreateInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateInstanceRequest(c.Server, body)
+func (c *Client) GetDevice(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeviceRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1283,8 +2731,8 @@ func (c *Client) CreateInstance(ctx context.Context, body CreateInstanceJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteInstanceRequest(c.Server, id)
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1295,8 +2743,8 @@ func (c *Client) DeleteInstance(ctx context.Context, id string, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetInstanceRequest(c.Server, id)
+func (c *Client) GetHostTopology(ctx context.Context, params *GetHostTopologyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHostTopologyRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1307,8 +2755,8 @@ func (c *Client) GetInstance(ctx context.Context, id string, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetInstanceLogs(ctx context.Context, id string, params *GetInstanceLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetInstanceLogsRequest(c.Server, id, params)
+func (c *Client) ListHypervisors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListHypervisorsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1319,8 +2767,8 @@ func (c *Client) GetInstanceLogs(ctx context.Context, id string, params *GetInst
 	return c.Client.Do(req)
 }
 
-func (c *Client) RestoreInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRestoreInstanceRequest(c.Server, id)
+func (c *Client) ListImages(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListImagesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1331,8 +2779,8 @@ func (c *Client) RestoreInstance(ctx context.Context, id string, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) StandbyInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStandbyInstanceRequest(c.Server, id)
+func (c *Client) CreateImageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateImageRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1343,8 +2791,8 @@ func (c *Client) StandbyInstance(ctx context.Context, id string, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) StartInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStartInstanceRequest(c.Server, id)
+func (c *Client) CreateImage(ctx context.Context, body CreateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateImageRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1355,8 +2803,8 @@ func (c *Client) StartInstance(ctx context.Context, id string, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) StatInstancePath(ctx context.Context, id string, params *StatInstancePathParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStatInstancePathRequest(c.Server, id, params)
+func (c *Client) CollectImageGarbage(ctx context.Context, params *CollectImageGarbageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCollectImageGarbageRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1367,8 +2815,8 @@ func (c *Client) StatInstancePath(ctx context.Context, id string, params *StatIn
 	return c.Client.Do(req)
 }
 
-func (c *Client) StopInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStopInstanceRequest(c.Server, id)
+func (c *Client) DeleteImage(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteImageRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1379,8 +2827,8 @@ func (c *Client) StopInstance(ctx context.Context, id string, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) DetachVolume(ctx context.Context, id string, volumeId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDetachVolumeRequest(c.Server, id, volumeId)
+func (c *Client) GetImage(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetImageRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1391,8 +2839,8 @@ func (c *Client) DetachVolume(ctx context.Context, id string, volumeId string, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) AttachVolumeWithBody(ctx context.Context, id string, volumeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAttachVolumeRequestWithBody(c.Server, id, volumeId, contentType, body)
+func (c *Client) GetImageEvents(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetImageEventsRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1403,8 +2851,8 @@ func (c *Client) AttachVolumeWithBody(ctx context.Context, id string, volumeId s
 	return c.Client.Do(req)
 }
 
-func (c *Client) AttachVolume(ctx context.Context, id string, volumeId string, body AttachVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAttachVolumeRequest(c.Server, id, volumeId, body)
+func (c *Client) ListIngresses(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIngressesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1415,8 +2863,8 @@ func (c *Client) AttachVolume(ctx context.Context, id string, volumeId string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetResourcesRequest(c.Server)
+func (c *Client) CreateIngressWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngressRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1427,8 +2875,8 @@ func (c *Client) GetResources(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListVolumes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListVolumesRequest(c.Server)
+func (c *Client) CreateIngress(ctx context.Context, body CreateIngressJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngressRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1439,8 +2887,8 @@ func (c *Client) ListVolumes(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateVolumeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateVolumeRequestWithBody(c.Server, contentType, body)
+func (c *Client) ClearIngressDefaultBackend(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClearIngressDefaultBackendRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1451,8 +2899,8 @@ func (c *Client) CreateVolumeWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateVolume(ctx context.Context, body CreateVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateVolumeRequest(c.Server, body)
+func (c *Client) GetIngressDefaultBackend(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIngressDefaultBackendRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1463,8 +2911,8 @@ func (c *Client) CreateVolume(ctx context.Context, body CreateVolumeJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteVolume(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteVolumeRequest(c.Server, id)
+func (c *Client) SetIngressDefaultBackendWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetIngressDefaultBackendRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1475,8 +2923,8 @@ func (c *Client) DeleteVolume(ctx context.Context, id string, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetVolume(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetVolumeRequest(c.Server, id)
+func (c *Client) SetIngressDefaultBackend(ctx context.Context, body SetIngressDefaultBackendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetIngressDefaultBackendRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1487,415 +2935,511 @@ func (c *Client) GetVolume(ctx context.Context, id string, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-// NewListBuildsRequest generates requests for ListBuilds
-func NewListBuildsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) LookupIngressDNS(ctx context.Context, params *LookupIngressDNSParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLookupIngressDNSRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/builds")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteIngress(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteIngressRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateBuildRequestWithBody generates requests for CreateBuild with any type of body
-func NewCreateBuildRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetIngress(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIngressRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/builds")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListInstances(ctx context.Context, params *ListInstancesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInstancesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCancelBuildRequest generates requests for CancelBuild
-func NewCancelBuildRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) CreateInstanceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInstanceRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateInstance(ctx context.Context, body CreateInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInstanceRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/builds/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateInstancesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInstancesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetBuildRequest generates requests for GetBuild
-func NewGetBuildRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) CreateInstances(ctx context.Context, body CreateInstancesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInstancesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) WatchInstances(ctx context.Context, params *WatchInstancesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWatchInstancesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/builds/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListPreservedSnapshots(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPreservedSnapshotsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetBuildEventsRequest generates requests for GetBuildEvents
-func NewGetBuildEventsRequest(server string, id string, params *GetBuildEventsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) DeleteInstance(ctx context.Context, id string, params *DeleteInstanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInstanceRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInstanceRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/builds/%s/events", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetInstanceBootStatus(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInstanceBootStatusRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Follow != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow", runtime.ParamLocationQuery, *params.Follow); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) CloneInstanceWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneInstanceRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListDevicesRequest generates requests for ListDevices
-func NewListDevicesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CloneInstance(ctx context.Context, id string, body CloneInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneInstanceRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/devices")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CompactInstanceOverlay(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompactInstanceOverlayRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateDeviceRequest calls the generic CreateDevice builder with application/json body
-func NewCreateDeviceRequest(server string, body CreateDeviceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DetachInstanceDevice(ctx context.Context, id string, deviceId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDetachInstanceDeviceRequest(c.Server, id, deviceId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDeviceRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateDeviceRequestWithBody generates requests for CreateDevice with any type of body
-func NewCreateDeviceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AttachInstanceDevice(ctx context.Context, id string, deviceId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachInstanceDeviceRequest(c.Server, id, deviceId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/devices")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListExecSessions(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListExecSessionsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListAvailableDevicesRequest generates requests for ListAvailableDevices
-func NewListAvailableDevicesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetExecSessionRecording(ctx context.Context, id string, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExecSessionRecordingRequest(c.Server, id, sessionId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/devices/available")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RunInstanceCommandWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunInstanceCommandRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteDeviceRequest generates requests for DeleteDevice
-func NewDeleteDeviceRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) RunInstanceCommand(ctx context.Context, id string, body RunInstanceCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunInstanceCommandRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/devices/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetInstanceFile(ctx context.Context, id string, params *GetInstanceFileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInstanceFileRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) GetInstanceLogs(ctx context.Context, id string, params *GetInstanceLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInstanceLogsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetDeviceRequest generates requests for GetDevice
-func NewGetDeviceRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) ListInstanceProcesses(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInstanceProcessesRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ResetInstanceOverlay(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetInstanceOverlayRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/devices/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RestoreInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreInstanceRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) StandbyInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStandbyInstanceRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) StartInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartInstanceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetHealthRequest generates requests for GetHealth
-func NewGetHealthRequest(server string) (*http.Request, error) {
-	var err error
+func (c *Client) StatInstancePath(ctx context.Context, id string, params *StatInstancePathParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStatInstancePathRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) StopInstance(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStopInstanceRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) SyncInstanceTime(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncInstanceTimeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DetachVolume(ctx context.Context, id string, volumeId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDetachVolumeRequest(c.Server, id, volumeId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) AttachVolumeWithBody(ctx context.Context, id string, volumeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachVolumeRequestWithBody(c.Server, id, volumeId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) AttachVolume(ctx context.Context, id string, volumeId string, body AttachVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachVolumeRequest(c.Server, id, volumeId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListImagesRequest generates requests for ListImages
-func NewListImagesRequest(server string) (*http.Request, error) {
-	var err error
+func (c *Client) GetResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetResourcesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListVolumes(ctx context.Context, params *ListVolumesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListVolumesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/images")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) CreateVolumeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVolumeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateVolume(ctx context.Context, body CreateVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVolumeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DeleteVolume(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteVolumeRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) GetVolume(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVolumeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateImageRequest calls the generic CreateImage builder with application/json body
-func NewCreateImageRequest(server string, body CreateImageJSONRequestBody) (*http.Request, error) {
+// NewVerifyTokenRequest calls the generic VerifyToken builder with application/json body
+func NewVerifyTokenRequest(server string, body VerifyTokenJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateImageRequestWithBody(server, "application/json", bodyReader)
+	return NewVerifyTokenRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateImageRequestWithBody generates requests for CreateImage with any type of body
-func NewCreateImageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewVerifyTokenRequestWithBody generates requests for VerifyToken with any type of body
+func NewVerifyTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1903,7 +3447,7 @@ func NewCreateImageRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/images")
+	operationPath := fmt.Sprintf("/admin/auth/verify")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1923,23 +3467,16 @@ func NewCreateImageRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
-// NewDeleteImageRequest generates requests for DeleteImage
-func NewDeleteImageRequest(server string, name string) (*http.Request, error) {
+// NewGetDrainStatusRequest generates requests for GetDrainStatus
+func NewGetDrainStatusRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/images/%s", pathParam0)
+	operationPath := fmt.Sprintf("/admin/drain")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1949,7 +3486,7 @@ func NewDeleteImageRequest(server string, name string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1957,23 +3494,27 @@ func NewDeleteImageRequest(server string, name string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetImageRequest generates requests for GetImage
-func NewGetImageRequest(server string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+// NewSetDrainRequest calls the generic SetDrain builder with application/json body
+func NewSetDrainRequest(server string, body SetDrainJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetDrainRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetDrainRequestWithBody generates requests for SetDrain with any type of body
+func NewSetDrainRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/images/%s", pathParam0)
+	operationPath := fmt.Sprintf("/admin/drain")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1983,16 +3524,18 @@ func NewGetImageRequest(server string, name string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewListIngressesRequest generates requests for ListIngresses
-func NewListIngressesRequest(server string) (*http.Request, error) {
+// NewListApiKeysRequest generates requests for ListApiKeys
+func NewListApiKeysRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2000,7 +3543,7 @@ func NewListIngressesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ingresses")
+	operationPath := fmt.Sprintf("/api-keys")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2018,19 +3561,19 @@ func NewListIngressesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateIngressRequest calls the generic CreateIngress builder with application/json body
-func NewCreateIngressRequest(server string, body CreateIngressJSONRequestBody) (*http.Request, error) {
+// NewCreateApiKeyRequest calls the generic CreateApiKey builder with application/json body
+func NewCreateApiKeyRequest(server string, body CreateApiKeyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateIngressRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateApiKeyRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateIngressRequestWithBody generates requests for CreateIngress with any type of body
-func NewCreateIngressRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateApiKeyRequestWithBody generates requests for CreateApiKey with any type of body
+func NewCreateApiKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2038,7 +3581,7 @@ func NewCreateIngressRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ingresses")
+	operationPath := fmt.Sprintf("/api-keys")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2058,8 +3601,8 @@ func NewCreateIngressRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewDeleteIngressRequest generates requests for DeleteIngress
-func NewDeleteIngressRequest(server string, id string) (*http.Request, error) {
+// NewRevokeApiKeyRequest generates requests for RevokeApiKey
+func NewRevokeApiKeyRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2074,7 +3617,7 @@ func NewDeleteIngressRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ingresses/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api-keys/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2092,8 +3635,8 @@ func NewDeleteIngressRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetIngressRequest generates requests for GetIngress
-func NewGetIngressRequest(server string, id string) (*http.Request, error) {
+// NewGetApiKeyRequest generates requests for GetApiKey
+func NewGetApiKeyRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2108,7 +3651,7 @@ func NewGetIngressRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ingresses/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api-keys/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2126,8 +3669,8 @@ func NewGetIngressRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewListInstancesRequest generates requests for ListInstances
-func NewListInstancesRequest(server string) (*http.Request, error) {
+// NewListBuildsRequest generates requests for ListBuilds
+func NewListBuildsRequest(server string, params *ListBuildsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2135,7 +3678,7 @@ func NewListInstancesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances")
+	operationPath := fmt.Sprintf("/builds")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2145,6 +3688,60 @@ func NewListInstancesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2153,19 +3750,8 @@ func NewListInstancesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateInstanceRequest calls the generic CreateInstance builder with application/json body
-func NewCreateInstanceRequest(server string, body CreateInstanceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateInstanceRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCreateInstanceRequestWithBody generates requests for CreateInstance with any type of body
-func NewCreateInstanceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateBuildRequestWithBody generates requests for CreateBuild with any type of body
+func NewCreateBuildRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2173,7 +3759,7 @@ func NewCreateInstanceRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances")
+	operationPath := fmt.Sprintf("/builds")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2193,23 +3779,16 @@ func NewCreateInstanceRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewDeleteInstanceRequest generates requests for DeleteInstance
-func NewDeleteInstanceRequest(server string, id string) (*http.Request, error) {
+// NewGetBuildQueueRequest generates requests for GetBuildQueue
+func NewGetBuildQueueRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances/%s", pathParam0)
+	operationPath := fmt.Sprintf("/builds/queue")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2219,7 +3798,7 @@ func NewDeleteInstanceRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2227,8 +3806,8 @@ func NewDeleteInstanceRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetInstanceRequest generates requests for GetInstance
-func NewGetInstanceRequest(server string, id string) (*http.Request, error) {
+// NewCancelBuildRequest generates requests for CancelBuild
+func NewCancelBuildRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2243,7 +3822,7 @@ func NewGetInstanceRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances/%s", pathParam0)
+	operationPath := fmt.Sprintf("/builds/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2253,7 +3832,7 @@ func NewGetInstanceRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2261,8 +3840,8 @@ func NewGetInstanceRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetInstanceLogsRequest generates requests for GetInstanceLogs
-func NewGetInstanceLogsRequest(server string, id string, params *GetInstanceLogsParams) (*http.Request, error) {
+// NewGetBuildRequest generates requests for GetBuild
+func NewGetBuildRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2277,7 +3856,7 @@ func NewGetInstanceLogsRequest(server string, id string, params *GetInstanceLogs
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances/%s/logs", pathParam0)
+	operationPath := fmt.Sprintf("/builds/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2287,60 +3866,6 @@ func NewGetInstanceLogsRequest(server string, id string, params *GetInstanceLogs
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Tail != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tail", runtime.ParamLocationQuery, *params.Tail); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Follow != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow", runtime.ParamLocationQuery, *params.Follow); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Source != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2349,8 +3874,8 @@ func NewGetInstanceLogsRequest(server string, id string, params *GetInstanceLogs
 	return req, nil
 }
 
-// NewRestoreInstanceRequest generates requests for RestoreInstance
-func NewRestoreInstanceRequest(server string, id string) (*http.Request, error) {
+// NewGetBuildArtifactRequest generates requests for GetBuildArtifact
+func NewGetBuildArtifactRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2365,7 +3890,7 @@ func NewRestoreInstanceRequest(server string, id string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances/%s/restore", pathParam0)
+	operationPath := fmt.Sprintf("/builds/%s/artifact", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2375,7 +3900,7 @@ func NewRestoreInstanceRequest(server string, id string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2383,8 +3908,8 @@ func NewRestoreInstanceRequest(server string, id string) (*http.Request, error)
 	return req, nil
 }
 
-// NewStandbyInstanceRequest generates requests for StandbyInstance
-func NewStandbyInstanceRequest(server string, id string) (*http.Request, error) {
+// NewRequestBuildCancelRequest generates requests for RequestBuildCancel
+func NewRequestBuildCancelRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2399,7 +3924,7 @@ func NewStandbyInstanceRequest(server string, id string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances/%s/standby", pathParam0)
+	operationPath := fmt.Sprintf("/builds/%s/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2417,8 +3942,8 @@ func NewStandbyInstanceRequest(server string, id string) (*http.Request, error)
 	return req, nil
 }
 
-// NewStartInstanceRequest generates requests for StartInstance
-func NewStartInstanceRequest(server string, id string) (*http.Request, error) {
+// NewGetBuildEventsRequest generates requests for GetBuildEvents
+func NewGetBuildEventsRequest(server string, id string, params *GetBuildEventsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2433,7 +3958,7 @@ func NewStartInstanceRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances/%s/start", pathParam0)
+	operationPath := fmt.Sprintf("/builds/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2443,7 +3968,29 @@ func NewStartInstanceRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Follow != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow", runtime.ParamLocationQuery, *params.Follow); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2451,23 +3998,16 @@ func NewStartInstanceRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewStatInstancePathRequest generates requests for StatInstancePath
-func NewStatInstancePathRequest(server string, id string, params *StatInstancePathParams) (*http.Request, error) {
+// NewListDevicesRequest generates requests for ListDevices
+func NewListDevicesRequest(server string, params *ListDevicesParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances/%s/stat", pathParam0)
+	operationPath := fmt.Sprintf("/devices")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2480,21 +4020,41 @@ func NewStatInstancePathRequest(server string, id string, params *StatInstancePa
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
-		if params.FollowLinks != nil {
+		if params.Cursor != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow_links", runtime.ParamLocationQuery, *params.FollowLinks); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2519,23 +4079,27 @@ func NewStatInstancePathRequest(server string, id string, params *StatInstancePa
 	return req, nil
 }
 
-// NewStopInstanceRequest generates requests for StopInstance
-func NewStopInstanceRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+// NewCreateDeviceRequest calls the generic CreateDevice builder with application/json body
+func NewCreateDeviceRequest(server string, body CreateDeviceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDeviceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateDeviceRequestWithBody generates requests for CreateDevice with any type of body
+func NewCreateDeviceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances/%s/stop", pathParam0)
+	operationPath := fmt.Sprintf("/devices")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2545,38 +4109,53 @@ func NewStopInstanceRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDetachVolumeRequest generates requests for DetachVolume
-func NewDetachVolumeRequest(server string, id string, volumeId string) (*http.Request, error) {
+// NewListAvailableDevicesRequest generates requests for ListAvailableDevices
+func NewListAvailableDevicesRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/devices/available")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "volumeId", runtime.ParamLocationPath, volumeId)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewListDevicePoolsRequest generates requests for ListDevicePools
+func NewListDevicePoolsRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances/%s/volumes/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/devices/pools")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2586,7 +4165,7 @@ func NewDetachVolumeRequest(server string, id string, volumeId string) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2594,19 +4173,42 @@ func NewDetachVolumeRequest(server string, id string, volumeId string) (*http.Re
 	return req, nil
 }
 
-// NewAttachVolumeRequest calls the generic AttachVolume builder with application/json body
-func NewAttachVolumeRequest(server string, id string, volumeId string, body AttachVolumeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewDeleteDeviceRequest generates requests for DeleteDevice
+func NewDeleteDeviceRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAttachVolumeRequestWithBody(server, id, volumeId, "application/json", bodyReader)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/devices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewAttachVolumeRequestWithBody generates requests for AttachVolume with any type of body
-func NewAttachVolumeRequestWithBody(server string, id string, volumeId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetDeviceRequest generates requests for GetDevice
+func NewGetDeviceRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2616,19 +4218,39 @@ func NewAttachVolumeRequestWithBody(server string, id string, volumeId string, c
 		return nil, err
 	}
 
-	var pathParam1 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "volumeId", runtime.ParamLocationPath, volumeId)
+	operationPath := fmt.Sprintf("/devices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/instances/%s/volumes/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/health")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2638,18 +4260,65 @@ func NewAttachVolumeRequestWithBody(server string, id string, volumeId string, c
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// NewGetHostTopologyRequest generates requests for GetHostTopology
+func NewGetHostTopologyRequest(server string, params *GetHostTopologyParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/host/topology")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Vcpus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "vcpus", runtime.ParamLocationQuery, *params.Vcpus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewGetResourcesRequest generates requests for GetResources
-func NewGetResourcesRequest(server string) (*http.Request, error) {
+// NewListHypervisorsRequest generates requests for ListHypervisors
+func NewListHypervisorsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2657,7 +4326,7 @@ func NewGetResourcesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/resources")
+	operationPath := fmt.Sprintf("/hypervisors")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2675,8 +4344,8 @@ func NewGetResourcesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewListVolumesRequest generates requests for ListVolumes
-func NewListVolumesRequest(server string) (*http.Request, error) {
+// NewListImagesRequest generates requests for ListImages
+func NewListImagesRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2684,7 +4353,7 @@ func NewListVolumesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/volumes")
+	operationPath := fmt.Sprintf("/images")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2702,19 +4371,19 @@ func NewListVolumesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateVolumeRequest calls the generic CreateVolume builder with application/json body
-func NewCreateVolumeRequest(server string, body CreateVolumeJSONRequestBody) (*http.Request, error) {
+// NewCreateImageRequest calls the generic CreateImage builder with application/json body
+func NewCreateImageRequest(server string, body CreateImageJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateVolumeRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateImageRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateVolumeRequestWithBody generates requests for CreateVolume with any type of body
-func NewCreateVolumeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateImageRequestWithBody generates requests for CreateImage with any type of body
+func NewCreateImageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2722,7 +4391,7 @@ func NewCreateVolumeRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/volumes")
+	operationPath := fmt.Sprintf("/images")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2742,13 +4411,62 @@ func NewCreateVolumeRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-// NewDeleteVolumeRequest generates requests for DeleteVolume
-func NewDeleteVolumeRequest(server string, id string) (*http.Request, error) {
+// NewCollectImageGarbageRequest generates requests for CollectImageGarbage
+func NewCollectImageGarbageRequest(server string, params *CollectImageGarbageParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/images/gc")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteImageRequest generates requests for DeleteImage
+func NewDeleteImageRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -2758,7 +4476,7 @@ func NewDeleteVolumeRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/volumes/%s", pathParam0)
+	operationPath := fmt.Sprintf("/images/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2776,13 +4494,13 @@ func NewDeleteVolumeRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetVolumeRequest generates requests for GetVolume
-func NewGetVolumeRequest(server string, id string) (*http.Request, error) {
+// NewGetImageRequest generates requests for GetImage
+func NewGetImageRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -2792,7 +4510,7 @@ func NewGetVolumeRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/volumes/%s", pathParam0)
+	operationPath := fmt.Sprintf("/images/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2810,2960 +4528,7718 @@ func NewGetVolumeRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewGetImageEventsRequest generates requests for GetImageEvents
+func NewGetImageEventsRequest(server string, name string) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListBuildsWithResponse request
-	ListBuildsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBuildsResponse, error)
+	operationPath := fmt.Sprintf("/images/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreateBuildWithBodyWithResponse request with any body
-	CreateBuildWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBuildResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CancelBuildWithResponse request
-	CancelBuildWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CancelBuildResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetBuildWithResponse request
-	GetBuildWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBuildResponse, error)
+	return req, nil
+}
 
-	// GetBuildEventsWithResponse request
-	GetBuildEventsWithResponse(ctx context.Context, id string, params *GetBuildEventsParams, reqEditors ...RequestEditorFn) (*GetBuildEventsResponse, error)
+// NewListIngressesRequest generates requests for ListIngresses
+func NewListIngressesRequest(server string) (*http.Request, error) {
+	var err error
 
-	// ListDevicesWithResponse request
-	ListDevicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDevicesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateDeviceWithBodyWithResponse request with any body
-	CreateDeviceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeviceResponse, error)
+	operationPath := fmt.Sprintf("/ingresses")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateDeviceWithResponse(ctx context.Context, body CreateDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeviceResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListAvailableDevicesWithResponse request
-	ListAvailableDevicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableDevicesResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteDeviceWithResponse request
-	DeleteDeviceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteDeviceResponse, error)
+	return req, nil
+}
 
-	// GetDeviceWithResponse request
-	GetDeviceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetDeviceResponse, error)
+// NewCreateIngressRequest calls the generic CreateIngress builder with application/json body
+func NewCreateIngressRequest(server string, body CreateIngressJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateIngressRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// GetHealthWithResponse request
-	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+// NewCreateIngressRequestWithBody generates requests for CreateIngress with any type of body
+func NewCreateIngressRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// ListImagesWithResponse request
-	ListImagesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListImagesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateImageWithBodyWithResponse request with any body
-	CreateImageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateImageResponse, error)
+	operationPath := fmt.Sprintf("/ingresses")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateImageWithResponse(ctx context.Context, body CreateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateImageResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteImageWithResponse request
-	DeleteImageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteImageResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetImageWithResponse request
-	GetImageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetImageResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ListIngressesWithResponse request
-	ListIngressesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIngressesResponse, error)
+	return req, nil
+}
 
-	// CreateIngressWithBodyWithResponse request with any body
-	CreateIngressWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngressResponse, error)
+// NewClearIngressDefaultBackendRequest generates requests for ClearIngressDefaultBackend
+func NewClearIngressDefaultBackendRequest(server string) (*http.Request, error) {
+	var err error
 
-	CreateIngressWithResponse(ctx context.Context, body CreateIngressJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngressResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteIngressWithResponse request
-	DeleteIngressWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteIngressResponse, error)
+	operationPath := fmt.Sprintf("/ingresses/default-backend")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetIngressWithResponse request
-	GetIngressWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetIngressResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListInstancesWithResponse request
-	ListInstancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInstancesResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateInstanceWithBodyWithResponse request with any body
-	CreateInstanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInstanceResponse, error)
+	return req, nil
+}
 
-	CreateInstanceWithResponse(ctx context.Context, body CreateInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInstanceResponse, error)
+// NewGetIngressDefaultBackendRequest generates requests for GetIngressDefaultBackend
+func NewGetIngressDefaultBackendRequest(server string) (*http.Request, error) {
+	var err error
 
-	// DeleteInstanceWithResponse request
-	DeleteInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteInstanceResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetInstanceWithResponse request
-	GetInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetInstanceResponse, error)
+	operationPath := fmt.Sprintf("/ingresses/default-backend")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetInstanceLogsWithResponse request
-	GetInstanceLogsWithResponse(ctx context.Context, id string, params *GetInstanceLogsParams, reqEditors ...RequestEditorFn) (*GetInstanceLogsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// RestoreInstanceWithResponse request
-	RestoreInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RestoreInstanceResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// StandbyInstanceWithResponse request
-	StandbyInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StandbyInstanceResponse, error)
+	return req, nil
+}
 
-	// StartInstanceWithResponse request
-	StartInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StartInstanceResponse, error)
+// NewSetIngressDefaultBackendRequest calls the generic SetIngressDefaultBackend builder with application/json body
+func NewSetIngressDefaultBackendRequest(server string, body SetIngressDefaultBackendJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetIngressDefaultBackendRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// StatInstancePathWithResponse request
-	StatInstancePathWithResponse(ctx context.Context, id string, params *StatInstancePathParams, reqEditors ...RequestEditorFn) (*StatInstancePathResponse, error)
+// NewSetIngressDefaultBackendRequestWithBody generates requests for SetIngressDefaultBackend with any type of body
+func NewSetIngressDefaultBackendRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// StopInstanceWithResponse request
-	StopInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopInstanceResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DetachVolumeWithResponse request
-	DetachVolumeWithResponse(ctx context.Context, id string, volumeId string, reqEditors ...RequestEditorFn) (*DetachVolumeResponse, error)
+	operationPath := fmt.Sprintf("/ingresses/default-backend")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AttachVolumeWithBodyWithResponse request with any body
-	AttachVolumeWithBodyWithResponse(ctx context.Context, id string, volumeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachVolumeResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	AttachVolumeWithResponse(ctx context.Context, id string, volumeId string, body AttachVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachVolumeResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetResourcesWithResponse request
-	GetResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetResourcesResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ListVolumesWithResponse request
-	ListVolumesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVolumesResponse, error)
+	return req, nil
+}
 
-	// CreateVolumeWithBodyWithResponse request with any body
-	CreateVolumeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVolumeResponse, error)
+// NewLookupIngressDNSRequest generates requests for LookupIngressDNS
+func NewLookupIngressDNSRequest(server string, params *LookupIngressDNSParams) (*http.Request, error) {
+	var err error
 
-	CreateVolumeWithResponse(ctx context.Context, body CreateVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVolumeResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteVolumeWithResponse request
-	DeleteVolumeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteVolumeResponse, error)
+	operationPath := fmt.Sprintf("/ingresses/dns")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetVolumeWithResponse request
-	GetVolumeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetVolumeResponse, error)
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-type ListBuildsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Build
-	JSON401      *Error
-	JSON500      *Error
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r ListBuildsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "instance", runtime.ParamLocationQuery, params.Instance); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListBuildsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type CreateBuildResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON202      *Build
-	JSON400      *Error
-	JSON401      *Error
-	JSON500      *Error
-}
+// NewDeleteIngressRequest generates requests for DeleteIngress
+func NewDeleteIngressRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r CreateBuildResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateBuildResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CancelBuildResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/ingresses/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CancelBuildResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CancelBuildResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetBuildResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Build
-	JSON404      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetBuildResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetIngressRequest generates requests for GetIngress
+func NewGetIngressRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBuildResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetBuildEventsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/ingresses/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetBuildEventsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBuildEventsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDevicesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Device
-	JSON401      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListDevicesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewListInstancesRequest generates requests for ListInstances
+func NewListInstancesRequest(server string, params *ListInstancesParams) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDevicesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type CreateDeviceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Device
-	JSON400      *Error
-	JSON401      *Error
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateDeviceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/instances")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDeviceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListAvailableDevicesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]AvailableDevice
-	JSON401      *Error
-	JSON500      *Error
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r ListAvailableDevicesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListAvailableDevicesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteDeviceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteDeviceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateInstanceRequest calls the generic CreateInstance builder with application/json body
+func NewCreateInstanceRequest(server string, body CreateInstanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInstanceRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDeviceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateInstanceRequestWithBody generates requests for CreateInstance with any type of body
+func NewCreateInstanceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDeviceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Device
-	JSON404      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/instances")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetDeviceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDeviceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetHealthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Health
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetHealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateInstancesRequest calls the generic CreateInstances builder with application/json body
+func NewCreateInstancesRequest(server string, body CreateInstancesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInstancesRequestWithBody(server, "application/json", bodyReader)
 }
 
-type ListImagesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Image
-	JSON401      *Error
-	JSON500      *Error
-}
+// NewCreateInstancesRequestWithBody generates requests for CreateInstances with any type of body
+func NewCreateInstancesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ListImagesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListImagesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/instances/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type CreateImageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON202      *Image
-	JSON400      *Error
-	JSON401      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateImageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateImageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteImageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON500      *Error
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteImageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewWatchInstancesRequest generates requests for WatchInstances
+func NewWatchInstancesRequest(server string, params *WatchInstancesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteImageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/instances/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetImageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Image
-	JSON404      *Error
-	JSON500      *Error
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetImageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Instance != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "instance", runtime.ParamLocationQuery, *params.Instance); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetImageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListIngressesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Ingress
-	JSON401      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListIngressesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewListPreservedSnapshotsRequest generates requests for ListPreservedSnapshots
+func NewListPreservedSnapshotsRequest(server string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListIngressesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateIngressResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Ingress
-	JSON400      *Error
-	JSON401      *Error
-	JSON409      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/instances/preserved")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateIngressResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateIngressResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type DeleteIngressResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
-}
+// NewDeleteInstanceRequest generates requests for DeleteInstance
+func NewDeleteInstanceRequest(server string, id string, params *DeleteInstanceParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r DeleteIngressResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteIngressResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetIngressResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Ingress
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetIngressResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/instances/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetIngressResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListInstancesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Instance
-	JSON401      *Error
-	JSON500      *Error
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r ListInstancesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Snapshot != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "snapshot", runtime.ParamLocationQuery, *params.Snapshot); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cascade != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cascade", runtime.ParamLocationQuery, *params.Cascade); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CascadeVolumes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cascade_volumes", runtime.ParamLocationQuery, *params.CascadeVolumes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListInstancesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Instance
-	JSON400      *Error
-	JSON401      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetInstanceRequest generates requests for GetInstance
+func NewGetInstanceRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/instances/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Instance
-	JSON404      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetInstanceBootStatusRequest generates requests for GetInstanceBootStatus
+func NewGetInstanceBootStatusRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetInstanceLogsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/instances/%s/boot-status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetInstanceLogsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetInstanceLogsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type RestoreInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Instance
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
+// NewCloneInstanceRequest calls the generic CloneInstance builder with application/json body
+func NewCloneInstanceRequest(server string, id string, body CloneInstanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCloneInstanceRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// Status returns HTTPResponse.Status
-func (r RestoreInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCloneInstanceRequestWithBody generates requests for CloneInstance with any type of body
+func NewCloneInstanceRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RestoreInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type StandbyInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Instance
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/instances/%s/clone", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r StandbyInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StandbyInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type StartInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Instance
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r StartInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCompactInstanceOverlayRequest generates requests for CompactInstanceOverlay
+func NewCompactInstanceOverlayRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StartInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type StatInstancePathResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PathInfo
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/instances/%s/compact", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r StatInstancePathResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StatInstancePathResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type StopInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Instance
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r StopInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewDetachInstanceDeviceRequest generates requests for DetachInstanceDevice
+func NewDetachInstanceDeviceRequest(server string, id string, deviceId string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StopInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DetachVolumeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Instance
-	JSON404      *Error
-	JSON500      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DetachVolumeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "deviceId", runtime.ParamLocationPath, deviceId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DetachVolumeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AttachVolumeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Instance
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/instances/%s/devices/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r AttachVolumeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AttachVolumeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetResourcesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Resources
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetResourcesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewAttachInstanceDeviceRequest generates requests for AttachInstanceDevice
+func NewAttachInstanceDeviceRequest(server string, id string, deviceId string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetResourcesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListVolumesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Volume
-	JSON401      *Error
-	JSON500      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r ListVolumesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "deviceId", runtime.ParamLocationPath, deviceId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListVolumesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateVolumeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Volume
-	JSON400      *Error
-	JSON401      *Error
-	JSON409      *Error
-	JSON500      *Error
-}
-
-// Status returns HTTPResponse.Status
-func (r CreateVolumeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateVolumeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/instances/%s/devices/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type DeleteVolumeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r DeleteVolumeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteVolumeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetVolumeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Volume
-	JSON404      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetVolumeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewListExecSessionsRequest generates requests for ListExecSessions
+func NewListExecSessionsRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetVolumeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	var pathParam0 string
 
-// ListBuildsWithResponse request returning *ListBuildsResponse
-func (c *ClientWithResponses) ListBuildsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBuildsResponse, error) {
-	rsp, err := c.ListBuilds(ctx, reqEditors...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListBuildsResponse(rsp)
-}
 
-// CreateBuildWithBodyWithResponse request with arbitrary body returning *CreateBuildResponse
-func (c *ClientWithResponses) CreateBuildWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBuildResponse, error) {
-	rsp, err := c.CreateBuildWithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateBuildResponse(rsp)
-}
 
-// CancelBuildWithResponse request returning *CancelBuildResponse
-func (c *ClientWithResponses) CancelBuildWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CancelBuildResponse, error) {
-	rsp, err := c.CancelBuild(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/instances/%s/exec-sessions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseCancelBuildResponse(rsp)
-}
 
-// GetBuildWithResponse request returning *GetBuildResponse
-func (c *ClientWithResponses) GetBuildWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBuildResponse, error) {
-	rsp, err := c.GetBuild(ctx, id, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetBuildResponse(rsp)
-}
 
-// GetBuildEventsWithResponse request returning *GetBuildEventsResponse
-func (c *ClientWithResponses) GetBuildEventsWithResponse(ctx context.Context, id string, params *GetBuildEventsParams, reqEditors ...RequestEditorFn) (*GetBuildEventsResponse, error) {
-	rsp, err := c.GetBuildEvents(ctx, id, params, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetBuildEventsResponse(rsp)
-}
 
-// ListDevicesWithResponse request returning *ListDevicesResponse
-func (c *ClientWithResponses) ListDevicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDevicesResponse, error) {
-	rsp, err := c.ListDevices(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListDevicesResponse(rsp)
+	return req, nil
 }
 
-// CreateDeviceWithBodyWithResponse request with arbitrary body returning *CreateDeviceResponse
-func (c *ClientWithResponses) CreateDeviceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeviceResponse, error) {
-	rsp, err := c.CreateDeviceWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateDeviceResponse(rsp)
-}
+// NewGetExecSessionRecordingRequest generates requests for GetExecSessionRecording
+func NewGetExecSessionRecordingRequest(server string, id string, sessionId string) (*http.Request, error) {
+	var err error
 
-func (c *ClientWithResponses) CreateDeviceWithResponse(ctx context.Context, body CreateDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeviceResponse, error) {
-	rsp, err := c.CreateDevice(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateDeviceResponse(rsp)
-}
+	var pathParam0 string
 
-// ListAvailableDevicesWithResponse request returning *ListAvailableDevicesResponse
-func (c *ClientWithResponses) ListAvailableDevicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableDevicesResponse, error) {
-	rsp, err := c.ListAvailableDevices(ctx, reqEditors...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListAvailableDevicesResponse(rsp)
-}
 
-// DeleteDeviceWithResponse request returning *DeleteDeviceResponse
-func (c *ClientWithResponses) DeleteDeviceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteDeviceResponse, error) {
-	rsp, err := c.DeleteDevice(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteDeviceResponse(rsp)
-}
+	var pathParam1 string
 
-// GetDeviceWithResponse request returning *GetDeviceResponse
-func (c *ClientWithResponses) GetDeviceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetDeviceResponse, error) {
-	rsp, err := c.GetDevice(ctx, id, reqEditors...)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDeviceResponse(rsp)
-}
 
-// GetHealthWithResponse request returning *GetHealthResponse
-func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
-	rsp, err := c.GetHealth(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetHealthResponse(rsp)
-}
 
-// ListImagesWithResponse request returning *ListImagesResponse
-func (c *ClientWithResponses) ListImagesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListImagesResponse, error) {
-	rsp, err := c.ListImages(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/instances/%s/exec-sessions/%s/recording", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseListImagesResponse(rsp)
-}
 
-// CreateImageWithBodyWithResponse request with arbitrary body returning *CreateImageResponse
-func (c *ClientWithResponses) CreateImageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateImageResponse, error) {
-	rsp, err := c.CreateImageWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateImageResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateImageWithResponse(ctx context.Context, body CreateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateImageResponse, error) {
-	rsp, err := c.CreateImage(ctx, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateImageResponse(rsp)
-}
 
-// DeleteImageWithResponse request returning *DeleteImageResponse
-func (c *ClientWithResponses) DeleteImageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteImageResponse, error) {
-	rsp, err := c.DeleteImage(ctx, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteImageResponse(rsp)
+	return req, nil
 }
 
-// GetImageWithResponse request returning *GetImageResponse
-func (c *ClientWithResponses) GetImageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetImageResponse, error) {
-	rsp, err := c.GetImage(ctx, name, reqEditors...)
+// NewRunInstanceCommandRequest calls the generic RunInstanceCommand builder with application/json body
+func NewRunInstanceCommandRequest(server string, id string, body RunInstanceCommandJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetImageResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewRunInstanceCommandRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// ListIngressesWithResponse request returning *ListIngressesResponse
-func (c *ClientWithResponses) ListIngressesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIngressesResponse, error) {
-	rsp, err := c.ListIngresses(ctx, reqEditors...)
+// NewRunInstanceCommandRequestWithBody generates requests for RunInstanceCommand with any type of body
+func NewRunInstanceCommandRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListIngressesResponse(rsp)
-}
 
-// CreateIngressWithBodyWithResponse request with arbitrary body returning *CreateIngressResponse
-func (c *ClientWithResponses) CreateIngressWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngressResponse, error) {
-	rsp, err := c.CreateIngressWithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateIngressResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateIngressWithResponse(ctx context.Context, body CreateIngressJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngressResponse, error) {
-	rsp, err := c.CreateIngress(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/instances/%s/exec/run", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseCreateIngressResponse(rsp)
-}
 
-// DeleteIngressWithResponse request returning *DeleteIngressResponse
-func (c *ClientWithResponses) DeleteIngressWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteIngressResponse, error) {
-	rsp, err := c.DeleteIngress(ctx, id, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteIngressResponse(rsp)
-}
 
-// GetIngressWithResponse request returning *GetIngressResponse
-func (c *ClientWithResponses) GetIngressWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetIngressResponse, error) {
-	rsp, err := c.GetIngress(ctx, id, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetIngressResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ListInstancesWithResponse request returning *ListInstancesResponse
-func (c *ClientWithResponses) ListInstancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInstancesResponse, error) {
-	rsp, err := c.ListInstances(ctx, reqEditors...)
+// NewGetInstanceFileRequest generates requests for GetInstanceFile
+func NewGetInstanceFileRequest(server string, id string, params *GetInstanceFileParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListInstancesResponse(rsp)
-}
 
-// CreateInstanceWithBodyWithResponse request with arbitrary body returning *CreateInstanceResponse
-func (c *ClientWithResponses) CreateInstanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInstanceResponse, error) {
-	rsp, err := c.CreateInstanceWithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateInstanceResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateInstanceWithResponse(ctx context.Context, body CreateInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInstanceResponse, error) {
-	rsp, err := c.CreateInstance(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/instances/%s/files", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseCreateInstanceResponse(rsp)
-}
 
-// DeleteInstanceWithResponse request returning *DeleteInstanceResponse
-func (c *ClientWithResponses) DeleteInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteInstanceResponse, error) {
-	rsp, err := c.DeleteInstance(ctx, id, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteInstanceResponse(rsp)
-}
 
-// GetInstanceWithResponse request returning *GetInstanceResponse
-func (c *ClientWithResponses) GetInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetInstanceResponse, error) {
-	rsp, err := c.GetInstance(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.StatOnly != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stat_only", runtime.ParamLocationQuery, *params.StatOnly); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return ParseGetInstanceResponse(rsp)
-}
 
-// GetInstanceLogsWithResponse request returning *GetInstanceLogsResponse
-func (c *ClientWithResponses) GetInstanceLogsWithResponse(ctx context.Context, id string, params *GetInstanceLogsParams, reqEditors ...RequestEditorFn) (*GetInstanceLogsResponse, error) {
-	rsp, err := c.GetInstanceLogs(ctx, id, params, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetInstanceLogsResponse(rsp)
+
+	return req, nil
 }
 
-// RestoreInstanceWithResponse request returning *RestoreInstanceResponse
-func (c *ClientWithResponses) RestoreInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RestoreInstanceResponse, error) {
-	rsp, err := c.RestoreInstance(ctx, id, reqEditors...)
+// NewGetInstanceLogsRequest generates requests for GetInstanceLogs
+func NewGetInstanceLogsRequest(server string, id string, params *GetInstanceLogsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRestoreInstanceResponse(rsp)
-}
 
-// StandbyInstanceWithResponse request returning *StandbyInstanceResponse
-func (c *ClientWithResponses) StandbyInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StandbyInstanceResponse, error) {
-	rsp, err := c.StandbyInstance(ctx, id, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStandbyInstanceResponse(rsp)
-}
 
-// StartInstanceWithResponse request returning *StartInstanceResponse
-func (c *ClientWithResponses) StartInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StartInstanceResponse, error) {
-	rsp, err := c.StartInstance(ctx, id, reqEditors...)
+	operationPath := fmt.Sprintf("/instances/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStartInstanceResponse(rsp)
-}
 
-// StatInstancePathWithResponse request returning *StatInstancePathResponse
-func (c *ClientWithResponses) StatInstancePathWithResponse(ctx context.Context, id string, params *StatInstancePathParams, reqEditors ...RequestEditorFn) (*StatInstancePathResponse, error) {
-	rsp, err := c.StatInstancePath(ctx, id, params, reqEditors...)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Tail != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tail", runtime.ParamLocationQuery, *params.Tail); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Follow != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow", runtime.ParamLocationQuery, *params.Follow); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Source != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStatInstancePathResponse(rsp)
+
+	return req, nil
 }
 
-// StopInstanceWithResponse request returning *StopInstanceResponse
-func (c *ClientWithResponses) StopInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopInstanceResponse, error) {
-	rsp, err := c.StopInstance(ctx, id, reqEditors...)
+// NewListInstanceProcessesRequest generates requests for ListInstanceProcesses
+func NewListInstanceProcessesRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStopInstanceResponse(rsp)
-}
 
-// DetachVolumeWithResponse request returning *DetachVolumeResponse
-func (c *ClientWithResponses) DetachVolumeWithResponse(ctx context.Context, id string, volumeId string, reqEditors ...RequestEditorFn) (*DetachVolumeResponse, error) {
-	rsp, err := c.DetachVolume(ctx, id, volumeId, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDetachVolumeResponse(rsp)
-}
 
-// AttachVolumeWithBodyWithResponse request with arbitrary body returning *AttachVolumeResponse
-func (c *ClientWithResponses) AttachVolumeWithBodyWithResponse(ctx context.Context, id string, volumeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachVolumeResponse, error) {
-	rsp, err := c.AttachVolumeWithBody(ctx, id, volumeId, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/instances/%s/processes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAttachVolumeResponse(rsp)
-}
 
-func (c *ClientWithResponses) AttachVolumeWithResponse(ctx context.Context, id string, volumeId string, body AttachVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachVolumeResponse, error) {
-	rsp, err := c.AttachVolume(ctx, id, volumeId, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAttachVolumeResponse(rsp)
+
+	return req, nil
 }
 
-// GetResourcesWithResponse request returning *GetResourcesResponse
-func (c *ClientWithResponses) GetResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetResourcesResponse, error) {
-	rsp, err := c.GetResources(ctx, reqEditors...)
+// NewResetInstanceOverlayRequest generates requests for ResetInstanceOverlay
+func NewResetInstanceOverlayRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetResourcesResponse(rsp)
-}
 
-// ListVolumesWithResponse request returning *ListVolumesResponse
-func (c *ClientWithResponses) ListVolumesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVolumesResponse, error) {
-	rsp, err := c.ListVolumes(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListVolumesResponse(rsp)
-}
 
-// CreateVolumeWithBodyWithResponse request with arbitrary body returning *CreateVolumeResponse
-func (c *ClientWithResponses) CreateVolumeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVolumeResponse, error) {
-	rsp, err := c.CreateVolumeWithBody(ctx, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/instances/%s/reset-overlay", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateVolumeResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateVolumeWithResponse(ctx context.Context, body CreateVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVolumeResponse, error) {
-	rsp, err := c.CreateVolume(ctx, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateVolumeResponse(rsp)
+
+	return req, nil
 }
 
-// DeleteVolumeWithResponse request returning *DeleteVolumeResponse
-func (c *ClientWithResponses) DeleteVolumeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteVolumeResponse, error) {
-	rsp, err := c.DeleteVolume(ctx, id, reqEditors...)
+// NewRestoreInstanceRequest generates requests for RestoreInstance
+func NewRestoreInstanceRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteVolumeResponse(rsp)
-}
 
-// GetVolumeWithResponse request returning *GetVolumeResponse
-func (c *ClientWithResponses) GetVolumeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetVolumeResponse, error) {
-	rsp, err := c.GetVolume(ctx, id, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetVolumeResponse(rsp)
-}
 
-// ParseListBuildsResponse parses an HTTP response from a ListBuildsWithResponse call
-func ParseListBuildsResponse(rsp *http.Response) (*ListBuildsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	operationPath := fmt.Sprintf("/instances/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListBuildsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Build
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// NewStandbyInstanceRequest generates requests for StandbyInstance
+func NewStandbyInstanceRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// ParseCreateBuildResponse parses an HTTP response from a CreateBuildWithResponse call
-func ParseCreateBuildResponse(rsp *http.Response) (*CreateBuildResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	operationPath := fmt.Sprintf("/instances/%s/standby", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateBuildResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest Build
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// NewStartInstanceRequest generates requests for StartInstance
+func NewStartInstanceRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	var pathParam0 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/instances/%s/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseCancelBuildResponse parses an HTTP response from a CancelBuildWithResponse call
-func ParseCancelBuildResponse(rsp *http.Response) (*CancelBuildResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CancelBuildResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+// NewStatInstancePathRequest generates requests for StatInstancePath
+func NewStatInstancePathRequest(server string, id string, params *StatInstancePathParams) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
-
-// ParseGetBuildResponse parses an HTTP response from a GetBuildWithResponse call
-func ParseGetBuildResponse(rsp *http.Response) (*GetBuildResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBuildResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/instances/%s/stat", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Build
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
 			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		if params.FollowLinks != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow_links", runtime.ParamLocationQuery, *params.FollowLinks); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON500 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParseGetBuildEventsResponse parses an HTTP response from a GetBuildEventsWithResponse call
-func ParseGetBuildEventsResponse(rsp *http.Response) (*GetBuildEventsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBuildEventsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	return req, nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// NewStopInstanceRequest generates requests for StopInstance
+func NewStopInstanceRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// ParseListDevicesResponse parses an HTTP response from a ListDevicesWithResponse call
-func ParseListDevicesResponse(rsp *http.Response) (*ListDevicesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	operationPath := fmt.Sprintf("/instances/%s/stop", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDevicesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Device
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// NewSyncInstanceTimeRequest generates requests for SyncInstanceTime
+func NewSyncInstanceTimeRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
-
-// ParseCreateDeviceResponse parses an HTTP response from a CreateDeviceWithResponse call
-func ParseCreateDeviceResponse(rsp *http.Response) (*CreateDeviceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDeviceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/instances/%s/synctime", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Device
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+// NewDetachVolumeRequest generates requests for DetachVolume
+func NewDetachVolumeRequest(server string, id string, volumeId string) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam1 string
 
-// ParseListAvailableDevicesResponse parses an HTTP response from a ListAvailableDevicesWithResponse call
-func ParseListAvailableDevicesResponse(rsp *http.Response) (*ListAvailableDevicesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "volumeId", runtime.ParamLocationPath, volumeId)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListAvailableDevicesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []AvailableDevice
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	operationPath := fmt.Sprintf("/instances/%s/volumes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseDeleteDeviceResponse parses an HTTP response from a DeleteDeviceWithResponse call
-func ParseDeleteDeviceResponse(rsp *http.Response) (*DeleteDeviceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewAttachVolumeRequest calls the generic AttachVolume builder with application/json body
+func NewAttachVolumeRequest(server string, id string, volumeId string, body AttachVolumeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAttachVolumeRequestWithBody(server, id, volumeId, "application/json", bodyReader)
+}
 
-	response := &DeleteDeviceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+// NewAttachVolumeRequestWithBody generates requests for AttachVolume with any type of body
+func NewAttachVolumeRequestWithBody(server string, id string, volumeId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam1 string
 
-// ParseGetDeviceResponse parses an HTTP response from a GetDeviceWithResponse call
-func ParseGetDeviceResponse(rsp *http.Response) (*GetDeviceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "volumeId", runtime.ParamLocationPath, volumeId)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDeviceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Device
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	operationPath := fmt.Sprintf("/instances/%s/volumes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
-func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetResourcesRequest generates requests for GetResources
+func NewGetResourcesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetHealthResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/resources")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Health
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseListImagesResponse parses an HTTP response from a ListImagesWithResponse call
-func ParseListImagesResponse(rsp *http.Response) (*ListImagesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewListVolumesRequest generates requests for ListVolumes
+func NewListVolumesRequest(server string, params *ListVolumesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListImagesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/volumes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Image
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+		if params.Limit != nil {
 
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return response, nil
-}
+		}
 
-// ParseCreateImageResponse parses an HTTP response from a CreateImageWithResponse call
-func ParseCreateImageResponse(rsp *http.Response) (*CreateImageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		if params.Cursor != nil {
 
-	response := &CreateImageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest Image
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON202 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+		if params.Sort != nil {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseDeleteImageResponse parses an HTTP response from a DeleteImageWithResponse call
-func ParseDeleteImageResponse(rsp *http.Response) (*DeleteImageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewCreateVolumeRequest calls the generic CreateVolume builder with application/json body
+func NewCreateVolumeRequest(server string, body CreateVolumeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateVolumeRequestWithBody(server, "application/json", bodyReader)
+}
 
-	response := &DeleteImageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// NewCreateVolumeRequestWithBody generates requests for CreateVolume with any type of body
+func NewCreateVolumeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/volumes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseGetImageResponse parses an HTTP response from a GetImageWithResponse call
-func ParseGetImageResponse(rsp *http.Response) (*GetImageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetImageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Image
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	req.Header.Add("Content-Type", contentType)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// NewDeleteVolumeRequest generates requests for DeleteVolume
+func NewDeleteVolumeRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/volumes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseListIngressesResponse parses an HTTP response from a ListIngressesWithResponse call
-func ParseListIngressesResponse(rsp *http.Response) (*ListIngressesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListIngressesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Ingress
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// NewGetVolumeRequest generates requests for GetVolume
+func NewGetVolumeRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
-
-// ParseCreateIngressResponse parses an HTTP response from a CreateIngressWithResponse call
-func ParseCreateIngressResponse(rsp *http.Response) (*CreateIngressResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateIngressResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/volumes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Ingress
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
 		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
 		}
-		response.JSON500 = &dest
-
 	}
+	return nil
+}
 
-	return response, nil
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
 }
 
-// ParseDeleteIngressResponse parses an HTTP response from a DeleteIngressWithResponse call
-func ParseDeleteIngressResponse(rsp *http.Response) (*DeleteIngressResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
 	if err != nil {
 		return nil, err
 	}
+	return &ClientWithResponses{client}, nil
+}
 
-	response := &DeleteIngressResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
 	}
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// VerifyTokenWithBodyWithResponse request with any body
+	VerifyTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyTokenResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	VerifyTokenWithResponse(ctx context.Context, body VerifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyTokenResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// GetDrainStatusWithResponse request
+	GetDrainStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDrainStatusResponse, error)
 
-	}
+	// SetDrainWithBodyWithResponse request with any body
+	SetDrainWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetDrainResponse, error)
 
-	return response, nil
-}
+	SetDrainWithResponse(ctx context.Context, body SetDrainJSONRequestBody, reqEditors ...RequestEditorFn) (*SetDrainResponse, error)
 
-// ParseGetIngressResponse parses an HTTP response from a GetIngressWithResponse call
-func ParseGetIngressResponse(rsp *http.Response) (*GetIngressResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// ListApiKeysWithResponse request
+	ListApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListApiKeysResponse, error)
 
-	response := &GetIngressResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// CreateApiKeyWithBodyWithResponse request with any body
+	CreateApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Ingress
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	CreateApiKeyWithResponse(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// RevokeApiKeyWithResponse request
+	RevokeApiKeyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RevokeApiKeyResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	// GetApiKeyWithResponse request
+	GetApiKeyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiKeyResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ListBuildsWithResponse request
+	ListBuildsWithResponse(ctx context.Context, params *ListBuildsParams, reqEditors ...RequestEditorFn) (*ListBuildsResponse, error)
 
-	}
+	// CreateBuildWithBodyWithResponse request with any body
+	CreateBuildWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBuildResponse, error)
 
-	return response, nil
-}
+	// GetBuildQueueWithResponse request
+	GetBuildQueueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBuildQueueResponse, error)
 
-// ParseListInstancesResponse parses an HTTP response from a ListInstancesWithResponse call
-func ParseListInstancesResponse(rsp *http.Response) (*ListInstancesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// CancelBuildWithResponse request
+	CancelBuildWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CancelBuildResponse, error)
 
-	response := &ListInstancesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// GetBuildWithResponse request
+	GetBuildWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBuildResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Instance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// GetBuildArtifactWithResponse request
+	GetBuildArtifactWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBuildArtifactResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// RequestBuildCancelWithResponse request
+	RequestBuildCancelWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RequestBuildCancelResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// GetBuildEventsWithResponse request
+	GetBuildEventsWithResponse(ctx context.Context, id string, params *GetBuildEventsParams, reqEditors ...RequestEditorFn) (*GetBuildEventsResponse, error)
 
-	}
+	// ListDevicesWithResponse request
+	ListDevicesWithResponse(ctx context.Context, params *ListDevicesParams, reqEditors ...RequestEditorFn) (*ListDevicesResponse, error)
 
-	return response, nil
-}
+	// CreateDeviceWithBodyWithResponse request with any body
+	CreateDeviceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeviceResponse, error)
 
-// ParseCreateInstanceResponse parses an HTTP response from a CreateInstanceWithResponse call
-func ParseCreateInstanceResponse(rsp *http.Response) (*CreateInstanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	CreateDeviceWithResponse(ctx context.Context, body CreateDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeviceResponse, error)
 
-	response := &CreateInstanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ListAvailableDevicesWithResponse request
+	ListAvailableDevicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableDevicesResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Instance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ListDevicePoolsWithResponse request
+	ListDevicePoolsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDevicePoolsResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// DeleteDeviceWithResponse request
+	DeleteDeviceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteDeviceResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// GetDeviceWithResponse request
+	GetDeviceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetDeviceResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
 
-	}
+	// GetHostTopologyWithResponse request
+	GetHostTopologyWithResponse(ctx context.Context, params *GetHostTopologyParams, reqEditors ...RequestEditorFn) (*GetHostTopologyResponse, error)
 
-	return response, nil
-}
+	// ListHypervisorsWithResponse request
+	ListHypervisorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListHypervisorsResponse, error)
 
-// ParseDeleteInstanceResponse parses an HTTP response from a DeleteInstanceWithResponse call
-func ParseDeleteInstanceResponse(rsp *http.Response) (*DeleteInstanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// ListImagesWithResponse request
+	ListImagesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListImagesResponse, error)
 
-	response := &DeleteInstanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// CreateImageWithBodyWithResponse request with any body
+	CreateImageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateImageResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	CreateImageWithResponse(ctx context.Context, body CreateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateImageResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// CollectImageGarbageWithResponse request
+	CollectImageGarbageWithResponse(ctx context.Context, params *CollectImageGarbageParams, reqEditors ...RequestEditorFn) (*CollectImageGarbageResponse, error)
 
-	}
+	// DeleteImageWithResponse request
+	DeleteImageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteImageResponse, error)
 
-	return response, nil
-}
+	// GetImageWithResponse request
+	GetImageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetImageResponse, error)
 
-// ParseGetInstanceResponse parses an HTTP response from a GetInstanceWithResponse call
-func ParseGetInstanceResponse(rsp *http.Response) (*GetInstanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// GetImageEventsWithResponse request
+	GetImageEventsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetImageEventsResponse, error)
 
-	response := &GetInstanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ListIngressesWithResponse request
+	ListIngressesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIngressesResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Instance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// CreateIngressWithBodyWithResponse request with any body
+	CreateIngressWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngressResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	CreateIngressWithResponse(ctx context.Context, body CreateIngressJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngressResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ClearIngressDefaultBackendWithResponse request
+	ClearIngressDefaultBackendWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ClearIngressDefaultBackendResponse, error)
 
-	}
+	// GetIngressDefaultBackendWithResponse request
+	GetIngressDefaultBackendWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIngressDefaultBackendResponse, error)
 
-	return response, nil
-}
+	// SetIngressDefaultBackendWithBodyWithResponse request with any body
+	SetIngressDefaultBackendWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetIngressDefaultBackendResponse, error)
 
-// ParseGetInstanceLogsResponse parses an HTTP response from a GetInstanceLogsWithResponse call
-func ParseGetInstanceLogsResponse(rsp *http.Response) (*GetInstanceLogsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	SetIngressDefaultBackendWithResponse(ctx context.Context, body SetIngressDefaultBackendJSONRequestBody, reqEditors ...RequestEditorFn) (*SetIngressDefaultBackendResponse, error)
 
-	response := &GetInstanceLogsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// LookupIngressDNSWithResponse request
+	LookupIngressDNSWithResponse(ctx context.Context, params *LookupIngressDNSParams, reqEditors ...RequestEditorFn) (*LookupIngressDNSResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// DeleteIngressWithResponse request
+	DeleteIngressWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteIngressResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// GetIngressWithResponse request
+	GetIngressWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetIngressResponse, error)
 
-	}
+	// ListInstancesWithResponse request
+	ListInstancesWithResponse(ctx context.Context, params *ListInstancesParams, reqEditors ...RequestEditorFn) (*ListInstancesResponse, error)
 
-	return response, nil
-}
+	// CreateInstanceWithBodyWithResponse request with any body
+	CreateInstanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInstanceResponse, error)
 
-// ParseRestoreInstanceResponse parses an HTTP response from a RestoreInstanceWithResponse call
-func ParseRestoreInstanceResponse(rsp *http.Response) (*RestoreInstanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	CreateInstanceWithResponse(ctx context.Context, body CreateInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInstanceResponse, error)
 
-	response := &RestoreInstanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// CreateInstancesWithBodyWithResponse request with any body
+	CreateInstancesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInstancesResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Instance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	CreateInstancesWithResponse(ctx context.Context, body CreateInstancesJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInstancesResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// WatchInstancesWithResponse request
+	WatchInstancesWithResponse(ctx context.Context, params *WatchInstancesParams, reqEditors ...RequestEditorFn) (*WatchInstancesResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	// ListPreservedSnapshotsWithResponse request
+	ListPreservedSnapshotsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPreservedSnapshotsResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// DeleteInstanceWithResponse request
+	DeleteInstanceWithResponse(ctx context.Context, id string, params *DeleteInstanceParams, reqEditors ...RequestEditorFn) (*DeleteInstanceResponse, error)
 
-	}
+	// GetInstanceWithResponse request
+	GetInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetInstanceResponse, error)
 
-	return response, nil
-}
+	// GetInstanceBootStatusWithResponse request
+	GetInstanceBootStatusWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetInstanceBootStatusResponse, error)
 
-// ParseStandbyInstanceResponse parses an HTTP response from a StandbyInstanceWithResponse call
-func ParseStandbyInstanceResponse(rsp *http.Response) (*StandbyInstanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// CloneInstanceWithBodyWithResponse request with any body
+	CloneInstanceWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneInstanceResponse, error)
 
-	response := &StandbyInstanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	CloneInstanceWithResponse(ctx context.Context, id string, body CloneInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneInstanceResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Instance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// CompactInstanceOverlayWithResponse request
+	CompactInstanceOverlayWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CompactInstanceOverlayResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// DetachInstanceDeviceWithResponse request
+	DetachInstanceDeviceWithResponse(ctx context.Context, id string, deviceId string, reqEditors ...RequestEditorFn) (*DetachInstanceDeviceResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	// AttachInstanceDeviceWithResponse request
+	AttachInstanceDeviceWithResponse(ctx context.Context, id string, deviceId string, reqEditors ...RequestEditorFn) (*AttachInstanceDeviceResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ListExecSessionsWithResponse request
+	ListExecSessionsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ListExecSessionsResponse, error)
 
-	}
+	// GetExecSessionRecordingWithResponse request
+	GetExecSessionRecordingWithResponse(ctx context.Context, id string, sessionId string, reqEditors ...RequestEditorFn) (*GetExecSessionRecordingResponse, error)
 
-	return response, nil
-}
+	// RunInstanceCommandWithBodyWithResponse request with any body
+	RunInstanceCommandWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunInstanceCommandResponse, error)
 
-// ParseStartInstanceResponse parses an HTTP response from a StartInstanceWithResponse call
-func ParseStartInstanceResponse(rsp *http.Response) (*StartInstanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	RunInstanceCommandWithResponse(ctx context.Context, id string, body RunInstanceCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*RunInstanceCommandResponse, error)
 
-	response := &StartInstanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// GetInstanceFileWithResponse request
+	GetInstanceFileWithResponse(ctx context.Context, id string, params *GetInstanceFileParams, reqEditors ...RequestEditorFn) (*GetInstanceFileResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Instance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// GetInstanceLogsWithResponse request
+	GetInstanceLogsWithResponse(ctx context.Context, id string, params *GetInstanceLogsParams, reqEditors ...RequestEditorFn) (*GetInstanceLogsResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// ListInstanceProcessesWithResponse request
+	ListInstanceProcessesWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ListInstanceProcessesResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	// ResetInstanceOverlayWithResponse request
+	ResetInstanceOverlayWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ResetInstanceOverlayResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// RestoreInstanceWithResponse request
+	RestoreInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RestoreInstanceResponse, error)
 
-	}
+	// StandbyInstanceWithResponse request
+	StandbyInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StandbyInstanceResponse, error)
 
-	return response, nil
-}
+	// StartInstanceWithResponse request
+	StartInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StartInstanceResponse, error)
 
-// ParseStatInstancePathResponse parses an HTTP response from a StatInstancePathWithResponse call
-func ParseStatInstancePathResponse(rsp *http.Response) (*StatInstancePathResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// StatInstancePathWithResponse request
+	StatInstancePathWithResponse(ctx context.Context, id string, params *StatInstancePathParams, reqEditors ...RequestEditorFn) (*StatInstancePathResponse, error)
 
-	response := &StatInstancePathResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// StopInstanceWithResponse request
+	StopInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopInstanceResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PathInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// SyncInstanceTimeWithResponse request
+	SyncInstanceTimeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*SyncInstanceTimeResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// DetachVolumeWithResponse request
+	DetachVolumeWithResponse(ctx context.Context, id string, volumeId string, reqEditors ...RequestEditorFn) (*DetachVolumeResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	// AttachVolumeWithBodyWithResponse request with any body
+	AttachVolumeWithBodyWithResponse(ctx context.Context, id string, volumeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachVolumeResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	AttachVolumeWithResponse(ctx context.Context, id string, volumeId string, body AttachVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachVolumeResponse, error)
 
-	}
+	// GetResourcesWithResponse request
+	GetResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetResourcesResponse, error)
 
-	return response, nil
-}
+	// ListVolumesWithResponse request
+	ListVolumesWithResponse(ctx context.Context, params *ListVolumesParams, reqEditors ...RequestEditorFn) (*ListVolumesResponse, error)
 
-// ParseStopInstanceResponse parses an HTTP response from a StopInstanceWithResponse call
-func ParseStopInstanceResponse(rsp *http.Response) (*StopInstanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// CreateVolumeWithBodyWithResponse request with any body
+	CreateVolumeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVolumeResponse, error)
 
-	response := &StopInstanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	CreateVolumeWithResponse(ctx context.Context, body CreateVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVolumeResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Instance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// DeleteVolumeWithResponse request
+	DeleteVolumeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteVolumeResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// GetVolumeWithResponse request
+	GetVolumeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetVolumeResponse, error)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+type VerifyTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenVerification
+	JSON400      *Error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r VerifyTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type GetDrainStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DrainStatus
+	JSON500      *Error
 }
 
-// ParseDetachVolumeResponse parses an HTTP response from a DetachVolumeWithResponse call
-func ParseDetachVolumeResponse(rsp *http.Response) (*DetachVolumeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetDrainStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &DetachVolumeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDrainStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Instance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type SetDrainResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DrainStatus
+	JSON400      *Error
+	JSON500      *Error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r SetDrainResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetDrainResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type ListApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ApiKey
+	JSON401      *Error
+	JSON500      *Error
 }
 
-// ParseAttachVolumeResponse parses an HTTP response from a AttachVolumeWithResponse call
-func ParseAttachVolumeResponse(rsp *http.Response) (*AttachVolumeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ListApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &AttachVolumeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Instance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+type CreateApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedApiKey
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r CreateApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type RevokeApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON500      *Error
 }
 
-// ParseGetResourcesResponse parses an HTTP response from a GetResourcesWithResponse call
-func ParseGetResourcesResponse(rsp *http.Response) (*GetResourcesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r RevokeApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &GetResourcesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Resources
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type GetApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiKey
+	JSON404      *Error
+	JSON500      *Error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r GetApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type ListBuildsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Build
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
 }
 
-// ParseListVolumesResponse parses an HTTP response from a ListVolumesWithResponse call
-func ParseListVolumesResponse(rsp *http.Response) (*ListVolumesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ListBuildsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &ListVolumesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBuildsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Volume
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type CreateBuildResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Build
+	JSON400      *Error
+	JSON401      *Error
+	JSON413      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON503      *Error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r CreateBuildResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBuildResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type GetBuildQueueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BuildQueueStats
+	JSON401      *Error
 }
 
-// ParseCreateVolumeResponse parses an HTTP response from a CreateVolumeWithResponse call
-func ParseCreateVolumeResponse(rsp *http.Response) (*CreateVolumeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetBuildQueueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &CreateVolumeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildQueueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Volume
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+type CancelBuildResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r CancelBuildResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelBuildResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type GetBuildResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Build
+	JSON404      *Error
+	JSON500      *Error
 }
 
-// ParseDeleteVolumeResponse parses an HTTP response from a DeleteVolumeWithResponse call
-func ParseDeleteVolumeResponse(rsp *http.Response) (*DeleteVolumeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetBuildResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &DeleteVolumeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+type GetBuildArtifactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r GetBuildArtifactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildArtifactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type RequestBuildCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Build
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
 }
 
-// ParseGetVolumeResponse parses an HTTP response from a GetVolumeWithResponse call
-func ParseGetVolumeResponse(rsp *http.Response) (*GetVolumeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r RequestBuildCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &GetVolumeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestBuildCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Volume
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type GetBuildEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON500      *Error
+}
 
+// Status returns HTTPResponse.Status
+func (r GetBuildEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	return response, nil
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-// ServerInterface represents all server handlers.
+type ListDevicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Device
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDevicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDevicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDeviceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Device
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDeviceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDeviceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAvailableDevicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AvailableDevice
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAvailableDevicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAvailableDevicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDevicePoolsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DevicePool
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDevicePoolsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDevicePoolsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDeviceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDeviceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDeviceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeviceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Device
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeviceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeviceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Health
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHostTopologyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HostTopology
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHostTopologyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHostTopologyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListHypervisorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HypervisorList
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListHypervisorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListHypervisorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListImagesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Image
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListImagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListImagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Image
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CollectImageGarbageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImageGCResult
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CollectImageGarbageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CollectImageGarbageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Image
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetImageEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetImageEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetImageEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIngressesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Ingress
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIngressesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIngressesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateIngressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Ingress
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateIngressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateIngressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClearIngressDefaultBackendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ClearIngressDefaultBackendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClearIngressDefaultBackendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIngressDefaultBackendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngressTarget
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIngressDefaultBackendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIngressDefaultBackendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetIngressDefaultBackendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngressTarget
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetIngressDefaultBackendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetIngressDefaultBackendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LookupIngressDNSResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngressDNSLookup
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r LookupIngressDNSResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LookupIngressDNSResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteIngressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteIngressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteIngressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIngressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ingress
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIngressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIngressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInstancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Instance
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInstancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInstancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Instance
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+	JSON500      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInstancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateInstancesResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *CreateInstancesResult
+	JSON500      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInstancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInstancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WatchInstancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r WatchInstancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WatchInstancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPreservedSnapshotsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PreservedSnapshot
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPreservedSnapshotsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPreservedSnapshotsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CascadeDeleteResult
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Instance
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInstanceBootStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BootStatus
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInstanceBootStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInstanceBootStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloneInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Instance
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CloneInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloneInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompactInstanceOverlayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OverlayCompaction
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CompactInstanceOverlayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompactInstanceOverlayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DetachInstanceDeviceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Instance
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DetachInstanceDeviceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DetachInstanceDeviceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AttachInstanceDeviceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Instance
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachInstanceDeviceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachInstanceDeviceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListExecSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ExecSession
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListExecSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListExecSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetExecSessionRecordingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExecSessionRecordingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExecSessionRecordingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RunInstanceCommandResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExecRunResult
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RunInstanceCommandResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RunInstanceCommandResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInstanceFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GuestFile
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInstanceFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInstanceFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInstanceLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInstanceLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInstanceLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInstanceProcessesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InstanceProcesses
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInstanceProcessesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInstanceProcessesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetInstanceOverlayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Instance
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetInstanceOverlayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetInstanceOverlayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Instance
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StandbyInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Instance
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StandbyInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StandbyInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Instance
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StartInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StatInstancePathResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PathInfo
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StatInstancePathResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StatInstancePathResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StopInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Instance
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StopInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StopInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SyncInstanceTimeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeSync
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SyncInstanceTimeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SyncInstanceTimeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DetachVolumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Instance
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DetachVolumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DetachVolumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AttachVolumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Instance
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachVolumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachVolumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResourcesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Resources
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListVolumesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Volume
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListVolumesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListVolumesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateVolumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Volume
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateVolumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateVolumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteVolumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteVolumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteVolumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVolumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Volume
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVolumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVolumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// VerifyTokenWithBodyWithResponse request with arbitrary body returning *VerifyTokenResponse
+func (c *ClientWithResponses) VerifyTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyTokenResponse, error) {
+	rsp, err := c.VerifyTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) VerifyTokenWithResponse(ctx context.Context, body VerifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyTokenResponse, error) {
+	rsp, err := c.VerifyToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyTokenResponse(rsp)
+}
+
+// GetDrainStatusWithResponse request returning *GetDrainStatusResponse
+func (c *ClientWithResponses) GetDrainStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDrainStatusResponse, error) {
+	rsp, err := c.GetDrainStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDrainStatusResponse(rsp)
+}
+
+// SetDrainWithBodyWithResponse request with arbitrary body returning *SetDrainResponse
+func (c *ClientWithResponses) SetDrainWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetDrainResponse, error) {
+	rsp, err := c.SetDrainWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetDrainResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetDrainWithResponse(ctx context.Context, body SetDrainJSONRequestBody, reqEditors ...RequestEditorFn) (*SetDrainResponse, error) {
+	rsp, err := c.SetDrain(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetDrainResponse(rsp)
+}
+
+// ListApiKeysWithResponse request returning *ListApiKeysResponse
+func (c *ClientWithResponses) ListApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListApiKeysResponse, error) {
+	rsp, err := c.ListApiKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListApiKeysResponse(rsp)
+}
+
+// CreateApiKeyWithBodyWithResponse request with arbitrary body returning *CreateApiKeyResponse
+func (c *ClientWithResponses) CreateApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error) {
+	rsp, err := c.CreateApiKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApiKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateApiKeyWithResponse(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error) {
+	rsp, err := c.CreateApiKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApiKeyResponse(rsp)
+}
+
+// RevokeApiKeyWithResponse request returning *RevokeApiKeyResponse
+func (c *ClientWithResponses) RevokeApiKeyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RevokeApiKeyResponse, error) {
+	rsp, err := c.RevokeApiKey(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeApiKeyResponse(rsp)
+}
+
+// GetApiKeyWithResponse request returning *GetApiKeyResponse
+func (c *ClientWithResponses) GetApiKeyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiKeyResponse, error) {
+	rsp, err := c.GetApiKey(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiKeyResponse(rsp)
+}
+
+// ListBuildsWithResponse request returning *ListBuildsResponse
+func (c *ClientWithResponses) ListBuildsWithResponse(ctx context.Context, params *ListBuildsParams, reqEditors ...RequestEditorFn) (*ListBuildsResponse, error) {
+	rsp, err := c.ListBuilds(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBuildsResponse(rsp)
+}
+
+// CreateBuildWithBodyWithResponse request with arbitrary body returning *CreateBuildResponse
+func (c *ClientWithResponses) CreateBuildWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBuildResponse, error) {
+	rsp, err := c.CreateBuildWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBuildResponse(rsp)
+}
+
+// GetBuildQueueWithResponse request returning *GetBuildQueueResponse
+func (c *ClientWithResponses) GetBuildQueueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBuildQueueResponse, error) {
+	rsp, err := c.GetBuildQueue(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildQueueResponse(rsp)
+}
+
+// CancelBuildWithResponse request returning *CancelBuildResponse
+func (c *ClientWithResponses) CancelBuildWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CancelBuildResponse, error) {
+	rsp, err := c.CancelBuild(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelBuildResponse(rsp)
+}
+
+// GetBuildWithResponse request returning *GetBuildResponse
+func (c *ClientWithResponses) GetBuildWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBuildResponse, error) {
+	rsp, err := c.GetBuild(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildResponse(rsp)
+}
+
+// GetBuildArtifactWithResponse request returning *GetBuildArtifactResponse
+func (c *ClientWithResponses) GetBuildArtifactWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBuildArtifactResponse, error) {
+	rsp, err := c.GetBuildArtifact(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildArtifactResponse(rsp)
+}
+
+// RequestBuildCancelWithResponse request returning *RequestBuildCancelResponse
+func (c *ClientWithResponses) RequestBuildCancelWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RequestBuildCancelResponse, error) {
+	rsp, err := c.RequestBuildCancel(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestBuildCancelResponse(rsp)
+}
+
+// GetBuildEventsWithResponse request returning *GetBuildEventsResponse
+func (c *ClientWithResponses) GetBuildEventsWithResponse(ctx context.Context, id string, params *GetBuildEventsParams, reqEditors ...RequestEditorFn) (*GetBuildEventsResponse, error) {
+	rsp, err := c.GetBuildEvents(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildEventsResponse(rsp)
+}
+
+// ListDevicesWithResponse request returning *ListDevicesResponse
+func (c *ClientWithResponses) ListDevicesWithResponse(ctx context.Context, params *ListDevicesParams, reqEditors ...RequestEditorFn) (*ListDevicesResponse, error) {
+	rsp, err := c.ListDevices(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDevicesResponse(rsp)
+}
+
+// CreateDeviceWithBodyWithResponse request with arbitrary body returning *CreateDeviceResponse
+func (c *ClientWithResponses) CreateDeviceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeviceResponse, error) {
+	rsp, err := c.CreateDeviceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDeviceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDeviceWithResponse(ctx context.Context, body CreateDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeviceResponse, error) {
+	rsp, err := c.CreateDevice(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDeviceResponse(rsp)
+}
+
+// ListAvailableDevicesWithResponse request returning *ListAvailableDevicesResponse
+func (c *ClientWithResponses) ListAvailableDevicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableDevicesResponse, error) {
+	rsp, err := c.ListAvailableDevices(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAvailableDevicesResponse(rsp)
+}
+
+// ListDevicePoolsWithResponse request returning *ListDevicePoolsResponse
+func (c *ClientWithResponses) ListDevicePoolsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDevicePoolsResponse, error) {
+	rsp, err := c.ListDevicePools(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDevicePoolsResponse(rsp)
+}
+
+// DeleteDeviceWithResponse request returning *DeleteDeviceResponse
+func (c *ClientWithResponses) DeleteDeviceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteDeviceResponse, error) {
+	rsp, err := c.DeleteDevice(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDeviceResponse(rsp)
+}
+
+// GetDeviceWithResponse request returning *GetDeviceResponse
+func (c *ClientWithResponses) GetDeviceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetDeviceResponse, error) {
+	rsp, err := c.GetDevice(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeviceResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// GetHostTopologyWithResponse request returning *GetHostTopologyResponse
+func (c *ClientWithResponses) GetHostTopologyWithResponse(ctx context.Context, params *GetHostTopologyParams, reqEditors ...RequestEditorFn) (*GetHostTopologyResponse, error) {
+	rsp, err := c.GetHostTopology(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHostTopologyResponse(rsp)
+}
+
+// ListHypervisorsWithResponse request returning *ListHypervisorsResponse
+func (c *ClientWithResponses) ListHypervisorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListHypervisorsResponse, error) {
+	rsp, err := c.ListHypervisors(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListHypervisorsResponse(rsp)
+}
+
+// ListImagesWithResponse request returning *ListImagesResponse
+func (c *ClientWithResponses) ListImagesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListImagesResponse, error) {
+	rsp, err := c.ListImages(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListImagesResponse(rsp)
+}
+
+// CreateImageWithBodyWithResponse request with arbitrary body returning *CreateImageResponse
+func (c *ClientWithResponses) CreateImageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateImageResponse, error) {
+	rsp, err := c.CreateImageWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateImageResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateImageWithResponse(ctx context.Context, body CreateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateImageResponse, error) {
+	rsp, err := c.CreateImage(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateImageResponse(rsp)
+}
+
+// CollectImageGarbageWithResponse request returning *CollectImageGarbageResponse
+func (c *ClientWithResponses) CollectImageGarbageWithResponse(ctx context.Context, params *CollectImageGarbageParams, reqEditors ...RequestEditorFn) (*CollectImageGarbageResponse, error) {
+	rsp, err := c.CollectImageGarbage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCollectImageGarbageResponse(rsp)
+}
+
+// DeleteImageWithResponse request returning *DeleteImageResponse
+func (c *ClientWithResponses) DeleteImageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteImageResponse, error) {
+	rsp, err := c.DeleteImage(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteImageResponse(rsp)
+}
+
+// GetImageWithResponse request returning *GetImageResponse
+func (c *ClientWithResponses) GetImageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetImageResponse, error) {
+	rsp, err := c.GetImage(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetImageResponse(rsp)
+}
+
+// GetImageEventsWithResponse request returning *GetImageEventsResponse
+func (c *ClientWithResponses) GetImageEventsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetImageEventsResponse, error) {
+	rsp, err := c.GetImageEvents(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetImageEventsResponse(rsp)
+}
+
+// ListIngressesWithResponse request returning *ListIngressesResponse
+func (c *ClientWithResponses) ListIngressesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIngressesResponse, error) {
+	rsp, err := c.ListIngresses(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIngressesResponse(rsp)
+}
+
+// CreateIngressWithBodyWithResponse request with arbitrary body returning *CreateIngressResponse
+func (c *ClientWithResponses) CreateIngressWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngressResponse, error) {
+	rsp, err := c.CreateIngressWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngressResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateIngressWithResponse(ctx context.Context, body CreateIngressJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngressResponse, error) {
+	rsp, err := c.CreateIngress(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngressResponse(rsp)
+}
+
+// ClearIngressDefaultBackendWithResponse request returning *ClearIngressDefaultBackendResponse
+func (c *ClientWithResponses) ClearIngressDefaultBackendWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ClearIngressDefaultBackendResponse, error) {
+	rsp, err := c.ClearIngressDefaultBackend(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClearIngressDefaultBackendResponse(rsp)
+}
+
+// GetIngressDefaultBackendWithResponse request returning *GetIngressDefaultBackendResponse
+func (c *ClientWithResponses) GetIngressDefaultBackendWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIngressDefaultBackendResponse, error) {
+	rsp, err := c.GetIngressDefaultBackend(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIngressDefaultBackendResponse(rsp)
+}
+
+// SetIngressDefaultBackendWithBodyWithResponse request with arbitrary body returning *SetIngressDefaultBackendResponse
+func (c *ClientWithResponses) SetIngressDefaultBackendWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetIngressDefaultBackendResponse, error) {
+	rsp, err := c.SetIngressDefaultBackendWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetIngressDefaultBackendResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetIngressDefaultBackendWithResponse(ctx context.Context, body SetIngressDefaultBackendJSONRequestBody, reqEditors ...RequestEditorFn) (*SetIngressDefaultBackendResponse, error) {
+	rsp, err := c.SetIngressDefaultBackend(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetIngressDefaultBackendResponse(rsp)
+}
+
+// LookupIngressDNSWithResponse request returning *LookupIngressDNSResponse
+func (c *ClientWithResponses) LookupIngressDNSWithResponse(ctx context.Context, params *LookupIngressDNSParams, reqEditors ...RequestEditorFn) (*LookupIngressDNSResponse, error) {
+	rsp, err := c.LookupIngressDNS(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLookupIngressDNSResponse(rsp)
+}
+
+// DeleteIngressWithResponse request returning *DeleteIngressResponse
+func (c *ClientWithResponses) DeleteIngressWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteIngressResponse, error) {
+	rsp, err := c.DeleteIngress(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteIngressResponse(rsp)
+}
+
+// GetIngressWithResponse request returning *GetIngressResponse
+func (c *ClientWithResponses) GetIngressWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetIngressResponse, error) {
+	rsp, err := c.GetIngress(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIngressResponse(rsp)
+}
+
+// ListInstancesWithResponse request returning *ListInstancesResponse
+func (c *ClientWithResponses) ListInstancesWithResponse(ctx context.Context, params *ListInstancesParams, reqEditors ...RequestEditorFn) (*ListInstancesResponse, error) {
+	rsp, err := c.ListInstances(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInstancesResponse(rsp)
+}
+
+// CreateInstanceWithBodyWithResponse request with arbitrary body returning *CreateInstanceResponse
+func (c *ClientWithResponses) CreateInstanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInstanceResponse, error) {
+	rsp, err := c.CreateInstanceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInstanceWithResponse(ctx context.Context, body CreateInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInstanceResponse, error) {
+	rsp, err := c.CreateInstance(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInstanceResponse(rsp)
+}
+
+// CreateInstancesWithBodyWithResponse request with arbitrary body returning *CreateInstancesResponse
+func (c *ClientWithResponses) CreateInstancesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInstancesResponse, error) {
+	rsp, err := c.CreateInstancesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInstancesResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInstancesWithResponse(ctx context.Context, body CreateInstancesJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInstancesResponse, error) {
+	rsp, err := c.CreateInstances(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInstancesResponse(rsp)
+}
+
+// WatchInstancesWithResponse request returning *WatchInstancesResponse
+func (c *ClientWithResponses) WatchInstancesWithResponse(ctx context.Context, params *WatchInstancesParams, reqEditors ...RequestEditorFn) (*WatchInstancesResponse, error) {
+	rsp, err := c.WatchInstances(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWatchInstancesResponse(rsp)
+}
+
+// ListPreservedSnapshotsWithResponse request returning *ListPreservedSnapshotsResponse
+func (c *ClientWithResponses) ListPreservedSnapshotsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPreservedSnapshotsResponse, error) {
+	rsp, err := c.ListPreservedSnapshots(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPreservedSnapshotsResponse(rsp)
+}
+
+// DeleteInstanceWithResponse request returning *DeleteInstanceResponse
+func (c *ClientWithResponses) DeleteInstanceWithResponse(ctx context.Context, id string, params *DeleteInstanceParams, reqEditors ...RequestEditorFn) (*DeleteInstanceResponse, error) {
+	rsp, err := c.DeleteInstance(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInstanceResponse(rsp)
+}
+
+// GetInstanceWithResponse request returning *GetInstanceResponse
+func (c *ClientWithResponses) GetInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetInstanceResponse, error) {
+	rsp, err := c.GetInstance(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInstanceResponse(rsp)
+}
+
+// GetInstanceBootStatusWithResponse request returning *GetInstanceBootStatusResponse
+func (c *ClientWithResponses) GetInstanceBootStatusWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetInstanceBootStatusResponse, error) {
+	rsp, err := c.GetInstanceBootStatus(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInstanceBootStatusResponse(rsp)
+}
+
+// CloneInstanceWithBodyWithResponse request with arbitrary body returning *CloneInstanceResponse
+func (c *ClientWithResponses) CloneInstanceWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneInstanceResponse, error) {
+	rsp, err := c.CloneInstanceWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CloneInstanceWithResponse(ctx context.Context, id string, body CloneInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneInstanceResponse, error) {
+	rsp, err := c.CloneInstance(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneInstanceResponse(rsp)
+}
+
+// CompactInstanceOverlayWithResponse request returning *CompactInstanceOverlayResponse
+func (c *ClientWithResponses) CompactInstanceOverlayWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CompactInstanceOverlayResponse, error) {
+	rsp, err := c.CompactInstanceOverlay(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompactInstanceOverlayResponse(rsp)
+}
+
+// DetachInstanceDeviceWithResponse request returning *DetachInstanceDeviceResponse
+func (c *ClientWithResponses) DetachInstanceDeviceWithResponse(ctx context.Context, id string, deviceId string, reqEditors ...RequestEditorFn) (*DetachInstanceDeviceResponse, error) {
+	rsp, err := c.DetachInstanceDevice(ctx, id, deviceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDetachInstanceDeviceResponse(rsp)
+}
+
+// AttachInstanceDeviceWithResponse request returning *AttachInstanceDeviceResponse
+func (c *ClientWithResponses) AttachInstanceDeviceWithResponse(ctx context.Context, id string, deviceId string, reqEditors ...RequestEditorFn) (*AttachInstanceDeviceResponse, error) {
+	rsp, err := c.AttachInstanceDevice(ctx, id, deviceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachInstanceDeviceResponse(rsp)
+}
+
+// ListExecSessionsWithResponse request returning *ListExecSessionsResponse
+func (c *ClientWithResponses) ListExecSessionsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ListExecSessionsResponse, error) {
+	rsp, err := c.ListExecSessions(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListExecSessionsResponse(rsp)
+}
+
+// GetExecSessionRecordingWithResponse request returning *GetExecSessionRecordingResponse
+func (c *ClientWithResponses) GetExecSessionRecordingWithResponse(ctx context.Context, id string, sessionId string, reqEditors ...RequestEditorFn) (*GetExecSessionRecordingResponse, error) {
+	rsp, err := c.GetExecSessionRecording(ctx, id, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExecSessionRecordingResponse(rsp)
+}
+
+// RunInstanceCommandWithBodyWithResponse request with arbitrary body returning *RunInstanceCommandResponse
+func (c *ClientWithResponses) RunInstanceCommandWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunInstanceCommandResponse, error) {
+	rsp, err := c.RunInstanceCommandWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunInstanceCommandResponse(rsp)
+}
+
+func (c *ClientWithResponses) RunInstanceCommandWithResponse(ctx context.Context, id string, body RunInstanceCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*RunInstanceCommandResponse, error) {
+	rsp, err := c.RunInstanceCommand(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunInstanceCommandResponse(rsp)
+}
+
+// GetInstanceFileWithResponse request returning *GetInstanceFileResponse
+func (c *ClientWithResponses) GetInstanceFileWithResponse(ctx context.Context, id string, params *GetInstanceFileParams, reqEditors ...RequestEditorFn) (*GetInstanceFileResponse, error) {
+	rsp, err := c.GetInstanceFile(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInstanceFileResponse(rsp)
+}
+
+// GetInstanceLogsWithResponse request returning *GetInstanceLogsResponse
+func (c *ClientWithResponses) GetInstanceLogsWithResponse(ctx context.Context, id string, params *GetInstanceLogsParams, reqEditors ...RequestEditorFn) (*GetInstanceLogsResponse, error) {
+	rsp, err := c.GetInstanceLogs(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInstanceLogsResponse(rsp)
+}
+
+// ListInstanceProcessesWithResponse request returning *ListInstanceProcessesResponse
+func (c *ClientWithResponses) ListInstanceProcessesWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ListInstanceProcessesResponse, error) {
+	rsp, err := c.ListInstanceProcesses(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInstanceProcessesResponse(rsp)
+}
+
+// ResetInstanceOverlayWithResponse request returning *ResetInstanceOverlayResponse
+func (c *ClientWithResponses) ResetInstanceOverlayWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ResetInstanceOverlayResponse, error) {
+	rsp, err := c.ResetInstanceOverlay(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetInstanceOverlayResponse(rsp)
+}
+
+// RestoreInstanceWithResponse request returning *RestoreInstanceResponse
+func (c *ClientWithResponses) RestoreInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RestoreInstanceResponse, error) {
+	rsp, err := c.RestoreInstance(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreInstanceResponse(rsp)
+}
+
+// StandbyInstanceWithResponse request returning *StandbyInstanceResponse
+func (c *ClientWithResponses) StandbyInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StandbyInstanceResponse, error) {
+	rsp, err := c.StandbyInstance(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStandbyInstanceResponse(rsp)
+}
+
+// StartInstanceWithResponse request returning *StartInstanceResponse
+func (c *ClientWithResponses) StartInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StartInstanceResponse, error) {
+	rsp, err := c.StartInstance(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartInstanceResponse(rsp)
+}
+
+// StatInstancePathWithResponse request returning *StatInstancePathResponse
+func (c *ClientWithResponses) StatInstancePathWithResponse(ctx context.Context, id string, params *StatInstancePathParams, reqEditors ...RequestEditorFn) (*StatInstancePathResponse, error) {
+	rsp, err := c.StatInstancePath(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStatInstancePathResponse(rsp)
+}
+
+// StopInstanceWithResponse request returning *StopInstanceResponse
+func (c *ClientWithResponses) StopInstanceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopInstanceResponse, error) {
+	rsp, err := c.StopInstance(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopInstanceResponse(rsp)
+}
+
+// SyncInstanceTimeWithResponse request returning *SyncInstanceTimeResponse
+func (c *ClientWithResponses) SyncInstanceTimeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*SyncInstanceTimeResponse, error) {
+	rsp, err := c.SyncInstanceTime(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncInstanceTimeResponse(rsp)
+}
+
+// DetachVolumeWithResponse request returning *DetachVolumeResponse
+func (c *ClientWithResponses) DetachVolumeWithResponse(ctx context.Context, id string, volumeId string, reqEditors ...RequestEditorFn) (*DetachVolumeResponse, error) {
+	rsp, err := c.DetachVolume(ctx, id, volumeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDetachVolumeResponse(rsp)
+}
+
+// AttachVolumeWithBodyWithResponse request with arbitrary body returning *AttachVolumeResponse
+func (c *ClientWithResponses) AttachVolumeWithBodyWithResponse(ctx context.Context, id string, volumeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachVolumeResponse, error) {
+	rsp, err := c.AttachVolumeWithBody(ctx, id, volumeId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachVolumeResponse(rsp)
+}
+
+func (c *ClientWithResponses) AttachVolumeWithResponse(ctx context.Context, id string, volumeId string, body AttachVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachVolumeResponse, error) {
+	rsp, err := c.AttachVolume(ctx, id, volumeId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachVolumeResponse(rsp)
+}
+
+// GetResourcesWithResponse request returning *GetResourcesResponse
+func (c *ClientWithResponses) GetResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetResourcesResponse, error) {
+	rsp, err := c.GetResources(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResourcesResponse(rsp)
+}
+
+// ListVolumesWithResponse request returning *ListVolumesResponse
+func (c *ClientWithResponses) ListVolumesWithResponse(ctx context.Context, params *ListVolumesParams, reqEditors ...RequestEditorFn) (*ListVolumesResponse, error) {
+	rsp, err := c.ListVolumes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListVolumesResponse(rsp)
+}
+
+// CreateVolumeWithBodyWithResponse request with arbitrary body returning *CreateVolumeResponse
+func (c *ClientWithResponses) CreateVolumeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVolumeResponse, error) {
+	rsp, err := c.CreateVolumeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVolumeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateVolumeWithResponse(ctx context.Context, body CreateVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVolumeResponse, error) {
+	rsp, err := c.CreateVolume(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVolumeResponse(rsp)
+}
+
+// DeleteVolumeWithResponse request returning *DeleteVolumeResponse
+func (c *ClientWithResponses) DeleteVolumeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteVolumeResponse, error) {
+	rsp, err := c.DeleteVolume(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteVolumeResponse(rsp)
+}
+
+// GetVolumeWithResponse request returning *GetVolumeResponse
+func (c *ClientWithResponses) GetVolumeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetVolumeResponse, error) {
+	rsp, err := c.GetVolume(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVolumeResponse(rsp)
+}
+
+// ParseVerifyTokenResponse parses an HTTP response from a VerifyTokenWithResponse call
+func ParseVerifyTokenResponse(rsp *http.Response) (*VerifyTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenVerification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDrainStatusResponse parses an HTTP response from a GetDrainStatusWithResponse call
+func ParseGetDrainStatusResponse(rsp *http.Response) (*GetDrainStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDrainStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DrainStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetDrainResponse parses an HTTP response from a SetDrainWithResponse call
+func ParseSetDrainResponse(rsp *http.Response) (*SetDrainResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetDrainResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DrainStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListApiKeysResponse parses an HTTP response from a ListApiKeysWithResponse call
+func ParseListApiKeysResponse(rsp *http.Response) (*ListApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ApiKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateApiKeyResponse parses an HTTP response from a CreateApiKeyWithResponse call
+func ParseCreateApiKeyResponse(rsp *http.Response) (*CreateApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedApiKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeApiKeyResponse parses an HTTP response from a RevokeApiKeyWithResponse call
+func ParseRevokeApiKeyResponse(rsp *http.Response) (*RevokeApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiKeyResponse parses an HTTP response from a GetApiKeyWithResponse call
+func ParseGetApiKeyResponse(rsp *http.Response) (*GetApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBuildsResponse parses an HTTP response from a ListBuildsWithResponse call
+func ParseListBuildsResponse(rsp *http.Response) (*ListBuildsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBuildsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Build
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBuildResponse parses an HTTP response from a CreateBuildWithResponse call
+func ParseCreateBuildResponse(rsp *http.Response) (*CreateBuildResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBuildResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Build
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildQueueResponse parses an HTTP response from a GetBuildQueueWithResponse call
+func ParseGetBuildQueueResponse(rsp *http.Response) (*GetBuildQueueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildQueueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BuildQueueStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelBuildResponse parses an HTTP response from a CancelBuildWithResponse call
+func ParseCancelBuildResponse(rsp *http.Response) (*CancelBuildResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelBuildResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildResponse parses an HTTP response from a GetBuildWithResponse call
+func ParseGetBuildResponse(rsp *http.Response) (*GetBuildResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Build
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildArtifactResponse parses an HTTP response from a GetBuildArtifactWithResponse call
+func ParseGetBuildArtifactResponse(rsp *http.Response) (*GetBuildArtifactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildArtifactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestBuildCancelResponse parses an HTTP response from a RequestBuildCancelWithResponse call
+func ParseRequestBuildCancelResponse(rsp *http.Response) (*RequestBuildCancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestBuildCancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Build
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildEventsResponse parses an HTTP response from a GetBuildEventsWithResponse call
+func ParseGetBuildEventsResponse(rsp *http.Response) (*GetBuildEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDevicesResponse parses an HTTP response from a ListDevicesWithResponse call
+func ParseListDevicesResponse(rsp *http.Response) (*ListDevicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDevicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Device
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDeviceResponse parses an HTTP response from a CreateDeviceWithResponse call
+func ParseCreateDeviceResponse(rsp *http.Response) (*CreateDeviceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDeviceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Device
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAvailableDevicesResponse parses an HTTP response from a ListAvailableDevicesWithResponse call
+func ParseListAvailableDevicesResponse(rsp *http.Response) (*ListAvailableDevicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAvailableDevicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AvailableDevice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDevicePoolsResponse parses an HTTP response from a ListDevicePoolsWithResponse call
+func ParseListDevicePoolsResponse(rsp *http.Response) (*ListDevicePoolsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDevicePoolsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DevicePool
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDeviceResponse parses an HTTP response from a DeleteDeviceWithResponse call
+func ParseDeleteDeviceResponse(rsp *http.Response) (*DeleteDeviceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDeviceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeviceResponse parses an HTTP response from a GetDeviceWithResponse call
+func ParseGetDeviceResponse(rsp *http.Response) (*GetDeviceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeviceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Device
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Health
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHostTopologyResponse parses an HTTP response from a GetHostTopologyWithResponse call
+func ParseGetHostTopologyResponse(rsp *http.Response) (*GetHostTopologyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHostTopologyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HostTopology
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListHypervisorsResponse parses an HTTP response from a ListHypervisorsWithResponse call
+func ParseListHypervisorsResponse(rsp *http.Response) (*ListHypervisorsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListHypervisorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HypervisorList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListImagesResponse parses an HTTP response from a ListImagesWithResponse call
+func ParseListImagesResponse(rsp *http.Response) (*ListImagesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListImagesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Image
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateImageResponse parses an HTTP response from a CreateImageWithResponse call
+func ParseCreateImageResponse(rsp *http.Response) (*CreateImageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateImageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Image
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCollectImageGarbageResponse parses an HTTP response from a CollectImageGarbageWithResponse call
+func ParseCollectImageGarbageResponse(rsp *http.Response) (*CollectImageGarbageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CollectImageGarbageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImageGCResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteImageResponse parses an HTTP response from a DeleteImageWithResponse call
+func ParseDeleteImageResponse(rsp *http.Response) (*DeleteImageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteImageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetImageResponse parses an HTTP response from a GetImageWithResponse call
+func ParseGetImageResponse(rsp *http.Response) (*GetImageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetImageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Image
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetImageEventsResponse parses an HTTP response from a GetImageEventsWithResponse call
+func ParseGetImageEventsResponse(rsp *http.Response) (*GetImageEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetImageEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListIngressesResponse parses an HTTP response from a ListIngressesWithResponse call
+func ParseListIngressesResponse(rsp *http.Response) (*ListIngressesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIngressesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Ingress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateIngressResponse parses an HTTP response from a CreateIngressWithResponse call
+func ParseCreateIngressResponse(rsp *http.Response) (*CreateIngressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateIngressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Ingress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseClearIngressDefaultBackendResponse parses an HTTP response from a ClearIngressDefaultBackendWithResponse call
+func ParseClearIngressDefaultBackendResponse(rsp *http.Response) (*ClearIngressDefaultBackendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ClearIngressDefaultBackendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIngressDefaultBackendResponse parses an HTTP response from a GetIngressDefaultBackendWithResponse call
+func ParseGetIngressDefaultBackendResponse(rsp *http.Response) (*GetIngressDefaultBackendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIngressDefaultBackendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngressTarget
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetIngressDefaultBackendResponse parses an HTTP response from a SetIngressDefaultBackendWithResponse call
+func ParseSetIngressDefaultBackendResponse(rsp *http.Response) (*SetIngressDefaultBackendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetIngressDefaultBackendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngressTarget
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLookupIngressDNSResponse parses an HTTP response from a LookupIngressDNSWithResponse call
+func ParseLookupIngressDNSResponse(rsp *http.Response) (*LookupIngressDNSResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LookupIngressDNSResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngressDNSLookup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteIngressResponse parses an HTTP response from a DeleteIngressWithResponse call
+func ParseDeleteIngressResponse(rsp *http.Response) (*DeleteIngressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteIngressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIngressResponse parses an HTTP response from a GetIngressWithResponse call
+func ParseGetIngressResponse(rsp *http.Response) (*GetIngressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIngressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ingress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInstancesResponse parses an HTTP response from a ListInstancesWithResponse call
+func ParseListInstancesResponse(rsp *http.Response) (*ListInstancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInstancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInstanceResponse parses an HTTP response from a CreateInstanceWithResponse call
+func ParseCreateInstanceResponse(rsp *http.Response) (*CreateInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInstancesResponse parses an HTTP response from a CreateInstancesWithResponse call
+func ParseCreateInstancesResponse(rsp *http.Response) (*CreateInstancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInstancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateInstancesResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest CreateInstancesResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWatchInstancesResponse parses an HTTP response from a WatchInstancesWithResponse call
+func ParseWatchInstancesResponse(rsp *http.Response) (*WatchInstancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WatchInstancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPreservedSnapshotsResponse parses an HTTP response from a ListPreservedSnapshotsWithResponse call
+func ParseListPreservedSnapshotsResponse(rsp *http.Response) (*ListPreservedSnapshotsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPreservedSnapshotsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PreservedSnapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteInstanceResponse parses an HTTP response from a DeleteInstanceWithResponse call
+func ParseDeleteInstanceResponse(rsp *http.Response) (*DeleteInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CascadeDeleteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInstanceResponse parses an HTTP response from a GetInstanceWithResponse call
+func ParseGetInstanceResponse(rsp *http.Response) (*GetInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInstanceBootStatusResponse parses an HTTP response from a GetInstanceBootStatusWithResponse call
+func ParseGetInstanceBootStatusResponse(rsp *http.Response) (*GetInstanceBootStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInstanceBootStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BootStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloneInstanceResponse parses an HTTP response from a CloneInstanceWithResponse call
+func ParseCloneInstanceResponse(rsp *http.Response) (*CloneInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloneInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompactInstanceOverlayResponse parses an HTTP response from a CompactInstanceOverlayWithResponse call
+func ParseCompactInstanceOverlayResponse(rsp *http.Response) (*CompactInstanceOverlayResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompactInstanceOverlayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OverlayCompaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDetachInstanceDeviceResponse parses an HTTP response from a DetachInstanceDeviceWithResponse call
+func ParseDetachInstanceDeviceResponse(rsp *http.Response) (*DetachInstanceDeviceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DetachInstanceDeviceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAttachInstanceDeviceResponse parses an HTTP response from a AttachInstanceDeviceWithResponse call
+func ParseAttachInstanceDeviceResponse(rsp *http.Response) (*AttachInstanceDeviceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AttachInstanceDeviceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListExecSessionsResponse parses an HTTP response from a ListExecSessionsWithResponse call
+func ParseListExecSessionsResponse(rsp *http.Response) (*ListExecSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListExecSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ExecSession
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetExecSessionRecordingResponse parses an HTTP response from a GetExecSessionRecordingWithResponse call
+func ParseGetExecSessionRecordingResponse(rsp *http.Response) (*GetExecSessionRecordingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetExecSessionRecordingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRunInstanceCommandResponse parses an HTTP response from a RunInstanceCommandWithResponse call
+func ParseRunInstanceCommandResponse(rsp *http.Response) (*RunInstanceCommandResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RunInstanceCommandResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExecRunResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInstanceFileResponse parses an HTTP response from a GetInstanceFileWithResponse call
+func ParseGetInstanceFileResponse(rsp *http.Response) (*GetInstanceFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInstanceFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GuestFile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInstanceLogsResponse parses an HTTP response from a GetInstanceLogsWithResponse call
+func ParseGetInstanceLogsResponse(rsp *http.Response) (*GetInstanceLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInstanceLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInstanceProcessesResponse parses an HTTP response from a ListInstanceProcessesWithResponse call
+func ParseListInstanceProcessesResponse(rsp *http.Response) (*ListInstanceProcessesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInstanceProcessesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InstanceProcesses
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetInstanceOverlayResponse parses an HTTP response from a ResetInstanceOverlayWithResponse call
+func ParseResetInstanceOverlayResponse(rsp *http.Response) (*ResetInstanceOverlayResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetInstanceOverlayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreInstanceResponse parses an HTTP response from a RestoreInstanceWithResponse call
+func ParseRestoreInstanceResponse(rsp *http.Response) (*RestoreInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStandbyInstanceResponse parses an HTTP response from a StandbyInstanceWithResponse call
+func ParseStandbyInstanceResponse(rsp *http.Response) (*StandbyInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StandbyInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartInstanceResponse parses an HTTP response from a StartInstanceWithResponse call
+func ParseStartInstanceResponse(rsp *http.Response) (*StartInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStatInstancePathResponse parses an HTTP response from a StatInstancePathWithResponse call
+func ParseStatInstancePathResponse(rsp *http.Response) (*StatInstancePathResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StatInstancePathResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PathInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStopInstanceResponse parses an HTTP response from a StopInstanceWithResponse call
+func ParseStopInstanceResponse(rsp *http.Response) (*StopInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StopInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSyncInstanceTimeResponse parses an HTTP response from a SyncInstanceTimeWithResponse call
+func ParseSyncInstanceTimeResponse(rsp *http.Response) (*SyncInstanceTimeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SyncInstanceTimeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeSync
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDetachVolumeResponse parses an HTTP response from a DetachVolumeWithResponse call
+func ParseDetachVolumeResponse(rsp *http.Response) (*DetachVolumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DetachVolumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAttachVolumeResponse parses an HTTP response from a AttachVolumeWithResponse call
+func ParseAttachVolumeResponse(rsp *http.Response) (*AttachVolumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AttachVolumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Instance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetResourcesResponse parses an HTTP response from a GetResourcesWithResponse call
+func ParseGetResourcesResponse(rsp *http.Response) (*GetResourcesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResourcesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Resources
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListVolumesResponse parses an HTTP response from a ListVolumesWithResponse call
+func ParseListVolumesResponse(rsp *http.Response) (*ListVolumesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListVolumesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Volume
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateVolumeResponse parses an HTTP response from a CreateVolumeWithResponse call
+func ParseCreateVolumeResponse(rsp *http.Response) (*CreateVolumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateVolumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Volume
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteVolumeResponse parses an HTTP response from a DeleteVolumeWithResponse call
+func ParseDeleteVolumeResponse(rsp *http.Response) (*DeleteVolumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteVolumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVolumeResponse parses an HTTP response from a GetVolumeWithResponse call
+func ParseGetVolumeResponse(rsp *http.Response) (*GetVolumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVolumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Volume
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Verify and decode a token
+	// (POST /admin/auth/verify)
+	VerifyToken(w http.ResponseWriter, r *http.Request)
+	// Get drain status
+	// (GET /admin/drain)
+	GetDrainStatus(w http.ResponseWriter, r *http.Request)
+	// Start or stop draining the host
+	// (POST /admin/drain)
+	SetDrain(w http.ResponseWriter, r *http.Request)
+	// List API keys
+	// (GET /api-keys)
+	ListApiKeys(w http.ResponseWriter, r *http.Request)
+	// Create an API key
+	// (POST /api-keys)
+	CreateApiKey(w http.ResponseWriter, r *http.Request)
+	// Revoke API key
+	// (DELETE /api-keys/{id})
+	RevokeApiKey(w http.ResponseWriter, r *http.Request, id string)
+	// Get API key details
+	// (GET /api-keys/{id})
+	GetApiKey(w http.ResponseWriter, r *http.Request, id string)
 	// List builds
 	// (GET /builds)
-	ListBuilds(w http.ResponseWriter, r *http.Request)
+	ListBuilds(w http.ResponseWriter, r *http.Request, params ListBuildsParams)
 	// Create a new build
 	// (POST /builds)
 	CreateBuild(w http.ResponseWriter, r *http.Request)
+	// Get build queue stats
+	// (GET /builds/queue)
+	GetBuildQueue(w http.ResponseWriter, r *http.Request)
 	// Cancel build
 	// (DELETE /builds/{id})
 	CancelBuild(w http.ResponseWriter, r *http.Request, id string)
 	// Get build details
 	// (GET /builds/{id})
 	GetBuild(w http.ResponseWriter, r *http.Request, id string)
+	// Download build artifact
+	// (GET /builds/{id}/artifact)
+	GetBuildArtifact(w http.ResponseWriter, r *http.Request, id string)
+	// Cancel build
+	// (POST /builds/{id}/cancel)
+	RequestBuildCancel(w http.ResponseWriter, r *http.Request, id string)
 	// Stream build events (SSE)
 	// (GET /builds/{id}/events)
 	GetBuildEvents(w http.ResponseWriter, r *http.Request, id string, params GetBuildEventsParams)
 	// List registered devices
 	// (GET /devices)
-	ListDevices(w http.ResponseWriter, r *http.Request)
+	ListDevices(w http.ResponseWriter, r *http.Request, params ListDevicesParams)
 	// Register a device for passthrough
 	// (POST /devices)
 	CreateDevice(w http.ResponseWriter, r *http.Request)
 	// Discover passthrough-capable devices on host
 	// (GET /devices/available)
 	ListAvailableDevices(w http.ResponseWriter, r *http.Request)
+	// List device pools
+	// (GET /devices/pools)
+	ListDevicePools(w http.ResponseWriter, r *http.Request)
 	// Unregister device
 	// (DELETE /devices/{id})
 	DeleteDevice(w http.ResponseWriter, r *http.Request, id string)
@@ -5773,24 +12249,48 @@ type ServerInterface interface {
 	// Health check
 	// (GET /health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Get host CPU topology
+	// (GET /host/topology)
+	GetHostTopology(w http.ResponseWriter, r *http.Request, params GetHostTopologyParams)
+	// List supported hypervisors
+	// (GET /hypervisors)
+	ListHypervisors(w http.ResponseWriter, r *http.Request)
 	// List images
 	// (GET /images)
 	ListImages(w http.ResponseWriter, r *http.Request)
 	// Pull and convert OCI image
 	// (POST /images)
 	CreateImage(w http.ResponseWriter, r *http.Request)
+	// Garbage collect unused images
+	// (POST /images/gc)
+	CollectImageGarbage(w http.ResponseWriter, r *http.Request, params CollectImageGarbageParams)
 	// Delete image
 	// (DELETE /images/{name})
 	DeleteImage(w http.ResponseWriter, r *http.Request, name string)
 	// Get image details
 	// (GET /images/{name})
 	GetImage(w http.ResponseWriter, r *http.Request, name string)
+	// Stream image pull progress (SSE)
+	// (GET /images/{name}/events)
+	GetImageEvents(w http.ResponseWriter, r *http.Request, name string)
 	// List ingresses
 	// (GET /ingresses)
 	ListIngresses(w http.ResponseWriter, r *http.Request)
 	// Create ingress
 	// (POST /ingresses)
 	CreateIngress(w http.ResponseWriter, r *http.Request)
+	// Clear the default backend
+	// (DELETE /ingresses/default-backend)
+	ClearIngressDefaultBackend(w http.ResponseWriter, r *http.Request)
+	// Get the default backend
+	// (GET /ingresses/default-backend)
+	GetIngressDefaultBackend(w http.ResponseWriter, r *http.Request)
+	// Set the default backend
+	// (PUT /ingresses/default-backend)
+	SetIngressDefaultBackend(w http.ResponseWriter, r *http.Request)
+	// Resolve an instance through the ingress DNS server
+	// (GET /ingresses/dns)
+	LookupIngressDNS(w http.ResponseWriter, r *http.Request, params LookupIngressDNSParams)
 	// Delete ingress
 	// (DELETE /ingresses/{id})
 	DeleteIngress(w http.ResponseWriter, r *http.Request, id string)
@@ -5799,19 +12299,61 @@ type ServerInterface interface {
 	GetIngress(w http.ResponseWriter, r *http.Request, id string)
 	// List instances
 	// (GET /instances)
-	ListInstances(w http.ResponseWriter, r *http.Request)
+	ListInstances(w http.ResponseWriter, r *http.Request, params ListInstancesParams)
 	// Create and start instance
 	// (POST /instances)
 	CreateInstance(w http.ResponseWriter, r *http.Request)
+	// Create and start a batch of instances
+	// (POST /instances/batch)
+	CreateInstances(w http.ResponseWriter, r *http.Request)
+	// Watch instance state changes (SSE)
+	// (GET /instances/events)
+	WatchInstances(w http.ResponseWriter, r *http.Request, params WatchInstancesParams)
+	// List instances preserved on delete
+	// (GET /instances/preserved)
+	ListPreservedSnapshots(w http.ResponseWriter, r *http.Request)
 	// Stop and delete instance
 	// (DELETE /instances/{id})
-	DeleteInstance(w http.ResponseWriter, r *http.Request, id string)
+	DeleteInstance(w http.ResponseWriter, r *http.Request, id string, params DeleteInstanceParams)
 	// Get instance details
 	// (GET /instances/{id})
 	GetInstance(w http.ResponseWriter, r *http.Request, id string)
+	// Get the outcome of the last boot
+	// (GET /instances/{id}/boot-status)
+	GetInstanceBootStatus(w http.ResponseWriter, r *http.Request, id string)
+	// Clone an instance
+	// (POST /instances/{id}/clone)
+	CloneInstance(w http.ResponseWriter, r *http.Request, id string)
+	// Reclaim unused space in the instance's overlay disk
+	// (POST /instances/{id}/compact)
+	CompactInstanceOverlay(w http.ResponseWriter, r *http.Request, id string)
+	// Hot-unplug a device from a running instance
+	// (DELETE /instances/{id}/devices/{deviceId})
+	DetachInstanceDevice(w http.ResponseWriter, r *http.Request, id string, deviceId string)
+	// Hotplug a device into a running instance
+	// (POST /instances/{id}/devices/{deviceId})
+	AttachInstanceDevice(w http.ResponseWriter, r *http.Request, id string, deviceId string)
+	// List exec sessions
+	// (GET /instances/{id}/exec-sessions)
+	ListExecSessions(w http.ResponseWriter, r *http.Request, id string)
+	// Download an exec session recording
+	// (GET /instances/{id}/exec-sessions/{sessionId}/recording)
+	GetExecSessionRecording(w http.ResponseWriter, r *http.Request, id string, sessionId string)
+	// Run a command and wait for its output
+	// (POST /instances/{id}/exec/run)
+	RunInstanceCommand(w http.ResponseWriter, r *http.Request, id string)
+	// Read a file from the guest
+	// (GET /instances/{id}/files)
+	GetInstanceFile(w http.ResponseWriter, r *http.Request, id string, params GetInstanceFileParams)
 	// Stream instance logs (SSE)
 	// (GET /instances/{id}/logs)
 	GetInstanceLogs(w http.ResponseWriter, r *http.Request, id string, params GetInstanceLogsParams)
+	// List guest processes and resource usage
+	// (GET /instances/{id}/processes)
+	ListInstanceProcesses(w http.ResponseWriter, r *http.Request, id string)
+	// Discard changes to the instance's overlay disk
+	// (POST /instances/{id}/reset-overlay)
+	ResetInstanceOverlay(w http.ResponseWriter, r *http.Request, id string)
 	// Restore instance from standby
 	// (POST /instances/{id}/restore)
 	RestoreInstance(w http.ResponseWriter, r *http.Request, id string)
@@ -5827,6 +12369,9 @@ type ServerInterface interface {
 	// Stop instance (graceful shutdown)
 	// (POST /instances/{id}/stop)
 	StopInstance(w http.ResponseWriter, r *http.Request, id string)
+	// Set the guest clock from the host
+	// (POST /instances/{id}/synctime)
+	SyncInstanceTime(w http.ResponseWriter, r *http.Request, id string)
 	// Detach volume from instance
 	// (DELETE /instances/{id}/volumes/{volumeId})
 	DetachVolume(w http.ResponseWriter, r *http.Request, id string, volumeId string)
@@ -5838,7 +12383,7 @@ type ServerInterface interface {
 	GetResources(w http.ResponseWriter, r *http.Request)
 	// List volumes
 	// (GET /volumes)
-	ListVolumes(w http.ResponseWriter, r *http.Request)
+	ListVolumes(w http.ResponseWriter, r *http.Request, params ListVolumesParams)
 	// Create volume
 	// (POST /volumes)
 	CreateVolume(w http.ResponseWriter, r *http.Request)
@@ -5850,1659 +12395,5157 @@ type ServerInterface interface {
 	GetVolume(w http.ResponseWriter, r *http.Request, id string)
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Verify and decode a token
+// (POST /admin/auth/verify)
+func (_ Unimplemented) VerifyToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get drain status
+// (GET /admin/drain)
+func (_ Unimplemented) GetDrainStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start or stop draining the host
+// (POST /admin/drain)
+func (_ Unimplemented) SetDrain(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List API keys
+// (GET /api-keys)
+func (_ Unimplemented) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an API key
+// (POST /api-keys)
+func (_ Unimplemented) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke API key
+// (DELETE /api-keys/{id})
+func (_ Unimplemented) RevokeApiKey(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get API key details
+// (GET /api-keys/{id})
+func (_ Unimplemented) GetApiKey(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List builds
+// (GET /builds)
+func (_ Unimplemented) ListBuilds(w http.ResponseWriter, r *http.Request, params ListBuildsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new build
+// (POST /builds)
+func (_ Unimplemented) CreateBuild(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get build queue stats
+// (GET /builds/queue)
+func (_ Unimplemented) GetBuildQueue(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel build
+// (DELETE /builds/{id})
+func (_ Unimplemented) CancelBuild(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get build details
+// (GET /builds/{id})
+func (_ Unimplemented) GetBuild(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download build artifact
+// (GET /builds/{id}/artifact)
+func (_ Unimplemented) GetBuildArtifact(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel build
+// (POST /builds/{id}/cancel)
+func (_ Unimplemented) RequestBuildCancel(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream build events (SSE)
+// (GET /builds/{id}/events)
+func (_ Unimplemented) GetBuildEvents(w http.ResponseWriter, r *http.Request, id string, params GetBuildEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List registered devices
+// (GET /devices)
+func (_ Unimplemented) ListDevices(w http.ResponseWriter, r *http.Request, params ListDevicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a device for passthrough
+// (POST /devices)
+func (_ Unimplemented) CreateDevice(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Discover passthrough-capable devices on host
+// (GET /devices/available)
+func (_ Unimplemented) ListAvailableDevices(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List device pools
+// (GET /devices/pools)
+func (_ Unimplemented) ListDevicePools(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unregister device
+// (DELETE /devices/{id})
+func (_ Unimplemented) DeleteDevice(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get device details
+// (GET /devices/{id})
+func (_ Unimplemented) GetDevice(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Health check
+// (GET /health)
+func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get host CPU topology
+// (GET /host/topology)
+func (_ Unimplemented) GetHostTopology(w http.ResponseWriter, r *http.Request, params GetHostTopologyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List supported hypervisors
+// (GET /hypervisors)
+func (_ Unimplemented) ListHypervisors(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List images
+// (GET /images)
+func (_ Unimplemented) ListImages(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Pull and convert OCI image
+// (POST /images)
+func (_ Unimplemented) CreateImage(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Garbage collect unused images
+// (POST /images/gc)
+func (_ Unimplemented) CollectImageGarbage(w http.ResponseWriter, r *http.Request, params CollectImageGarbageParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete image
+// (DELETE /images/{name})
+func (_ Unimplemented) DeleteImage(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get image details
+// (GET /images/{name})
+func (_ Unimplemented) GetImage(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream image pull progress (SSE)
+// (GET /images/{name}/events)
+func (_ Unimplemented) GetImageEvents(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List ingresses
+// (GET /ingresses)
+func (_ Unimplemented) ListIngresses(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create ingress
+// (POST /ingresses)
+func (_ Unimplemented) CreateIngress(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clear the default backend
+// (DELETE /ingresses/default-backend)
+func (_ Unimplemented) ClearIngressDefaultBackend(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the default backend
+// (GET /ingresses/default-backend)
+func (_ Unimplemented) GetIngressDefaultBackend(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the default backend
+// (PUT /ingresses/default-backend)
+func (_ Unimplemented) SetIngressDefaultBackend(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resolve an instance through the ingress DNS server
+// (GET /ingresses/dns)
+func (_ Unimplemented) LookupIngressDNS(w http.ResponseWriter, r *http.Request, params LookupIngressDNSParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete ingress
+// (DELETE /ingresses/{id})
+func (_ Unimplemented) DeleteIngress(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get ingress details
+// (GET /ingresses/{id})
+func (_ Unimplemented) GetIngress(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List instances
+// (GET /instances)
+func (_ Unimplemented) ListInstances(w http.ResponseWriter, r *http.Request, params ListInstancesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create and start instance
+// (POST /instances)
+func (_ Unimplemented) CreateInstance(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create and start a batch of instances
+// (POST /instances/batch)
+func (_ Unimplemented) CreateInstances(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Watch instance state changes (SSE)
+// (GET /instances/events)
+func (_ Unimplemented) WatchInstances(w http.ResponseWriter, r *http.Request, params WatchInstancesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List instances preserved on delete
+// (GET /instances/preserved)
+func (_ Unimplemented) ListPreservedSnapshots(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop and delete instance
+// (DELETE /instances/{id})
+func (_ Unimplemented) DeleteInstance(w http.ResponseWriter, r *http.Request, id string, params DeleteInstanceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get instance details
+// (GET /instances/{id})
+func (_ Unimplemented) GetInstance(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the outcome of the last boot
+// (GET /instances/{id}/boot-status)
+func (_ Unimplemented) GetInstanceBootStatus(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clone an instance
+// (POST /instances/{id}/clone)
+func (_ Unimplemented) CloneInstance(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reclaim unused space in the instance's overlay disk
+// (POST /instances/{id}/compact)
+func (_ Unimplemented) CompactInstanceOverlay(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Hot-unplug a device from a running instance
+// (DELETE /instances/{id}/devices/{deviceId})
+func (_ Unimplemented) DetachInstanceDevice(w http.ResponseWriter, r *http.Request, id string, deviceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Hotplug a device into a running instance
+// (POST /instances/{id}/devices/{deviceId})
+func (_ Unimplemented) AttachInstanceDevice(w http.ResponseWriter, r *http.Request, id string, deviceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List exec sessions
+// (GET /instances/{id}/exec-sessions)
+func (_ Unimplemented) ListExecSessions(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download an exec session recording
+// (GET /instances/{id}/exec-sessions/{sessionId}/recording)
+func (_ Unimplemented) GetExecSessionRecording(w http.ResponseWriter, r *http.Request, id string, sessionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Run a command and wait for its output
+// (POST /instances/{id}/exec/run)
+func (_ Unimplemented) RunInstanceCommand(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read a file from the guest
+// (GET /instances/{id}/files)
+func (_ Unimplemented) GetInstanceFile(w http.ResponseWriter, r *http.Request, id string, params GetInstanceFileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream instance logs (SSE)
+// (GET /instances/{id}/logs)
+func (_ Unimplemented) GetInstanceLogs(w http.ResponseWriter, r *http.Request, id string, params GetInstanceLogsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List guest processes and resource usage
+// (GET /instances/{id}/processes)
+func (_ Unimplemented) ListInstanceProcesses(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Discard changes to the instance's overlay disk
+// (POST /instances/{id}/reset-overlay)
+func (_ Unimplemented) ResetInstanceOverlay(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore instance from standby
+// (POST /instances/{id}/restore)
+func (_ Unimplemented) RestoreInstance(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Put instance in standby (pause, snapshot, delete VMM)
+// (POST /instances/{id}/standby)
+func (_ Unimplemented) StandbyInstance(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a stopped instance
+// (POST /instances/{id}/start)
+func (_ Unimplemented) StartInstance(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get filesystem path info
+// (GET /instances/{id}/stat)
+func (_ Unimplemented) StatInstancePath(w http.ResponseWriter, r *http.Request, id string, params StatInstancePathParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop instance (graceful shutdown)
+// (POST /instances/{id}/stop)
+func (_ Unimplemented) StopInstance(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the guest clock from the host
+// (POST /instances/{id}/synctime)
+func (_ Unimplemented) SyncInstanceTime(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Detach volume from instance
+// (DELETE /instances/{id}/volumes/{volumeId})
+func (_ Unimplemented) DetachVolume(w http.ResponseWriter, r *http.Request, id string, volumeId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Attach volume to instance
+// (POST /instances/{id}/volumes/{volumeId})
+func (_ Unimplemented) AttachVolume(w http.ResponseWriter, r *http.Request, id string, volumeId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get host resource capacity and allocations
+// (GET /resources)
+func (_ Unimplemented) GetResources(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List volumes
+// (GET /volumes)
+func (_ Unimplemented) ListVolumes(w http.ResponseWriter, r *http.Request, params ListVolumesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create volume
+// (POST /volumes)
+func (_ Unimplemented) CreateVolume(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete volume
+// (DELETE /volumes/{id})
+func (_ Unimplemented) DeleteVolume(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get volume details
+// (GET /volumes/{id})
+func (_ Unimplemented) GetVolume(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// VerifyToken operation middleware
+func (siw *ServerInterfaceWrapper) VerifyToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDrainStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetDrainStatus(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDrainStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetDrain operation middleware
+func (siw *ServerInterfaceWrapper) SetDrain(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetDrain(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApiKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateApiKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApiKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeApiKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeApiKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeApiKey(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiKey operation middleware
+func (siw *ServerInterfaceWrapper) GetApiKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiKey(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListBuilds operation middleware
+func (siw *ServerInterfaceWrapper) ListBuilds(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBuildsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBuilds(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateBuild operation middleware
+func (siw *ServerInterfaceWrapper) CreateBuild(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBuild(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBuildQueue operation middleware
+func (siw *ServerInterfaceWrapper) GetBuildQueue(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBuildQueue(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelBuild operation middleware
+func (siw *ServerInterfaceWrapper) CancelBuild(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelBuild(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBuild operation middleware
+func (siw *ServerInterfaceWrapper) GetBuild(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBuild(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBuildArtifact operation middleware
+func (siw *ServerInterfaceWrapper) GetBuildArtifact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBuildArtifact(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequestBuildCancel operation middleware
+func (siw *ServerInterfaceWrapper) RequestBuildCancel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestBuildCancel(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBuildEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetBuildEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBuildEventsParams
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBuildEvents(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDevices operation middleware
+func (siw *ServerInterfaceWrapper) ListDevices(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDevicesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDevices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDevice operation middleware
+func (siw *ServerInterfaceWrapper) CreateDevice(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDevice(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAvailableDevices operation middleware
+func (siw *ServerInterfaceWrapper) ListAvailableDevices(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAvailableDevices(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDevicePools operation middleware
+func (siw *ServerInterfaceWrapper) ListDevicePools(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDevicePools(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDevice operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDevice(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDevice(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDevice operation middleware
+func (siw *ServerInterfaceWrapper) GetDevice(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDevice(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHostTopology operation middleware
+func (siw *ServerInterfaceWrapper) GetHostTopology(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHostTopologyParams
+
+	// ------------- Optional query parameter "vcpus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vcpus", r.URL.Query(), &params.Vcpus)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vcpus", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHostTopology(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHypervisors operation middleware
+func (siw *ServerInterfaceWrapper) ListHypervisors(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHypervisors(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListImages operation middleware
+func (siw *ServerInterfaceWrapper) ListImages(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListImages(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateImage operation middleware
+func (siw *ServerInterfaceWrapper) CreateImage(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateImage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CollectImageGarbage operation middleware
+func (siw *ServerInterfaceWrapper) CollectImageGarbage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CollectImageGarbageParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CollectImageGarbage(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteImage operation middleware
+func (siw *ServerInterfaceWrapper) DeleteImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteImage(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetImage operation middleware
+func (siw *ServerInterfaceWrapper) GetImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetImage(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetImageEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetImageEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetImageEvents(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListIngresses operation middleware
+func (siw *ServerInterfaceWrapper) ListIngresses(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListIngresses(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateIngress operation middleware
+func (siw *ServerInterfaceWrapper) CreateIngress(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateIngress(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ClearIngressDefaultBackend operation middleware
+func (siw *ServerInterfaceWrapper) ClearIngressDefaultBackend(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClearIngressDefaultBackend(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetIngressDefaultBackend operation middleware
+func (siw *ServerInterfaceWrapper) GetIngressDefaultBackend(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIngressDefaultBackend(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetIngressDefaultBackend operation middleware
+func (siw *ServerInterfaceWrapper) SetIngressDefaultBackend(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetIngressDefaultBackend(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LookupIngressDNS operation middleware
+func (siw *ServerInterfaceWrapper) LookupIngressDNS(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupIngressDNSParams
+
+	// ------------- Required query parameter "instance" -------------
+
+	if paramValue := r.URL.Query().Get("instance"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "instance"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "instance", r.URL.Query(), &params.Instance)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "instance", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LookupIngressDNS(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteIngress operation middleware
+func (siw *ServerInterfaceWrapper) DeleteIngress(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteIngress(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetIngress operation middleware
+func (siw *ServerInterfaceWrapper) GetIngress(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIngress(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListInstances operation middleware
+func (siw *ServerInterfaceWrapper) ListInstances(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListInstancesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInstances(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateInstance operation middleware
+func (siw *ServerInterfaceWrapper) CreateInstance(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateInstance(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateInstances operation middleware
+func (siw *ServerInterfaceWrapper) CreateInstances(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateInstances(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WatchInstances operation middleware
+func (siw *ServerInterfaceWrapper) WatchInstances(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchInstancesParams
+
+	// ------------- Optional query parameter "instance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "instance", r.URL.Query(), &params.Instance)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "instance", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WatchInstances(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPreservedSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) ListPreservedSnapshots(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPreservedSnapshots(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteInstance operation middleware
+func (siw *ServerInterfaceWrapper) DeleteInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteInstanceParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "snapshot" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "snapshot", r.URL.Query(), &params.Snapshot)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshot", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cascade" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cascade", r.URL.Query(), &params.Cascade)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cascade", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cascade_volumes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cascade_volumes", r.URL.Query(), &params.CascadeVolumes)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cascade_volumes", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteInstance(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInstance operation middleware
+func (siw *ServerInterfaceWrapper) GetInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInstance(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInstanceBootStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetInstanceBootStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInstanceBootStatus(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloneInstance operation middleware
+func (siw *ServerInterfaceWrapper) CloneInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloneInstance(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CompactInstanceOverlay operation middleware
+func (siw *ServerInterfaceWrapper) CompactInstanceOverlay(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompactInstanceOverlay(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DetachInstanceDevice operation middleware
+func (siw *ServerInterfaceWrapper) DetachInstanceDevice(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "deviceId" -------------
+	var deviceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deviceId", chi.URLParam(r, "deviceId"), &deviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DetachInstanceDevice(w, r, id, deviceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AttachInstanceDevice operation middleware
+func (siw *ServerInterfaceWrapper) AttachInstanceDevice(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "deviceId" -------------
+	var deviceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deviceId", chi.URLParam(r, "deviceId"), &deviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AttachInstanceDevice(w, r, id, deviceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListExecSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListExecSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListExecSessions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetExecSessionRecording operation middleware
+func (siw *ServerInterfaceWrapper) GetExecSessionRecording(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", chi.URLParam(r, "sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExecSessionRecording(w, r, id, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RunInstanceCommand operation middleware
+func (siw *ServerInterfaceWrapper) RunInstanceCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunInstanceCommand(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInstanceFile operation middleware
+func (siw *ServerInterfaceWrapper) GetInstanceFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInstanceFileParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stat_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stat_only", r.URL.Query(), &params.StatOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stat_only", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInstanceFile(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInstanceLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetInstanceLogs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInstanceLogsParams
+
+	// ------------- Optional query parameter "tail" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tail", r.URL.Query(), &params.Tail)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tail", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInstanceLogs(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListInstanceProcesses operation middleware
+func (siw *ServerInterfaceWrapper) ListInstanceProcesses(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInstanceProcesses(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResetInstanceOverlay operation middleware
+func (siw *ServerInterfaceWrapper) ResetInstanceOverlay(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetInstanceOverlay(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreInstance operation middleware
+func (siw *ServerInterfaceWrapper) RestoreInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreInstance(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StandbyInstance operation middleware
+func (siw *ServerInterfaceWrapper) StandbyInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StandbyInstance(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartInstance operation middleware
+func (siw *ServerInterfaceWrapper) StartInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartInstance(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StatInstancePath operation middleware
+func (siw *ServerInterfaceWrapper) StatInstancePath(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StatInstancePathParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "follow_links" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow_links", r.URL.Query(), &params.FollowLinks)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow_links", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StatInstancePath(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StopInstance operation middleware
+func (siw *ServerInterfaceWrapper) StopInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopInstance(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SyncInstanceTime operation middleware
+func (siw *ServerInterfaceWrapper) SyncInstanceTime(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SyncInstanceTime(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DetachVolume operation middleware
+func (siw *ServerInterfaceWrapper) DetachVolume(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "volumeId" -------------
+	var volumeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeId", chi.URLParam(r, "volumeId"), &volumeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "volumeId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DetachVolume(w, r, id, volumeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AttachVolume operation middleware
+func (siw *ServerInterfaceWrapper) AttachVolume(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "volumeId" -------------
+	var volumeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeId", chi.URLParam(r, "volumeId"), &volumeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "volumeId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AttachVolume(w, r, id, volumeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetResources operation middleware
+func (siw *ServerInterfaceWrapper) GetResources(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetResources(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListVolumes operation middleware
+func (siw *ServerInterfaceWrapper) ListVolumes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVolumesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVolumes(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateVolume operation middleware
+func (siw *ServerInterfaceWrapper) CreateVolume(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateVolume(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteVolume operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVolume(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVolume(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVolume operation middleware
+func (siw *ServerInterfaceWrapper) GetVolume(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVolume(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/auth/verify", wrapper.VerifyToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/drain", wrapper.GetDrainStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/drain", wrapper.SetDrain)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api-keys", wrapper.ListApiKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api-keys", wrapper.CreateApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api-keys/{id}", wrapper.RevokeApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api-keys/{id}", wrapper.GetApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/builds", wrapper.ListBuilds)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/builds", wrapper.CreateBuild)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/builds/queue", wrapper.GetBuildQueue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/builds/{id}", wrapper.CancelBuild)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/builds/{id}", wrapper.GetBuild)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/builds/{id}/artifact", wrapper.GetBuildArtifact)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/builds/{id}/cancel", wrapper.RequestBuildCancel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/builds/{id}/events", wrapper.GetBuildEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/devices", wrapper.ListDevices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/devices", wrapper.CreateDevice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/devices/available", wrapper.ListAvailableDevices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/devices/pools", wrapper.ListDevicePools)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/devices/{id}", wrapper.DeleteDevice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/devices/{id}", wrapper.GetDevice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/host/topology", wrapper.GetHostTopology)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/hypervisors", wrapper.ListHypervisors)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/images", wrapper.ListImages)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/images", wrapper.CreateImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/images/gc", wrapper.CollectImageGarbage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/images/{name}", wrapper.DeleteImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/images/{name}", wrapper.GetImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/images/{name}/events", wrapper.GetImageEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ingresses", wrapper.ListIngresses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/ingresses", wrapper.CreateIngress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/ingresses/default-backend", wrapper.ClearIngressDefaultBackend)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ingresses/default-backend", wrapper.GetIngressDefaultBackend)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/ingresses/default-backend", wrapper.SetIngressDefaultBackend)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ingresses/dns", wrapper.LookupIngressDNS)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/ingresses/{id}", wrapper.DeleteIngress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ingresses/{id}", wrapper.GetIngress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/instances", wrapper.ListInstances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances", wrapper.CreateInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/batch", wrapper.CreateInstances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/instances/events", wrapper.WatchInstances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/instances/preserved", wrapper.ListPreservedSnapshots)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/instances/{id}", wrapper.DeleteInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/instances/{id}", wrapper.GetInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/instances/{id}/boot-status", wrapper.GetInstanceBootStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/{id}/clone", wrapper.CloneInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/{id}/compact", wrapper.CompactInstanceOverlay)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/instances/{id}/devices/{deviceId}", wrapper.DetachInstanceDevice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/{id}/devices/{deviceId}", wrapper.AttachInstanceDevice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/instances/{id}/exec-sessions", wrapper.ListExecSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/instances/{id}/exec-sessions/{sessionId}/recording", wrapper.GetExecSessionRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/{id}/exec/run", wrapper.RunInstanceCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/instances/{id}/files", wrapper.GetInstanceFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/instances/{id}/logs", wrapper.GetInstanceLogs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/instances/{id}/processes", wrapper.ListInstanceProcesses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/{id}/reset-overlay", wrapper.ResetInstanceOverlay)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/{id}/restore", wrapper.RestoreInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/{id}/standby", wrapper.StandbyInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/{id}/start", wrapper.StartInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/instances/{id}/stat", wrapper.StatInstancePath)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/{id}/stop", wrapper.StopInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/{id}/synctime", wrapper.SyncInstanceTime)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/instances/{id}/volumes/{volumeId}", wrapper.DetachVolume)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/instances/{id}/volumes/{volumeId}", wrapper.AttachVolume)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/resources", wrapper.GetResources)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/volumes", wrapper.ListVolumes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/volumes", wrapper.CreateVolume)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/volumes/{id}", wrapper.DeleteVolume)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/volumes/{id}", wrapper.GetVolume)
+	})
+
+	return r
+}
+
+type VerifyTokenRequestObject struct {
+	Body *VerifyTokenJSONRequestBody
+}
+
+type VerifyTokenResponseObject interface {
+	VisitVerifyTokenResponse(w http.ResponseWriter) error
+}
+
+type VerifyToken200JSONResponse TokenVerification
+
+func (response VerifyToken200JSONResponse) VisitVerifyTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyToken400JSONResponse Error
+
+func (response VerifyToken400JSONResponse) VisitVerifyTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDrainStatusRequestObject struct {
+}
+
+type GetDrainStatusResponseObject interface {
+	VisitGetDrainStatusResponse(w http.ResponseWriter) error
+}
+
+type GetDrainStatus200JSONResponse DrainStatus
+
+func (response GetDrainStatus200JSONResponse) VisitGetDrainStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDrainStatus500JSONResponse Error
+
+func (response GetDrainStatus500JSONResponse) VisitGetDrainStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDrainRequestObject struct {
+	Body *SetDrainJSONRequestBody
+}
+
+type SetDrainResponseObject interface {
+	VisitSetDrainResponse(w http.ResponseWriter) error
+}
+
+type SetDrain200JSONResponse DrainStatus
+
+func (response SetDrain200JSONResponse) VisitSetDrainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDrain400JSONResponse Error
+
+func (response SetDrain400JSONResponse) VisitSetDrainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDrain500JSONResponse Error
+
+func (response SetDrain500JSONResponse) VisitSetDrainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeysRequestObject struct {
+}
+
+type ListApiKeysResponseObject interface {
+	VisitListApiKeysResponse(w http.ResponseWriter) error
+}
+
+type ListApiKeys200JSONResponse []ApiKey
+
+func (response ListApiKeys200JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeys401JSONResponse Error
+
+func (response ListApiKeys401JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeys500JSONResponse Error
+
+func (response ListApiKeys500JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKeyRequestObject struct {
+	Body *CreateApiKeyJSONRequestBody
+}
+
+type CreateApiKeyResponseObject interface {
+	VisitCreateApiKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateApiKey201JSONResponse CreatedApiKey
+
+func (response CreateApiKey201JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey400JSONResponse Error
+
+func (response CreateApiKey400JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey401JSONResponse Error
+
+func (response CreateApiKey401JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey403JSONResponse Error
+
+func (response CreateApiKey403JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey500JSONResponse Error
+
+func (response CreateApiKey500JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKeyRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RevokeApiKeyResponseObject interface {
+	VisitRevokeApiKeyResponse(w http.ResponseWriter) error
+}
+
+type RevokeApiKey204Response struct {
+}
+
+func (response RevokeApiKey204Response) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeApiKey404JSONResponse Error
+
+func (response RevokeApiKey404JSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKey500JSONResponse Error
+
+func (response RevokeApiKey500JSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiKeyRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetApiKeyResponseObject interface {
+	VisitGetApiKeyResponse(w http.ResponseWriter) error
+}
+
+type GetApiKey200JSONResponse ApiKey
+
+func (response GetApiKey200JSONResponse) VisitGetApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiKey404JSONResponse Error
+
+func (response GetApiKey404JSONResponse) VisitGetApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiKey500JSONResponse Error
+
+func (response GetApiKey500JSONResponse) VisitGetApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBuildsRequestObject struct {
+	Params ListBuildsParams
+}
+
+type ListBuildsResponseObject interface {
+	VisitListBuildsResponse(w http.ResponseWriter) error
+}
+
+type ListBuilds200ResponseHeaders struct {
+	XNextCursor string
+	XTotalCount int
+}
+
+type ListBuilds200JSONResponse struct {
+	Body    []Build
+	Headers ListBuilds200ResponseHeaders
+}
+
+func (response ListBuilds200JSONResponse) VisitListBuildsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListBuilds400JSONResponse Error
+
+func (response ListBuilds400JSONResponse) VisitListBuildsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBuilds401JSONResponse Error
+
+func (response ListBuilds401JSONResponse) VisitListBuildsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBuilds500JSONResponse Error
+
+func (response ListBuilds500JSONResponse) VisitListBuildsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBuildRequestObject struct {
+	Body *multipart.Reader
+}
+
+type CreateBuildResponseObject interface {
+	VisitCreateBuildResponse(w http.ResponseWriter) error
+}
+
+type CreateBuild202JSONResponse Build
+
+func (response CreateBuild202JSONResponse) VisitCreateBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBuild400JSONResponse Error
+
+func (response CreateBuild400JSONResponse) VisitCreateBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBuild401JSONResponse Error
+
+func (response CreateBuild401JSONResponse) VisitCreateBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBuild413JSONResponse Error
+
+func (response CreateBuild413JSONResponse) VisitCreateBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBuild415JSONResponse Error
+
+func (response CreateBuild415JSONResponse) VisitCreateBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBuild500JSONResponse Error
+
+func (response CreateBuild500JSONResponse) VisitCreateBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBuild503JSONResponse Error
+
+func (response CreateBuild503JSONResponse) VisitCreateBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBuildQueueRequestObject struct {
+}
+
+type GetBuildQueueResponseObject interface {
+	VisitGetBuildQueueResponse(w http.ResponseWriter) error
+}
+
+type GetBuildQueue200JSONResponse BuildQueueStats
+
+func (response GetBuildQueue200JSONResponse) VisitGetBuildQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBuildQueue401JSONResponse Error
+
+func (response GetBuildQueue401JSONResponse) VisitGetBuildQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelBuildRequestObject struct {
+	Id string `json:"id"`
+}
+
+type CancelBuildResponseObject interface {
+	VisitCancelBuildResponse(w http.ResponseWriter) error
+}
+
+type CancelBuild204Response struct {
+}
+
+func (response CancelBuild204Response) VisitCancelBuildResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelBuild404JSONResponse Error
+
+func (response CancelBuild404JSONResponse) VisitCancelBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelBuild409JSONResponse Error
+
+func (response CancelBuild409JSONResponse) VisitCancelBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelBuild500JSONResponse Error
+
+func (response CancelBuild500JSONResponse) VisitCancelBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBuildRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetBuildResponseObject interface {
+	VisitGetBuildResponse(w http.ResponseWriter) error
+}
+
+type GetBuild200JSONResponse Build
+
+func (response GetBuild200JSONResponse) VisitGetBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBuild404JSONResponse Error
+
+func (response GetBuild404JSONResponse) VisitGetBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBuild500JSONResponse Error
+
+func (response GetBuild500JSONResponse) VisitGetBuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBuildArtifactRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetBuildArtifactResponseObject interface {
+	VisitGetBuildArtifactResponse(w http.ResponseWriter) error
+}
+
+type GetBuildArtifact200ApplicationxTarResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetBuildArtifact200ApplicationxTarResponse) VisitGetBuildArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-tar")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetBuildArtifact404JSONResponse Error
+
+func (response GetBuildArtifact404JSONResponse) VisitGetBuildArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBuildArtifact409JSONResponse Error
+
+func (response GetBuildArtifact409JSONResponse) VisitGetBuildArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBuildArtifact500JSONResponse Error
+
+func (response GetBuildArtifact500JSONResponse) VisitGetBuildArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestBuildCancelRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RequestBuildCancelResponseObject interface {
+	VisitRequestBuildCancelResponse(w http.ResponseWriter) error
+}
+
+type RequestBuildCancel200JSONResponse Build
+
+func (response RequestBuildCancel200JSONResponse) VisitRequestBuildCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestBuildCancel404JSONResponse Error
+
+func (response RequestBuildCancel404JSONResponse) VisitRequestBuildCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestBuildCancel409JSONResponse Error
+
+func (response RequestBuildCancel409JSONResponse) VisitRequestBuildCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestBuildCancel500JSONResponse Error
+
+func (response RequestBuildCancel500JSONResponse) VisitRequestBuildCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBuildEventsRequestObject struct {
+	Id     string `json:"id"`
+	Params GetBuildEventsParams
+}
+
+type GetBuildEventsResponseObject interface {
+	VisitGetBuildEventsResponse(w http.ResponseWriter) error
+}
+
+type GetBuildEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetBuildEvents200TexteventStreamResponse) VisitGetBuildEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetBuildEvents404JSONResponse Error
 
-type Unimplemented struct{}
+func (response GetBuildEvents404JSONResponse) VisitGetBuildEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-// List builds
-// (GET /builds)
-func (_ Unimplemented) ListBuilds(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Create a new build
-// (POST /builds)
-func (_ Unimplemented) CreateBuild(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+type GetBuildEvents500JSONResponse Error
 
-// Cancel build
-// (DELETE /builds/{id})
-func (_ Unimplemented) CancelBuild(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+func (response GetBuildEvents500JSONResponse) VisitGetBuildEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-// Get build details
-// (GET /builds/{id})
-func (_ Unimplemented) GetBuild(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Stream build events (SSE)
-// (GET /builds/{id}/events)
-func (_ Unimplemented) GetBuildEvents(w http.ResponseWriter, r *http.Request, id string, params GetBuildEventsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListDevicesRequestObject struct {
+	Params ListDevicesParams
 }
 
-// List registered devices
-// (GET /devices)
-func (_ Unimplemented) ListDevices(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListDevicesResponseObject interface {
+	VisitListDevicesResponse(w http.ResponseWriter) error
 }
 
-// Register a device for passthrough
-// (POST /devices)
-func (_ Unimplemented) CreateDevice(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListDevices200ResponseHeaders struct {
+	XNextCursor string
+	XTotalCount int
 }
 
-// Discover passthrough-capable devices on host
-// (GET /devices/available)
-func (_ Unimplemented) ListAvailableDevices(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListDevices200JSONResponse struct {
+	Body    []Device
+	Headers ListDevices200ResponseHeaders
 }
 
-// Unregister device
-// (DELETE /devices/{id})
-func (_ Unimplemented) DeleteDevice(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+func (response ListDevices200JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
 
-// Get device details
-// (GET /devices/{id})
-func (_ Unimplemented) GetDevice(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// Health check
-// (GET /health)
-func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+type ListDevices400JSONResponse Error
 
-// List images
-// (GET /images)
-func (_ Unimplemented) ListImages(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (response ListDevices400JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Pull and convert OCI image
-// (POST /images)
-func (_ Unimplemented) CreateImage(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListDevices401JSONResponse Error
+
+func (response ListDevices401JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Delete image
-// (DELETE /images/{name})
-func (_ Unimplemented) DeleteImage(w http.ResponseWriter, r *http.Request, name string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListDevices500JSONResponse Error
+
+func (response ListDevices500JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Get image details
-// (GET /images/{name})
-func (_ Unimplemented) GetImage(w http.ResponseWriter, r *http.Request, name string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type CreateDeviceRequestObject struct {
+	Body *CreateDeviceJSONRequestBody
 }
 
-// List ingresses
-// (GET /ingresses)
-func (_ Unimplemented) ListIngresses(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type CreateDeviceResponseObject interface {
+	VisitCreateDeviceResponse(w http.ResponseWriter) error
 }
 
-// Create ingress
-// (POST /ingresses)
-func (_ Unimplemented) CreateIngress(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type CreateDevice201JSONResponse Device
+
+func (response CreateDevice201JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Delete ingress
-// (DELETE /ingresses/{id})
-func (_ Unimplemented) DeleteIngress(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type CreateDevice400JSONResponse Error
+
+func (response CreateDevice400JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Get ingress details
-// (GET /ingresses/{id})
-func (_ Unimplemented) GetIngress(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type CreateDevice401JSONResponse Error
+
+func (response CreateDevice401JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// List instances
-// (GET /instances)
-func (_ Unimplemented) ListInstances(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type CreateDevice404JSONResponse Error
+
+func (response CreateDevice404JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Create and start instance
-// (POST /instances)
-func (_ Unimplemented) CreateInstance(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type CreateDevice409JSONResponse Error
+
+func (response CreateDevice409JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Stop and delete instance
-// (DELETE /instances/{id})
-func (_ Unimplemented) DeleteInstance(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type CreateDevice500JSONResponse Error
+
+func (response CreateDevice500JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Get instance details
-// (GET /instances/{id})
-func (_ Unimplemented) GetInstance(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListAvailableDevicesRequestObject struct {
 }
 
-// Stream instance logs (SSE)
-// (GET /instances/{id}/logs)
-func (_ Unimplemented) GetInstanceLogs(w http.ResponseWriter, r *http.Request, id string, params GetInstanceLogsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListAvailableDevicesResponseObject interface {
+	VisitListAvailableDevicesResponse(w http.ResponseWriter) error
 }
 
-// Restore instance from standby
-// (POST /instances/{id}/restore)
-func (_ Unimplemented) RestoreInstance(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListAvailableDevices200JSONResponse []AvailableDevice
+
+func (response ListAvailableDevices200JSONResponse) VisitListAvailableDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Put instance in standby (pause, snapshot, delete VMM)
-// (POST /instances/{id}/standby)
-func (_ Unimplemented) StandbyInstance(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListAvailableDevices401JSONResponse Error
+
+func (response ListAvailableDevices401JSONResponse) VisitListAvailableDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Start a stopped instance
-// (POST /instances/{id}/start)
-func (_ Unimplemented) StartInstance(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListAvailableDevices500JSONResponse Error
+
+func (response ListAvailableDevices500JSONResponse) VisitListAvailableDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Get filesystem path info
-// (GET /instances/{id}/stat)
-func (_ Unimplemented) StatInstancePath(w http.ResponseWriter, r *http.Request, id string, params StatInstancePathParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListDevicePoolsRequestObject struct {
 }
 
-// Stop instance (graceful shutdown)
-// (POST /instances/{id}/stop)
-func (_ Unimplemented) StopInstance(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListDevicePoolsResponseObject interface {
+	VisitListDevicePoolsResponse(w http.ResponseWriter) error
 }
 
-// Detach volume from instance
-// (DELETE /instances/{id}/volumes/{volumeId})
-func (_ Unimplemented) DetachVolume(w http.ResponseWriter, r *http.Request, id string, volumeId string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListDevicePools200JSONResponse []DevicePool
+
+func (response ListDevicePools200JSONResponse) VisitListDevicePoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Attach volume to instance
-// (POST /instances/{id}/volumes/{volumeId})
-func (_ Unimplemented) AttachVolume(w http.ResponseWriter, r *http.Request, id string, volumeId string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListDevicePools401JSONResponse Error
+
+func (response ListDevicePools401JSONResponse) VisitListDevicePoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Get host resource capacity and allocations
-// (GET /resources)
-func (_ Unimplemented) GetResources(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type ListDevicePools500JSONResponse Error
+
+func (response ListDevicePools500JSONResponse) VisitListDevicePoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// List volumes
-// (GET /volumes)
-func (_ Unimplemented) ListVolumes(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type DeleteDeviceRequestObject struct {
+	Id string `json:"id"`
 }
 
-// Create volume
-// (POST /volumes)
-func (_ Unimplemented) CreateVolume(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type DeleteDeviceResponseObject interface {
+	VisitDeleteDeviceResponse(w http.ResponseWriter) error
 }
 
-// Delete volume
-// (DELETE /volumes/{id})
-func (_ Unimplemented) DeleteVolume(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+type DeleteDevice204Response struct {
 }
 
-// Get volume details
-// (GET /volumes/{id})
-func (_ Unimplemented) GetVolume(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (response DeleteDevice204Response) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+type DeleteDevice404JSONResponse Error
 
-type MiddlewareFunc func(http.Handler) http.Handler
+func (response DeleteDevice404JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-// ListBuilds operation middleware
-func (siw *ServerInterfaceWrapper) ListBuilds(w http.ResponseWriter, r *http.Request) {
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx := r.Context()
+type DeleteDevice409JSONResponse Error
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response DeleteDevice409JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListBuilds(w, r)
-	}))
+type DeleteDevice500JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response DeleteDevice500JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// CreateBuild operation middleware
-func (siw *ServerInterfaceWrapper) CreateBuild(w http.ResponseWriter, r *http.Request) {
+type GetDeviceRequestObject struct {
+	Id string `json:"id"`
+}
 
-	ctx := r.Context()
+type GetDeviceResponseObject interface {
+	VisitGetDeviceResponse(w http.ResponseWriter) error
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type GetDevice200JSONResponse Device
 
-	r = r.WithContext(ctx)
+func (response GetDevice200JSONResponse) VisitGetDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateBuild(w, r)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetDevice404JSONResponse Error
 
-	handler.ServeHTTP(w, r)
-}
+func (response GetDevice404JSONResponse) VisitGetDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-// CancelBuild operation middleware
-func (siw *ServerInterfaceWrapper) CancelBuild(w http.ResponseWriter, r *http.Request) {
+	return json.NewEncoder(w).Encode(response)
+}
 
-	var err error
+type GetDevice500JSONResponse Error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+func (response GetDevice500JSONResponse) VisitGetDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx := r.Context()
+type GetHealthRequestObject struct {
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
 
-	r = r.WithContext(ctx)
+type GetHealth200JSONResponse Health
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CancelBuild(w, r, id)
-	}))
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type GetHostTopologyRequestObject struct {
+	Params GetHostTopologyParams
 }
 
-// GetBuild operation middleware
-func (siw *ServerInterfaceWrapper) GetBuild(w http.ResponseWriter, r *http.Request) {
+type GetHostTopologyResponseObject interface {
+	VisitGetHostTopologyResponse(w http.ResponseWriter) error
+}
 
-	var err error
+type GetHostTopology200JSONResponse HostTopology
 
-	// ------------- Path parameter "id" -------------
-	var id string
+func (response GetHostTopology200JSONResponse) VisitGetHostTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx := r.Context()
+type GetHostTopology400JSONResponse Error
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response GetHostTopology400JSONResponse) VisitGetHostTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBuild(w, r, id)
-	}))
+type GetHostTopology500JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetHostTopology500JSONResponse) VisitGetHostTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetBuildEvents operation middleware
-func (siw *ServerInterfaceWrapper) GetBuildEvents(w http.ResponseWriter, r *http.Request) {
+type ListHypervisorsRequestObject struct {
+}
 
-	var err error
+type ListHypervisorsResponseObject interface {
+	VisitListHypervisorsResponse(w http.ResponseWriter) error
+}
 
-	// ------------- Path parameter "id" -------------
-	var id string
+type ListHypervisors200JSONResponse HypervisorList
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+func (response ListHypervisors200JSONResponse) VisitListHypervisorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type ListHypervisors500JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response ListHypervisors500JSONResponse) VisitListHypervisorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBuildEventsParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional query parameter "follow" -------------
+type ListImagesRequestObject struct {
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
-		return
-	}
+type ListImagesResponseObject interface {
+	VisitListImagesResponse(w http.ResponseWriter) error
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBuildEvents(w, r, id, params)
-	}))
+type ListImages200JSONResponse []Image
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response ListImages200JSONResponse) VisitListImagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ListDevices operation middleware
-func (siw *ServerInterfaceWrapper) ListDevices(w http.ResponseWriter, r *http.Request) {
+type ListImages401JSONResponse Error
 
-	ctx := r.Context()
+func (response ListImages401JSONResponse) VisitListImagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type ListImages500JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListDevices(w, r)
-	}))
+func (response ListImages500JSONResponse) VisitListImagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type CreateImageRequestObject struct {
+	Body *CreateImageJSONRequestBody
 }
 
-// CreateDevice operation middleware
-func (siw *ServerInterfaceWrapper) CreateDevice(w http.ResponseWriter, r *http.Request) {
+type CreateImageResponseObject interface {
+	VisitCreateImageResponse(w http.ResponseWriter) error
+}
 
-	ctx := r.Context()
+type CreateImage202JSONResponse Image
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response CreateImage202JSONResponse) VisitCreateImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateDevice(w, r)
-	}))
+type CreateImage400JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response CreateImage400JSONResponse) VisitCreateImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ListAvailableDevices operation middleware
-func (siw *ServerInterfaceWrapper) ListAvailableDevices(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
+type CreateImage401JSONResponse Error
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response CreateImage401JSONResponse) VisitCreateImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListAvailableDevices(w, r)
-	}))
+type CreateImage404JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response CreateImage404JSONResponse) VisitCreateImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// DeleteDevice operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDevice(w http.ResponseWriter, r *http.Request) {
+type CreateImage500JSONResponse Error
 
-	var err error
+func (response CreateImage500JSONResponse) VisitCreateImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type CollectImageGarbageRequestObject struct {
+	Params CollectImageGarbageParams
+}
 
-	ctx := r.Context()
+type CollectImageGarbageResponseObject interface {
+	VisitCollectImageGarbageResponse(w http.ResponseWriter) error
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type CollectImageGarbage200JSONResponse ImageGCResult
 
-	r = r.WithContext(ctx)
+func (response CollectImageGarbage200JSONResponse) VisitCollectImageGarbageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteDevice(w, r, id)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type CollectImageGarbage401JSONResponse Error
 
-	handler.ServeHTTP(w, r)
+func (response CollectImageGarbage401JSONResponse) VisitCollectImageGarbageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetDevice operation middleware
-func (siw *ServerInterfaceWrapper) GetDevice(w http.ResponseWriter, r *http.Request) {
+type CollectImageGarbage500JSONResponse Error
 
-	var err error
+func (response CollectImageGarbage500JSONResponse) VisitCollectImageGarbageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type DeleteImageRequestObject struct {
+	Name string `json:"name"`
+}
 
-	ctx := r.Context()
+type DeleteImageResponseObject interface {
+	VisitDeleteImageResponse(w http.ResponseWriter) error
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type DeleteImage204Response struct {
+}
 
-	r = r.WithContext(ctx)
+func (response DeleteImage204Response) VisitDeleteImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDevice(w, r, id)
-	}))
+type DeleteImage404JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response DeleteImage404JSONResponse) VisitDeleteImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+type DeleteImage500JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
-	}))
+func (response DeleteImage500JSONResponse) VisitDeleteImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type GetImageRequestObject struct {
+	Name string `json:"name"`
 }
 
-// ListImages operation middleware
-func (siw *ServerInterfaceWrapper) ListImages(w http.ResponseWriter, r *http.Request) {
+type GetImageResponseObject interface {
+	VisitGetImageResponse(w http.ResponseWriter) error
+}
 
-	ctx := r.Context()
+type GetImage200JSONResponse Image
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response GetImage200JSONResponse) VisitGetImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListImages(w, r)
-	}))
+type GetImage404JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetImage404JSONResponse) VisitGetImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// CreateImage operation middleware
-func (siw *ServerInterfaceWrapper) CreateImage(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
+type GetImage500JSONResponse Error
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response GetImage500JSONResponse) VisitGetImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateImage(w, r)
-	}))
+type GetImageEventsRequestObject struct {
+	Name string `json:"name"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetImageEventsResponseObject interface {
+	VisitGetImageEventsResponse(w http.ResponseWriter) error
+}
 
-	handler.ServeHTTP(w, r)
+type GetImageEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-// DeleteImage operation middleware
-func (siw *ServerInterfaceWrapper) DeleteImage(w http.ResponseWriter, r *http.Request) {
+func (response GetImageEvents200TexteventStreamResponse) VisitGetImageEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
 
-	var err error
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+type GetImageEvents404JSONResponse Error
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
+func (response GetImageEvents404JSONResponse) VisitGetImageEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type GetImageEvents500JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response GetImageEvents500JSONResponse) VisitGetImageEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteImage(w, r, name)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type ListIngressesRequestObject struct {
+}
 
-	handler.ServeHTTP(w, r)
+type ListIngressesResponseObject interface {
+	VisitListIngressesResponse(w http.ResponseWriter) error
 }
 
-// GetImage operation middleware
-func (siw *ServerInterfaceWrapper) GetImage(w http.ResponseWriter, r *http.Request) {
+type ListIngresses200JSONResponse []Ingress
 
-	var err error
+func (response ListIngresses200JSONResponse) VisitListIngressesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
+type ListIngresses401JSONResponse Error
 
-	ctx := r.Context()
+func (response ListIngresses401JSONResponse) VisitListIngressesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type ListIngresses500JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetImage(w, r, name)
-	}))
+func (response ListIngresses500JSONResponse) VisitListIngressesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type CreateIngressRequestObject struct {
+	Body *CreateIngressJSONRequestBody
 }
 
-// ListIngresses operation middleware
-func (siw *ServerInterfaceWrapper) ListIngresses(w http.ResponseWriter, r *http.Request) {
+type CreateIngressResponseObject interface {
+	VisitCreateIngressResponse(w http.ResponseWriter) error
+}
 
-	ctx := r.Context()
+type CreateIngress201JSONResponse Ingress
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response CreateIngress201JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListIngresses(w, r)
-	}))
+type CreateIngress400JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response CreateIngress400JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// CreateIngress operation middleware
-func (siw *ServerInterfaceWrapper) CreateIngress(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
+type CreateIngress401JSONResponse Error
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response CreateIngress401JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateIngress(w, r)
-	}))
+type CreateIngress409JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response CreateIngress409JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// DeleteIngress operation middleware
-func (siw *ServerInterfaceWrapper) DeleteIngress(w http.ResponseWriter, r *http.Request) {
+type CreateIngress500JSONResponse Error
 
-	var err error
+func (response CreateIngress500JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type ClearIngressDefaultBackendRequestObject struct {
+}
 
-	ctx := r.Context()
+type ClearIngressDefaultBackendResponseObject interface {
+	VisitClearIngressDefaultBackendResponse(w http.ResponseWriter) error
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type ClearIngressDefaultBackend204Response struct {
+}
 
-	r = r.WithContext(ctx)
+func (response ClearIngressDefaultBackend204Response) VisitClearIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteIngress(w, r, id)
-	}))
+type ClearIngressDefaultBackend401JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response ClearIngressDefaultBackend401JSONResponse) VisitClearIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetIngress operation middleware
-func (siw *ServerInterfaceWrapper) GetIngress(w http.ResponseWriter, r *http.Request) {
+type ClearIngressDefaultBackend500JSONResponse Error
 
-	var err error
+func (response ClearIngressDefaultBackend500JSONResponse) VisitClearIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type GetIngressDefaultBackendRequestObject struct {
+}
 
-	ctx := r.Context()
+type GetIngressDefaultBackendResponseObject interface {
+	VisitGetIngressDefaultBackendResponse(w http.ResponseWriter) error
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type GetIngressDefaultBackend200JSONResponse IngressTarget
 
-	r = r.WithContext(ctx)
+func (response GetIngressDefaultBackend200JSONResponse) VisitGetIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetIngress(w, r, id)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetIngressDefaultBackend401JSONResponse Error
 
-	handler.ServeHTTP(w, r)
+func (response GetIngressDefaultBackend401JSONResponse) VisitGetIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ListInstances operation middleware
-func (siw *ServerInterfaceWrapper) ListInstances(w http.ResponseWriter, r *http.Request) {
+type GetIngressDefaultBackend404JSONResponse Error
 
-	ctx := r.Context()
+func (response GetIngressDefaultBackend404JSONResponse) VisitGetIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type GetIngressDefaultBackend500JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListInstances(w, r)
-	}))
+func (response GetIngressDefaultBackend500JSONResponse) VisitGetIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type SetIngressDefaultBackendRequestObject struct {
+	Body *SetIngressDefaultBackendJSONRequestBody
 }
 
-// CreateInstance operation middleware
-func (siw *ServerInterfaceWrapper) CreateInstance(w http.ResponseWriter, r *http.Request) {
+type SetIngressDefaultBackendResponseObject interface {
+	VisitSetIngressDefaultBackendResponse(w http.ResponseWriter) error
+}
 
-	ctx := r.Context()
+type SetIngressDefaultBackend200JSONResponse IngressTarget
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response SetIngressDefaultBackend200JSONResponse) VisitSetIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateInstance(w, r)
-	}))
+type SetIngressDefaultBackend400JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response SetIngressDefaultBackend400JSONResponse) VisitSetIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// DeleteInstance operation middleware
-func (siw *ServerInterfaceWrapper) DeleteInstance(w http.ResponseWriter, r *http.Request) {
+type SetIngressDefaultBackend401JSONResponse Error
 
-	var err error
+func (response SetIngressDefaultBackend401JSONResponse) VisitSetIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type SetIngressDefaultBackend409JSONResponse Error
 
-	ctx := r.Context()
+func (response SetIngressDefaultBackend409JSONResponse) VisitSetIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type SetIngressDefaultBackend500JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteInstance(w, r, id)
-	}))
+func (response SetIngressDefaultBackend500JSONResponse) VisitSetIngressDefaultBackendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type LookupIngressDNSRequestObject struct {
+	Params LookupIngressDNSParams
 }
 
-// GetInstance operation middleware
-func (siw *ServerInterfaceWrapper) GetInstance(w http.ResponseWriter, r *http.Request) {
+type LookupIngressDNSResponseObject interface {
+	VisitLookupIngressDNSResponse(w http.ResponseWriter) error
+}
 
-	var err error
+type LookupIngressDNS200JSONResponse IngressDNSLookup
 
-	// ------------- Path parameter "id" -------------
-	var id string
+func (response LookupIngressDNS200JSONResponse) VisitLookupIngressDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx := r.Context()
+type LookupIngressDNS400JSONResponse Error
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response LookupIngressDNS400JSONResponse) VisitLookupIngressDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetInstance(w, r, id)
-	}))
+type LookupIngressDNS401JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response LookupIngressDNS401JSONResponse) VisitLookupIngressDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetInstanceLogs operation middleware
-func (siw *ServerInterfaceWrapper) GetInstanceLogs(w http.ResponseWriter, r *http.Request) {
+type LookupIngressDNS500JSONResponse Error
 
-	var err error
+func (response LookupIngressDNS500JSONResponse) VisitLookupIngressDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type LookupIngressDNS503JSONResponse Error
 
-	ctx := r.Context()
+func (response LookupIngressDNS503JSONResponse) VisitLookupIngressDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type DeleteIngressRequestObject struct {
+	Id string `json:"id"`
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetInstanceLogsParams
+type DeleteIngressResponseObject interface {
+	VisitDeleteIngressResponse(w http.ResponseWriter) error
+}
 
-	// ------------- Optional query parameter "tail" -------------
+type DeleteIngress204Response struct {
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "tail", r.URL.Query(), &params.Tail)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tail", Err: err})
-		return
-	}
+func (response DeleteIngress204Response) VisitDeleteIngressResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteIngress400JSONResponse Error
+
+func (response DeleteIngress400JSONResponse) VisitDeleteIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// ------------- Optional query parameter "follow" -------------
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
-		return
-	}
+type DeleteIngress404JSONResponse Error
 
-	// ------------- Optional query parameter "source" -------------
+func (response DeleteIngress404JSONResponse) VisitDeleteIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetInstanceLogs(w, r, id, params)
-	}))
+type DeleteIngress409JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response DeleteIngress409JSONResponse) VisitDeleteIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// RestoreInstance operation middleware
-func (siw *ServerInterfaceWrapper) RestoreInstance(w http.ResponseWriter, r *http.Request) {
+type DeleteIngress500JSONResponse Error
 
-	var err error
+func (response DeleteIngress500JSONResponse) VisitDeleteIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type GetIngressRequestObject struct {
+	Id string `json:"id"`
+}
 
-	ctx := r.Context()
+type GetIngressResponseObject interface {
+	VisitGetIngressResponse(w http.ResponseWriter) error
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type GetIngress200JSONResponse Ingress
 
-	r = r.WithContext(ctx)
+func (response GetIngress200JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RestoreInstance(w, r, id)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetIngress404JSONResponse Error
 
-	handler.ServeHTTP(w, r)
+func (response GetIngress404JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StandbyInstance operation middleware
-func (siw *ServerInterfaceWrapper) StandbyInstance(w http.ResponseWriter, r *http.Request) {
+type GetIngress409JSONResponse Error
 
-	var err error
+func (response GetIngress409JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type GetIngress500JSONResponse Error
 
-	ctx := r.Context()
+func (response GetIngress500JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type ListInstancesRequestObject struct {
+	Params ListInstancesParams
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StandbyInstance(w, r, id)
-	}))
+type ListInstancesResponseObject interface {
+	VisitListInstancesResponse(w http.ResponseWriter) error
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type ListInstances200ResponseHeaders struct {
+	XNextCursor string
+	XTotalCount int
+}
 
-	handler.ServeHTTP(w, r)
+type ListInstances200JSONResponse struct {
+	Body    []Instance
+	Headers ListInstances200ResponseHeaders
 }
 
-// StartInstance operation middleware
-func (siw *ServerInterfaceWrapper) StartInstance(w http.ResponseWriter, r *http.Request) {
+func (response ListInstances200JSONResponse) VisitListInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
 
-	var err error
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	// ------------- Path parameter "id" -------------
-	var id string
+type ListInstances400JSONResponse Error
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+func (response ListInstances400JSONResponse) VisitListInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type ListInstances401JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response ListInstances401JSONResponse) VisitListInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StartInstance(w, r, id)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type ListInstances500JSONResponse Error
 
-	handler.ServeHTTP(w, r)
+func (response ListInstances500JSONResponse) VisitListInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatInstancePath operation middleware
-func (siw *ServerInterfaceWrapper) StatInstancePath(w http.ResponseWriter, r *http.Request) {
+type CreateInstanceRequestObject struct {
+	Body *CreateInstanceJSONRequestBody
+}
 
-	var err error
+type CreateInstanceResponseObject interface {
+	VisitCreateInstanceResponse(w http.ResponseWriter) error
+}
 
-	// ------------- Path parameter "id" -------------
-	var id string
+type CreateInstance201JSONResponse Instance
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+func (response CreateInstance201JSONResponse) VisitCreateInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type CreateInstance400JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response CreateInstance400JSONResponse) VisitCreateInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params StatInstancePathParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Required query parameter "path" -------------
+type CreateInstance401JSONResponse Error
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+func (response CreateInstance401JSONResponse) VisitCreateInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
-	}
+type CreateInstance409JSONResponse Error
 
-	// ------------- Optional query parameter "follow_links" -------------
+func (response CreateInstance409JSONResponse) VisitCreateInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	err = runtime.BindQueryParameter("form", true, false, "follow_links", r.URL.Query(), &params.FollowLinks)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow_links", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StatInstancePath(w, r, id, params)
-	}))
+type CreateInstance500JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response CreateInstance500JSONResponse) VisitCreateInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StopInstance operation middleware
-func (siw *ServerInterfaceWrapper) StopInstance(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+type CreateInstance503JSONResponse Error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+func (response CreateInstance503JSONResponse) VisitCreateInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx := r.Context()
+type CreateInstancesRequestObject struct {
+	Body *CreateInstancesJSONRequestBody
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type CreateInstancesResponseObject interface {
+	VisitCreateInstancesResponse(w http.ResponseWriter) error
+}
 
-	r = r.WithContext(ctx)
+type CreateInstances201JSONResponse CreateInstancesResult
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StopInstance(w, r, id)
-	}))
+func (response CreateInstances201JSONResponse) VisitCreateInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type CreateInstances400JSONResponse Error
+
+func (response CreateInstances400JSONResponse) VisitCreateInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// DetachVolume operation middleware
-func (siw *ServerInterfaceWrapper) DetachVolume(w http.ResponseWriter, r *http.Request) {
+type CreateInstances401JSONResponse Error
 
-	var err error
+func (response CreateInstances401JSONResponse) VisitCreateInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type CreateInstances409JSONResponse CreateInstancesResult
 
-	// ------------- Path parameter "volumeId" -------------
-	var volumeId string
+func (response CreateInstances409JSONResponse) VisitCreateInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "volumeId", chi.URLParam(r, "volumeId"), &volumeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "volumeId", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx := r.Context()
+type CreateInstances500JSONResponse Error
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response CreateInstances500JSONResponse) VisitCreateInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DetachVolume(w, r, id, volumeId)
-	}))
+type CreateInstances503JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response CreateInstances503JSONResponse) VisitCreateInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// AttachVolume operation middleware
-func (siw *ServerInterfaceWrapper) AttachVolume(w http.ResponseWriter, r *http.Request) {
+type WatchInstancesRequestObject struct {
+	Params WatchInstancesParams
+}
 
-	var err error
+type WatchInstancesResponseObject interface {
+	VisitWatchInstancesResponse(w http.ResponseWriter) error
+}
 
-	// ------------- Path parameter "id" -------------
-	var id string
+type WatchInstances200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+func (response WatchInstances200TexteventStreamResponse) VisitWatchInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
+	w.WriteHeader(200)
 
-	// ------------- Path parameter "volumeId" -------------
-	var volumeId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "volumeId", chi.URLParam(r, "volumeId"), &volumeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "volumeId", Err: err})
-		return
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
 	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	ctx := r.Context()
+type WatchInstances401JSONResponse Error
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response WatchInstances401JSONResponse) VisitWatchInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AttachVolume(w, r, id, volumeId)
-	}))
+type WatchInstances404JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response WatchInstances404JSONResponse) VisitWatchInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetResources operation middleware
-func (siw *ServerInterfaceWrapper) GetResources(w http.ResponseWriter, r *http.Request) {
+type WatchInstances409JSONResponse Error
 
-	ctx := r.Context()
+func (response WatchInstances409JSONResponse) VisitWatchInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type WatchInstances500JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetResources(w, r)
-	}))
+func (response WatchInstances500JSONResponse) VisitWatchInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type ListPreservedSnapshotsRequestObject struct {
 }
 
-// ListVolumes operation middleware
-func (siw *ServerInterfaceWrapper) ListVolumes(w http.ResponseWriter, r *http.Request) {
+type ListPreservedSnapshotsResponseObject interface {
+	VisitListPreservedSnapshotsResponse(w http.ResponseWriter) error
+}
 
-	ctx := r.Context()
+type ListPreservedSnapshots200JSONResponse []PreservedSnapshot
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+func (response ListPreservedSnapshots200JSONResponse) VisitListPreservedSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListVolumes(w, r)
-	}))
+type ListPreservedSnapshots401JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response ListPreservedSnapshots401JSONResponse) VisitListPreservedSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// CreateVolume operation middleware
-func (siw *ServerInterfaceWrapper) CreateVolume(w http.ResponseWriter, r *http.Request) {
+type ListPreservedSnapshots500JSONResponse Error
 
-	ctx := r.Context()
+func (response ListPreservedSnapshots500JSONResponse) VisitListPreservedSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type DeleteInstanceRequestObject struct {
+	Id     string `json:"id"`
+	Params DeleteInstanceParams
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateVolume(w, r)
-	}))
+type DeleteInstanceResponseObject interface {
+	VisitDeleteInstanceResponse(w http.ResponseWriter) error
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type DeleteInstance200JSONResponse CascadeDeleteResult
 
-	handler.ServeHTTP(w, r)
+func (response DeleteInstance200JSONResponse) VisitDeleteInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// DeleteVolume operation middleware
-func (siw *ServerInterfaceWrapper) DeleteVolume(w http.ResponseWriter, r *http.Request) {
+type DeleteInstance204Response struct {
+}
 
-	var err error
+func (response DeleteInstance204Response) VisitDeleteInstanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	// ------------- Path parameter "id" -------------
-	var id string
+type DeleteInstance400JSONResponse Error
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+func (response DeleteInstance400JSONResponse) VisitDeleteInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type DeleteInstance404JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response DeleteInstance404JSONResponse) VisitDeleteInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteVolume(w, r, id)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type DeleteInstance409JSONResponse Error
 
-	handler.ServeHTTP(w, r)
+func (response DeleteInstance409JSONResponse) VisitDeleteInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetVolume operation middleware
-func (siw *ServerInterfaceWrapper) GetVolume(w http.ResponseWriter, r *http.Request) {
+type DeleteInstance500JSONResponse Error
 
-	var err error
+func (response DeleteInstance500JSONResponse) VisitDeleteInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type GetInstanceRequestObject struct {
+	Id string `json:"id"`
+}
 
-	ctx := r.Context()
+type GetInstanceResponseObject interface {
+	VisitGetInstanceResponse(w http.ResponseWriter) error
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type GetInstance200JSONResponse Instance
 
-	r = r.WithContext(ctx)
+func (response GetInstance200JSONResponse) VisitGetInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVolume(w, r, id)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetInstance404JSONResponse Error
 
-	handler.ServeHTTP(w, r)
-}
+func (response GetInstance404JSONResponse) VisitGetInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+type GetInstance500JSONResponse Error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+func (response GetInstance500JSONResponse) VisitGetInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type GetInstanceBootStatusRequestObject struct {
+	Id string `json:"id"`
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+type GetInstanceBootStatusResponseObject interface {
+	VisitGetInstanceBootStatusResponse(w http.ResponseWriter) error
 }
 
-type RequiredParamError struct {
-	ParamName string
-}
+type GetInstanceBootStatus200JSONResponse BootStatus
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+func (response GetInstanceBootStatus200JSONResponse) VisitGetInstanceBootStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+type GetInstanceBootStatus404JSONResponse Error
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+func (response GetInstanceBootStatus404JSONResponse) VisitGetInstanceBootStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+type GetInstanceBootStatus500JSONResponse Error
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+func (response GetInstanceBootStatus500JSONResponse) VisitGetInstanceBootStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type CloneInstanceRequestObject struct {
+	Id   string `json:"id"`
+	Body *CloneInstanceJSONRequestBody
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+type CloneInstanceResponseObject interface {
+	VisitCloneInstanceResponse(w http.ResponseWriter) error
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type CloneInstance201JSONResponse Instance
+
+func (response CloneInstance201JSONResponse) VisitCloneInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+type CloneInstance400JSONResponse Error
+
+func (response CloneInstance400JSONResponse) VisitCloneInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+type CloneInstance404JSONResponse Error
+
+func (response CloneInstance404JSONResponse) VisitCloneInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type CloneInstance409JSONResponse Error
+
+func (response CloneInstance409JSONResponse) VisitCloneInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type CloneInstance500JSONResponse Error
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+func (response CloneInstance500JSONResponse) VisitCloneInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/builds", wrapper.ListBuilds)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/builds", wrapper.CreateBuild)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/builds/{id}", wrapper.CancelBuild)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/builds/{id}", wrapper.GetBuild)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/builds/{id}/events", wrapper.GetBuildEvents)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/devices", wrapper.ListDevices)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/devices", wrapper.CreateDevice)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/devices/available", wrapper.ListAvailableDevices)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/devices/{id}", wrapper.DeleteDevice)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/devices/{id}", wrapper.GetDevice)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/images", wrapper.ListImages)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/images", wrapper.CreateImage)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/images/{name}", wrapper.DeleteImage)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/images/{name}", wrapper.GetImage)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/ingresses", wrapper.ListIngresses)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/ingresses", wrapper.CreateIngress)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/ingresses/{id}", wrapper.DeleteIngress)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/ingresses/{id}", wrapper.GetIngress)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/instances", wrapper.ListInstances)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/instances", wrapper.CreateInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/instances/{id}", wrapper.DeleteInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/instances/{id}", wrapper.GetInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/instances/{id}/logs", wrapper.GetInstanceLogs)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/instances/{id}/restore", wrapper.RestoreInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/instances/{id}/standby", wrapper.StandbyInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/instances/{id}/start", wrapper.StartInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/instances/{id}/stat", wrapper.StatInstancePath)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/instances/{id}/stop", wrapper.StopInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/instances/{id}/volumes/{volumeId}", wrapper.DetachVolume)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/instances/{id}/volumes/{volumeId}", wrapper.AttachVolume)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/resources", wrapper.GetResources)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/volumes", wrapper.ListVolumes)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/volumes", wrapper.CreateVolume)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/volumes/{id}", wrapper.DeleteVolume)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/volumes/{id}", wrapper.GetVolume)
-	})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return r
+type CloneInstance503JSONResponse Error
+
+func (response CloneInstance503JSONResponse) VisitCloneInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListBuildsRequestObject struct {
+type CompactInstanceOverlayRequestObject struct {
+	Id string `json:"id"`
 }
 
-type ListBuildsResponseObject interface {
-	VisitListBuildsResponse(w http.ResponseWriter) error
+type CompactInstanceOverlayResponseObject interface {
+	VisitCompactInstanceOverlayResponse(w http.ResponseWriter) error
 }
 
-type ListBuilds200JSONResponse []Build
+type CompactInstanceOverlay200JSONResponse OverlayCompaction
 
-func (response ListBuilds200JSONResponse) VisitListBuildsResponse(w http.ResponseWriter) error {
+func (response CompactInstanceOverlay200JSONResponse) VisitCompactInstanceOverlayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListBuilds401JSONResponse Error
+type CompactInstanceOverlay404JSONResponse Error
 
-func (response ListBuilds401JSONResponse) VisitListBuildsResponse(w http.ResponseWriter) error {
+func (response CompactInstanceOverlay404JSONResponse) VisitCompactInstanceOverlayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListBuilds500JSONResponse Error
+type CompactInstanceOverlay409JSONResponse Error
 
-func (response ListBuilds500JSONResponse) VisitListBuildsResponse(w http.ResponseWriter) error {
+func (response CompactInstanceOverlay409JSONResponse) VisitCompactInstanceOverlayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompactInstanceOverlay500JSONResponse Error
+
+func (response CompactInstanceOverlay500JSONResponse) VisitCompactInstanceOverlayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateBuildRequestObject struct {
-	Body *multipart.Reader
+type DetachInstanceDeviceRequestObject struct {
+	Id       string `json:"id"`
+	DeviceId string `json:"deviceId"`
 }
 
-type CreateBuildResponseObject interface {
-	VisitCreateBuildResponse(w http.ResponseWriter) error
+type DetachInstanceDeviceResponseObject interface {
+	VisitDetachInstanceDeviceResponse(w http.ResponseWriter) error
 }
 
-type CreateBuild202JSONResponse Build
+type DetachInstanceDevice200JSONResponse Instance
 
-func (response CreateBuild202JSONResponse) VisitCreateBuildResponse(w http.ResponseWriter) error {
+func (response DetachInstanceDevice200JSONResponse) VisitDetachInstanceDeviceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateBuild400JSONResponse Error
+type DetachInstanceDevice400JSONResponse Error
 
-func (response CreateBuild400JSONResponse) VisitCreateBuildResponse(w http.ResponseWriter) error {
+func (response DetachInstanceDevice400JSONResponse) VisitDetachInstanceDeviceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateBuild401JSONResponse Error
+type DetachInstanceDevice404JSONResponse Error
 
-func (response CreateBuild401JSONResponse) VisitCreateBuildResponse(w http.ResponseWriter) error {
+func (response DetachInstanceDevice404JSONResponse) VisitDetachInstanceDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DetachInstanceDevice409JSONResponse Error
+
+func (response DetachInstanceDevice409JSONResponse) VisitDetachInstanceDeviceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateBuild500JSONResponse Error
+type DetachInstanceDevice500JSONResponse Error
 
-func (response CreateBuild500JSONResponse) VisitCreateBuildResponse(w http.ResponseWriter) error {
+func (response DetachInstanceDevice500JSONResponse) VisitDetachInstanceDeviceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelBuildRequestObject struct {
-	Id string `json:"id"`
+type AttachInstanceDeviceRequestObject struct {
+	Id       string `json:"id"`
+	DeviceId string `json:"deviceId"`
 }
 
-type CancelBuildResponseObject interface {
-	VisitCancelBuildResponse(w http.ResponseWriter) error
+type AttachInstanceDeviceResponseObject interface {
+	VisitAttachInstanceDeviceResponse(w http.ResponseWriter) error
 }
 
-type CancelBuild204Response struct {
+type AttachInstanceDevice200JSONResponse Instance
+
+func (response AttachInstanceDevice200JSONResponse) VisitAttachInstanceDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response CancelBuild204Response) VisitCancelBuildResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type AttachInstanceDevice400JSONResponse Error
+
+func (response AttachInstanceDevice400JSONResponse) VisitAttachInstanceDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelBuild404JSONResponse Error
+type AttachInstanceDevice404JSONResponse Error
 
-func (response CancelBuild404JSONResponse) VisitCancelBuildResponse(w http.ResponseWriter) error {
+func (response AttachInstanceDevice404JSONResponse) VisitAttachInstanceDeviceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelBuild409JSONResponse Error
+type AttachInstanceDevice409JSONResponse Error
 
-func (response CancelBuild409JSONResponse) VisitCancelBuildResponse(w http.ResponseWriter) error {
+func (response AttachInstanceDevice409JSONResponse) VisitAttachInstanceDeviceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelBuild500JSONResponse Error
+type AttachInstanceDevice500JSONResponse Error
 
-func (response CancelBuild500JSONResponse) VisitCancelBuildResponse(w http.ResponseWriter) error {
+func (response AttachInstanceDevice500JSONResponse) VisitAttachInstanceDeviceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetBuildRequestObject struct {
+type ListExecSessionsRequestObject struct {
 	Id string `json:"id"`
 }
 
-type GetBuildResponseObject interface {
-	VisitGetBuildResponse(w http.ResponseWriter) error
+type ListExecSessionsResponseObject interface {
+	VisitListExecSessionsResponse(w http.ResponseWriter) error
 }
 
-type GetBuild200JSONResponse Build
+type ListExecSessions200JSONResponse []ExecSession
 
-func (response GetBuild200JSONResponse) VisitGetBuildResponse(w http.ResponseWriter) error {
+func (response ListExecSessions200JSONResponse) VisitListExecSessionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetBuild404JSONResponse Error
+type ListExecSessions404JSONResponse Error
 
-func (response GetBuild404JSONResponse) VisitGetBuildResponse(w http.ResponseWriter) error {
+func (response ListExecSessions404JSONResponse) VisitListExecSessionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetBuild500JSONResponse Error
+type ListExecSessions500JSONResponse Error
 
-func (response GetBuild500JSONResponse) VisitGetBuildResponse(w http.ResponseWriter) error {
+func (response ListExecSessions500JSONResponse) VisitListExecSessionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetBuildEventsRequestObject struct {
-	Id     string `json:"id"`
-	Params GetBuildEventsParams
+type GetExecSessionRecordingRequestObject struct {
+	Id        string `json:"id"`
+	SessionId string `json:"sessionId"`
 }
 
-type GetBuildEventsResponseObject interface {
-	VisitGetBuildEventsResponse(w http.ResponseWriter) error
+type GetExecSessionRecordingResponseObject interface {
+	VisitGetExecSessionRecordingResponse(w http.ResponseWriter) error
 }
 
-type GetBuildEvents200TexteventStreamResponse struct {
+type GetExecSessionRecording200ApplicationxAsciicastResponse struct {
 	Body          io.Reader
 	ContentLength int64
 }
 
-func (response GetBuildEvents200TexteventStreamResponse) VisitGetBuildEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/event-stream")
+func (response GetExecSessionRecording200ApplicationxAsciicastResponse) VisitGetExecSessionRecordingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-asciicast")
 	if response.ContentLength != 0 {
 		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
@@ -7515,1423 +17558,1985 @@ func (response GetBuildEvents200TexteventStreamResponse) VisitGetBuildEventsResp
 	return err
 }
 
-type GetBuildEvents404JSONResponse Error
+type GetExecSessionRecording404JSONResponse Error
 
-func (response GetBuildEvents404JSONResponse) VisitGetBuildEventsResponse(w http.ResponseWriter) error {
+func (response GetExecSessionRecording404JSONResponse) VisitGetExecSessionRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetBuildEvents500JSONResponse Error
+type GetExecSessionRecording500JSONResponse Error
 
-func (response GetBuildEvents500JSONResponse) VisitGetBuildEventsResponse(w http.ResponseWriter) error {
+func (response GetExecSessionRecording500JSONResponse) VisitGetExecSessionRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListDevicesRequestObject struct {
+type RunInstanceCommandRequestObject struct {
+	Id   string `json:"id"`
+	Body *RunInstanceCommandJSONRequestBody
 }
 
-type ListDevicesResponseObject interface {
-	VisitListDevicesResponse(w http.ResponseWriter) error
+type RunInstanceCommandResponseObject interface {
+	VisitRunInstanceCommandResponse(w http.ResponseWriter) error
 }
 
-type ListDevices200JSONResponse []Device
+type RunInstanceCommand200JSONResponse ExecRunResult
 
-func (response ListDevices200JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+func (response RunInstanceCommand200JSONResponse) VisitRunInstanceCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListDevices401JSONResponse Error
+type RunInstanceCommand400JSONResponse Error
 
-func (response ListDevices401JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+func (response RunInstanceCommand400JSONResponse) VisitRunInstanceCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListDevices500JSONResponse Error
+type RunInstanceCommand404JSONResponse Error
 
-func (response ListDevices500JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+func (response RunInstanceCommand404JSONResponse) VisitRunInstanceCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDeviceRequestObject struct {
-	Body *CreateDeviceJSONRequestBody
-}
+type RunInstanceCommand409JSONResponse Error
 
-type CreateDeviceResponseObject interface {
-	VisitCreateDeviceResponse(w http.ResponseWriter) error
+func (response RunInstanceCommand409JSONResponse) VisitRunInstanceCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDevice201JSONResponse Device
+type RunInstanceCommand500JSONResponse Error
 
-func (response CreateDevice201JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+func (response RunInstanceCommand500JSONResponse) VisitRunInstanceCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDevice400JSONResponse Error
+type GetInstanceFileRequestObject struct {
+	Id     string `json:"id"`
+	Params GetInstanceFileParams
+}
 
-func (response CreateDevice400JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+type GetInstanceFileResponseObject interface {
+	VisitGetInstanceFileResponse(w http.ResponseWriter) error
+}
+
+type GetInstanceFile200JSONResponse GuestFile
+
+func (response GetInstanceFile200JSONResponse) VisitGetInstanceFileResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDevice401JSONResponse Error
+type GetInstanceFile400JSONResponse Error
 
-func (response CreateDevice401JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+func (response GetInstanceFile400JSONResponse) VisitGetInstanceFileResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDevice404JSONResponse Error
+type GetInstanceFile404JSONResponse Error
 
-func (response CreateDevice404JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+func (response GetInstanceFile404JSONResponse) VisitGetInstanceFileResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDevice409JSONResponse Error
+type GetInstanceFile409JSONResponse Error
 
-func (response CreateDevice409JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+func (response GetInstanceFile409JSONResponse) VisitGetInstanceFileResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDevice500JSONResponse Error
+type GetInstanceFile500JSONResponse Error
 
-func (response CreateDevice500JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+func (response GetInstanceFile500JSONResponse) VisitGetInstanceFileResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListAvailableDevicesRequestObject struct {
+type GetInstanceLogsRequestObject struct {
+	Id     string `json:"id"`
+	Params GetInstanceLogsParams
 }
 
-type ListAvailableDevicesResponseObject interface {
-	VisitListAvailableDevicesResponse(w http.ResponseWriter) error
+type GetInstanceLogsResponseObject interface {
+	VisitGetInstanceLogsResponse(w http.ResponseWriter) error
 }
 
-type ListAvailableDevices200JSONResponse []AvailableDevice
+type GetInstanceLogs200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-func (response ListAvailableDevices200JSONResponse) VisitListAvailableDevicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetInstanceLogs200TexteventStreamResponse) VisitGetInstanceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type ListAvailableDevices401JSONResponse Error
+type GetInstanceLogs404JSONResponse Error
 
-func (response ListAvailableDevices401JSONResponse) VisitListAvailableDevicesResponse(w http.ResponseWriter) error {
+func (response GetInstanceLogs404JSONResponse) VisitGetInstanceLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListAvailableDevices500JSONResponse Error
+type GetInstanceLogs500JSONResponse Error
 
-func (response ListAvailableDevices500JSONResponse) VisitListAvailableDevicesResponse(w http.ResponseWriter) error {
+func (response GetInstanceLogs500JSONResponse) VisitGetInstanceLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDeviceRequestObject struct {
+type ListInstanceProcessesRequestObject struct {
 	Id string `json:"id"`
 }
 
-type DeleteDeviceResponseObject interface {
-	VisitDeleteDeviceResponse(w http.ResponseWriter) error
+type ListInstanceProcessesResponseObject interface {
+	VisitListInstanceProcessesResponse(w http.ResponseWriter) error
 }
 
-type DeleteDevice204Response struct {
-}
+type ListInstanceProcesses200JSONResponse InstanceProcesses
 
-func (response DeleteDevice204Response) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response ListInstanceProcesses200JSONResponse) VisitListInstanceProcessesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDevice404JSONResponse Error
+type ListInstanceProcesses404JSONResponse Error
 
-func (response DeleteDevice404JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+func (response ListInstanceProcesses404JSONResponse) VisitListInstanceProcessesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDevice409JSONResponse Error
+type ListInstanceProcesses409JSONResponse Error
 
-func (response DeleteDevice409JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+func (response ListInstanceProcesses409JSONResponse) VisitListInstanceProcessesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDevice500JSONResponse Error
+type ListInstanceProcesses500JSONResponse Error
 
-func (response DeleteDevice500JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+func (response ListInstanceProcesses500JSONResponse) VisitListInstanceProcessesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDeviceRequestObject struct {
+type ResetInstanceOverlayRequestObject struct {
 	Id string `json:"id"`
 }
 
-type GetDeviceResponseObject interface {
-	VisitGetDeviceResponse(w http.ResponseWriter) error
+type ResetInstanceOverlayResponseObject interface {
+	VisitResetInstanceOverlayResponse(w http.ResponseWriter) error
 }
 
-type GetDevice200JSONResponse Device
+type ResetInstanceOverlay200JSONResponse Instance
 
-func (response GetDevice200JSONResponse) VisitGetDeviceResponse(w http.ResponseWriter) error {
+func (response ResetInstanceOverlay200JSONResponse) VisitResetInstanceOverlayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDevice404JSONResponse Error
+type ResetInstanceOverlay404JSONResponse Error
 
-func (response GetDevice404JSONResponse) VisitGetDeviceResponse(w http.ResponseWriter) error {
+func (response ResetInstanceOverlay404JSONResponse) VisitResetInstanceOverlayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDevice500JSONResponse Error
+type ResetInstanceOverlay409JSONResponse Error
 
-func (response GetDevice500JSONResponse) VisitGetDeviceResponse(w http.ResponseWriter) error {
+func (response ResetInstanceOverlay409JSONResponse) VisitResetInstanceOverlayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetInstanceOverlay500JSONResponse Error
+
+func (response ResetInstanceOverlay500JSONResponse) VisitResetInstanceOverlayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHealthRequestObject struct {
+type RestoreInstanceRequestObject struct {
+	Id string `json:"id"`
 }
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
+type RestoreInstanceResponseObject interface {
+	VisitRestoreInstanceResponse(w http.ResponseWriter) error
 }
 
-type GetHealth200JSONResponse Health
+type RestoreInstance200JSONResponse Instance
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+func (response RestoreInstance200JSONResponse) VisitRestoreInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListImagesRequestObject struct {
-}
-
-type ListImagesResponseObject interface {
-	VisitListImagesResponse(w http.ResponseWriter) error
-}
-
-type ListImages200JSONResponse []Image
+type RestoreInstance404JSONResponse Error
 
-func (response ListImages200JSONResponse) VisitListImagesResponse(w http.ResponseWriter) error {
+func (response RestoreInstance404JSONResponse) VisitRestoreInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListImages401JSONResponse Error
+type RestoreInstance409JSONResponse Error
 
-func (response ListImages401JSONResponse) VisitListImagesResponse(w http.ResponseWriter) error {
+func (response RestoreInstance409JSONResponse) VisitRestoreInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListImages500JSONResponse Error
+type RestoreInstance500JSONResponse Error
 
-func (response ListImages500JSONResponse) VisitListImagesResponse(w http.ResponseWriter) error {
+func (response RestoreInstance500JSONResponse) VisitRestoreInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateImageRequestObject struct {
-	Body *CreateImageJSONRequestBody
+type StandbyInstanceRequestObject struct {
+	Id string `json:"id"`
 }
 
-type CreateImageResponseObject interface {
-	VisitCreateImageResponse(w http.ResponseWriter) error
+type StandbyInstanceResponseObject interface {
+	VisitStandbyInstanceResponse(w http.ResponseWriter) error
 }
 
-type CreateImage202JSONResponse Image
+type StandbyInstance200JSONResponse Instance
 
-func (response CreateImage202JSONResponse) VisitCreateImageResponse(w http.ResponseWriter) error {
+func (response StandbyInstance200JSONResponse) VisitStandbyInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateImage400JSONResponse Error
+type StandbyInstance404JSONResponse Error
 
-func (response CreateImage400JSONResponse) VisitCreateImageResponse(w http.ResponseWriter) error {
+func (response StandbyInstance404JSONResponse) VisitStandbyInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateImage401JSONResponse Error
+type StandbyInstance409JSONResponse Error
 
-func (response CreateImage401JSONResponse) VisitCreateImageResponse(w http.ResponseWriter) error {
+func (response StandbyInstance409JSONResponse) VisitStandbyInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateImage404JSONResponse Error
+type StandbyInstance500JSONResponse Error
 
-func (response CreateImage404JSONResponse) VisitCreateImageResponse(w http.ResponseWriter) error {
+func (response StandbyInstance500JSONResponse) VisitStandbyInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateImage500JSONResponse Error
+type StartInstanceRequestObject struct {
+	Id string `json:"id"`
+}
 
-func (response CreateImage500JSONResponse) VisitCreateImageResponse(w http.ResponseWriter) error {
+type StartInstanceResponseObject interface {
+	VisitStartInstanceResponse(w http.ResponseWriter) error
+}
+
+type StartInstance200JSONResponse Instance
+
+func (response StartInstance200JSONResponse) VisitStartInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteImageRequestObject struct {
-	Name string `json:"name"`
-}
-
-type DeleteImageResponseObject interface {
-	VisitDeleteImageResponse(w http.ResponseWriter) error
-}
+type StartInstance404JSONResponse Error
 
-type DeleteImage204Response struct {
-}
+func (response StartInstance404JSONResponse) VisitStartInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-func (response DeleteImage204Response) VisitDeleteImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteImage404JSONResponse Error
+type StartInstance409JSONResponse Error
 
-func (response DeleteImage404JSONResponse) VisitDeleteImageResponse(w http.ResponseWriter) error {
+func (response StartInstance409JSONResponse) VisitStartInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteImage500JSONResponse Error
+type StartInstance500JSONResponse Error
 
-func (response DeleteImage500JSONResponse) VisitDeleteImageResponse(w http.ResponseWriter) error {
+func (response StartInstance500JSONResponse) VisitStartInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetImageRequestObject struct {
-	Name string `json:"name"`
+type StatInstancePathRequestObject struct {
+	Id     string `json:"id"`
+	Params StatInstancePathParams
 }
 
-type GetImageResponseObject interface {
-	VisitGetImageResponse(w http.ResponseWriter) error
+type StatInstancePathResponseObject interface {
+	VisitStatInstancePathResponse(w http.ResponseWriter) error
 }
 
-type GetImage200JSONResponse Image
+type StatInstancePath200JSONResponse PathInfo
 
-func (response GetImage200JSONResponse) VisitGetImageResponse(w http.ResponseWriter) error {
+func (response StatInstancePath200JSONResponse) VisitStatInstancePathResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetImage404JSONResponse Error
+type StatInstancePath404JSONResponse Error
 
-func (response GetImage404JSONResponse) VisitGetImageResponse(w http.ResponseWriter) error {
+func (response StatInstancePath404JSONResponse) VisitStatInstancePathResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetImage500JSONResponse Error
+type StatInstancePath409JSONResponse Error
 
-func (response GetImage500JSONResponse) VisitGetImageResponse(w http.ResponseWriter) error {
+func (response StatInstancePath409JSONResponse) VisitStatInstancePathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StatInstancePath500JSONResponse Error
+
+func (response StatInstancePath500JSONResponse) VisitStatInstancePathResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListIngressesRequestObject struct {
+type StopInstanceRequestObject struct {
+	Id string `json:"id"`
 }
 
-type ListIngressesResponseObject interface {
-	VisitListIngressesResponse(w http.ResponseWriter) error
+type StopInstanceResponseObject interface {
+	VisitStopInstanceResponse(w http.ResponseWriter) error
 }
 
-type ListIngresses200JSONResponse []Ingress
+type StopInstance200JSONResponse Instance
 
-func (response ListIngresses200JSONResponse) VisitListIngressesResponse(w http.ResponseWriter) error {
+func (response StopInstance200JSONResponse) VisitStopInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListIngresses401JSONResponse Error
+type StopInstance404JSONResponse Error
 
-func (response ListIngresses401JSONResponse) VisitListIngressesResponse(w http.ResponseWriter) error {
+func (response StopInstance404JSONResponse) VisitStopInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListIngresses500JSONResponse Error
+type StopInstance409JSONResponse Error
 
-func (response ListIngresses500JSONResponse) VisitListIngressesResponse(w http.ResponseWriter) error {
+func (response StopInstance409JSONResponse) VisitStopInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateIngressRequestObject struct {
-	Body *CreateIngressJSONRequestBody
-}
-
-type CreateIngressResponseObject interface {
-	VisitCreateIngressResponse(w http.ResponseWriter) error
-}
-
-type CreateIngress201JSONResponse Ingress
+type StopInstance500JSONResponse Error
 
-func (response CreateIngress201JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+func (response StopInstance500JSONResponse) VisitStopInstanceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateIngress400JSONResponse Error
+type SyncInstanceTimeRequestObject struct {
+	Id string `json:"id"`
+}
 
-func (response CreateIngress400JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+type SyncInstanceTimeResponseObject interface {
+	VisitSyncInstanceTimeResponse(w http.ResponseWriter) error
+}
+
+type SyncInstanceTime200JSONResponse TimeSync
+
+func (response SyncInstanceTime200JSONResponse) VisitSyncInstanceTimeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateIngress401JSONResponse Error
+type SyncInstanceTime404JSONResponse Error
 
-func (response CreateIngress401JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+func (response SyncInstanceTime404JSONResponse) VisitSyncInstanceTimeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateIngress409JSONResponse Error
+type SyncInstanceTime409JSONResponse Error
 
-func (response CreateIngress409JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+func (response SyncInstanceTime409JSONResponse) VisitSyncInstanceTimeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateIngress500JSONResponse Error
+type SyncInstanceTime500JSONResponse Error
 
-func (response CreateIngress500JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+func (response SyncInstanceTime500JSONResponse) VisitSyncInstanceTimeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteIngressRequestObject struct {
-	Id string `json:"id"`
+type DetachVolumeRequestObject struct {
+	Id       string `json:"id"`
+	VolumeId string `json:"volumeId"`
 }
 
-type DeleteIngressResponseObject interface {
-	VisitDeleteIngressResponse(w http.ResponseWriter) error
+type DetachVolumeResponseObject interface {
+	VisitDetachVolumeResponse(w http.ResponseWriter) error
 }
 
-type DeleteIngress204Response struct {
+type DetachVolume200JSONResponse Instance
+
+func (response DetachVolume200JSONResponse) VisitDetachVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response DeleteIngress204Response) VisitDeleteIngressResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type DetachVolume400JSONResponse Error
+
+func (response DetachVolume400JSONResponse) VisitDetachVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteIngress404JSONResponse Error
+type DetachVolume404JSONResponse Error
 
-func (response DeleteIngress404JSONResponse) VisitDeleteIngressResponse(w http.ResponseWriter) error {
+func (response DetachVolume404JSONResponse) VisitDetachVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteIngress409JSONResponse Error
+type DetachVolume409JSONResponse Error
 
-func (response DeleteIngress409JSONResponse) VisitDeleteIngressResponse(w http.ResponseWriter) error {
+func (response DetachVolume409JSONResponse) VisitDetachVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteIngress500JSONResponse Error
+type DetachVolume500JSONResponse Error
 
-func (response DeleteIngress500JSONResponse) VisitDeleteIngressResponse(w http.ResponseWriter) error {
+func (response DetachVolume500JSONResponse) VisitDetachVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetIngressRequestObject struct {
-	Id string `json:"id"`
+type AttachVolumeRequestObject struct {
+	Id       string `json:"id"`
+	VolumeId string `json:"volumeId"`
+	Body     *AttachVolumeJSONRequestBody
 }
 
-type GetIngressResponseObject interface {
-	VisitGetIngressResponse(w http.ResponseWriter) error
+type AttachVolumeResponseObject interface {
+	VisitAttachVolumeResponse(w http.ResponseWriter) error
 }
 
-type GetIngress200JSONResponse Ingress
+type AttachVolume200JSONResponse Instance
 
-func (response GetIngress200JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
+func (response AttachVolume200JSONResponse) VisitAttachVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetIngress404JSONResponse Error
+type AttachVolume400JSONResponse Error
 
-func (response GetIngress404JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
+func (response AttachVolume400JSONResponse) VisitAttachVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AttachVolume404JSONResponse Error
+
+func (response AttachVolume404JSONResponse) VisitAttachVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetIngress409JSONResponse Error
+type AttachVolume409JSONResponse Error
 
-func (response GetIngress409JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
+func (response AttachVolume409JSONResponse) VisitAttachVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetIngress500JSONResponse Error
+type AttachVolume500JSONResponse Error
 
-func (response GetIngress500JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
+func (response AttachVolume500JSONResponse) VisitAttachVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListInstancesRequestObject struct {
+type GetResourcesRequestObject struct {
 }
 
-type ListInstancesResponseObject interface {
-	VisitListInstancesResponse(w http.ResponseWriter) error
+type GetResourcesResponseObject interface {
+	VisitGetResourcesResponse(w http.ResponseWriter) error
 }
 
-type ListInstances200JSONResponse []Instance
+type GetResources200JSONResponse Resources
 
-func (response ListInstances200JSONResponse) VisitListInstancesResponse(w http.ResponseWriter) error {
+func (response GetResources200JSONResponse) VisitGetResourcesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListInstances401JSONResponse Error
+type GetResources500JSONResponse Error
 
-func (response ListInstances401JSONResponse) VisitListInstancesResponse(w http.ResponseWriter) error {
+func (response GetResources500JSONResponse) VisitGetResourcesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListInstances500JSONResponse Error
-
-func (response ListInstances500JSONResponse) VisitListInstancesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+type ListVolumesRequestObject struct {
+	Params ListVolumesParams
 }
 
-type CreateInstanceRequestObject struct {
-	Body *CreateInstanceJSONRequestBody
+type ListVolumesResponseObject interface {
+	VisitListVolumesResponse(w http.ResponseWriter) error
 }
 
-type CreateInstanceResponseObject interface {
-	VisitCreateInstanceResponse(w http.ResponseWriter) error
+type ListVolumes200ResponseHeaders struct {
+	XNextCursor string
+	XTotalCount int
 }
 
-type CreateInstance201JSONResponse Instance
+type ListVolumes200JSONResponse struct {
+	Body    []Volume
+	Headers ListVolumes200ResponseHeaders
+}
 
-func (response CreateInstance201JSONResponse) VisitCreateInstanceResponse(w http.ResponseWriter) error {
+func (response ListVolumes200JSONResponse) VisitListVolumesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateInstance400JSONResponse Error
+type ListVolumes400JSONResponse Error
 
-func (response CreateInstance400JSONResponse) VisitCreateInstanceResponse(w http.ResponseWriter) error {
+func (response ListVolumes400JSONResponse) VisitListVolumesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInstance401JSONResponse Error
+type ListVolumes401JSONResponse Error
 
-func (response CreateInstance401JSONResponse) VisitCreateInstanceResponse(w http.ResponseWriter) error {
+func (response ListVolumes401JSONResponse) VisitListVolumesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInstance500JSONResponse Error
+type ListVolumes500JSONResponse Error
 
-func (response CreateInstance500JSONResponse) VisitCreateInstanceResponse(w http.ResponseWriter) error {
+func (response ListVolumes500JSONResponse) VisitListVolumesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteInstanceRequestObject struct {
-	Id string `json:"id"`
+type CreateVolumeRequestObject struct {
+	JSONBody      *CreateVolumeJSONRequestBody
+	MultipartBody *multipart.Reader
 }
 
-type DeleteInstanceResponseObject interface {
-	VisitDeleteInstanceResponse(w http.ResponseWriter) error
+type CreateVolumeResponseObject interface {
+	VisitCreateVolumeResponse(w http.ResponseWriter) error
 }
 
-type DeleteInstance204Response struct {
+type CreateVolume201JSONResponse Volume
+
+func (response CreateVolume201JSONResponse) VisitCreateVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response DeleteInstance204Response) VisitDeleteInstanceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type CreateVolume400JSONResponse Error
+
+func (response CreateVolume400JSONResponse) VisitCreateVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteInstance404JSONResponse Error
+type CreateVolume401JSONResponse Error
 
-func (response DeleteInstance404JSONResponse) VisitDeleteInstanceResponse(w http.ResponseWriter) error {
+func (response CreateVolume401JSONResponse) VisitCreateVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteInstance500JSONResponse Error
+type CreateVolume409JSONResponse Error
+
+func (response CreateVolume409JSONResponse) VisitCreateVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVolume500JSONResponse Error
 
-func (response DeleteInstance500JSONResponse) VisitDeleteInstanceResponse(w http.ResponseWriter) error {
+func (response CreateVolume500JSONResponse) VisitCreateVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInstanceRequestObject struct {
+type DeleteVolumeRequestObject struct {
 	Id string `json:"id"`
 }
 
-type GetInstanceResponseObject interface {
-	VisitGetInstanceResponse(w http.ResponseWriter) error
+type DeleteVolumeResponseObject interface {
+	VisitDeleteVolumeResponse(w http.ResponseWriter) error
 }
 
-type GetInstance200JSONResponse Instance
+type DeleteVolume204Response struct {
+}
 
-func (response GetInstance200JSONResponse) VisitGetInstanceResponse(w http.ResponseWriter) error {
+func (response DeleteVolume204Response) VisitDeleteVolumeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteVolume404JSONResponse Error
+
+func (response DeleteVolume404JSONResponse) VisitDeleteVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInstance404JSONResponse Error
+type DeleteVolume409JSONResponse Error
 
-func (response GetInstance404JSONResponse) VisitGetInstanceResponse(w http.ResponseWriter) error {
+func (response DeleteVolume409JSONResponse) VisitDeleteVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInstance500JSONResponse Error
+type DeleteVolume500JSONResponse Error
 
-func (response GetInstance500JSONResponse) VisitGetInstanceResponse(w http.ResponseWriter) error {
+func (response DeleteVolume500JSONResponse) VisitDeleteVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInstanceLogsRequestObject struct {
-	Id     string `json:"id"`
-	Params GetInstanceLogsParams
+type GetVolumeRequestObject struct {
+	Id string `json:"id"`
 }
 
-type GetInstanceLogsResponseObject interface {
-	VisitGetInstanceLogsResponse(w http.ResponseWriter) error
+type GetVolumeResponseObject interface {
+	VisitGetVolumeResponse(w http.ResponseWriter) error
 }
 
-type GetInstanceLogs200TexteventStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+type GetVolume200JSONResponse Volume
 
-func (response GetInstanceLogs200TexteventStreamResponse) VisitGetInstanceLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/event-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
+func (response GetVolume200JSONResponse) VisitGetVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInstanceLogs404JSONResponse Error
+type GetVolume404JSONResponse Error
 
-func (response GetInstanceLogs404JSONResponse) VisitGetInstanceLogsResponse(w http.ResponseWriter) error {
+func (response GetVolume404JSONResponse) VisitGetVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInstanceLogs500JSONResponse Error
+type GetVolume500JSONResponse Error
 
-func (response GetInstanceLogs500JSONResponse) VisitGetInstanceLogsResponse(w http.ResponseWriter) error {
+func (response GetVolume500JSONResponse) VisitGetVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RestoreInstanceRequestObject struct {
-	Id string `json:"id"`
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Verify and decode a token
+	// (POST /admin/auth/verify)
+	VerifyToken(ctx context.Context, request VerifyTokenRequestObject) (VerifyTokenResponseObject, error)
+	// Get drain status
+	// (GET /admin/drain)
+	GetDrainStatus(ctx context.Context, request GetDrainStatusRequestObject) (GetDrainStatusResponseObject, error)
+	// Start or stop draining the host
+	// (POST /admin/drain)
+	SetDrain(ctx context.Context, request SetDrainRequestObject) (SetDrainResponseObject, error)
+	// List API keys
+	// (GET /api-keys)
+	ListApiKeys(ctx context.Context, request ListApiKeysRequestObject) (ListApiKeysResponseObject, error)
+	// Create an API key
+	// (POST /api-keys)
+	CreateApiKey(ctx context.Context, request CreateApiKeyRequestObject) (CreateApiKeyResponseObject, error)
+	// Revoke API key
+	// (DELETE /api-keys/{id})
+	RevokeApiKey(ctx context.Context, request RevokeApiKeyRequestObject) (RevokeApiKeyResponseObject, error)
+	// Get API key details
+	// (GET /api-keys/{id})
+	GetApiKey(ctx context.Context, request GetApiKeyRequestObject) (GetApiKeyResponseObject, error)
+	// List builds
+	// (GET /builds)
+	ListBuilds(ctx context.Context, request ListBuildsRequestObject) (ListBuildsResponseObject, error)
+	// Create a new build
+	// (POST /builds)
+	CreateBuild(ctx context.Context, request CreateBuildRequestObject) (CreateBuildResponseObject, error)
+	// Get build queue stats
+	// (GET /builds/queue)
+	GetBuildQueue(ctx context.Context, request GetBuildQueueRequestObject) (GetBuildQueueResponseObject, error)
+	// Cancel build
+	// (DELETE /builds/{id})
+	CancelBuild(ctx context.Context, request CancelBuildRequestObject) (CancelBuildResponseObject, error)
+	// Get build details
+	// (GET /builds/{id})
+	GetBuild(ctx context.Context, request GetBuildRequestObject) (GetBuildResponseObject, error)
+	// Download build artifact
+	// (GET /builds/{id}/artifact)
+	GetBuildArtifact(ctx context.Context, request GetBuildArtifactRequestObject) (GetBuildArtifactResponseObject, error)
+	// Cancel build
+	// (POST /builds/{id}/cancel)
+	RequestBuildCancel(ctx context.Context, request RequestBuildCancelRequestObject) (RequestBuildCancelResponseObject, error)
+	// Stream build events (SSE)
+	// (GET /builds/{id}/events)
+	GetBuildEvents(ctx context.Context, request GetBuildEventsRequestObject) (GetBuildEventsResponseObject, error)
+	// List registered devices
+	// (GET /devices)
+	ListDevices(ctx context.Context, request ListDevicesRequestObject) (ListDevicesResponseObject, error)
+	// Register a device for passthrough
+	// (POST /devices)
+	CreateDevice(ctx context.Context, request CreateDeviceRequestObject) (CreateDeviceResponseObject, error)
+	// Discover passthrough-capable devices on host
+	// (GET /devices/available)
+	ListAvailableDevices(ctx context.Context, request ListAvailableDevicesRequestObject) (ListAvailableDevicesResponseObject, error)
+	// List device pools
+	// (GET /devices/pools)
+	ListDevicePools(ctx context.Context, request ListDevicePoolsRequestObject) (ListDevicePoolsResponseObject, error)
+	// Unregister device
+	// (DELETE /devices/{id})
+	DeleteDevice(ctx context.Context, request DeleteDeviceRequestObject) (DeleteDeviceResponseObject, error)
+	// Get device details
+	// (GET /devices/{id})
+	GetDevice(ctx context.Context, request GetDeviceRequestObject) (GetDeviceResponseObject, error)
+	// Health check
+	// (GET /health)
+	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// Get host CPU topology
+	// (GET /host/topology)
+	GetHostTopology(ctx context.Context, request GetHostTopologyRequestObject) (GetHostTopologyResponseObject, error)
+	// List supported hypervisors
+	// (GET /hypervisors)
+	ListHypervisors(ctx context.Context, request ListHypervisorsRequestObject) (ListHypervisorsResponseObject, error)
+	// List images
+	// (GET /images)
+	ListImages(ctx context.Context, request ListImagesRequestObject) (ListImagesResponseObject, error)
+	// Pull and convert OCI image
+	// (POST /images)
+	CreateImage(ctx context.Context, request CreateImageRequestObject) (CreateImageResponseObject, error)
+	// Garbage collect unused images
+	// (POST /images/gc)
+	CollectImageGarbage(ctx context.Context, request CollectImageGarbageRequestObject) (CollectImageGarbageResponseObject, error)
+	// Delete image
+	// (DELETE /images/{name})
+	DeleteImage(ctx context.Context, request DeleteImageRequestObject) (DeleteImageResponseObject, error)
+	// Get image details
+	// (GET /images/{name})
+	GetImage(ctx context.Context, request GetImageRequestObject) (GetImageResponseObject, error)
+	// Stream image pull progress (SSE)
+	// (GET /images/{name}/events)
+	GetImageEvents(ctx context.Context, request GetImageEventsRequestObject) (GetImageEventsResponseObject, error)
+	// List ingresses
+	// (GET /ingresses)
+	ListIngresses(ctx context.Context, request ListIngressesRequestObject) (ListIngressesResponseObject, error)
+	// Create ingress
+	// (POST /ingresses)
+	CreateIngress(ctx context.Context, request CreateIngressRequestObject) (CreateIngressResponseObject, error)
+	// Clear the default backend
+	// (DELETE /ingresses/default-backend)
+	ClearIngressDefaultBackend(ctx context.Context, request ClearIngressDefaultBackendRequestObject) (ClearIngressDefaultBackendResponseObject, error)
+	// Get the default backend
+	// (GET /ingresses/default-backend)
+	GetIngressDefaultBackend(ctx context.Context, request GetIngressDefaultBackendRequestObject) (GetIngressDefaultBackendResponseObject, error)
+	// Set the default backend
+	// (PUT /ingresses/default-backend)
+	SetIngressDefaultBackend(ctx context.Context, request SetIngressDefaultBackendRequestObject) (SetIngressDefaultBackendResponseObject, error)
+	// Resolve an instance through the ingress DNS server
+	// (GET /ingresses/dns)
+	LookupIngressDNS(ctx context.Context, request LookupIngressDNSRequestObject) (LookupIngressDNSResponseObject, error)
+	// Delete ingress
+	// (DELETE /ingresses/{id})
+	DeleteIngress(ctx context.Context, request DeleteIngressRequestObject) (DeleteIngressResponseObject, error)
+	// Get ingress details
+	// (GET /ingresses/{id})
+	GetIngress(ctx context.Context, request GetIngressRequestObject) (GetIngressResponseObject, error)
+	// List instances
+	// (GET /instances)
+	ListInstances(ctx context.Context, request ListInstancesRequestObject) (ListInstancesResponseObject, error)
+	// Create and start instance
+	// (POST /instances)
+	CreateInstance(ctx context.Context, request CreateInstanceRequestObject) (CreateInstanceResponseObject, error)
+	// Create and start a batch of instances
+	// (POST /instances/batch)
+	CreateInstances(ctx context.Context, request CreateInstancesRequestObject) (CreateInstancesResponseObject, error)
+	// Watch instance state changes (SSE)
+	// (GET /instances/events)
+	WatchInstances(ctx context.Context, request WatchInstancesRequestObject) (WatchInstancesResponseObject, error)
+	// List instances preserved on delete
+	// (GET /instances/preserved)
+	ListPreservedSnapshots(ctx context.Context, request ListPreservedSnapshotsRequestObject) (ListPreservedSnapshotsResponseObject, error)
+	// Stop and delete instance
+	// (DELETE /instances/{id})
+	DeleteInstance(ctx context.Context, request DeleteInstanceRequestObject) (DeleteInstanceResponseObject, error)
+	// Get instance details
+	// (GET /instances/{id})
+	GetInstance(ctx context.Context, request GetInstanceRequestObject) (GetInstanceResponseObject, error)
+	// Get the outcome of the last boot
+	// (GET /instances/{id}/boot-status)
+	GetInstanceBootStatus(ctx context.Context, request GetInstanceBootStatusRequestObject) (GetInstanceBootStatusResponseObject, error)
+	// Clone an instance
+	// (POST /instances/{id}/clone)
+	CloneInstance(ctx context.Context, request CloneInstanceRequestObject) (CloneInstanceResponseObject, error)
+	// Reclaim unused space in the instance's overlay disk
+	// (POST /instances/{id}/compact)
+	CompactInstanceOverlay(ctx context.Context, request CompactInstanceOverlayRequestObject) (CompactInstanceOverlayResponseObject, error)
+	// Hot-unplug a device from a running instance
+	// (DELETE /instances/{id}/devices/{deviceId})
+	DetachInstanceDevice(ctx context.Context, request DetachInstanceDeviceRequestObject) (DetachInstanceDeviceResponseObject, error)
+	// Hotplug a device into a running instance
+	// (POST /instances/{id}/devices/{deviceId})
+	AttachInstanceDevice(ctx context.Context, request AttachInstanceDeviceRequestObject) (AttachInstanceDeviceResponseObject, error)
+	// List exec sessions
+	// (GET /instances/{id}/exec-sessions)
+	ListExecSessions(ctx context.Context, request ListExecSessionsRequestObject) (ListExecSessionsResponseObject, error)
+	// Download an exec session recording
+	// (GET /instances/{id}/exec-sessions/{sessionId}/recording)
+	GetExecSessionRecording(ctx context.Context, request GetExecSessionRecordingRequestObject) (GetExecSessionRecordingResponseObject, error)
+	// Run a command and wait for its output
+	// (POST /instances/{id}/exec/run)
+	RunInstanceCommand(ctx context.Context, request RunInstanceCommandRequestObject) (RunInstanceCommandResponseObject, error)
+	// Read a file from the guest
+	// (GET /instances/{id}/files)
+	GetInstanceFile(ctx context.Context, request GetInstanceFileRequestObject) (GetInstanceFileResponseObject, error)
+	// Stream instance logs (SSE)
+	// (GET /instances/{id}/logs)
+	GetInstanceLogs(ctx context.Context, request GetInstanceLogsRequestObject) (GetInstanceLogsResponseObject, error)
+	// List guest processes and resource usage
+	// (GET /instances/{id}/processes)
+	ListInstanceProcesses(ctx context.Context, request ListInstanceProcessesRequestObject) (ListInstanceProcessesResponseObject, error)
+	// Discard changes to the instance's overlay disk
+	// (POST /instances/{id}/reset-overlay)
+	ResetInstanceOverlay(ctx context.Context, request ResetInstanceOverlayRequestObject) (ResetInstanceOverlayResponseObject, error)
+	// Restore instance from standby
+	// (POST /instances/{id}/restore)
+	RestoreInstance(ctx context.Context, request RestoreInstanceRequestObject) (RestoreInstanceResponseObject, error)
+	// Put instance in standby (pause, snapshot, delete VMM)
+	// (POST /instances/{id}/standby)
+	StandbyInstance(ctx context.Context, request StandbyInstanceRequestObject) (StandbyInstanceResponseObject, error)
+	// Start a stopped instance
+	// (POST /instances/{id}/start)
+	StartInstance(ctx context.Context, request StartInstanceRequestObject) (StartInstanceResponseObject, error)
+	// Get filesystem path info
+	// (GET /instances/{id}/stat)
+	StatInstancePath(ctx context.Context, request StatInstancePathRequestObject) (StatInstancePathResponseObject, error)
+	// Stop instance (graceful shutdown)
+	// (POST /instances/{id}/stop)
+	StopInstance(ctx context.Context, request StopInstanceRequestObject) (StopInstanceResponseObject, error)
+	// Set the guest clock from the host
+	// (POST /instances/{id}/synctime)
+	SyncInstanceTime(ctx context.Context, request SyncInstanceTimeRequestObject) (SyncInstanceTimeResponseObject, error)
+	// Detach volume from instance
+	// (DELETE /instances/{id}/volumes/{volumeId})
+	DetachVolume(ctx context.Context, request DetachVolumeRequestObject) (DetachVolumeResponseObject, error)
+	// Attach volume to instance
+	// (POST /instances/{id}/volumes/{volumeId})
+	AttachVolume(ctx context.Context, request AttachVolumeRequestObject) (AttachVolumeResponseObject, error)
+	// Get host resource capacity and allocations
+	// (GET /resources)
+	GetResources(ctx context.Context, request GetResourcesRequestObject) (GetResourcesResponseObject, error)
+	// List volumes
+	// (GET /volumes)
+	ListVolumes(ctx context.Context, request ListVolumesRequestObject) (ListVolumesResponseObject, error)
+	// Create volume
+	// (POST /volumes)
+	CreateVolume(ctx context.Context, request CreateVolumeRequestObject) (CreateVolumeResponseObject, error)
+	// Delete volume
+	// (DELETE /volumes/{id})
+	DeleteVolume(ctx context.Context, request DeleteVolumeRequestObject) (DeleteVolumeResponseObject, error)
+	// Get volume details
+	// (GET /volumes/{id})
+	GetVolume(ctx context.Context, request GetVolumeRequestObject) (GetVolumeResponseObject, error)
 }
 
-type RestoreInstanceResponseObject interface {
-	VisitRestoreInstanceResponse(w http.ResponseWriter) error
-}
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-type RestoreInstance200JSONResponse Instance
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
 
-func (response RestoreInstance200JSONResponse) VisitRestoreInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
 
-	return json.NewEncoder(w).Encode(response)
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type RestoreInstance404JSONResponse Error
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
 
-func (response RestoreInstance404JSONResponse) VisitRestoreInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// VerifyToken operation middleware
+func (sh *strictHandler) VerifyToken(w http.ResponseWriter, r *http.Request) {
+	var request VerifyTokenRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body VerifyTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type RestoreInstance409JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyToken(ctx, request.(VerifyTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyToken")
+	}
 
-func (response RestoreInstance409JSONResponse) VisitRestoreInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyTokenResponseObject); ok {
+		if err := validResponse.VisitVerifyTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RestoreInstance500JSONResponse Error
+// GetDrainStatus operation middleware
+func (sh *strictHandler) GetDrainStatus(w http.ResponseWriter, r *http.Request) {
+	var request GetDrainStatusRequestObject
 
-func (response RestoreInstance500JSONResponse) VisitRestoreInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDrainStatus(ctx, request.(GetDrainStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDrainStatus")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDrainStatusResponseObject); ok {
+		if err := validResponse.VisitGetDrainStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StandbyInstanceRequestObject struct {
-	Id string `json:"id"`
-}
+// SetDrain operation middleware
+func (sh *strictHandler) SetDrain(w http.ResponseWriter, r *http.Request) {
+	var request SetDrainRequestObject
 
-type StandbyInstanceResponseObject interface {
-	VisitStandbyInstanceResponse(w http.ResponseWriter) error
-}
+	var body SetDrainJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type StandbyInstance200JSONResponse Instance
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetDrain(ctx, request.(SetDrainRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetDrain")
+	}
 
-func (response StandbyInstance200JSONResponse) VisitStandbyInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetDrainResponseObject); ok {
+		if err := validResponse.VisitSetDrainResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StandbyInstance404JSONResponse Error
+// ListApiKeys operation middleware
+func (sh *strictHandler) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+	var request ListApiKeysRequestObject
 
-func (response StandbyInstance404JSONResponse) VisitStandbyInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListApiKeys(ctx, request.(ListApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListApiKeys")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type StandbyInstance409JSONResponse Error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListApiKeysResponseObject); ok {
+		if err := validResponse.VisitListApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response StandbyInstance409JSONResponse) VisitStandbyInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// CreateApiKey operation middleware
+func (sh *strictHandler) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	var request CreateApiKeyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateApiKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type StandbyInstance500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateApiKey(ctx, request.(CreateApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateApiKey")
+	}
 
-func (response StandbyInstance500JSONResponse) VisitStandbyInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateApiKeyResponseObject); ok {
+		if err := validResponse.VisitCreateApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StartInstanceRequestObject struct {
-	Id string `json:"id"`
-}
+// RevokeApiKey operation middleware
+func (sh *strictHandler) RevokeApiKey(w http.ResponseWriter, r *http.Request, id string) {
+	var request RevokeApiKeyRequestObject
 
-type StartInstanceResponseObject interface {
-	VisitStartInstanceResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type StartInstance200JSONResponse Instance
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeApiKey(ctx, request.(RevokeApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeApiKey")
+	}
 
-func (response StartInstance200JSONResponse) VisitStartInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeApiKeyResponseObject); ok {
+		if err := validResponse.VisitRevokeApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StartInstance404JSONResponse Error
-
-func (response StartInstance404JSONResponse) VisitStartInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetApiKey operation middleware
+func (sh *strictHandler) GetApiKey(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetApiKeyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type StartInstance409JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiKey(ctx, request.(GetApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiKey")
+	}
 
-func (response StartInstance409JSONResponse) VisitStartInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiKeyResponseObject); ok {
+		if err := validResponse.VisitGetApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StartInstance500JSONResponse Error
+// ListBuilds operation middleware
+func (sh *strictHandler) ListBuilds(w http.ResponseWriter, r *http.Request, params ListBuildsParams) {
+	var request ListBuildsRequestObject
 
-func (response StartInstance500JSONResponse) VisitStartInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBuilds(ctx, request.(ListBuildsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBuilds")
+	}
 
-type StatInstancePathRequestObject struct {
-	Id     string `json:"id"`
-	Params StatInstancePathParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type StatInstancePathResponseObject interface {
-	VisitStatInstancePathResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListBuildsResponseObject); ok {
+		if err := validResponse.VisitListBuildsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StatInstancePath200JSONResponse PathInfo
-
-func (response StatInstancePath200JSONResponse) VisitStatInstancePathResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// CreateBuild operation middleware
+func (sh *strictHandler) CreateBuild(w http.ResponseWriter, r *http.Request) {
+	var request CreateBuildRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
 
-type StatInstancePath404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateBuild(ctx, request.(CreateBuildRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateBuild")
+	}
 
-func (response StatInstancePath404JSONResponse) VisitStatInstancePathResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateBuildResponseObject); ok {
+		if err := validResponse.VisitCreateBuildResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StatInstancePath409JSONResponse Error
+// GetBuildQueue operation middleware
+func (sh *strictHandler) GetBuildQueue(w http.ResponseWriter, r *http.Request) {
+	var request GetBuildQueueRequestObject
 
-func (response StatInstancePath409JSONResponse) VisitStatInstancePathResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBuildQueue(ctx, request.(GetBuildQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBuildQueue")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBuildQueueResponseObject); ok {
+		if err := validResponse.VisitGetBuildQueueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StatInstancePath500JSONResponse Error
+// CancelBuild operation middleware
+func (sh *strictHandler) CancelBuild(w http.ResponseWriter, r *http.Request, id string) {
+	var request CancelBuildRequestObject
 
-func (response StatInstancePath500JSONResponse) VisitStatInstancePathResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelBuild(ctx, request.(CancelBuildRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelBuild")
+	}
 
-type StopInstanceRequestObject struct {
-	Id string `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type StopInstanceResponseObject interface {
-	VisitStopInstanceResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelBuildResponseObject); ok {
+		if err := validResponse.VisitCancelBuildResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StopInstance200JSONResponse Instance
+// GetBuild operation middleware
+func (sh *strictHandler) GetBuild(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetBuildRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBuild(ctx, request.(GetBuildRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBuild")
+	}
 
-func (response StopInstance200JSONResponse) VisitStopInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBuildResponseObject); ok {
+		if err := validResponse.VisitGetBuildResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StopInstance404JSONResponse Error
-
-func (response StopInstance404JSONResponse) VisitStopInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetBuildArtifact operation middleware
+func (sh *strictHandler) GetBuildArtifact(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetBuildArtifactRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type StopInstance409JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBuildArtifact(ctx, request.(GetBuildArtifactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBuildArtifact")
+	}
 
-func (response StopInstance409JSONResponse) VisitStopInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBuildArtifactResponseObject); ok {
+		if err := validResponse.VisitGetBuildArtifactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StopInstance500JSONResponse Error
+// RequestBuildCancel operation middleware
+func (sh *strictHandler) RequestBuildCancel(w http.ResponseWriter, r *http.Request, id string) {
+	var request RequestBuildCancelRequestObject
 
-func (response StopInstance500JSONResponse) VisitStopInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestBuildCancel(ctx, request.(RequestBuildCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestBuildCancel")
+	}
 
-type DetachVolumeRequestObject struct {
-	Id       string `json:"id"`
-	VolumeId string `json:"volumeId"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DetachVolumeResponseObject interface {
-	VisitDetachVolumeResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestBuildCancelResponseObject); ok {
+		if err := validResponse.VisitRequestBuildCancelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DetachVolume200JSONResponse Instance
-
-func (response DetachVolume200JSONResponse) VisitDetachVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetBuildEvents operation middleware
+func (sh *strictHandler) GetBuildEvents(w http.ResponseWriter, r *http.Request, id string, params GetBuildEventsParams) {
+	var request GetBuildEventsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.Params = params
 
-type DetachVolume404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBuildEvents(ctx, request.(GetBuildEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBuildEvents")
+	}
 
-func (response DetachVolume404JSONResponse) VisitDetachVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBuildEventsResponseObject); ok {
+		if err := validResponse.VisitGetBuildEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DetachVolume500JSONResponse Error
+// ListDevices operation middleware
+func (sh *strictHandler) ListDevices(w http.ResponseWriter, r *http.Request, params ListDevicesParams) {
+	var request ListDevicesRequestObject
 
-func (response DetachVolume500JSONResponse) VisitDetachVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDevices(ctx, request.(ListDevicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDevices")
+	}
 
-type AttachVolumeRequestObject struct {
-	Id       string `json:"id"`
-	VolumeId string `json:"volumeId"`
-	Body     *AttachVolumeJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type AttachVolumeResponseObject interface {
-	VisitAttachVolumeResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDevicesResponseObject); ok {
+		if err := validResponse.VisitListDevicesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AttachVolume200JSONResponse Instance
-
-func (response AttachVolume200JSONResponse) VisitAttachVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// CreateDevice operation middleware
+func (sh *strictHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
+	var request CreateDeviceRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateDeviceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type AttachVolume404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateDevice(ctx, request.(CreateDeviceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateDevice")
+	}
 
-func (response AttachVolume404JSONResponse) VisitAttachVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateDeviceResponseObject); ok {
+		if err := validResponse.VisitCreateDeviceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AttachVolume409JSONResponse Error
+// ListAvailableDevices operation middleware
+func (sh *strictHandler) ListAvailableDevices(w http.ResponseWriter, r *http.Request) {
+	var request ListAvailableDevicesRequestObject
 
-func (response AttachVolume409JSONResponse) VisitAttachVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAvailableDevices(ctx, request.(ListAvailableDevicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAvailableDevices")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAvailableDevicesResponseObject); ok {
+		if err := validResponse.VisitListAvailableDevicesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AttachVolume500JSONResponse Error
+// ListDevicePools operation middleware
+func (sh *strictHandler) ListDevicePools(w http.ResponseWriter, r *http.Request) {
+	var request ListDevicePoolsRequestObject
 
-func (response AttachVolume500JSONResponse) VisitAttachVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDevicePools(ctx, request.(ListDevicePoolsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDevicePools")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetResourcesRequestObject struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDevicePoolsResponseObject); ok {
+		if err := validResponse.VisitListDevicePoolsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetResourcesResponseObject interface {
-	VisitGetResourcesResponse(w http.ResponseWriter) error
-}
+// DeleteDevice operation middleware
+func (sh *strictHandler) DeleteDevice(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteDeviceRequestObject
 
-type GetResources200JSONResponse Resources
+	request.Id = id
 
-func (response GetResources200JSONResponse) VisitGetResourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDevice(ctx, request.(DeleteDeviceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDevice")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDeviceResponseObject); ok {
+		if err := validResponse.VisitDeleteDeviceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetResources500JSONResponse Error
+// GetDevice operation middleware
+func (sh *strictHandler) GetDevice(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetDeviceRequestObject
 
-func (response GetResources500JSONResponse) VisitGetResourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDevice(ctx, request.(GetDeviceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDevice")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-type ListVolumesRequestObject struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDeviceResponseObject); ok {
+		if err := validResponse.VisitGetDeviceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListVolumesResponseObject interface {
-	VisitListVolumesResponse(w http.ResponseWriter) error
-}
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
 
-type ListVolumes200JSONResponse []Volume
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
 
-func (response ListVolumes200JSONResponse) VisitListVolumesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListVolumes401JSONResponse Error
-
-func (response ListVolumes401JSONResponse) VisitListVolumesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetHostTopology operation middleware
+func (sh *strictHandler) GetHostTopology(w http.ResponseWriter, r *http.Request, params GetHostTopologyParams) {
+	var request GetHostTopologyRequestObject
 
-type ListVolumes500JSONResponse Error
+	request.Params = params
 
-func (response ListVolumes500JSONResponse) VisitListVolumesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHostTopology(ctx, request.(GetHostTopologyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHostTopology")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateVolumeRequestObject struct {
-	JSONBody      *CreateVolumeJSONRequestBody
-	MultipartBody *multipart.Reader
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHostTopologyResponseObject); ok {
+		if err := validResponse.VisitGetHostTopologyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateVolumeResponseObject interface {
-	VisitCreateVolumeResponse(w http.ResponseWriter) error
-}
+// ListHypervisors operation middleware
+func (sh *strictHandler) ListHypervisors(w http.ResponseWriter, r *http.Request) {
+	var request ListHypervisorsRequestObject
 
-type CreateVolume201JSONResponse Volume
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListHypervisors(ctx, request.(ListHypervisorsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListHypervisors")
+	}
 
-func (response CreateVolume201JSONResponse) VisitCreateVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListHypervisorsResponseObject); ok {
+		if err := validResponse.VisitListHypervisorsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateVolume400JSONResponse Error
+// ListImages operation middleware
+func (sh *strictHandler) ListImages(w http.ResponseWriter, r *http.Request) {
+	var request ListImagesRequestObject
 
-func (response CreateVolume400JSONResponse) VisitCreateVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListImages(ctx, request.(ListImagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListImages")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateVolume401JSONResponse Error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListImagesResponseObject); ok {
+		if err := validResponse.VisitListImagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response CreateVolume401JSONResponse) VisitCreateVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// CreateImage operation middleware
+func (sh *strictHandler) CreateImage(w http.ResponseWriter, r *http.Request) {
+	var request CreateImageRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateImageJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CreateVolume409JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateImage(ctx, request.(CreateImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateImage")
+	}
 
-func (response CreateVolume409JSONResponse) VisitCreateVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateImageResponseObject); ok {
+		if err := validResponse.VisitCreateImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateVolume500JSONResponse Error
+// CollectImageGarbage operation middleware
+func (sh *strictHandler) CollectImageGarbage(w http.ResponseWriter, r *http.Request, params CollectImageGarbageParams) {
+	var request CollectImageGarbageRequestObject
 
-func (response CreateVolume500JSONResponse) VisitCreateVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CollectImageGarbage(ctx, request.(CollectImageGarbageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CollectImageGarbage")
+	}
 
-type DeleteVolumeRequestObject struct {
-	Id string `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteVolumeResponseObject interface {
-	VisitDeleteVolumeResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CollectImageGarbageResponseObject); ok {
+		if err := validResponse.VisitCollectImageGarbageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteVolume204Response struct {
-}
+// DeleteImage operation middleware
+func (sh *strictHandler) DeleteImage(w http.ResponseWriter, r *http.Request, name string) {
+	var request DeleteImageRequestObject
 
-func (response DeleteVolume204Response) VisitDeleteVolumeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	request.Name = name
 
-type DeleteVolume404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteImage(ctx, request.(DeleteImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteImage")
+	}
 
-func (response DeleteVolume404JSONResponse) VisitDeleteVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteImageResponseObject); ok {
+		if err := validResponse.VisitDeleteImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteVolume409JSONResponse Error
-
-func (response DeleteVolume409JSONResponse) VisitDeleteVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// GetImage operation middleware
+func (sh *strictHandler) GetImage(w http.ResponseWriter, r *http.Request, name string) {
+	var request GetImageRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Name = name
 
-type DeleteVolume500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetImage(ctx, request.(GetImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetImage")
+	}
 
-func (response DeleteVolume500JSONResponse) VisitDeleteVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetImageResponseObject); ok {
+		if err := validResponse.VisitGetImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetVolumeRequestObject struct {
-	Id string `json:"id"`
-}
+// GetImageEvents operation middleware
+func (sh *strictHandler) GetImageEvents(w http.ResponseWriter, r *http.Request, name string) {
+	var request GetImageEventsRequestObject
 
-type GetVolumeResponseObject interface {
-	VisitGetVolumeResponse(w http.ResponseWriter) error
-}
+	request.Name = name
 
-type GetVolume200JSONResponse Volume
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetImageEvents(ctx, request.(GetImageEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetImageEvents")
+	}
 
-func (response GetVolume200JSONResponse) VisitGetVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetImageEventsResponseObject); ok {
+		if err := validResponse.VisitGetImageEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetVolume404JSONResponse Error
+// ListIngresses operation middleware
+func (sh *strictHandler) ListIngresses(w http.ResponseWriter, r *http.Request) {
+	var request ListIngressesRequestObject
 
-func (response GetVolume404JSONResponse) VisitGetVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListIngresses(ctx, request.(ListIngressesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListIngresses")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetVolume500JSONResponse Error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListIngressesResponseObject); ok {
+		if err := validResponse.VisitListIngressesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetVolume500JSONResponse) VisitGetVolumeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// CreateIngress operation middleware
+func (sh *strictHandler) CreateIngress(w http.ResponseWriter, r *http.Request) {
+	var request CreateIngressRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateIngressJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List builds
-	// (GET /builds)
-	ListBuilds(ctx context.Context, request ListBuildsRequestObject) (ListBuildsResponseObject, error)
-	// Create a new build
-	// (POST /builds)
-	CreateBuild(ctx context.Context, request CreateBuildRequestObject) (CreateBuildResponseObject, error)
-	// Cancel build
-	// (DELETE /builds/{id})
-	CancelBuild(ctx context.Context, request CancelBuildRequestObject) (CancelBuildResponseObject, error)
-	// Get build details
-	// (GET /builds/{id})
-	GetBuild(ctx context.Context, request GetBuildRequestObject) (GetBuildResponseObject, error)
-	// Stream build events (SSE)
-	// (GET /builds/{id}/events)
-	GetBuildEvents(ctx context.Context, request GetBuildEventsRequestObject) (GetBuildEventsResponseObject, error)
-	// List registered devices
-	// (GET /devices)
-	ListDevices(ctx context.Context, request ListDevicesRequestObject) (ListDevicesResponseObject, error)
-	// Register a device for passthrough
-	// (POST /devices)
-	CreateDevice(ctx context.Context, request CreateDeviceRequestObject) (CreateDeviceResponseObject, error)
-	// Discover passthrough-capable devices on host
-	// (GET /devices/available)
-	ListAvailableDevices(ctx context.Context, request ListAvailableDevicesRequestObject) (ListAvailableDevicesResponseObject, error)
-	// Unregister device
-	// (DELETE /devices/{id})
-	DeleteDevice(ctx context.Context, request DeleteDeviceRequestObject) (DeleteDeviceResponseObject, error)
-	// Get device details
-	// (GET /devices/{id})
-	GetDevice(ctx context.Context, request GetDeviceRequestObject) (GetDeviceResponseObject, error)
-	// Health check
-	// (GET /health)
-	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
-	// List images
-	// (GET /images)
-	ListImages(ctx context.Context, request ListImagesRequestObject) (ListImagesResponseObject, error)
-	// Pull and convert OCI image
-	// (POST /images)
-	CreateImage(ctx context.Context, request CreateImageRequestObject) (CreateImageResponseObject, error)
-	// Delete image
-	// (DELETE /images/{name})
-	DeleteImage(ctx context.Context, request DeleteImageRequestObject) (DeleteImageResponseObject, error)
-	// Get image details
-	// (GET /images/{name})
-	GetImage(ctx context.Context, request GetImageRequestObject) (GetImageResponseObject, error)
-	// List ingresses
-	// (GET /ingresses)
-	ListIngresses(ctx context.Context, request ListIngressesRequestObject) (ListIngressesResponseObject, error)
-	// Create ingress
-	// (POST /ingresses)
-	CreateIngress(ctx context.Context, request CreateIngressRequestObject) (CreateIngressResponseObject, error)
-	// Delete ingress
-	// (DELETE /ingresses/{id})
-	DeleteIngress(ctx context.Context, request DeleteIngressRequestObject) (DeleteIngressResponseObject, error)
-	// Get ingress details
-	// (GET /ingresses/{id})
-	GetIngress(ctx context.Context, request GetIngressRequestObject) (GetIngressResponseObject, error)
-	// List instances
-	// (GET /instances)
-	ListInstances(ctx context.Context, request ListInstancesRequestObject) (ListInstancesResponseObject, error)
-	// Create and start instance
-	// (POST /instances)
-	CreateInstance(ctx context.Context, request CreateInstanceRequestObject) (CreateInstanceResponseObject, error)
-	// Stop and delete instance
-	// (DELETE /instances/{id})
-	DeleteInstance(ctx context.Context, request DeleteInstanceRequestObject) (DeleteInstanceResponseObject, error)
-	// Get instance details
-	// (GET /instances/{id})
-	GetInstance(ctx context.Context, request GetInstanceRequestObject) (GetInstanceResponseObject, error)
-	// Stream instance logs (SSE)
-	// (GET /instances/{id}/logs)
-	GetInstanceLogs(ctx context.Context, request GetInstanceLogsRequestObject) (GetInstanceLogsResponseObject, error)
-	// Restore instance from standby
-	// (POST /instances/{id}/restore)
-	RestoreInstance(ctx context.Context, request RestoreInstanceRequestObject) (RestoreInstanceResponseObject, error)
-	// Put instance in standby (pause, snapshot, delete VMM)
-	// (POST /instances/{id}/standby)
-	StandbyInstance(ctx context.Context, request StandbyInstanceRequestObject) (StandbyInstanceResponseObject, error)
-	// Start a stopped instance
-	// (POST /instances/{id}/start)
-	StartInstance(ctx context.Context, request StartInstanceRequestObject) (StartInstanceResponseObject, error)
-	// Get filesystem path info
-	// (GET /instances/{id}/stat)
-	StatInstancePath(ctx context.Context, request StatInstancePathRequestObject) (StatInstancePathResponseObject, error)
-	// Stop instance (graceful shutdown)
-	// (POST /instances/{id}/stop)
-	StopInstance(ctx context.Context, request StopInstanceRequestObject) (StopInstanceResponseObject, error)
-	// Detach volume from instance
-	// (DELETE /instances/{id}/volumes/{volumeId})
-	DetachVolume(ctx context.Context, request DetachVolumeRequestObject) (DetachVolumeResponseObject, error)
-	// Attach volume to instance
-	// (POST /instances/{id}/volumes/{volumeId})
-	AttachVolume(ctx context.Context, request AttachVolumeRequestObject) (AttachVolumeResponseObject, error)
-	// Get host resource capacity and allocations
-	// (GET /resources)
-	GetResources(ctx context.Context, request GetResourcesRequestObject) (GetResourcesResponseObject, error)
-	// List volumes
-	// (GET /volumes)
-	ListVolumes(ctx context.Context, request ListVolumesRequestObject) (ListVolumesResponseObject, error)
-	// Create volume
-	// (POST /volumes)
-	CreateVolume(ctx context.Context, request CreateVolumeRequestObject) (CreateVolumeResponseObject, error)
-	// Delete volume
-	// (DELETE /volumes/{id})
-	DeleteVolume(ctx context.Context, request DeleteVolumeRequestObject) (DeleteVolumeResponseObject, error)
-	// Get volume details
-	// (GET /volumes/{id})
-	GetVolume(ctx context.Context, request GetVolumeRequestObject) (GetVolumeResponseObject, error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateIngress(ctx, request.(CreateIngressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateIngress")
+	}
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	response, err := handler(r.Context(), w, r, request)
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateIngressResponseObject); ok {
+		if err := validResponse.VisitCreateIngressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+// ClearIngressDefaultBackend operation middleware
+func (sh *strictHandler) ClearIngressDefaultBackend(w http.ResponseWriter, r *http.Request) {
+	var request ClearIngressDefaultBackendRequestObject
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ClearIngressDefaultBackend(ctx, request.(ClearIngressDefaultBackendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClearIngressDefaultBackend")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ClearIngressDefaultBackendResponseObject); ok {
+		if err := validResponse.VisitClearIngressDefaultBackendResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListBuilds operation middleware
-func (sh *strictHandler) ListBuilds(w http.ResponseWriter, r *http.Request) {
-	var request ListBuildsRequestObject
+// GetIngressDefaultBackend operation middleware
+func (sh *strictHandler) GetIngressDefaultBackend(w http.ResponseWriter, r *http.Request) {
+	var request GetIngressDefaultBackendRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListBuilds(ctx, request.(ListBuildsRequestObject))
+		return sh.ssi.GetIngressDefaultBackend(ctx, request.(GetIngressDefaultBackendRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListBuilds")
+		handler = middleware(handler, "GetIngressDefaultBackend")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListBuildsResponseObject); ok {
-		if err := validResponse.VisitListBuildsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetIngressDefaultBackendResponseObject); ok {
+		if err := validResponse.VisitGetIngressDefaultBackendResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8939,30 +19544,30 @@ func (sh *strictHandler) ListBuilds(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateBuild operation middleware
-func (sh *strictHandler) CreateBuild(w http.ResponseWriter, r *http.Request) {
-	var request CreateBuildRequestObject
+// SetIngressDefaultBackend operation middleware
+func (sh *strictHandler) SetIngressDefaultBackend(w http.ResponseWriter, r *http.Request) {
+	var request SetIngressDefaultBackendRequestObject
 
-	if reader, err := r.MultipartReader(); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+	var body SetIngressDefaultBackendJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
-	} else {
-		request.Body = reader
 	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateBuild(ctx, request.(CreateBuildRequestObject))
+		return sh.ssi.SetIngressDefaultBackend(ctx, request.(SetIngressDefaultBackendRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateBuild")
+		handler = middleware(handler, "SetIngressDefaultBackend")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateBuildResponseObject); ok {
-		if err := validResponse.VisitCreateBuildResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetIngressDefaultBackendResponseObject); ok {
+		if err := validResponse.VisitSetIngressDefaultBackendResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8970,25 +19575,25 @@ func (sh *strictHandler) CreateBuild(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CancelBuild operation middleware
-func (sh *strictHandler) CancelBuild(w http.ResponseWriter, r *http.Request, id string) {
-	var request CancelBuildRequestObject
+// LookupIngressDNS operation middleware
+func (sh *strictHandler) LookupIngressDNS(w http.ResponseWriter, r *http.Request, params LookupIngressDNSParams) {
+	var request LookupIngressDNSRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CancelBuild(ctx, request.(CancelBuildRequestObject))
+		return sh.ssi.LookupIngressDNS(ctx, request.(LookupIngressDNSRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CancelBuild")
+		handler = middleware(handler, "LookupIngressDNS")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CancelBuildResponseObject); ok {
-		if err := validResponse.VisitCancelBuildResponse(w); err != nil {
+	} else if validResponse, ok := response.(LookupIngressDNSResponseObject); ok {
+		if err := validResponse.VisitLookupIngressDNSResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8996,25 +19601,25 @@ func (sh *strictHandler) CancelBuild(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// GetBuild operation middleware
-func (sh *strictHandler) GetBuild(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetBuildRequestObject
+// DeleteIngress operation middleware
+func (sh *strictHandler) DeleteIngress(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteIngressRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetBuild(ctx, request.(GetBuildRequestObject))
+		return sh.ssi.DeleteIngress(ctx, request.(DeleteIngressRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetBuild")
+		handler = middleware(handler, "DeleteIngress")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetBuildResponseObject); ok {
-		if err := validResponse.VisitGetBuildResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteIngressResponseObject); ok {
+		if err := validResponse.VisitDeleteIngressResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9022,26 +19627,25 @@ func (sh *strictHandler) GetBuild(w http.ResponseWriter, r *http.Request, id str
 	}
 }
 
-// GetBuildEvents operation middleware
-func (sh *strictHandler) GetBuildEvents(w http.ResponseWriter, r *http.Request, id string, params GetBuildEventsParams) {
-	var request GetBuildEventsRequestObject
+// GetIngress operation middleware
+func (sh *strictHandler) GetIngress(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetIngressRequestObject
 
 	request.Id = id
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetBuildEvents(ctx, request.(GetBuildEventsRequestObject))
+		return sh.ssi.GetIngress(ctx, request.(GetIngressRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetBuildEvents")
+		handler = middleware(handler, "GetIngress")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetBuildEventsResponseObject); ok {
-		if err := validResponse.VisitGetBuildEventsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetIngressResponseObject); ok {
+		if err := validResponse.VisitGetIngressResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9049,23 +19653,25 @@ func (sh *strictHandler) GetBuildEvents(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListDevices operation middleware
-func (sh *strictHandler) ListDevices(w http.ResponseWriter, r *http.Request) {
-	var request ListDevicesRequestObject
+// ListInstances operation middleware
+func (sh *strictHandler) ListInstances(w http.ResponseWriter, r *http.Request, params ListInstancesParams) {
+	var request ListInstancesRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListDevices(ctx, request.(ListDevicesRequestObject))
+		return sh.ssi.ListInstances(ctx, request.(ListInstancesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListDevices")
+		handler = middleware(handler, "ListInstances")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListDevicesResponseObject); ok {
-		if err := validResponse.VisitListDevicesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListInstancesResponseObject); ok {
+		if err := validResponse.VisitListInstancesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9073,11 +19679,11 @@ func (sh *strictHandler) ListDevices(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateDevice operation middleware
-func (sh *strictHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
-	var request CreateDeviceRequestObject
+// CreateInstance operation middleware
+func (sh *strictHandler) CreateInstance(w http.ResponseWriter, r *http.Request) {
+	var request CreateInstanceRequestObject
 
-	var body CreateDeviceJSONRequestBody
+	var body CreateInstanceJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9085,18 +19691,18 @@ func (sh *strictHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateDevice(ctx, request.(CreateDeviceRequestObject))
+		return sh.ssi.CreateInstance(ctx, request.(CreateInstanceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateDevice")
+		handler = middleware(handler, "CreateInstance")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateDeviceResponseObject); ok {
-		if err := validResponse.VisitCreateDeviceResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateInstanceResponseObject); ok {
+		if err := validResponse.VisitCreateInstanceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9104,23 +19710,30 @@ func (sh *strictHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListAvailableDevices operation middleware
-func (sh *strictHandler) ListAvailableDevices(w http.ResponseWriter, r *http.Request) {
-	var request ListAvailableDevicesRequestObject
+// CreateInstances operation middleware
+func (sh *strictHandler) CreateInstances(w http.ResponseWriter, r *http.Request) {
+	var request CreateInstancesRequestObject
+
+	var body CreateInstancesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAvailableDevices(ctx, request.(ListAvailableDevicesRequestObject))
+		return sh.ssi.CreateInstances(ctx, request.(CreateInstancesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAvailableDevices")
+		handler = middleware(handler, "CreateInstances")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListAvailableDevicesResponseObject); ok {
-		if err := validResponse.VisitListAvailableDevicesResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateInstancesResponseObject); ok {
+		if err := validResponse.VisitCreateInstancesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9128,25 +19741,25 @@ func (sh *strictHandler) ListAvailableDevices(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// DeleteDevice operation middleware
-func (sh *strictHandler) DeleteDevice(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteDeviceRequestObject
+// WatchInstances operation middleware
+func (sh *strictHandler) WatchInstances(w http.ResponseWriter, r *http.Request, params WatchInstancesParams) {
+	var request WatchInstancesRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteDevice(ctx, request.(DeleteDeviceRequestObject))
+		return sh.ssi.WatchInstances(ctx, request.(WatchInstancesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteDevice")
+		handler = middleware(handler, "WatchInstances")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteDeviceResponseObject); ok {
-		if err := validResponse.VisitDeleteDeviceResponse(w); err != nil {
+	} else if validResponse, ok := response.(WatchInstancesResponseObject); ok {
+		if err := validResponse.VisitWatchInstancesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9154,25 +19767,23 @@ func (sh *strictHandler) DeleteDevice(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// GetDevice operation middleware
-func (sh *strictHandler) GetDevice(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetDeviceRequestObject
-
-	request.Id = id
+// ListPreservedSnapshots operation middleware
+func (sh *strictHandler) ListPreservedSnapshots(w http.ResponseWriter, r *http.Request) {
+	var request ListPreservedSnapshotsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDevice(ctx, request.(GetDeviceRequestObject))
+		return sh.ssi.ListPreservedSnapshots(ctx, request.(ListPreservedSnapshotsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDevice")
+		handler = middleware(handler, "ListPreservedSnapshots")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDeviceResponseObject); ok {
-		if err := validResponse.VisitGetDeviceResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPreservedSnapshotsResponseObject); ok {
+		if err := validResponse.VisitListPreservedSnapshotsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9180,23 +19791,26 @@ func (sh *strictHandler) GetDevice(w http.ResponseWriter, r *http.Request, id st
 	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// DeleteInstance operation middleware
+func (sh *strictHandler) DeleteInstance(w http.ResponseWriter, r *http.Request, id string, params DeleteInstanceParams) {
+	var request DeleteInstanceRequestObject
+
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.DeleteInstance(ctx, request.(DeleteInstanceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "DeleteInstance")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteInstanceResponseObject); ok {
+		if err := validResponse.VisitDeleteInstanceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9204,23 +19818,25 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListImages operation middleware
-func (sh *strictHandler) ListImages(w http.ResponseWriter, r *http.Request) {
-	var request ListImagesRequestObject
+// GetInstance operation middleware
+func (sh *strictHandler) GetInstance(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetInstanceRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListImages(ctx, request.(ListImagesRequestObject))
+		return sh.ssi.GetInstance(ctx, request.(GetInstanceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListImages")
+		handler = middleware(handler, "GetInstance")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListImagesResponseObject); ok {
-		if err := validResponse.VisitListImagesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetInstanceResponseObject); ok {
+		if err := validResponse.VisitGetInstanceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9228,30 +19844,25 @@ func (sh *strictHandler) ListImages(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateImage operation middleware
-func (sh *strictHandler) CreateImage(w http.ResponseWriter, r *http.Request) {
-	var request CreateImageRequestObject
+// GetInstanceBootStatus operation middleware
+func (sh *strictHandler) GetInstanceBootStatus(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetInstanceBootStatusRequestObject
 
-	var body CreateImageJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateImage(ctx, request.(CreateImageRequestObject))
+		return sh.ssi.GetInstanceBootStatus(ctx, request.(GetInstanceBootStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateImage")
+		handler = middleware(handler, "GetInstanceBootStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateImageResponseObject); ok {
-		if err := validResponse.VisitCreateImageResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetInstanceBootStatusResponseObject); ok {
+		if err := validResponse.VisitGetInstanceBootStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9259,25 +19870,32 @@ func (sh *strictHandler) CreateImage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteImage operation middleware
-func (sh *strictHandler) DeleteImage(w http.ResponseWriter, r *http.Request, name string) {
-	var request DeleteImageRequestObject
+// CloneInstance operation middleware
+func (sh *strictHandler) CloneInstance(w http.ResponseWriter, r *http.Request, id string) {
+	var request CloneInstanceRequestObject
 
-	request.Name = name
+	request.Id = id
+
+	var body CloneInstanceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteImage(ctx, request.(DeleteImageRequestObject))
+		return sh.ssi.CloneInstance(ctx, request.(CloneInstanceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteImage")
+		handler = middleware(handler, "CloneInstance")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteImageResponseObject); ok {
-		if err := validResponse.VisitDeleteImageResponse(w); err != nil {
+	} else if validResponse, ok := response.(CloneInstanceResponseObject); ok {
+		if err := validResponse.VisitCloneInstanceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9285,25 +19903,25 @@ func (sh *strictHandler) DeleteImage(w http.ResponseWriter, r *http.Request, nam
 	}
 }
 
-// GetImage operation middleware
-func (sh *strictHandler) GetImage(w http.ResponseWriter, r *http.Request, name string) {
-	var request GetImageRequestObject
+// CompactInstanceOverlay operation middleware
+func (sh *strictHandler) CompactInstanceOverlay(w http.ResponseWriter, r *http.Request, id string) {
+	var request CompactInstanceOverlayRequestObject
 
-	request.Name = name
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetImage(ctx, request.(GetImageRequestObject))
+		return sh.ssi.CompactInstanceOverlay(ctx, request.(CompactInstanceOverlayRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetImage")
+		handler = middleware(handler, "CompactInstanceOverlay")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetImageResponseObject); ok {
-		if err := validResponse.VisitGetImageResponse(w); err != nil {
+	} else if validResponse, ok := response.(CompactInstanceOverlayResponseObject); ok {
+		if err := validResponse.VisitCompactInstanceOverlayResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9311,23 +19929,26 @@ func (sh *strictHandler) GetImage(w http.ResponseWriter, r *http.Request, name s
 	}
 }
 
-// ListIngresses operation middleware
-func (sh *strictHandler) ListIngresses(w http.ResponseWriter, r *http.Request) {
-	var request ListIngressesRequestObject
+// DetachInstanceDevice operation middleware
+func (sh *strictHandler) DetachInstanceDevice(w http.ResponseWriter, r *http.Request, id string, deviceId string) {
+	var request DetachInstanceDeviceRequestObject
+
+	request.Id = id
+	request.DeviceId = deviceId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListIngresses(ctx, request.(ListIngressesRequestObject))
+		return sh.ssi.DetachInstanceDevice(ctx, request.(DetachInstanceDeviceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListIngresses")
+		handler = middleware(handler, "DetachInstanceDevice")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListIngressesResponseObject); ok {
-		if err := validResponse.VisitListIngressesResponse(w); err != nil {
+	} else if validResponse, ok := response.(DetachInstanceDeviceResponseObject); ok {
+		if err := validResponse.VisitDetachInstanceDeviceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9335,30 +19956,26 @@ func (sh *strictHandler) ListIngresses(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateIngress operation middleware
-func (sh *strictHandler) CreateIngress(w http.ResponseWriter, r *http.Request) {
-	var request CreateIngressRequestObject
+// AttachInstanceDevice operation middleware
+func (sh *strictHandler) AttachInstanceDevice(w http.ResponseWriter, r *http.Request, id string, deviceId string) {
+	var request AttachInstanceDeviceRequestObject
 
-	var body CreateIngressJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.DeviceId = deviceId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateIngress(ctx, request.(CreateIngressRequestObject))
+		return sh.ssi.AttachInstanceDevice(ctx, request.(AttachInstanceDeviceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateIngress")
+		handler = middleware(handler, "AttachInstanceDevice")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateIngressResponseObject); ok {
-		if err := validResponse.VisitCreateIngressResponse(w); err != nil {
+	} else if validResponse, ok := response.(AttachInstanceDeviceResponseObject); ok {
+		if err := validResponse.VisitAttachInstanceDeviceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9366,25 +19983,25 @@ func (sh *strictHandler) CreateIngress(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteIngress operation middleware
-func (sh *strictHandler) DeleteIngress(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteIngressRequestObject
+// ListExecSessions operation middleware
+func (sh *strictHandler) ListExecSessions(w http.ResponseWriter, r *http.Request, id string) {
+	var request ListExecSessionsRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteIngress(ctx, request.(DeleteIngressRequestObject))
+		return sh.ssi.ListExecSessions(ctx, request.(ListExecSessionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteIngress")
+		handler = middleware(handler, "ListExecSessions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteIngressResponseObject); ok {
-		if err := validResponse.VisitDeleteIngressResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListExecSessionsResponseObject); ok {
+		if err := validResponse.VisitListExecSessionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9392,25 +20009,26 @@ func (sh *strictHandler) DeleteIngress(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetIngress operation middleware
-func (sh *strictHandler) GetIngress(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetIngressRequestObject
+// GetExecSessionRecording operation middleware
+func (sh *strictHandler) GetExecSessionRecording(w http.ResponseWriter, r *http.Request, id string, sessionId string) {
+	var request GetExecSessionRecordingRequestObject
 
 	request.Id = id
+	request.SessionId = sessionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetIngress(ctx, request.(GetIngressRequestObject))
+		return sh.ssi.GetExecSessionRecording(ctx, request.(GetExecSessionRecordingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetIngress")
+		handler = middleware(handler, "GetExecSessionRecording")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetIngressResponseObject); ok {
-		if err := validResponse.VisitGetIngressResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetExecSessionRecordingResponseObject); ok {
+		if err := validResponse.VisitGetExecSessionRecordingResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9418,23 +20036,32 @@ func (sh *strictHandler) GetIngress(w http.ResponseWriter, r *http.Request, id s
 	}
 }
 
-// ListInstances operation middleware
-func (sh *strictHandler) ListInstances(w http.ResponseWriter, r *http.Request) {
-	var request ListInstancesRequestObject
+// RunInstanceCommand operation middleware
+func (sh *strictHandler) RunInstanceCommand(w http.ResponseWriter, r *http.Request, id string) {
+	var request RunInstanceCommandRequestObject
+
+	request.Id = id
+
+	var body RunInstanceCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListInstances(ctx, request.(ListInstancesRequestObject))
+		return sh.ssi.RunInstanceCommand(ctx, request.(RunInstanceCommandRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListInstances")
+		handler = middleware(handler, "RunInstanceCommand")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListInstancesResponseObject); ok {
-		if err := validResponse.VisitListInstancesResponse(w); err != nil {
+	} else if validResponse, ok := response.(RunInstanceCommandResponseObject); ok {
+		if err := validResponse.VisitRunInstanceCommandResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9442,30 +20069,26 @@ func (sh *strictHandler) ListInstances(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateInstance operation middleware
-func (sh *strictHandler) CreateInstance(w http.ResponseWriter, r *http.Request) {
-	var request CreateInstanceRequestObject
+// GetInstanceFile operation middleware
+func (sh *strictHandler) GetInstanceFile(w http.ResponseWriter, r *http.Request, id string, params GetInstanceFileParams) {
+	var request GetInstanceFileRequestObject
 
-	var body CreateInstanceJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateInstance(ctx, request.(CreateInstanceRequestObject))
+		return sh.ssi.GetInstanceFile(ctx, request.(GetInstanceFileRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateInstance")
+		handler = middleware(handler, "GetInstanceFile")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateInstanceResponseObject); ok {
-		if err := validResponse.VisitCreateInstanceResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetInstanceFileResponseObject); ok {
+		if err := validResponse.VisitGetInstanceFileResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9473,25 +20096,26 @@ func (sh *strictHandler) CreateInstance(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// DeleteInstance operation middleware
-func (sh *strictHandler) DeleteInstance(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteInstanceRequestObject
+// GetInstanceLogs operation middleware
+func (sh *strictHandler) GetInstanceLogs(w http.ResponseWriter, r *http.Request, id string, params GetInstanceLogsParams) {
+	var request GetInstanceLogsRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteInstance(ctx, request.(DeleteInstanceRequestObject))
+		return sh.ssi.GetInstanceLogs(ctx, request.(GetInstanceLogsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteInstance")
+		handler = middleware(handler, "GetInstanceLogs")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteInstanceResponseObject); ok {
-		if err := validResponse.VisitDeleteInstanceResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetInstanceLogsResponseObject); ok {
+		if err := validResponse.VisitGetInstanceLogsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9499,25 +20123,25 @@ func (sh *strictHandler) DeleteInstance(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetInstance operation middleware
-func (sh *strictHandler) GetInstance(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetInstanceRequestObject
+// ListInstanceProcesses operation middleware
+func (sh *strictHandler) ListInstanceProcesses(w http.ResponseWriter, r *http.Request, id string) {
+	var request ListInstanceProcessesRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetInstance(ctx, request.(GetInstanceRequestObject))
+		return sh.ssi.ListInstanceProcesses(ctx, request.(ListInstanceProcessesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetInstance")
+		handler = middleware(handler, "ListInstanceProcesses")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetInstanceResponseObject); ok {
-		if err := validResponse.VisitGetInstanceResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListInstanceProcessesResponseObject); ok {
+		if err := validResponse.VisitListInstanceProcessesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9525,26 +20149,25 @@ func (sh *strictHandler) GetInstance(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// GetInstanceLogs operation middleware
-func (sh *strictHandler) GetInstanceLogs(w http.ResponseWriter, r *http.Request, id string, params GetInstanceLogsParams) {
-	var request GetInstanceLogsRequestObject
+// ResetInstanceOverlay operation middleware
+func (sh *strictHandler) ResetInstanceOverlay(w http.ResponseWriter, r *http.Request, id string) {
+	var request ResetInstanceOverlayRequestObject
 
 	request.Id = id
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetInstanceLogs(ctx, request.(GetInstanceLogsRequestObject))
+		return sh.ssi.ResetInstanceOverlay(ctx, request.(ResetInstanceOverlayRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetInstanceLogs")
+		handler = middleware(handler, "ResetInstanceOverlay")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetInstanceLogsResponseObject); ok {
-		if err := validResponse.VisitGetInstanceLogsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ResetInstanceOverlayResponseObject); ok {
+		if err := validResponse.VisitResetInstanceOverlayResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9683,6 +20306,32 @@ func (sh *strictHandler) StopInstance(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
+// SyncInstanceTime operation middleware
+func (sh *strictHandler) SyncInstanceTime(w http.ResponseWriter, r *http.Request, id string) {
+	var request SyncInstanceTimeRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SyncInstanceTime(ctx, request.(SyncInstanceTimeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SyncInstanceTime")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SyncInstanceTimeResponseObject); ok {
+		if err := validResponse.VisitSyncInstanceTimeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // DetachVolume operation middleware
 func (sh *strictHandler) DetachVolume(w http.ResponseWriter, r *http.Request, id string, volumeId string) {
 	var request DetachVolumeRequestObject
@@ -9769,9 +20418,11 @@ func (sh *strictHandler) GetResources(w http.ResponseWriter, r *http.Request) {
 }
 
 // ListVolumes operation middleware
-func (sh *strictHandler) ListVolumes(w http.ResponseWriter, r *http.Request) {
+func (sh *strictHandler) ListVolumes(w http.ResponseWriter, r *http.Request, params ListVolumesParams) {
 	var request ListVolumesRequestObject
 
+	request.Params = params
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.ListVolumes(ctx, request.(ListVolumesRequestObject))
 	}
@@ -9889,143 +20540,401 @@ func (sh *strictHandler) GetVolume(w http.ResponseWriter, r *http.Request, id st
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x97XITubboq6j6nl3HOdt2nA+Y4FNTt0ICTPYhkCKQffeecI3cLduadEs9ktqJofg7",
-	"DzCPOE9yS0tSf1ltd4AYcmHXrhqT1ufS0vpeSx+CkCcpZ4QpGQw/BDKckQTDz0OlcDi74HGWkFfk94xI",
-	"pf+cCp4SoSiBRgnPmBqlWM30vyIiQ0FTRTkLhsEZVjN0PSOCoDmMguSMZ3GExgRBPxIF3YDc4CSNSTAM",
-	"thOmtiOscNAN1CLVf5JKUDYNPnYDQXDEWbww00xwFqtgOMGxJN3atKd6aIQl0l160Ccfb8x5TDALPsKI",
-	"v2dUkCgY/lrextu8MR//RkKlJz+cYxrjcUyOyZyGZBkMYSYEYWoUCTonYhkUR+Z7vEBjnrEImXaow7I4",
-	"RnSCGGdkqwIMNqcR1ZDQTfTUwVCJjHggE8GaRjTynMDRCTKf0ckx6szITXWS3Z/GB0HzkAwnZHnQX7IE",
-	"s54Grl6WGx/alsd+vu8bmfIkyUZTwbN0eeSTl6enbxB8RCxLxkSURzzYzcejTJEpEXrANKQjHEWCSOnf",
-	"v/tYXttgMBgM8e5wMOgPfKucExZx0QhS89kP0p1BRFYM2QqkdvwlkL64ODk+OURHXKRcYOi7NFMNscvg",
-	"Ke+rjDbVU/Hh/+OMxpEH67lemCLRCKvlTUEnZNtQzpCiCZEKJ2nQDSZcJLpTEGFFevpLG1QPBcFrptMt",
-	"Wk22jPSZgekokU2juyaIMpTQOKaShJxFsjwHZerhfvNmSqhLhOAeWvFE/xklREo8JaijCZimogxJhVUm",
-	"EZVogmlMoq02IPPhsNnMb3yMaESYohNavWnBWDfo4XG4s7vnvcUJnpJRRKeWJ1SHP4a/Iz5BehyFoLV/",
-	"IxrlF+32AVMKMlme7ykQUZhEkAkRhIWfPV0q+JwwzAyx/w+YN/hf2wWz3LacchuAeVY0/9gNfs9IRkYp",
-	"l9SscImG2C8ajQDUCHr41wyfVp11CaOkwmL1/YAWX+AmmvW1gs25aVqnTEB47DCVm91IgJ7MCVM+KsSU",
-	"/VDd8XM+RTFlBNkWFr4TLpCe4OeYT7eCL7O3blCAdPlC63V/AkEyf2gYTX/rBoRliQZmzKdlaM4IFmpM",
-	"KsBsYBB2oGJ1jeA/q1yJ6hmMsSSj1VThjDJGIqRb2stqWqJMghy4tH24GVdUjeZESO89gmX9D1XItmgc",
-	"Kubh1YTGZDTDcmZWjKMI7iCOzyo78chCFeESp5qwuQGBR0ukODr/5XD3wUNkJ/DAUPJMhGYFyzsp9dbD",
-	"m7ZIYTHGcezFjWZ0uz3fXcYQPwac5xejiZ/kGOgQ01CvwJ6mHr4bpJmcmV9Aj/WqgJ9pMqDRK9a/33o2",
-	"fQREwsjgjRqJX8J6mZrDRtOYa5guUMbo71lFfO2jEy2JK6SJP41I1EUYPmgyjDPFe1PCiNB0Ck0ET5Ca",
-	"EVQSMVGH9Kf9LrrUUldPy5g9vNsbDHqDy6AqJMb7vWmaaVBgpYjQC/y/v+Le+8Pevwe9R2+Ln6N+7+3f",
-	"/8OHAG3lXo1Oep12nx1397vILbYsDNcXulpQXiFr+qiIOb4Tffdve3pHJ8sM3qw/4uEVEX3Kt2M6Flgs",
-	"ttmUspthjBWRqrqb1W3X7g/WtmJjbKq3fsut1UR/QLdOzK+JCDWljIlGENnVxJIq2UVYa49AZJDmZv+N",
-	"Qsw0zhrGzgUiLELXVM0QhnZVCCSLHk5pj5qlBt0gwTfPCZtq9f3h3hI+amTs2B+9t//l/rT1v70oKbKY",
-	"eJDxFc8UZVMEnw33nVGJijVQRZK17NZBN4tBxEooOzHddvKVYCHwwn9qbnGrTk8qTXwaj89cIM/+jp2C",
-	"LZFV2oAhYDCfwH6fnb3Z1lcyxVKqmeDZdFY+lV8dPXhbgkWDNOA22Q0iKq9GlI/GqW9NVF6hk+2XSFMr",
-	"FNOEqoI67QwGp4+35WWg//HA/WOrj46NXQWWrzfPhSWacoYFAdYdIc7Q0dkbhOOYh1YZmmgJa0KnmSBR",
-	"v6YNw+g+bCFs/hl8+AmbU8FZomWhORZUX56Kjv8hePHy+MnoyYuLYKhPMspCqzCfvXz1OhgGe4PBIPCx",
-	"uhlXaZxNR5K+JxVrU7D37HFQX8hhvn6UkIQLI1/aMVBnVr3ehv2imF4RdKnHM4ew86xOeHdhqiUgzBYp",
-	"EXMqfXrjL/k3fX6ZJOW7ZpC7esSSiDkR+dnBYfZLvDuMeRb1SlN2g99JAmhaLNTTyK+7taLqa8g1jlPK",
-	"SCO97n4rNPaai6uY46i384VJLCNKj728xRfmQ/UwLQKQ/PyD7pLczqJrGqnZKOLXTC/ZQ0vsF5Q3zgnK",
-	"jd4Jjv/648+L00Kg2Hk2Ti112dl98JnUpUZP9NBeZSHfSJb6t/Em9W/i4vSvP/50O/m6myBM42dUITpG",
-	"/65u5Z8zomZElLiMO2D9JyPtQXfk8KU0fUWhL9vDlwghnxMR44WHEO4MPJTwn4IquF+2H9IcCunOa8ig",
-	"Hs0xo2VCOPBTQs+iPGt6rO+3pcttVpIvZGf31P7cbUub52HqlCO7pN36cl6AUVuL5HMqVIZjjScVtuW1",
-	"cRvviYfNG+dMWdyw55/jA1ZVk2hbccuMDK6UZeHDL2EZKt8sYa3xJPkMlbnWFmZS8aRkrkSdmkJGq6pb",
-	"9cTmPO5FWGGgxy2ZhlnushE+WZihzKE0oeZoOvZo+RoDKUNTOsXjhaoKLDuD5aP3A9qN7wN1k4PKoAeJ",
-	"Rop7/C4OW06ONRxd2zZ2QHBnjRQfzSfUM3JOqQoNlEoU1rxhFmn1EL00pNY71kXXM6ppm0QOCMDQLk7L",
-	"gnT/kvWQXtwQHecT5MPmQ2qWDtYGGKLDRWkRFAxHaLzYQhhdnPbR63y1/ykRw4rOifPYzbBEY0IYyoAn",
-	"kgjmBz9keQGZ1BoPVfXuVgY3zr0t0Be4/dZHWoBLMEPXNI7B3pBgRUMwVoxpbT9gJDYHpWfSBIAVYt4l",
-	"K2OW9ZLWSf5qd8orMqVSiZozBXVePT3a29t7VCfSuw96g53ezoPXO4PhQP//3+39Ll/ef+kb67BKL6z5",
-	"p0xRjt6cHO9ajlCdR73fx48Obm6wevSQXstH75OxmP62hzfi4fSTp+PCboU6mSSi50ifxiqftapkFGqw",
-	"Rn2ykelWzlVn1l7FfszuXuuWd+GO9bkirCH89g7TOhFc68wobW5pP/qvWj4oML+kkFmbYUi91lGt8z8W",
-	"BF9pUd7DXzV7liPDd/wGg0wLr+MFIjdariUREpyriTRKWlVM2dn/af9g7+H+wWDg8X0uIzEP6SjUXKXV",
-	"ArRmGOOFVk51H9QB6TpC45iPq8j7YO/hwU+DRzu7bddhZNN2cMilKNcLdSxE/u4iWtyXyqJ2d396uLe3",
-	"N3j4cHe/1aqsgNdqUU4YrIgOP+39tL9zsLvfCgo+Wf+J80XXfWuRB0kP0zSmRrPpyZSEdEJDBN5spDug",
-	"TgJsieRidvVOjnE0ElYM9PIDhWnsAUPJ1GImsy1RR/P0JIsVTWNivsGBtJJ0YefHMJLPzEYZI2KUu+pv",
-	"MZL14K81R7i95E1ARInIOJtOjZukAN0plSBZFAIRJXE0NDd0LZ2D0ywW9rYJD+weWmLDc35NRC8mcxKX",
-	"kcCwI73YhAuCcjwxh1bZFWVzHNNoRFmaeVGiEZRPMwHypRkU4THPFMiS5sDKk4DfAXSEiSbX7dxevxAc",
-	"m9C2KiQKF7GjzfyqahfjV2uPww7iO4YTZzGrHUDiYYFHp8eGwYecKUwZESghCttAupKVGZwdQTfoaZyK",
-	"MEk4Q3wy+e/VducGFSC/IKuEyKOlaJw7ESAbPM6viOTxnEQowYxOiFTW41yZWc7w7oOHQxPrEpHJ/oOH",
-	"/X7fb51RYpFy6gs1eJJ/a3cU28a22SvG7MvZ553DHdjT2+zlQ3B2+PqXYBhsZ1JsxzzE8bYcUzYs/Tv/",
-	"Z/EBfph/jinz2uFbhUfRyVJYVOV40yyO7d+HeieMhDlCciA2a1Vcv/z9QqNmTN+TCHm9kwpPtSBuMO7z",
-	"3JCfEVBUxJeqUiBR2drUIqiIvl8ttUlr1oA2ds6MKRoX8VbL8tonRczJlQEIS8EHKWF5yEEcm18hZ3N9",
-	"K3zxBxUC7r4tHcY1F1eUTUcR9WDnP81HFFFBQgXuoPV3KNjGaboeFf3WoJymtY2lsp5UD3f56pT8U/T2",
-	"6uwvp//4/f/Is59+2/n9+cXFv+bP/nH8gv7rIj57+VnuotVO9K/qCV9pmgVlteIBb4sep1iFHsFnxqVq",
-	"gJr9ghRHie7cR0eYoTEZXrIeek4VETgeossAp7RvgdkPeXIZoA65waEyvRBnSA+FZgRHRGzpzmfGZaY7",
-	"f3A62cf6GNGC4YSGSFgg564YmY0jnmDKti7ZJbNjIbcRCbY//StCIU5VJog+ERRmIl6gscAhyQN7ism7",
-	"6ANO049bl0zNsELkRgm9gxQLlUfcuBngoO2qjG3RNicRmuM4IxKFAKhLlvOPSC9BD6KwmBLVzzVRkPdr",
-	"9r0GoHgNOlyoioviYND1nCPS7fRBxlQqwlDuSqQSkBd1nIPpYFC5/geDg/Vm7ByHVqAfYPdytolDyhb3",
-	"wyAwTG2I8WimVLo+fQTojbkj6JfXr880GPR/z5EbqIBFfsQmshRrvZhIY5xVMcgk1qe3FfgMsOZ0W27o",
-	"tWmsu8Vy/T6ewMTo9fNzpIhIKDP0uxNqcE60+k6MmZBKmWlUpBgdHp0+2eq3SJcB2ObrX3GOr/Md1qxR",
-	"ziO9bAGDHoXtRcO3i06Ou1qcsje0ELTA/P6UCxQbAlPc6yF6I0nVGQZHZSyF5iTjRREVY6j6ZbDlRkzr",
-	"lGKIXuXyHc6XkkcCFsjghizuJQx7yf6pEcP4BpZG71bXCl4Pq79Y0gaeAKyQtZ0AK24mBauvvwficOc5",
-	"qwcM3O5ulyMN9GR+1CjO/s4lkL3b6pK3jaqqOpRLAQR5YNXXjYhajm/CciQZTuWMq2aPHUauDSI3VCq5",
-	"HE3Uyse0HE1VZTYmTmqFi/5LxkWJjDFwl9W38cUjnr6mA+rbi7ZaGR/1uUFOVty6oxinxuvtiw+q3nTz",
-	"5y8brXQny6nEHfmIQZkrueiATw416gbU4xk9lJJOGYnQyVkRT1+YL9zwtT092u3vPDzo7wwG/Z1BG2NO",
-	"gsMVc58eHrWffLBr1NshHg/DaEgmn2FMsohtxAccX+OFRJdOwLsMjERZEiVL19YKga3M2csRXZ8WwFVn",
-	"aetCtG4TktWK3q9KdDuvpri1lhIe/PuzsuHIejHeXKJzaOx6jW5j5iQo5Fkcsf9UaKxvnhHsSWT1D0lU",
-	"kT0Il/UNu2L8mlW3bqxd+v7+nhGxQBenpxXbqCATm0jVYuM8TRvPgae3OobdNcLa2tWUIvA2EXVXp4Ql",
-	"DvTFY+zKhhzn7DNY18KgU8a75uAvGA6MNSZeLxpqzEB2dDTOFMqDoDXKHWk5CJWkKxPqBPrTKyNo6RGA",
-	"Z4T6S7zIBbCVnc+wRj/XN4V/re5xPsuUZu7QR84yhfS/YMl6C1aAXT2EweQhesGhj11pV5P/miRsmmMW",
-	"jRfLzetSc8fYdrQyp7ggEUxmr+UQPc2vYn6Z7eXtSGJ/GgphncLg8N4ySp8VWu1pBd3AQj3oBgaEQTdw",
-	"kNE/zQ7hFyw+6AZ2Id54kjOsZidswpfVtNuQLOt8cUpxqjcpIVkzIoySaKuPXlZol4UbuHNiSVCUERve",
-	"ZuAgsI0oxEZVTbGaAWJCR8qm1WDrpQnbEBKzhtXhjDCvbdhG5pF+h8FrkQGsjEoiES5cB630KypHExqT",
-	"NgMLMs1iLBC0b7dkuUhiyq7ajC4XyZjHNES6Q50hTXgc8+uR/iR/hr1stdqd7jAqrGQ1BmMWZ22k5kBq",
-	"8xZb+FnvcqvmdQk1N9g2/beh+ksbEdIbBfGUxgQlEP3yhtGbEqJXY4P2dwdNTraGQSvutWrYT6vwohon",
-	"sSjr4xSviEk8PswTFzwmmjRbXudcM1SX71D1wO77dgtWllUuxXyokl/RSaUu9qoK11IMVKuQK8esvVGF",
-	"OU9scDOtqIzhhvUL+idlU2RdK54n/tgXLSo3QevUCNLL8KpY7h4cPHq0t//g0W4r0FhtJ1eXG4xhTSqz",
-	"W8G2JGEtR6h6YrsPBvC/Wy3KKMz+JTUozdUFVfJ9PnlBH1dcnyJNvhaDn9+PFfWhipMUdrjKUe4ftIIW",
-	"dgWrPMqu+wQSRSmNs0MmEwKC2sjArVcspubkabWGEKc4pGrh8YPia7B7o7xJafSH7UIma4v1gNSOjfBE",
-	"ael/ToTMxkWQXcdNjv4LgSWphgsHreNJZTYewQgeo1t9VmhnHUVRTQUplB6ejeOSedpGiudFJHx21Osc",
-	"mOgay4puqH+HikTdUppu3YhgWrSvQuJwPS9EUphHfaHk/qIj5eOvHWc3KHOTAp3rEF/FxpqvoObK4IVq",
-	"o6Z5uKInTtTyxTYDFUVjNB/8tF6jcTnSe2UofSUsPGcot5+2ZJa9Tcd6DCqgh12DhUAxdrdyQr7DNUpz",
-	"U4JT4uoY1kJUqalHZXN+UKkx6pAkVQsXJOV0+q3bKfGH+YBe3PjCbq3Boy8RWPNmZSTN/ycpc2W7iZtk",
-	"rcVk6Uwb3dd+6fG47pMwapJNGaja0GuB0FKtKLu2qtimqXoJOpANHZlm9VjXWxTYbNJ6i5vj6qm5Cpvr",
-	"lLkGR7HJpyntrLSS5rMxRrPPrEZKpStD+okgsxrJ+lgMY6LROmGvnlMCUti1oKDiWAAZwGoQ5Frrsmq8",
-	"2pZ/im/yGUCBxBLVUp/NPkplQZ49hhT3Vy63gE7cELCMehL7488r0+qwavkwVtVtdWZZ78Wz9GcFRWu6",
-	"WzXkLObori4Nq0kXCTNB1eJcMwTrcSRYEHGYGTQETgGbgD8Xk0M80sePoDVOPMLjM8KIoCE6PDsBLEkw",
-	"w1N9ZBenKKYTEi7CmNhwkiXTJpSneHl00jNxcM7jCv4/qgAgLt/08OwEUt1slbVg0N/tQ2kUnhKGUxoM",
-	"g73+DiTzaTDAFrchzBh+WtuMvofAyU4iy3EfmyYatDLlTBrg7A4Gtap9uEgn2v5NGqODYa+tZTRToHTZ",
-	"iL4UJeEkAbv8j91gf7Bzq/WszQDyTfuG4UzNuKDvCSzzwS2B8EmTnjCj5LpCL8Q2LHA2GP5axdZf3358",
-	"2w1kliRYi4gGXAWsUi6bRBgiEUaMXNv489/4uI/OjYoA6UBF6WejwZNIkySMFBb96XuERTijc3LJLCU2",
-	"2VxYQLBdgjQFNqFOVTQzU5vTN1eYSPWYR4sadPPhtvVwII1UAXzrcoZ5aYK0oa6hjzqaDEgZcm/qJ2GY",
-	"qSKhzqQ+XpEFSgWZ0BtvuBIEbfgNwMf5N1cAs0rbtbhLWRhnUcEAq4UHvWkwkoSC+ITsf5y/fIHg4kEJ",
-	"Q2hWxJpAkQrKNNlEUQacBzClf8me4HCGDEWF/PrLgEaXQVGqbguoXyaJIWq9HpDkn6GGp5mmS6Of+309",
-	"lKH2Q/TrBzPKEF0GLE1Gil8Rdhl87KLShylVs2ycf3t7ybwbbtC5zyuwQh2DyVsu1l/vsHSpzS3ALELc",
-	"Yk68QBgVh1SW5ceUYbFoqvvIMzVyhYcbUiFssyJO9+FgsLXeNmy36uFzlYZaGvi4RNZ3vxhFs9R8maKV",
-	"ajxr+sFsnktk6PgGSOpjHLnwyx+8Yw3vsEJviStAfys5bH+g0UeDvjExfukaaYdSoI60p1jghCgiJMzr",
-	"Q4uTY62z6387Tw4oqUYFrCJvtwSeuiT4dgmx9xtrrObVSgEX9jeAfzBvkcQK8z7a1Lw4NiVU8rrv9wod",
-	"4bAcInb9Yuszor4FjBtsipS6XPuviL/3BX+eESsJF0CrUbNtMnfmR7+/WgmCE2lHMY21EHwOa+qdE6YQ",
-	"VPeWfftfJ59BVM67mE/fDZEBYWxrm0sjExXGQ80ULSyhk8kKzPvZZNlwhtmUSNQx/POvP/509Zn/+uNP",
-	"W5/5rz/+hOu+bV8bgOHyyuLvhuh/CEl7OKZz4jYj9RbInIgF2hvYGnnwyZN6Ky/ZJXtFVCaYzGM39L4A",
-	"JmZAyIlgsB/KMiKRBBBCbaWJDSowtgmPbuDusgHlRm90d0lFsjsobUBzRYcD4KGijCqKY8QzZcogwDog",
-	"RK9YiNlzUJ68bmZZMrytpy+K3CiDvT2zwFsSGFOZ33PvTLF6MybqnJ8/2eojEPcNVkDgCOgNxTBWE+j/",
-	"oEnraZKhKFWCAlA2tKlUsrjRSHNs22zCSmOL093CTCOgChnRGqvbzA+xu4XJxg83Z77x2VCOXbGpZiPK",
-	"p+/XV7i/lU755c7Z4d4yzG0ltQJkX0ObRB1bBCdPUqyUa/taSL8RAlyq8pdTYcRNauTGNJwjziYxDRXq",
-	"ubXYYu651lNFkPtCDl7ZVSPs9jWB3NaiEn2ZVWxXAocamUbtPbzNcI/6I3y3YCP5rkp19X5wknWoc0xl",
-	"yHXfErb0QpyWnv2TxT0tY9E6284x/D1nOSsF8/x5BVQ8ibchK4+dOmN13rABonhcI4hfkRDWkv5KhWbv",
-	"Eza/yU/RFdZcYQT6tlBzsDkpaNMGIR+a3yeLUFQDm6aCs7xoYBN62bKCd3jQdgbPxs+JcLfaLNQkmxXb",
-	"Ml1ROCPhldmQrf+6SiI4cSVi714OMLURb8H97fJ/sPsWimMBq1XK4onNQLw7XbHyStiG3Y8WwTxABvf+",
-	"uHhIk0Sog+WChVvflQdyI5yhXq/1Ht2ksyyOnSF+ToQqKkSW6en2By0ftJCT3W1bKYu8efW8R1jIIZjD",
-	"gK5RIHEF4b6stGwOzGzlB5q00a8AVA4xmoXRzzh/E91ZPJL4t92ntrrL33afmvouf9s7LN5KvBtkGWyK",
-	"NG9aer3HyKeFV1oFGpAmU7ZtnbSXt9qIwGfrY95G5MsX+EPqayP1lcG1UvDLS5XeoehXfUd1w36CHNl8",
-	"0IZPLv7sOxP5Nmt6shhpoxtmVFZt8bZIBLy0aasumlec7mGAHM0xrkx/W9pQiwu5UjpwqHty3LUFNU0Z",
-	"zDyyeEMWVbeOjUuJdt7Nm1MPkzGdZjyT5Up/UD+VyOLNkgoBvm/ya8GeGyXYbxhLB5tkHRsXUH/g/R2J",
-	"zvUDNcTbuEXWCc+u1WaE58JV0156div8IT23kp5L4FotPecF4u5SfK4+ZL9x+dnhmw/gNvf1e5Sg71va",
-	"BrM27pKzt0LjWguoRVne1by/eIN2447+fPLNy6WuHtT9DD/lJuA8cpJgwWuaRcFvDR8Gm6V9mxcB7zOK",
-	"PSs/BeEXtkzuRcyn6zMv8pFcmoEn9eKSuXcj3pl0yHcoR1SkOJIkJqGyb1PHHN5eNgHuJksDp+m7PO9y",
-	"a4ieQXhnORMUJu9IIiiOUciZ5LGpQfpuniTvhsu5/Benp9DJZGCYrP13w/y96PyOSd2qnFahdxFjqdAL",
-	"myzS0QcueBybt0TfaXiW9rdlEy6KFNVL5ku+YOTaDkgn6F0pD+NdQyKGQ8Ln+pS+0s3vNtcFNntRHAkA",
-	"nHnTg8Ajkb4kDPuApScFY2fgLUnTMh3ELOOOs0G6y+93TvO87woq4zRti752mYDF8yRZgcOoU7ycgKSK",
-	"eKb+LlVEhHnqyWJ3E3KjDg7NPxS+Mg8TVd5yMJVxfaCyqc1eUAXm+TVXUNf8a54kgXlYIsG+Armfn1ZT",
-	"H3BZH9MnU8qd+cEzbpMVUyX2pbSYGuewlZmhCoRXeXtlGnz3kosrYf2V0XDzrojSKigU12fReAFnW9QG",
-	"v185AXCQxc6A39l9ee+I+9Z4R2xJ8e/+jhT48Z3fkpALeCtPutcu7k/wVknjKF33DjxEUBT47zqt9+L0",
-	"dKvp0pjn1hqvjPihDts4yu+ep8DbDPfvtpjHdnC+gVXGQn0hVKOO7nRWykwZJK1qmPf88XJZS3jDQC6k",
-	"IolR2CdZDIltELVu6wPg8hsNXUSVhGrFXTBZlerzX7IxmWh+mBKh59bdoWxWoXv41NpzhfPre2bu4Leh",
-	"10KlS1DlsGqC2tLz067IpU93yutyfvKSnoKiWn0jQqJOTK/MIydoLlGsf2yt1HTNAxJfuvrBp9+s/IkU",
-	"X1arwdkcmb8HCndSI2vuuZ97R9aekfJlcfQHDtpH1ni6is3z9AeXt28P/ZCJ76VMDI6efDedqcAhcFxp",
-	"X5fyy7/2mZbtD+bHyTp3ocLh7MKVCP82WKmtKLxuGrfBe3Ep7Z4iYlJ6N38neV70+Z6mbWjAuS2A6aTs",
-	"+PRzAVNM/nvD7i8f41KG460iXDZ6t1y6/DdztzbN+ewaXLh2GR735ZobTHM7geLFZdVWlB+ZWanQukdH",
-	"4MUj1y1/radbfoLJVOfLFdTisYD8tZf+Jcuft3HVAdHR2ZuufVK3C4/2mhHsoyp95H+FSCIsiHuK6JIp",
-	"jkIch1mMFUH5czzmCS3Z4NZ9VXqi6s7uWzGJ56Dzd4hk/k7NfdIx/DgBp1d+CAcwrvQebWNsqX2adiOR",
-	"pZaZ3SKu1O3gRwhei6jSErDalN03zfvoPEtTLpRE6prDe5QSfPlQZHHMo8UQ5f0YMk8fWRJn36yx9edJ",
-	"BO+G6L6nlVr8pQFcz1SQXspTIB2RSdmxMDbi0XKV/4ZC/rl8dHfhsXXRoXvbtwFKa6meR3WPKC+8b2vB",
-	"a9haeLkhWlV89z10kj8+EGZS8cSNe3KMOjhTvDclTAO3qPOfCj6nUf3Zt2/kjadTfEOTLMkfOn32GJ6N",
-	"FCbUAx4QhkAjh1PkJiQkkhD5sXXL96CWn4KyZ/FpNe+/HBFz1LRRpvyKMdNFcUJ9xFrGdEiuOEcxFlOy",
-	"9d1kJtq7ViQmnhzX0hLvYbT33GFfIWe0jO9up9K21DTvIrY7N3dsNrL74tvRwkr12+5heuE8FzObQsq/",
-	"LRQcbI4lbDqU/OIeW+20tjWvgc0MoEf0IcxzHuIYRWROYp7Ci4embdANMhHb99uG29taTYu1Ijc8GBwM",
-	"go9vP/6/AAAA///Bm92RVcUAAA==",
+	"H4sIAAAAAAAC/+y963YbubEo/CpYPOcsS/uQ1MWyxyOvWd+WJdmjHcvWlmRPssP5OGA3SCJqAp0GWhJn",
+	"Pv/NA+QR8yTfqiqgb0STlC/yKOOTsxOLjWuhUKh7/daJ9CzVSihrOvu/daaCxyLDf/6590bc2t5hnhmd",
+	"wQ+xMFEmUyu16ux36Hc21hmzU8GUuLUs5RPRZWKW2jnTCn9PuKHfO92OiaZixmEoO09FZ79jbCbVpPPh",
+	"Q7fz596ltjzpHepc2cXZ3uSzkciYHjNpxcwwHmXaGMaTBAc3odGlsmIiss4HGD/lGZ8J6/b2WhrbujGt",
+	"rFS5YHxsBW0uzcS11LnBufrsjBuDv9dAxAh2sEY75XagCBo30k6xseEzwYzObH+gOt2OhLn+nots3ul2",
+	"FJ/BiiNa0nJIwdpfy5kMQOmU38pZPmOqAS2rWSZsnrXNm+Bw1WljMeZ5Yjv7O9vb3c6MxsW/4E+p3J/d",
+	"IKxpGAT0QSr/JObwrzTTqcisFPh7lAluRTzkgV0cwjcJ+CNnwlg+S9nG+cvDx48ff7/Z6XbELZ+lCUy6",
+	"u737pLe909t5crmzvb8N//9/Ot3OWGczGLcTcyt6MEin24RjtyPjxZkPcqt7E6FEBotjuZJ/zwWTsVBW",
+	"jqXI2Mbhu5OjXUYz1Bdjf93j3z+7veX2+6fyxnz/62yUTf72mIfmJrA3Z/8xn3HVywSP+SiBmzMSSW2K",
+	"SPZikSZ6HhozE9f6qgWiP00F3cYrMWc33DDXuMskoAibcsNGQqg24Kk8SWBNnX2b5SIwuYl0KszixK8y",
+	"rgCS9J1xwwadQb69/TjKhNF5Fgn8S+z7H3n8/91k0rqfB50uu5mKTDDfnEm6eWOZGcsOzk5Yyu10oIyY",
+	"zISybEP0J30mlbFcRcJ02SiXSWy6jKeydyXmZpPpjA06/zHo9NlPMBOTszSRAmDC4/5AHSP1mgmuDBvn",
+	"ScJ4FAlj6NIWZ/HXTjHHPi640+3IGVCifRin83O3g1cvcIUL8PEs43OEXj76m4gC5/bOiKw4Nx5ZhOBG",
+	"Iq8E4+y/frp8ZJjJRyxKuJxtNlFlpO0iniCi/D2XmYhxE3GnnL44xm71ev5cjKGp2Ydu58BaHk3f6ySf",
+	"iXPx91wYu3jFZ0DJh3A8ixs743bqTvYaR2FmqvMkZiPBsJ+Ia9vZmim7FXPLw5jPY62SeY1ujXliRLdJ",
+	"H2Foxumse9inGG+kdSK4WgBRZRtBUFxziXfjSFzLSAQoXZ5lQtlhnMlrEX5H4XsyZyOdq5hRO7YBdw6u",
+	"p9JK1M9WXctY8nWuZYxrGoZI3dnhCaPP7OSIbUzFbYO2fjd61mkfci0K5sbHttWxX++FRpZ6NsuHk0zn",
+	"6eLIJ29PT98x/Ohet+qIz3YXHyIAz4wPlY5DC9XGsjfvTg8YfMcr5hYrDeOI3SKGZ7M4hlxdKX2jgHoY",
+	"qSaJ6GHPqTb1d2C79VgqK0s5okQ6Dp8Lj+NMGEOchGAX572Tt+9ZOp0bGfGEjXMVQWuk3nYqTXXt7Fpm",
+	"Nq+0qkF+e3t7e//xaH97u7+9DgKlkRy61Sxd6uIkfNdPsjDotVCxzlqxkj6HsXJnOxZLhlwLK934C1j5",
+	"5v3J0ckBO9RZqjPuQLecfFbBU91X9ebVETtEQl5wG01PBSD1cZbpLEBDgkiMjRl86xJNAw5PxGw0Z0S/",
+	"T9wTVaceeugWxz3pCkF0Jozhk9ZZ/ee1mZs3wP06hB7BhtlMNK9x50ZnVyLrfbcS8O7wEC7lWoPA1dpe",
+	"WG5zswhW4aHd5JbmxPVPuRFszGUiYrYBrwU8WYoZyy1eNvpUx1DX3GpmhM1Tpq9FlvD5Pj1rbCsW11vX",
+	"8WifKc1MHk3d3Q0BkoYa4jIWVwkbc0sEceOu63TrCs2L/UI084aNeVZKdSNYwUTb/YHqefq4z95o+jDj",
+	"cJaGSeI8eZqyRE/YhhLwvEETEXeZTmKRMamkzeCvjGXaIu+tc7sJ40JDqSb77ERJC1vK4OsoJ6ZV6fI3",
+	"mAUQKNE8ZnNhoTcIt4mwYp9dVr8CC+y6QSuCzz47YKMSqPQj44pGngCTw1J9IzJY3XhM/KACMeivHbf7",
+	"Trfj1ovISXN3/El2fq4egPttFabTYQQxGzjbAK2At2sI3fDP/52JcWe/87+2Sjl/y8lnWzjCIbS/wOYf",
+	"ykWH5Qjs4IFak9A+WnJYJgi66RbEwbVlvDgnQj6cmbbRfRPA05lMEmlEpFVsqnNIZZ/uddZ52FsoSo1m",
+	"Nq9obup3dCXIZNy2mb/pUUVard13lIN6fBTt7D4Ocl8gvAxjOXG8fH34I/wd6DeMY5mctW4E3tn5evvA",
+	"KRE/m/O9RK4LJ8nEWGRCRZ88nc5tmtsh/b5I87mltwkBmWY6ziNh2MZYJsKglivRwHwhPeAZ45lg3LIt",
+	"bG+2fpPxhy2eWTnmkd2sUAbcRKfbwd4AeJ51fg6sLs30tVD4Wq9za8/K5h+6oM7JxTDVRtJ2Ftgq9wWQ",
+	"nDaIPcIQxU/x5lr47ojoktuLLT4DnTDFG74SNu65D8u69G2lhNskjcHXcMbVnB3p6EpkgCbMWJEahl3/",
+	"JC2zWl+xcaZnTFrDkC4j+kx9V2kHStyKKLci7rNTaYwwTCs3jrhNRWSJlxgJ6h+jYoJxxQTPEolPZ5rb",
+	"gYqmXE3gYaL3mSZDcZ5Jox5ZNhJSTUDXoeHASJsRejimMrTbC1yR5VdC0Y6KSapE5kkIRWjYGW6ubWAP",
+	"hOpgO62DxcPR3AYHk78ij+lAlfC5yAwbC4t/FuvOxEQam80dlDZcO54gLfGKa7wngi46sBkRqSVqXNST",
+	"3b3dZ8+2tytI7d+KBb1oHRkrwG6AqLHJVuQ8vhbKhkQFZUVIg/5aT1gilWCuhbv8qL+fp+KHRE82O5/n",
+	"4nU75X1ffAth3R/xlofpthsNvpU0N9GT6lWfCp7Zkajd9BZuyw1Urq4V/Gc1el0/gxE3Yrj8QT2TCkU1",
+	"boR756gly02IKezS+30l7fBaZCZI5Au641q0DvVpHGKioyugdsMpN1PaL49jfF54claDQ0B5VLdWpHBb",
+	"/YAokaOt4uLHg90nT5mbIHACRkSZsEMTcbVqBxfY9AJaQkdUIuPSA+SjnBbWRW3hsR/xJAmiZDuW351T",
+	"XkTMMOL9NzzPLY8SNjAsy5UCag+PzQ2XII/gPefMJNr22UWirUHCZqY8A9LIZQYKSGFvhFADoA9cWcM2",
+	"UHLL7VQoKyOUy5yy2JDQafLRTFovfREvtNllRjPuR3lk3O9sxueOHeBgKgMYk/jnviOB5oy6ORuaJ8og",
+	"7M10JvzeQg8Yj6y8FqugUqXfe6E3ZsZvh5FWTm/bOhzuP+IKxmXcMt1QtATHToWKYQ1tgy6eVnXI70JD",
+	"urNaHPKSPpAB0qOEzoo5COqdiqFi2T2i0ZoYuGDMaCrP67Ds+jMqIVFuoJXUluqbNpmnIPX+BSAetuPI",
+	"Js2T5mZK/0KUKsVyoIgqEokT0Reu+SE3EY/FkUiEFefCoH2hSfNjQWKzVJNMhHmdE/+JucZsJCKeG8HE",
+	"tcjmLMsTJDcT4S+ULBV4ax0SLTE+d8aykK3Jr5PMLoFVklVncY1KM22nIisWxaYcVzkrFOafcZl+kqCW",
+	"+OTIaxL9ImVI07m+RbYpKVQm7wZOdhGKIdw9TLQqVLCtVrIIWtVPY5kF65W8JnMF9mORTqUoDAUEzkeG",
+	"gUWSdN00bp/9JO1U55bMBXYqBooGmAhr0MTkxpj12bm3jfneJOsmN3xu3INB1LdpOFtH9Yuz1k5pNu95",
+	"ePcykWa6gyT4tVATO+3sP33c7aTcWpHBUP/vX3nv1+3e9z9vuH/0fv4P/9Pm//O/19MbBw8LxUHyVmg9",
+	"qy9htm+znF98rMXcmcAHTQM12NIHnf9A8/Sgs9kfqLczafGlqZq52Z/E3Dj7QUxvBydBMkZzO1iiZ7mx",
+	"LCMowUNv8pERltxNDDX+/djL++yIbhSyliS3JonIgjtVfo8D5RCeR2gwxtf+SszJ4g6zNza4zOLegm1k",
+	"Mb4jtr1NidNmk0QDXzr3XioVY2ufnYxRZgWlkoxF3GUcP6CFsO7jUkjFVcMjohCgSxrJHpjzeny3t73d",
+	"2x506laEZK83SfPOwhU96P0PXMnyn8N+7+f/+787n2BiLEk+7nPDX+su84ut2h2bC11lk0y1TpYA200K",
+	"rQCLeBxX12J1n53BJ5JgkEZWv8PP9C3lkeg3IYhzfzwIl9gk2yndCdy9u6Le4cmiTpaAH6MSrC/1ViJH",
+	"Gc/mW2oi1e1+wq1oGMg7y9t+Kgl3TNan0XA8sI0EjD0RN4IlAo7GdEFIl9Z0UbKKUTxl8FI+B0GgsEUB",
+	"ky1UQTyh3WbzyQN3JMdQfNb3rtsBLjJwk851jlw/fnZem9Kwcg1rcW4eunmCXNtMqhPqtrNCEHAGW1rc",
+	"stNbwS7RjQrs78j7shjmjPtI74k1xf2+Onu3BfQk5cbYaabzybTPDmpXG8+dusDbq+ZsnIniGjtSyS02",
+	"7tefN0cJ7/SOxdJcDWNpIp6FfCG4Mcx9NWzj8vzkdLMk12SPdC+aM+aSwN/g/VAaRyvGQJUdiYE1tD9w",
+	"YoGZrvrssmiB9mpDDD5islaoPnQrAsc8GYHnXaJvTDEerMDomcBliObjm+UCj+Lvkb7Z9aumTsjt4seM",
+	"3zTe1ppJoMJuIvykHo7SEEJIc8VOtt4yeOcYeriW79rO9vbpiy1DPNET/8dmfbmAeTpzLwARddDXxUwr",
+	"dnj2jvEEjDpkVxmDWnUsJzlwxw2XFRw9dFWFuv4E9dmxupaZVuj2eM0zCYdec8T5rfPm7dHx8PjN+85+",
+	"hyxa2LXbOXt7ftnZ7zze3t7uhPiTqbZpkk+GRv4qajJJ5/GrF53mQg6K9bOZmOmMlMpuDLYxrdNWUn4x",
+	"dGIcwHh0CDuvmk/2Lk61AITpPBXZtQy6bv9YfIPzy42oEjqiLPUjNiK7FllxdniY/YoeIUp0HvcqU3Y7",
+	"fxezvNPtjGUmoozDU1a36we6BCy4iRjyqDTWefAaq9NON2SbnPI0FcqQsQ77WzkTINKRERTkb+D6YZfx",
+	"aD7oMKN4aqaa7nCx/4GCfzn1G0yXol3IdgtPB/Tkd+9CweVbzaRlmTBWZ8Kg5WokxjoTzrCUZvpWgvuJ",
+	"iXgioPmvItNEOMbcWHbDr8Rmv+Y04TbrVlyHov+xDXhu8yGDkk5rG3Zu/M7LGXQWSjMlLDiDMJvx8VhG",
+	"bEOqKMljBAXtfKDc1s0mQkZptFIxIwwo1ytPaKLVhG280oUrAXGkgNzbM5K03ikjrPMprq2NnGEAEDQg",
+	"ARN22BQvHm/PWs32a7FqK3gwnqRSiVYmrNuRStrhrMWb8qbyJmW53+UMoyUGHQDcoNP48MiAenwGsOWG",
+	"cedVOVBppkEQ7TLn5gzmKi4VCGyDjpkbK2bxoIOeOoa5v2GEs5MjtuMUpyDQ9t6fDlTp8QOIOMsTK9NE",
+	"4LWXkTDPQeIjON1MtRHFishm6kfHuQZqy4yk2gI41IlIdVlyHNyhLJbaZQIeOg+U+o2A3+BGUNPGjXA/",
+	"Bo7mSmRKJHA4Yd7v+NZmnFEr5lpVDgwAZBgnh64uuA+SEHnqWkbwnnvGY6BonEfGjcRsJoT38qo4cjlt",
+	"vnPvdk7d6DKRyNGWW8VATTXqZxmncXw4Ea2MpgIuDYyj0MzNidduMkHNH63KX6lH+MUxIlcyTb22qsKr",
+	"jXMjOt2OGtX1DisFsN7Pv213nz7+EOS7Z/zW8cKPdxdZPXdErca7V5X9FgY8S650ixoMerYeGeZejhJQ",
+	"oIxJycZfDAOCyVxYb9hGbk8aFusbBUdPDA0FVORG4J1w/mwD1AziA/M38kUoopkSSf5AhROpnw55PmKp",
+	"S0abqKnMHN41lt3UpEx7T/s7u/1nPfre2+nv9iDWZ2d3J+i7lOjJMBNWKP+gLhNhXuvJedF23VicLy8Q",
+	"ekrV2/nM8qB76gJqWfpQZ36KC1jRqDeN2yq+kbGdDj0CBXhv94UVjQsG/BZ2wpN//eOf709L1c3Oq1Hq",
+	"uPGd3SefyI03+G8YOmhRLzaSp+FtvEvDm3h/+q9//NPv5OtuIlZmSNQgJPMLoxP4hNKYFYpJZXVJXx8Z",
+	"tiVstJVhuz4gwhJac/TmYnhxfP7++Lwh+u5s9+E/u51uZ6eP/1kuBlco5SKhFAouXFxji0n+WwhpQ2tU",
+	"KeMXTJVbuOvueb11BMqZzQNBlZfvvO6x8shcHpx5vQDcfXqv3pwcLgHgm+PLn96e/2l4evmuBsHvt2sx",
+	"lt/XYyyffPc06HkneBbBHZxxqUL2A/zO3Pf1EaB+tOY66ktFiN4h2WvGVfnTmuf8NKAdWhA6nTpg6L0j",
+	"qnJRxm8WxCJUYXpx0h2QG8MpMzJ+U8QLcmOFsc+96gHMW+DOZkrlx0CheraggCNwTKnySV6lQUMoIYBr",
+	"YqWkVz6O2GKgIp7ykUykndfZPNoNNqrzePRTyHfVwWZRIN/ZDkjkP3kdUBUeDDqvEMdhNK8UWRTIt8MS",
+	"eWBRgTW9gHfT6QfWWUmxkJ3dU/fP3XV1BJ5XXuUbRM1Iy4/ODNdRmtetsLvd1kh0H2l1ePaupncJBqOt",
+	"tLeXhMzqGrFh3NZ94NdV1tLIGPO40lHD6WdJnFytn23Xr0cr4/f9ELBP3BeIGhgLRJZmWEpcCb63YpYm",
+	"3Iou8Lbjsbz1bGhvhzn2kvXIGoqT4z+b8vOTRhT78iD2bsdPugrGYbV1E7rFaF0Hn7UgHHY0wRCDkLvP",
+	"VLiQqmo8EHGmoMmeORCToJvpJBnx6IoVPjBrodRCqNoS1w3TEtlfcdcAxwQfmk5BYX7VSKD9knE/EcYH",
+	"K42aJ1w/+k9GV3TSa5ovaN6V16HcQ9cDvP3IVsRBhzxYCsNilBurZ7UUAw0Draybcuv071onvZhbjlLD",
+	"mpF4tNzF+MfZnIYiStVG6IeTUYvjtVRsIiec3JarjtzbK72h3Vr8+O2gjst8EjxJ3o47+39dfuKu/Ydu",
+	"81SuxDx8h5wDQJ+9BRQsgiq1Kojwc4ZaUCYtMyLKM5HM69z6dDZs8z0aPhnvjvr9/kozJ6xvEQ4/f+h2",
+	"mt5TayGcb81OjmpLlWoybA8KCiNQMVYIheqGzTWyD7Tac9sC6r232dDqkI+dezRPjuDmVDzTVkaYYPj9",
+	"0Orh9VjqYA4NEjhqseJRI3rfvd0wRC+NpIvmd8Yy0qTQ/pHNfH9as0ZCLCIsbt9rUKQphy2GBIJOTrEw",
+	"xIbOKouQ6PXNRvNNxtn7U7Ln0WofGaa4ldfCralI+sFypwbqUyxkYmoLyA0ZCJrdnS2NkhFgVg2l3bc+",
+	"+5EEBXYjkwQ9TmbcygjdVUaysR+0aNBBwUzAB6nSXFN/xp1P3KLktiyM8JziPe4hp8wXyLfwNdPUfP6M",
+	"DEF6clTxktnIjch6/rEDrAr5K1Xcglr8kRZJ2acng8B8Cz7Ot5rw4asnePg6eRzCPlNHVVepytpHAgxl",
+	"xsORq3mLH1Rr5M9yl2KY5BJafokME6GHC5t0PyIHRPOpWRmMSJs7c+AOOcQMZRxyOD9qeM0V0fgO1BVN",
+	"TytduJNHS/iCF75x6514mDmsbLQdRpfBIDH4FQBR0uCKMsb5L0Yy6PsPXiQvMsGvQNm9CH1yYW2LR4TO",
+	"GIUFNjVx68wymdZ2bMhEWNcb7Ox9t/fs8dO99SIKux0dySEFc62zALAJY6ijj3t0wT+jRI/qZPTJ46fP",
+	"vtv+fmd33XU4R561llGoNXwvtuEg8n+9sdB/qS1qd/e7p48fP95++nR3b61V0WDrLcq1rYst3z3+bm/n",
+	"2e7euvGdiziZcanaXdngazAYCP2krHbGI9+u63ykMJmfATjxKBIpevUpcVNRrACHWET3rFB6Ny5bsaif",
+	"2/bTlkyFInuGbt62ACcKzIF3XSqQaVFQ8OwxxRmAlQ05xLFU0kxXxgS3w9Gz7G3QwQnJ5cIbONexErhY",
+	"quESRUehxWHGAgtchF8pehNB6Vyd6nFoY0a66NJAMj+/aZ/G5KN52BWsQxt6hKDQbeBACIXulODoIE0T",
+	"SeawnklFJMFVRxRZj9jGDGUGUeiQ60/5iMdD58QTZtYtl0ng8Cr+bDSZa8k2QOAqnEjwG9KotXRPuPMj",
+	"HCmsNVMiGxb5Q+4wUmumpoYN2++laILyYyxG+WTSiIvsnDp3i1JalSKJ95nPZrEcS9ZIy1Tdw5rY8Bqs",
+	"771EXIukigQkK5BvSCZYgSd0aA1lxzVPJERypbm9U9Krl3mGlIQGZXxEsVQOqLVJyCylNITW5CpeLyDk",
+	"+FZE57laolVHP6JQslr8QFrebJLPhCLLY5Y3nF4iDltGc582vUwkghtxN+4uSvPh33NteWAdZ+/IVOZW",
+	"isG+uQGBDn3ffgAtg5zJZgaF7f6TKmHSeS0dmZMrYeqbwOZ/0tkVHHwsMxFZndUlii2epsF7n+l0WJjm",
+	"ZFBPXfla2xW6sN1QLJ1z8zs8OBte/OVieHB0evIGPZ8OXr/uD5Q7GYw7BhajHkNlnOvflF+L+hAL3kq1",
+	"r50u/v3m+HJ4fvDT3Y7v09yNq1SxxfN4Aa3J3DdMWrIV41fnVOGtrh7SAbwBPzD/+Uqi/h96idtICHLd",
+	"tEzcSh/yjNRh5/F3dd3s7pOnp2GboYiA1g7TTI9lIkIWdWzAXAN3yWqLzlVMforOfDjooEHDOGf67kA5",
+	"/zVo/OLsJbreIWuX2oxHoltGCTHK6GK6LBPk68hVjFGb0ZX/Rvs8Pjs+P+2zqo+pQo9/K7IuQidF8A5U",
+	"AwW7dadH42M8YVe4k+pG6hZs93NQZDI2lgEPtyNueeGG4CPxaGYIqoNOFTWpBSYnSnRLCotW999jzCTj",
+	"FX3oHq2YS+nFNrbZDwAb96mGUGhjgg+G6TyAR7t7NTx63JAJHu8GZRCItQeHhiGfBHMJXLiVWY1h+Q33",
+	"R+zk0v/4mPWaWWXlChYeZtxs5+dlT1CL2fFW2mH4YfZvEDRh7u1frh4zNhZZwH//wnIV8yymZ7XL8hR2",
+	"v9NyYW3c4gHuBqGEXytGsVmuMLNFQG0AYpgcM5oIM7/iuh3FcTH66MsQ8dRl2wCTgGVmqjO7huJ6Idkf",
+	"bqkAULcC9upS287vgpzTP4J/wJ1Qb5bxxgu0BX7PZnrX1yZelY3cT4hNPQW7mcoEbWvSMJ0KhU+qHFdc",
+	"qShsQWDuPiL7/SXiz97u/s7j9VX4S/D82ON3Eczu36qd3e9cJvXCSo13Nlebflu5sjJp7tr0V16VkA7T",
+	"HXNbqr+7qPgzEeksFnG78FxZ8iPj7pTLIU9dEfEjrnDHIgUV01pi9LLccQsoslrMfXw3U83yGHKd+VeS",
+	"HgZARFG/JRsDn/WT3P5zVUuUUxdF0arRYmS1dr4W9DF2hbPLy7/cWavTSPPuqQHNXTuJCkaEiAy6qYPm",
+	"7J2XkxpKIO8N3qZ1ezHHKEHfDMO0VJrJa5mIiYhBZMhqWqvvnz59/PS7p3s7T9dS+sWF0bhxZchiXWp/",
+	"SzGBMvIGb8fYtKSLfCkTQU5mRe6xYkBxa4P5zV0ieS1DjABlpsePXkc/cYqLylKD6KMtT9rAjTVV6ImS",
+	"ilGjsI5zLeiCurRtqnekSm2d4e458qoAK062PJT61muL6y4gYisyv5RJAI9bs+hB80oGPZf8qsigOQS/",
+	"pR9Qf+tq43jZVAqzSBIYRr4/pzglkQ1d7JOgLA3PB2vZ9oSKdDip1LH7gjkRac19hqhLVM2nNkStCHt3",
+	"+bL3jHmX9Kd7DAd24aw+z7Ed98BMTS3qzqv+28oFT4IeUTdKZM6cfHK0+lk0w1hm7TwbxXwaxsPKgVY/",
+	"gnCAG576DFWO75S8ZanIMCBJq/qh7u0GFzvDRyhw52M5dvpN79j5mRwRllTdqFIXEnDMfDbSiYxYItWV",
+	"YeQM3izAAXojxFb6b+8rvsQZeAGAS8jQmiadNZh1Kg7jQsR4NiF3SNrzzukLlKOcygH5FneVPeOux+O1",
+	"8CRvx2G82CtRuJm1Aw6sQGuHhw6aHoFoVro/rfTsjEhIgKTN4kSqJeIbfK3oEDeojBfQMBeWZqcAvDrG",
+	"/7WD6NDpdnqTTrcTczHTCqD4/HMYjkmaLyKwqhMX8y7iftDsT2BpnEvQnpSGB0CPDpYGxwne+sy02h7P",
+	"hUHenRlhl12LvWdPvnu63tPckrTf7xs/s43zH5zZpssufjCJECn+++gHChCAH7rsf374Vc9GUnRZv9+v",
+	"P1oXq9PPIIqmaenb1y1Qz6+yCptWRC5SXDasptJchXxYRNajCgsx2XXJTrGWZabB1AawE9R0O4uT7rCZ",
+	"VLkVqMZj/FpkNGtVu70bUGbjcE8C4z1ZPeBO24CB8dYY7vFOYDintl3JzDsFbtEOiQUYW8swNhPE7Gfb",
+	"Tx5vP3389NlaqO2WM85E60reKbTkU8vglIVPw12mXIO3dslS2if+FA6Y8M6fb4E4wfW1HlsIgF13j1pv",
+	"36VOdaIn86Clh1n3teqpWUY/OZuriNk1moUwx2DD8t1ktzNhhqnIhrEUpG1clkU1lq51yqMrPqn3CNN0",
+	"amhWt3SPHA4PywqYh0cGOQavoigDwB4ZNk64LSIPSzClWGJudZCQDz8qb0pYq5wBDTdBtYFLPeZC+/kc",
+	"VJcRh3QGYw2+FUUM+CPjX/QuM5APwmLqpMIR0qDmDXxxIL9flMmRMOgH7OKd1n3cGzhNe6xsIoSDPwqe",
+	"EAdbR5QyGbmXSPRVXQrRV2uVVMlb5tV11G9DU4JXHZuCYZoTb89d+QAV01JAu4x4Miyj0Wp2fZ7FN5Ri",
+	"Do+vWgzVHWQV057uLS2OFpigRAGSE8lIefauYArluMAilolUZy6J6NrOEDDDGx0HH1u/hSDlcR/ZBqdb",
+	"KMdsC3iyrSjNpRrrhgJW8Hhz5a0LXfllPZr+iSUkgyhVkIfDhs15kbGBZFYtxtILSutgWLyY18rlUV4Q",
+	"UiZpPqzEFywZtOKdXu0QGtTnhmrVtPkxS5d+TI4g/F/lXNAGlOR1KTY0lzRXHzFTkb92vVnomVwyTyaM",
+	"/BUGnjnGZ/m4Kc/NMgDh9y3y5QsOQOHA7QPU0qgxetFD4/hMUEuG8k22XIontuEyMG0GR7yGe7hkOKAM",
+	"PXqCsCmaSnLllB2ri24WK144HQ9Wv4ZFLO82rtICyjbwqhKJveTynqixXqLvXq7Br8SIj6TiGRXhRf8g",
+	"F85jUq1icnvkReIWX6V5Ef5N95VltLaFAH3oLqt/55cQCysichZz9eVKwltsfnP9eh3lYppFO75QqrXW",
+	"jDtHuDMRVw/H77qyySYA6vLw3veh0IhwUZFqucXa+S1HPCj3HXgtfHz6EgCjSETRVS7QukjnFmvhiueh",
+	"u9ycaXUPZ1F+xT2sxSk0buAq7tLDpT5ZCMIns6AFK5qFrOSnRxR4VCQjYzNhuStI/MnKsBaNeel399WL",
+	"pbeVsXEZZsDdT8kxYha1rM5spnz3ydN9CjONxXjvydNgBCzgn83mLRay4+LbekexRTnueuWYfTP9tHP4",
+	"Avk619nLb52zg8sfQQmfm2wLC8lhKrr9yt/Fn+UH/Af9OZIqmOdzrXKF6AFTL1NYO940TxL3+z7sRDl6",
+	"6X201rAItdQNANRM5K8iZsHU05ZjPRPCuE/LMf0JJfTKOt22UjqvKj+sUUZP/uo1M+E4lZqO2M1JHiVF",
+	"/cO1NF1rVfRbUmxlodBKWcYF0ID+FWl1DbciVGul9mb4bwuHcUOOvWET34LX7zp3yHsD3y3cwYeeeZq2",
+	"bvVAfFteHbbWisnmwyxX7UYspS0KMDfcZ1YuC9FmOCjm34NkHtyyG185PxMz3TDctRqwxpkQ8XKco2xI",
+	"0O7TFZvdjlvcEMPNliWIyVVxx11wmt9Ymf26EctWW9bustld1N1iwE6lBltjPhASXIFtJA86m//n4iv3",
+	"1zaa858tz9/PH61B8+izsKsmkOun3IqoZ3mStFQTxJ7DMlNk0HqYYlkc5/zhfePodMqemIWcZ82qgz4E",
+	"bDNg+FoLrWiFqAhfujhaD9BRVGv2dqpF/ddZ1OOdvSff7a5nsWh5V19ymeSZaBQCLqZ1ryzZ5PHfP5Qy",
+	"xwKK4IaWVeotT4FC3Cpnsc5+78C2tb0ZdKlGlZcjvOXNT3tQ7lKX7x6qTxaPhAfrFyhB6eowBMSXry4q",
+	"fEzCivrsbyf/9fc/m7Pv/rbz99fv3//l+tV/Hb2Rf3mfnL1dP0dNIH/rnTLV3G8djaXkvmpJp0Wt5j9o",
+	"+KM3F6+1vsrTRTwp84YG4x+r2Sl8skdIAOo9sMl9TBmshl/Pn7D7HWYD3dnf29l9/CSoBtDGLqkUhmMD",
+	"5wPqLyniwLn1FxJRhhAxXSKvnpxd7/mkF11Wqntgw7A2FssYbGbOGaq+xe3+zjbuMZgWA5+UZcHBwVx4",
+	"U1GFb8RVJatPYBEtXE7YcR0GJhUjRvvEos/e/Pno7enByZtQUvpYC0x/Lm4xxzNlClKanZw9Z5AA9uXB",
+	"yWvX74ZfucArZJWczthJg3Wv/Ddvj8/P356v1JYV2FFNbtvxe1sE7xL8P+U2ChgR2/HvR/eFWc1m0LnP",
+	"DtGxfR9SI72WVmQ82WeDDuCg21o/0jOsunbLI0u9mFYMhmJTwWORbULnM8rhDJ1/84v/0Bwjnis+kxHL",
+	"HJEpcgObfESZXDcHaqDcWMxvxGCkpcK8iRFPbZ5Rpo8ox6quGcdK9pSvqZy8y37jafphE8rCcDhtm8EO",
+	"Up7Z4u77GagwC62Kkkq55mDk50kujIsAGFSZd+dqSCUt+wV+YSxxM+l3GCjhtDNZPTvss+1u4BwZtIOD",
+	"BElJKFbktpYGiTfbcAOwZ9vdelouG6WbdXeVZ+EsP5m2OvJJcNxqOlNrF2t4nLmmLgn07bycHtpv9mFS",
+	"96jQd0heW2pTDISQup3AxvoD9RP6WySGuZTJXcaLQTDTmM4tZbeAQ7h8fcEu3pyUJwryJPwoDZr8RDxQ",
+	"zoLSTED63Ee8YpkQ+IJTYBXAEXkbIFeHVSQVugi4JVa4IgcVG6V1HYD/fT2asOSy41u6cNdnngSs8RoT",
+	"uXAVgJ2mfDjS8bzV/4kSihZadWjbUNX4uihWV68Ce83RM9V1pEQU9Rz7ezuP+2wbE2DR41REdqJVq7+m",
+	"o2CR5TTsoihIiTLEU1hZfBTZOGdJ+PHy8gx2Bf97wfxA5RUr8Iw4fucB47xmEtQlOrwNWxgJUmue3CU1",
+	"hm7JGkVUj3FixH4rsplUxBZvRIA36JEtKO2YNCYHCic5Ozg8Pd7ss5dEHuimdumOwRVbuFpwp2gGd6lc",
+	"2Z3+auMn4WwBgiU4f1kAqY71/uYGNEzYo3zrYb1ddnKEQrF7O0odKxSDdXQxV4kwpsKxSMOMsJgzEICS",
+	"0ONYvkn77J0RjWo3ABxKvEXokszLklzE2Q06m37EtPnK7bNzvzDGi8UWOqES4/yQ5ZuCww4UhpVRQsOF",
+	"0bv1tcrSEZ65ZxnTF/Ky8qmVM9H+jIVr6LQzhfiOI3Do9b3R8BfmtKilTMYiESOe4CrJ86cLJ+ERbKAq",
+	"jKXLYgq3Ei8sPTBIYBYObCHxwI0YYV5Z+N/du7lzl290APngoy9GEirc3PbcGiujq/nQlEGuS5N7Y2sf",
+	"EbvgpqyztptVXp0vLlo/vqsVblm9QO9w4Ar8UbNmhb+1dMN3r6tXT+VeKYlRlNb7ujXxFo55ys2w3S3G",
+	"g5IXfjEkDJnFenJrAXSxnl6dW8Wvy5Ljf87KeD4X1cI2vnTNu6+YyLRZb++jyus5VgaD1IGCVpttfum6",
+	"didxIpC6uMz4lCmj+WT5yPh6yt2KOwtmndl8MJXlTpS0FHhXOpX7cghi0U2naiLCRW7+voqrrVWGbOXj",
+	"+nG1xKqYQgllAIk/sfAWNxbv1bW08+DD+Jobu1BtUWe1WorMCKG8nCoRz4nIuAtHf8Utly74tO7s7z35",
+	"hKx391VSbGkRsE+t5NWoWfSZC3m1vvihIlgNDfGTtsf/40tyfZHlrFlcawVpqiQu8SofJw1vflodraWl",
+	"s0LsTPWlqOS1/thqWSEF+4ExcqJQwV5kua64yPjhG0fw/W5/5+kz1KqjTn3l9ZzxaMncpweH60++vUsW",
+	"rn0+2o/ifTFea/62QmGfBxeoBNi6+dP99Sfh1yXxquYgQ6amom2pvNaFu6RdzId2pwJjelw+eo8K2Tn7",
+	"jOXEVlcQu1uO90oxN4pTw7SFzrM/E0XlhS6LptoIUh+jt520c/cYWVONl/BhDX12UJx4rnCc/sqw41D5",
+	"s7uUO1unvhh9WKO62McVE2sKeWExxeXGD8kDJ0fNV4ukFK0ERegnWjke76NlgfAmV1UnW6/s2JKMRhfw",
+	"7SPUA08+nocpQsLXKYl0gY19r+FdPEMFBV2ByXAkkBMHnWpdXvIJUvDteUduN/Wtu/gCqynsgb0/Pa25",
+	"k2YC+OV47Y0PM8FNWN4jTvOTlo4GwVLgHUaJBKRGsO2zN5rRDzQ8jO20R0WGv/enpy6YDUa6ns2GuUI5",
+	"E3a2zy5rTbz2YeSyzsIXb6V1sSN+FHErrYjLAXzCAmnYBK7RCM04xg8MtyoRY9j+VNIouRK3KQpTQxgQ",
+	"t16OR+F+8L45oDgiXllPpCdK/ipgLK8+GUoFuJcIGOqgsBP7z7gMfAWyPEWjFdWWl/QFynnPfe7IulUp",
+	"fAKdbqcBUfcLQafT7YQ22el2AuutU9DaIGsgIorjQ95aqP4O9GB3hbpw9Wo+Q1XE+6iE2GRNKxLMZ697",
+	"WHWu8cmtPTKsdLKhZbW4TvpVhx+6ghOv+kCt6dt0UrWnBLuJm+HHEX+dxB/Zc4nPXZHMj9LWMn+z4jt7",
+	"362zIjwOKgQT9qyrHkxx9qvc7ZpjL2zyT1LFcBXcq4I7xUfCYdE+K47N/UKFCLS2AsmuU8vuswviItAi",
+	"56Ix45p7DbR2lAVa4z/oN/y8z85c2tuyuXMih7pe+I8aEXXrKXP6dwrKVVFjdjtukKDLpd/cmc9gtngh",
+	"0uqnYJYaYTwUalmqABKxyMiX4ezkaF06UMuHFAo09xlmVg5CuWgWbEjFhvxYy3DnIpygx38mxEGMOfQY",
+	"A++tRxZ4t4uC8sCgHILOnVX0+lSeDc2n5x6X3p+irI9lGcCRjH5f3vmMA5/l+2K07YrpLqa5BR0S9jHT",
+	"3KKvMS4ZtuCYl+VDeHx+o7FPkaZI6aYNhpo7VG82b7RlG0ViUrpIOJlj4vbZy4LnLFg/nynJCMGqfCTe",
+	"1gpv7IonYGGIzdp1Oiyu03lxnQimnW7Hgwr+WVyxi+KKuZUFr1hNyxgQcG8YFNhimbaIMImeoFReTdHC",
+	"M8GuRGp9Ulr0xCLvsWql8oF6/fbV8PTgz8ODV8e4cf/3y5PXxxdkKG762dwOg/YCIjiNVSVxmZZNGrbx",
+	"SrPYqS1dVv1BZ+fps+mCru7ps2kwtSa/HY5li78uTYyf4aSvhEhZKkCUr9W8eLK8JHBI31BksVi0FQcZ",
+	"pteU1oNSaThBVzXyZf91u7vT3e0+DqhCqikrGqSMeIzl+chcFqDluZxQv+EZr+banj77buf7ve+efvf4",
+	"6d1zGeFri3AJUcm3pFuAgI3STheKRUHrV4t+BxUTLvdUyT56tQX2ZJGbQU0+IZaJlkJCykethbq2LGZv",
+	"9/u9759+t/v907tU32pVHL2sqYzclCL+PMqjxiE31tKAVLd2hiE0gAyV4UwLd1FIFLkKyApQZutksVAS",
+	"K0m8rYn37rGQxpXYiqn+Fleu0EzG7bSkWIKl3E7xNcaO4BNbj4htTriOdEZrWJ5HAud1DddR0H+hJKnS",
+	"DMMlMhYHzsQkT3iG5HfNJZv5DBKRrjN6LXNpU2dD6a6G8AkCqRJTV+K17g46DEv3w4bUTotzjpx0II15",
+	"yy1gIuDNRhhqBJLyFvXfcmk/V9sbvkRa2i+YqrVBFhzKBm98JpDtiC8q3jgNf31uWnPzlJ46XnlbVSkR",
+	"r/MS77RsKJbhu5NyusxocmGWPsFS0XsdpF3T8aY2fcYV0+oe3G5WuXE0V/Xp3hzL9B5H9fmmPP5oRf7S",
+	"EKIlc6y0s6ceJZeXJqhhkpeNP1sY3eqUABie7QtY4uvg1828BuuzJKEM6ku8rqyGfJWLWttAA6QhMuBr",
+	"yh8UmUODTPUiLJzpzjNaNbIXzh1prpbCtRiqkm/Bs0q+QqnZDAN3vVS9H6EZLObqtBfrL4ZdT2tYuxDX",
+	"QRewtSSGRXjVfHmfPPv++8d7T75fL++qs9AXHiktLqhtXil+BVtGRBCRRWblf/3jn+9P6ye2+2Qb/9+d",
+	"FpWn7Ut6l66xoPen//rHP/2qPnpBH5Zcn9birMX9WHRdLsIMy5PM3HC1o9xbL/Z9SUq0g1qi4DJJMNsQ",
+	"47Gg2qEEt165mEYk1lpriHjKIxmqq3LOb8gZumjSyJS5xuiNxYbLB8LYTuSspFBNabt+cvYfDLUeDVx4",
+	"trbcZ/LREEcIvPDNWbGdczeJG1aaNSowEkaEFU/FfugpLK2o3i2zW/imLXqgWF94d82ge4/ri5VXolDp",
+	"/7ARoHr8jePsdqqvSTVrWx3iy56x9iuINrV1k58FXsVwWc51B3L0wb2DH9drOKrWQ19alL9WPL14UO4+",
+	"bcXz8S4dG0dP6FEwKE7vUTq8VU8odLgXIsqEvYh4QP16OBXRldexpLmZitjx1+g1JPiViH0mDBzGdLHO",
+	"okvPh18GKjfC+O8UZktdqIikBGXuHAdDVQX6FQV0sZj0wwxNxJUS8TK7bYzai8i6pVJHFsFeRBwkOjB5",
+	"yAmaR1NaGK4K61hSjIEbtUtFOVCJjvuDILANqoEKjTCIeLNzpxgjjFpdlkSJ5qRSlltZrrYcaHEZYFjA",
+	"P2nuSkZOZ3tq1Dpui9Zzy+g2wR7EoFpM0qIZTCrDBIDSeY9YTUG1Y8adxuFRNQQPfdA4i7S+kqJLj2qa",
+	"UtLmgUJFd6V+mkWJntC/COyrDBdCJRq6RcoqNIrQBid1NeFczVzcw6NwqExnOhsmoyDRt8kS60ZlwoQb",
+	"22Y7AMtBURKVX8E2LeMFNGiEutJuZ7pGXWvoFj7ZutPEon+Z1pZp+koHVTHLSMWcbwZzdV+CyT5blVIu",
+	"rs5qNCczqaz20YB4y6l733Wvsf55YmUvNyIrvwasK+ZqmCtpg4UXpDUMWlCKJzsVc1faGC2V3aIsgKSU",
+	"P8zk47G8rfuFToS18/+0dr7TBzGRkvQ6kPR8GGbx6RO9RC+F4spiPrv/zkUuWgqNkNE0AOypYBaHeFSa",
+	"q13F+1WuLz650fJBaTAsCAtjI7Ywk9TDR56ExqchQnmZcff+xhYzQY1Dq6+EWrNYYZOXoul83f9OucHQ",
+	"FbmUM3ExV9EiqPV4bIQdzkI533WWOQdAx7l6UwqF11A95A1k6aHYiXG/WzkTXaR3MkmkKwDcVIquWVln",
+	"rqIWXdCP2k21sCLULuKd/FwqoaalpYBZdYVBuMMBvxdZUWssxJtOdCbtdBZAHTlBDC+alBFWiDkux0lt",
+	"lz9e7D55GqIkPI+lcMG1levvXAjvGO3Qnry6XBwG7/3NCxjlCt3S0euQqt9FCZczs1/2E7epzMJyCX0y",
+	"DiU+k8LPDyrV0KFre7nospgsLdf19YpAb8cnXOwyJSboacE0vDblxoqV9/bWU89QPgS37/W2hV2yOpym",
+	"1qZmf2tLxumqZC9XYh5Uk/1JzIGDbMPFhXGUthV77XpLJzAOw8X7LvBjeflpATe8YJ8Zn3B4hIkvM6m2",
+	"SM2JPJgrcbOcMOztflQt2zVLzgYFa6x8i/twvmcTaWw2d1tDJhSfJpGBn88GjyKBzrpasa3rXTSgVOMt",
+	"YQGdbscP0ygWacLnhJdxuREUHi1KeUorKMF/94LbNF23SNFe0MH66YfIKlLUORLXcwr6WaSrtKqF3fzX",
+	"T5fwil3jCN0iuw7sY9B5IXiGpfdZmgnilFa8wjhJcImorA6Qe3SwxTJ/AScVSekjXfoxVmlcKf+itP9C",
+	"Cp07OOYeFAMGlRmfOcvC9vefI4Hhu6UZC6910ou55S1hm0F1PMEiqIzHocjQ0GoZmoxCTzUZbSdywgOG",
+	"2/U8dNyC/CQrvaAXzvSOjtAtET+0/UagYg1Q0L7Xbg1xxYODdVBdseVmNdS6oX6m7JbLIr0weCZ4DORu",
+	"OaEqb45LIhD3sNOdqVTdAlfZWWUl7WeDu108lmUAwkKxN1hLrDwI7CDijwSZM6GtTs6El1ywVGS9AiVc",
+	"Z3xJIQwEbHKZV2B4EBTeFosG+uUBbqf8tpgBWjBuWD36i9E+ysxCO69eoIahSI8kx34IXEZDtRAOF6tj",
+	"0TKYeKxaPIwqVi3um9oHL56jP0soWtvdaj6hxRw11FzER+SoojyTdn4BD4Lz6sPn7iAPoeEBg5eSQzAr",
+	"NNCZ/BXp/z7zj2S+vf04wgcQ/ykg0puUK758PzcDtdD9IJXAQFL3KzH3ncntdwsyw16JudkklRg+XwhZ",
+	"nLWECPCxnQ8f0PY6DphgXgklMhnhWgB1Z1zxCeDR+1OWyLGI5lEiXD6qBedo1Ju8PTzpURJI77KAgerS",
+	"kpzlQq8Ozk46lUo3ne3+bn8b8T4Viqeys9953N/BSjVwNgj3LR7PpNriuZ1uESMCv6Y6XOWDqjrdFM42",
+	"cC5F2nnPCHbLoFiSpihnPXLIeqBQ7ph3XVV0PlEaN763veOqYHB2k4GOj9SyXealRTjRkm3uD9RlVb6L",
+	"BVYpZ+Ia/h4zidTWiXV9doJ/4g6lzyFhp2KgDJ8JZgRy5YbSu7tkfE7BcHB2QucPVBMR5ySGi1PyfR26",
+	"CcLYFzqeN+rGo7qCBO6tv7lARGKEVrJJi5zlh/qtAxKDP1BOVzzQ3e3tz7aCRZUBLqBZ/xRO4LrSytUm",
+	"AMzb+4yrQR/P0AreaEu4WCMunf2/1snKX3/+8DMISbMZz+bFCbpacYA8jDv5AYZxFyPOuMQ1Oo1rHQle",
+	"CXsEDS58ru8vdhTVaQIgwM++LMeHbufJfcD9xGeEdqkMhGt4hzN4JSyLG2sPE5+fpjIR1BYjPJAfpRAh",
+	"bwfBQCpSmpKlDG/5k+3H+GULM8b/CplpiYwVpSs51cDC730fNNMYl0q6AAmSquczug+Um45ngsJneaKV",
+	"6DoNuPcwwMgTy1F6xtryFAYBN0UTpyvmAzWWSpppn12Q7pRdnLx6d3G+48mQg7HVk4nPLESky3IrQgTq",
+	"wuHmF6JOOPZXokt3uAzO96IMO7w3qvSCx/4teUg3khIUgKrK6rS4bwU6O9roOKNWwgjaA+KuPpkqrqVS",
+	"oLkChp8FEHm9hmMMTZdJFSU5XrlMXOsr1GRh8g5mIp2K2Ddlzr9bGOyIDIy0hkGMm1O99Qm7dr78Sb9T",
+	"3PGyIn5I6IXg97CvUvs6/pD05071yxCw6hR3omM7n3kJsUfeRYB7zPOhuV+BdrENbxrB22A2vxqK720/",
+	"/vKTnheJm2i7SAmd2VTcRkJQ9a2IY1Eaj8mPHhTT5VQrpXRcJ+pbv8n4AzFgibBBzzsik9C4nrJczmYi",
+	"ltyKZE5+S+QIwiRFUZANO4/RoN0fqAUKG2E0BJa6gCla6exzplECpRVg/RG2t70XYoNosQUlSXnGZ8Ji",
+	"Kre//tZy3Si2HH7xgTmouyXNaJ08dCvn2VSQ/LxAOvY6+21zureHEH3vy+ORnxc4X/R3ekgYTIdaom+3",
+	"VTz7nRz85wPr6sfCRaN/w6R1BdAFwAE1dK48yxjcF9RkAbdCeymbbEHX1+hi/KG7VuPDPDOwr+5ijJ5I",
+	"0BHG6Myy0bzrbIVewTXo9AYdl6HARE6uxCQaHs19aXGH5zBOp4rZZTmTXsUAVBp367/W/ijqn/Xcv37u",
+	"fvaLspZsgMd0F9Gg8OIiPwKc4M+9N+LW9txRtMzo2m/VG3/odv7cu9SWJ71Db4NZ3rva+MOH+2L6Thyf",
+	"h+7vXTD8gv4Z+B/Aim+CzRqCjcOcViUWcV6GcabEDbVmf9OjPrugUAbUQpqp16hTpJGIGTfk+duf/Mog",
+	"3aa8FgPlDHDou5mC0A52bQaGtxAfRFPTXVgmUBXDbcFwaISuA7iZPdgIqjs6bCsOTs6wPGGpVErEWM3K",
+	"eZq7LgGjGNZzHcoZquqCtelcXQCq/Oq5dasZ9UFTgnME5jhlr1IolpkpzyD90UjYGyEUSzMNfKUBU14q",
+	"ODlhYFoUJJ/ojI1TIFtrBA1D3C8wrKBV5PFz7EbHKm5x6WQGwTmtpn8McSBSFdJJre/tVhkg4D2Kfpg9",
+	"quUvIzctvGxtLiRdF9kbDuM/Kr4xhyB1S6fS1ilPSnOwj8nh2YgnSbBM6DjDweKW4tJ/oipy2KTPjugB",
+	"KqwxAFzbk4qVC+9fb/fZW5AHbqQRjA+U7+6wzOTRFK4Qddkqe+7v9L9DOyGdWcqjK1PM3R0oSq7vC1z5",
+	"HXqvuhfvTl4fDQ9ev3770/HR8OX52zeXx2+OLjBk7SaRxjaLwgTnXwahoU5DyP9fF2/fMF248mIJtsKp",
+	"nOIQPLgKSGzgDiObsF5PpxZMmse0sH3228DVGBp0oC5dmuk4R1/bQefDQIUW6CJCZ6PWcFCf37LiKSYV",
+	"O31R1jvb3d57ttlnpw66wLAQhAeqAeLTkzfD0+PTt+d/GZ6+QG28+/3gz+XvoK+jm0cRCLkyA+XU7dJW",
+	"DQJcsUHHfaGNDDpE8fvV3VZD7HKb5nZYust5psjHhgQqSZSUgOAJGsUBdRh0KDTIwFLwF39c3kmuD8Zq",
+	"zMEz6OCG8YQGHUdVHHXCB8vyCaQuprxGztu/68pC8EwMVKXeL5pXXx1fMsfdoqS/xTMrxzxqFGrzW8NV",
+	"UBWqYDoqF0vTgqVIuADQ1KwsFkGkWiEOx3lWOM8DXgKxdeg9Rau/jMEm7+WvTUSu3BDOsF4P3Q1+oELC",
+	"OE1Xxj/0+1UU/+tvNArgt0pnQ/IV6EDxw/LDRNppPiq+/RzGfXMl02F5h4fINPFwNq6LK5kS0Zgry2/J",
+	"J9R7OpVjuJemQFyfF6jqaDlQ0visb+5dAzC4gam4GnoAi0zOhLI8KS8/hj5hAj+I7inJehE6NOj8LzfS",
+	"D4OOywIDjsLwvFEUh/Nmrd2QiodJW1ToRe05YBvEw2z6Kv1w7BV2jvgfwHfteAbYFSsX3B8oMuQbmwk+",
+	"cznbMFwfknZjRiWWp3WbWUlHDv48vHj77vzweHhx8j/HOJjBQpePDDukF64HaR278LoZYbsF7a/ynJNf",
+	"Zco2dMZue/DPze5AVT/f9qKi3HjPcuSgq991ZIXt0Qaew5nlquwwUNBDGp/mmOBdOCyOpOJZsGqMK4XR",
+	"7jP+gmgjNSup8NPt7c3VuR/c8QacedbQl+9+Nv7dSXIBfTVurppQk+y1X8vY93X04zv3oB9vXGhpWEI1",
+	"LDEpVvCe0dqe3NvaUBaaoshUvdROZYzr5AxubuWiFhtC/P7KYifMfg9HiVFL0pQ2XgqHtc6RC34ppFSD",
+	"/nRVho74FulyffN4zjbI5Qrq9VM0wFhmxsJDvflx9oly+qpKbguv9jKXnDKm70t65DQjB1vJEq6XUWbY",
+	"r0Mb7qoQHS2uuwL/VeahC6x9atjZ24sGpxlxFYmkHulAcznZyh9Wf1F9gV29+mKpMp+gfnJEVTRL2fee",
+	"DDruLcL1Jvdo0KF5a0r4ve3v72tenhARKJN6PySDKB6WJzXd5YTl94R+2/fFWt23TSmAzA/JojSqA61B",
+	"PAuJu/KINS3sNs+UcXI+CGok+FOSNw4arUgYM84d0pIcV1FTsEJ9MFA68+qDbqFI9lrkkKbYI/qBX+UD",
+	"QfhbkLbqOLBScAq4M5fA8YI6gviRcfClA/mDkPUp+sYyj7DI4zV1VzpzzSzhpYghFvAB3dgyjxw9ZR7v",
+	"F+4tPertARL0jsAVK92Fa6khQElZ+8GneRAZ4xOKEBsoyqJhNblgeqV4l8IoGjpVpBGKZSIR3AhngcAP",
+	"A+WSUQLeZnIyLSrP0Uy+UA+5z/RpHkxlwaSKRSpUjPngsJA4MWeu3vm0qGmH65k5f2fKxgstURt1w+fF",
+	"HrSCkgaxNL6Qk5qQMhJaQ8SGdRodXBWgUtiVh4xWsFAC9R/yLf7GWj5Y1rJJUBD1TSsbcIF3wniLIjaG",
+	"J5ySDvQu4N5gLRrTd//r7XFYQOSXRE9+2aeLDWUOWCKV1/mWodNAVRwYsRN5axT96E/nPG/YBlG2f/3j",
+	"n7goqSb/+sc/4UWgf+FJbVHedayx8ctU8MyOBLe/7LM/CZH2eAJPq9sMlkcEBfOcPd5Gm0Ca4adqyTen",
+	"tIQIPuU5I59xnSpJcOMG7BI5hP1IlQuvKIaGcuxSgVNk5kAdJhR5RkXgoJnz8LY6NSVlMlWrLiTTKtL9",
+	"DBQl4ADzEgZvkLuoNOUVJWC3ScLLuC86z69H4BacjQ4dTCsgBf2Mx0oMs5BKWskTxym1OBnRKYTdjNoC",
+	"oVeTWytuLd0np1u/I71FeIeIwHH1edq4uDje7DM0URGeYgJ6tHWVwzBdjQb4Ji4tjzdBwNZIHEKZqKUr",
+	"pbrUD+/ItfljOOIF/fBqP9ad8lwmhx7+79fywaMjuosTHpnCsdxXXJzvN4e8bw55d3LIC2DRipgjh6lf",
+	"MuaIpvhKMUf+JgbCJvFLBWRfN9wIy5jrjJ0dnvgq7l8z9ugeXnHYKWFp+ZQzrVzc5T3JXIdajRMZQe51",
+	"txas0DcThRxWR5CHEzJCq2bc7wue40rV9hq/sVVLX98e4upblSzIPcS61ie9y6Na7IqVuGa+vSSr9IPS",
+	"RPpa1LClF/EUAemAWN7TKhalWifr8K5n2O7+GDGY7y54424MbecbuqzBeNQhVsWJRfN5HSuoUmbBhiwV",
+	"/6mVk/99SZ77MXO7qXPV5Bfu4aE8ajySX/FxrGemq6bzfkgo+644RbevZVbw3xdqbt8fZ3zfRvAQmj+o",
+	"xD4NsAEVnAqe2Oky760fqcUXPGg3Q8iDUGT+VtNCKTS+3BZ1JW9otyFt7JbVqU70ZL6WQR96PDLMgFcz",
+	"aKwjnQlUXAN7TdmGoKIwlt01ffYTKJCuozQ3XcYToyHUqByMko0fnr1jfg21kgJouuOW8hlOcDoY/2YK",
+	"NQ7BYXqgAL3AMAB+0z4tml/jBsVPKabjmEGJNRZhzkytGMc21OPi9HKzRZcNToaXHjorSEZlAqtZmpCj",
+	"Jm6w2NxYt6nMEEQ1ndnSOs5fkpLUNt3meVngzH1J2ZCPoQJizB6ZicIhFuEcccWm/Fo8NFKDuFi9Be5y",
+	"FnkUzcqriTF+02qZTmn8qgAsGHvRqLrSpUqhrnbVQLnci2RTAwFBJlAOa5zwiemyNMmNq1zii2D5ohyV",
+	"iUMXCVjKHyt7+ZK4W0wDkwaJZJ46X6QqeB8ag27CuwCsQbeW5WLbCTW5D4kNp7qLsOaW/01MWwMLSlgt",
+	"0wmfuFi4L6cSxhnupBH+fFE1DsECQIYPvloZRZ6xDW7mKtr8YwXW3AezT8B+kLz+WZ4k3o3kWmSWFVWl",
+	"q/R0axK1e86R0sMUaQHMFTHCMBKFsY8SPSInQ1/rmKt5yehuFD4ZLnVhCoGiOvN+d0SwmbEySdhIgP3V",
+	"BarANFzNLXiwYFZxK4CBHigqWmeAucgzDIXESuCh3Ao6SUREj8IrCCSarBSPKZcyuwHmvMignImZvhZx",
+	"ER+BKiKKzKH1tbC+cTYfZrn63C4Vn0hSXh2euzzAi1jnoMQiglwzafC3Z6ud261DjuUK74N/yCr37TfA",
+	"jjVUjSezNfD13fnrnlCUYpsuabtOx335zApHIpC+wPo3srzabIGg8oS4XZ/3CefvFAQUyd6X+v/svkzk",
+	"KOPZ/P/svuRJKpX4P48PEm6FsZtfDFm274sVum8F4ANGPhDKZR1oC6RpXfdXWeFDfertu7jBFh6tBM+m",
+	"R6srVoh+rJjV81//+KfjZNqcWv0qftlnZyJzmYV8oo1ijV3GLZtp4z1cd59szwxLRUa1cL+Eeyxmbzal",
+	"Hs9Xb3J7Bl6HFluuER1mjQN1UVFuoHyAr4vu1RkjCBS8FOAlcVJwNJaRWpJxBl61SQFnXG+LdhBHWs/T",
+	"9Z4foM/oXoqbBB75011M60Pdu5vpA6ZHzs2UMAfueUlJKt6mUuFPq5Q/Rat70f/QbHfSABUL/MZNr6ME",
+	"qoJrqR6IGn5ZTRDN8ZW8AwtkC0EbP33NXORfUQN0v84FDiP9Oy5N3QMPg18w1mSqjcVPUoFe5AFmIZcF",
+	"xlXp75ZTX/RGPLoqcgW2pSN3BZtuptqIEiQzbjFHo9IFPCfCMg6ZwqkqayDNRCJ45jDdpR584VawnlMM",
+	"dmFu1SyC4UT81fD2weACwImSotUhWJFb2w3qlZKR3NIqKmW8WrECMqjiQZONHTMCKgEMMXQo+hc408bD",
+	"roct25+bRlO1/7DfyAIM/3315m90E2cwP5ywD01absH+NA9hv87tWjheUD6rGWeocgY3YDVQ/tJ0mVZO",
+	"xPzx8vKMJdJYobBpn52MYQz83Q/k3p65sN2BCqyZeas5uq7jjM+2qRhEcU99Vr2JvBZqoEbzwtn/5Og5",
+	"GM5tnolqrkjMQ6gtpVYVcegmXiy7iZ+fWQtcwvurf3VXCuCvw33za12WqysFdTuKo8eC4q5aCLlB/Hsz",
+	"dWd0AVCGd9zbCOM60IBFKenSTEdOwnsw4nQbwWpwcapdu/ffucik8C+4W9HRmwu/qkMex3NgaqkCTJ46",
+	"HVWXiVseWcgMaiDZc5rpWynKACK0pnWB4FmRJGzQgTFHGSV1ZZwypWd6xgYAW0bub8YKtB52+gP1Wl4J",
+	"IJb1ccHVh93wK5f5pMZyyDjBDNiY7oKreDQPOvFofZWnnki9uVil8Trxc5TEERP4kL1H0TIcdSdK0Cgg",
+	"zlPZYjD0y//9KN4LqBCUgkStxA3KWVIt3fbmz0dvTw9O3vyhxNI/QJLKyqFLV6aTDP13jf4yOrkWjauL",
+	"kTyOANFFKqdrkrL1wjZKDdGKq03TwY2GK9n9SrkK/TpqVtV7wCmi7QUjUPpEVlJDYvVmp6Glj5VU20Nn",
+	"jXleOz1XZuz+9OFu3vsPRDmYjeQk17mpVG4v2H4q4ZGIumLzoZmtS7V3q+H6d3zZtu9TJXvvdulveP+F",
+	"LObNA6U3yLmcrzBK+Vbf0qCsTINC1cmEL0729fKinFSCBde37pUn/S0hyreEKHe0dXrkWWnrrImIX8rY",
+	"SZN8NWunv30hgNO3b/bOL/aWV2SxpYbOb/UYqvUYKjf4o4o+x41ItgaTsTUCbmpJjltXwtAFEfpupFLT",
+	"SjArZmnCLVXKYzga7MoVECLDK2aRFQPFJ5NMTGBdmXCV45C2GwhGxfJFFK8qx+jtPxOzkchcLS+r3dXs",
+	"0lj0sfBPYEazMSe/fZ8Il4y+rcURqyzUl6d55qsWna+sos1H/wDquxTn+xXJYFl7BJGJyrCbJsr8WxDL",
+	"9Q+nehko90RU3vASWDfcsExjoAvo6L+R0i9BSrkDth43hqyQ1XV9nV0HhnJJ4aQc9HbuUswy+YYOlMca",
+	"/IiWAjsVczblaSpUn51xY8vxnEE1Eyn4A2Ni8iiRMLadckvlTIHGamagmuWczaQxokyzazTLRA9a1Vww",
+	"DFhJIp7BFCPQ42FeWBiuzPzdZ4d6NhOK0g7QWhYdnSHTrrPBuMclcvl60XodU1FS5wNNb41zoBUqNsxV",
+	"YCwqwBXJy8lZ+jkrVsSsHiic7QYOERYYeCF+gm9LZOxGyVtKgG4jx9SUYWphJdTmajvNHTL14uwG7L50",
+	"Wq6OgRFY3cq0zIXDdj9WgEWku3TFsxqS7Jf1rq4u4NOcq6sj1X2r/22DTgsT471r8gLWTXcZQvq8itD6",
+	"UMTtn4jzDdPzms+5fyLSTOB0cesr8Rp9bzw7W8lFgf4/NMUNJysIVYqhtiRfGcVTM9XguINRKZmIsMJD",
+	"MSAWbHO3Q5oiHFXD+qm4m8bCE5gyBJnuTMAhSK1YKjKp47bkFWd+axduDffjO78w7Tp6tqJTHe++6ZbW",
+	"1i2xApOZVg67msi+rj21eADX85X4zPnGFt7WP0H8ONVYOYUbdnZyhIygK8BSY4YeGaaEvdHZVbdIE8kV",
+	"pInRST5zKWSAScpEMkdVuCqGprsRI7v0zlCy0sZ9HyhoKA2b5tDqgo+xjnQmbDYHibmoeo0uLzd83mcH",
+	"idHuPEzNC9D5sXi7iYuTz3RuYUy61eFc/lkkwhr6trjzRZAC79WAm6/p23UbkUXRhS7j3smmIGhMjwcK",
+	"NMLoyOPqHbMQZS0D3FiTdA3Uxtn58cXx+fvjo+HFm4Ozix/fXg7Pjy+P31yevH2ziXzlYkF6z2EOVNHn",
+	"xfHLt+fHw6Pj18eXx8wI67heDuV2RsC3zkZSeaMIgrAdwn6PIRZwCVArJ101kRdvRZYngAJJ4nyH6nyq",
+	"KyA+UJSaQJfF9dHxhj3ZfozOoRUXpIxcUq2m1iWyDpTVusuUpgwLsny/vKTqefNKjfa97e+fl2seKBgd",
+	"etdTLOI9dyXRCufXlFsrMoVihZwonYF0c0mPFb03iCbG5V7gxXq8k6oHVFVzhC/jcybH5PNauTZCscgf",
+	"rGuNCimiomwmjPHBiiiQzdqPOuIm4vGnXifMF+eGooxxVTzwpKeatLIuopCoPCDfRISJzFjMLYejS7Sx",
+	"KzcwdJP8fvJRHNLC6E1pV6oUr4g/9psKLJ87tAEoEop7BVTNf6bFz6Y+8NfJrI6UpsEKwmvZOLbiWXK/",
+	"b/4xxJHw01cPMNnAAvX+z6FUw9yI517/3LzpmwhdaUsC4cFO3Dsmj6tGaIuBelgxtZrKR8XeZac0Zrf7",
+	"7Hxdhu7LeuqsYU68f1+d0J16WE4xTdAtShJbI61tjzIOLMn0mOrMGjbVN2gaavCcmOsJxmETbfvsJ3ja",
+	"Of2QTjnw4DC7szZRukypwCk8k9b5sVM7uBL0+pc8ouQJi7QyOqHvqb4RmaGx3p8yPR4/p3e4ktx1VlDi",
+	"lGdFqTKeplD0rDUajfbzQmt7QeD4N7xpld2FHj04MocL367ZXeLPdG4jPSsK3xY3InjlokQrsdpQXJhJ",
+	"jKvo3zT6C0Zs1COf6cWlksMUqQip7kDBKkRMhgDOIp3OYZHwgOprkSV8TiIjDsnZOBNm6oVvjBojkPfZ",
+	"wUD5Aqw0K3C1KceYipupBGWjNT4BXQayWipBNDgrSz94WX6gvBUFIRHUfR3Cl9/Fm/cFzNnVvf0OPXhw",
+	"fV/ffeffm2+uJS2orEIq0tNYp1qKuELVB96UwqBPqQsMs/xKqG+26c9hm0akr5WhCJFuPUtd/fkw8X6Z",
+	"CVFmqyfaOgXn3tEcMnFGV96oWJBfPGW0B3PDfhWZFqY/UAfs75G+2S1aIYPjk2x67kZa6sGiJDdWZOY5",
+	"4yzjN0WvKTcDVbTKyISS5goVGDiCYmnCI9FnFymntPdehCdGjYroS4MVXtEcnXAJuiNXEptaxdJEPMMM",
+	"U5ZtGEGZSIfu580+Q8uqC0IGxd1AuVSj5XEFXwECt7+mb2lb/46Mmdua2zDsI3ADXCPmsFDEf1RC6SLa",
+	"HA49rGpqeIF89lGDt06qpkhV5c2ChKgoikT/OFllEwKlpT/E9erQ3JtlaM2CN36jD0JrUSl8E00JQ++F",
+	"iSqLELBYu1SDLmG/ryoz1TZN8sn9kw6dLRRp7DZ+rCvX64qxe3auqAbMPhzy8qO2vVzB+VbKNZLo56W3",
+	"KkzDPMwLqWLjshTgCFaz9y9P3sKbr4SIfe7vOEavNXdWfvz3p32oNo83FCTXWmUQXqCjaeBj6Pk/sN/I",
+	"1tcgW/4afiNbYbL1VclRZUE+2qN6Xg+IUtXJFGYBCZGpAPcjbkXUM8IYqZVZq3YYz2NpQf0LIg50Z747",
+	"06lQ5HBTM1lh4VJUM/8kRhdYc4w6ChWnWiowASYxyUiZsfvgH8AyrtA8Dn4AQlEMBmjNpR0ocSsLYzks",
+	"xAXdl9q8miW5KGkA2nRy5PDm9hZXseNbEV14mDxQEWkt57TKRtdxSzuunvY3vfb6bmmiDrhV93DrN/ev",
+	"k/jDViYinYEX1lq3k1qTTiLNKdUau7z8S20JqBozkZQRN5Zd74IeYcbBKXOsM+eQT5fpF2ymxIyDdmP+",
+	"S5/5e4Gq6WI2vFulLxJquJ0fzfGfjw+H58eHb8+PTt68Yka4gCponRtKqmKmkKELYhiUEhkzfE7a8RYL",
+	"UwVtzwvo/F64meolYSdH4UmK8/2CNOG2V5xx/SrQaYPni1QcnWeaEwWqUtJ+SmT8ere/UN16IIMDldLW",
+	"Le6BFfb2Gfu5ql/RCqRbyMVWlqt29ek5XEDOlFY9TG3GIwtZ/SM9m2E4o6ooHemVJCIirWHGxjoHcmBs",
+	"LLIMv8Oby9D3xPtij6WSZiqM89Z24Q3SsIijPpJbtnP64vlA5c4pteX1d46l5Rp1xhKtJj3PwLg1B/Wa",
+	"53kRdHRIzf7NTFxATs5zdSfj1vbnn73NX80B3SND3LnvRAx/IEPXScO6VViRLbcPKu/2ea7Qgk6oA/93",
+	"w6WjA9Y41iVI99CIspIRmgnL0V3UmfGtUI4TcnVBxmhlr5JAHHhuLDrHwhZVTPYVkCVSihpkZgbeyi5N",
+	"GPYoDEicjXP8lkLixUM3pzQUGUx6uJ3TF2DFt1Pj3cPTTEddtmXm5KMAumjvpz9QOEGXvTx5+ZY+u3LN",
+	"jvOixGUgBElT0lJXLKUHRqYV/jkvZfL70QEdjIxOcou+01Nv2Ft2TLVMk1vCRltqItUt/XcfzqjFQ9it",
+	"+xPWSmiGdrwS1Twi4Jr9DQyvAO7rEHr/fryTXwF0ESECNx5+D96peyP2cGkYeogj0e+yNNNU8BiZQVR4",
+	"I97zkbvdX0W7hWf/7V34BJMeMMIERtS1Fxc/+BgkenKHaHZo3VKya6DeORb1F/LI+oUVVBEItxFY5xAD",
+	"UmAc/A3Hp+pePE1/YRvuAm/us1fEVZcwpsk36k6YVMfrejb7ZZ8dJjqPWUV5C3FV0AnbgOJ/xtUv+9hi",
+	"xhUriLqBVlB2q6oEQKe5Ny62HTJXWp9/Yc5+sVwmlf1tuvJbGgHHE/BNgB5S5cK4XXqHEBpQjtkvYw0e",
+	"CD8A6fxlxTPzGk7p9/LMvMkxY4Ueu71QsBpQc8Q3oWJIDeB3j4rUTFtM5gLn7nw4xrXCZloJp8PIrMj6",
+	"bbHtXCZher+zvV1Qe6msmFDm198Wrfe4rOCZYI4D9AUGBHMMVFu8HBzdJ0b4vNaF82L9LvA0DeE/rYht",
+	"XMvMSu1vwPOQazKxRi9lJqIMUnpneE+uRKZE4oemv3xkU/hmEZvgewBo8OlyEu8ETq71Vl7PZkvuJNuo",
+	"WOJIVv6/JCljZ3db2y4r2yC3FOdyRtrySvTTZnscHgI8fHJA0StZCOkvAhN08CzT9Qz+263w41IOrkiU",
+	"sFKVhKhTSYXwTY98p+pxtecsGMOPb6NL6C/Ws+kUratGIxmLqo4IVDKk0SV91bXI+ER0MeuVzuak1E1F",
+	"1pthWi7018vhemIemAwDH+gJqgw6aSnMWE0nelZs5d84YKfcZKhUNQKrPCTS1zn6izD+pv54aCahyRpn",
+	"GrjXmTDC9pxT2xLtr0BnVNP0hgO/V5SR3Ah0obliYpbaObIyTvY2fCYGyshfRdc7pQKwKUES5RBhMx4X",
+	"AYqZ1jUtCjtgRT38qrdhJqpxFNAzAiajWBDAAVIhkSYachWdnOGPpweHzweKs6a7K5z/3Pif++y9Dy/O",
+	"gJewOgd7fp+di3EZYTFQVV07tdWpNzPXEx1Itayixjmcxx/Aq3aZr4vbNkPc/CNHHFT8Shw6onpi0Uni",
+	"QVmo6PIXeYMaDoXruNhmwlid1QK1Fm4RNPjDR+Y6QMV/8LAdn1wFzlYXEesPS5OFB1nuDF87t6/gHfHf",
+	"Wu/IBTX4w9+REj/+4Lck0lkmIvvw+N+zvBJRX7nuGxgE261kBnFZHd6fnm62XZrMLr0y2bd0Dwikb2+K",
+	"Exse3G25cPl9m3JP24WwKzU+UpEHmNTKp8gls2u7RfydEeM8QckIs6ijimjs+1GO/C5KbID+hS5oJonp",
+	"HaiRGMN7mIoM5obuMH5FNRosqGp5qQWiO/j7MCPAYkjxzW0b1OoGap6mWzG3/IsZpV+iWp+Z+WykExmB",
+	"XeDKsI0EKkniMq8NS+Afm0vtAkPs9/sxTAOkT9RYt1uFS2T+pgR7YPk+ysvi6c9Yt5A1nS575nX67ZUv",
+	"I3i/8cQP8ZXXaSXJ2yTjEb64ZprbWN+oFv53riIrZ0tS4FxYkRqnZtXRFXnBNSODvE5nqo19ZGpVSet2",
+	"GifWkraau6ylMmKwDrbx6t3xxeXw8uT0eHjxlzeHw5M3l8fn7w9eb7LYZXjkudVAqyPwMyg8g6W3X3M3",
+	"XeayZNGSEZqGmTyaMm58tYnL1xdsylVsplAQOcg9zFXksfRSzv4tSQPsC/bZbjUiGP5BFLO/v6BjwKSq",
+	"y0GuMsGjKdhgPq7c+aRyqoUJBS5ukEC4fJpbv9E/FpIbNINWMfrRMO6StDYjnkvFdkE7ICMJ4wFbT7nY",
+	"XKFJ2FSyvxYRQGgmloZNi3DriYi7A0W+Vgqr7CyLfIbuUx8AqWJKkOc8dHyWa/IcZLmBxZKuujfTcZmJ",
+	"NuLOm3NUJhqgkCZvVQqQFwIWWZt+N4IJLedOdWY9ZjwIdsft796zQUCkaQUJI66AwpRIW0XtJVkC7t0j",
+	"1S2pHjtV+bEen/4V47Cr9rIKmcPc30oXNKQC54dVUBrAXEOQ1ckjDmyTGtfCulfS4uLngaKE6xUEDhNQ",
+	"zDf1i/sLck5d/eK1G2XfgYp4ykcykVYKs1mj4jyGqIlEXhOBxyOjSLBf8N9DID2/MFIGQR7zMtton70t",
+	"UrzDkISZM+FjGlygKQwLfnOGifEYSycBnVfiluoq1aO/wdPAtKfD+CPT7s8fqFaF6VeKVlvj5bj3hBw+",
+	"To3IFxyfC1nw2RpMoi1LxJjin+r07au/F19DpndraKbkQLCtcLd4SG8C3ZcKaa8r9ovM/ivV+d4PfUoF",
+	"lagbAyIdSTvvVnLPuozEpatmSSkzwa9Az0BCPs3MpIqSPBbs8Oxdl3k3T6D1NIJLbktMtclHxeIYklpy",
+	"q0LgixhKcLCIJ1GecCsc8YZ3gipntsQQFEvpfEGqUU4SOGj/sZLM+SFpWMM4gadXooXLSOGkoaUl/p1z",
+	"3bcC/6sL/H+tev7vi9dj3Wr+Za2Ub7X8v9Xyv5MXs0edD91VKdgxWIma99mFFz/sjWagijEYPIQ1MEc6",
+	"nu+zop93TaauhXdyKiI5lmDPl78K6HuKlRp5hmzUrDKA75lmopfqFN8fX1aHYOwldsuz/uRXxrNoKq9F",
+	"a43uQmz4cgW6m1x0tzPz29uC7fXQlFwbNM1grVYK01hL/TzqeyyjlV0i6Ioao4xhXpkvptuR8eJUb/Ef",
+	"EFmVG6tnftyTI7bBc6t7E6EAuJQBWWl0hr+WsYg3a6bza53gdns7oYmJiLeIUo4el2PN5jTUtT/ChfEA",
+	"nYaT0eKQp/xWzvIZ4hsIxa9esA1xazMK5ir1jh6nfIlwkHFrG9oJRvtVpKS/4qZYj7m1sF5xFuWbQrVh",
+	"7zvXvX9bWsWrr5jqnm246HCqFKazAsmt1izh2cSXkXrgBdrvIEO5hHgSAikKgYq8dR7SU+OqnXu5uMKs",
+	"rlnDcz1Nz0coYD7ZFLjXSrxqddruQQ3w/vcj+kvzIBNxEq5V1DdtFch+v+i4fX9PxX1XIQvh90MS5a8b",
+	"YKMBsusw8rzWEU9AxSgSnaIWndp2up08Szr7nam16f7WFugAkqk2dv/Z9rPtzoefP/z/AwAZZzK/iPMB",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
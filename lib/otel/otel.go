@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	goruntime "runtime"
 	"time"
 
@@ -33,6 +34,12 @@ type Config struct {
 	Insecure          bool
 	Version           string
 	Env               string
+
+	// PrometheusEnabled serves metrics for scraping through
+	// Provider.MetricsHandler. It works with or without Enabled.
+	PrometheusEnabled bool
+	// PrometheusToken, if set, is required as a bearer token to scrape
+	PrometheusToken string
 }
 
 // Provider holds initialized OTel providers.
@@ -43,14 +50,19 @@ type Provider struct {
 	Tracer         trace.Tracer
 	Meter          metric.Meter
 	LogHandler     slog.Handler
+	// MetricsHandler serves metrics in the Prometheus text format.
+	// Nil unless Config.PrometheusEnabled is set.
+	MetricsHandler http.Handler
 	startTime      time.Time
 }
 
 // Init initializes OpenTelemetry with the given configuration.
 // Returns a shutdown function that should be called on application exit.
-// If OTel is disabled, returns a no-op shutdown function.
+// If OTel is disabled, returns a no-op shutdown function. With only
+// Prometheus enabled, metrics are recorded for scraping but traces and logs
+// are not exported.
 func Init(ctx context.Context, cfg Config) (*Provider, func(context.Context) error, error) {
-	if !cfg.Enabled {
+	if !cfg.Enabled && !cfg.PrometheusEnabled {
 		// Return no-op provider when disabled
 		return &Provider{
 			Tracer:    otel.Tracer(cfg.ServiceName),
@@ -74,6 +86,17 @@ func Init(ctx context.Context, cfg Config) (*Provider, func(context.Context) err
 		return nil, nil, fmt.Errorf("create resource: %w", err)
 	}
 
+	meterOpts := []sdkmetric.Option{sdkmetric.WithResource(res)}
+	var prometheus *prometheusExporter
+	if cfg.PrometheusEnabled {
+		prometheus = newPrometheusExporter(cfg.PrometheusToken)
+		meterOpts = append(meterOpts, sdkmetric.WithReader(prometheus.reader))
+	}
+
+	if !cfg.Enabled {
+		return initMetricsOnly(cfg, meterOpts, prometheus)
+	}
+
 	// Create trace exporter
 	traceOpts := []otlptracegrpc.Option{
 		otlptracegrpc.WithEndpoint(cfg.Endpoint),
@@ -106,10 +129,8 @@ func Init(ctx context.Context, cfg Config) (*Provider, func(context.Context) err
 	}
 
 	// Create meter provider
-	meterProvider := sdkmetric.NewMeterProvider(
-		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
-		sdkmetric.WithResource(res),
-	)
+	meterOpts = append(meterOpts, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)))
+	meterProvider := sdkmetric.NewMeterProvider(meterOpts...)
 
 	// Create log exporter
 	logOpts := []otlploggrpc.Option{
@@ -159,6 +180,9 @@ func Init(ctx context.Context, cfg Config) (*Provider, func(context.Context) err
 		LogHandler:     logHandler,
 		startTime:      time.Now(),
 	}
+	if prometheus != nil {
+		provider.MetricsHandler = prometheus
+	}
 
 	// Register system metrics (uptime, info)
 	if err := provider.registerSystemMetrics(cfg); err != nil {
@@ -188,6 +212,38 @@ func Init(ctx context.Context, cfg Config) (*Provider, func(context.Context) err
 	return provider, shutdown, nil
 }
 
+// initMetricsOnly sets up a meter provider for Prometheus scraping without
+// any OTLP exporters. Tracing stays a no-op.
+func initMetricsOnly(cfg Config, meterOpts []sdkmetric.Option, prometheus *prometheusExporter) (*Provider, func(context.Context) error, error) {
+	meterProvider := sdkmetric.NewMeterProvider(meterOpts...)
+	otel.SetMeterProvider(meterProvider)
+
+	if err := otelruntime.Start(otelruntime.WithMeterProvider(meterProvider)); err != nil {
+		meterProvider.Shutdown(context.Background())
+		return nil, nil, fmt.Errorf("start runtime metrics: %w", err)
+	}
+
+	provider := &Provider{
+		MeterProvider:  meterProvider,
+		Tracer:         otel.Tracer(cfg.ServiceName),
+		Meter:          meterProvider.Meter(cfg.ServiceName),
+		MetricsHandler: prometheus,
+		startTime:      time.Now(),
+	}
+	if err := provider.registerSystemMetrics(cfg); err != nil {
+		meterProvider.Shutdown(context.Background())
+		return nil, nil, fmt.Errorf("register system metrics: %w", err)
+	}
+
+	shutdown := func(ctx context.Context) error {
+		if err := meterProvider.Shutdown(ctx); err != nil {
+			return fmt.Errorf("shutdown meter: %w", err)
+		}
+		return nil
+	}
+	return provider, shutdown, nil
+}
+
 // registerSystemMetrics registers uptime and info metrics.
 func (p *Provider) registerSystemMetrics(cfg Config) error {
 	// Uptime gauge
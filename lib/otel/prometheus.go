@@ -0,0 +1,233 @@
+package otel
+
+import (
+	"bufio"
+	"crypto/subtle"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// prometheusContentType is the Prometheus text exposition format version 0.0.4
+const prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// prometheusExporter serves metrics in the Prometheus text format. Metrics are
+// collected from the SDK on every scrape, so it works alongside the OTLP
+// periodic reader or on its own.
+type prometheusExporter struct {
+	reader *sdkmetric.ManualReader
+	token  string
+}
+
+func newPrometheusExporter(token string) *prometheusExporter {
+	return &prometheusExporter{
+		reader: sdkmetric.NewManualReader(),
+		token:  token,
+	}
+}
+
+// ServeHTTP collects current metric values and writes them out. If a token is
+// configured, scrapes must send it as a bearer token.
+func (e *prometheusExporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if e.token != "" {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(e.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := e.reader.Collect(r.Context(), &rm); err != nil {
+		http.Error(w, fmt.Sprintf("collect metrics: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", prometheusContentType)
+	bw := bufio.NewWriter(w)
+	writePrometheus(bw, rm)
+	bw.Flush()
+}
+
+// promFamily groups the samples for one metric name. The same name can be
+// recorded from several instrumentation scopes, but Prometheus expects a
+// single HELP/TYPE header per name.
+type promFamily struct {
+	help    string
+	typ     string
+	samples []string
+}
+
+// writePrometheus renders collected metrics in the text exposition format.
+// Exponential histograms and summaries are skipped; nothing in hypeman
+// records them.
+func writePrometheus(w *bufio.Writer, rm metricdata.ResourceMetrics) {
+	families := map[string]*promFamily{}
+	family := func(name, help, typ string) *promFamily {
+		f, ok := families[name]
+		if !ok {
+			f = &promFamily{help: help, typ: typ}
+			families[name] = f
+		}
+		return f
+	}
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			name := sanitizeName(m.Name)
+			switch data := m.Data.(type) {
+			case metricdata.Gauge[int64]:
+				f := family(name, m.Description, "gauge")
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, sample(name, dp.Attributes, nil, float64(dp.Value)))
+				}
+			case metricdata.Gauge[float64]:
+				f := family(name, m.Description, "gauge")
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, sample(name, dp.Attributes, nil, dp.Value))
+				}
+			case metricdata.Sum[int64]:
+				f := family(name, m.Description, sumType(data.IsMonotonic))
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, sample(name, dp.Attributes, nil, float64(dp.Value)))
+				}
+			case metricdata.Sum[float64]:
+				f := family(name, m.Description, sumType(data.IsMonotonic))
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, sample(name, dp.Attributes, nil, dp.Value))
+				}
+			case metricdata.Histogram[int64]:
+				f := family(name, m.Description, "histogram")
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, histogramSamples(name, dp.Attributes, dp.Bounds, dp.BucketCounts, float64(dp.Sum), dp.Count)...)
+				}
+			case metricdata.Histogram[float64]:
+				f := family(name, m.Description, "histogram")
+				for _, dp := range data.DataPoints {
+					f.samples = append(f.samples, histogramSamples(name, dp.Attributes, dp.Bounds, dp.BucketCounts, dp.Sum, dp.Count)...)
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		f := families[name]
+		if f.help != "" {
+			fmt.Fprintf(w, "# HELP %s %s\n", name, escapeHelp(f.help))
+		}
+		fmt.Fprintf(w, "# TYPE %s %s\n", name, f.typ)
+		for _, s := range f.samples {
+			w.WriteString(s)
+		}
+	}
+}
+
+func sumType(monotonic bool) string {
+	if monotonic {
+		return "counter"
+	}
+	return "gauge"
+}
+
+// histogramSamples renders cumulative _bucket, _sum and _count samples
+func histogramSamples(name string, attrs attribute.Set, bounds []float64, counts []uint64, sum float64, count uint64) []string {
+	samples := make([]string, 0, len(bounds)+3)
+	var cumulative uint64
+	for i, bound := range bounds {
+		if i < len(counts) {
+			cumulative += counts[i]
+		}
+		le := attribute.String("le", formatFloat(bound))
+		samples = append(samples, sample(name+"_bucket", attrs, &le, float64(cumulative)))
+	}
+	inf := attribute.String("le", "+Inf")
+	samples = append(samples,
+		sample(name+"_bucket", attrs, &inf, float64(count)),
+		sample(name+"_sum", attrs, nil, sum),
+		sample(name+"_count", attrs, nil, float64(count)),
+	)
+	return samples
+}
+
+// sample renders one line: name{labels} value
+func sample(name string, attrs attribute.Set, extra *attribute.KeyValue, value float64) string {
+	var b strings.Builder
+	b.WriteString(name)
+
+	kvs := attrs.ToSlice()
+	if extra != nil {
+		kvs = append(kvs, *extra)
+	}
+	if len(kvs) > 0 {
+		b.WriteByte('{')
+		for i, kv := range kvs {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(sanitizeName(string(kv.Key)))
+			b.WriteString(`="`)
+			b.WriteString(escapeLabelValue(kv.Value.Emit()))
+			b.WriteByte('"')
+		}
+		b.WriteByte('}')
+	}
+
+	b.WriteByte(' ')
+	b.WriteString(formatFloat(value))
+	b.WriteByte('\n')
+	return b.String()
+}
+
+// sanitizeName maps OTel names like "process.runtime.go.goroutines" onto the
+// Prometheus character set [a-zA-Z0-9_:]
+func sanitizeName(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_', r == ':':
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string       { return helpEscaper.Replace(s) }
+func escapeLabelValue(s string) string { return labelEscaper.Replace(s) }
@@ -0,0 +1,92 @@
+package otel
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func scrape(t *testing.T, h http.Handler, token string) (int, string) {
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return rec.Code, string(body)
+}
+
+func TestPrometheusExporter(t *testing.T) {
+	exporter := newPrometheusExporter("")
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter.reader))
+	meter := mp.Meter("test")
+	ctx := context.Background()
+
+	counter, err := meter.Int64Counter("hypeman_builds_total", metric.WithDescription("Total number of builds"))
+	require.NoError(t, err)
+	counter.Add(ctx, 2, metric.WithAttributes(attribute.String("status", "success")))
+
+	hist, err := meter.Float64Histogram("hypeman_build_duration_seconds",
+		metric.WithExplicitBucketBoundaries(1, 10))
+	require.NoError(t, err)
+	hist.Record(ctx, 0.5)
+	hist.Record(ctx, 5)
+
+	gauge, err := meter.Int64UpDownCounter("process.runtime.go.goroutines")
+	require.NoError(t, err)
+	gauge.Add(ctx, 7)
+
+	code, body := scrape(t, exporter, "")
+	require.Equal(t, http.StatusOK, code)
+
+	assert.Contains(t, body, "# HELP hypeman_builds_total Total number of builds\n")
+	assert.Contains(t, body, "# TYPE hypeman_builds_total counter\n")
+	assert.Contains(t, body, "hypeman_builds_total{status=\"success\"} 2\n")
+
+	assert.Contains(t, body, "# TYPE hypeman_build_duration_seconds histogram\n")
+	assert.Contains(t, body, "hypeman_build_duration_seconds_bucket{le=\"1\"} 1\n")
+	assert.Contains(t, body, "hypeman_build_duration_seconds_bucket{le=\"10\"} 2\n")
+	assert.Contains(t, body, "hypeman_build_duration_seconds_bucket{le=\"+Inf\"} 2\n")
+	assert.Contains(t, body, "hypeman_build_duration_seconds_sum 5.5\n")
+	assert.Contains(t, body, "hypeman_build_duration_seconds_count 2\n")
+
+	// Non-monotonic sums are gauges, and OTel dots become underscores
+	assert.Contains(t, body, "# TYPE process_runtime_go_goroutines gauge\n")
+	assert.Contains(t, body, "process_runtime_go_goroutines 7\n")
+}
+
+func TestPrometheusExporterToken(t *testing.T) {
+	exporter := newPrometheusExporter("secret")
+	sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter.reader))
+
+	code, _ := scrape(t, exporter, "")
+	assert.Equal(t, http.StatusUnauthorized, code)
+	code, _ = scrape(t, exporter, "wrong")
+	assert.Equal(t, http.StatusUnauthorized, code)
+	code, _ = scrape(t, exporter, "secret")
+	assert.Equal(t, http.StatusOK, code)
+}
+
+func TestInitPrometheusWithoutOTLP(t *testing.T) {
+	provider, shutdown, err := Init(context.Background(), Config{
+		ServiceName:       "hypeman",
+		PrometheusEnabled: true,
+	})
+	require.NoError(t, err)
+	defer shutdown(context.Background())
+	require.NotNil(t, provider.MetricsHandler)
+
+	code, body := scrape(t, provider.MetricsHandler, "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "hypeman_uptime_seconds")
+}
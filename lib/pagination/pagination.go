@@ -0,0 +1,173 @@
+// Package pagination sorts list results and splits them into pages.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultLimit is the page size when a request doesn't set one
+	DefaultLimit = 100
+	// MaxLimit is the largest page size a request may ask for
+	MaxLimit = 1000
+)
+
+var (
+	// ErrInvalidLimit is returned when the limit is negative or above MaxLimit
+	ErrInvalidLimit = errors.New("invalid limit")
+
+	// ErrInvalidSort is returned when the sort field isn't supported by the list
+	ErrInvalidSort = errors.New("invalid sort")
+
+	// ErrInvalidCursor is returned when the cursor can't be decoded or was
+	// issued for a different sort order
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// Request selects one page of a list
+type Request struct {
+	Limit  int    // Page size, 0 for DefaultLimit
+	Cursor string // NextCursor of the previous page, empty for the first page
+	Sort   string // Sort field, prefixed with "-" for descending, empty for the list's default
+}
+
+// Page is one page of a list
+type Page[T any] struct {
+	Items      []T
+	Total      int    // Number of items across all pages
+	NextCursor string // Empty on the last page
+}
+
+// SortKeys maps the sort fields a list supports to the function that returns
+// an item's key for that field. Keys are compared as strings.
+type SortKeys[T any] map[string]func(T) string
+
+// TimeKey formats t as a sort key that orders chronologically
+func TimeKey(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000000000Z")
+}
+
+// SortField returns the field a sort sorts by, without its direction
+func SortField(sort string) string {
+	return strings.TrimPrefix(sort, "-")
+}
+
+// cursor is the position after which the next page starts. It carries the
+// sort it was issued for, so it can't be replayed against another order.
+type cursor struct {
+	Sort string `json:"s"`
+	Key  string `json:"k"`
+	ID   string `json:"i"`
+}
+
+type keyed[T any] struct {
+	item T
+	key  string
+	id   string
+}
+
+// Paginate sorts items by the requested field, or by defaultSort if the
+// request has none, and returns the requested page. Items with equal keys are
+// ordered by ID, so pages don't overlap or skip items even when many share a
+// key. The cursor is a position rather than an offset, so items created or
+// deleted between requests don't shift the following pages.
+func Paginate[T any](items []T, req Request, keys SortKeys[T], defaultSort string, id func(T) string) (*Page[T], error) {
+	limit := req.Limit
+	if limit < 0 || limit > MaxLimit {
+		return nil, fmt.Errorf("%w: must be between 1 and %d", ErrInvalidLimit, MaxLimit)
+	}
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+
+	sortBy := req.Sort
+	if sortBy == "" {
+		sortBy = defaultSort
+	}
+	desc := strings.HasPrefix(sortBy, "-")
+	keyFunc, ok := keys[SortField(sortBy)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q, must be one of %s", ErrInvalidSort, sortBy, strings.Join(sortFields(keys), ", "))
+	}
+
+	compare := func(key, id string, other keyed[T]) int {
+		c := strings.Compare(key, other.key)
+		if c == 0 {
+			c = strings.Compare(id, other.id)
+		}
+		if desc {
+			c = -c
+		}
+		return c
+	}
+
+	sorted := make([]keyed[T], len(items))
+	for i, item := range items {
+		sorted[i] = keyed[T]{item: item, key: keyFunc(item), id: id(item)}
+	}
+	slices.SortFunc(sorted, func(a, b keyed[T]) int { return compare(a.key, a.id, b) })
+
+	start := 0
+	if req.Cursor != "" {
+		after, err := decodeCursor(req.Cursor)
+		if err != nil || after.Sort != sortBy {
+			return nil, ErrInvalidCursor
+		}
+		start, _ = slices.BinarySearchFunc(sorted, after, func(item keyed[T], after cursor) int {
+			if compare(item.key, item.id, keyed[T]{key: after.Key, id: after.ID}) <= 0 {
+				return -1
+			}
+			return 1
+		})
+	}
+	end := min(start+limit, len(sorted))
+
+	page := &Page[T]{Items: make([]T, 0, end-start), Total: len(sorted)}
+	for _, item := range sorted[start:end] {
+		page.Items = append(page.Items, item.item)
+	}
+	if end < len(sorted) {
+		last := sorted[end-1]
+		page.NextCursor = encodeCursor(cursor{Sort: sortBy, Key: last.key, ID: last.id})
+	}
+	return page, nil
+}
+
+// Map converts the items of a page, keeping its total and cursor
+func Map[T, U any](p *Page[T], f func(T) U) *Page[U] {
+	items := make([]U, len(p.Items))
+	for i, item := range p.Items {
+		items[i] = f(item)
+	}
+	return &Page[U]{Items: items, Total: p.Total, NextCursor: p.NextCursor}
+}
+
+func sortFields[T any](keys SortKeys[T]) []string {
+	fields := make([]string, 0, len(keys))
+	for field := range keys {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	return fields
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string) (cursor, error) {
+	var c cursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(data, &c)
+	return c, err
+}
@@ -0,0 +1,117 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	id      string
+	name    string
+	created time.Time
+}
+
+var testKeys = SortKeys[item]{
+	"created_at": func(i item) string { return TimeKey(i.created) },
+	"name":       func(i item) string { return i.name },
+}
+
+func itemID(i item) string { return i.id }
+
+func ids(items []item) []string {
+	out := make([]string, len(items))
+	for i, it := range items {
+		out[i] = it.id
+	}
+	return out
+}
+
+func testItems() []item {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []item{
+		{id: "c", name: "web", created: base.Add(2 * time.Hour)},
+		{id: "a", name: "api", created: base},
+		{id: "e", name: "web", created: base.Add(4 * time.Hour)},
+		{id: "b", name: "db", created: base.Add(time.Hour)},
+		{id: "d", name: "api", created: base.Add(3 * time.Hour)},
+	}
+}
+
+func TestPaginate_Sort(t *testing.T) {
+	page, err := Paginate(testItems(), Request{}, testKeys, "created_at", itemID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, ids(page.Items))
+	assert.Equal(t, 5, page.Total)
+	assert.Empty(t, page.NextCursor)
+
+	page, err = Paginate(testItems(), Request{Sort: "-created_at"}, testKeys, "created_at", itemID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"e", "d", "c", "b", "a"}, ids(page.Items))
+
+	// Equal names are ordered by ID
+	page, err = Paginate(testItems(), Request{Sort: "name"}, testKeys, "created_at", itemID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "d", "b", "c", "e"}, ids(page.Items))
+
+	page, err = Paginate(testItems(), Request{Sort: "-name"}, testKeys, "created_at", itemID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"e", "c", "b", "d", "a"}, ids(page.Items))
+}
+
+func TestPaginate_Cursor(t *testing.T) {
+	for _, sort := range []string{"created_at", "-created_at", "name", "-name"} {
+		all, err := Paginate(testItems(), Request{Sort: sort}, testKeys, "created_at", itemID)
+		require.NoError(t, err)
+
+		var got []string
+		req := Request{Limit: 2, Sort: sort}
+		for {
+			page, err := Paginate(testItems(), req, testKeys, "created_at", itemID)
+			require.NoError(t, err)
+			assert.Equal(t, 5, page.Total)
+			got = append(got, ids(page.Items)...)
+			if page.NextCursor == "" {
+				break
+			}
+			req.Cursor = page.NextCursor
+		}
+		assert.Equal(t, ids(all.Items), got, "sort %s", sort)
+	}
+}
+
+func TestPaginate_CursorSurvivesChanges(t *testing.T) {
+	page, err := Paginate(testItems(), Request{Limit: 2}, testKeys, "created_at", itemID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, ids(page.Items))
+
+	// Deleting an item on the first page doesn't shift the second
+	items := testItems()[:1]
+	items = append(items, testItems()[2:]...)
+	next, err := Paginate(items, Request{Limit: 2, Cursor: page.NextCursor}, testKeys, "created_at", itemID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "d"}, ids(next.Items))
+	assert.Equal(t, 4, next.Total)
+}
+
+func TestPaginate_Invalid(t *testing.T) {
+	_, err := Paginate(testItems(), Request{Limit: -1}, testKeys, "created_at", itemID)
+	assert.ErrorIs(t, err, ErrInvalidLimit)
+
+	_, err = Paginate(testItems(), Request{Limit: MaxLimit + 1}, testKeys, "created_at", itemID)
+	assert.ErrorIs(t, err, ErrInvalidLimit)
+
+	_, err = Paginate(testItems(), Request{Sort: "state"}, testKeys, "created_at", itemID)
+	assert.ErrorIs(t, err, ErrInvalidSort)
+
+	_, err = Paginate(testItems(), Request{Cursor: "not a cursor"}, testKeys, "created_at", itemID)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	// A cursor only continues the sort it was issued for
+	page, err := Paginate(testItems(), Request{Limit: 2}, testKeys, "created_at", itemID)
+	require.NoError(t, err)
+	_, err = Paginate(testItems(), Request{Sort: "name", Cursor: page.NextCursor}, testKeys, "created_at", itemID)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
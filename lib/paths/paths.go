@@ -129,6 +129,12 @@ func (p *Paths) InstanceOverlay(id string) string {
 	return filepath.Join(p.InstanceDir(id), "overlay.raw")
 }
 
+// InstanceOverlayQcow2 returns the path to instance overlay disk when it is a
+// qcow2 image.
+func (p *Paths) InstanceOverlayQcow2(id string) string {
+	return filepath.Join(p.InstanceDir(id), "overlay.qcow2")
+}
+
 // InstanceConfigDisk returns the path to instance config disk.
 func (p *Paths) InstanceConfigDisk(id string) string {
 	return filepath.Join(p.InstanceDir(id), "config.ext4")
@@ -161,11 +167,17 @@ func (p *Paths) InstanceLogs(id string) string {
 	return filepath.Join(p.InstanceDir(id), "logs")
 }
 
-// InstanceAppLog returns the path to instance application log (guest serial console).
+// InstanceAppLog returns the path to instance application log (guest virtio
+// console, or the serial console where the hypervisor has no virtio console).
 func (p *Paths) InstanceAppLog(id string) string {
 	return filepath.Join(p.InstanceLogs(id), "app.log")
 }
 
+// InstanceSystemLog returns the path to instance kernel and init log (guest serial console).
+func (p *Paths) InstanceSystemLog(id string) string {
+	return filepath.Join(p.InstanceLogs(id), "system.log")
+}
+
 // InstanceVMMLog returns the path to instance VMM log (Cloud Hypervisor stdout+stderr).
 func (p *Paths) InstanceVMMLog(id string) string {
 	return filepath.Join(p.InstanceLogs(id), "vmm.log")
@@ -192,11 +204,44 @@ func (p *Paths) InstanceSnapshotConfig(id string) string {
 	return filepath.Join(p.InstanceSnapshotLatest(id), "config.json")
 }
 
+// InstanceExecSessions returns the directory of an instance's exec session log.
+func (p *Paths) InstanceExecSessions(id string) string {
+	return filepath.Join(p.InstanceDir(id), "exec-sessions")
+}
+
+// InstanceExecSession returns the path to an exec session's audit record.
+func (p *Paths) InstanceExecSession(id, sessionID string) string {
+	return filepath.Join(p.InstanceExecSessions(id), sessionID+".json")
+}
+
+// InstanceExecRecording returns the path to an exec session's TTY recording
+// (asciicast v2).
+func (p *Paths) InstanceExecRecording(id, sessionID string) string {
+	return filepath.Join(p.InstanceExecSessions(id), sessionID+".cast")
+}
+
 // GuestsDir returns the root guests directory.
 func (p *Paths) GuestsDir() string {
 	return filepath.Join(p.dataDir, "guests")
 }
 
+// PreservedDir returns the root directory of instances kept by a delete
+// with snapshot.
+func (p *Paths) PreservedDir() string {
+	return filepath.Join(p.dataDir, "preserved")
+}
+
+// PreservedInstanceDir returns the directory of a preserved instance. It
+// holds the instance directory as it was at deletion.
+func (p *Paths) PreservedInstanceDir(id string) string {
+	return filepath.Join(p.PreservedDir(), id)
+}
+
+// PreservedInfo returns the path to a preserved instance's preserved.json.
+func (p *Paths) PreservedInfo(id string) string {
+	return filepath.Join(p.PreservedInstanceDir(id), "preserved.json")
+}
+
 // Device path methods
 
 // DevicesDir returns the root devices directory.
@@ -263,6 +308,11 @@ func (p *Paths) CaddyLogFile() string {
 	return filepath.Join(p.CaddyDir(), "caddy.log")
 }
 
+// CaddyAccessLogFile returns the path to Caddy's access log of proxied requests.
+func (p *Paths) CaddyAccessLogFile() string {
+	return filepath.Join(p.CaddyDir(), "access.log")
+}
+
 // CaddyDataDir returns the path to Caddy's data directory (for certs, etc.).
 func (p *Paths) CaddyDataDir() string {
 	return filepath.Join(p.CaddyDir(), "data")
@@ -285,6 +335,12 @@ func (p *Paths) IngressMetadata(id string) string {
 	return filepath.Join(p.IngressesDir(), id+".json")
 }
 
+// IngressDefaultBackend returns the path to the default backend setting. It
+// lives outside IngressesDir, where every .json file is an ingress.
+func (p *Paths) IngressDefaultBackend() string {
+	return filepath.Join(p.dataDir, "ingress-default-backend.json")
+}
+
 // Build path methods
 
 // BuildsDir returns the root builds directory.
@@ -292,6 +348,12 @@ func (p *Paths) BuildsDir() string {
 	return filepath.Join(p.dataDir, "builds")
 }
 
+// BuildUploadsDir returns the directory build sources are staged in while
+// they are uploaded.
+func (p *Paths) BuildUploadsDir() string {
+	return filepath.Join(p.dataDir, "build-uploads")
+}
+
 // BuildDir returns the directory for a specific build.
 func (p *Paths) BuildDir(id string) string {
 	return filepath.Join(p.BuildsDir(), id)
@@ -321,3 +383,53 @@ func (p *Paths) BuildSourceDir(id string) string {
 func (p *Paths) BuildConfig(id string) string {
 	return filepath.Join(p.BuildDir(id), "config.json")
 }
+
+// BuildArtifact returns the path to a build's exported artifact: a tarball for
+// "tar" output, or a directory of files for "local" output.
+func (p *Paths) BuildArtifact(id string) string {
+	return filepath.Join(p.BuildDir(id), "artifact")
+}
+
+// API key path methods
+
+// APIKeysDir returns the directory holding API key records.
+func (p *Paths) APIKeysDir() string {
+	return filepath.Join(p.dataDir, "apikeys")
+}
+
+// APIKeyMetadata returns the path to an API key's record.
+func (p *Paths) APIKeyMetadata(id string) string {
+	return filepath.Join(p.APIKeysDir(), id+".json")
+}
+
+// Audit path methods
+
+// AuditLog returns the path to the API audit log.
+func (p *Paths) AuditLog() string {
+	return filepath.Join(p.dataDir, "logs", "audit.log")
+}
+
+// Reference index path methods
+
+// ReferenceIndex returns the path to the persisted index of which resources
+// reference which.
+func (p *Paths) ReferenceIndex() string {
+	return filepath.Join(p.dataDir, "references.json")
+}
+
+// Registry path methods
+
+// RegistryDir returns the root directory for built-in registry state.
+func (p *Paths) RegistryDir() string {
+	return filepath.Join(p.dataDir, "registry")
+}
+
+// RegistryRepositoriesDir returns the directory holding per-repository manifest indexes.
+func (p *Paths) RegistryRepositoriesDir() string {
+	return filepath.Join(p.RegistryDir(), "repositories")
+}
+
+// RegistryRepoIndex returns the path to a registry repository's manifest index.
+func (p *Paths) RegistryRepoIndex(repo string) string {
+	return filepath.Join(p.RegistryRepositoriesDir(), repo, "index.json")
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/c2h5oh/datasize"
 	"github.com/onkernel/hypeman/cmd/api/config"
+	"github.com/onkernel/hypeman/lib/apikeys"
 	"github.com/onkernel/hypeman/lib/builds"
 	"github.com/onkernel/hypeman/lib/devices"
 	"github.com/onkernel/hypeman/lib/hypervisor"
@@ -15,6 +18,7 @@ import (
 	"github.com/onkernel/hypeman/lib/ingress"
 	"github.com/onkernel/hypeman/lib/instances"
 	"github.com/onkernel/hypeman/lib/logger"
+	"github.com/onkernel/hypeman/lib/middleware"
 	"github.com/onkernel/hypeman/lib/network"
 	hypemanotel "github.com/onkernel/hypeman/lib/otel"
 	"github.com/onkernel/hypeman/lib/paths"
@@ -120,10 +124,27 @@ func ProvideInstanceManager(p *paths.Paths, cfg *config.Config, imageManager ima
 		MaxTotalMemory:       maxTotalMemory,
 	}
 
+	stopGracePeriod, err := time.ParseDuration(cfg.StopGracePeriod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse STOP_GRACE_PERIOD '%s': %w (expected format like '10s', '1m')", cfg.StopGracePeriod, err)
+	}
+
+	if cfg.SerialConsoleBaud <= 0 {
+		return nil, fmt.Errorf("invalid SERIAL_CONSOLE_BAUD %d: must be positive", cfg.SerialConsoleBaud)
+	}
+	console := instances.ConsoleConfig{BaudRate: cfg.SerialConsoleBaud}
+	if cfg.KernelLogBufferSize != "" {
+		var bufSize datasize.ByteSize
+		if err := bufSize.UnmarshalText([]byte(cfg.KernelLogBufferSize)); err != nil {
+			return nil, fmt.Errorf("failed to parse KERNEL_LOG_BUFFER_SIZE '%s': %w", cfg.KernelLogBufferSize, err)
+		}
+		console.KernelLogBufSize = int64(bufSize)
+	}
+
 	meter := otel.GetMeterProvider().Meter("hypeman")
 	tracer := otel.GetTracerProvider().Tracer("hypeman")
 	defaultHypervisor := hypervisor.Type(cfg.DefaultHypervisor)
-	return instances.NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, defaultHypervisor, meter, tracer), nil
+	return instances.NewManager(p, imageManager, systemManager, networkManager, deviceManager, volumeManager, limits, stopGracePeriod, console, defaultHypervisor, meter, tracer), nil
 }
 
 // ProvideVolumeManager provides the volume manager
@@ -142,9 +163,39 @@ func ProvideVolumeManager(p *paths.Paths, cfg *config.Config) (volumes.Manager,
 	return volumes.NewManager(p, maxTotalVolumeStorage, meter), nil
 }
 
-// ProvideRegistry provides the OCI registry for image push
-func ProvideRegistry(p *paths.Paths, imageManager images.Manager) (*registry.Registry, error) {
-	return registry.New(p, imageManager)
+// ProvideRegistry provides the OCI registry for image push, optionally
+// mirroring an upstream registry on pull misses
+func ProvideRegistry(p *paths.Paths, cfg *config.Config, imageManager images.Manager) (*registry.Registry, error) {
+	reg, err := registry.New(p, imageManager)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.RegistryRepoQuota != "" {
+		var quota datasize.ByteSize
+		if err := quota.UnmarshalText([]byte(cfg.RegistryRepoQuota)); err != nil {
+			return nil, fmt.Errorf("failed to parse REGISTRY_REPO_QUOTA '%s': %w (expected format like '20GB', '500MB')", cfg.RegistryRepoQuota, err)
+		}
+		reg.SetRepositoryQuota(int64(quota))
+	}
+
+	if cfg.RegistryUpstream == "" {
+		return reg, nil
+	}
+
+	tagTTL, err := time.ParseDuration(cfg.RegistryUpstreamTagTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse REGISTRY_UPSTREAM_TAG_TTL '%s': %w (expected format like '5m', '1h')", cfg.RegistryUpstreamTagTTL, err)
+	}
+	if err := reg.SetUpstream(registry.UpstreamConfig{
+		Registry: cfg.RegistryUpstream,
+		Username: cfg.RegistryUpstreamUsername,
+		Password: cfg.RegistryUpstreamPassword,
+		TagTTL:   tagTTL,
+	}); err != nil {
+		return nil, fmt.Errorf("configure registry upstream: %w", err)
+	}
+	return reg, nil
 }
 
 // ProvideResourceManager provides the resource manager for capacity tracking
@@ -164,6 +215,34 @@ func ProvideResourceManager(ctx context.Context, cfg *config.Config, p *paths.Pa
 	return mgr, nil
 }
 
+// ProvideJWTVerifier provides the verifier of API tokens, built from the JWT settings
+func ProvideJWTVerifier(cfg *config.Config) (*middleware.JWTVerifier, error) {
+	jwtCfg := middleware.JWTConfig{
+		Secret:    cfg.JwtSecret,
+		Algorithm: cfg.JwtAlgorithm,
+		JWKSURL:   cfg.JwtJwksURL,
+		Audience:  cfg.JwtAudience,
+		Issuer:    cfg.JwtIssuer,
+	}
+	if cfg.JwtPublicKeyFile != "" {
+		keyPEM, err := os.ReadFile(cfg.JwtPublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read JWT_PUBLIC_KEY_FILE: %w", err)
+		}
+		jwtCfg.PublicKeyPEM = keyPEM
+	}
+	verifier, err := middleware.NewJWTVerifier(jwtCfg)
+	if err != nil {
+		return nil, fmt.Errorf("configure JWT verification: %w", err)
+	}
+	return verifier, nil
+}
+
+// ProvideAPIKeyManager provides the API key manager
+func ProvideAPIKeyManager(p *paths.Paths) apikeys.Manager {
+	return apikeys.NewManager(p)
+}
+
 // ProvideIngressManager provides the ingress manager
 func ProvideIngressManager(p *paths.Paths, cfg *config.Config, instanceManager instances.Manager) (ingress.Manager, error) {
 	// Parse DNS provider - fail if invalid
@@ -186,11 +265,12 @@ func ProvideIngressManager(p *paths.Paths, cfg *config.Config, instanceManager i
 	}
 
 	ingressConfig := ingress.Config{
-		ListenAddress:  cfg.CaddyListenAddress,
-		AdminAddress:   cfg.CaddyAdminAddress,
-		AdminPort:      cfg.CaddyAdminPort,
-		DNSPort:        internalDNSPort,
-		StopOnShutdown: cfg.CaddyStopOnShutdown,
+		ListenAddress:     cfg.CaddyListenAddress,
+		AdminAddress:      cfg.CaddyAdminAddress,
+		AdminPort:         cfg.CaddyAdminPort,
+		DNSPort:           internalDNSPort,
+		StopOnShutdown:    cfg.CaddyStopOnShutdown,
+		DNSServiceRecords: cfg.InternalDNSServiceRecords,
 		ACME: ingress.ACMEConfig{
 			Email:                 cfg.AcmeEmail,
 			DNSProvider:           dnsProvider,
@@ -215,13 +295,26 @@ func ProvideIngressManager(p *paths.Paths, cfg *config.Config, instanceManager i
 }
 
 // ProvideBuildManager provides the build manager
-func ProvideBuildManager(p *paths.Paths, cfg *config.Config, instanceManager instances.Manager, volumeManager volumes.Manager, log *slog.Logger) (builds.Manager, error) {
+func ProvideBuildManager(p *paths.Paths, cfg *config.Config, instanceManager instances.Manager, volumeManager volumes.Manager, imageManager images.Manager, reg *registry.Registry, log *slog.Logger) (builds.Manager, error) {
 	buildConfig := builds.Config{
 		MaxConcurrentBuilds: cfg.MaxConcurrentSourceBuilds,
 		BuilderImage:        cfg.BuilderImage,
 		RegistryURL:         cfg.RegistryURL,
 		DefaultTimeout:      cfg.BuildTimeout,
-		RegistrySecret:      cfg.JwtSecret, // Use same secret for registry tokens
+		RegistrySecret:      middleware.PrimaryJWTSecret(cfg.JwtSecret), // Use same secret for registry tokens
+		BuilderPoolSize:     cfg.BuilderPoolSize,
+		MinMemoryMB:         cfg.BuildMinMemoryMB,
+		MaxMemoryMB:         cfg.BuildMaxMemoryMB,
+	}
+	var maxSourceSize datasize.ByteSize
+	if err := maxSourceSize.UnmarshalText([]byte(cfg.BuildMaxSourceSize)); err != nil {
+		return nil, fmt.Errorf("failed to parse BUILD_MAX_SOURCE_SIZE '%s': %w", cfg.BuildMaxSourceSize, err)
+	}
+	buildConfig.MaxSourceSize = int64(maxSourceSize)
+	for _, frontend := range strings.Split(cfg.BuildAllowedFrontends, ",") {
+		if frontend = strings.TrimSpace(frontend); frontend != "" {
+			buildConfig.AllowedFrontends = append(buildConfig.AllowedFrontends, frontend)
+		}
 	}
 
 	// Apply defaults if not set
@@ -247,6 +340,14 @@ func ProvideBuildManager(p *paths.Paths, cfg *config.Config, instanceManager ins
 		secretProvider = &builds.NoOpSecretProvider{}
 	}
 
+	// Builder VMs pull base images through the registry's pull-through cache
+	var baseImages builds.BaseImageCache
+	if reg.Upstream() != "" {
+		baseImages = reg
+		log.Info("build base image cache enabled", "upstream", reg.Upstream())
+	}
+
 	meter := otel.GetMeterProvider().Meter("hypeman")
-	return builds.NewManager(p, buildConfig, instanceManager, volumeManager, secretProvider, log, meter)
+	tracer := otel.GetTracerProvider().Tracer("hypeman")
+	return builds.NewManager(p, buildConfig, instanceManager, volumeManager, imageManager, secretProvider, baseImages, log, meter, tracer)
 }
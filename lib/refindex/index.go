@@ -0,0 +1,258 @@
+// Package refindex indexes which resources reference which, so a resource's
+// dependents can be found without every manager scanning its own metadata.
+package refindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// ResourceType is a kind of resource in the index
+type ResourceType string
+
+const (
+	TypeInstance ResourceType = "instance"
+	TypeVolume   ResourceType = "volume"
+	TypeDevice   ResourceType = "device"
+	TypeIngress  ResourceType = "ingress"
+	TypeBuild    ResourceType = "build"
+)
+
+// Ref names a resource
+type Ref struct {
+	Type ResourceType `json:"type"`
+	ID   string       `json:"id"`
+}
+
+// Source reports the references held by one manager's resources, for
+// rebuilding the index from the managers' own metadata
+type Source interface {
+	// IndexedReferences returns what each resource references, keyed by
+	// the referencing resource
+	IndexedReferences(ctx context.Context) (map[Ref][]Ref, error)
+}
+
+// Index maps each resource to the resources it references, and back. It is
+// persisted on every change. The managers' metadata stays the source of
+// truth: Rebuild replaces the index from it at startup.
+//
+// A nil *Index ignores updates and has no dependents, so managers can hold
+// one before it is set.
+type Index struct {
+	path string
+
+	mu         sync.RWMutex
+	refs       map[Ref][]Ref            // What each resource references
+	dependents map[Ref]map[Ref]struct{} // Who references each resource
+	touched    map[Ref]struct{}         // Resources updated during a rebuild (nil when not rebuilding)
+}
+
+// entry is the persisted form of a resource's references
+type entry struct {
+	From Ref   `json:"from"`
+	To   []Ref `json:"to"`
+}
+
+// Open loads the index persisted at path, or starts an empty one if there is
+// none. A file that doesn't parse is ignored; Rebuild replaces it anyway.
+func Open(path string) (*Index, error) {
+	x := &Index{path: path}
+	x.reset(nil)
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return x, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read reference index: %w", err)
+	}
+	var entries []entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return x, nil
+	}
+	refs := make(map[Ref][]Ref, len(entries))
+	for _, e := range entries {
+		refs[e.From] = e.To
+	}
+	x.reset(refs)
+	return x, nil
+}
+
+// Set records everything a resource references, replacing what was recorded
+// for it before. Setting no references removes the resource from the index.
+func (x *Index) Set(from Ref, to []Ref) error {
+	if x == nil {
+		return nil
+	}
+	to = normalize(from, to)
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.touched != nil {
+		x.touched[from] = struct{}{}
+	}
+	if slices.Equal(x.refs[from], to) {
+		return nil
+	}
+	x.unlink(from)
+	if len(to) > 0 {
+		x.link(from, to)
+	}
+	return x.save()
+}
+
+// Remove drops a resource's references, when it is deleted. Resources that
+// reference it keep their entries until they change themselves.
+func (x *Index) Remove(from Ref) error {
+	return x.Set(from, nil)
+}
+
+// GetDependents returns the resources that reference the given one, ordered
+// by type and ID
+func (x *Index) GetDependents(ctx context.Context, resourceType ResourceType, id string) []Ref {
+	if x == nil {
+		return nil
+	}
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	deps := x.dependents[Ref{Type: resourceType, ID: id}]
+	result := make([]Ref, 0, len(deps))
+	for dep := range deps {
+		result = append(result, dep)
+	}
+	sortRefs(result)
+	return result
+}
+
+// Rebuild replaces the index with the references the sources report. A
+// resource updated while the sources are listed keeps its update, since a
+// source may have listed it before the change.
+func (x *Index) Rebuild(ctx context.Context, sources ...Source) error {
+	x.mu.Lock()
+	x.touched = make(map[Ref]struct{})
+	x.mu.Unlock()
+
+	refs := make(map[Ref][]Ref)
+	var listErr error
+	for _, src := range sources {
+		srcRefs, err := src.IndexedReferences(ctx)
+		if err != nil {
+			listErr = fmt.Errorf("list references: %w", err)
+			break
+		}
+		for from, to := range srcRefs {
+			if to = normalize(from, to); len(to) > 0 {
+				refs[from] = to
+			}
+		}
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	touched := x.touched
+	x.touched = nil
+	if listErr != nil {
+		return listErr
+	}
+	for from := range touched {
+		if to, ok := x.refs[from]; ok {
+			refs[from] = to
+		} else {
+			delete(refs, from)
+		}
+	}
+	x.reset(refs)
+	return x.save()
+}
+
+// reset replaces the index's contents. Callers hold mu, or own x.
+func (x *Index) reset(refs map[Ref][]Ref) {
+	x.refs = make(map[Ref][]Ref, len(refs))
+	x.dependents = make(map[Ref]map[Ref]struct{})
+	for from, to := range refs {
+		x.link(from, to)
+	}
+}
+
+func (x *Index) link(from Ref, to []Ref) {
+	x.refs[from] = to
+	for _, target := range to {
+		if x.dependents[target] == nil {
+			x.dependents[target] = make(map[Ref]struct{})
+		}
+		x.dependents[target][from] = struct{}{}
+	}
+}
+
+func (x *Index) unlink(from Ref) {
+	for _, target := range x.refs[from] {
+		delete(x.dependents[target], from)
+		if len(x.dependents[target]) == 0 {
+			delete(x.dependents, target)
+		}
+	}
+	delete(x.refs, from)
+}
+
+// save persists the index. Callers hold mu.
+func (x *Index) save() error {
+	entries := make([]entry, 0, len(x.refs))
+	for from, to := range x.refs {
+		entries = append(entries, entry{From: from, To: to})
+	}
+	sort.Slice(entries, func(i, j int) bool { return refLess(entries[i].From, entries[j].From) })
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal reference index: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(x.path), 0755); err != nil {
+		return fmt.Errorf("create reference index directory: %w", err)
+	}
+	tmpPath := x.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("write reference index: %w", err)
+	}
+	if err := os.Rename(tmpPath, x.path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("write reference index: %w", err)
+	}
+	return nil
+}
+
+// normalize sorts references and drops duplicates, empty IDs and
+// self-references, so equal sets compare equal
+func normalize(from Ref, to []Ref) []Ref {
+	result := make([]Ref, 0, len(to))
+	for _, ref := range to {
+		if ref.ID != "" && ref != from {
+			result = append(result, ref)
+		}
+	}
+	sortRefs(result)
+	result = slices.Compact(result)
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+func sortRefs(refs []Ref) {
+	sort.Slice(refs, func(i, j int) bool { return refLess(refs[i], refs[j]) })
+}
+
+func refLess(a, b Ref) bool {
+	if a.Type != b.Type {
+		return a.Type < b.Type
+	}
+	return a.ID < b.ID
+}
@@ -0,0 +1,88 @@
+package refindex
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSource map[Ref][]Ref
+
+func (s fakeSource) IndexedReferences(ctx context.Context) (map[Ref][]Ref, error) {
+	return s, nil
+}
+
+// updatingSource updates the index while it is being listed, as a manager
+// would if a resource changed during a rebuild
+type updatingSource struct {
+	idx  *Index
+	refs map[Ref][]Ref
+}
+
+func (s updatingSource) IndexedReferences(ctx context.Context) (map[Ref][]Ref, error) {
+	s.idx.Set(Ref{TypeIngress, "ing-new"}, []Ref{{TypeInstance, "inst-1"}})
+	s.idx.Remove(Ref{TypeBuild, "build-1"})
+	return s.refs, nil
+}
+
+func TestIndex_SetAndGetDependents(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "references.json")
+	idx, err := Open(path)
+	require.NoError(t, err)
+
+	inst := Ref{TypeInstance, "inst-1"}
+	require.NoError(t, idx.Set(inst, []Ref{{TypeVolume, "vol-1"}, {TypeDevice, "gpu-0"}, {TypeVolume, "vol-1"}}))
+	require.NoError(t, idx.Set(Ref{TypeIngress, "ing-1"}, []Ref{inst}))
+	require.NoError(t, idx.Set(Ref{TypeBuild, "build-1"}, []Ref{inst}))
+
+	assert.Equal(t, []Ref{{TypeBuild, "build-1"}, {TypeIngress, "ing-1"}}, idx.GetDependents(ctx, TypeInstance, "inst-1"))
+	assert.Equal(t, []Ref{inst}, idx.GetDependents(ctx, TypeVolume, "vol-1"))
+	assert.Equal(t, []Ref{inst}, idx.GetDependents(ctx, TypeDevice, "gpu-0"))
+	assert.Empty(t, idx.GetDependents(ctx, TypeVolume, "vol-2"))
+
+	// Replacing an instance's references moves it between volumes
+	require.NoError(t, idx.Set(inst, []Ref{{TypeVolume, "vol-2"}}))
+	assert.Empty(t, idx.GetDependents(ctx, TypeVolume, "vol-1"))
+	assert.Equal(t, []Ref{inst}, idx.GetDependents(ctx, TypeVolume, "vol-2"))
+
+	require.NoError(t, idx.Remove(Ref{TypeBuild, "build-1"}))
+	assert.Equal(t, []Ref{{TypeIngress, "ing-1"}}, idx.GetDependents(ctx, TypeInstance, "inst-1"))
+
+	// The index survives a restart
+	reopened, err := Open(path)
+	require.NoError(t, err)
+	assert.Equal(t, []Ref{{TypeIngress, "ing-1"}}, reopened.GetDependents(ctx, TypeInstance, "inst-1"))
+	assert.Equal(t, []Ref{inst}, reopened.GetDependents(ctx, TypeVolume, "vol-2"))
+
+	// A nil index ignores updates
+	var none *Index
+	assert.NoError(t, none.Set(inst, []Ref{{TypeVolume, "vol-1"}}))
+	assert.Empty(t, none.GetDependents(ctx, TypeVolume, "vol-1"))
+}
+
+func TestIndex_Rebuild(t *testing.T) {
+	ctx := context.Background()
+	idx, err := Open(filepath.Join(t.TempDir(), "references.json"))
+	require.NoError(t, err)
+
+	inst := Ref{TypeInstance, "inst-1"}
+	require.NoError(t, idx.Set(Ref{TypeIngress, "ing-stale"}, []Ref{inst}))
+
+	require.NoError(t, idx.Rebuild(ctx,
+		fakeSource{inst: {{TypeVolume, "vol-1"}}},
+		updatingSource{idx: idx, refs: map[Ref][]Ref{
+			{TypeIngress, "ing-1"}:    {inst},
+			{TypeBuild, "build-1"}:    {inst},
+			{TypeIngress, "ing-none"}: nil,
+		}},
+	))
+
+	// Stale entries are gone, and updates made during the rebuild win over
+	// what the sources listed
+	assert.Equal(t, []Ref{{TypeIngress, "ing-1"}, {TypeIngress, "ing-new"}}, idx.GetDependents(ctx, TypeInstance, "inst-1"))
+	assert.Equal(t, []Ref{inst}, idx.GetDependents(ctx, TypeVolume, "vol-1"))
+}
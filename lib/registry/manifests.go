@@ -0,0 +1,405 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/onkernel/hypeman/lib/images"
+	"github.com/onkernel/hypeman/lib/logger"
+)
+
+// errQuotaExceeded is returned when a manifest push would take a repository over its quota
+var errQuotaExceeded = errors.New("repository quota exceeded")
+
+// manifestRecord is a manifest pushed to a repository and the blobs it references.
+// The underlying registry keeps manifests in memory only; records persist what
+// quotas, deletion, and image garbage collection need across restarts.
+type manifestRecord struct {
+	Digest      string    `json:"digest"`
+	ImageDigest string    `json:"image_digest,omitempty"` // Digest of the imported image, once queued for conversion
+	Size        int64     `json:"size"`                   // Manifest size in bytes
+	Tags        []string  `json:"tags,omitempty"`
+	Blobs       []blobRef `json:"blobs,omitempty"` // Config and layer blobs
+}
+
+// blobRef is a blob referenced by a pushed manifest
+type blobRef struct {
+	Digest string `json:"digest"`
+	Size   int64  `json:"size"`
+}
+
+// repoIndex is the set of manifests pushed to one repository, keyed by digest
+type repoIndex struct {
+	Manifests map[string]*manifestRecord `json:"manifests"`
+}
+
+// SetRepositoryQuota limits the total size of each repository's manifests and
+// blobs. Blobs shared between manifests in a repository count once. Pushes that
+// would exceed the quota are rejected with 413. Zero disables the quota.
+func (r *Registry) SetRepositoryQuota(bytes int64) {
+	r.quota = bytes
+}
+
+// newManifestRecord builds the record for a pushed manifest. Blob sizes come
+// from the blob store when the blob was uploaded, so the quota can't be dodged
+// by under-declaring sizes in the manifest.
+func (r *Registry) newManifestRecord(ctx context.Context, repo, digest string, body []byte) *manifestRecord {
+	record := &manifestRecord{Digest: digest, Size: int64(len(body))}
+
+	var manifest internalManifest
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		// Indexes and unparseable manifests reference no blobs of their own
+		return record
+	}
+
+	add := func(digest string, declared int64) {
+		h, err := v1.NewHash(digest)
+		if err != nil {
+			return
+		}
+		size := declared
+		if actual, err := r.blobStore.Stat(ctx, repo, h); err == nil {
+			size = actual
+		}
+		record.Blobs = append(record.Blobs, blobRef{Digest: h.String(), Size: size})
+	}
+	add(manifest.Config.Digest, manifest.Config.Size)
+	for _, layer := range manifest.Layers {
+		add(layer.Digest, layer.Size)
+	}
+	return record
+}
+
+// usage returns the bytes a repository occupies, counting shared blobs once.
+// A record with the same digest as extra is replaced by it.
+func (idx *repoIndex) usage(extra *manifestRecord) int64 {
+	var total int64
+	seen := make(map[string]bool)
+	add := func(rec *manifestRecord) {
+		total += rec.Size
+		for _, b := range rec.Blobs {
+			if !seen[b.Digest] {
+				seen[b.Digest] = true
+				total += b.Size
+			}
+		}
+	}
+	for digest, rec := range idx.Manifests {
+		if extra == nil || digest != extra.Digest {
+			add(rec)
+		}
+	}
+	if extra != nil {
+		add(extra)
+	}
+	return total
+}
+
+// findTag returns the record a tag points at, if any
+func (idx *repoIndex) findTag(tag string) *manifestRecord {
+	for _, rec := range idx.Manifests {
+		if slices.Contains(rec.Tags, tag) {
+			return rec
+		}
+	}
+	return nil
+}
+
+// checkQuota returns errQuotaExceeded if adding record would take the
+// repository over its quota. Caller holds indexMu.
+func (r *Registry) checkQuota(repo string, record *manifestRecord) error {
+	if r.quota <= 0 {
+		return nil
+	}
+	idx, err := r.loadIndex(repo)
+	if err != nil {
+		return err
+	}
+	if usage := idx.usage(record); usage > r.quota {
+		return fmt.Errorf("%w: %s would use %d bytes, quota is %d", errQuotaExceeded, repo, usage, r.quota)
+	}
+	return nil
+}
+
+// recordManifest stores a pushed manifest, moving the tag to it if the push
+// was by tag. Caller holds indexMu.
+func (r *Registry) recordManifest(repo, reference string, record *manifestRecord) error {
+	idx, err := r.loadIndex(repo)
+	if err != nil {
+		return err
+	}
+
+	if existing, ok := idx.Manifests[record.Digest]; ok {
+		record.Tags = existing.Tags
+		record.ImageDigest = existing.ImageDigest
+	}
+	if !strings.HasPrefix(reference, "sha256:") {
+		for _, rec := range idx.Manifests {
+			rec.Tags = slices.DeleteFunc(rec.Tags, func(t string) bool { return t == reference })
+		}
+		record.Tags = append(record.Tags, reference)
+	}
+	idx.Manifests[record.Digest] = record
+	return r.saveIndex(repo, idx)
+}
+
+// setImageDigest records the digest a pushed manifest was imported as
+func (r *Registry) setImageDigest(repo, digest, imageDigest string) {
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+
+	idx, err := r.loadIndex(repo)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to load registry index for %s: %v\n", repo, err)
+		return
+	}
+	rec, ok := idx.Manifests[digest]
+	if !ok {
+		// Deleted while converting
+		return
+	}
+	rec.ImageDigest = imageDigest
+	if err := r.saveIndex(repo, idx); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to save registry index for %s: %v\n", repo, err)
+	}
+}
+
+// deleteManifest handles DELETE /v2/{name}/manifests/{reference}. Deleting a
+// tag removes only that tag; deleting a digest removes the manifest and every
+// tag pointing at it. Blobs are not removed here: once no pushed manifest
+// references them, image garbage collection frees them along with the image.
+func (r *Registry) deleteManifest(w http.ResponseWriter, req *http.Request, repo, reference string) {
+	ctx := req.Context()
+
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+
+	idx, err := r.loadIndex(repo)
+	if err != nil {
+		writeRegistryError(w, http.StatusInternalServerError, "UNKNOWN", err.Error())
+		return
+	}
+
+	var rec *manifestRecord
+	if strings.HasPrefix(reference, "sha256:") {
+		rec = idx.Manifests[reference]
+	} else {
+		rec = idx.findTag(reference)
+	}
+	if rec == nil {
+		writeRegistryError(w, http.StatusNotFound, "MANIFEST_UNKNOWN", "Unknown manifest")
+		return
+	}
+
+	removedTags := []string{reference}
+	if strings.HasPrefix(reference, "sha256:") {
+		removedTags = rec.Tags
+		delete(idx.Manifests, rec.Digest)
+		r.forgetManifest(req, repo, rec.Digest)
+	} else {
+		rec.Tags = slices.DeleteFunc(rec.Tags, func(t string) bool { return t == reference })
+	}
+	for _, tag := range removedTags {
+		r.forgetManifest(req, repo, tag)
+	}
+
+	if err := r.saveIndex(repo, idx); err != nil {
+		writeRegistryError(w, http.StatusInternalServerError, "UNKNOWN", err.Error())
+		return
+	}
+
+	// Pushed tags were imported as images under the full repository name
+	fullRepo := repo
+	if req.Host != "" {
+		fullRepo = req.Host + "/" + repo
+	}
+	for _, tag := range removedTags {
+		if err := r.imageManager.DeleteImage(ctx, fullRepo+":"+tag); err != nil && !errors.Is(err, images.ErrNotFound) {
+			logger.FromContext(ctx).WarnContext(ctx, "delete image for registry tag", "repo", fullRepo, "tag", tag, "error", err)
+		}
+	}
+
+	logger.FromContext(ctx).InfoContext(ctx, "deleted registry manifest", "repo", repo, "reference", reference, "digest", rec.Digest)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// forgetManifest removes a tag or digest from the underlying in-memory registry
+func (r *Registry) forgetManifest(req *http.Request, repo, reference string) {
+	del := req.Clone(req.Context())
+	del.Method = http.MethodDelete
+	del.URL.Path = "/v2/" + repo + "/manifests/" + reference
+	del.Body = http.NoBody
+	r.handler.ServeHTTP(&bufferedResponse{header: make(http.Header)}, del)
+}
+
+// deleteBlob handles DELETE /v2/{name}/blobs/{digest}. Blobs live in the OCI
+// cache shared with pulled images, so they are never removed directly: a blob
+// still referenced by a pushed manifest is refused with 409, and otherwise the
+// request is accepted and the blob is left to image garbage collection.
+func (r *Registry) deleteBlob(w http.ResponseWriter, req *http.Request, digest string) {
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+
+	refs, err := r.blobRefCounts()
+	if err != nil {
+		writeRegistryError(w, http.StatusInternalServerError, "UNKNOWN", err.Error())
+		return
+	}
+	if n := refs[digest]; n > 0 {
+		writeRegistryError(w, http.StatusConflict, "DENIED", fmt.Sprintf("blob is referenced by %d manifest(s)", n))
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// blobRefCounts counts how many pushed manifests across all repositories
+// reference each blob, including the manifests themselves. Caller holds indexMu.
+func (r *Registry) blobRefCounts() (map[string]int, error) {
+	indexes, err := r.allIndexes()
+	if err != nil {
+		return nil, err
+	}
+	refs := make(map[string]int)
+	for _, idx := range indexes {
+		for _, rec := range idx.Manifests {
+			refs[rec.Digest]++
+			for _, b := range rec.Blobs {
+				refs[b.Digest]++
+			}
+		}
+	}
+	return refs, nil
+}
+
+// ImageReferences implements images.ReferenceSource. Images imported from
+// pushed manifests are kept until the manifest is deleted from the registry.
+func (r *Registry) ImageReferences(ctx context.Context) ([]string, error) {
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+
+	indexes, err := r.allIndexes()
+	if err != nil {
+		return nil, err
+	}
+	var digests []string
+	for _, idx := range indexes {
+		for _, rec := range idx.Manifests {
+			if rec.ImageDigest != "" {
+				digests = append(digests, rec.ImageDigest)
+			}
+		}
+	}
+	return digests, nil
+}
+
+// BlobReferences implements images.BlobReferenceSource, keeping the blobs of
+// every pushed manifest so the registry can keep serving it.
+func (r *Registry) BlobReferences(ctx context.Context) ([]string, error) {
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+
+	refs, err := r.blobRefCounts()
+	if err != nil {
+		return nil, err
+	}
+	digests := make([]string, 0, len(refs))
+	for d := range refs {
+		digests = append(digests, d)
+	}
+	return digests, nil
+}
+
+// loadIndex reads a repository's manifest index, returning an empty index if
+// nothing has been pushed to it
+func (r *Registry) loadIndex(repo string) (*repoIndex, error) {
+	if strings.Contains(repo, "..") {
+		return nil, fmt.Errorf("invalid repository %q", repo)
+	}
+	idx := &repoIndex{Manifests: make(map[string]*manifestRecord)}
+	data, err := os.ReadFile(r.paths.RegistryRepoIndex(repo))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return idx, nil
+		}
+		return nil, fmt.Errorf("read repository index: %w", err)
+	}
+	if err := json.Unmarshal(data, idx); err != nil {
+		return nil, fmt.Errorf("parse repository index: %w", err)
+	}
+	if idx.Manifests == nil {
+		idx.Manifests = make(map[string]*manifestRecord)
+	}
+	return idx, nil
+}
+
+// saveIndex writes a repository's manifest index, removing it once empty
+func (r *Registry) saveIndex(repo string, idx *repoIndex) error {
+	path := r.paths.RegistryRepoIndex(repo)
+	if len(idx.Manifests) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove repository index: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal repository index: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create repository index dir: %w", err)
+	}
+	if err := writeFileAtomic(path, data); err != nil {
+		return fmt.Errorf("write repository index: %w", err)
+	}
+	return nil
+}
+
+// allIndexes loads the manifest index of every repository
+func (r *Registry) allIndexes() (map[string]*repoIndex, error) {
+	root := r.paths.RegistryRepositoriesDir()
+	indexes := make(map[string]*repoIndex)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || d.Name() != "index.json" {
+			return nil
+		}
+		repo, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		idx, err := r.loadIndex(repo)
+		if err != nil {
+			return err
+		}
+		indexes[repo] = idx
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk registry repositories: %w", err)
+	}
+	return indexes, nil
+}
+
+// writeRegistryError writes an error in the OCI distribution error format
+func writeRegistryError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]string{{"code": code, "message": message}},
+	})
+}
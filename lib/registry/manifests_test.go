@@ -0,0 +1,172 @@
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/onkernel/hypeman/lib/images"
+	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubImages records imports and deletes instead of converting images
+type stubImages struct {
+	images.Manager
+
+	mu      sync.Mutex
+	deleted []string
+}
+
+func (s *stubImages) ImportLocalImage(ctx context.Context, repo, reference, digest string) (*images.Image, error) {
+	return &images.Image{Name: repo + ":" + reference, Digest: digest}, nil
+}
+
+func (s *stubImages) DeleteImage(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleted = append(s.deleted, name)
+	return nil
+}
+
+func newTestRegistry(t *testing.T) (*Registry, *stubImages, string) {
+	stub := &stubImages{}
+	reg, err := New(paths.New(t.TempDir()), stub)
+	require.NoError(t, err)
+	srv := httptest.NewServer(reg.Handler())
+	t.Cleanup(srv.Close)
+	return reg, stub, strings.TrimPrefix(srv.URL, "http://")
+}
+
+func doRequest(t *testing.T, method, url string) *http.Response {
+	req, err := http.NewRequest(method, url, nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	return resp
+}
+
+func TestDeleteManifest(t *testing.T) {
+	reg, stub, host := newTestRegistry(t)
+	ctx := context.Background()
+
+	img, err := random.Image(1024, 2)
+	require.NoError(t, err)
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	layers, err := img.Layers()
+	require.NoError(t, err)
+	layerDigest, err := layers[0].Digest()
+	require.NoError(t, err)
+
+	tag, err := name.NewTag(host + "/builds/abc:latest")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(tag, img))
+
+	// Pushed blobs are held for image garbage collection
+	blobs, err := reg.BlobReferences(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, blobs, layerDigest.String())
+	assert.Contains(t, blobs, digest.String())
+
+	// Referenced blobs can't be deleted
+	resp := doRequest(t, http.MethodDelete, "http://"+host+"/v2/builds/abc/blobs/"+layerDigest.String())
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	// Deleting the tag leaves the manifest reachable by digest
+	resp = doRequest(t, http.MethodDelete, "http://"+host+"/v2/builds/abc/manifests/latest")
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Equal(t, []string{host + "/builds/abc:latest"}, stub.deleted)
+	resp = doRequest(t, http.MethodGet, "http://"+host+"/v2/builds/abc/manifests/latest")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp = doRequest(t, http.MethodGet, "http://"+host+"/v2/builds/abc/manifests/"+digest.String())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Deleting the digest releases its blobs
+	resp = doRequest(t, http.MethodDelete, "http://"+host+"/v2/builds/abc/manifests/"+digest.String())
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	resp = doRequest(t, http.MethodGet, "http://"+host+"/v2/builds/abc/manifests/"+digest.String())
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	blobs, err = reg.BlobReferences(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, blobs)
+	refs, err := reg.ImageReferences(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+
+	resp = doRequest(t, http.MethodDelete, "http://"+host+"/v2/builds/abc/blobs/"+layerDigest.String())
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	resp = doRequest(t, http.MethodDelete, "http://"+host+"/v2/builds/abc/manifests/"+digest.String())
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestSharedBlobsSurviveDelete(t *testing.T) {
+	reg, _, host := newTestRegistry(t)
+	ctx := context.Background()
+
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	layers, err := img.Layers()
+	require.NoError(t, err)
+	layerDigest, err := layers[0].Digest()
+	require.NoError(t, err)
+
+	for _, repo := range []string{"/builds/a:v1", "/builds/b:v1"} {
+		tag, err := name.NewTag(host + repo)
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(tag, img))
+	}
+
+	resp := doRequest(t, http.MethodDelete, "http://"+host+"/v2/builds/a/manifests/"+digest.String())
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	// Still referenced by the manifest in builds/b
+	blobs, err := reg.BlobReferences(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, blobs, layerDigest.String())
+	resp = doRequest(t, http.MethodDelete, "http://"+host+"/v2/builds/a/blobs/"+layerDigest.String())
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+}
+
+func TestRepositoryQuota(t *testing.T) {
+	reg, _, host := newTestRegistry(t)
+
+	small, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	large, err := random.Image(64*1024, 2)
+	require.NoError(t, err)
+
+	reg.SetRepositoryQuota(32 * 1024)
+
+	tag, err := name.NewTag(host + "/builds/abc:small")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(tag, small))
+
+	tag, err = name.NewTag(host + "/builds/abc:large")
+	require.NoError(t, err)
+	err = remote.Write(tag, large)
+	var terr *transport.Error
+	require.ErrorAs(t, err, &terr)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, terr.StatusCode)
+
+	resp := doRequest(t, http.MethodGet, "http://"+host+"/v2/builds/abc/manifests/large")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Quotas are per repository
+	tag, err = name.NewTag(host + "/builds/other:small")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(tag, small))
+}
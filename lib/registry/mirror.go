@@ -0,0 +1,351 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/onkernel/hypeman/lib/logger"
+	"github.com/onkernel/hypeman/lib/paths"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultUpstreamTagTTL is how long a tag resolved from the upstream registry
+// is served from cache before it is revalidated.
+const DefaultUpstreamTagTTL = 5 * time.Minute
+
+var (
+	// manifestGetPattern matches GET/HEAD requests to /v2/{name}/manifests/{reference}
+	manifestGetPattern = manifestPutPattern
+	// blobGetPattern matches GET requests to /v2/{name}/blobs/{digest}
+	blobGetPattern = regexp.MustCompile(`^/v2/(.+)/blobs/(sha256:[a-f0-9]{64})$`)
+)
+
+// UpstreamConfig configures pull-through caching from an upstream registry.
+type UpstreamConfig struct {
+	// Registry is the upstream registry host (e.g. "docker.io", "ghcr.io")
+	Registry string
+	// Username and Password authenticate to the upstream. If empty, credentials
+	// come from the Docker config keychain, like image pulls.
+	Username string
+	Password string
+	// TagTTL is how long a resolved tag is trusted before revalidating it
+	// upstream. Defaults to DefaultUpstreamTagTTL.
+	TagTTL time.Duration
+}
+
+// mirror fetches manifests and blobs missing locally from an upstream registry
+// and stores them in the OCI cache.
+type mirror struct {
+	registry  name.Registry
+	auth      remote.Option
+	tagTTL    time.Duration
+	paths     *paths.Paths
+	blobStore *BlobStore
+	fetches   singleflight.Group
+}
+
+// SetUpstream enables pull-through caching: manifests and blobs not found
+// locally are fetched from the upstream registry, stored, and served.
+// Pushes are unaffected.
+func (r *Registry) SetUpstream(cfg UpstreamConfig) error {
+	reg, err := name.NewRegistry(cfg.Registry)
+	if err != nil {
+		return fmt.Errorf("parse upstream registry %q: %w", cfg.Registry, err)
+	}
+
+	auth := remote.WithAuthFromKeychain(authn.DefaultKeychain)
+	if cfg.Username != "" {
+		auth = remote.WithAuth(&authn.Basic{Username: cfg.Username, Password: cfg.Password})
+	}
+
+	ttl := cfg.TagTTL
+	if ttl <= 0 {
+		ttl = DefaultUpstreamTagTTL
+	}
+
+	r.mirror = &mirror{
+		registry:  reg,
+		auth:      auth,
+		tagTTL:    ttl,
+		paths:     r.paths,
+		blobStore: r.blobStore,
+	}
+	return nil
+}
+
+// Upstream returns the host of the registry the pull-through cache mirrors,
+// or "" if pull-through caching is disabled
+func (r *Registry) Upstream() string {
+	if r.mirror == nil {
+		return ""
+	}
+	return r.mirror.registry.Name()
+}
+
+// Prefetch pulls an image from the upstream into the cache ahead of a client
+// asking for it: its manifest and, for the host's platform, its config and
+// layers. Returns true if nothing had to be downloaded.
+func (r *Registry) Prefetch(ctx context.Context, repo, reference string) (bool, error) {
+	if r.mirror == nil {
+		return false, fmt.Errorf("no upstream registry configured")
+	}
+	m := r.mirror
+	digest, err := m.resolve(ctx, repo, reference)
+	if err != nil {
+		return false, fmt.Errorf("resolve %s:%s: %w", repo, reference, err)
+	}
+	data, err := os.ReadFile(m.paths.OCICacheBlob(strings.TrimPrefix(digest, "sha256:")))
+	if err != nil {
+		return false, fmt.Errorf("read manifest: %w", err)
+	}
+
+	if manifestMediaType(data).IsIndex() {
+		index, err := v1.ParseIndexManifest(bytes.NewReader(data))
+		if err != nil {
+			return false, fmt.Errorf("parse index: %w", err)
+		}
+		digest = ""
+		for _, desc := range index.Manifests {
+			if desc.Platform != nil && desc.Platform.OS == "linux" && desc.Platform.Architecture == runtime.GOARCH {
+				digest = desc.Digest.String()
+				break
+			}
+		}
+		if digest == "" {
+			return false, fmt.Errorf("%s:%s has no linux/%s image", repo, reference, runtime.GOARCH)
+		}
+		if _, err := m.resolve(ctx, repo, digest); err != nil {
+			return false, fmt.Errorf("resolve platform manifest: %w", err)
+		}
+		if data, err = os.ReadFile(m.paths.OCICacheBlob(strings.TrimPrefix(digest, "sha256:"))); err != nil {
+			return false, fmt.Errorf("read manifest: %w", err)
+		}
+	}
+
+	manifest, err := v1.ParseManifest(bytes.NewReader(data))
+	if err != nil {
+		return false, fmt.Errorf("parse manifest: %w", err)
+	}
+	cached := true
+	for _, desc := range append([]v1.Descriptor{manifest.Config}, manifest.Layers...) {
+		if m.hasBlob(desc.Digest.String()) {
+			continue
+		}
+		cached = false
+		if err := m.ensureBlob(ctx, repo, desc.Digest.String()); err != nil {
+			return false, fmt.Errorf("fetch blob %s: %w", desc.Digest, err)
+		}
+	}
+	return cached, nil
+}
+
+// serveManifest serves a manifest from the mirror cache, fetching it from
+// upstream if needed. Returns false if the upstream doesn't have it either.
+func (m *mirror) serveManifest(w http.ResponseWriter, req *http.Request, repo, reference string) bool {
+	ctx := req.Context()
+	log := logger.FromContext(ctx)
+
+	digest, err := m.resolve(ctx, repo, reference)
+	if err != nil {
+		if !isUpstreamNotFound(err) {
+			log.WarnContext(ctx, "upstream registry manifest fetch failed", "repo", repo, "reference", reference, "error", err)
+		}
+		return false
+	}
+
+	data, err := os.ReadFile(m.paths.OCICacheBlob(strings.TrimPrefix(digest, "sha256:")))
+	if err != nil {
+		log.WarnContext(ctx, "read mirrored manifest", "digest", digest, "error", err)
+		return false
+	}
+
+	w.Header().Set("Content-Type", string(manifestMediaType(data)))
+	w.Header().Set("Docker-Content-Digest", digest)
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	if req.Method == http.MethodGet {
+		w.Write(data)
+	}
+	return true
+}
+
+// resolve returns the digest of a manifest stored locally, fetching it from
+// upstream on a miss. Tags are revalidated upstream once older than tagTTL;
+// if the upstream is unreachable, a stale tag is served rather than failing.
+func (m *mirror) resolve(ctx context.Context, repo, reference string) (string, error) {
+	upstreamRepo, err := name.NewRepository(m.registry.Name() + "/" + repo)
+	if err != nil {
+		return "", err
+	}
+	if strings.Contains(repo, "..") {
+		return "", fmt.Errorf("invalid repository %q", repo)
+	}
+
+	if strings.HasPrefix(reference, "sha256:") {
+		ref, err := name.NewDigest(upstreamRepo.Name() + "@" + reference)
+		if err != nil {
+			return "", err
+		}
+		if m.hasBlob(reference) {
+			return reference, nil
+		}
+		return reference, m.fetchManifest(ctx, ref, reference)
+	}
+
+	ref, err := name.NewTag(upstreamRepo.Name() + ":" + reference)
+	if err != nil {
+		return "", err
+	}
+
+	tagPath := m.tagPath(repo, reference)
+	cached, checked, cacheErr := readMirrorTag(tagPath)
+	if cacheErr == nil && time.Since(checked) < m.tagTTL && m.hasBlob(cached) {
+		return cached, nil
+	}
+
+	desc, err := remote.Head(ref, remote.WithContext(ctx), m.auth)
+	if err != nil {
+		if cacheErr == nil && m.hasBlob(cached) && !isUpstreamNotFound(err) {
+			logger.FromContext(ctx).WarnContext(ctx, "upstream registry unreachable, serving stale tag", "repo", repo, "tag", reference, "error", err)
+			return cached, nil
+		}
+		return "", err
+	}
+
+	digest := desc.Digest.String()
+	if !m.hasBlob(digest) {
+		if err := m.fetchManifest(ctx, ref, digest); err != nil {
+			return "", err
+		}
+	}
+	if err := writeMirrorTag(tagPath, digest); err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "cache mirrored tag", "repo", repo, "tag", reference, "error", err)
+	}
+	return digest, nil
+}
+
+// fetchManifest downloads a manifest from upstream and stores it by digest
+func (m *mirror) fetchManifest(ctx context.Context, ref name.Reference, digest string) error {
+	_, err, _ := m.fetches.Do(digest, func() (any, error) {
+		desc, err := remote.Get(ref, remote.WithContext(ctx), m.auth)
+		if err != nil {
+			return nil, err
+		}
+		if actual := computeDigest(desc.Manifest); actual != digest {
+			return nil, fmt.Errorf("upstream manifest digest mismatch: expected %s, got %s", digest, actual)
+		}
+		return nil, writeFileAtomic(m.paths.OCICacheBlob(strings.TrimPrefix(digest, "sha256:")), desc.Manifest)
+	})
+	return err
+}
+
+// ensureBlob downloads a blob from upstream into the blob store if it isn't
+// already present, so the registry handler can serve it.
+func (m *mirror) ensureBlob(ctx context.Context, repo, digest string) error {
+	if m.hasBlob(digest) {
+		return nil
+	}
+	_, err, _ := m.fetches.Do(digest, func() (any, error) {
+		ref, err := name.NewDigest(m.registry.Name() + "/" + repo + "@" + digest)
+		if err != nil {
+			return nil, err
+		}
+		layer, err := remote.Layer(ref, remote.WithContext(ctx), m.auth)
+		if err != nil {
+			return nil, err
+		}
+		rc, err := layer.Compressed()
+		if err != nil {
+			return nil, err
+		}
+		h, err := v1.NewHash(digest)
+		if err != nil {
+			return nil, err
+		}
+		// Put verifies the digest before the blob becomes visible
+		return nil, m.blobStore.Put(ctx, repo, h, rc)
+	})
+	return err
+}
+
+func (m *mirror) hasBlob(digest string) bool {
+	_, err := os.Stat(m.paths.OCICacheBlob(strings.TrimPrefix(digest, "sha256:")))
+	return err == nil
+}
+
+// tagPath returns where a mirrored tag's digest is cached
+func (m *mirror) tagPath(repo, tag string) string {
+	return filepath.Join(m.paths.SystemOCICache(), "mirror-tags", m.registry.Name(), repo, tag)
+}
+
+// readMirrorTag returns a cached tag's digest and when it was last validated
+// (the file's modification time)
+func readMirrorTag(path string) (string, time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return strings.TrimSpace(string(data)), info.ModTime(), nil
+}
+
+func writeMirrorTag(path, digest string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return writeFileAtomic(path, []byte(digest))
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// manifestMediaType reads the media type from a manifest body, falling back
+// to the OCI type implied by its shape when mediaType is omitted
+func manifestMediaType(data []byte) types.MediaType {
+	var probe struct {
+		MediaType string          `json:"mediaType"`
+		Manifests json.RawMessage `json:"manifests"`
+	}
+	if err := json.Unmarshal(data, &probe); err == nil && probe.MediaType != "" {
+		return types.MediaType(probe.MediaType)
+	}
+	if len(probe.Manifests) > 0 {
+		return types.OCIImageIndex
+	}
+	return types.OCIManifestSchema1
+}
+
+// isUpstreamNotFound reports whether an upstream error means the manifest or
+// blob doesn't exist, as opposed to the upstream being unreachable
+func isUpstreamNotFound(err error) bool {
+	var terr *transport.Error
+	if errors.As(err, &terr) {
+		return terr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package registry
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/onkernel/hypeman/lib/paths"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullThroughMirror(t *testing.T) {
+	upstream := httptest.NewServer(ggcrregistry.New())
+	defer upstream.Close()
+	upstreamHost := strings.TrimPrefix(upstream.URL, "http://")
+
+	img, err := random.Image(1024, 2)
+	require.NoError(t, err)
+	tag, err := name.NewTag(upstreamHost + "/library/app:v1")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(tag, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	reg, err := New(paths.New(t.TempDir()), nil)
+	require.NoError(t, err)
+	require.NoError(t, reg.SetUpstream(UpstreamConfig{Registry: upstreamHost, TagTTL: time.Nanosecond}))
+	srv := httptest.NewServer(reg.Handler())
+	defer srv.Close()
+
+	get := func(path string) *http.Response {
+		resp, err := http.Get(srv.URL + path)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	// Tag is fetched from upstream
+	resp := get("/v2/library/app/manifests/v1")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, digest.String(), resp.Header.Get("Docker-Content-Digest"))
+
+	// Layers are fetched and verified on first pull
+	layers, err := img.Layers()
+	require.NoError(t, err)
+	layerDigest, err := layers[0].Digest()
+	require.NoError(t, err)
+	resp = get("/v2/library/app/blobs/" + layerDigest.String())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	size, err := layers[0].Size()
+	require.NoError(t, err)
+	assert.Equal(t, size, int64(len(body)))
+
+	// Expired tag falls back to the cached digest while the upstream is down
+	upstream.Close()
+	resp = get("/v2/library/app/manifests/v1")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = get("/v2/library/app/manifests/" + digest.String())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = get("/v2/library/app/blobs/" + layerDigest.String())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = get("/v2/library/missing/manifests/v1")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestPrefetch(t *testing.T) {
+	upstream := httptest.NewServer(ggcrregistry.New())
+	defer upstream.Close()
+	upstreamHost := strings.TrimPrefix(upstream.URL, "http://")
+
+	img, err := random.Image(1024, 2)
+	require.NoError(t, err)
+	tag, err := name.NewTag(upstreamHost + "/library/base:v1")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(tag, img))
+
+	p := paths.New(t.TempDir())
+	reg, err := New(p, nil)
+	require.NoError(t, err)
+	_, err = reg.Prefetch(context.Background(), "library/base", "v1")
+	assert.Error(t, err, "no upstream configured")
+
+	require.NoError(t, reg.SetUpstream(UpstreamConfig{Registry: upstreamHost}))
+	assert.Equal(t, upstreamHost, reg.Upstream())
+
+	cached, err := reg.Prefetch(context.Background(), "library/base", "v1")
+	require.NoError(t, err)
+	assert.False(t, cached)
+
+	// Every layer is in the cache before any client pulls it
+	layers, err := img.Layers()
+	require.NoError(t, err)
+	for _, layer := range layers {
+		digest, err := layer.Digest()
+		require.NoError(t, err)
+		assert.FileExists(t, p.OCICacheBlob(digest.Hex))
+	}
+
+	cached, err = reg.Prefetch(context.Background(), "library/base", "v1")
+	require.NoError(t, err)
+	assert.True(t, cached)
+}
@@ -8,12 +8,14 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/google/go-containerregistry/pkg/registry"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
@@ -21,6 +23,7 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/layout"
 	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/onkernel/hypeman/lib/images"
+	"github.com/onkernel/hypeman/lib/logger"
 	"github.com/onkernel/hypeman/lib/paths"
 )
 
@@ -31,6 +34,10 @@ type Registry struct {
 	imageManager images.Manager
 	blobStore    *BlobStore
 	handler      http.Handler
+	mirror       *mirror // Pull-through cache, nil unless SetUpstream was called
+
+	indexMu sync.Mutex // Guards repository manifest indexes
+	quota   int64      // Max bytes per repository, 0 = unlimited
 }
 
 // manifestPutPattern matches PUT requests to /v2/{name}/manifests/{reference}
@@ -65,53 +72,159 @@ func (r *Registry) Handler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		// Intercept manifest PUT requests to store in blob store and trigger conversion
 		if req.Method == http.MethodPut {
-			matches := manifestPutPattern.FindStringSubmatch(req.URL.Path)
-			if matches != nil {
-				pathRepo := matches[1]
-				reference := matches[2]
-
-				// Include the host to form the full repository path
-				// This preserves the registry host (e.g., "10.102.0.1:8083/builds/xxx")
-				// instead of normalizing to docker.io
-				fullRepo := pathRepo
-				if req.Host != "" {
-					fullRepo = req.Host + "/" + pathRepo
-				}
-
-				body, err := io.ReadAll(req.Body)
-				req.Body.Close()
-				if err != nil {
-					http.Error(w, "failed to read body", http.StatusInternalServerError)
-					return
-				}
-
-				digest := computeDigest(body)
-
-				// Verify digest if reference is a digest
-				if strings.HasPrefix(reference, "sha256:") && reference != digest {
-					http.Error(w, fmt.Sprintf("digest mismatch: expected %s, got %s", reference, digest), http.StatusBadRequest)
-					return
-				}
-
-				if err := r.storeManifestBlob(digest, body); err != nil {
-					fmt.Fprintf(os.Stderr, "Warning: failed to store manifest blob: %v\n", err)
-				}
-
-				req.Body = io.NopCloser(bytes.NewReader(body))
-				wrapper := &responseWrapper{ResponseWriter: w}
-				r.handler.ServeHTTP(wrapper, req)
-
-				if wrapper.statusCode == http.StatusCreated {
-					go r.triggerConversion(fullRepo, reference, digest)
-				}
+			if matches := manifestPutPattern.FindStringSubmatch(req.URL.Path); matches != nil {
+				r.putManifest(w, req, matches[1], matches[2])
+				return
+			}
+		}
+
+		// Deletes are tracked in the repository indexes, which the underlying
+		// registry doesn't know about
+		if req.Method == http.MethodDelete {
+			if matches := manifestPutPattern.FindStringSubmatch(req.URL.Path); matches != nil {
+				r.deleteManifest(w, req, matches[1], matches[2])
+				return
+			}
+			if matches := blobGetPattern.FindStringSubmatch(req.URL.Path); matches != nil {
+				r.deleteBlob(w, req, matches[2])
 				return
 			}
 		}
 
+		if r.mirror != nil && r.serveFromMirror(w, req) {
+			return
+		}
+
 		r.handler.ServeHTTP(w, req)
 	})
 }
 
+// putManifest stores a pushed manifest, enforces the repository quota, and
+// queues the image for conversion.
+func (r *Registry) putManifest(w http.ResponseWriter, req *http.Request, pathRepo, reference string) {
+	// Include the host to form the full repository path
+	// This preserves the registry host (e.g., "10.102.0.1:8083/builds/xxx")
+	// instead of normalizing to docker.io
+	fullRepo := pathRepo
+	if req.Host != "" {
+		fullRepo = req.Host + "/" + pathRepo
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusInternalServerError)
+		return
+	}
+
+	digest := computeDigest(body)
+
+	// Verify digest if reference is a digest
+	if strings.HasPrefix(reference, "sha256:") && reference != digest {
+		http.Error(w, fmt.Sprintf("digest mismatch: expected %s, got %s", reference, digest), http.StatusBadRequest)
+		return
+	}
+
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+
+	record := r.newManifestRecord(req.Context(), pathRepo, digest, body)
+	if err := r.checkQuota(pathRepo, record); err != nil {
+		if errors.Is(err, errQuotaExceeded) {
+			writeRegistryError(w, http.StatusRequestEntityTooLarge, "DENIED", err.Error())
+			return
+		}
+		writeRegistryError(w, http.StatusInternalServerError, "UNKNOWN", err.Error())
+		return
+	}
+
+	if err := r.storeManifestBlob(digest, body); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to store manifest blob: %v\n", err)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	wrapper := &responseWrapper{ResponseWriter: w}
+	r.handler.ServeHTTP(wrapper, req)
+
+	if wrapper.statusCode == http.StatusCreated {
+		if err := r.recordManifest(pathRepo, reference, record); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to record manifest for %s: %v\n", pathRepo, err)
+		}
+		go func() {
+			if imageDigest := r.triggerConversion(fullRepo, reference, digest); imageDigest != "" {
+				r.setImageDigest(pathRepo, digest, imageDigest)
+			}
+		}()
+	}
+}
+
+// serveFromMirror handles pulls when pull-through caching is enabled. Blobs
+// missing locally are fetched into the blob store before the registry serves
+// them. Manifests are served locally if pushed here, otherwise from the
+// mirror. Returns false if the request should go to the registry handler.
+func (r *Registry) serveFromMirror(w http.ResponseWriter, req *http.Request) bool {
+	ctx := req.Context()
+
+	switch req.Method {
+	case http.MethodGet:
+		if matches := blobGetPattern.FindStringSubmatch(req.URL.Path); matches != nil {
+			if err := r.mirror.ensureBlob(ctx, matches[1], matches[2]); err != nil && !isUpstreamNotFound(err) {
+				logger.FromContext(ctx).WarnContext(ctx, "upstream registry blob fetch failed", "repo", matches[1], "digest", matches[2], "error", err)
+			}
+			return false
+		}
+		fallthrough
+	case http.MethodHead:
+		matches := manifestGetPattern.FindStringSubmatch(req.URL.Path)
+		if matches == nil {
+			return false
+		}
+
+		// Pushed manifests take precedence over the upstream
+		local := &bufferedResponse{header: make(http.Header)}
+		r.handler.ServeHTTP(local, req)
+		if local.statusCode != http.StatusNotFound {
+			local.writeTo(w)
+			return true
+		}
+		if r.mirror.serveManifest(w, req, matches[1], matches[2]) {
+			return true
+		}
+		local.writeTo(w)
+		return true
+	}
+	return false
+}
+
+// bufferedResponse captures a response so it can be inspected before sending
+type bufferedResponse struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header { return b.header }
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	if b.statusCode == 0 {
+		b.statusCode = http.StatusOK
+	}
+	return b.body.Write(p)
+}
+
+func (b *bufferedResponse) WriteHeader(code int) { b.statusCode = code }
+
+func (b *bufferedResponse) writeTo(w http.ResponseWriter) {
+	for k, v := range b.header {
+		w.Header()[k] = v
+	}
+	if b.statusCode == 0 {
+		b.statusCode = http.StatusOK
+	}
+	w.WriteHeader(b.statusCode)
+	w.Write(b.body.Bytes())
+}
+
 // storeManifestBlob stores a manifest in the blob store by its digest.
 func (r *Registry) storeManifestBlob(digest string, data []byte) error {
 	digestHex := strings.TrimPrefix(digest, "sha256:")
@@ -138,7 +251,8 @@ func (w *responseWrapper) WriteHeader(code int) {
 }
 
 // triggerConversion queues the image for conversion to ext4 disk format.
-func (r *Registry) triggerConversion(repo, reference, dockerDigest string) {
+// Returns the digest the image was imported as, or "" on failure.
+func (r *Registry) triggerConversion(repo, reference, dockerDigest string) string {
 	imageRef := repo + ":" + reference
 	if strings.HasPrefix(reference, "sha256:") {
 		imageRef = repo + "@" + reference
@@ -147,13 +261,15 @@ func (r *Registry) triggerConversion(repo, reference, dockerDigest string) {
 	ociDigest, err := r.addToOCILayout(dockerDigest)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: failed to add image to OCI layout for %s: %v\n", imageRef, err)
-		return
+		return ""
 	}
 
 	_, err = r.imageManager.ImportLocalImage(context.Background(), repo, reference, ociDigest)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: failed to queue image conversion for %s: %v\n", imageRef, err)
+		return ""
 	}
+	return ociDigest
 }
 
 // addToOCILayout adds the image to the OCI layout, converting Docker v2 to OCI if needed.
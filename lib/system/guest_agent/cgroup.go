@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+)
+
+// cgroupRoot is where init mounts the cgroup v2 hierarchy
+var cgroupRoot = "/sys/fs/cgroup"
+
+// cpuPeriodUsec is the cpu.max period that CPU quotas are expressed against
+const cpuPeriodUsec = 100000
+
+// execCgroup is a transient cgroup holding one exec'd command and its
+// children, so a debugging command can't starve the guest's workload
+type execCgroup struct {
+	path string
+	dir  *os.File
+}
+
+// newExecCgroup creates a cgroup with the exec request's CPU and memory
+// limits. It returns nil when the request sets no limits.
+func newExecCgroup(start *pb.ExecStart) (*execCgroup, error) {
+	if start.CpuQuota < 0 || start.MemoryLimitBytes < 0 {
+		return nil, fmt.Errorf("cpu quota and memory limit must not be negative")
+	}
+	if start.CpuQuota == 0 && start.MemoryLimitBytes == 0 {
+		return nil, nil
+	}
+
+	var controllers []string
+	if start.CpuQuota > 0 {
+		controllers = append(controllers, "+cpu")
+	}
+	if start.MemoryLimitBytes > 0 {
+		controllers = append(controllers, "+memory")
+	}
+	if err := os.WriteFile(filepath.Join(cgroupRoot, "cgroup.subtree_control"), []byte(strings.Join(controllers, " ")), 0644); err != nil {
+		return nil, fmt.Errorf("enable cgroup controllers: %w", err)
+	}
+
+	path, err := os.MkdirTemp(cgroupRoot, "hypeman-exec-")
+	if err != nil {
+		return nil, fmt.Errorf("create cgroup: %w", err)
+	}
+	cg := &execCgroup{path: path}
+
+	if start.CpuQuota > 0 {
+		quota := max(int64(start.CpuQuota*cpuPeriodUsec), 1000)
+		if err := cg.write("cpu.max", fmt.Sprintf("%d %d", quota, cpuPeriodUsec)); err != nil {
+			cg.remove()
+			return nil, err
+		}
+	}
+	if start.MemoryLimitBytes > 0 {
+		if err := cg.write("memory.max", strconv.FormatInt(start.MemoryLimitBytes, 10)); err != nil {
+			cg.remove()
+			return nil, err
+		}
+		// Don't let the command dodge the limit by swapping (absent without swap support)
+		if err := cg.write("memory.swap.max", "0"); err != nil && !os.IsNotExist(err) {
+			cg.remove()
+			return nil, err
+		}
+	}
+
+	if cg.dir, err = os.Open(path); err != nil {
+		cg.remove()
+		return nil, fmt.Errorf("open cgroup: %w", err)
+	}
+	return cg, nil
+}
+
+func (cg *execCgroup) write(file, value string) error {
+	if err := os.WriteFile(filepath.Join(cg.path, file), []byte(value), 0644); err != nil {
+		return fmt.Errorf("write %s: %w", file, err)
+	}
+	return nil
+}
+
+// apply makes cmd start inside the cgroup, so the limits hold from its
+// first instruction
+func (cg *execCgroup) apply(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = int(cg.dir.Fd())
+}
+
+// oomKilled reports whether the kernel OOM-killed a process in the cgroup
+func (cg *execCgroup) oomKilled() bool {
+	f, err := os.Open(filepath.Join(cg.path, "memory.events"))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if count, ok := strings.CutPrefix(scanner.Text(), "oom_kill "); ok {
+			n, _ := strconv.Atoi(count)
+			return n > 0
+		}
+	}
+	return false
+}
+
+// remove kills anything the command left running and deletes the cgroup
+func (cg *execCgroup) remove() {
+	if cg.dir != nil {
+		cg.dir.Close()
+	}
+	os.WriteFile(filepath.Join(cg.path, "cgroup.kill"), []byte("1"), 0644)
+
+	// Killed processes leave the cgroup asynchronously
+	for i := 0; i < 50; i++ {
+		if err := os.Remove(cg.path); err == nil || os.IsNotExist(err) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	log.Printf("[guest-agent] warning: failed to remove cgroup %s", cg.path)
+}
+
+// exitCode returns the exit code to report for a finished command, using
+// pb.ExitCodeOOMKilled when the cgroup's memory limit killed it
+func (cg *execCgroup) exitCode(code int32) int32 {
+	if cg != nil && code != 0 && cg.oomKilled() {
+		return pb.ExitCodeOOMKilled
+	}
+	return code
+}
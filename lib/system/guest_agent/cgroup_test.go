@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExecCgroup(t *testing.T) {
+	// A plain directory stands in for the cgroup filesystem
+	cgroupRoot = t.TempDir()
+	t.Cleanup(func() { cgroupRoot = "/sys/fs/cgroup" })
+
+	cg, err := newExecCgroup(&pb.ExecStart{})
+	require.NoError(t, err)
+	assert.Nil(t, cg, "no limits means no cgroup")
+
+	_, err = newExecCgroup(&pb.ExecStart{MemoryLimitBytes: -1})
+	assert.Error(t, err)
+
+	cg, err = newExecCgroup(&pb.ExecStart{CpuQuota: 0.5, MemoryLimitBytes: 64 << 20})
+	require.NoError(t, err)
+	defer cg.dir.Close()
+
+	read := func(path string) string {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		return string(data)
+	}
+	assert.Equal(t, "+cpu +memory", read(filepath.Join(cgroupRoot, "cgroup.subtree_control")))
+	assert.Equal(t, "50000 100000", read(filepath.Join(cg.path, "cpu.max")))
+	assert.Equal(t, "67108864", read(filepath.Join(cg.path, "memory.max")))
+	assert.Equal(t, "0", read(filepath.Join(cg.path, "memory.swap.max")))
+
+	// OOM kills show up in memory.events
+	assert.Equal(t, int32(1), cg.exitCode(1))
+	require.NoError(t, os.WriteFile(filepath.Join(cg.path, "memory.events"), []byte("low 0\nhigh 0\nmax 3\noom 1\noom_kill 1\n"), 0644))
+	assert.Equal(t, int32(pb.ExitCodeOOMKilled), cg.exitCode(-1))
+	assert.Equal(t, int32(0), cg.exitCode(0))
+
+	var none *execCgroup
+	assert.Equal(t, int32(-1), none.exitCode(-1))
+}
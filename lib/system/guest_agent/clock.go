@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"log"
+	"syscall"
+	"time"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SyncTime steps the guest clock to the host's. A VM restored from a
+// snapshot resumes with the clock it had when paused, and long-running
+// guests drift; either breaks TLS and token expiry checks.
+func (s *guestServer) SyncTime(ctx context.Context, req *pb.SyncTimeRequest) (*pb.SyncTimeResponse, error) {
+	if req.UnixNanos <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "unix_nanos is required")
+	}
+
+	offset := time.Duration(req.UnixNanos - time.Now().UnixNano())
+	tv := syscall.NsecToTimeval(req.UnixNanos)
+	if err := syscall.Settimeofday(&tv); err != nil {
+		return nil, status.Errorf(codes.Internal, "set clock: %v", err)
+	}
+	if offset > time.Second || offset < -time.Second {
+		log.Printf("[guest-agent] sync-time: stepped clock by %s", offset)
+	}
+	return &pb.SyncTimeResponse{OffsetNanos: int64(offset)}, nil
+}
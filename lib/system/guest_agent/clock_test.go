@@ -0,0 +1,17 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSyncTime_RequiresHostTime(t *testing.T) {
+	// Setting the clock for real would change the test host's time
+	_, err := (&guestServer{}).SyncTime(context.Background(), &pb.SyncTimeRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -34,11 +34,28 @@ func (s *guestServer) Exec(stream pb.GuestService_ExecServer) error {
 		command = []string{"/bin/sh"}
 	}
 
-	log.Printf("[guest-agent] exec: command=%v tty=%v cwd=%s timeout=%d",
-		command, start.Tty, start.Cwd, start.TimeoutSeconds)
+	log.Printf("[guest-agent] exec: command=%v tty=%v cwd=%s timeout=%d separate_streams=%v cpu_quota=%g memory_limit=%d drop_capabilities=%v seccomp_profile=%q",
+		command, start.Tty, start.Cwd, start.TimeoutSeconds, start.SeparateStreams, start.CpuQuota, start.MemoryLimitBytes,
+		start.DropCapabilities, start.SeccompProfile)
 
-	// Create context with timeout if specified
-	ctx := context.Background()
+	sb, err := newExecSandbox(start)
+	if err != nil {
+		return fmt.Errorf("set up exec sandbox: %w", err)
+	}
+
+	// Resource limits are applied through a transient cgroup
+	cg, err := newExecCgroup(start)
+	if err != nil {
+		return fmt.Errorf("set up exec limits: %w", err)
+	}
+	if cg != nil {
+		defer cg.remove()
+	}
+
+	// The command is killed if the host ends the exec before it exits, e.g.
+	// when an idle session is closed or its client went away. Create context
+	// with timeout if specified.
+	ctx := stream.Context()
 	if start.TimeoutSeconds > 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, time.Duration(start.TimeoutSeconds)*time.Second)
@@ -46,13 +63,15 @@ func (s *guestServer) Exec(stream pb.GuestService_ExecServer) error {
 	}
 
 	if start.Tty {
-		return s.executeTTY(ctx, stream, start)
+		return s.executeTTY(ctx, stream, start, cg, sb)
 	}
-	return s.executeNoTTY(ctx, stream, start)
+	return s.executeNoTTY(ctx, stream, start, cg, sb)
 }
 
-// executeNoTTY executes command without TTY
-func (s *guestServer) executeNoTTY(ctx context.Context, stream pb.GuestService_ExecServer, start *pb.ExecStart) error {
+// executeNoTTY executes command without TTY. Output is sent as it is
+// produced: with SeparateStreams as typed stdout and stderr messages,
+// otherwise through one pipe shared by both, which keeps their exact order.
+func (s *guestServer) executeNoTTY(ctx context.Context, stream pb.GuestService_ExecServer, start *pb.ExecStart, cg *execCgroup, sb *execSandbox) error {
 	// Run command directly - guest-agent is already running in container namespace
 	if len(start.Command) == 0 {
 		return fmt.Errorf("empty command")
@@ -67,21 +86,68 @@ func (s *guestServer) executeNoTTY(ctx context.Context, stream pb.GuestService_E
 	if start.Cwd != "" {
 		cmd.Dir = start.Cwd
 	}
+	if cg != nil {
+		cg.apply(cmd)
+	}
+	if sb != nil {
+		if err := sb.apply(cmd); err != nil {
+			return fmt.Errorf("sandbox command: %w", err)
+		}
+	}
 
 	stdin, _ := cmd.StdinPipe()
-	stdout, _ := cmd.StdoutPipe()
-	stderr, _ := cmd.StderrPipe()
-
-	if err := cmd.Start(); err != nil {
-		return fmt.Errorf("start command: %w", err)
-	}
 
 	// Mutex to protect concurrent stream.Send calls (gRPC streams are not thread-safe)
 	var sendMu sync.Mutex
+	send := func(resp *pb.ExecResponse) {
+		sendMu.Lock()
+		stream.Send(resp)
+		sendMu.Unlock()
+	}
+	sendStdout := func(data []byte) {
+		send(&pb.ExecResponse{Response: &pb.ExecResponse_Stdout{Stdout: data}})
+	}
+	sendStderr := func(data []byte) {
+		send(&pb.ExecResponse{Response: &pb.ExecResponse_Stderr{Stderr: data}})
+	}
 
-	// Use WaitGroup to ensure all output is read before sending
+	// Use WaitGroup to ensure all output is sent before the exit code
 	var wg sync.WaitGroup
-	var stdoutData, stderrData []byte
+	if start.SeparateStreams {
+		stdout, _ := cmd.StdoutPipe()
+		stderr, _ := cmd.StderrPipe()
+		if err := cmd.Start(); err != nil {
+			return fmt.Errorf("start command: %w", err)
+		}
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			streamOutput(stdout, sendStdout)
+		}()
+		go func() {
+			defer wg.Done()
+			streamOutput(stderr, sendStderr)
+		}()
+	} else {
+		output, outputWriter, err := os.Pipe()
+		if err != nil {
+			return fmt.Errorf("create output pipe: %w", err)
+		}
+		defer output.Close()
+		cmd.Stdout = outputWriter
+		cmd.Stderr = outputWriter
+		err = cmd.Start()
+		// The command has its own copy; ours must be closed for reads to see EOF
+		outputWriter.Close()
+		if err != nil {
+			return fmt.Errorf("start command: %w", err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			streamOutput(output, sendStdout)
+		}()
+	}
 
 	// Handle stdin in background
 	go func() {
@@ -97,58 +163,18 @@ func (s *guestServer) executeNoTTY(ctx context.Context, stream pb.GuestService_E
 		}
 	}()
 
-	// Read all stdout/stderr BEFORE calling Wait() - Wait() closes the pipes!
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		data, _ := io.ReadAll(stdout)
-		stdoutData = data
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		data, _ := io.ReadAll(stderr)
-		stderrData = data
-	}()
-
-	// Wait for all reads to complete FIRST (before Wait closes pipes)
+	// Drain all output BEFORE calling Wait() - Wait() closes the pipes!
 	wg.Wait()
 
 	// Now safe to call Wait - pipes are fully drained
 	waitErr := cmd.Wait()
 
-	// Now stream output in chunks (streaming compatible)
-	const chunkSize = 32 * 1024
-	for i := 0; i < len(stdoutData); i += chunkSize {
-		end := i + chunkSize
-		if end > len(stdoutData) {
-			end = len(stdoutData)
-		}
-		sendMu.Lock()
-		stream.Send(&pb.ExecResponse{
-			Response: &pb.ExecResponse_Stdout{Stdout: stdoutData[i:end]},
-		})
-		sendMu.Unlock()
-	}
-	for i := 0; i < len(stderrData); i += chunkSize {
-		end := i + chunkSize
-		if end > len(stderrData) {
-			end = len(stderrData)
-		}
-		sendMu.Lock()
-		stream.Send(&pb.ExecResponse{
-			Response: &pb.ExecResponse_Stderr{Stderr: stderrData[i:end]},
-		})
-		sendMu.Unlock()
-	}
-
 	exitCode := int32(0)
-	if cmd.ProcessState != nil {
-		exitCode = int32(cmd.ProcessState.ExitCode())
-	} else if waitErr != nil {
+	if ctx.Err() == context.DeadlineExceeded || (cmd.ProcessState == nil && waitErr != nil) {
 		// If killed by timeout, exit with 124 (GNU timeout convention)
 		exitCode = 124
+	} else if cmd.ProcessState != nil {
+		exitCode = cg.exitCode(int32(cmd.ProcessState.ExitCode()))
 	}
 
 	log.Printf("[guest-agent] command finished with exit code: %d", exitCode)
@@ -159,8 +185,22 @@ func (s *guestServer) executeNoTTY(ctx context.Context, stream pb.GuestService_E
 	})
 }
 
+// streamOutput sends r's data in chunks as it is read, until EOF or an error
+func streamOutput(r io.Reader, send func([]byte)) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			send(buf[:n])
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
 // executeTTY executes command with TTY
-func (s *guestServer) executeTTY(ctx context.Context, stream pb.GuestService_ExecServer, start *pb.ExecStart) error {
+func (s *guestServer) executeTTY(ctx context.Context, stream pb.GuestService_ExecServer, start *pb.ExecStart, cg *execCgroup, sb *execSandbox) error {
 	// Run command directly with PTY - guest-agent is already running in container namespace
 	// This ensures PTY and shell are in the same namespace, fixing Ctrl+C signal handling
 	if len(start.Command) == 0 {
@@ -176,6 +216,14 @@ func (s *guestServer) executeTTY(ctx context.Context, stream pb.GuestService_Exe
 	if start.Cwd != "" {
 		cmd.Dir = start.Cwd
 	}
+	if cg != nil {
+		cg.apply(cmd)
+	}
+	if sb != nil {
+		if err := sb.apply(cmd); err != nil {
+			return fmt.Errorf("sandbox command: %w", err)
+		}
+	}
 
 	// Start with PTY
 	ptmx, err := pty.Start(cmd)
@@ -232,7 +280,7 @@ func (s *guestServer) executeTTY(ctx context.Context, stream pb.GuestService_Exe
 
 	exitCode := int32(0)
 	if cmd.ProcessState != nil {
-		exitCode = int32(cmd.ProcessState.ExitCode())
+		exitCode = cg.exitCode(int32(cmd.ProcessState.ExitCode()))
 	} else if waitErr != nil {
 		// If killed by timeout, exit with 124 (GNU timeout convention)
 		exitCode = 124
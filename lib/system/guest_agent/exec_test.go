@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeExecStream records the responses sent for an exec with no stdin
+type fakeExecStream struct {
+	grpc.ServerStream
+	stdout, stderr string
+	exitCode       int32
+
+	// Arrival time of each output message and of the exit code
+	outputTimes []time.Time
+	exitTime    time.Time
+}
+
+func (f *fakeExecStream) Recv() (*pb.ExecRequest, error) { return nil, io.EOF }
+
+func (f *fakeExecStream) Send(resp *pb.ExecResponse) error {
+	switch r := resp.Response.(type) {
+	case *pb.ExecResponse_Stdout:
+		f.stdout += string(r.Stdout)
+		f.outputTimes = append(f.outputTimes, time.Now())
+	case *pb.ExecResponse_Stderr:
+		f.stderr += string(r.Stderr)
+		f.outputTimes = append(f.outputTimes, time.Now())
+	case *pb.ExecResponse_ExitCode:
+		f.exitCode = r.ExitCode
+		f.exitTime = time.Now()
+	}
+	return nil
+}
+
+func TestExecuteNoTTY(t *testing.T) {
+	s := &guestServer{}
+	command := []string{"/bin/sh", "-c", "echo one; echo two >&2; echo three; exit 3"}
+
+	// Merged output keeps the order it was written in
+	stream := &fakeExecStream{}
+	require.NoError(t, s.executeNoTTY(context.Background(), stream, &pb.ExecStart{Command: command}, nil, nil))
+	assert.Equal(t, "one\ntwo\nthree\n", stream.stdout)
+	assert.Empty(t, stream.stderr)
+	assert.Equal(t, int32(3), stream.exitCode)
+
+	stream = &fakeExecStream{}
+	require.NoError(t, s.executeNoTTY(context.Background(), stream, &pb.ExecStart{Command: command, SeparateStreams: true}, nil, nil))
+	assert.Equal(t, "one\nthree\n", stream.stdout)
+	assert.Equal(t, "two\n", stream.stderr)
+	assert.Equal(t, int32(3), stream.exitCode)
+}
+
+func TestExecuteNoTTY_StreamsLive(t *testing.T) {
+	s := &guestServer{}
+	stream := &fakeExecStream{}
+	command := []string{"/bin/sh", "-c", "for i in 1 2 3 4 5; do echo line$i; sleep 0.1; done"}
+	require.NoError(t, s.executeNoTTY(context.Background(), stream, &pb.ExecStart{Command: command}, nil, nil))
+
+	assert.Equal(t, 5, strings.Count(stream.stdout, "line"))
+	assert.Equal(t, int32(0), stream.exitCode)
+
+	// Each line is sent as it is printed, not all at once when the command exits
+	require.Greater(t, len(stream.outputTimes), 1)
+	assert.Greater(t, stream.exitTime.Sub(stream.outputTimes[0]), 300*time.Millisecond)
+}
+
+func TestExecuteNoTTY_Timeout(t *testing.T) {
+	s := &guestServer{}
+	stream := &fakeExecStream{}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	require.NoError(t, s.executeNoTTY(ctx, stream, &pb.ExecStart{Command: []string{"sleep", "10"}}, nil, nil))
+	assert.Equal(t, int32(124), stream.exitCode)
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxReadFileBytes caps how much of a file ReadFile returns
+const maxReadFileBytes = 1 << 20
+
+// protectedReadDirs are kernel pseudo-filesystems that StatFile and ReadFile
+// refuse to touch. Files here are device nodes or synthesized by the kernel
+// and can block, have unbounded size, or expose host-sensitive state.
+var protectedReadDirs = []string{
+	"/dev",
+	"/proc",
+	"/sys",
+}
+
+// StatFile returns metadata for a file that may be read with ReadFile
+func (s *guestServer) StatFile(ctx context.Context, req *pb.StatFileRequest) (*pb.StatFileResponse, error) {
+	log.Printf("[guest-agent] stat-file: path=%s", req.Path)
+
+	resolved, info, err := resolveReadablePath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StatFileResponse{Info: fileInfoToProto(resolved, info)}, nil
+}
+
+// ReadFile returns the contents of a small regular file. It never opens
+// files for writing.
+func (s *guestServer) ReadFile(ctx context.Context, req *pb.ReadFileRequest) (*pb.ReadFileResponse, error) {
+	log.Printf("[guest-agent] read-file: path=%s max_bytes=%d", req.Path, req.MaxBytes)
+
+	resolved, info, err := resolveReadablePath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is a directory", req.Path)
+	}
+
+	// O_NOFOLLOW and O_NONBLOCK guard against the path being swapped for a
+	// symlink or FIFO between resolution and open.
+	f, err := os.OpenFile(resolved, os.O_RDONLY|syscall.O_NOFOLLOW|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, statusFromPathError(req.Path, err)
+	}
+	defer f.Close()
+
+	// Re-check the opened file in case it changed after resolution
+	info, err = f.Stat()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "stat %s: %v", req.Path, err)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is not a regular file", req.Path)
+	}
+
+	limit := req.MaxBytes
+	if limit <= 0 || limit > maxReadFileBytes {
+		limit = maxReadFileBytes
+	}
+
+	data, err := io.ReadAll(io.LimitReader(f, limit+1))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "read %s: %v", req.Path, err)
+	}
+	truncated := int64(len(data)) > limit
+	if truncated {
+		data = data[:limit]
+	}
+
+	return &pb.ReadFileResponse{
+		Info:      fileInfoToProto(resolved, info),
+		Data:      data,
+		Truncated: truncated,
+	}, nil
+}
+
+// resolveReadablePath validates a path for StatFile/ReadFile. Symbolic links
+// are resolved before the protected directory check so they cannot be used to
+// reach into /proc, /sys or /dev. Device, FIFO and socket files are rejected.
+func resolveReadablePath(path string) (string, os.FileInfo, error) {
+	if !filepath.IsAbs(path) {
+		return "", nil, status.Errorf(codes.InvalidArgument, "path %q must be absolute", path)
+	}
+
+	resolved, err := filepath.EvalSymlinks(filepath.Clean(path))
+	if err != nil {
+		return "", nil, statusFromPathError(path, err)
+	}
+	if isProtectedPath(resolved) {
+		return "", nil, status.Errorf(codes.PermissionDenied, "%s is in a protected system directory", path)
+	}
+
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return "", nil, statusFromPathError(path, err)
+	}
+	if !info.IsDir() && !info.Mode().IsRegular() {
+		return "", nil, status.Errorf(codes.FailedPrecondition, "%s is not a regular file", path)
+	}
+	return resolved, info, nil
+}
+
+// isProtectedPath checks if a path is or is under a protected directory
+func isProtectedPath(path string) bool {
+	cleanPath := filepath.Clean(path)
+	for _, dir := range protectedReadDirs {
+		if cleanPath == dir || strings.HasPrefix(cleanPath, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// statusFromPathError converts a filesystem error to a gRPC status
+func statusFromPathError(path string, err error) error {
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return status.Errorf(codes.NotFound, "%s does not exist", path)
+	case errors.Is(err, os.ErrPermission):
+		return status.Errorf(codes.PermissionDenied, "%s: permission denied", path)
+	case errors.Is(err, syscall.ELOOP):
+		return status.Errorf(codes.FailedPrecondition, "%s changed while being read", path)
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", path, err))
+	}
+}
+
+// fileInfoToProto converts os.FileInfo to the protobuf FileInfo
+func fileInfoToProto(path string, info os.FileInfo) *pb.FileInfo {
+	fi := &pb.FileInfo{
+		Path:  path,
+		IsDir: info.IsDir(),
+		Mode:  uint32(info.Mode().Perm()),
+		Size:  info.Size(),
+		Mtime: info.ModTime().Unix(),
+	}
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		fi.Uid = stat.Uid
+		fi.Gid = stat.Gid
+	}
+	return fi
+}
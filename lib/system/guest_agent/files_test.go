@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsProtectedPath(t *testing.T) {
+	assert.True(t, isProtectedPath("/proc"))
+	assert.True(t, isProtectedPath("/proc/1/environ"))
+	assert.True(t, isProtectedPath("/sys/kernel/../kernel/debug"))
+	assert.True(t, isProtectedPath("/dev/mem"))
+	assert.False(t, isProtectedPath("/etc/hosts"))
+	assert.False(t, isProtectedPath("/devices/config"))
+	assert.False(t, isProtectedPath("/app/proc"))
+}
+
+func TestReadFile(t *testing.T) {
+	s := &guestServer{}
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("key: value\n"), 0640))
+
+	t.Run("reads regular file", func(t *testing.T) {
+		resp, err := s.ReadFile(ctx, &pb.ReadFileRequest{Path: path})
+		require.NoError(t, err)
+		assert.Equal(t, "key: value\n", string(resp.Data))
+		assert.False(t, resp.Truncated)
+		assert.Equal(t, int64(11), resp.Info.Size)
+		assert.Equal(t, uint32(0640), resp.Info.Mode)
+	})
+
+	t.Run("truncates at max bytes", func(t *testing.T) {
+		resp, err := s.ReadFile(ctx, &pb.ReadFileRequest{Path: path, MaxBytes: 3})
+		require.NoError(t, err)
+		assert.Equal(t, "key", string(resp.Data))
+		assert.True(t, resp.Truncated)
+	})
+
+	t.Run("caps at 1MB", func(t *testing.T) {
+		big := filepath.Join(dir, "big.bin")
+		require.NoError(t, os.WriteFile(big, []byte(strings.Repeat("x", maxReadFileBytes+10)), 0644))
+
+		resp, err := s.ReadFile(ctx, &pb.ReadFileRequest{Path: big, MaxBytes: 10 * maxReadFileBytes})
+		require.NoError(t, err)
+		assert.Len(t, resp.Data, maxReadFileBytes)
+		assert.True(t, resp.Truncated)
+	})
+
+	t.Run("rejects directory", func(t *testing.T) {
+		_, err := s.ReadFile(ctx, &pb.ReadFileRequest{Path: dir})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("rejects FIFO", func(t *testing.T) {
+		fifo := filepath.Join(dir, "fifo")
+		require.NoError(t, syscall.Mkfifo(fifo, 0644))
+
+		_, err := s.ReadFile(ctx, &pb.ReadFileRequest{Path: fifo})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("rejects symlink into protected directory", func(t *testing.T) {
+		link := filepath.Join(dir, "environ")
+		require.NoError(t, os.Symlink("/proc/self/environ", link))
+
+		_, err := s.ReadFile(ctx, &pb.ReadFileRequest{Path: link})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("rejects relative path", func(t *testing.T) {
+		_, err := s.ReadFile(ctx, &pb.ReadFileRequest{Path: "etc/hosts"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := s.ReadFile(ctx, &pb.ReadFileRequest{Path: filepath.Join(dir, "missing")})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestStatFile(t *testing.T) {
+	s := &guestServer{}
+	dir := t.TempDir()
+
+	resp, err := s.StatFile(context.Background(), &pb.StatFileRequest{Path: dir})
+	require.NoError(t, err)
+	assert.True(t, resp.Info.IsDir)
+
+	_, err = s.StatFile(context.Background(), &pb.StatFileRequest{Path: "/dev/null"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"os"
 	"time"
 
 	"github.com/mdlayher/vsock"
@@ -15,6 +16,11 @@ type guestServer struct {
 }
 
 func main() {
+	// Re-executed to launch a sandboxed exec command
+	if config, ok := os.LookupEnv(sandboxEnv); ok {
+		runSandboxed(config)
+	}
+
 	// Listen on vsock port 2222 with retries
 	var l *vsock.Listener
 	var err error
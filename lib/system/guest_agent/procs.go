@@ -0,0 +1,233 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+)
+
+// pseudoFilesystems are skipped when reporting disk usage
+var pseudoFilesystems = map[string]bool{
+	"proc":        true,
+	"sysfs":       true,
+	"devtmpfs":    true,
+	"devpts":      true,
+	"tmpfs":       true,
+	"cgroup":      true,
+	"cgroup2":     true,
+	"mqueue":      true,
+	"debugfs":     true,
+	"tracefs":     true,
+	"securityfs":  true,
+	"pstore":      true,
+	"bpf":         true,
+	"configfs":    true,
+	"fusectl":     true,
+	"hugetlbfs":   true,
+	"binfmt_misc": true,
+}
+
+// ListProcesses returns the processes running in the guest by reading /proc
+func (s *guestServer) ListProcesses(ctx context.Context, req *pb.ListProcessesRequest) (*pb.ListProcessesResponse, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, fmt.Errorf("read /proc: %w", err)
+	}
+
+	pageSize := int64(os.Getpagesize())
+	var procs []*pb.ProcessInfo
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		// Processes may exit while we walk /proc, so skip any we can't read
+		proc, err := readProcess(filepath.Join("/proc", entry.Name()), pid, pageSize)
+		if err != nil {
+			continue
+		}
+		procs = append(procs, proc)
+	}
+
+	sort.Slice(procs, func(i, j int) bool { return procs[i].Pid < procs[j].Pid })
+	log.Printf("[guest-agent] list-processes: %d processes", len(procs))
+	return &pb.ListProcessesResponse{Processes: procs}, nil
+}
+
+// readProcess collects process info from a /proc/<pid> directory
+func readProcess(dir string, pid int, pageSize int64) (*pb.ProcessInfo, error) {
+	statData, err := os.ReadFile(filepath.Join(dir, "stat"))
+	if err != nil {
+		return nil, err
+	}
+	name, state, ppid, err := parseProcStat(string(statData))
+	if err != nil {
+		return nil, err
+	}
+
+	proc := &pb.ProcessInfo{
+		Pid:   int32(pid),
+		Ppid:  int32(ppid),
+		Name:  name,
+		State: state,
+	}
+
+	if cmdline, err := os.ReadFile(filepath.Join(dir, "cmdline")); err == nil {
+		proc.Cmdline = parseCmdline(cmdline)
+	}
+
+	if statm, err := os.ReadFile(filepath.Join(dir, "statm")); err == nil {
+		fields := strings.Fields(string(statm))
+		if len(fields) >= 2 {
+			if pages, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+				proc.RssBytes = pages * pageSize
+			}
+		}
+	}
+
+	return proc, nil
+}
+
+// parseProcStat extracts the command name, state and parent PID from the
+// contents of /proc/<pid>/stat. The command name is wrapped in parentheses
+// and may itself contain spaces or parentheses, so we split on the last ')'.
+func parseProcStat(data string) (name, state string, ppid int, err error) {
+	openIdx := strings.IndexByte(data, '(')
+	closeIdx := strings.LastIndexByte(data, ')')
+	if openIdx < 0 || closeIdx < openIdx {
+		return "", "", 0, fmt.Errorf("malformed stat: %q", data)
+	}
+	name = data[openIdx+1 : closeIdx]
+
+	fields := strings.Fields(data[closeIdx+1:])
+	if len(fields) < 2 {
+		return "", "", 0, fmt.Errorf("malformed stat: %q", data)
+	}
+	ppid, err = strconv.Atoi(fields[1])
+	if err != nil {
+		return "", "", 0, fmt.Errorf("parse ppid: %w", err)
+	}
+	return name, fields[0], ppid, nil
+}
+
+// parseCmdline splits the NUL-separated contents of /proc/<pid>/cmdline
+func parseCmdline(data []byte) []string {
+	data = bytes.TrimRight(data, "\x00")
+	if len(data) == 0 {
+		return nil
+	}
+	parts := bytes.Split(data, []byte{0})
+	args := make([]string, len(parts))
+	for i, p := range parts {
+		args[i] = string(p)
+	}
+	return args
+}
+
+// GetGuestStats returns load average, memory and per-mount disk usage of the guest
+func (s *guestServer) GetGuestStats(ctx context.Context, req *pb.GetGuestStatsRequest) (*pb.GetGuestStatsResponse, error) {
+	resp := &pb.GetGuestStatsResponse{}
+
+	loadavg, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return nil, fmt.Errorf("read /proc/loadavg: %w", err)
+	}
+	resp.Load1, resp.Load5, resp.Load15, err = parseLoadavg(string(loadavg))
+	if err != nil {
+		return nil, err
+	}
+
+	meminfo, err := os.ReadFile("/proc/meminfo")
+	if err != nil {
+		return nil, fmt.Errorf("read /proc/meminfo: %w", err)
+	}
+	mem := parseMeminfo(string(meminfo))
+	resp.MemoryTotalBytes = mem["MemTotal"]
+	resp.MemoryAvailableBytes = mem["MemAvailable"]
+	resp.MemoryFreeBytes = mem["MemFree"]
+
+	mounts, err := os.ReadFile("/proc/mounts")
+	if err != nil {
+		return nil, fmt.Errorf("read /proc/mounts: %w", err)
+	}
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(mounts))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+		device, mountPoint, fsType := fields[0], fields[1], fields[2]
+		if pseudoFilesystems[fsType] || seen[mountPoint] {
+			continue
+		}
+		seen[mountPoint] = true
+
+		var st syscall.Statfs_t
+		if err := syscall.Statfs(mountPoint, &st); err != nil || st.Blocks == 0 {
+			continue
+		}
+		bsize := uint64(st.Bsize)
+		resp.Disks = append(resp.Disks, &pb.DiskUsage{
+			MountPoint:     mountPoint,
+			Device:         device,
+			FsType:         fsType,
+			TotalBytes:     st.Blocks * bsize,
+			UsedBytes:      (st.Blocks - st.Bfree) * bsize,
+			AvailableBytes: st.Bavail * bsize,
+		})
+	}
+
+	return resp, nil
+}
+
+// parseLoadavg parses the first three fields of /proc/loadavg
+func parseLoadavg(data string) (load1, load5, load15 float64, err error) {
+	fields := strings.Fields(data)
+	if len(fields) < 3 {
+		return 0, 0, 0, fmt.Errorf("malformed loadavg: %q", data)
+	}
+	var loads [3]float64
+	for i := range loads {
+		loads[i], err = strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("parse loadavg: %w", err)
+		}
+	}
+	return loads[0], loads[1], loads[2], nil
+}
+
+// parseMeminfo parses /proc/meminfo into a map of field name to bytes
+func parseMeminfo(data string) map[string]uint64 {
+	result := make(map[string]uint64)
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		key, rest, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		if len(fields) > 1 && fields[1] == "kB" {
+			value *= 1024
+		}
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProcStat(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantName  string
+		wantState string
+		wantPPID  int
+		wantErr   bool
+	}{
+		{
+			name:      "simple",
+			data:      "1 (init) S 0 1 1 0 -1 4194560 1234 0 0 0",
+			wantName:  "init",
+			wantState: "S",
+			wantPPID:  0,
+		},
+		{
+			name:      "name with spaces and parens",
+			data:      "42 (my (weird) proc) R 7 42 42 0 -1",
+			wantName:  "my (weird) proc",
+			wantState: "R",
+			wantPPID:  7,
+		},
+		{
+			name:    "missing parens",
+			data:    "42 init S 1",
+			wantErr: true,
+		},
+		{
+			name:    "truncated",
+			data:    "42 (init) S",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, state, ppid, err := parseProcStat(tt.data)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantState, state)
+			assert.Equal(t, tt.wantPPID, ppid)
+		})
+	}
+}
+
+func TestParseCmdline(t *testing.T) {
+	assert.Equal(t, []string{"/bin/sh", "-c", "echo hi"}, parseCmdline([]byte("/bin/sh\x00-c\x00echo hi\x00")))
+	assert.Nil(t, parseCmdline([]byte{}))
+}
+
+func TestParseLoadavg(t *testing.T) {
+	l1, l5, l15, err := parseLoadavg("0.52 0.31 0.12 1/123 4567\n")
+	require.NoError(t, err)
+	assert.Equal(t, 0.52, l1)
+	assert.Equal(t, 0.31, l5)
+	assert.Equal(t, 0.12, l15)
+
+	_, _, _, err = parseLoadavg("0.52")
+	assert.Error(t, err)
+}
+
+func TestParseMeminfo(t *testing.T) {
+	mem := parseMeminfo("MemTotal:        2048000 kB\nMemFree:          512000 kB\nMemAvailable:    1024000 kB\nHugePages_Total:       0\n")
+	assert.Equal(t, uint64(2048000*1024), mem["MemTotal"])
+	assert.Equal(t, uint64(512000*1024), mem["MemFree"])
+	assert.Equal(t, uint64(1024000*1024), mem["MemAvailable"])
+	assert.Equal(t, uint64(0), mem["HugePages_Total"])
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"unsafe"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+	"golang.org/x/sys/unix"
+)
+
+// sandboxEnv passes a sandboxed command's settings to the guest agent when it
+// is re-executed to launch the command
+const sandboxEnv = "HYPEMAN_EXEC_SANDBOX"
+
+// x32SyscallBit marks x32 ABI syscalls, whose numbers would otherwise slip
+// past the x86_64 denylist
+const x32SyscallBit = 0x40000000
+
+// deniedSyscalls are blocked by the default seccomp profile
+var deniedSyscalls = []uint32{
+	unix.SYS_MOUNT, unix.SYS_UMOUNT2, unix.SYS_PIVOT_ROOT, unix.SYS_MOUNT_SETATTR,
+	unix.SYS_FSOPEN, unix.SYS_FSCONFIG, unix.SYS_FSMOUNT, unix.SYS_FSPICK,
+	unix.SYS_MOVE_MOUNT, unix.SYS_OPEN_TREE,
+	unix.SYS_INIT_MODULE, unix.SYS_FINIT_MODULE, unix.SYS_DELETE_MODULE,
+	unix.SYS_KEXEC_LOAD, unix.SYS_KEXEC_FILE_LOAD, unix.SYS_REBOOT,
+	unix.SYS_SWAPON, unix.SYS_SWAPOFF, unix.SYS_ACCT,
+	unix.SYS_BPF, unix.SYS_PERF_EVENT_OPEN, unix.SYS_USERFAULTFD,
+	unix.SYS_PTRACE, unix.SYS_PROCESS_VM_READV, unix.SYS_PROCESS_VM_WRITEV,
+	unix.SYS_UNSHARE, unix.SYS_SETNS,
+	unix.SYS_KEYCTL, unix.SYS_ADD_KEY, unix.SYS_REQUEST_KEY,
+	unix.SYS_OPEN_BY_HANDLE_AT,
+	unix.SYS_SETTIMEOFDAY, unix.SYS_CLOCK_SETTIME, unix.SYS_CLOCK_ADJTIME, unix.SYS_ADJTIMEX,
+}
+
+// namespaceCloneFlags are the CLONE_NEW* flags the default seccomp profile
+// refuses on clone, which creates namespaces the way unshare does.
+// CLONE_NEWTIME is left out because clone reads that bit as part of the exit
+// signal; it only means a new time namespace to clone3 and unshare.
+const namespaceCloneFlags = unix.CLONE_NEWNS | unix.CLONE_NEWCGROUP | unix.CLONE_NEWUTS |
+	unix.CLONE_NEWIPC | unix.CLONE_NEWUSER | unix.CLONE_NEWPID | unix.CLONE_NEWNET
+
+// execSandbox restricts what an exec'd command may do
+type execSandbox struct {
+	Path         string `json:"path"`                   // Command to run once sandboxed
+	Capabilities []int  `json:"capabilities,omitempty"` // Capabilities to drop
+	Seccomp      string `json:"seccomp,omitempty"`      // Seccomp profile
+}
+
+// newExecSandbox returns the sandbox an exec request asks for, or nil when it
+// asks for none
+func newExecSandbox(start *pb.ExecStart) (*execSandbox, error) {
+	if err := pb.ValidateExecSandbox(start.DropCapabilities, start.SeccompProfile); err != nil {
+		return nil, err
+	}
+	if len(start.DropCapabilities) == 0 && start.SeccompProfile == "" {
+		return nil, nil
+	}
+	caps, _ := pb.CapabilityNumbers(start.DropCapabilities)
+	return &execSandbox{Capabilities: caps, Seccomp: start.SeccompProfile}, nil
+}
+
+// apply makes cmd start through the guest agent binary, which restricts
+// itself and then execs the command. Go can't run code between fork and
+// exec, so this is how the restrictions hold from the command's first
+// instruction.
+func (sb *execSandbox) apply(cmd *exec.Cmd) error {
+	if cmd.Err != nil {
+		return nil // Start reports the command can't be found
+	}
+	sb.Path = cmd.Path
+	config, err := json.Marshal(sb)
+	if err != nil {
+		return err
+	}
+	cmd.Path = "/proc/self/exe"
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, sandboxEnv+"="+string(config))
+	return nil
+}
+
+// runSandboxed is the guest agent's entry point when it was re-executed to
+// launch a sandboxed command. It only returns by exiting.
+func runSandboxed(config string) {
+	// Capabilities and seccomp filters are per thread, and execve keeps the
+	// calling thread's
+	runtime.LockOSThread()
+
+	var sb execSandbox
+	if err := json.Unmarshal([]byte(config), &sb); err != nil {
+		fmt.Fprintf(os.Stderr, "exec sandbox: invalid config: %v\n", err)
+		os.Exit(126)
+	}
+	var env []string
+	for _, kv := range os.Environ() {
+		if !strings.HasPrefix(kv, sandboxEnv+"=") {
+			env = append(env, kv)
+		}
+	}
+
+	if err := sb.restrict(); err != nil {
+		fmt.Fprintf(os.Stderr, "exec sandbox: %v\n", err)
+		os.Exit(126)
+	}
+	err := unix.Exec(sb.Path, os.Args, env)
+	fmt.Fprintf(os.Stderr, "exec sandbox: exec %s: %v\n", sb.Path, err)
+	os.Exit(127)
+}
+
+// restrict drops the sandbox's capabilities and installs its seccomp filter
+// on the calling thread. No new privileges can be gained afterwards, so a
+// setuid binary can't restore them.
+func (sb *execSandbox) restrict() error {
+	if len(sb.Capabilities) > 0 {
+		if err := dropCapabilities(sb.Capabilities); err != nil {
+			return err
+		}
+	}
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("set no_new_privs: %w", err)
+	}
+	if sb.Seccomp == pb.SeccompProfileDefault {
+		filter, err := seccompDenyFilter(deniedSyscalls)
+		if err != nil {
+			return err
+		}
+		prog := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+		if err := unix.Prctl(unix.PR_SET_SECCOMP, unix.SECCOMP_MODE_FILTER, uintptr(unsafe.Pointer(&prog)), 0, 0); err != nil {
+			return fmt.Errorf("install seccomp filter: %w", err)
+		}
+	}
+	return nil
+}
+
+// dropCapabilities removes capabilities from the bounding set, which caps
+// what a command run as root gets, and from the current sets
+func dropCapabilities(caps []int) error {
+	for _, c := range caps {
+		// EINVAL means the guest kernel predates the capability
+		if err := unix.Prctl(unix.PR_CAPBSET_DROP, uintptr(c), 0, 0, 0); err != nil && err != unix.EINVAL {
+			return fmt.Errorf("drop capability %d from bounding set: %w", c, err)
+		}
+	}
+	if err := unix.Prctl(unix.PR_CAP_AMBIENT, unix.PR_CAP_AMBIENT_CLEAR_ALL, 0, 0, 0); err != nil && err != unix.EINVAL {
+		return fmt.Errorf("clear ambient capabilities: %w", err)
+	}
+
+	hdr := unix.CapUserHeader{Version: unix.LINUX_CAPABILITY_VERSION_3}
+	var data [2]unix.CapUserData
+	if err := unix.Capget(&hdr, &data[0]); err != nil {
+		return fmt.Errorf("get capabilities: %w", err)
+	}
+	for _, c := range caps {
+		mask := ^uint32(1 << (c % 32))
+		data[c/32].Effective &= mask
+		data[c/32].Permitted &= mask
+		data[c/32].Inheritable &= mask
+	}
+	if err := unix.Capset(&hdr, &data[0]); err != nil {
+		return fmt.Errorf("set capabilities: %w", err)
+	}
+	return nil
+}
+
+// seccompDenyFilter builds a seccomp BPF program that fails the given
+// syscalls with EPERM and allows everything else. Syscalls from another
+// architecture's ABI are failed too, since their numbers mean different calls.
+// clone is failed with EPERM when it asks for a new namespace. clone3 takes
+// its flags in a struct seccomp can't read, so it fails with ENOSYS, which
+// libc takes as a cue to fall back to clone.
+func seccompDenyFilter(syscalls []uint32) ([]unix.SockFilter, error) {
+	var arch uint32
+	switch runtime.GOARCH {
+	case "amd64":
+		arch = unix.AUDIT_ARCH_X86_64
+	case "arm64":
+		arch = unix.AUDIT_ARCH_AARCH64
+	default:
+		return nil, fmt.Errorf("seccomp profiles are not supported on %s", runtime.GOARCH)
+	}
+
+	const (
+		offsetNr   = 0 // Offsets in struct seccomp_data
+		offsetArch = 4
+		offsetArg0 = 16 // Low word of the first argument on little-endian
+	)
+	deny := uint32(unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM))
+	enosys := uint32(unix.SECCOMP_RET_ERRNO | uint32(unix.ENOSYS))
+	allow := uint32(unix.SECCOMP_RET_ALLOW)
+
+	// The program ends with the allow, ENOSYS and deny returns, in that
+	// order. Jumps are relative to the instruction after the jump, so each
+	// jump below counts the instructions between it and its target.
+	n := len(syscalls)
+	filter := []unix.SockFilter{
+		{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: offsetArch},
+		{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, K: arch, Jt: 0, Jf: uint8(n + 8)},
+		{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: offsetNr},
+		{Code: unix.BPF_JMP | unix.BPF_JGE | unix.BPF_K, K: x32SyscallBit, Jt: uint8(n + 6), Jf: 0},
+	}
+	for i, nr := range syscalls {
+		filter = append(filter, unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, K: nr, Jt: uint8(n + 5 - i), Jf: 0})
+	}
+	filter = append(filter,
+		unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, K: unix.SYS_CLONE3, Jt: 4, Jf: 0},
+		unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, K: unix.SYS_CLONE, Jt: 0, Jf: 2},
+		unix.SockFilter{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: offsetArg0},
+		unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JSET | unix.BPF_K, K: namespaceCloneFlags, Jt: 2, Jf: 0},
+		unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: allow},
+		unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: enosys},
+		unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: deny},
+	)
+	return filter, nil
+}
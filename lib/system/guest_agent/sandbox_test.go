@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"testing"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+// seccompProbeEnv makes the test binary install the default seccomp profile
+// and report how clone and clone3 fail under it
+const seccompProbeEnv = "HYPEMAN_TEST_SECCOMP_PROBE"
+
+// TestMain lets the test binary stand in for the guest agent when a
+// sandboxed command re-executes it
+func TestMain(m *testing.M) {
+	if config, ok := os.LookupEnv(sandboxEnv); ok {
+		runSandboxed(config)
+	}
+	if _, ok := os.LookupEnv(seccompProbeEnv); ok {
+		probeSeccomp()
+	}
+	os.Exit(m.Run())
+}
+
+// probeSeccomp makes calls the kernel would reject with EINVAL before
+// creating anything, so only the filter can make them fail differently
+func probeSeccomp() {
+	runtime.LockOSThread()
+	if err := (&execSandbox{Seccomp: pb.SeccompProfileDefault}).restrict(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	// CLONE_NEWNS with CLONE_FS, and CLONE_SIGHAND without CLONE_VM, are invalid
+	_, _, newns := unix.RawSyscall(unix.SYS_CLONE, unix.CLONE_NEWNS|unix.CLONE_FS, 0, 0)
+	_, _, plain := unix.RawSyscall(unix.SYS_CLONE, unix.CLONE_SIGHAND, 0, 0)
+	_, _, clone3 := unix.RawSyscall(unix.SYS_CLONE3, 0, 0, 0)
+	fmt.Printf("newns=%d plain=%d clone3=%d\n", newns, plain, clone3)
+	os.Exit(0)
+}
+
+func TestSeccompCloneFilter(t *testing.T) {
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), seccompProbeEnv+"=1")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	assert.Equal(t, fmt.Sprintf("newns=%d plain=%d clone3=%d\n", unix.EPERM, unix.EINVAL, unix.ENOSYS), string(out))
+}
+
+func TestNewExecSandbox(t *testing.T) {
+	sb, err := newExecSandbox(&pb.ExecStart{})
+	require.NoError(t, err)
+	assert.Nil(t, sb, "no restrictions means no sandbox")
+
+	_, err = newExecSandbox(&pb.ExecStart{DropCapabilities: []string{"CAP_FLY"}})
+	assert.Error(t, err)
+	_, err = newExecSandbox(&pb.ExecStart{SeccompProfile: "strict"})
+	assert.Error(t, err)
+
+	sb, err = newExecSandbox(&pb.ExecStart{DropCapabilities: []string{"sys_admin", "CAP_NET_RAW"}})
+	require.NoError(t, err)
+	assert.Equal(t, []int{21, 13}, sb.Capabilities)
+}
+
+func TestExecuteSandboxed(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("dropping capabilities from the bounding set needs root")
+	}
+
+	start := &pb.ExecStart{
+		Command:          []string{"/bin/sh", "-c", "grep -E '^(CapBnd|NoNewPrivs|Seccomp):' /proc/self/status; unshare --mount true"},
+		DropCapabilities: []string{pb.DropAllCapabilities},
+		SeccompProfile:   pb.SeccompProfileDefault,
+	}
+	sb, err := newExecSandbox(start)
+	require.NoError(t, err)
+
+	stream := &fakeExecStream{}
+	require.NoError(t, (&guestServer{}).executeNoTTY(context.Background(), stream, start, nil, sb))
+	assert.Contains(t, stream.stdout, "CapBnd:\t0000000000000000")
+	assert.Contains(t, stream.stdout, "NoNewPrivs:\t1")
+	assert.Contains(t, stream.stdout, "Seccomp:\t2")
+	assert.NotEqual(t, int32(0), stream.exitCode, "unshare is blocked")
+}
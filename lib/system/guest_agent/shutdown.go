@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+)
+
+const (
+	// defaultShutdownTimeout is used when the host does not specify one
+	defaultShutdownTimeout = 10 * time.Second
+
+	// sigSystemdPowerOff is SIGRTMIN+4 in glibc numbering, which systemd
+	// handles by starting poweroff.target
+	sigSystemdPowerOff = syscall.Signal(38)
+)
+
+// Shutdown syncs filesystems and powers off the guest. The response is sent
+// before shutdown begins so the host is not left waiting on a dying connection.
+func (s *guestServer) Shutdown(ctx context.Context, req *pb.ShutdownRequest) (*pb.ShutdownResponse, error) {
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+	log.Printf("[guest-agent] shutdown requested: timeout=%s", timeout)
+
+	go shutdownGuest(timeout)
+	return &pb.ShutdownResponse{}, nil
+}
+
+// shutdownGuest stops processes and powers off the VM
+func shutdownGuest(timeout time.Duration) {
+	// Give gRPC a moment to flush the response
+	time.Sleep(100 * time.Millisecond)
+
+	// In systemd mode let systemd run its normal stop sequence
+	if comm, err := os.ReadFile("/proc/1/comm"); err == nil && strings.TrimSpace(string(comm)) == "systemd" {
+		log.Printf("[guest-agent] shutdown: signalling systemd to power off")
+		err := syscall.Kill(1, sigSystemdPowerOff)
+		if err == nil {
+			return
+		}
+		log.Printf("[guest-agent] shutdown: failed to signal systemd: %v", err)
+	}
+
+	// Exec mode: SIGTERM everything except init and ourselves, then wait
+	log.Printf("[guest-agent] shutdown: sending SIGTERM to all processes")
+	syscall.Kill(-1, syscall.SIGTERM)
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) && hasUserProcesses() {
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	log.Printf("[guest-agent] shutdown: syncing filesystems and powering off")
+	syscall.Sync()
+	if err := syscall.Reboot(syscall.LINUX_REBOOT_CMD_POWER_OFF); err != nil {
+		log.Printf("[guest-agent] shutdown: power off failed: %v", err)
+	}
+}
+
+// hasUserProcesses reports whether any process other than init, the guest
+// agent, kernel threads and zombies is still running
+func hasUserProcesses() bool {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return false
+	}
+
+	self := os.Getpid()
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil || pid == 1 || pid == 2 || pid == self {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join("/proc", entry.Name(), "stat"))
+		if err != nil {
+			continue
+		}
+		_, state, ppid, err := parseProcStat(string(data))
+		if err != nil || ppid == 2 || state == "Z" {
+			continue
+		}
+		return true
+	}
+	return false
+}
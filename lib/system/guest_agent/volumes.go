@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultDiskWaitTimeout is how long MountVolume waits for a hotplugged disk
+// to show up when the host does not say
+const defaultDiskWaitTimeout = 10 * time.Second
+
+// sysBlockDir is where virtio-blk disks and their serials are listed
+var sysBlockDir = "/sys/block"
+
+// MountVolume waits for the disk with the requested serial to appear and
+// mounts it. Hotplugged disks get whatever /dev/vdX name is free, so they
+// are only ever found by serial.
+func (s *guestServer) MountVolume(ctx context.Context, req *pb.MountVolumeRequest) (*pb.MountVolumeResponse, error) {
+	log.Printf("[guest-agent] mount-volume: serial=%s path=%s readonly=%v", req.Serial, req.Path, req.Readonly)
+
+	if req.Serial == "" {
+		return nil, status.Error(codes.InvalidArgument, "serial is required")
+	}
+	if !filepath.IsAbs(req.Path) {
+		return nil, status.Errorf(codes.InvalidArgument, "path %q must be absolute", req.Path)
+	}
+
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = defaultDiskWaitTimeout
+	}
+	device, err := waitForDisk(ctx, req.Serial, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(req.Path, 0755); err != nil {
+		return nil, status.Errorf(codes.Internal, "mkdir %s: %v", req.Path, err)
+	}
+	var flags uintptr
+	data := ""
+	if req.Readonly {
+		// noload skips journal recovery, as other instances may share the volume
+		flags, data = syscall.MS_RDONLY, "noload"
+	} else if req.Discard {
+		data = "discard"
+	}
+	if err := syscall.Mount(device, req.Path, "ext4", flags, data); err != nil {
+		return nil, status.Errorf(codes.Internal, "mount %s at %s: %v", device, req.Path, err)
+	}
+
+	log.Printf("[guest-agent] mount-volume: mounted %s at %s", device, req.Path)
+	return &pb.MountVolumeResponse{Device: device}, nil
+}
+
+// UnmountVolume syncs and unmounts a volume. Processes still using it keep
+// it busy, and the unmount fails rather than leaving them with a vanished disk.
+func (s *guestServer) UnmountVolume(ctx context.Context, req *pb.UnmountVolumeRequest) (*pb.UnmountVolumeResponse, error) {
+	log.Printf("[guest-agent] unmount-volume: path=%s", req.Path)
+
+	if !filepath.IsAbs(req.Path) {
+		return nil, status.Errorf(codes.InvalidArgument, "path %q must be absolute", req.Path)
+	}
+
+	syscall.Sync()
+	if err := syscall.Unmount(req.Path, 0); err != nil {
+		switch err {
+		case syscall.EINVAL:
+			// Not a mount point: the volume was never mounted (e.g. it was
+			// attached for the next boot), so there is nothing to do
+			return &pb.UnmountVolumeResponse{}, nil
+		case syscall.EBUSY:
+			return nil, status.Errorf(codes.FailedPrecondition, "%s is in use", req.Path)
+		}
+		return nil, status.Errorf(codes.Internal, "unmount %s: %v", req.Path, err)
+	}
+	return &pb.UnmountVolumeResponse{}, nil
+}
+
+// waitForDisk polls for the disk with the given serial until it appears
+func waitForDisk(ctx context.Context, serial string, timeout time.Duration) (string, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		if device := findDiskBySerial(serial); device != "" {
+			return device, nil
+		}
+		if time.Now().After(deadline) {
+			return "", status.Errorf(codes.DeadlineExceeded, "no disk with serial %s appeared within %s", serial, timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return "", status.FromContextError(ctx.Err()).Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// findDiskBySerial returns the device of the virtio-blk disk with the given
+// serial, or "" if there is none
+func findDiskBySerial(serial string) string {
+	paths, _ := filepath.Glob(filepath.Join(sysBlockDir, "vd*", "serial"))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err == nil && strings.TrimSpace(string(data)) == serial {
+			return "/dev/" + filepath.Base(filepath.Dir(path))
+		}
+	}
+	return ""
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/onkernel/hypeman/lib/guest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWaitForDisk(t *testing.T) {
+	dir := t.TempDir()
+	orig := sysBlockDir
+	sysBlockDir = dir
+	t.Cleanup(func() { sysBlockDir = orig })
+
+	addDisk := func(name, serial string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, name), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name, "serial"), []byte(serial+"\n"), 0644))
+	}
+	addDisk("vda", "")
+	addDisk("vdd", "hv0123")
+
+	ctx := context.Background()
+	device, err := waitForDisk(ctx, "hv0123", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/vdd", device)
+
+	// A disk that shows up while waiting is found
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		addDisk("vde", "hv4567")
+	}()
+	device, err = waitForDisk(ctx, "hv4567", 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/vde", device)
+
+	_, err = waitForDisk(ctx, "missing", 200*time.Millisecond)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestMountVolumeValidation(t *testing.T) {
+	s := &guestServer{}
+	ctx := context.Background()
+
+	_, err := s.MountVolume(ctx, &pb.MountVolumeRequest{Path: "/mnt/data"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.MountVolume(ctx, &pb.MountVolumeRequest{Serial: "hv0123", Path: "mnt/data"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.UnmountVolume(ctx, &pb.UnmountVolumeRequest{Path: "mnt/data"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// clocksourceDir is where the kernel lists and selects its clocksource
+const clocksourceDir = "/sys/devices/system/clocksource/clocksource0"
+
+// configureClock selects the kvm-clock clocksource when the hypervisor offers
+// it, which tracks the host across pauses better than the TSC, and loads
+// ptp_kvm so a guest running chrony can use /dev/ptp0 as a reference clock.
+// The host still steps the clock through the guest agent after a restore.
+func configureClock(log *Logger) {
+	available, err := os.ReadFile(filepath.Join(clocksourceDir, "available_clocksource"))
+	if err == nil && slices.Contains(strings.Fields(string(available)), "kvm-clock") {
+		current, _ := os.ReadFile(filepath.Join(clocksourceDir, "current_clocksource"))
+		if strings.TrimSpace(string(current)) != "kvm-clock" {
+			if err := os.WriteFile(filepath.Join(clocksourceDir, "current_clocksource"), []byte("kvm-clock"), 0644); err != nil {
+				log.Error("clock", "failed to select kvm-clock", err)
+			} else {
+				log.Info("clock", "selected kvm-clock clocksource")
+			}
+		}
+	}
+
+	if _, err := os.Stat("/dev/ptp0"); err == nil {
+		return
+	}
+	kver, err := kernelRelease()
+	if err != nil {
+		return
+	}
+	// Not every kernel ships ptp_kvm, and the guest works without it
+	if err := loadModule(log, []string{filepath.Join("/lib/modules", kver)}, "ptp_kvm"); err != nil {
+		log.Info("clock", "ptp_kvm not available")
+	}
+}
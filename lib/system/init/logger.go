@@ -29,6 +29,12 @@ func NewLogger() *Logger {
 	return l
 }
 
+// Console returns the file init logs to, for processes whose output belongs
+// with init's rather than the application's.
+func (l *Logger) Console() *os.File {
+	return l.console
+}
+
 // SetConsole sets the serial console for output.
 func (l *Logger) SetConsole(path string) {
 	if f, err := os.OpenFile(path, os.O_WRONLY, 0); err == nil {
@@ -63,6 +69,11 @@ func (l *Logger) Infof(phase, format string, args ...interface{}) {
 	l.Info(phase, msg)
 }
 
+// Marker writes a machine-readable line as is, without timestamp or level.
+func (l *Logger) Marker(line string) {
+	l.write(line + "\n")
+}
+
 // write outputs a log line to serial console.
 func (l *Logger) write(line string) {
 	if l.console != nil {
@@ -10,32 +10,41 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
+	"syscall"
+
+	"github.com/onkernel/hypeman/lib/vmconfig"
 )
 
 func main() {
 	log := NewLogger()
 	log.Info("boot", "init starting")
+	log.Marker(vmconfig.BootStartedMarker)
 
 	// Phase 1: Mount additional filesystems (proc/sys/dev already mounted by init.sh)
 	if err := mountEssentials(log); err != nil {
-		log.Error("mount", "failed to mount essentials", err)
-		dropToShell()
+		bootFailed(log, "mount", "failed to mount essentials", err)
 	}
+	configureClock(log)
 
 	// Phase 2: Setup overlay rootfs
 	if err := setupOverlay(log); err != nil {
-		log.Error("overlay", "failed to setup overlay", err)
-		dropToShell()
+		bootFailed(log, "overlay", "failed to setup overlay", err)
 	}
 
 	// Phase 3: Read and parse config
 	cfg, err := readConfig(log)
 	if err != nil {
-		log.Error("config", "failed to read config", err)
-		dropToShell()
+		bootFailed(log, "config", "failed to read config", err)
+	}
+	if cfg.Discard {
+		if err := enableOverlayDiscard(log); err != nil {
+			log.Error("overlay", "failed to enable discard", err)
+			// Continue anyway - the disk just won't shrink
+		}
 	}
 
 	// Phase 4: Configure network (shared between modes)
@@ -62,19 +71,26 @@ func main() {
 		}
 	}
 
-	// Phase 7: Bind mount filesystems to new root
+	// Phase 7: Load requested kernel modules (after volumes, which can supply them)
+	if len(cfg.KernelModules) > 0 {
+		if err := loadKernelModules(log, cfg.KernelModules); err != nil {
+			log.Error("modules", "failed to load kernel modules", err)
+			// Continue anyway - the workload reports what it is missing
+		}
+	}
+
+	// Phase 8: Bind mount filesystems to new root
 	if err := bindMountsToNewRoot(log); err != nil {
-		log.Error("bind", "failed to bind mounts", err)
-		dropToShell()
+		bootFailed(log, "bind", "failed to bind mounts", err)
 	}
 
-	// Phase 8: Copy guest-agent to target location
+	// Phase 9: Copy guest-agent to target location
 	if err := copyGuestAgent(log); err != nil {
 		log.Error("agent", "failed to copy guest-agent", err)
 		// Continue anyway - exec will still work, just no remote access
 	}
 
-	// Phase 9: Mode-specific execution
+	// Phase 10: Mode-specific execution
 	if cfg.InitMode == "systemd" {
 		log.Info("mode", "entering systemd mode")
 		runSystemdMode(log, cfg)
@@ -84,14 +100,28 @@ func main() {
 	}
 }
 
-// dropToShell drops to an interactive shell for debugging when boot fails
-func dropToShell() {
-	fmt.Fprintln(os.Stderr, "FATAL: dropping to shell for debugging")
-	cmd := exec.Command("/bin/sh", "-i")
-	cmd.Stdin = os.Stdin
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	cmd.Run()
+// bootFailed reports a failed boot phase and powers the VM off. A headless VM
+// has no console to drop to, so the failure is written to the serial console
+// as a marker the host parses into the instance's boot status. For local
+// debugging, HYPEMAN_DEBUG_SHELL=1 on the kernel command line drops to an
+// interactive shell instead.
+func bootFailed(log *Logger, phase, msg string, err error) {
+	log.Error(phase, msg, err)
+	data, _ := json.Marshal(vmconfig.BootFailure{Phase: phase, Error: fmt.Sprintf("%s: %v", msg, err)})
+	log.Marker(vmconfig.BootFailedMarker + string(data))
+
+	if os.Getenv("HYPEMAN_DEBUG_SHELL") == "1" {
+		fmt.Fprintln(os.Stderr, "FATAL: dropping to shell for debugging")
+		cmd := exec.Command("/bin/sh", "-i")
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		cmd.Run()
+	}
+
+	// PID 1 exiting panics the kernel; power off cleanly instead
+	syscall.Sync()
+	syscall.Reboot(syscall.LINUX_REBOOT_CMD_POWER_OFF)
 	os.Exit(1)
 }
 
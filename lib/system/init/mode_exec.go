@@ -22,25 +22,24 @@ func runExecMode(log *Logger, cfg *vmconfig.Config) {
 	// Change root to the new filesystem using chroot (consistent with systemd mode)
 	log.Info("exec", "executing chroot")
 	if err := syscall.Chroot(newroot); err != nil {
-		log.Error("exec", "chroot failed", err)
-		dropToShell()
+		bootFailed(log, "exec", "chroot failed", err)
 	}
 
 	// Change to new root directory
 	if err := os.Chdir("/"); err != nil {
-		log.Error("exec", "chdir / failed", err)
-		dropToShell()
+		bootFailed(log, "exec", "chdir / failed", err)
 	}
 
 	// Set up environment
 	os.Setenv("PATH", "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin")
 	os.Setenv("HOME", "/root")
 
-	// Start guest-agent in background
+	// Start guest-agent in background. It logs to the system console with
+	// init, not among the application's output.
 	log.Info("exec", "starting guest-agent in background")
 	agentCmd := exec.Command("/opt/hypeman/guest-agent")
-	agentCmd.Stdout = os.Stdout
-	agentCmd.Stderr = os.Stderr
+	agentCmd.Stdout = log.Console()
+	agentCmd.Stderr = log.Console()
 	if err := agentCmd.Start(); err != nil {
 		log.Error("exec", "failed to start guest-agent", err)
 	}
@@ -74,11 +73,11 @@ func runExecMode(log *Logger, cfg *vmconfig.Config) {
 	appCmd.Env = buildEnv(cfg.Env)
 
 	if err := appCmd.Start(); err != nil {
-		log.Error("exec", "failed to start entrypoint", err)
-		dropToShell()
+		bootFailed(log, "exec", "failed to start entrypoint", err)
 	}
 
 	log.Info("exec", fmt.Sprintf("container app started (PID %d)", appCmd.Process.Pid))
+	log.Marker(vmconfig.BootCompleteMarker)
 
 	// Wait for app to exit
 	err := appCmd.Wait()
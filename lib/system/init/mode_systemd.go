@@ -3,15 +3,17 @@ package main
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"syscall"
 
 	"github.com/onkernel/hypeman/lib/vmconfig"
 )
 
 // runSystemdMode hands off control to systemd.
-// This is used when the image's CMD is /sbin/init or /lib/systemd/systemd.
+// This is used when the image's CMD is /sbin/init or /lib/systemd/systemd,
+// or when the instance was created in systemd mode.
 // The init binary:
-// 1. Injects the hypeman-agent.service unit
+// 1. Injects the hypeman-agent.service unit and masks the units asked for
 // 2. Uses chroot to switch to the container rootfs
 // 3. Execs the image's entrypoint/cmd (systemd) which becomes the new PID 1
 func runSystemdMode(log *Logger, cfg *vmconfig.Config) {
@@ -24,17 +26,21 @@ func runSystemdMode(log *Logger, cfg *vmconfig.Config) {
 		// Continue anyway - VM will work, just without agent
 	}
 
+	for _, unit := range cfg.SystemdMaskUnits {
+		if err := maskUnit(newroot, unit); err != nil {
+			log.Error("systemd", fmt.Sprintf("failed to mask %s", unit), err)
+		}
+	}
+
 	// Change root to the new filesystem using chroot
 	log.Info("systemd", "executing chroot")
 	if err := syscall.Chroot(newroot); err != nil {
-		log.Error("systemd", "chroot failed", err)
-		dropToShell()
+		bootFailed(log, "systemd", "chroot failed", err)
 	}
 
 	// Change to new root directory
 	if err := os.Chdir("/"); err != nil {
-		log.Error("systemd", "chdir / failed", err)
-		dropToShell()
+		bootFailed(log, "systemd", "chdir / failed", err)
 	}
 
 	// Build effective command from entrypoint + cmd
@@ -43,17 +49,37 @@ func runSystemdMode(log *Logger, cfg *vmconfig.Config) {
 		// Fallback to /sbin/init if no command specified
 		argv = []string{"/sbin/init"}
 	}
+	// Boot into the requested target rather than default.target
+	if cfg.SystemdTarget != "" {
+		argv = append(argv, "--unit="+cfg.SystemdTarget)
+	}
 
 	// Exec systemd - this replaces the current process
 	log.Info("systemd", fmt.Sprintf("exec %v", argv))
 
+	// Boot is complete once systemd takes over; a failed exec is reported
+	// after this and supersedes it
+	log.Marker(vmconfig.BootCompleteMarker)
+
 	// syscall.Exec replaces the current process with the new one
 	// Use buildEnv to include user's environment variables from the image/instance config
 	err := syscall.Exec(argv[0], argv, buildEnv(cfg.Env))
 	if err != nil {
-		log.Error("systemd", fmt.Sprintf("exec %s failed", argv[0]), err)
-		dropToShell()
+		bootFailed(log, "systemd", fmt.Sprintf("exec %s failed", argv[0]), err)
+	}
+}
+
+// maskUnit masks a systemd unit by linking it to /dev/null, as systemctl mask
+// does, replacing any unit file the image has in /etc
+func maskUnit(newroot, unit string) error {
+	unitPath := filepath.Join(newroot, "etc/systemd/system", unit)
+	if err := os.MkdirAll(filepath.Dir(unitPath), 0755); err != nil {
+		return err
+	}
+	if err := os.Remove(unitPath); err != nil && !os.IsNotExist(err) {
+		return err
 	}
+	return os.Symlink("/dev/null", unitPath)
 }
 
 // injectAgentService creates the systemd service unit for the hypeman guest-agent.
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// loadKernelModules loads the kernel modules the instance asked for. Each one
+// is looked up in the initrd's module tree, then in the container's
+// /lib/modules, which a volume mounted there can supply. A module that is
+// missing or fails to load is logged by name and skipped so the workload
+// still boots.
+func loadKernelModules(log *Logger, names []string) error {
+	log.Info("modules", "loading kernel modules")
+
+	kver, err := kernelRelease()
+	if err != nil {
+		return err
+	}
+	trees := []string{
+		filepath.Join("/lib/modules", kver),
+		filepath.Join("/overlay/newroot/lib/modules", kver),
+	}
+
+	var failed []string
+	for _, name := range names {
+		if err := loadModule(log, trees, name); err != nil {
+			log.Error("modules", fmt.Sprintf("load %s failed", name), err)
+			failed = append(failed, name)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("modules not loaded: %s", strings.Join(failed, ", "))
+	}
+
+	log.Info("modules", fmt.Sprintf("loaded %d kernel modules for kernel %s", len(names), kver))
+	return nil
+}
+
+// kernelRelease returns the running kernel's release, which names its
+// directory under /lib/modules
+func kernelRelease() (string, error) {
+	release, err := os.ReadFile("/proc/sys/kernel/osrelease")
+	if err != nil {
+		return "", fmt.Errorf("read kernel release: %w", err)
+	}
+	return strings.TrimSpace(string(release)), nil
+}
+
+// loadModule loads one module and the modules it depends on from the first
+// tree that has it. Modules that are built in or already loaded are skipped.
+func loadModule(log *Logger, trees []string, name string) error {
+	if moduleLoaded(name) {
+		log.Info("modules", fmt.Sprintf("%s already loaded or built in", name))
+		return nil
+	}
+
+	for _, tree := range trees {
+		paths, err := resolveModule(tree, name)
+		if err != nil {
+			return err
+		}
+		if paths == nil {
+			continue
+		}
+		for _, path := range paths {
+			if moduleLoaded(moduleName(path)) {
+				continue
+			}
+			if output, err := exec.Command("/sbin/insmod", path).CombinedOutput(); err != nil {
+				return fmt.Errorf("insmod %s: %s", filepath.Base(path), strings.TrimSpace(string(output)))
+			}
+		}
+		log.Info("modules", fmt.Sprintf("loaded %s from %s", name, tree))
+		return nil
+	}
+	return fmt.Errorf("module not found in %s", strings.Join(trees, " or "))
+}
+
+// resolveModule returns the files to insmod for a module in tree, its
+// dependencies first, or nil if the tree does not have it. Dependencies come
+// from modules.dep; a tree without one is searched for the module file alone.
+func resolveModule(tree, name string) ([]string, error) {
+	want := moduleName(name)
+
+	f, err := os.Open(filepath.Join(tree, "modules.dep"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return findModuleFile(tree, want)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read modules.dep: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		mod, deps, ok := strings.Cut(scanner.Text(), ":")
+		if !ok || moduleName(mod) != want {
+			continue
+		}
+		// modules.dep lists the deepest dependency last
+		paths := strings.Fields(deps)
+		slices.Reverse(paths)
+		paths = append(paths, mod)
+		for i, p := range paths {
+			if !filepath.IsAbs(p) {
+				paths[i] = filepath.Join(tree, p)
+			}
+		}
+		return paths, nil
+	}
+	return nil, scanner.Err()
+}
+
+// findModuleFile searches tree for the file of a module
+func findModuleFile(tree, want string) ([]string, error) {
+	var found string
+	err := filepath.WalkDir(tree, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+			return err
+		}
+		if !d.IsDir() && strings.HasSuffix(path, ".ko") && moduleName(path) == want {
+			found = path
+			return fs.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search %s: %w", tree, err)
+	}
+	if found == "" {
+		return nil, nil
+	}
+	return []string{found}, nil
+}
+
+// moduleName returns the kernel's name for a module given its name or file
+// path: "kernel/fs/fuse/fuse.ko" and "fuse" are both "fuse", and dashes
+// become underscores as they do under /sys/module.
+func moduleName(s string) string {
+	name, _, _ := strings.Cut(filepath.Base(s), ".ko")
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+// moduleLoaded reports whether a module is loaded or built into the kernel
+func moduleLoaded(name string) bool {
+	_, err := os.Stat(filepath.Join("/sys/module", moduleName(name)))
+	return err == nil
+}
@@ -60,6 +60,13 @@ func mountEssentials(log *Logger) error {
 
 	log.Info("mount", "redirected to serial console")
 
+	// The application's output goes to the virtio console where the
+	// hypervisor provides one, apart from the kernel and init logs
+	if _, err := os.Stat("/dev/hvc0"); err == nil {
+		redirectToConsole("/dev/hvc0")
+		log.Info("mount", "redirected application output to virtio console")
+	}
+
 	return nil
 }
 
@@ -106,6 +113,17 @@ func setupOverlay(log *Logger) error {
 	return nil
 }
 
+// enableOverlayDiscard remounts the overlay disk with online discard, so files
+// the guest deletes free their blocks on the host. The disk is mounted before
+// the config that asks for this is read.
+func enableOverlayDiscard(log *Logger) error {
+	if err := syscall.Mount("", "/overlay", "", syscall.MS_REMOUNT, "discard"); err != nil {
+		return fmt.Errorf("remount /overlay: %w", err)
+	}
+	log.Info("overlay", "enabled discard on overlay disk")
+	return nil
+}
+
 // bindMountsToNewRoot bind-mounts essential filesystems to the new root.
 // Uses bind mounts instead of move so that the original /dev remains populated
 // for processes running in the initrd namespace.
@@ -186,7 +204,8 @@ func bindMount(source, target string) error {
 	return nil
 }
 
-// redirectToConsole redirects stdout/stderr to the serial console
+// redirectToConsole redirects stdout/stderr, which the application inherits,
+// to a console device
 func redirectToConsole(device string) {
 	f, err := os.OpenFile(device, os.O_WRONLY, 0)
 	if err != nil {
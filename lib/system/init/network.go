@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/onkernel/hypeman/lib/vmconfig"
 )
@@ -22,6 +23,13 @@ func configureNetwork(log *Logger, cfg *vmconfig.Config) error {
 		return fmt.Errorf("add IP address: %w", err)
 	}
 
+	// Match the TAP device MTU before bringing eth0 up
+	if cfg.GuestMTU > 0 {
+		if err := runIP("link", "set", "eth0", "mtu", fmt.Sprint(cfg.GuestMTU)); err != nil {
+			return fmt.Errorf("set eth0 mtu: %w", err)
+		}
+	}
+
 	// Bring up eth0
 	if err := runIP("link", "set", "eth0", "up"); err != nil {
 		return fmt.Errorf("bring up eth0: %w", err)
@@ -33,7 +41,7 @@ func configureNetwork(log *Logger, cfg *vmconfig.Config) error {
 	}
 
 	// Configure DNS in the new root
-	resolvConf := fmt.Sprintf("nameserver %s\n", cfg.GuestDNS)
+	resolvConf := buildResolvConf(cfg)
 	resolvPath := "/overlay/newroot/etc/resolv.conf"
 
 	// Ensure /etc exists
@@ -49,6 +57,23 @@ func configureNetwork(log *Logger, cfg *vmconfig.Config) error {
 	return nil
 }
 
+// buildResolvConf renders resolv.conf from the instance's resolvers and
+// search domains, falling back to the host's DNS server.
+func buildResolvConf(cfg *vmconfig.Config) string {
+	servers := cfg.DNSServers
+	if len(servers) == 0 {
+		servers = []string{cfg.GuestDNS}
+	}
+	var b strings.Builder
+	if len(cfg.SearchDomains) > 0 {
+		fmt.Fprintf(&b, "search %s\n", strings.Join(cfg.SearchDomains, " "))
+	}
+	for _, server := range servers {
+		fmt.Fprintf(&b, "nameserver %s\n", server)
+	}
+	return b.String()
+}
+
 // runIP executes an 'ip' command with the given arguments.
 func runIP(args ...string) error {
 	cmd := exec.Command("/sbin/ip", args...)
@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 
 	"github.com/onkernel/hypeman/lib/vmconfig"
 )
@@ -14,7 +15,19 @@ import (
 func mountVolumes(log *Logger, cfg *vmconfig.Config) error {
 	log.Info("volumes", "mounting volumes")
 
+	serials := diskSerials()
 	for _, vol := range cfg.VolumeMounts {
+		var err error
+		if vol.Device, err = findDisk(serials, vol.Serial, vol.Device); err != nil {
+			log.Error("volumes", fmt.Sprintf("find disk for %s failed", vol.Path), err)
+			continue
+		}
+		if vol.OverlayDevice != "" {
+			if vol.OverlayDevice, err = findDisk(serials, vol.OverlaySerial, vol.OverlayDevice); err != nil {
+				log.Error("volumes", fmt.Sprintf("find overlay disk for %s failed", vol.Path), err)
+				continue
+			}
+		}
 		mountPath := filepath.Join("/overlay/newroot", vol.Path)
 
 		// Create mount point
@@ -42,6 +55,36 @@ func mountVolumes(log *Logger, cfg *vmconfig.Config) error {
 	return nil
 }
 
+// diskSerials maps the serial of each virtio-blk disk to its device.
+// /dev/vdX letters follow the order disks were attached in, and aren't even
+// that on a second PCI bus, so volumes are found by serial where possible.
+func diskSerials() map[string]string {
+	serials := make(map[string]string)
+	paths, _ := filepath.Glob("/sys/block/vd*/serial")
+	for _, path := range paths {
+		serial, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		name := filepath.Base(filepath.Dir(path))
+		serials[strings.TrimSpace(string(serial))] = "/dev/" + name
+	}
+	return serials
+}
+
+// findDisk returns the device with the given serial. Without a serial (the
+// hypervisor doesn't pass them on) it returns the configured device; a
+// serial that matches no disk is an error rather than a guess.
+func findDisk(serials map[string]string, serial, device string) (string, error) {
+	if serial == "" {
+		return device, nil
+	}
+	if found, ok := serials[serial]; ok {
+		return found, nil
+	}
+	return "", fmt.Errorf("no disk with serial %s", serial)
+}
+
 // mountVolumeOverlay mounts a volume in overlay mode.
 // Uses the base device as read-only lower layer and overlay device for writable upper layer.
 func mountVolumeOverlay(log *Logger, vol vmconfig.VolumeMount, mountPath string) error {
@@ -66,7 +109,7 @@ func mountVolumeOverlay(log *Logger, vol vmconfig.VolumeMount, mountPath string)
 	}
 
 	// Mount overlay disk (writable)
-	cmd = exec.Command("/bin/mount", "-t", "ext4", vol.OverlayDevice, overlayMount)
+	cmd = exec.Command("/bin/mount", "-t", "ext4", "-o", writableMountOptions(vol), vol.OverlayDevice, overlayMount)
 	if output, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("mount overlay disk: %s: %s", err, output)
 	}
@@ -102,7 +145,7 @@ func mountVolumeReadOnly(log *Logger, vol vmconfig.VolumeMount, mountPath string
 
 // mountVolumeReadWrite mounts a volume in read-write mode.
 func mountVolumeReadWrite(log *Logger, vol vmconfig.VolumeMount, mountPath string) error {
-	cmd := exec.Command("/bin/mount", "-t", "ext4", vol.Device, mountPath)
+	cmd := exec.Command("/bin/mount", "-t", "ext4", "-o", writableMountOptions(vol), vol.Device, mountPath)
 	if output, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("%s: %s", err, output)
 	}
@@ -111,3 +154,11 @@ func mountVolumeReadWrite(log *Logger, vol vmconfig.VolumeMount, mountPath strin
 	return nil
 }
 
+
+// writableMountOptions returns the mount options for a volume's writable disk
+func writableMountOptions(vol vmconfig.VolumeMount) string {
+	if vol.Discard {
+		return "rw,discard"
+	}
+	return "rw"
+}
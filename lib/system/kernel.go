@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 )
 
 // downloadKernel downloads a kernel from Cloud Hypervisor releases
@@ -39,27 +41,60 @@ func (m *manager) downloadKernel(version KernelVersion, arch string) error {
 		return fmt.Errorf("download failed with status %d from %s", resp.StatusCode, url)
 	}
 
-	// Create output file
-	outFile, err := os.Create(destPath)
+	// Download to a temp file and rename, so an interrupted download never
+	// leaves a truncated kernel that ensureKernel would accept
+	tmpPath := destPath + ".tmp"
+	outFile, err := os.Create(tmpPath)
 	if err != nil {
 		return fmt.Errorf("create file: %w", err)
 	}
-	defer outFile.Close()
+	defer os.Remove(tmpPath)
 
-	// Copy with progress
 	_, err = io.Copy(outFile, resp.Body)
+	if closeErr := outFile.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
 		return fmt.Errorf("write file: %w", err)
 	}
 
 	// Make executable
-	if err := os.Chmod(destPath, 0755); err != nil {
+	if err := os.Chmod(tmpPath, 0755); err != nil {
 		return fmt.Errorf("chmod: %w", err)
 	}
 
+	if err := os.Rename(tmpPath, destPath); err != nil {
+		return fmt.Errorf("rename kernel: %w", err)
+	}
+
 	return nil
 }
 
+// EnsureKernel returns the path to a supported kernel version, downloading it
+// on first use. Unknown versions fail with ErrUnsupportedVersion and the list
+// of versions available for this architecture.
+func (m *manager) EnsureKernel(version KernelVersion) (string, error) {
+	arch := GetArch()
+	if _, ok := KernelDownloadURLs[version][arch]; !ok || !slices.Contains(SupportedKernelVersions, version) {
+		return "", fmt.Errorf("%w: kernel %q (available: %s)", ErrUnsupportedVersion, version, strings.Join(availableKernels(arch), ", "))
+	}
+
+	m.kernelMu.Lock()
+	defer m.kernelMu.Unlock()
+	return m.ensureKernel(version)
+}
+
+// availableKernels lists the supported kernel versions downloadable for arch
+func availableKernels(arch string) []string {
+	var versions []string
+	for _, v := range SupportedKernelVersions {
+		if _, ok := KernelDownloadURLs[v][arch]; ok {
+			versions = append(versions, string(v))
+		}
+	}
+	return versions
+}
+
 // ensureKernel ensures kernel exists, downloads if missing
 func (m *manager) ensureKernel(version KernelVersion) (string, error) {
 	arch := GetArch()
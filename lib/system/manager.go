@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/onkernel/hypeman/lib/paths"
 )
@@ -13,6 +14,10 @@ type Manager interface {
 	// EnsureSystemFiles ensures default kernel and initrd exist
 	EnsureSystemFiles(ctx context.Context) error
 
+	// EnsureKernel validates a kernel version and downloads it if missing,
+	// returning its path
+	EnsureKernel(version KernelVersion) (string, error)
+
 	// GetKernelPath returns path to kernel file
 	GetKernelPath(version KernelVersion) (string, error)
 
@@ -24,7 +29,8 @@ type Manager interface {
 }
 
 type manager struct {
-	paths *paths.Paths
+	paths    *paths.Paths
+	kernelMu sync.Mutex // serializes on-demand kernel downloads
 }
 
 // NewManager creates a new system manager
@@ -2,6 +2,8 @@ package system
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/onkernel/hypeman/lib/paths"
@@ -28,6 +30,27 @@ func TestGetKernelPath(t *testing.T) {
 	assert.Contains(t, kernelPath, "vmlinux")
 }
 
+func TestEnsureKernel(t *testing.T) {
+	tmpDir := t.TempDir()
+	mgr := NewManager(paths.New(tmpDir))
+
+	// Unknown versions are rejected with the list of available ones
+	_, err := mgr.EnsureKernel("ch-6.1.0-nope")
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+	assert.Contains(t, err.Error(), `"ch-6.1.0-nope"`)
+	assert.Contains(t, err.Error(), string(DefaultKernelVersion))
+
+	// A kernel already on disk is returned without downloading
+	want, err := mgr.GetKernelPath(Kernel_20251211)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(want), 0755))
+	require.NoError(t, os.WriteFile(want, []byte("kernel"), 0755))
+
+	got, err := mgr.EnsureKernel(Kernel_20251211)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
 func TestEnsureSystemFiles(t *testing.T) {
 	// This test requires network access and takes a while
 	// Skip by default, run explicitly with: go test -run TestEnsureSystemFiles
@@ -0,0 +1,22 @@
+package vmconfig
+
+// Boot markers are lines the guest init writes to the serial console, which
+// the host captures as the instance's app log, so the host can tell how far
+// the last boot got without a console to look at.
+const (
+	// BootStartedMarker is written when init starts
+	BootStartedMarker = "HYPEMAN_BOOT_STARTED"
+
+	// BootCompleteMarker is written once the workload has been started
+	BootCompleteMarker = "HYPEMAN_BOOT_COMPLETE"
+
+	// BootFailedMarker prefixes a JSON-encoded BootFailure when a boot phase
+	// fails
+	BootFailedMarker = "HYPEMAN_BOOT_FAILED "
+)
+
+// BootFailure says which boot phase failed and why
+type BootFailure struct {
+	Phase string `json:"phase"`
+	Error string `json:"error"`
+}
@@ -19,6 +19,11 @@ type Config struct {
 	GuestCIDR      int    `json:"guest_cidr,omitempty"`
 	GuestGW        string `json:"guest_gw,omitempty"`
 	GuestDNS       string `json:"guest_dns,omitempty"`
+	GuestMTU       int    `json:"guest_mtu,omitempty"`
+
+	// Resolvers replacing GuestDNS, and search domains, for resolv.conf
+	DNSServers    []string `json:"dns_servers,omitempty"`
+	SearchDomains []string `json:"search_domains,omitempty"`
 
 	// GPU passthrough
 	HasGPU bool `json:"has_gpu"`
@@ -26,14 +31,29 @@ type Config struct {
 	// Volume mounts
 	VolumeMounts []VolumeMount `json:"volume_mounts,omitempty"`
 
+	// Mount the overlay disk with online discard, so deleted files free host disk
+	Discard bool `json:"discard,omitempty"`
+
+	// Kernel modules to load at boot, by name
+	KernelModules []string `json:"kernel_modules,omitempty"`
+
 	// Init mode: "exec" (default) or "systemd"
 	InitMode string `json:"init_mode"`
+
+	// Systemd mode: target to boot into (empty = default.target) and units to mask
+	SystemdTarget    string   `json:"systemd_target,omitempty"`
+	SystemdMaskUnits []string `json:"systemd_mask_units,omitempty"`
 }
 
 // VolumeMount represents a volume mount configuration.
+// Devices past /dev/vdz may be probed in any order, so the guest looks the
+// disks up by serial and falls back to the device names.
 type VolumeMount struct {
 	Device        string `json:"device"`
+	Serial        string `json:"serial,omitempty"`
 	Path          string `json:"path"`
 	Mode          string `json:"mode"` // "ro", "rw", or "overlay"
 	OverlayDevice string `json:"overlay_device,omitempty"`
+	OverlaySerial string `json:"overlay_serial,omitempty"`
+	Discard       bool   `json:"discard,omitempty"` // Mount the writable disk with online discard
 }
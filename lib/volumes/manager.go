@@ -2,14 +2,17 @@ package volumes
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/nrednav/cuid2"
 	"github.com/onkernel/hypeman/lib/images"
+	"github.com/onkernel/hypeman/lib/pagination"
 	"github.com/onkernel/hypeman/lib/paths"
 	"go.opentelemetry.io/otel/metric"
 )
@@ -17,11 +20,18 @@ import (
 // Manager provides volume lifecycle operations
 type Manager interface {
 	ListVolumes(ctx context.Context) ([]Volume, error)
+	// ListVolumesPage returns one page of volumes, sorted by created_at
+	// (default) or name
+	ListVolumesPage(ctx context.Context, req pagination.Request) (*pagination.Page[Volume], error)
 	CreateVolume(ctx context.Context, req CreateVolumeRequest) (*Volume, error)
 	CreateVolumeFromArchive(ctx context.Context, req CreateVolumeFromArchiveRequest, archive io.Reader) (*Volume, error)
-	GetVolume(ctx context.Context, id string) (*Volume, error)
+	// GetVolume looks a volume up by ID, name, or unique ID prefix
+	GetVolume(ctx context.Context, idOrName string) (*Volume, error)
 	GetVolumeByName(ctx context.Context, name string) (*Volume, error)
 	DeleteVolume(ctx context.Context, id string) error
+	// CloneVolume copies a volume's data into a new, unattached volume.
+	// Writers must be paused for the copy to be consistent.
+	CloneVolume(ctx context.Context, id string, name string) (*Volume, error)
 
 	// Attachment operations (called by instance manager)
 	// Multi-attach rules:
@@ -82,7 +92,7 @@ func (m *manager) ListVolumes(ctx context.Context) ([]Volume, error) {
 
 	volumes := make([]Volume, 0, len(ids))
 	for _, id := range ids {
-		vol, err := m.GetVolume(ctx, id)
+		vol, err := m.getVolume(id)
 		if err != nil {
 			// Skip volumes that can't be loaded
 			continue
@@ -93,6 +103,21 @@ func (m *manager) ListVolumes(ctx context.Context) ([]Volume, error) {
 	return volumes, nil
 }
 
+// volumeSortKeys are the fields volume lists can be sorted by
+var volumeSortKeys = pagination.SortKeys[Volume]{
+	"created_at": func(v Volume) string { return pagination.TimeKey(v.CreatedAt) },
+	"name":       func(v Volume) string { return v.Name },
+}
+
+// ListVolumesPage returns one page of volumes, oldest first by default
+func (m *manager) ListVolumesPage(ctx context.Context, req pagination.Request) (*pagination.Page[Volume], error) {
+	volumes, err := m.ListVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pagination.Paginate(volumes, req, volumeSortKeys, "created_at", func(v Volume) string { return v.Id })
+}
+
 // calculateTotalVolumeStorage calculates total storage used by all volumes
 func (m *manager) calculateTotalVolumeStorage(ctx context.Context) (int64, error) {
 	volumes, err := m.ListVolumes(ctx)
@@ -249,8 +274,55 @@ func (m *manager) CreateVolumeFromArchive(ctx context.Context, req CreateVolumeF
 	return m.metadataToVolume(meta), nil
 }
 
-// GetVolume returns a volume by ID
-func (m *manager) GetVolume(ctx context.Context, id string) (*Volume, error) {
+// GetVolume returns a volume by ID, name, or ID prefix.
+// Lookup order: exact ID match -> exact name match -> ID prefix match.
+// Returns ErrAmbiguousName if the name or prefix matches multiple volumes.
+func (m *manager) GetVolume(ctx context.Context, idOrName string) (*Volume, error) {
+	// 1. Try exact ID match first (most common case)
+	vol, err := m.getVolume(idOrName)
+	if !errors.Is(err, ErrNotFound) {
+		return vol, err
+	}
+
+	// 2. Load all volumes for name and prefix matching
+	all, err := m.ListVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Try exact name match
+	var nameMatches []Volume
+	for _, v := range all {
+		if v.Name == idOrName {
+			nameMatches = append(nameMatches, v)
+		}
+	}
+	if len(nameMatches) == 1 {
+		return &nameMatches[0], nil
+	}
+	if len(nameMatches) > 1 {
+		return nil, ErrAmbiguousName
+	}
+
+	// 4. Try ID prefix match
+	var prefixMatches []Volume
+	for _, v := range all {
+		if len(idOrName) > 0 && strings.HasPrefix(v.Id, idOrName) {
+			prefixMatches = append(prefixMatches, v)
+		}
+	}
+	if len(prefixMatches) == 1 {
+		return &prefixMatches[0], nil
+	}
+	if len(prefixMatches) > 1 {
+		return nil, ErrAmbiguousName
+	}
+
+	return nil, ErrNotFound
+}
+
+// getVolume returns a volume by exact ID
+func (m *manager) getVolume(id string) (*Volume, error) {
 	lock := m.getVolumeLock(id)
 	lock.RLock()
 	defer lock.RUnlock()
@@ -288,6 +360,54 @@ func (m *manager) GetVolumeByName(ctx context.Context, name string) (*Volume, er
 	return &matches[0], nil
 }
 
+// CloneVolume copies a volume's data into a new, unattached volume
+func (m *manager) CloneVolume(ctx context.Context, id string, name string) (*Volume, error) {
+	start := time.Now()
+
+	lock := m.getVolumeLock(id)
+	lock.RLock()
+	defer lock.RUnlock()
+
+	source, err := loadMetadata(m.paths, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check total volume storage limit
+	if m.maxTotalVolumeStorage > 0 {
+		currentStorage, err := m.calculateTotalVolumeStorage(ctx)
+		if err == nil {
+			newVolumeSize := int64(source.SizeGb) * 1024 * 1024 * 1024
+			if currentStorage+newVolumeSize > m.maxTotalVolumeStorage {
+				return nil, fmt.Errorf("total volume storage would be %d bytes, exceeds limit of %d bytes", currentStorage+newVolumeSize, m.maxTotalVolumeStorage)
+			}
+		}
+	}
+
+	cloneID := cuid2.Generate()
+	if err := ensureVolumeDir(m.paths, cloneID); err != nil {
+		return nil, err
+	}
+	if err := images.CopyDisk(m.paths.VolumeData(id), m.paths.VolumeData(cloneID)); err != nil {
+		deleteVolumeData(m.paths, cloneID)
+		return nil, err
+	}
+
+	meta := &storedMetadata{
+		Id:        cloneID,
+		Name:      name,
+		SizeGb:    source.SizeGb,
+		CreatedAt: time.Now().Format(time.RFC3339),
+	}
+	if err := saveMetadata(m.paths, meta); err != nil {
+		deleteVolumeData(m.paths, cloneID)
+		return nil, err
+	}
+
+	m.recordCreateDuration(ctx, start, "success")
+	return m.metadataToVolume(meta), nil
+}
+
 // DeleteVolume deletes a volume
 func (m *manager) DeleteVolume(ctx context.Context, id string) error {
 	lock := m.getVolumeLock(id)
@@ -334,7 +454,7 @@ func (m *manager) AttachVolume(ctx context.Context, id string, req AttachVolumeR
 	// Check if this instance is already attached
 	for _, att := range meta.Attachments {
 		if att.InstanceID == req.InstanceID {
-			return fmt.Errorf("volume already attached to instance %s", req.InstanceID)
+			return fmt.Errorf("%w: volume already attached to instance %s", ErrInUse, req.InstanceID)
 		}
 	}
 
@@ -343,12 +463,12 @@ func (m *manager) AttachVolume(ctx context.Context, id string, req AttachVolumeR
 		// Check if any existing attachment is read-write
 		for _, att := range meta.Attachments {
 			if !att.Readonly {
-				return fmt.Errorf("volume has exclusive read-write attachment to instance %s", att.InstanceID)
+				return fmt.Errorf("%w: volume has exclusive read-write attachment to instance %s", ErrInUse, att.InstanceID)
 			}
 		}
 		// Existing attachments are all read-only, new attachment must also be read-only
 		if !req.Readonly {
-			return fmt.Errorf("cannot attach read-write: volume has existing read-only attachments")
+			return fmt.Errorf("%w: cannot attach read-write: volume has existing read-only attachments", ErrInUse)
 		}
 	}
 
@@ -3,6 +3,7 @@ package volumes
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"testing"
 
@@ -32,6 +33,61 @@ func setupTestManager(t *testing.T) (Manager, *paths.Paths, func()) {
 	return manager, p, cleanup
 }
 
+func TestGetVolume_Resolution(t *testing.T) {
+	manager, p, cleanup := setupTestManager(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	for _, meta := range []storedMetadata{
+		{Id: "abc123def456", Name: "api-data", SizeGb: 1},
+		{Id: "abc789xyz123", Name: "web-data", SizeGb: 1},
+		{Id: "xyz999aaa111", Name: "admin-data", SizeGb: 1},
+		{Id: "def000bbb222", Name: "shared", SizeGb: 1},
+		{Id: "def111ccc333", Name: "shared", SizeGb: 1},
+	} {
+		require.NoError(t, ensureVolumeDir(p, meta.Id))
+		require.NoError(t, saveMetadata(p, &meta))
+	}
+
+	t.Run("exact ID match", func(t *testing.T) {
+		vol, err := manager.GetVolume(ctx, "abc123def456")
+		require.NoError(t, err)
+		assert.Equal(t, "api-data", vol.Name)
+	})
+
+	t.Run("exact name match", func(t *testing.T) {
+		vol, err := manager.GetVolume(ctx, "web-data")
+		require.NoError(t, err)
+		assert.Equal(t, "abc789xyz123", vol.Id)
+	})
+
+	t.Run("unique ID prefix match", func(t *testing.T) {
+		vol, err := manager.GetVolume(ctx, "xyz")
+		require.NoError(t, err)
+		assert.Equal(t, "xyz999aaa111", vol.Id)
+
+		vol, err = manager.GetVolume(ctx, "abc123")
+		require.NoError(t, err)
+		assert.Equal(t, "abc123def456", vol.Id)
+	})
+
+	t.Run("ambiguous ID prefix", func(t *testing.T) {
+		// "abc" matches both abc123def456 and abc789xyz123
+		_, err := manager.GetVolume(ctx, "abc")
+		assert.ErrorIs(t, err, ErrAmbiguousName)
+	})
+
+	t.Run("ambiguous name", func(t *testing.T) {
+		_, err := manager.GetVolume(ctx, "shared")
+		assert.ErrorIs(t, err, ErrAmbiguousName)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := manager.GetVolume(ctx, "nonexistent")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
 func TestMultiAttach_FirstAttachmentRW(t *testing.T) {
 	manager, _, cleanup := setupTestManager(t)
 	defer cleanup()
@@ -389,3 +445,36 @@ func TestMultiAttach_ConcurrentRWConflict(t *testing.T) {
 	assert.Len(t, vol.Attachments, 1, "Should have exactly one attachment")
 	assert.False(t, vol.Attachments[0].Readonly, "Attachment should be read-write")
 }
+
+func TestCloneVolume(t *testing.T) {
+	manager, p, cleanup := setupTestManager(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	vol, err := manager.CreateVolume(ctx, CreateVolumeRequest{Name: "data", SizeGb: 1})
+	require.NoError(t, err)
+	require.NoError(t, manager.AttachVolume(ctx, vol.Id, AttachVolumeRequest{InstanceID: "instance-1", MountPath: "/data"}))
+
+	// The clone is a separate, unattached copy of the data
+	clone, err := manager.CloneVolume(ctx, vol.Id, "data-copy")
+	require.NoError(t, err)
+	assert.NotEqual(t, vol.Id, clone.Id)
+	assert.Equal(t, "data-copy", clone.Name)
+	assert.Equal(t, 1, clone.SizeGb)
+	assert.Empty(t, clone.Attachments)
+
+	// Compare the start of the disks, which holds the ext4 superblock
+	head := func(id string) []byte {
+		f, err := os.Open(p.VolumeData(id))
+		require.NoError(t, err)
+		defer f.Close()
+		buf := make([]byte, 64*1024)
+		_, err = io.ReadFull(f, buf)
+		require.NoError(t, err)
+		return buf
+	}
+	assert.Equal(t, head(vol.Id), head(clone.Id))
+
+	_, err = manager.CloneVolume(ctx, "missing", "copy")
+	assert.ErrorIs(t, err, ErrNotFound)
+}